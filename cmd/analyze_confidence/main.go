@@ -4,31 +4,14 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
+	"binance-trading-bot/internal/database"
+
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-type TradeWithConfidence struct {
-	Symbol       string
-	Confidence   float64
-	RealizedPnL  float64
-	PnLPercent   float64
-	EntryTime    time.Time
-	PositionSide string
-}
-
-type ConfidenceBucket struct {
-	MinConf       float64
-	MaxConf       float64
-	TotalTrades   int
-	WinningTrades int
-	LosingTrades  int
-	TotalPnL      float64
-	AvgPnL        float64
-	WinRate       float64
-}
-
 func main() {
 	// Read from environment variables directly
 	// Build connection string
@@ -41,6 +24,14 @@ func main() {
 	connStr := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
 		dbUser, dbPass, dbHost, dbPort, dbName)
 
+	// Prefer a read replica so the full-history scan doesn't load the trading primary
+	if replicas := getEnv("DB_REPLICA_URLS", ""); replicas != "" {
+		if replica := strings.TrimSpace(strings.Split(replicas, ",")[0]); replica != "" {
+			connStr = replica
+			fmt.Println("📖 Using read replica")
+		}
+	}
+
 	ctx := context.Background()
 	pool, err := pgxpool.New(ctx, connStr)
 	if err != nil {
@@ -53,39 +44,12 @@ func main() {
 	fmt.Println("🧪 CONFIDENCE THRESHOLD BACKTEST ANALYSIS")
 	fmt.Println("=" + string(make([]byte, 79)))
 
-	// Query trades with AI decision confidence
-	query := `
-		SELECT
-			ft.symbol,
-			COALESCE(ad.confidence, 0) as confidence,
-			COALESCE(ft.realized_pnl, 0) as realized_pnl,
-			COALESCE(ft.realized_pnl_percent, 0) as pnl_percent,
-			ft.entry_time,
-			ft.position_side
-		FROM futures_trades ft
-		LEFT JOIN ai_decisions ad ON ft.ai_decision_id = ad.id
-		WHERE ft.status = 'CLOSED'
-		  AND ft.realized_pnl IS NOT NULL
-		ORDER BY ft.entry_time DESC
-	`
-
-	rows, err := pool.Query(ctx, query)
+	db := &database.DB{Pool: pool}
+	trades, err := db.GetConfidenceTrades(ctx, "", time.Time{}, time.Time{}, "")
 	if err != nil {
 		fmt.Printf("Query failed: %v\n", err)
 		os.Exit(1)
 	}
-	defer rows.Close()
-
-	var trades []TradeWithConfidence
-	for rows.Next() {
-		var t TradeWithConfidence
-		err := rows.Scan(&t.Symbol, &t.Confidence, &t.RealizedPnL, &t.PnLPercent, &t.EntryTime, &t.PositionSide)
-		if err != nil {
-			fmt.Printf("Scan error: %v\n", err)
-			continue
-		}
-		trades = append(trades, t)
-	}
 
 	if len(trades) == 0 {
 		fmt.Println("\n❌ No closed trades with AI decisions found in database.")
@@ -107,48 +71,16 @@ func main() {
 
 	fmt.Printf("\n📊 Analyzing %d closed trades with AI decisions...\n\n", len(trades))
 
-	// Define confidence buckets
-	buckets := []ConfidenceBucket{
-		{MinConf: 0.00, MaxConf: 0.35, TotalTrades: 0},  // Very low (would be rejected at 35%)
-		{MinConf: 0.35, MaxConf: 0.50, TotalTrades: 0},  // Low (35-50%)
-		{MinConf: 0.50, MaxConf: 0.55, TotalTrades: 0},  // Medium-Low (50-55%)
-		{MinConf: 0.55, MaxConf: 0.65, TotalTrades: 0},  // Medium (55-65%)
-		{MinConf: 0.65, MaxConf: 0.75, TotalTrades: 0},  // Medium-High (65-75%)
-		{MinConf: 0.75, MaxConf: 1.00, TotalTrades: 0},  // High (75%+)
-	}
-
-	// Categorize trades into buckets
-	for _, t := range trades {
-		for i := range buckets {
-			if t.Confidence >= buckets[i].MinConf && t.Confidence < buckets[i].MaxConf {
-				buckets[i].TotalTrades++
-				buckets[i].TotalPnL += t.RealizedPnL
-				if t.RealizedPnL > 0 {
-					buckets[i].WinningTrades++
-				} else if t.RealizedPnL < 0 {
-					buckets[i].LosingTrades++
-				}
-				break
-			}
-		}
-	}
-
-	// Calculate stats
-	for i := range buckets {
-		if buckets[i].TotalTrades > 0 {
-			buckets[i].AvgPnL = buckets[i].TotalPnL / float64(buckets[i].TotalTrades)
-			buckets[i].WinRate = float64(buckets[i].WinningTrades) / float64(buckets[i].TotalTrades) * 100
-		}
-	}
+	analysis := database.AnalyzeConfidence(trades)
 
 	// Print bucket analysis
 	fmt.Println("┌─────────────────┬────────┬─────────┬─────────┬──────────────┬──────────────┬──────────┐")
 	fmt.Println("│ Confidence      │ Trades │ Winners │ Losers  │ Total PnL    │ Avg PnL      │ Win Rate │")
 	fmt.Println("├─────────────────┼────────┼─────────┼─────────┼──────────────┼──────────────┼──────────┤")
 
-	for _, b := range buckets {
+	for _, b := range analysis.Buckets {
 		fmt.Printf("│ %5.0f%% - %5.0f%% │ %6d │ %7d │ %7d │ %+12.2f │ %+12.2f │ %7.1f%% │\n",
-			b.MinConf*100, b.MaxConf*100,
+			b.MinConfidence*100, b.MaxConfidence*100,
 			b.TotalTrades, b.WinningTrades, b.LosingTrades,
 			b.TotalPnL, b.AvgPnL, b.WinRate)
 	}
@@ -159,54 +91,18 @@ func main() {
 	fmt.Println("📈 THRESHOLD COMPARISON ANALYSIS")
 	fmt.Println("=" + string(make([]byte, 79)))
 
-	thresholds := []float64{0.35, 0.50, 0.55, 0.65, 0.75}
-
-	for _, threshold := range thresholds {
-		var included, excluded int
-		var includedPnL, excludedPnL float64
-		var includedWins, excludedWins int
-		var includedLosses, excludedLosses int
-
-		for _, t := range trades {
-			if t.Confidence >= threshold {
-				included++
-				includedPnL += t.RealizedPnL
-				if t.RealizedPnL > 0 {
-					includedWins++
-				} else if t.RealizedPnL < 0 {
-					includedLosses++
-				}
-			} else {
-				excluded++
-				excludedPnL += t.RealizedPnL
-				if t.RealizedPnL > 0 {
-					excludedWins++
-				} else if t.RealizedPnL < 0 {
-					excludedLosses++
-				}
-			}
-		}
-
-		includedWinRate := 0.0
-		if included > 0 {
-			includedWinRate = float64(includedWins) / float64(included) * 100
-		}
-
-		excludedWinRate := 0.0
-		if excluded > 0 {
-			excludedWinRate = float64(excludedWins) / float64(excluded) * 100
-		}
-
+	for _, cmp := range analysis.Thresholds {
+		threshold := cmp.Threshold
 		fmt.Printf("\n🎯 Threshold: %.0f%%\n", threshold*100)
 		fmt.Printf("   ├── INCLUDED (≥%.0f%%): %d trades, PnL: $%.2f, Win Rate: %.1f%%\n",
-			threshold*100, included, includedPnL, includedWinRate)
+			threshold*100, cmp.Included.Trades, cmp.Included.PnL, cmp.Included.WinRate)
 		fmt.Printf("   └── EXCLUDED (<%.0f%%): %d trades, PnL: $%.2f, Win Rate: %.1f%%\n",
-			threshold*100, excluded, excludedPnL, excludedWinRate)
+			threshold*100, cmp.Excluded.Trades, cmp.Excluded.PnL, cmp.Excluded.WinRate)
 
-		if excludedPnL < 0 {
-			fmt.Printf("   💰 AVOIDED LOSS: $%.2f by using %.0f%% threshold\n", -excludedPnL, threshold*100)
-		} else if excludedPnL > 0 {
-			fmt.Printf("   ⚠️  MISSED PROFIT: $%.2f by using %.0f%% threshold\n", excludedPnL, threshold*100)
+		if cmp.Excluded.PnL < 0 {
+			fmt.Printf("   💰 AVOIDED LOSS: $%.2f by using %.0f%% threshold\n", -cmp.Excluded.PnL, threshold*100)
+		} else if cmp.Excluded.PnL > 0 {
+			fmt.Printf("   ⚠️  MISSED PROFIT: $%.2f by using %.0f%% threshold\n", cmp.Excluded.PnL, threshold*100)
 		}
 	}
 
@@ -215,28 +111,10 @@ func main() {
 	fmt.Println("🏆 RECOMMENDATION")
 	fmt.Println("=" + string(make([]byte, 79)))
 
-	// Find best threshold based on excluded PnL being negative (avoided losses)
-	bestThreshold := 0.55
-	bestAvoidedLoss := 0.0
-
-	for _, threshold := range thresholds {
-		var excludedPnL float64
-		for _, t := range trades {
-			if t.Confidence < threshold {
-				excludedPnL += t.RealizedPnL
-			}
-		}
-		if excludedPnL < bestAvoidedLoss {
-			bestAvoidedLoss = excludedPnL
-			bestThreshold = threshold
-		}
-	}
-
-	if bestAvoidedLoss < 0 {
-		fmt.Printf("\n✅ Optimal threshold: %.0f%% (would have avoided $%.2f in losses)\n",
-			bestThreshold*100, -bestAvoidedLoss)
+	if analysis.HasOptimal {
+		fmt.Printf("\n✅ %s\n", analysis.Recommendation)
 	} else {
-		fmt.Println("\n⚠️  No clear optimal threshold - confidence doesn't correlate with outcomes.")
+		fmt.Printf("\n⚠️  %s.\n", analysis.Recommendation)
 		fmt.Println("   Consider: Is the confidence scoring system accurate?")
 	}
 
@@ -245,13 +123,9 @@ func main() {
 	fmt.Println("📉 TOP LOSING TRADES (by confidence)")
 	fmt.Println("=" + string(make([]byte, 79)))
 
-	losingCount := 0
-	for _, t := range trades {
-		if t.RealizedPnL < -10 && losingCount < 10 { // Show top 10 losses > $10
-			fmt.Printf("   %s | Conf: %.1f%% | PnL: $%.2f | %s | %s\n",
-				t.Symbol, t.Confidence*100, t.RealizedPnL, t.PositionSide, t.EntryTime.Format("2006-01-02 15:04"))
-			losingCount++
-		}
+	for _, t := range analysis.TopLosingTrades {
+		fmt.Printf("   %s | Conf: %.1f%% | PnL: $%.2f | %s | %s\n",
+			t.Symbol, t.Confidence*100, t.RealizedPnL, t.PositionSide, t.EntryTime.Format("2006-01-02 15:04"))
 	}
 }
 
@@ -2,12 +2,14 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 
+	"binance-trading-bot/internal/database"
 	"binance-trading-bot/internal/license"
 )
 
@@ -18,6 +20,7 @@ func main() {
 	fmt.Println()
 
 	reader := bufio.NewReader(os.Stdin)
+	repo := connectStore()
 
 	for {
 		fmt.Println("\nOptions:")
@@ -25,7 +28,13 @@ func main() {
 		fmt.Println("  2. Generate batch license keys")
 		fmt.Println("  3. Validate a license key")
 		fmt.Println("  4. Show license type info")
-		fmt.Println("  5. Exit")
+		fmt.Println("  5. Show this installation's fingerprint")
+		fmt.Println("  6. List issued keys")
+		fmt.Println("  7. Search issued keys")
+		fmt.Println("  8. Revoke keys")
+		fmt.Println("  9. Import keys from file")
+		fmt.Println("  10. Export revocation list")
+		fmt.Println("  0. Exit")
 		fmt.Print("\nSelect option: ")
 
 		input, _ := reader.ReadString('\n')
@@ -33,14 +42,26 @@ func main() {
 
 		switch input {
 		case "1":
-			generateSingleKey(reader)
+			generateSingleKey(reader, repo)
 		case "2":
-			generateBatchKeys(reader)
+			generateBatchKeys(reader, repo)
 		case "3":
-			validateKey(reader)
+			validateKey(reader, repo)
 		case "4":
 			showLicenseInfo()
 		case "5":
+			showFingerprint()
+		case "6":
+			listKeys(repo)
+		case "7":
+			searchKeys(reader, repo)
+		case "8":
+			revokeKeys(reader, repo)
+		case "9":
+			importKeys(reader, repo)
+		case "10":
+			exportRevocationList(reader, repo)
+		case "0":
 			fmt.Println("Goodbye!")
 			os.Exit(0)
 		default:
@@ -49,7 +70,7 @@ func main() {
 	}
 }
 
-func generateSingleKey(reader *bufio.Reader) {
+func generateSingleKey(reader *bufio.Reader, repo *database.Repository) {
 	fmt.Println("\n--- Generate License Key ---")
 	fmt.Println("License types:")
 	fmt.Println("  1. Personal  (10 symbols, basic features)")
@@ -75,6 +96,16 @@ func generateSingleKey(reader *bufio.Reader) {
 
 	key := license.GenerateLicenseKey(licenseType)
 
+	expiresInDays := 0
+	if repo != nil {
+		expiresInDays = readExpiryDays(reader)
+		fmt.Print("Customer email (optional): ")
+		email, _ := reader.ReadString('\n')
+		if err := recordKey(repo, key, licenseType, expiresInDays, strings.TrimSpace(email), ""); err != nil {
+			fmt.Printf("⚠️  Failed to record key: %v\n", err)
+		}
+	}
+
 	fmt.Println("\n========================================")
 	fmt.Printf("  License Type: %s\n", licenseType)
 	fmt.Printf("  License Key:  %s\n", key)
@@ -93,7 +124,7 @@ func generateSingleKey(reader *bufio.Reader) {
 	}
 }
 
-func generateBatchKeys(reader *bufio.Reader) {
+func generateBatchKeys(reader *bufio.Reader, repo *database.Repository) {
 	fmt.Println("\n--- Generate Batch License Keys ---")
 	fmt.Println("License types:")
 	fmt.Println("  1. Personal")
@@ -125,6 +156,11 @@ func generateBatchKeys(reader *bufio.Reader) {
 		return
 	}
 
+	expiresInDays := 0
+	if repo != nil {
+		expiresInDays = readExpiryDays(reader)
+	}
+
 	fmt.Printf("\nGenerating %d %s license keys...\n", count, licenseType)
 	fmt.Println("========================================")
 
@@ -132,6 +168,11 @@ func generateBatchKeys(reader *bufio.Reader) {
 	for i := 0; i < count; i++ {
 		keys[i] = license.GenerateLicenseKey(licenseType)
 		fmt.Printf("  %d. %s\n", i+1, keys[i])
+		if repo != nil {
+			if err := recordKey(repo, keys[i], licenseType, expiresInDays, "", "batch"); err != nil {
+				fmt.Printf("     ⚠️  Failed to record key: %v\n", err)
+			}
+		}
 		time.Sleep(10 * time.Millisecond) // Small delay for better randomness
 	}
 	fmt.Println("========================================")
@@ -149,7 +190,7 @@ func generateBatchKeys(reader *bufio.Reader) {
 	fmt.Printf("\nSaved to: %s\n", filename)
 }
 
-func validateKey(reader *bufio.Reader) {
+func validateKey(reader *bufio.Reader, repo *database.Repository) {
 	fmt.Println("\n--- Validate License Key ---")
 	fmt.Print("Enter license key: ")
 
@@ -157,6 +198,9 @@ func validateKey(reader *bufio.Reader) {
 	key = strings.TrimSpace(key)
 
 	validator := license.NewValidator("")
+	if repo != nil {
+		validator.AddRevocationList(repo.LicenseRevocationList())
+	}
 	info, err := validator.ValidateLicense(key)
 
 	fmt.Println("\n========================================")
@@ -168,6 +212,14 @@ func validateKey(reader *bufio.Reader) {
 		fmt.Printf("  Type:    %s\n", info.Type)
 		fmt.Printf("  Symbols: %d max\n", info.MaxSymbols)
 		fmt.Printf("  Message: %s\n", info.Message)
+		fmt.Printf("  Fingerprint: %s\n", info.Fingerprint)
+		if repo != nil {
+			if issued, err := repo.GetLicenseByKey(context.Background(), info.Key); err == nil && issued != nil {
+				fmt.Printf("  Issued:  %s (%s)\n", issued.CreatedAt.Format("2006-01-02"), issued.Status())
+			} else if err == nil {
+				fmt.Printf("  Issued:  not recorded\n")
+			}
+		}
 		if info.IsValid {
 			fmt.Printf("  Features:\n")
 			for _, f := range info.Features {
@@ -178,6 +230,23 @@ func validateKey(reader *bufio.Reader) {
 	fmt.Println("========================================")
 }
 
+func showFingerprint() {
+	fingerprint, source := license.Fingerprint()
+	policy := license.FingerprintPolicyFromEnv()
+
+	fmt.Println("\n========================================")
+	fmt.Printf("  Fingerprint: %s\n", fingerprint)
+	fmt.Printf("  Source:      %s\n", source)
+	if policy.MaxFingerprints > 0 {
+		fmt.Printf("  Limit:       %d installations per white-label key\n", policy.MaxFingerprints)
+	} else {
+		fmt.Printf("  Limit:       unlimited\n")
+	}
+	fmt.Printf("  Grace:       %d days before an unused installation frees its slot\n", int(policy.Grace.Hours()/24))
+	fmt.Println("========================================")
+	fmt.Println("Set LICENSE_INSTALL_ID to keep the fingerprint stable across hardware changes.")
+}
+
 func showLicenseInfo() {
 	fmt.Println("\n========================================")
 	fmt.Println(" License Types Overview")
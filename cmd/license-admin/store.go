@@ -0,0 +1,312 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"binance-trading-bot/internal/database"
+	"binance-trading-bot/internal/license"
+)
+
+// connectStore opens the license database (DB_* env vars, same as the app and
+// the migrate tool). Returns nil if the database is unreachable so keys can
+// still be generated offline, just without an issuance record.
+func connectStore() *database.Repository {
+	db, err := database.NewDB(database.Config{
+		Host:     getEnv("DB_HOST", "localhost"),
+		Port:     getEnvInt("DB_PORT", 5432),
+		User:     getEnv("DB_USER", "trading_bot"),
+		Password: getEnv("DB_PASSWORD", "trading_bot_password"),
+		Database: getEnv("DB_NAME", "trading_bot"),
+		SSLMode:  getEnv("DB_SSLMODE", "disable"),
+		MaxConns: 2,
+		MinConns: 1,
+	})
+	if err != nil {
+		fmt.Printf("⚠️  License database unavailable (%v)\n", err)
+		fmt.Println("   Generated keys will not be recorded; list/search/revoke/import are disabled.")
+		return nil
+	}
+
+	repo := database.NewRepository(db)
+	if err := repo.CreateLicenseTable(context.Background()); err != nil {
+		fmt.Printf("⚠️  License table check failed: %v\n", err)
+	}
+	fmt.Println("✅ Connected to license database")
+	return repo
+}
+
+// requireStore prints a notice and returns false when there is no database
+func requireStore(repo *database.Repository) bool {
+	if repo == nil {
+		fmt.Println("License database not connected (set DB_HOST, DB_USER, DB_PASSWORD, DB_NAME)")
+		return false
+	}
+	return true
+}
+
+// readExpiryDays asks how long generated or imported keys are valid for
+func readExpiryDays(reader *bufio.Reader) int {
+	fmt.Print("Valid for how many days? (0 = no expiry): ")
+	input, _ := reader.ReadString('\n')
+	days, err := strconv.Atoi(strings.TrimSpace(input))
+	if err != nil || days < 0 {
+		return 0
+	}
+	return days
+}
+
+// recordKey stores an issued key with its type, limits and expiry
+func recordKey(repo *database.Repository, key string, licenseType license.LicenseType, expiresInDays int, email, notes string) error {
+	features, maxSymbols := license.FeaturesForType(licenseType)
+	dbLicense := &database.License{
+		Key:           key,
+		Type:          string(licenseType),
+		CustomerEmail: email,
+		MaxSymbols:    maxSymbols,
+		Features:      database.FeaturesToJSON(features),
+		IsActive:      true,
+		Notes:         notes,
+	}
+	if expiresInDays > 0 {
+		expires := time.Now().AddDate(0, 0, expiresInDays)
+		dbLicense.ExpiresAt = &expires
+	}
+	return repo.CreateLicense(context.Background(), dbLicense)
+}
+
+func printLicenses(licenses []database.License) {
+	if len(licenses) == 0 {
+		fmt.Println("  No licenses found")
+		return
+	}
+	fmt.Printf("  %-19s %-11s %-9s %-10s %-10s %s\n", "KEY", "TYPE", "STATUS", "ISSUED", "EXPIRES", "CUSTOMER")
+	for _, l := range licenses {
+		expires := "never"
+		if l.ExpiresAt != nil {
+			expires = l.ExpiresAt.Format("2006-01-02")
+		}
+		fmt.Printf("  %-19s %-11s %-9s %-10s %-10s %s\n",
+			l.Key, l.Type, l.Status(), l.CreatedAt.Format("2006-01-02"), expires, l.CustomerEmail)
+		if l.RevokedAt != nil && l.RevokeReason != "" {
+			fmt.Printf("  %-19s revoked %s: %s\n", "", l.RevokedAt.Format("2006-01-02"), l.RevokeReason)
+		}
+	}
+}
+
+func listKeys(repo *database.Repository) {
+	if !requireStore(repo) {
+		return
+	}
+	licenses, total, err := repo.ListLicenses(context.Background(), "", false, 50, 0)
+	if err != nil {
+		fmt.Printf("Failed to list licenses: %v\n", err)
+		return
+	}
+
+	fmt.Printf("\n--- Issued License Keys (%d of %d, newest first) ---\n", len(licenses), total)
+	printLicenses(licenses)
+}
+
+func searchKeys(reader *bufio.Reader, repo *database.Repository) {
+	if !requireStore(repo) {
+		return
+	}
+	fmt.Print("\nSearch by key, customer email or name: ")
+	term, _ := reader.ReadString('\n')
+	term = strings.TrimSpace(term)
+	if term == "" {
+		return
+	}
+
+	licenses, err := repo.SearchLicenses(context.Background(), term, 50)
+	if err != nil {
+		fmt.Printf("Search failed: %v\n", err)
+		return
+	}
+	fmt.Printf("\n--- %d match(es) for %q ---\n", len(licenses), term)
+	printLicenses(licenses)
+}
+
+func revokeKeys(reader *bufio.Reader, repo *database.Repository) {
+	if !requireStore(repo) {
+		return
+	}
+	fmt.Println("\n--- Revoke License Keys ---")
+	fmt.Print("Keys (comma or space separated) or @path to a key file: ")
+	input, _ := reader.ReadString('\n')
+	keys, err := parseKeyInput(strings.TrimSpace(input))
+	if err != nil {
+		fmt.Printf("Failed to read keys: %v\n", err)
+		return
+	}
+	if len(keys) == 0 {
+		fmt.Println("No keys given")
+		return
+	}
+
+	fmt.Print("Reason: ")
+	reason, _ := reader.ReadString('\n')
+	reason = strings.TrimSpace(reason)
+
+	fmt.Printf("Revoke %d key(s)? This cannot be undone (y/n): ", len(keys))
+	confirm, _ := reader.ReadString('\n')
+	if strings.TrimSpace(strings.ToLower(confirm)) != "y" {
+		fmt.Println("Cancelled")
+		return
+	}
+
+	revoked := 0
+	for _, key := range keys {
+		ok, err := repo.RevokeLicenseByKey(context.Background(), key, reason)
+		switch {
+		case err != nil:
+			fmt.Printf("  %s: %v\n", key, err)
+		case !ok:
+			fmt.Printf("  %s: not issued or already revoked\n", key)
+		default:
+			fmt.Printf("  %s: revoked\n", key)
+			revoked++
+		}
+	}
+	fmt.Printf("\nRevoked %d of %d key(s). Export the revocation list (option 10) to update offline installations.\n", revoked, len(keys))
+}
+
+// importKeys records keys generated elsewhere (e.g. batch files from before
+// the tool had a database) so they can be listed and revoked
+func importKeys(reader *bufio.Reader, repo *database.Repository) {
+	if !requireStore(repo) {
+		return
+	}
+	fmt.Println("\n--- Import License Keys ---")
+	fmt.Print("Path to key file: ")
+	path, _ := reader.ReadString('\n')
+	keys, err := readKeyFile(strings.TrimSpace(path))
+	if err != nil {
+		fmt.Printf("Failed to read keys: %v\n", err)
+		return
+	}
+	expiresInDays := readExpiryDays(reader)
+
+	ctx := context.Background()
+	validator := license.NewValidator("")
+	imported, skipped := 0, 0
+	for _, key := range keys {
+		info, err := validator.ValidateLicense(key)
+		if err != nil || !info.IsValid {
+			fmt.Printf("  %s: invalid key, skipped\n", key)
+			skipped++
+			continue
+		}
+		existing, err := repo.GetLicenseByKey(ctx, key)
+		if err != nil {
+			fmt.Printf("  %s: %v\n", key, err)
+			skipped++
+			continue
+		}
+		if existing != nil {
+			fmt.Printf("  %s: already recorded (%s)\n", key, existing.Status())
+			skipped++
+			continue
+		}
+		if err := recordKey(repo, key, info.Type, expiresInDays, "", "imported from "+path); err != nil {
+			fmt.Printf("  %s: %v\n", key, err)
+			skipped++
+			continue
+		}
+		imported++
+	}
+	fmt.Printf("\nImported %d key(s), skipped %d\n", imported, skipped)
+}
+
+// exportRevocationList writes revoked keys in the format read by
+// LICENSE_REVOCATION_FILE / LICENSE_REVOCATION_URL
+func exportRevocationList(reader *bufio.Reader, repo *database.Repository) {
+	if !requireStore(repo) {
+		return
+	}
+	keys, err := repo.GetRevokedLicenseKeys(context.Background())
+	if err != nil {
+		fmt.Printf("Failed to load revoked keys: %v\n", err)
+		return
+	}
+
+	fmt.Print("\nOutput file [revoked_licenses.txt]: ")
+	filename, _ := reader.ReadString('\n')
+	filename = strings.TrimSpace(filename)
+	if filename == "" {
+		filename = "revoked_licenses.txt"
+	}
+
+	var content strings.Builder
+	content.WriteString("# Revoked license keys\n")
+	content.WriteString(fmt.Sprintf("# Generated: %s\n", time.Now().Format("2006-01-02 15:04:05")))
+	content.WriteString(fmt.Sprintf("# Count: %d\n\n", len(keys)))
+	for _, key := range keys {
+		content.WriteString(key + "\n")
+	}
+	if err := os.WriteFile(filename, []byte(content.String()), 0644); err != nil {
+		fmt.Printf("Failed to write %s: %v\n", filename, err)
+		return
+	}
+	fmt.Printf("Wrote %d revoked key(s) to %s\n", len(keys), filename)
+}
+
+// parseKeyInput reads keys typed inline or, with an @ prefix, from a file
+func parseKeyInput(input string) ([]string, error) {
+	if strings.HasPrefix(input, "@") {
+		return readKeyFile(strings.TrimPrefix(input, "@"))
+	}
+	var keys []string
+	for _, field := range strings.FieldsFunc(input, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' }) {
+		keys = append(keys, strings.ToUpper(field))
+	}
+	return keys, nil
+}
+
+// readKeyFile reads one key per line, taking the last field so the numbered
+// batch files written by this tool ("1. PRO-XXXX-XXXX-XXXX") import as is.
+// Blank lines and # comments are ignored.
+func readKeyFile(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var keys []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		keys = append(keys, strings.ToUpper(fields[len(fields)-1]))
+	}
+	return keys, scanner.Err()
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+func getEnvInt(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		if intValue, err := strconv.Atoi(value); err == nil {
+			return intValue
+		}
+	}
+	return defaultValue
+}
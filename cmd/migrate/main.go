@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"binance-trading-bot/internal/database"
+)
+
+func main() {
+	futures := flag.Bool("futures", getEnvBool("FUTURES_ENABLED", true), "include futures migrations")
+	multiTenant := flag.Bool("multi-tenant", getEnvBool("AUTH_ENABLED", false), "include multi-tenant migrations")
+	flag.Usage = func() {
+		fmt.Println("Usage: migrate [flags] <status|up|down N>")
+		fmt.Println("")
+		fmt.Println("Connects using DB_HOST, DB_PORT, DB_USER, DB_PASSWORD, DB_NAME, DB_SSLMODE.")
+		fmt.Println("")
+		fmt.Println("Commands:")
+		fmt.Println("  status   list registered migrations and whether they are applied")
+		fmt.Println("  up       apply all pending migrations")
+		fmt.Println("  down N   roll back the N most recent migrations")
+		fmt.Println("")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	db, err := database.NewDB(database.Config{
+		Host:     getEnv("DB_HOST", "localhost"),
+		Port:     getEnvInt("DB_PORT", 5432),
+		User:     getEnv("DB_USER", "trading_bot"),
+		Password: getEnv("DB_PASSWORD", "trading_bot_password"),
+		Database: getEnv("DB_NAME", "trading_bot"),
+		SSLMode:  getEnv("DB_SSLMODE", "disable"),
+		MaxConns: 4,
+		MinConns: 1,
+		// Migrations can legitimately run longer than the app's query timeout
+		StatementTimeout: -1,
+	})
+	if err != nil {
+		fmt.Printf("❌ Failed to connect to database: %v\n", err)
+		os.Exit(1)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+
+	switch flag.Arg(0) {
+	case "status":
+		printStatus(ctx, db)
+
+	case "up":
+		var groups []string
+		if *futures {
+			groups = append(groups, database.MigrationGroupFutures)
+		}
+		if *multiTenant {
+			groups = append(groups, database.MigrationGroupMultiTenant)
+		}
+		applied, err := db.MigrateUp(ctx, groups...)
+		if err != nil {
+			fmt.Printf("❌ Migration failed: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("✅ Ran %d migration steps (core %s)\n", applied, strings.Join(groups, " "))
+
+	case "down":
+		if flag.NArg() < 2 {
+			flag.Usage()
+			os.Exit(1)
+		}
+		n, err := strconv.Atoi(flag.Arg(1))
+		if err != nil || n <= 0 {
+			fmt.Printf("❌ Invalid count %q\n", flag.Arg(1))
+			os.Exit(1)
+		}
+		rolledBack, err := db.MigrateDown(ctx, n)
+		for _, v := range rolledBack {
+			fmt.Printf("↩️  Rolled back %03d\n", v)
+		}
+		if err != nil {
+			fmt.Printf("❌ Rollback stopped: %v\n", err)
+			os.Exit(1)
+		}
+
+	default:
+		flag.Usage()
+		os.Exit(1)
+	}
+}
+
+func printStatus(ctx context.Context, db *database.DB) {
+	states, err := db.MigrationStatus(ctx)
+	if err != nil {
+		fmt.Printf("❌ Failed to read migration status: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("%-8s %-32s %-13s %-10s %s\n", "VERSION", "NAME", "GROUP", "KIND", "STATUS")
+	for _, s := range states {
+		kind := "sql"
+		if s.Repeatable {
+			kind = "repeatable"
+		}
+		status := "pending"
+		if s.Applied {
+			status = "applied " + s.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+		if s.Drifted {
+			status += " ⚠️ DRIFT"
+		}
+		fmt.Printf("%-8s %-32s %-13s %-10s %s\n", fmt.Sprintf("%03d", s.Version), s.Name, s.Group, kind, status)
+	}
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+func getEnvInt(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		if intValue, err := strconv.Atoi(value); err == nil {
+			return intValue
+		}
+	}
+	return defaultValue
+}
+
+func getEnvBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+	}
+	return defaultValue
+}
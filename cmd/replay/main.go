@@ -0,0 +1,313 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"binance-trading-bot/internal/autopilot"
+	"binance-trading-bot/internal/binance"
+	"binance-trading-bot/internal/database"
+	"binance-trading-bot/internal/logging"
+)
+
+// klinePageSize is the most candles Binance returns per klines request
+const klinePageSize = 1500
+
+// replayBalance is the simulated account balance during a replay
+const replayBalance = 10000
+
+func main() {
+	symbols := flag.String("symbols", "", "comma-separated symbols (run: default every recorded symbol)")
+	timeframes := flag.String("timeframes", "1m,5m,15m,1h,4h", "comma-separated kline intervals to record")
+	from := flag.String("from", "", "window start, RFC3339 or YYYY-MM-DD (UTC)")
+	to := flag.String("to", "", "window end, RFC3339 or YYYY-MM-DD (UTC), default now")
+	warmup := flag.Duration("warmup", 72*time.Hour, "run: history loaded before -from so indicators have candles")
+	step := flag.Duration("step", time.Minute, "run: replay clock advance between decision rounds")
+	mode := flag.String("mode", "", "run: fixed trading mode (scalp, swing, position, ultra_fast); default auto-select")
+	speed := flag.Float64("speed", 0, "run: replay speed as a multiple of real time; 0 runs as fast as possible")
+	out := flag.String("out", "", "run: write decisions as JSON lines to this file instead of stdout")
+	compare := flag.Bool("compare", false, "run: also list the AI decisions recorded live in the window")
+	testnet := flag.Bool("testnet", false, "record: fetch from the futures testnet")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: replay [flags] <record|run>")
+		fmt.Fprintln(os.Stderr, "")
+		fmt.Fprintln(os.Stderr, "Connects using DB_HOST, DB_PORT, DB_USER, DB_PASSWORD, DB_NAME, DB_SSLMODE.")
+		fmt.Fprintln(os.Stderr, "")
+		fmt.Fprintln(os.Stderr, "Commands:")
+		fmt.Fprintln(os.Stderr, "  record   fetch futures klines for -symbols over the window into market_kline_snapshots")
+		fmt.Fprintln(os.Stderr, "  run      replay recorded klines through the Ginie analyzer and print its decisions")
+		fmt.Fprintln(os.Stderr, "")
+		fmt.Fprintln(os.Stderr, "Record at least -warmup before the replay window so the analyzer has history.")
+		fmt.Fprintln(os.Stderr, "")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	start, err := parseTime(*from, time.Time{})
+	if err != nil || start.IsZero() {
+		fmt.Fprintf(os.Stderr, "❌ Invalid or missing -from %q\n", *from)
+		os.Exit(1)
+	}
+	end, err := parseTime(*to, time.Now().UTC())
+	if err != nil || !end.After(start) {
+		fmt.Fprintf(os.Stderr, "❌ Invalid -to %q: must be after -from\n", *to)
+		os.Exit(1)
+	}
+
+	db, err := database.NewDB(database.Config{
+		Host:     getEnv("DB_HOST", "localhost"),
+		Port:     getEnvInt("DB_PORT", 5432),
+		User:     getEnv("DB_USER", "trading_bot"),
+		Password: getEnv("DB_PASSWORD", "trading_bot_password"),
+		Database: getEnv("DB_NAME", "trading_bot"),
+		SSLMode:  getEnv("DB_SSLMODE", "disable"),
+		MaxConns: 4,
+		MinConns: 1,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Failed to connect to database: %v\n", err)
+		os.Exit(1)
+	}
+	defer db.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	switch flag.Arg(0) {
+	case "record":
+		if *symbols == "" {
+			fmt.Fprintln(os.Stderr, "❌ record needs -symbols")
+			os.Exit(1)
+		}
+		client := binance.NewFuturesClient("", "", *testnet) // Klines are public
+		for _, symbol := range splitList(*symbols, true) {
+			for _, timeframe := range splitList(*timeframes, false) {
+				n, err := record(ctx, db, client, symbol, timeframe, start, end)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "❌ %s %s: %v\n", symbol, timeframe, err)
+					os.Exit(1)
+				}
+				fmt.Fprintf(os.Stderr, "✅ %s %s: recorded %d candles\n", symbol, timeframe, n)
+			}
+		}
+
+	case "run":
+		if err := run(ctx, db, splitList(*symbols, true), start, end, *warmup, *step, autopilot.GinieTradingMode(*mode), *speed, *out, *compare); err != nil {
+			fmt.Fprintf(os.Stderr, "❌ Replay failed: %v\n", err)
+			os.Exit(1)
+		}
+
+	default:
+		flag.Usage()
+		os.Exit(1)
+	}
+}
+
+// record pages through the klines of one symbol and timeframe in [start, end]
+// and upserts them
+func record(ctx context.Context, db *database.DB, client *binance.FuturesClientImpl, symbol, timeframe string, start, end time.Time) (int, error) {
+	total := 0
+	next := start.UnixMilli()
+	for next <= end.UnixMilli() {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+		klines, err := client.GetFuturesKlinesRange(symbol, timeframe, next, end.UnixMilli(), klinePageSize)
+		if err != nil {
+			return total, err
+		}
+		if len(klines) == 0 {
+			break
+		}
+
+		rows := make([]database.MarketKline, len(klines))
+		for i, k := range klines {
+			rows[i] = database.MarketKline{
+				Symbol:              symbol,
+				Timeframe:           timeframe,
+				OpenTime:            k.OpenTime,
+				Open:                k.Open,
+				High:                k.High,
+				Low:                 k.Low,
+				Close:               k.Close,
+				Volume:              k.Volume,
+				CloseTime:           k.CloseTime,
+				QuoteVolume:         k.QuoteAssetVolume,
+				Trades:              k.NumberOfTrades,
+				TakerBuyVolume:      k.TakerBuyBaseAssetVolume,
+				TakerBuyQuoteVolume: k.TakerBuyQuoteAssetVolume,
+			}
+		}
+		if err := db.SaveMarketKlines(ctx, rows); err != nil {
+			return total, err
+		}
+		total += len(klines)
+		next = klines[len(klines)-1].OpenTime + 1
+
+		time.Sleep(250 * time.Millisecond) // Stay well under the request weight limit
+	}
+	return total, nil
+}
+
+// run loads the recorded klines into a replay client and replays the window
+func run(ctx context.Context, db *database.DB, symbols []string, start, end time.Time, warmup, step time.Duration, mode autopilot.GinieTradingMode, speed float64, out string, compare bool) error {
+	recorded, err := db.GetMarketKlineTimeframes(ctx, start.Add(-warmup), end)
+	if err != nil {
+		return err
+	}
+	if len(symbols) == 0 {
+		for symbol := range recorded {
+			symbols = append(symbols, symbol)
+		}
+		sort.Strings(symbols) // Same decision order on every run
+	}
+
+	client := binance.NewReplayFuturesClient(replayBalance, start)
+	for _, symbol := range symbols {
+		if len(recorded[symbol]) == 0 {
+			return fmt.Errorf("no klines recorded for %s in the window", symbol)
+		}
+		for _, timeframe := range recorded[symbol] {
+			rows, err := db.GetMarketKlines(ctx, symbol, timeframe, start.Add(-warmup), end)
+			if err != nil {
+				return err
+			}
+			client.LoadKlines(symbol, timeframe, toKlines(rows))
+			fmt.Fprintf(os.Stderr, "📼 %s %s: %d candles\n", symbol, timeframe, len(rows))
+		}
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	if out != "" {
+		f, err := os.Create(out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = bufio.NewWriter(f)
+	}
+	defer w.Flush()
+	enc := json.NewEncoder(w)
+
+	counts := make(map[string]int)
+	logger := logging.New(&logging.Config{Level: "warn", Output: "stderr", Component: "replay"})
+	n, err := autopilot.RunGinieReplay(ctx, client, logger, autopilot.GinieReplayConfig{
+		Symbols: symbols,
+		Start:   start,
+		End:     end,
+		Step:    step,
+		Mode:    mode,
+		Speed:   speed,
+	}, func(d autopilot.GinieReplayDecision) {
+		if d.Error != "" {
+			counts["ERROR"]++
+		} else {
+			counts[string(d.Recommendation)]++
+		}
+		enc.Encode(d)
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "🏁 %d decisions: %d EXECUTE, %d WAIT, %d SKIP, %d errors\n",
+		n, counts[string(autopilot.RecommendationExecute)], counts[string(autopilot.RecommendationWait)],
+		counts[string(autopilot.RecommendationSkip)], counts["ERROR"])
+
+	if compare {
+		return printRecordedDecisions(ctx, db, symbols, start, end)
+	}
+	return nil
+}
+
+// printRecordedDecisions lists the AI decisions made live in the window
+func printRecordedDecisions(ctx context.Context, db *database.DB, symbols []string, start, end time.Time) error {
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "Recorded live decisions:")
+	for _, symbol := range symbols {
+		decisions, err := db.GetAIDecisionsBetween(ctx, symbol, start, end)
+		if err != nil {
+			return err
+		}
+		for _, d := range decisions {
+			fmt.Fprintf(os.Stderr, "  %s %-12s %-5s conf=%.2f price=%.8g executed=%v\n",
+				d.CreatedAt.UTC().Format(time.RFC3339), d.Symbol, d.Action, d.Confidence, d.CurrentPrice, d.Executed)
+		}
+	}
+	return nil
+}
+
+func toKlines(rows []database.MarketKline) []binance.Kline {
+	klines := make([]binance.Kline, len(rows))
+	for i, r := range rows {
+		klines[i] = binance.Kline{
+			OpenTime:                 r.OpenTime,
+			Open:                     r.Open,
+			High:                     r.High,
+			Low:                      r.Low,
+			Close:                    r.Close,
+			Volume:                   r.Volume,
+			CloseTime:                r.CloseTime,
+			QuoteAssetVolume:         r.QuoteVolume,
+			NumberOfTrades:           r.Trades,
+			TakerBuyBaseAssetVolume:  r.TakerBuyVolume,
+			TakerBuyQuoteAssetVolume: r.TakerBuyQuoteVolume,
+		}
+	}
+	return klines
+}
+
+func parseTime(value string, defaultValue time.Time) (time.Time, error) {
+	if value == "" {
+		return defaultValue, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.UTC(), nil
+	}
+	return time.Parse("2006-01-02", value)
+}
+
+// splitList splits a comma-separated flag. Intervals keep their case: "1M" is a month, "1m" a minute.
+func splitList(value string, upper bool) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+		if upper {
+			item = strings.ToUpper(item)
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+func getEnvInt(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		if intValue, err := strconv.Atoi(value); err == nil {
+			return intValue
+		}
+	}
+	return defaultValue
+}
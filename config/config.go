@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -29,6 +30,13 @@ type Config struct {
 	VaultConfig   VaultConfig   `json:"vault"`
 	BillingConfig BillingConfig `json:"billing"`
 	RedisConfig   RedisConfig   `json:"redis"`
+	WebhookConfig WebhookConfig `json:"webhook"`
+	GRPCConfig    GRPCConfig    `json:"grpc"`
+
+	// Per-mode TP quantity allocation (percent of the position per TP level),
+	// filled from the autopilot mode settings by the caller so Validate can
+	// check it. Not part of config.json.
+	ModeTPAllocations map[string][]float64 `json:"-"`
 }
 
 // FuturesConfig holds Binance Futures trading configuration
@@ -39,6 +47,22 @@ type FuturesConfig struct {
 	DefaultMarginType string `json:"default_margin_type"` // CROSSED or ISOLATED
 	PositionMode      string `json:"position_mode"`       // ONE_WAY or HEDGE
 	MaxLeverage       int    `json:"max_leverage"`
+	ShutdownPolicy    string `json:"shutdown_policy"` // leave, cancel_algos, or flatten
+	// Countdown cancel-all timeout refreshed by the position monitor (0 = disabled)
+	DeadManSwitchSeconds int `json:"dead_man_switch_seconds"`
+	// Cached (WebSocket) prices older than this fall back to a REST fetch
+	PriceMaxAgeSeconds int `json:"price_max_age_seconds"`
+	// Open algo (SL/TP) orders allowed per symbol before new ones are refused (0 = no cap)
+	MaxAlgoOrdersPerSymbol int `json:"max_algo_orders_per_symbol"`
+	// Extra accounts traded alongside the admin's primary account, each by its own autopilot
+	Accounts []FuturesAccountConfig `json:"accounts"`
+}
+
+// FuturesAccountConfig names an extra futures account. Its API key is the
+// admin's stored Binance key with the same label.
+type FuturesAccountConfig struct {
+	Name  string `json:"name"`
+	Route string `json:"route"` // all, long, or short
 }
 
 type LoggingConfig struct {
@@ -54,6 +78,13 @@ type BinanceConfig struct {
 	BaseURL   string `json:"base_url"`
 	TestNet   bool   `json:"testnet"`
 	MockMode  bool   `json:"mock_mode"` // Use simulated data when Binance API is unavailable
+	// Per-user API keys can be stored (ENCRYPTION_KEY is set); not part of config.json
+	UserKeyStore bool `json:"-"`
+
+	// Retry policy for transient API failures (network, 5xx, 429)
+	RetryMaxRetries  int `json:"retry_max_retries"`   // Retries after the first attempt
+	RetryBaseDelayMs int `json:"retry_base_delay_ms"` // First backoff, doubled per retry
+	RetryMaxDelayMs  int `json:"retry_max_delay_ms"`  // Backoff cap (jitter is added on top)
 }
 
 type ScreenerConfig struct {
@@ -65,12 +96,23 @@ type ScreenerConfig struct {
 	QuoteCurrency    string   `json:"quote_currency"` // "USDT", "BTC", etc.
 	MaxSymbols       int      `json:"max_symbols"`    // Max symbols to screen
 	ScreeningInterval int     `json:"screening_interval"` // Seconds between screens
+
+	// Load bounds per screening cycle (0 = default)
+	WorkerCount         int `json:"worker_count"`            // Concurrent symbol analyses (default 5, max 20)
+	MaxAPICallsPerCycle int `json:"max_api_calls_per_cycle"` // API call budget, ticker fetch included (default 100)
+	MaxSymbolsPerCycle  int `json:"max_symbols_per_cycle"`   // Max filtered symbols analyzed, most liquid first (default 100)
+
+	CacheTTL int `json:"cache_ttl"` // Seconds a symbol's analysis is reused while its price is unchanged (0 = off)
 }
 
 type TradingConfig struct {
 	MaxOpenPositions int     `json:"max_open_positions"`
 	MaxRiskPerTrade  float64 `json:"max_risk_per_trade"` // As percentage
 	DryRun           bool    `json:"dry_run"`            // Test mode without real orders
+	// Operator kill switch: when set (or when ForceDryRunFile exists) every
+	// client and autopilot is pinned to paper mode and live switches are refused
+	ForceDryRun     bool   `json:"force_dry_run"`
+	ForceDryRunFile string `json:"force_dry_run_file"`
 }
 
 type ScannerConfig struct {
@@ -120,6 +162,12 @@ type AIConfig struct {
 	LLMModel         string `json:"llm_model"`         // e.g., "claude-3-opus", "gpt-4", "deepseek-chat"
 	MLEnabled        bool   `json:"ml_enabled"`        // Enable ML predictions
 	SentimentEnabled bool   `json:"sentiment_enabled"` // Enable sentiment analysis
+
+	// LLM request concurrency: in-flight cap, queue length and how long a queued
+	// request waits before falling back to rule-based analysis (0 = defaults)
+	LLMMaxConcurrent  int `json:"llm_max_concurrent"`
+	LLMMaxQueued      int `json:"llm_max_queued"`
+	LLMQueueTimeoutMs int `json:"llm_queue_timeout_ms"`
 }
 
 // AutopilotConfig holds autopilot trading configuration
@@ -236,6 +284,10 @@ type CircuitBreakerConfig struct {
 	MaxTradesPerMinute   int     `json:"max_trades_per_minute"`   // Rate limit
 	MaxDailyLoss         float64 `json:"max_daily_loss"`          // Max daily loss %
 	MaxDailyTrades       int     `json:"max_daily_trades"`        // Max trades per day
+
+	// Escalating trip alerts
+	ReminderIntervalMinutes int `json:"reminder_interval_minutes"` // Remind while tripped (0 = no reminders)
+	AlertDebounceMinutes    int `json:"alert_debounce_minutes"`    // Reset must hold this long before the resolved alert
 }
 
 // ServerConfig holds HTTP server configuration
@@ -278,6 +330,24 @@ type VaultConfig struct {
 	CACert     string `json:"ca_cert"`
 }
 
+// WebhookConfig holds inbound trade-alert webhook configuration
+type WebhookConfig struct {
+	TradingViewEnabled        bool     `json:"tradingview_enabled"`
+	TradingViewSecret         string   `json:"tradingview_secret"`          // Shared secret: HMAC key, header/query token or payload "secret"
+	TradingViewAllowedSymbols []string `json:"tradingview_allowed_symbols"` // Empty = any symbol
+}
+
+// GRPCConfig holds the opt-in gRPC control API, served on its own port
+type GRPCConfig struct {
+	Enabled   bool   `json:"enabled"`
+	Host      string `json:"host"`
+	Port      int    `json:"port"`
+	AuthToken string `json:"auth_token"` // Bearer token every call must carry
+	// Default and minimum interval of StreamPositions snapshots
+	StreamIntervalMs    int `json:"stream_interval_ms"`
+	MinStreamIntervalMs int `json:"min_stream_interval_ms"`
+}
+
 // BillingConfig holds billing and subscription configuration
 type BillingConfig struct {
 	Enabled               bool    `json:"enabled"`
@@ -331,9 +401,22 @@ func applyEnvOverrides(cfg *Config) {
 	}
 	cfg.BinanceConfig.TestNet = getEnvOrDefault("BINANCE_TESTNET", "false") == "true"
 	cfg.BinanceConfig.MockMode = getEnvOrDefault("MOCK_MODE", "false") == "true"
+	cfg.BinanceConfig.UserKeyStore = os.Getenv("ENCRYPTION_KEY") != ""
+	cfg.BinanceConfig.RetryMaxRetries = getEnvIntOrDefault("BINANCE_RETRY_MAX_RETRIES", 3)
+	cfg.BinanceConfig.RetryBaseDelayMs = getEnvIntOrDefault("BINANCE_RETRY_BASE_DELAY_MS", 500)
+	cfg.BinanceConfig.RetryMaxDelayMs = getEnvIntOrDefault("BINANCE_RETRY_MAX_DELAY_MS", 5000)
 
 	// Trading config
 	cfg.TradingConfig.DryRun = getEnvOrDefault("TRADING_DRY_RUN", "false") == "true"
+	// The env var can only engage the kill switch, never release one set in config.json
+	cfg.TradingConfig.ForceDryRun = cfg.TradingConfig.ForceDryRun || getEnvOrDefault("FORCE_DRY_RUN", "false") == "true"
+	cfg.TradingConfig.ForceDryRunFile = getEnvOrDefault("FORCE_DRY_RUN_FILE", cfg.TradingConfig.ForceDryRunFile)
+
+	// Screener config
+	cfg.ScreenerConfig.WorkerCount = getEnvIntOrDefault("SCREENER_WORKER_COUNT", cfg.ScreenerConfig.WorkerCount)
+	cfg.ScreenerConfig.MaxAPICallsPerCycle = getEnvIntOrDefault("SCREENER_MAX_API_CALLS_PER_CYCLE", cfg.ScreenerConfig.MaxAPICallsPerCycle)
+	cfg.ScreenerConfig.MaxSymbolsPerCycle = getEnvIntOrDefault("SCREENER_MAX_SYMBOLS_PER_CYCLE", cfg.ScreenerConfig.MaxSymbolsPerCycle)
+	cfg.ScreenerConfig.CacheTTL = getEnvIntOrDefault("SCREENER_CACHE_TTL", cfg.ScreenerConfig.CacheTTL)
 
 	// Scanner config
 	cfg.ScannerConfig.Enabled = getEnvOrDefault("SCANNER_ENABLED", "true") == "true"
@@ -361,6 +444,9 @@ func applyEnvOverrides(cfg *Config) {
 	cfg.AIConfig.LLMModel = getEnvOrDefault("AI_LLM_MODEL", "claude-3-haiku-20240307")
 	cfg.AIConfig.MLEnabled = getEnvOrDefault("AI_ML_ENABLED", "true") == "true"
 	cfg.AIConfig.SentimentEnabled = getEnvOrDefault("AI_SENTIMENT_ENABLED", "true") == "true"
+	cfg.AIConfig.LLMMaxConcurrent = getEnvIntOrDefault("AI_LLM_MAX_CONCURRENT", 4)
+	cfg.AIConfig.LLMMaxQueued = getEnvIntOrDefault("AI_LLM_MAX_QUEUED", 32)
+	cfg.AIConfig.LLMQueueTimeoutMs = getEnvIntOrDefault("AI_LLM_QUEUE_TIMEOUT_MS", 10000)
 
 	// Server config
 	cfg.ServerConfig.Port = getEnvIntOrDefault("WEB_PORT", 8080)
@@ -402,6 +488,13 @@ func applyEnvOverrides(cfg *Config) {
 	cfg.FuturesConfig.DefaultMarginType = getEnvOrDefault("FUTURES_DEFAULT_MARGIN_TYPE", "CROSSED")
 	cfg.FuturesConfig.PositionMode = getEnvOrDefault("FUTURES_POSITION_MODE", "ONE_WAY")
 	cfg.FuturesConfig.MaxLeverage = getEnvIntOrDefault("FUTURES_MAX_LEVERAGE", 125)
+	cfg.FuturesConfig.ShutdownPolicy = getEnvOrDefault("FUTURES_SHUTDOWN_POLICY", "leave")
+	cfg.FuturesConfig.DeadManSwitchSeconds = getEnvIntOrDefault("FUTURES_DEAD_MAN_SWITCH_SECONDS", 0)
+	cfg.FuturesConfig.PriceMaxAgeSeconds = getEnvIntOrDefault("FUTURES_PRICE_MAX_AGE_SECONDS", 30)
+	cfg.FuturesConfig.MaxAlgoOrdersPerSymbol = getEnvIntOrDefault("FUTURES_MAX_ALGO_ORDERS_PER_SYMBOL", 10)
+	if v := os.Getenv("FUTURES_ACCOUNTS"); v != "" {
+		cfg.FuturesConfig.Accounts = parseFuturesAccounts(v)
+	}
 
 	// Futures autopilot config
 	cfg.FuturesAutopilotConfig.Enabled = getEnvOrDefault("FUTURES_AUTOPILOT_ENABLED", "true") == "true"
@@ -418,6 +511,8 @@ func applyEnvOverrides(cfg *Config) {
 	cfg.CircuitBreakerConfig.MaxLossPerHour = getEnvFloatOrDefault("CIRCUIT_MAX_LOSS_PER_HOUR", 3.0)
 	cfg.CircuitBreakerConfig.MaxConsecutiveLosses = getEnvIntOrDefault("CIRCUIT_MAX_CONSECUTIVE_LOSSES", 5)
 	cfg.CircuitBreakerConfig.CooldownMinutes = getEnvIntOrDefault("CIRCUIT_COOLDOWN_MINUTES", 30)
+	cfg.CircuitBreakerConfig.ReminderIntervalMinutes = getEnvIntOrDefault("CIRCUIT_REMINDER_INTERVAL_MINUTES", 15)
+	cfg.CircuitBreakerConfig.AlertDebounceMinutes = getEnvIntOrDefault("CIRCUIT_ALERT_DEBOUNCE_MINUTES", 2)
 
 	// Billing config
 	cfg.BillingConfig.Enabled = getEnvOrDefault("BILLING_ENABLED", "false") == "true"
@@ -425,6 +520,26 @@ func applyEnvOverrides(cfg *Config) {
 	cfg.BillingConfig.StripePublishableKey = getEnvOrDefault("STRIPE_PUBLISHABLE_KEY", cfg.BillingConfig.StripePublishableKey)
 	cfg.BillingConfig.StripeWebhookSecret = getEnvOrDefault("STRIPE_WEBHOOK_SECRET", cfg.BillingConfig.StripeWebhookSecret)
 
+	// Webhook config
+	cfg.WebhookConfig.TradingViewEnabled = getEnvOrDefault("TRADINGVIEW_WEBHOOK_ENABLED", "false") == "true"
+	cfg.WebhookConfig.TradingViewSecret = getEnvOrDefault("TRADINGVIEW_WEBHOOK_SECRET", cfg.WebhookConfig.TradingViewSecret)
+	if symbols := getEnvOrDefault("TRADINGVIEW_WEBHOOK_SYMBOLS", ""); symbols != "" {
+		cfg.WebhookConfig.TradingViewAllowedSymbols = nil
+		for _, symbol := range strings.Split(symbols, ",") {
+			if symbol = strings.ToUpper(strings.TrimSpace(symbol)); symbol != "" {
+				cfg.WebhookConfig.TradingViewAllowedSymbols = append(cfg.WebhookConfig.TradingViewAllowedSymbols, symbol)
+			}
+		}
+	}
+
+	// gRPC control API
+	cfg.GRPCConfig.Enabled = getEnvOrDefault("GRPC_ENABLED", "false") == "true"
+	cfg.GRPCConfig.Host = getEnvOrDefault("GRPC_HOST", "0.0.0.0")
+	cfg.GRPCConfig.Port = getEnvIntOrDefault("GRPC_PORT", 9090)
+	cfg.GRPCConfig.AuthToken = getEnvOrDefault("GRPC_AUTH_TOKEN", cfg.GRPCConfig.AuthToken)
+	cfg.GRPCConfig.StreamIntervalMs = getEnvIntOrDefault("GRPC_STREAM_INTERVAL_MS", 1000)
+	cfg.GRPCConfig.MinStreamIntervalMs = getEnvIntOrDefault("GRPC_MIN_STREAM_INTERVAL_MS", 100)
+
 	// Redis config
 	cfg.RedisConfig.Enabled = getEnvOrDefault("REDIS_ENABLED", "false") == "true"
 	redisHost := getEnvOrDefault("REDIS_HOST", "localhost")
@@ -451,6 +566,23 @@ func loadFromFile(filename string) (*Config, error) {
 	return &config, nil
 }
 
+// parseFuturesAccounts parses "name:route,name:route" (route defaults to all)
+func parseFuturesAccounts(v string) []FuturesAccountConfig {
+	var accounts []FuturesAccountConfig
+	for _, entry := range strings.Split(v, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, route, _ := strings.Cut(entry, ":")
+		accounts = append(accounts, FuturesAccountConfig{
+			Name:  strings.TrimSpace(name),
+			Route: strings.TrimSpace(route),
+		})
+	}
+	return accounts
+}
+
 func getEnvOrDefault(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
@@ -525,6 +657,12 @@ func GenerateSampleConfig(filename string) error {
 			QuoteCurrency:     "USDT",
 			MaxSymbols:        50,
 			ScreeningInterval: 60,
+
+			WorkerCount:         5,
+			MaxAPICallsPerCycle: 100,
+			MaxSymbolsPerCycle:  100,
+
+			CacheTTL: 300,
 		},
 		TradingConfig: TradingConfig{
 			MaxOpenPositions: 5,
@@ -0,0 +1,352 @@
+package config
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// ValidationError collects every configuration problem found by Validate so
+// they can be reported together instead of failing on the first one.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "invalid configuration (%d problem(s)):", len(e.Problems))
+	for _, p := range e.Problems {
+		b.WriteString("\n  - ")
+		b.WriteString(p)
+	}
+	return b.String()
+}
+
+// add records a problem with a printf-style message
+func (e *ValidationError) add(format string, args ...interface{}) {
+	e.Problems = append(e.Problems, fmt.Sprintf(format, args...))
+}
+
+// Validate checks cross-field invariants of the loaded configuration.
+// Zero values are treated as "not configured" and are only rejected when the
+// owning feature is enabled. Returns a *ValidationError listing every problem,
+// or nil if the configuration is usable.
+func (c *Config) Validate() error {
+	v := &ValidationError{}
+
+	c.validateServer(v)
+	c.validateAuth(v)
+	c.validateBinance(v)
+	c.validateFutures(v)
+	c.validateTPAllocations(v)
+	c.validateRisk(v)
+	c.validateCircuitBreaker(v)
+	c.validateNotifications(v)
+	c.validateAI(v)
+	c.validateIntegrations(v)
+
+	if len(v.Problems) > 0 {
+		return v
+	}
+	return nil
+}
+
+func (c *Config) validateServer(v *ValidationError) {
+	s := c.ServerConfig
+	if s.Port < 0 || s.Port > 65535 {
+		v.add("server.port %d is out of range (1-65535); set WEB_PORT", s.Port)
+	}
+	if s.TLSEnabled && (s.TLSCertFile == "" || s.TLSKeyFile == "") {
+		v.add("SERVER_TLS_ENABLED is true but SERVER_TLS_CERT and/or SERVER_TLS_KEY is empty")
+	}
+	if s.ReadTimeout < 0 || s.WriteTimeout < 0 || s.ShutdownTimeout < 0 {
+		v.add("server timeouts must not be negative (read=%d, write=%d, shutdown=%d)",
+			s.ReadTimeout, s.WriteTimeout, s.ShutdownTimeout)
+	}
+}
+
+func (c *Config) validateAuth(v *ValidationError) {
+	a := c.AuthConfig
+	if !a.Enabled {
+		return
+	}
+	if a.JWTSecret == "" {
+		v.add("AUTH_ENABLED is true but AUTH_JWT_SECRET is not set")
+	} else if len(a.JWTSecret) < 32 {
+		v.add("AUTH_JWT_SECRET is only %d characters; use at least 32", len(a.JWTSecret))
+	}
+	if a.AccessTokenDuration <= 0 {
+		v.add("AUTH_ACCESS_TOKEN_DURATION must be positive, got %v", a.AccessTokenDuration)
+	}
+	if a.RefreshTokenDuration > 0 && a.RefreshTokenDuration < a.AccessTokenDuration {
+		v.add("AUTH_REFRESH_TOKEN_DURATION (%v) is shorter than AUTH_ACCESS_TOKEN_DURATION (%v)",
+			a.RefreshTokenDuration, a.AccessTokenDuration)
+	}
+	if a.MinPasswordLength < 6 {
+		v.add("AUTH_MIN_PASSWORD_LENGTH is %d; use at least 6", a.MinPasswordLength)
+	}
+}
+
+func (c *Config) validateBinance(v *ValidationError) {
+	b := c.BinanceConfig
+	if b.RetryMaxRetries < 0 || b.RetryMaxRetries > 10 {
+		v.add("BINANCE_RETRY_MAX_RETRIES %d is out of range (0-10)", b.RetryMaxRetries)
+	}
+	if b.RetryBaseDelayMs <= 0 {
+		v.add("BINANCE_RETRY_BASE_DELAY_MS must be positive, got %d", b.RetryBaseDelayMs)
+	}
+	if b.RetryMaxDelayMs < b.RetryBaseDelayMs {
+		v.add("BINANCE_RETRY_MAX_DELAY_MS (%d) must be at least BINANCE_RETRY_BASE_DELAY_MS (%d)",
+			b.RetryMaxDelayMs, b.RetryBaseDelayMs)
+	}
+
+	// Live trading needs real credentials: either a key pair in config.json
+	// or the per-user key store
+	if c.TradingConfig.DryRun || c.TradingConfig.ForceDryRun || b.MockMode {
+		return
+	}
+	hasKey, hasSecret := b.APIKey != "", b.SecretKey != ""
+	switch {
+	case hasKey != hasSecret:
+		v.add("binance.api_key and binance.secret_key must be set together")
+	case hasKey && (b.APIKey == placeholderAPIKey || b.SecretKey == placeholderSecretKey):
+		v.add("binance.api_key/secret_key still hold the example placeholders; set real keys or TRADING_DRY_RUN=true")
+	case !hasKey && !b.UserKeyStore:
+		v.add("live trading (TRADING_DRY_RUN=false) needs Binance API keys: set binance.api_key/secret_key or ENCRYPTION_KEY for per-user keys")
+	}
+}
+
+// Example credentials written by the sample config
+const (
+	placeholderAPIKey    = "your_api_key_here"
+	placeholderSecretKey = "your_secret_key_here"
+)
+
+func (c *Config) validateFutures(v *ValidationError) {
+	f := c.FuturesConfig
+	if !f.Enabled {
+		return
+	}
+	if f.MaxLeverage < 1 || f.MaxLeverage > 125 {
+		v.add("FUTURES_MAX_LEVERAGE %d is out of range (1-125)", f.MaxLeverage)
+	}
+	if f.DefaultLeverage < 1 || (f.MaxLeverage > 0 && f.DefaultLeverage > f.MaxLeverage) {
+		v.add("FUTURES_DEFAULT_LEVERAGE %d must be between 1 and FUTURES_MAX_LEVERAGE (%d)",
+			f.DefaultLeverage, f.MaxLeverage)
+	}
+	if f.DefaultMarginType != "CROSSED" && f.DefaultMarginType != "ISOLATED" {
+		v.add("FUTURES_DEFAULT_MARGIN_TYPE %q must be CROSSED or ISOLATED", f.DefaultMarginType)
+	}
+	if f.PositionMode != "ONE_WAY" && f.PositionMode != "HEDGE" {
+		v.add("FUTURES_POSITION_MODE %q must be ONE_WAY or HEDGE", f.PositionMode)
+	}
+	switch f.ShutdownPolicy {
+	case "leave", "cancel_algos", "flatten":
+	default:
+		v.add("FUTURES_SHUTDOWN_POLICY %q must be leave, cancel_algos, or flatten", f.ShutdownPolicy)
+	}
+	if f.DeadManSwitchSeconds != 0 && (f.DeadManSwitchSeconds < 30 || f.DeadManSwitchSeconds > 3600) {
+		v.add("FUTURES_DEAD_MAN_SWITCH_SECONDS %d must be 0 (disabled) or between 30 and 3600", f.DeadManSwitchSeconds)
+	}
+	if f.MaxAlgoOrdersPerSymbol < 0 || f.MaxAlgoOrdersPerSymbol > 100 {
+		v.add("FUTURES_MAX_ALGO_ORDERS_PER_SYMBOL %d is out of range (0-100)", f.MaxAlgoOrdersPerSymbol)
+	}
+	seenAccounts := make(map[string]bool)
+	for _, account := range f.Accounts {
+		if account.Name == "" {
+			v.add("FUTURES_ACCOUNTS has an entry with no account name")
+			continue
+		}
+		if seenAccounts[account.Name] {
+			v.add("FUTURES_ACCOUNTS lists account %q more than once", account.Name)
+		}
+		seenAccounts[account.Name] = true
+		switch strings.ToLower(account.Route) {
+		case "", "all", "long", "short":
+		default:
+			v.add("FUTURES_ACCOUNTS route %q for account %q must be all, long, or short", account.Route, account.Name)
+		}
+	}
+
+	fa := c.FuturesAutopilotConfig
+	if !fa.Enabled {
+		return
+	}
+	if fa.MinConfidence < 0 || fa.MinConfidence > 1 {
+		v.add("futures_autopilot.min_confidence %.2f must be between 0 and 1", fa.MinConfidence)
+	}
+	if fa.MaxLeverage > 0 && fa.DefaultLeverage > fa.MaxLeverage {
+		v.add("futures_autopilot.default_leverage (%d) exceeds futures_autopilot.max_leverage (%d)",
+			fa.DefaultLeverage, fa.MaxLeverage)
+	}
+	if fa.MaxLeverage > f.MaxLeverage && f.MaxLeverage > 0 {
+		v.add("futures_autopilot.max_leverage (%d) exceeds FUTURES_MAX_LEVERAGE (%d)",
+			fa.MaxLeverage, f.MaxLeverage)
+	}
+	if fa.StopLossPercent > 0 && fa.TakeProfitPercent > 0 && fa.TakeProfitPercent <= fa.StopLossPercent {
+		v.add("futures_autopilot.take_profit_percent (%.2f) must be greater than stop_loss_percent (%.2f)",
+			fa.TakeProfitPercent, fa.StopLossPercent)
+	}
+	if fa.TakeProfitPercent1 > 0 && fa.TakeProfitPercent2 > 0 && fa.TakeProfitPercent2 <= fa.TakeProfitPercent1 {
+		v.add("futures_autopilot.take_profit_percent_2 (%.2f) must be greater than take_profit_percent_1 (%.2f)",
+			fa.TakeProfitPercent2, fa.TakeProfitPercent1)
+	}
+	if fa.MinSLPercent > 0 && fa.MaxSLPercent > 0 && fa.MinSLPercent > fa.MaxSLPercent {
+		v.add("futures_autopilot.min_sl_percent (%.2f) is greater than max_sl_percent (%.2f)",
+			fa.MinSLPercent, fa.MaxSLPercent)
+	}
+	if fa.MinTPPercent > 0 && fa.MaxTPPercent > 0 && fa.MinTPPercent > fa.MaxTPPercent {
+		v.add("futures_autopilot.min_tp_percent (%.2f) is greater than max_tp_percent (%.2f)",
+			fa.MinTPPercent, fa.MaxTPPercent)
+	}
+	if fa.ProfitReinvestPercent < 0 || fa.ProfitReinvestPercent > 100 {
+		v.add("futures_autopilot.profit_reinvest_percent %.2f must be between 0 and 100", fa.ProfitReinvestPercent)
+	}
+	if fa.HedgingEnabled {
+		for i, step := range fa.HedgePartialSteps {
+			if step <= 0 || step > 100 {
+				v.add("futures_autopilot.hedge_partial_steps[%d] = %.2f must be between 0 and 100", i, step)
+			}
+			if i > 0 && step <= fa.HedgePartialSteps[i-1] {
+				v.add("futures_autopilot.hedge_partial_steps must be strictly increasing")
+				break
+			}
+		}
+	}
+}
+
+// validateTPAllocations checks that each mode's TP quantity allocation
+// covers exactly the whole position
+func (c *Config) validateTPAllocations(v *ValidationError) {
+	modes := make([]string, 0, len(c.ModeTPAllocations))
+	for mode := range c.ModeTPAllocations {
+		modes = append(modes, mode)
+	}
+	sort.Strings(modes) // Stable report order
+
+	for _, mode := range modes {
+		allocation := c.ModeTPAllocations[mode]
+		if len(allocation) == 0 {
+			continue
+		}
+		sum := 0.0
+		for i, pct := range allocation {
+			if pct < 0 || pct > 100 {
+				v.add("%s tp_allocation[%d] = %.2f must be between 0 and 100", mode, i, pct)
+			}
+			sum += pct
+		}
+		if math.Abs(sum-100) > 0.01 {
+			v.add("%s tp_allocation %v sums to %.2f; the levels must add up to 100", mode, allocation, sum)
+		}
+	}
+}
+
+func (c *Config) validateRisk(v *ValidationError) {
+	r := c.RiskConfig
+	switch r.PositionSizeMethod {
+	case "", "fixed", "percent", "kelly", "atr":
+	default:
+		v.add("risk.position_size_method %q must be one of fixed, percent, kelly, atr", r.PositionSizeMethod)
+	}
+	if r.PositionSizeMethod == "fixed" && r.FixedPositionSize <= 0 {
+		v.add("risk.position_size_method is fixed but risk.fixed_position_size is %.2f", r.FixedPositionSize)
+	}
+	if r.MaxRiskPerTrade < 0 || r.MaxRiskPerTrade > 100 {
+		v.add("risk.max_risk_per_trade %.2f must be between 0 and 100", r.MaxRiskPerTrade)
+	}
+	if r.MaxDailyDrawdown < 0 || r.MaxDailyDrawdown > 100 {
+		v.add("risk.max_daily_drawdown %.2f must be between 0 and 100", r.MaxDailyDrawdown)
+	}
+	if r.MaxOpenPositions < 0 {
+		v.add("risk.max_open_positions must not be negative, got %d", r.MaxOpenPositions)
+	}
+	if r.UseTrailingStop && r.TrailingStopPercent <= 0 {
+		v.add("risk.use_trailing_stop is true but risk.trailing_stop_percent is %.2f", r.TrailingStopPercent)
+	}
+}
+
+func (c *Config) validateCircuitBreaker(v *ValidationError) {
+	cb := c.CircuitBreakerConfig
+	if !cb.Enabled {
+		return
+	}
+	if cb.MaxLossPerHour < 0 || cb.MaxDailyLoss < 0 {
+		v.add("circuit breaker loss limits must not be negative (hourly=%.2f, daily=%.2f)",
+			cb.MaxLossPerHour, cb.MaxDailyLoss)
+	}
+	if cb.MaxDailyLoss > 0 && cb.MaxLossPerHour > cb.MaxDailyLoss {
+		v.add("CIRCUIT_MAX_LOSS_PER_HOUR (%.2f) exceeds circuit_breaker.max_daily_loss (%.2f)",
+			cb.MaxLossPerHour, cb.MaxDailyLoss)
+	}
+	if cb.MaxConsecutiveLosses < 0 || cb.CooldownMinutes < 0 || cb.MaxTradesPerMinute < 0 || cb.MaxDailyTrades < 0 {
+		v.add("circuit breaker counters must not be negative")
+	}
+}
+
+func (c *Config) validateNotifications(v *ValidationError) {
+	n := c.NotificationConfig
+	if !n.Enabled {
+		return
+	}
+	if n.Telegram.Enabled && (n.Telegram.BotToken == "" || n.Telegram.ChatID == "") {
+		v.add("TELEGRAM_ENABLED is true but TELEGRAM_BOT_TOKEN and/or TELEGRAM_CHAT_ID is empty")
+	}
+	if n.Discord.Enabled && n.Discord.WebhookURL == "" {
+		v.add("DISCORD_ENABLED is true but DISCORD_WEBHOOK_URL is empty")
+	}
+}
+
+func (c *Config) validateAI(v *ValidationError) {
+	if !c.AIConfig.Enabled {
+		return
+	}
+	switch c.AIConfig.LLMProvider {
+	case "claude", "openai", "deepseek":
+	default:
+		v.add("AI_LLM_PROVIDER %q must be one of claude, openai, deepseek", c.AIConfig.LLMProvider)
+	}
+	if c.AIConfig.LLMMaxConcurrent < 0 || c.AIConfig.LLMMaxQueued < 0 || c.AIConfig.LLMQueueTimeoutMs < 0 {
+		v.add("AI_LLM_MAX_CONCURRENT, AI_LLM_MAX_QUEUED and AI_LLM_QUEUE_TIMEOUT_MS must not be negative (got %d, %d, %d)",
+			c.AIConfig.LLMMaxConcurrent, c.AIConfig.LLMMaxQueued, c.AIConfig.LLMQueueTimeoutMs)
+	}
+}
+
+func (c *Config) validateIntegrations(v *ValidationError) {
+	if c.VaultConfig.Enabled {
+		if c.VaultConfig.Address == "" {
+			v.add("VAULT_ENABLED is true but VAULT_ADDR is empty")
+		}
+		if c.VaultConfig.Token == "" {
+			v.add("VAULT_ENABLED is true but VAULT_TOKEN is empty")
+		}
+	}
+	if c.BillingConfig.Enabled && c.BillingConfig.StripeSecretKey == "" && !c.BillingConfig.CryptoPaymentsEnabled {
+		v.add("BILLING_ENABLED is true but neither STRIPE_SECRET_KEY nor crypto payments are configured")
+	}
+	if c.BillingConfig.CryptoPaymentsEnabled && c.BillingConfig.CryptoWalletAddress == "" {
+		v.add("billing.crypto_payments_enabled is true but billing.crypto_wallet_address is empty")
+	}
+	if c.WebhookConfig.TradingViewEnabled && c.WebhookConfig.TradingViewSecret == "" {
+		v.add("TRADINGVIEW_WEBHOOK_ENABLED is true but TRADINGVIEW_WEBHOOK_SECRET is empty")
+	}
+	if c.GRPCConfig.Enabled {
+		if c.GRPCConfig.Port <= 0 || c.GRPCConfig.Port > 65535 {
+			v.add("grpc.port %d is out of range (1-65535); set GRPC_PORT", c.GRPCConfig.Port)
+		} else if c.GRPCConfig.Port == c.ServerConfig.Port {
+			v.add("GRPC_PORT %d is the same as WEB_PORT; the gRPC API needs its own port", c.GRPCConfig.Port)
+		}
+		if len(c.GRPCConfig.AuthToken) < 16 {
+			v.add("GRPC_ENABLED is true but GRPC_AUTH_TOKEN is missing or shorter than 16 characters")
+		}
+	}
+	if c.RedisConfig.Enabled {
+		if c.RedisConfig.Address == "" {
+			v.add("REDIS_ENABLED is true but REDIS_HOST/REDIS_PORT resolve to an empty address")
+		}
+		if c.RedisConfig.PoolSize < 0 {
+			v.add("REDIS_POOL_SIZE must not be negative, got %d", c.RedisConfig.PoolSize)
+		}
+	}
+}
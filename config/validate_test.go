@@ -0,0 +1,150 @@
+package config
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// validConfig returns a minimal configuration that passes Validate
+func validConfig() *Config {
+	return &Config{
+		BinanceConfig: BinanceConfig{RetryMaxRetries: 3, RetryBaseDelayMs: 500, RetryMaxDelayMs: 5000},
+		TradingConfig: TradingConfig{DryRun: true},
+		ServerConfig:  ServerConfig{Port: 8080},
+		ModeTPAllocations: map[string][]float64{
+			"scalp":    {100, 0, 0, 0},
+			"position": {40, 30, 20, 10},
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(c *Config)
+		want   []string // Substrings of the expected problems; empty means valid
+	}{
+		{
+			name:   "valid",
+			mutate: func(c *Config) {},
+		},
+		{
+			name: "auth enabled without secret",
+			mutate: func(c *Config) {
+				c.AuthConfig = AuthConfig{Enabled: true, AccessTokenDuration: 1, MinPasswordLength: 8}
+			},
+			want: []string{"AUTH_JWT_SECRET is not set"},
+		},
+		{
+			name: "tp allocation short of 100",
+			mutate: func(c *Config) {
+				c.ModeTPAllocations["swing"] = []float64{50, 40, 0, 0}
+			},
+			want: []string{"swing tp_allocation [50 40 0 0] sums to 90.00"},
+		},
+		{
+			name: "tp allocation over 100",
+			mutate: func(c *Config) {
+				c.ModeTPAllocations["scalp"] = []float64{100, 10, 0, 0}
+			},
+			want: []string{"scalp tp_allocation [100 10 0 0] sums to 110.00"},
+		},
+		{
+			name: "negative tp allocation level",
+			mutate: func(c *Config) {
+				c.ModeTPAllocations["scalp"] = []float64{110, -10, 0, 0}
+			},
+			want: []string{"scalp tp_allocation[0] = 110.00", "scalp tp_allocation[1] = -10.00"},
+		},
+		{
+			name: "empty tp allocation is not configured",
+			mutate: func(c *Config) {
+				c.ModeTPAllocations["swing"] = nil
+			},
+		},
+		{
+			name: "live without any api keys",
+			mutate: func(c *Config) {
+				c.TradingConfig.DryRun = false
+			},
+			want: []string{"live trading (TRADING_DRY_RUN=false) needs Binance API keys"},
+		},
+		{
+			name: "live with per-user key store",
+			mutate: func(c *Config) {
+				c.TradingConfig.DryRun = false
+				c.BinanceConfig.UserKeyStore = true
+			},
+		},
+		{
+			name: "live with config keys",
+			mutate: func(c *Config) {
+				c.TradingConfig.DryRun = false
+				c.BinanceConfig.APIKey = "key"
+				c.BinanceConfig.SecretKey = "secret"
+			},
+		},
+		{
+			name: "live with api key but no secret",
+			mutate: func(c *Config) {
+				c.TradingConfig.DryRun = false
+				c.BinanceConfig.APIKey = "key"
+				c.BinanceConfig.UserKeyStore = true
+			},
+			want: []string{"must be set together"},
+		},
+		{
+			name: "live with placeholder keys",
+			mutate: func(c *Config) {
+				c.TradingConfig.DryRun = false
+				c.BinanceConfig.APIKey = placeholderAPIKey
+				c.BinanceConfig.SecretKey = placeholderSecretKey
+			},
+			want: []string{"example placeholders"},
+		},
+		{
+			name: "forced dry run needs no keys",
+			mutate: func(c *Config) {
+				c.TradingConfig.DryRun = false
+				c.TradingConfig.ForceDryRun = true
+			},
+		},
+		{
+			name: "problems are combined",
+			mutate: func(c *Config) {
+				c.TradingConfig.DryRun = false
+				c.ModeTPAllocations["swing"] = []float64{50, 0, 0, 0}
+				c.ServerConfig.Port = 70000
+			},
+			want: []string{"server.port 70000", "swing tp_allocation", "needs Binance API keys"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := validConfig()
+			tt.mutate(c)
+			err := c.Validate()
+
+			if len(tt.want) == 0 {
+				if err != nil {
+					t.Fatalf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+			var verr *ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("Validate() = %v, want *ValidationError", err)
+			}
+			if len(verr.Problems) != len(tt.want) {
+				t.Errorf("got %d problems, want %d:\n%v", len(verr.Problems), len(tt.want), err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("Validate() error missing %q:\n%v", want, err)
+				}
+			}
+		})
+	}
+}
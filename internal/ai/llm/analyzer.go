@@ -2,11 +2,14 @@ package llm
 
 import (
 	"binance-trading-bot/internal/binance"
+	"context"
 	"encoding/json"
 	"fmt"
+	"log"
 	"regexp"
 	"strings"
 	"sync"
+	"text/template"
 	"time"
 )
 
@@ -39,8 +42,29 @@ type AnalyzerConfig struct {
 	EnablePatterns    bool          `json:"enable_patterns"`
 	EnableRiskCheck   bool          `json:"enable_risk_check"`
 	EnableBigCandle   bool          `json:"enable_big_candle"`
+
+	// Provider circuit breaker (0 = DefaultFailureThreshold / DefaultFailureCooldown)
+	FailureThreshold int           `json:"failure_threshold"`
+	FailureCooldown  time.Duration `json:"failure_cooldown"`
+
+	// Optional user prompt overrides keyed by PromptType* (pattern, risk-check,
+	// big-candle, sltp), rendered with PromptContext. Unset keys use built-in prompts.
+	PromptTemplates map[string]string `json:"prompt_templates,omitempty"`
+
+	// Latency budget for a single provider request (0 = DefaultRequestTimeout)
+	RequestTimeout time.Duration `json:"request_timeout"`
+
+	// Concurrent in-flight requests, applied under RateLimitPerMin. Requests
+	// that can't get a slot within QueueTimeout fail so callers fall back to
+	// rule-based analysis (0 = DefaultMaxConcurrent / DefaultMaxQueued / DefaultQueueTimeout).
+	MaxConcurrent int           `json:"max_concurrent"`
+	MaxQueued     int           `json:"max_queued"`
+	QueueTimeout  time.Duration `json:"queue_timeout"`
 }
 
+// DefaultRequestTimeout leaves room for large requests such as coin selection
+const DefaultRequestTimeout = 120 * time.Second
+
 // DefaultAnalyzerConfig returns default configuration
 func DefaultAnalyzerConfig() *AnalyzerConfig {
 	return &AnalyzerConfig{
@@ -55,6 +79,10 @@ func DefaultAnalyzerConfig() *AnalyzerConfig {
 		EnablePatterns:    true,
 		EnableRiskCheck:   true,
 		EnableBigCandle:   true,
+		RequestTimeout:    DefaultRequestTimeout,
+		MaxConcurrent:     DefaultMaxConcurrent,
+		MaxQueued:         DefaultMaxQueued,
+		QueueTimeout:      DefaultQueueTimeout,
 	}
 }
 
@@ -279,12 +307,13 @@ type CachedAnalysis struct {
 
 // Analyzer orchestrates LLM-based market analysis
 type Analyzer struct {
-	config       *AnalyzerConfig
-	client       *Client
-	cache        map[string]*CachedAnalysis
-	requestCount int
-	lastReset    time.Time
-	mu           sync.RWMutex
+	config          *AnalyzerConfig
+	client          *Client
+	promptTemplates map[string]*template.Template
+	cache           map[string]*CachedAnalysis
+	requestCount    int
+	lastReset       time.Time
+	mu              sync.RWMutex
 }
 
 // NewAnalyzer creates a new LLM analyzer
@@ -293,20 +322,39 @@ func NewAnalyzer(config *AnalyzerConfig) *Analyzer {
 		config = DefaultAnalyzerConfig()
 	}
 
+	requestTimeout := config.RequestTimeout
+	if requestTimeout <= 0 {
+		requestTimeout = DefaultRequestTimeout
+	}
+
 	clientConfig := &ClientConfig{
 		Provider:    config.Provider,
 		APIKey:      config.APIKey,
 		Model:       config.Model,
 		MaxTokens:   config.MaxTokens,
 		Temperature: config.Temperature,
-		Timeout:     120 * time.Second, // Increased for complex LLM requests (coin selection)
+		Timeout:     requestTimeout,
+
+		FailureThreshold: config.FailureThreshold,
+		FailureCooldown:  config.FailureCooldown,
+
+		MaxConcurrent: config.MaxConcurrent,
+		MaxQueued:     config.MaxQueued,
+		QueueTimeout:  config.QueueTimeout,
+	}
+
+	// Invalid templates are dropped so those analysis types keep the built-in prompt
+	promptTemplates, err := ParsePromptTemplates(config.PromptTemplates)
+	if err != nil {
+		log.Printf("[LLM] %v - falling back to built-in prompts for those types", err)
 	}
 
 	return &Analyzer{
-		config:    config,
-		client:    NewClient(clientConfig),
-		cache:     make(map[string]*CachedAnalysis),
-		lastReset: time.Now(),
+		config:          config,
+		client:          NewClient(clientConfig),
+		promptTemplates: promptTemplates,
+		cache:           make(map[string]*CachedAnalysis),
+		lastReset:       time.Now(),
 	}
 }
 
@@ -372,7 +420,11 @@ func (a *Analyzer) AnalyzePatterns(symbol, timeframe string, klines []binance.Kl
 	}
 
 	klineData := formatKlines(klines)
-	prompt := BuildPatternPrompt(symbol, timeframe, klineData)
+	prompt := a.renderPrompt(PromptTypePattern, PromptContext{
+		Symbol:    symbol,
+		Timeframe: timeframe,
+		KlineData: klineData,
+	}, func() string { return BuildPatternPrompt(symbol, timeframe, klineData) })
 
 	response, err := a.client.Complete(SystemPromptPatternRecognition, prompt)
 	if err != nil {
@@ -404,7 +456,11 @@ func (a *Analyzer) AnalyzeBigCandle(symbol string, bigCandle binance.Kline, cont
 
 	contextData := formatKlines(contextKlines) + "\n\nIndicators:\n" + calculateIndicatorsSummary(contextKlines)
 
-	prompt := BuildBigCandlePrompt(symbol, candleData, contextData)
+	prompt := a.renderPrompt(PromptTypeBigCandle, PromptContext{
+		Symbol:      symbol,
+		CandleData:  candleData,
+		ContextData: contextData,
+	}, func() string { return BuildBigCandlePrompt(symbol, candleData, contextData) })
 
 	response, err := a.client.Complete(SystemPromptBigCandleAnalysis, prompt)
 	if err != nil {
@@ -434,7 +490,11 @@ func (a *Analyzer) AssessRisk(tradeDetails map[string]interface{}, accountBalanc
 	accountInfo := fmt.Sprintf("Balance: $%.2f", accountBalance)
 	marketConditions := fmt.Sprintf("Current Volatility: %.2f%%", marketVolatility*100)
 
-	prompt := BuildRiskAssessmentPrompt(string(tradeJSON), accountInfo, marketConditions)
+	prompt := a.renderPrompt(PromptTypeRiskCheck, PromptContext{
+		TradeDetails:     string(tradeJSON),
+		AccountInfo:      accountInfo,
+		MarketConditions: marketConditions,
+	}, func() string { return BuildRiskAssessmentPrompt(string(tradeJSON), accountInfo, marketConditions) })
 
 	response, err := a.client.Complete(SystemPromptRiskAssessment, prompt)
 	if err != nil {
@@ -619,15 +679,10 @@ Available for Trading: $%.2f`,
 
 	prompt := BuildAutoTradingPrompt(watchlistStr, marketDataStr, existingPosStr, constraintsStr, accountStr)
 
-	response, err := a.client.Complete(SystemPromptAutoTradingDecision, prompt)
-	if err != nil {
-		return nil, fmt.Errorf("LLM request failed: %w", err)
-	}
-
-	cleanResponse := stripMarkdownCodeBlock(response)
 	var decision AutoTradingDecision
-	if err := json.Unmarshal([]byte(cleanResponse), &decision); err != nil {
-		return nil, fmt.Errorf("failed to parse LLM response: %w", err)
+	if err := a.completeStructured(context.Background(), SystemPromptAutoTradingDecision, prompt, &decision,
+		decision.Validate, AutoTradingRequiredFields...); err != nil {
+		return nil, err
 	}
 
 	// Enforce hard limits on the decisions
@@ -692,6 +747,11 @@ func (a *Analyzer) enforceAutoModeConstraints(decision AutoTradingDecision, cons
 
 // AnalyzePositionSLTP analyzes current position and recommends optimal SL/TP levels
 func (a *Analyzer) AnalyzePositionSLTP(pos *PositionInfo, klines []binance.Kline) (*PositionSLTPAnalysis, error) {
+	return a.AnalyzePositionSLTPContext(context.Background(), pos, klines)
+}
+
+// AnalyzePositionSLTPContext is AnalyzePositionSLTP bounded by ctx
+func (a *Analyzer) AnalyzePositionSLTPContext(ctx context.Context, pos *PositionInfo, klines []binance.Kline) (*PositionSLTPAnalysis, error) {
 	if !a.config.Enabled || !a.client.IsConfigured() {
 		return nil, fmt.Errorf("LLM analyzer not enabled")
 	}
@@ -733,17 +793,17 @@ Trading Mode: %s`,
 	klineData := formatKlines(klines)
 	indicators := calculateIndicatorsSummary(klines)
 
-	prompt := BuildPositionSLTPPrompt(positionInfo, klineData, indicators)
-
-	response, err := a.client.Complete(SystemPromptPositionSLTP, prompt)
-	if err != nil {
-		return nil, fmt.Errorf("LLM request failed: %w", err)
-	}
+	prompt := a.renderPrompt(PromptTypeSLTP, PromptContext{
+		Symbol:       pos.Symbol,
+		PositionInfo: positionInfo,
+		KlineData:    klineData,
+		Indicators:   indicators,
+	}, func() string { return BuildPositionSLTPPrompt(positionInfo, klineData, indicators) })
 
-	cleanResponse := stripMarkdownCodeBlock(response)
 	var analysis PositionSLTPAnalysis
-	if err := json.Unmarshal([]byte(cleanResponse), &analysis); err != nil {
-		return nil, fmt.Errorf("failed to parse LLM response: %w", err)
+	if err := a.completeStructured(ctx, SystemPromptPositionSLTP, prompt, &analysis,
+		func() error { return analysis.Validate(pos) }, PositionSLTPRequiredFields...); err != nil {
+		return nil, err
 	}
 
 	return &analysis, nil
@@ -1094,9 +1154,15 @@ func (a *Analyzer) checkRateLimit() bool {
 	return true
 }
 
-// IsEnabled returns if the analyzer is enabled
+// IsEnabled returns if the analyzer is enabled and the provider is not degraded,
+// so callers fall back to rule-based analysis while the LLM is failing
 func (a *Analyzer) IsEnabled() bool {
-	return a.config.Enabled && a.client.IsConfigured()
+	return a.config.Enabled && a.client.IsAvailable()
+}
+
+// Health returns the provider circuit breaker state
+func (a *Analyzer) Health() Health {
+	return a.client.Health()
 }
 
 // GetClient returns the underlying LLM client for direct use
@@ -2,9 +2,12 @@ package llm
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
 	"time"
 )
@@ -25,7 +28,18 @@ type ClientConfig struct {
 	Model       string        `json:"model"`
 	MaxTokens   int           `json:"max_tokens"`
 	Temperature float64       `json:"temperature"`
-	Timeout     time.Duration `json:"timeout"`
+	Timeout     time.Duration `json:"timeout"` // Per-call budget, enforced via context
+
+	// Circuit breaker: after FailureThreshold consecutive errors the provider is
+	// treated as unavailable for FailureCooldown (0 = package defaults)
+	FailureThreshold int           `json:"failure_threshold"`
+	FailureCooldown  time.Duration `json:"failure_cooldown"`
+
+	// Concurrency limiter: at most MaxConcurrent calls in flight, up to MaxQueued
+	// more waiting at most QueueTimeout for a slot (0 = package defaults)
+	MaxConcurrent int           `json:"max_concurrent"`
+	MaxQueued     int           `json:"max_queued"`
+	QueueTimeout  time.Duration `json:"queue_timeout"`
 }
 
 // DefaultClientConfig returns default configuration
@@ -43,8 +57,14 @@ func DefaultClientConfig() *ClientConfig {
 type Client struct {
 	config     *ClientConfig
 	httpClient *http.Client
+	health     *providerHealth
+	latency    *latencyTracker
+	limiter    *concurrencyLimiter
 }
 
+// ErrRequestTimeout is returned when a call exceeds the configured timeout
+var ErrRequestTimeout = errors.New("LLM request timed out")
+
 // NewClient creates a new LLM client
 func NewClient(config *ClientConfig) *Client {
 	if config == nil {
@@ -55,6 +75,9 @@ func NewClient(config *ClientConfig) *Client {
 		httpClient: &http.Client{
 			Timeout: config.Timeout,
 		},
+		health:  newProviderHealth(config.FailureThreshold, config.FailureCooldown),
+		latency: &latencyTracker{},
+		limiter: newConcurrencyLimiter(config.MaxConcurrent, config.MaxQueued, config.QueueTimeout),
 	}
 }
 
@@ -97,10 +120,16 @@ type ClaudeResponse struct {
 
 // OpenAIRequest represents an OpenAI API request
 type OpenAIRequest struct {
-	Model       string    `json:"model"`
-	Messages    []Message `json:"messages"`
-	MaxTokens   int       `json:"max_tokens,omitempty"`
-	Temperature float64   `json:"temperature,omitempty"`
+	Model          string          `json:"model"`
+	Messages       []Message       `json:"messages"`
+	MaxTokens      int             `json:"max_tokens,omitempty"`
+	Temperature    float64         `json:"temperature,omitempty"`
+	ResponseFormat *ResponseFormat `json:"response_format,omitempty"`
+}
+
+// ResponseFormat requests structured output from OpenAI-compatible APIs
+type ResponseFormat struct {
+	Type string `json:"type"` // "json_object"
 }
 
 // OpenAIResponse represents an OpenAI API response
@@ -129,22 +158,80 @@ type OpenAIResponse struct {
 	} `json:"error,omitempty"`
 }
 
-// Complete sends a completion request to the LLM
+// Complete sends a completion request to the LLM.
+// While the provider is degraded it fails fast with ErrProviderDegraded.
 func (c *Client) Complete(systemPrompt string, userPrompt string) (string, error) {
+	return c.complete(context.Background(), systemPrompt, userPrompt, false)
+}
+
+// CompleteContext is Complete bounded by ctx as well as the per-call timeout
+func (c *Client) CompleteContext(ctx context.Context, systemPrompt string, userPrompt string) (string, error) {
+	return c.complete(ctx, systemPrompt, userPrompt, false)
+}
+
+// CompleteJSON is Complete with the provider's JSON mode enabled: response_format
+// json_object for OpenAI/DeepSeek, and a prefilled "{" for Claude
+func (c *Client) CompleteJSON(systemPrompt string, userPrompt string) (string, error) {
+	return c.complete(context.Background(), systemPrompt, userPrompt, true)
+}
+
+// CompleteJSONContext is CompleteJSON bounded by ctx as well as the per-call timeout
+func (c *Client) CompleteJSONContext(ctx context.Context, systemPrompt string, userPrompt string) (string, error) {
+	return c.complete(ctx, systemPrompt, userPrompt, true)
+}
+
+func (c *Client) complete(ctx context.Context, systemPrompt string, userPrompt string, jsonMode bool) (string, error) {
+	if !c.health.allow() {
+		return "", ErrProviderDegraded
+	}
+
+	// Queue time doesn't count against the per-call timeout, and a request
+	// turned away here says nothing about provider health
+	release, err := c.limiter.acquire(ctx)
+	if err != nil {
+		if errors.Is(err, ErrQueueFull) || errors.Is(err, ErrQueueTimeout) {
+			log.Printf("[LLM] %s request not sent: %v", c.config.Provider, err)
+		}
+		return "", err
+	}
+	defer release()
+
+	if c.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.config.Timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	var response string
 	switch c.config.Provider {
 	case ProviderClaude:
-		return c.completeClaude(systemPrompt, userPrompt)
+		response, err = c.completeClaude(ctx, systemPrompt, userPrompt, jsonMode)
 	case ProviderOpenAI:
-		return c.completeOpenAI(systemPrompt, userPrompt)
+		response, err = c.completeOpenAI(ctx, systemPrompt, userPrompt, jsonMode)
 	case ProviderDeepSeek:
-		return c.completeDeepSeek(systemPrompt, userPrompt)
+		response, err = c.completeDeepSeek(ctx, systemPrompt, userPrompt, jsonMode)
 	default:
 		return "", fmt.Errorf("unsupported provider: %s", c.config.Provider)
 	}
+	elapsed := time.Since(start)
+
+	timedOut := errors.Is(ctx.Err(), context.DeadlineExceeded)
+	c.latency.record(elapsed, timedOut)
+	if timedOut {
+		log.Printf("[LLM] %s request timed out after %v", c.config.Provider, elapsed.Round(time.Millisecond))
+		err = fmt.Errorf("%w after %v: %v", ErrRequestTimeout, elapsed.Round(time.Millisecond), err)
+	}
+
+	// A caller abandoning the request says nothing about provider health
+	if !errors.Is(ctx.Err(), context.Canceled) {
+		c.health.record(c.config.Provider, err)
+	}
+	return response, err
 }
 
 // completeClaude sends a request to Claude API
-func (c *Client) completeClaude(systemPrompt string, userPrompt string) (string, error) {
+func (c *Client) completeClaude(ctx context.Context, systemPrompt string, userPrompt string, jsonMode bool) (string, error) {
 	req := ClaudeRequest{
 		Model:       c.config.Model,
 		MaxTokens:   c.config.MaxTokens,
@@ -154,13 +241,17 @@ func (c *Client) completeClaude(systemPrompt string, userPrompt string) (string,
 			{Role: "user", Content: userPrompt},
 		},
 	}
+	// Claude has no JSON mode; prefilling the assistant turn forces an object
+	if jsonMode {
+		req.Messages = append(req.Messages, Message{Role: "assistant", Content: "{"})
+	}
 
 	body, err := json.Marshal(req)
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	httpReq, err := http.NewRequest("POST", "https://api.anthropic.com/v1/messages", bytes.NewReader(body))
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", "https://api.anthropic.com/v1/messages", bytes.NewReader(body))
 	if err != nil {
 		return "", fmt.Errorf("failed to create request: %w", err)
 	}
@@ -193,11 +284,14 @@ func (c *Client) completeClaude(systemPrompt string, userPrompt string) (string,
 		return "", fmt.Errorf("empty response from Claude")
 	}
 
+	if jsonMode {
+		return "{" + claudeResp.Content[0].Text, nil
+	}
 	return claudeResp.Content[0].Text, nil
 }
 
 // completeOpenAI sends a request to OpenAI API
-func (c *Client) completeOpenAI(systemPrompt string, userPrompt string) (string, error) {
+func (c *Client) completeOpenAI(ctx context.Context, systemPrompt string, userPrompt string, jsonMode bool) (string, error) {
 	messages := []Message{
 		{Role: "system", Content: systemPrompt},
 		{Role: "user", Content: userPrompt},
@@ -209,13 +303,16 @@ func (c *Client) completeOpenAI(systemPrompt string, userPrompt string) (string,
 		MaxTokens:   c.config.MaxTokens,
 		Temperature: c.config.Temperature,
 	}
+	if jsonMode {
+		req.ResponseFormat = &ResponseFormat{Type: "json_object"}
+	}
 
 	body, err := json.Marshal(req)
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	httpReq, err := http.NewRequest("POST", "https://api.openai.com/v1/chat/completions", bytes.NewReader(body))
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", "https://api.openai.com/v1/chat/completions", bytes.NewReader(body))
 	if err != nil {
 		return "", fmt.Errorf("failed to create request: %w", err)
 	}
@@ -251,7 +348,7 @@ func (c *Client) completeOpenAI(systemPrompt string, userPrompt string) (string,
 }
 
 // completeDeepSeek sends a request to DeepSeek API (OpenAI-compatible)
-func (c *Client) completeDeepSeek(systemPrompt string, userPrompt string) (string, error) {
+func (c *Client) completeDeepSeek(ctx context.Context, systemPrompt string, userPrompt string, jsonMode bool) (string, error) {
 	messages := []Message{
 		{Role: "system", Content: systemPrompt},
 		{Role: "user", Content: userPrompt},
@@ -263,13 +360,16 @@ func (c *Client) completeDeepSeek(systemPrompt string, userPrompt string) (strin
 		MaxTokens:   c.config.MaxTokens,
 		Temperature: c.config.Temperature,
 	}
+	if jsonMode {
+		req.ResponseFormat = &ResponseFormat{Type: "json_object"}
+	}
 
 	body, err := json.Marshal(req)
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	httpReq, err := http.NewRequest("POST", "https://api.deepseek.com/v1/chat/completions", bytes.NewReader(body))
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", "https://api.deepseek.com/v1/chat/completions", bytes.NewReader(body))
 	if err != nil {
 		return "", fmt.Errorf("failed to create request: %w", err)
 	}
@@ -318,3 +418,23 @@ func (c *Client) GetProvider() Provider {
 func (c *Client) IsConfigured() bool {
 	return c.config.APIKey != ""
 }
+
+// IsAvailable reports whether the client is configured and not degraded
+func (c *Client) IsAvailable() bool {
+	return c.IsConfigured() && c.health.allow()
+}
+
+// Health returns the provider circuit breaker state
+func (c *Client) Health() Health {
+	return c.health.snapshot(c.IsConfigured())
+}
+
+// ConcurrencyStats returns the in-flight and queued request counts
+func (c *Client) ConcurrencyStats() ConcurrencyStats {
+	return c.limiter.stats()
+}
+
+// LatencyStats returns average/p95 latency over recent calls to this provider
+func (c *Client) LatencyStats() LatencyStats {
+	return c.latency.stats(c.config.Provider)
+}
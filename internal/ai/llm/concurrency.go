@@ -0,0 +1,116 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Concurrency limiter defaults
+const (
+	DefaultMaxConcurrent = 4
+	DefaultMaxQueued     = 32
+	DefaultQueueTimeout  = 10 * time.Second
+)
+
+// ErrQueueFull and ErrQueueTimeout are returned without calling the provider
+// when the concurrency limiter can't admit a request, so callers take their
+// rule-based path
+var (
+	ErrQueueFull    = errors.New("LLM request queue full, using rule-based fallback")
+	ErrQueueTimeout = errors.New("LLM request waited too long for a slot, using rule-based fallback")
+)
+
+// ConcurrencyStats is a snapshot of the concurrency limiter
+type ConcurrencyStats struct {
+	MaxConcurrent int   `json:"max_concurrent"`
+	MaxQueued     int   `json:"max_queued"`
+	InFlight      int   `json:"in_flight"`
+	Queued        int   `json:"queued"`
+	Rejected      int64 `json:"rejected"`       // Turned away with the queue full
+	QueueTimeouts int64 `json:"queue_timeouts"` // Gave up waiting for a slot
+}
+
+// concurrencyLimiter caps in-flight provider calls. Callers beyond the cap
+// wait in a bounded queue for up to queueTimeout.
+type concurrencyLimiter struct {
+	slots        chan struct{}
+	maxQueued    int
+	queueTimeout time.Duration
+
+	mu            sync.Mutex
+	queued        int
+	rejected      int64
+	queueTimeouts int64
+}
+
+func newConcurrencyLimiter(maxConcurrent, maxQueued int, queueTimeout time.Duration) *concurrencyLimiter {
+	if maxConcurrent <= 0 {
+		maxConcurrent = DefaultMaxConcurrent
+	}
+	if maxQueued <= 0 {
+		maxQueued = DefaultMaxQueued
+	}
+	if queueTimeout <= 0 {
+		queueTimeout = DefaultQueueTimeout
+	}
+	return &concurrencyLimiter{
+		slots:        make(chan struct{}, maxConcurrent),
+		maxQueued:    maxQueued,
+		queueTimeout: queueTimeout,
+	}
+}
+
+// acquire takes a slot, waiting in the queue if none is free. The returned
+// release must be called when the call finishes.
+func (l *concurrencyLimiter) acquire(ctx context.Context) (func(), error) {
+	release := func() { <-l.slots }
+
+	select {
+	case l.slots <- struct{}{}:
+		return release, nil
+	default:
+	}
+
+	l.mu.Lock()
+	if l.queued >= l.maxQueued {
+		l.rejected++
+		l.mu.Unlock()
+		return nil, ErrQueueFull
+	}
+	l.queued++
+	l.mu.Unlock()
+	defer func() {
+		l.mu.Lock()
+		l.queued--
+		l.mu.Unlock()
+	}()
+
+	timer := time.NewTimer(l.queueTimeout)
+	defer timer.Stop()
+	select {
+	case l.slots <- struct{}{}:
+		return release, nil
+	case <-timer.C:
+		l.mu.Lock()
+		l.queueTimeouts++
+		l.mu.Unlock()
+		return nil, ErrQueueTimeout
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (l *concurrencyLimiter) stats() ConcurrencyStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return ConcurrencyStats{
+		MaxConcurrent: cap(l.slots),
+		MaxQueued:     l.maxQueued,
+		InFlight:      len(l.slots),
+		Queued:        l.queued,
+		Rejected:      l.rejected,
+		QueueTimeouts: l.queueTimeouts,
+	}
+}
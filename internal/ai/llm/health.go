@@ -0,0 +1,104 @@
+package llm
+
+import (
+	"errors"
+	"log"
+	"sync"
+	"time"
+)
+
+// Circuit breaker defaults for a flaky provider
+const (
+	DefaultFailureThreshold = 3
+	DefaultFailureCooldown  = 5 * time.Minute
+)
+
+// ErrProviderDegraded is returned without calling the provider while the
+// circuit breaker is open
+var ErrProviderDegraded = errors.New("LLM provider degraded, using rule-based fallback")
+
+// Health is a snapshot of the provider circuit breaker
+type Health struct {
+	Available           bool      `json:"available"`
+	Degraded            bool      `json:"degraded"`
+	ConsecutiveFailures int       `json:"consecutive_failures"`
+	LastError           string    `json:"last_error,omitempty"`
+	LastErrorTime       time.Time `json:"last_error_time,omitempty"`
+	LastSuccessTime     time.Time `json:"last_success_time,omitempty"`
+	DegradedUntil       time.Time `json:"degraded_until,omitempty"`
+}
+
+// providerHealth tracks consecutive provider failures. After threshold failures
+// in a row the provider is marked degraded for cooldown; the next call after the
+// cooldown is a trial that either recovers it or re-opens the breaker.
+type providerHealth struct {
+	mu                  sync.Mutex
+	threshold           int
+	cooldown            time.Duration
+	consecutiveFailures int
+	degradedUntil       time.Time
+	lastError           string
+	lastErrorTime       time.Time
+	lastSuccessTime     time.Time
+}
+
+func newProviderHealth(threshold int, cooldown time.Duration) *providerHealth {
+	if threshold <= 0 {
+		threshold = DefaultFailureThreshold
+	}
+	if cooldown <= 0 {
+		cooldown = DefaultFailureCooldown
+	}
+	return &providerHealth{threshold: threshold, cooldown: cooldown}
+}
+
+// allow reports whether a request may be sent to the provider
+func (h *providerHealth) allow() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return !time.Now().Before(h.degradedUntil)
+}
+
+// record updates the breaker with the outcome of a provider call
+func (h *providerHealth) record(provider Provider, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if err == nil {
+		if h.consecutiveFailures >= h.threshold {
+			log.Printf("[LLM] %s provider recovered after %d consecutive failures", provider, h.consecutiveFailures)
+		}
+		h.consecutiveFailures = 0
+		h.degradedUntil = time.Time{}
+		h.lastSuccessTime = time.Now()
+		return
+	}
+
+	h.consecutiveFailures++
+	h.lastError = err.Error()
+	h.lastErrorTime = time.Now()
+	if h.consecutiveFailures >= h.threshold {
+		h.degradedUntil = time.Now().Add(h.cooldown)
+		log.Printf("[LLM] %s provider DEGRADED after %d consecutive failures (last: %v) - falling back to rule-based analysis for %v",
+			provider, h.consecutiveFailures, err, h.cooldown)
+	}
+}
+
+func (h *providerHealth) snapshot(configured bool) Health {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	degraded := time.Now().Before(h.degradedUntil)
+	health := Health{
+		Available:           configured && !degraded,
+		Degraded:            degraded,
+		ConsecutiveFailures: h.consecutiveFailures,
+		LastError:           h.lastError,
+		LastErrorTime:       h.lastErrorTime,
+		LastSuccessTime:     h.lastSuccessTime,
+	}
+	if degraded {
+		health.DegradedUntil = h.degradedUntil
+	}
+	return health
+}
@@ -0,0 +1,163 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// maxStructuredAttempts is the initial request plus one retry on malformed output
+const maxStructuredAttempts = 2
+
+var trailingCommaRe = regexp.MustCompile(`,\s*([}\]])`)
+
+// RepairJSON turns a near-JSON LLM reply into a parseable object: strips
+// markdown fences and surrounding prose, and drops trailing commas
+func RepairJSON(raw string) string {
+	cleaned := stripMarkdownCodeBlock(raw)
+
+	if start := strings.Index(cleaned, "{"); start >= 0 {
+		if end := strings.LastIndex(cleaned, "}"); end > start {
+			cleaned = cleaned[start : end+1]
+		}
+	}
+
+	return trailingCommaRe.ReplaceAllString(cleaned, "$1")
+}
+
+// DecodeJSONResponse repairs and decodes an LLM reply into out, rejecting it
+// when any of the required top-level fields is missing or null
+func DecodeJSONResponse(raw string, out interface{}, required ...string) error {
+	cleaned := RepairJSON(raw)
+
+	if len(required) > 0 {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(cleaned), &fields); err != nil {
+			return fmt.Errorf("response is not a JSON object: %w", err)
+		}
+		for _, key := range required {
+			if v, ok := fields[key]; !ok || string(v) == "null" {
+				return fmt.Errorf("missing required field %q", key)
+			}
+		}
+	}
+
+	if err := json.Unmarshal([]byte(cleaned), out); err != nil {
+		return fmt.Errorf("failed to parse LLM response: %w", err)
+	}
+	return nil
+}
+
+// completeStructured requests a JSON-mode completion and decodes it into out,
+// retrying once when the reply is malformed or fails validate
+func (a *Analyzer) completeStructured(ctx context.Context, systemPrompt, prompt string, out interface{}, validate func() error, required ...string) error {
+	var lastErr error
+	for attempt := 1; attempt <= maxStructuredAttempts; attempt++ {
+		// Clear fields left over from a rejected attempt
+		reflect.ValueOf(out).Elem().Set(reflect.Zero(reflect.TypeOf(out).Elem()))
+
+		response, err := a.client.CompleteJSONContext(ctx, systemPrompt, prompt)
+		if err != nil {
+			return fmt.Errorf("LLM request failed: %w", err)
+		}
+
+		lastErr = DecodeJSONResponse(response, out, required...)
+		if lastErr == nil && validate != nil {
+			lastErr = validate()
+		}
+		if lastErr == nil {
+			return nil
+		}
+
+		log.Printf("[LLM] Malformed response (attempt %d/%d): %v | raw: %s",
+			attempt, maxStructuredAttempts, lastErr, response)
+	}
+	return fmt.Errorf("invalid LLM response after %d attempts: %w", maxStructuredAttempts, lastErr)
+}
+
+// Required top-level fields for structured responses
+var (
+	PositionSLTPRequiredFields = []string{"recommended_sl", "recommended_tp", "action", "confidence"}
+	AutoTradingRequiredFields  = []string{"trading_decisions"}
+)
+
+var (
+	validSLTPActions = map[string]bool{
+		"tighten_sl": true, "widen_sl": true, "move_to_breakeven": true,
+		"trail_stop": true, "hold_current": true, "close_now": true,
+	}
+	validSLTPUrgency = map[string]bool{"": true, "immediate": true, "normal": true, "hold": true}
+
+	validTradingActions = map[string]bool{
+		"open_long": true, "open_short": true, "close": true, "average_down": true,
+		"average_up": true, "take_profit": true, "hold": true, "skip": true,
+	}
+)
+
+func validFinite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+// Validate checks the SL/TP recommendation against the position it is for:
+// positive finite prices on the protective side of the current price
+func (p *PositionSLTPAnalysis) Validate(pos *PositionInfo) error {
+	if !validSLTPActions[p.Action] {
+		return fmt.Errorf("invalid action %q", p.Action)
+	}
+	if !validSLTPUrgency[p.Urgency] {
+		return fmt.Errorf("invalid urgency %q", p.Urgency)
+	}
+	if !validFinite(p.Confidence) || p.Confidence < 0 || p.Confidence > 1 {
+		return fmt.Errorf("confidence %.4f out of range 0-1", p.Confidence)
+	}
+	if !validFinite(p.RecommendedSL) || p.RecommendedSL <= 0 {
+		return fmt.Errorf("invalid recommended_sl %v", p.RecommendedSL)
+	}
+	if !validFinite(p.RecommendedTP) || p.RecommendedTP <= 0 {
+		return fmt.Errorf("invalid recommended_tp %v", p.RecommendedTP)
+	}
+
+	if pos == nil || pos.CurrentPrice <= 0 || p.Action == "close_now" {
+		return nil
+	}
+	isLong := strings.EqualFold(pos.Side, "LONG")
+	if isLong && (p.RecommendedSL >= pos.CurrentPrice || p.RecommendedTP <= pos.CurrentPrice) {
+		return fmt.Errorf("levels SL %.8f / TP %.8f on wrong side of price %.8f for LONG",
+			p.RecommendedSL, p.RecommendedTP, pos.CurrentPrice)
+	}
+	if !isLong && (p.RecommendedSL <= pos.CurrentPrice || p.RecommendedTP >= pos.CurrentPrice) {
+		return fmt.Errorf("levels SL %.8f / TP %.8f on wrong side of price %.8f for SHORT",
+			p.RecommendedSL, p.RecommendedTP, pos.CurrentPrice)
+	}
+	return nil
+}
+
+// Validate checks each trading decision for a known action and sane numbers
+func (d *AutoTradingDecision) Validate() error {
+	for i, td := range d.TradingDecisions {
+		if strings.TrimSpace(td.Symbol) == "" {
+			return fmt.Errorf("decision %d: missing symbol", i)
+		}
+		if !validTradingActions[td.Action] {
+			return fmt.Errorf("decision %d (%s): invalid action %q", i, td.Symbol, td.Action)
+		}
+		if !validFinite(td.Confidence) || td.Confidence < 0 || td.Confidence > 1 {
+			return fmt.Errorf("decision %d (%s): confidence %.4f out of range 0-1", i, td.Symbol, td.Confidence)
+		}
+		for name, v := range map[string]float64{
+			"position_size_usd":   td.PositionSizeUSD,
+			"stop_loss_percent":   td.StopLossPercent,
+			"take_profit_percent": td.TakeProfitPercent,
+		} {
+			if !validFinite(v) || v < 0 {
+				return fmt.Errorf("decision %d (%s): invalid %s %v", i, td.Symbol, name, v)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package llm
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// latencyWindow is how many recent calls the percentiles are computed over
+const latencyWindow = 200
+
+// LatencyStats summarises recent provider call latency
+type LatencyStats struct {
+	Provider      Provider `json:"provider"`
+	Calls         int64    `json:"calls"`
+	Timeouts      int64    `json:"timeouts"`
+	AvgLatencyMs  int64    `json:"avg_latency_ms"`
+	P95LatencyMs  int64    `json:"p95_latency_ms"`
+	LastLatencyMs int64    `json:"last_latency_ms"`
+}
+
+// latencyTracker keeps a ring of the most recent call durations
+type latencyTracker struct {
+	mu       sync.Mutex
+	samples  []time.Duration
+	next     int
+	calls    int64
+	timeouts int64
+	last     time.Duration
+}
+
+func (t *latencyTracker) record(d time.Duration, timedOut bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(t.samples) < latencyWindow {
+		t.samples = append(t.samples, d)
+	} else {
+		t.samples[t.next] = d
+		t.next = (t.next + 1) % latencyWindow
+	}
+	t.calls++
+	if timedOut {
+		t.timeouts++
+	}
+	t.last = d
+}
+
+func (t *latencyTracker) stats(provider Provider) LatencyStats {
+	t.mu.Lock()
+	sorted := make([]time.Duration, len(t.samples))
+	copy(sorted, t.samples)
+	stats := LatencyStats{
+		Provider:      provider,
+		Calls:         t.calls,
+		Timeouts:      t.timeouts,
+		LastLatencyMs: t.last.Milliseconds(),
+	}
+	t.mu.Unlock()
+
+	if len(sorted) == 0 {
+		return stats
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var total time.Duration
+	for _, d := range sorted {
+		total += d
+	}
+	stats.AvgLatencyMs = (total / time.Duration(len(sorted))).Milliseconds()
+	stats.P95LatencyMs = sorted[(len(sorted)*95+99)/100-1].Milliseconds()
+	return stats
+}
@@ -0,0 +1,98 @@
+package llm
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// Prompt template keys for AnalyzerConfig.PromptTemplates
+const (
+	PromptTypePattern   = "pattern"
+	PromptTypeRiskCheck = "risk-check"
+	PromptTypeBigCandle = "big-candle"
+	PromptTypeSLTP      = "sltp"
+)
+
+var knownPromptTypes = map[string]bool{
+	PromptTypePattern:   true,
+	PromptTypeRiskCheck: true,
+	PromptTypeBigCandle: true,
+	PromptTypeSLTP:      true,
+}
+
+// PromptContext is the market context available to prompt templates.
+// Only the fields relevant to an analysis type are filled:
+//   - pattern:    Symbol, Timeframe, KlineData
+//   - risk-check: TradeDetails, AccountInfo, MarketConditions
+//   - big-candle: Symbol, CandleData, ContextData
+//   - sltp:       Symbol, PositionInfo, KlineData, Indicators
+//
+// Templates only replace the user prompt; the system prompt (and with it the
+// required JSON response format) stays built in.
+type PromptContext struct {
+	Symbol           string
+	Timeframe        string
+	KlineData        string
+	Indicators       string
+	CandleData       string
+	ContextData      string
+	TradeDetails     string
+	AccountInfo      string
+	MarketConditions string
+	PositionInfo     string
+}
+
+// ParsePromptTemplates parses user prompt overrides keyed by analysis type.
+// Unknown keys, parse errors and templates that reference fields missing from
+// PromptContext are rejected. Empty templates are ignored.
+func ParsePromptTemplates(raw map[string]string) (map[string]*template.Template, error) {
+	parsed := make(map[string]*template.Template, len(raw))
+	var errs []string
+
+	for key, text := range raw {
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		if !knownPromptTypes[key] {
+			errs = append(errs, fmt.Sprintf("unknown prompt type %q", key))
+			continue
+		}
+		tmpl, err := template.New(key).Option("missingkey=error").Parse(text)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", key, err))
+			continue
+		}
+		// Dry run against an empty context so field typos fail at init, not mid-trade
+		if err := tmpl.Execute(&bytes.Buffer{}, PromptContext{}); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", key, err))
+			continue
+		}
+		parsed[key] = tmpl
+	}
+
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return parsed, fmt.Errorf("invalid prompt templates: %s", strings.Join(errs, "; "))
+	}
+	return parsed, nil
+}
+
+// renderPrompt renders the configured template for promptType, falling back to
+// the built-in prompt when none is set or rendering fails
+func (a *Analyzer) renderPrompt(promptType string, ctx PromptContext, builtin func() string) string {
+	tmpl, ok := a.promptTemplates[promptType]
+	if !ok {
+		return builtin()
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, ctx); err != nil {
+		log.Printf("[LLM] Prompt template %q failed, using built-in prompt: %v", promptType, err)
+		return builtin()
+	}
+	return buf.String()
+}
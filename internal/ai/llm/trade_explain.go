@@ -0,0 +1,175 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SystemPromptTradeReview asks for a retrospective on a closed trade
+const SystemPromptTradeReview = `You are an experienced cryptocurrency trading coach reviewing a CLOSED trade for the trader's journal.
+
+Explain in plain English why the trade was taken, how it played out, and what it teaches.
+Judge the decision process separately from the outcome: a losing trade can still be a good trade, and a winner can be a lucky one.
+Only refer to facts in the trade record. Do not invent prices, indicators or events.
+
+Your response must be in valid JSON format:
+{
+  "summary": "2-3 sentence plain-English retrospective",
+  "what_worked": ["short point", ...],
+  "what_didnt": ["short point", ...],
+  "lessons": ["actionable lesson", ...],
+  "verdict": "good_trade" | "good_process_bad_outcome" | "bad_process_good_outcome" | "bad_trade"
+}
+
+Keep each list to at most 3 points. Be concise and specific.`
+
+// TradeReviewRequiredFields are the fields a trade review response must have
+var TradeReviewRequiredFields = []string{"summary", "lessons", "verdict"}
+
+var validTradeVerdicts = map[string]bool{
+	"good_trade": true, "good_process_bad_outcome": true,
+	"bad_process_good_outcome": true, "bad_trade": true,
+}
+
+// TradeReview is a closed trade and what was recorded around it
+type TradeReview struct {
+	Symbol     string
+	Market     string // "futures" or "spot"
+	Side       string
+	Mode       string
+	Strategy   string
+	Source     string
+	EntryPrice float64
+	ExitPrice  float64
+	Quantity   float64
+	Leverage   int
+	StopLoss   float64 // 0 when none was set
+	TakeProfit float64
+	EntryTime  time.Time
+	ExitTime   time.Time
+	PnL        float64
+	PnLPercent float64
+	MAE        *float64 // Max adverse excursion %, when recorded
+	MFE        *float64 // Max favorable excursion %, when recorded
+	Tags       []string
+	Notes      string
+
+	// EntryContext describes the decision and market conditions at entry
+	EntryContext string
+	// Timeline lists what happened to the position, oldest first
+	Timeline []string
+}
+
+// TradeExplanation is the LLM's retrospective on a closed trade
+type TradeExplanation struct {
+	Summary    string   `json:"summary"`
+	WhatWorked []string `json:"what_worked"`
+	WhatDidnt  []string `json:"what_didnt"`
+	Lessons    []string `json:"lessons"`
+	Verdict    string   `json:"verdict"`
+	Provider   string   `json:"provider,omitempty"`
+	Model      string   `json:"model,omitempty"`
+}
+
+// Validate checks a trade review response
+func (e *TradeExplanation) Validate() error {
+	if strings.TrimSpace(e.Summary) == "" {
+		return fmt.Errorf("summary is empty")
+	}
+	if len(e.Lessons) == 0 {
+		return fmt.Errorf("no lessons given")
+	}
+	if !validTradeVerdicts[e.Verdict] {
+		return fmt.Errorf("invalid verdict %q", e.Verdict)
+	}
+	return nil
+}
+
+// BuildTradeReviewPrompt builds the prompt for a closed trade retrospective
+func BuildTradeReviewPrompt(t *TradeReview) string {
+	var b strings.Builder
+	b.WriteString("Review this closed trade:\n\n=== TRADE ===\n")
+	fmt.Fprintf(&b, "Symbol: %s (%s)\n", t.Symbol, t.Market)
+	fmt.Fprintf(&b, "Side: %s\n", t.Side)
+	if t.Leverage > 0 {
+		fmt.Fprintf(&b, "Leverage: %dx\n", t.Leverage)
+	}
+	if t.Mode != "" {
+		fmt.Fprintf(&b, "Trading Mode: %s\n", t.Mode)
+	}
+	if t.Strategy != "" {
+		fmt.Fprintf(&b, "Strategy: %s\n", t.Strategy)
+	}
+	if t.Source != "" {
+		fmt.Fprintf(&b, "Source: %s\n", t.Source)
+	}
+	fmt.Fprintf(&b, "Entry: %.8f at %s\n", t.EntryPrice, t.EntryTime.UTC().Format(time.RFC3339))
+	fmt.Fprintf(&b, "Exit: %.8f at %s\n", t.ExitPrice, t.ExitTime.UTC().Format(time.RFC3339))
+	fmt.Fprintf(&b, "Hold Duration: %s\n", t.ExitTime.Sub(t.EntryTime).Round(time.Second))
+	fmt.Fprintf(&b, "Quantity: %.6f\n", t.Quantity)
+	if t.StopLoss > 0 {
+		fmt.Fprintf(&b, "Stop Loss: %.8f\n", t.StopLoss)
+	}
+	if t.TakeProfit > 0 {
+		fmt.Fprintf(&b, "Take Profit: %.8f\n", t.TakeProfit)
+	}
+
+	b.WriteString("\n=== OUTCOME ===\n")
+	fmt.Fprintf(&b, "Realized P&L: $%.2f (%.2f%%)\n", t.PnL, t.PnLPercent)
+	if t.MAE != nil {
+		fmt.Fprintf(&b, "Max Adverse Excursion: %.2f%%\n", *t.MAE)
+	}
+	if t.MFE != nil {
+		fmt.Fprintf(&b, "Max Favorable Excursion: %.2f%%\n", *t.MFE)
+	}
+
+	b.WriteString("\n=== ENTRY CONTEXT ===\n")
+	if t.EntryContext != "" {
+		b.WriteString(t.EntryContext + "\n")
+	} else {
+		b.WriteString("No entry decision was recorded.\n")
+	}
+
+	if len(t.Timeline) > 0 {
+		b.WriteString("\n=== POSITION TIMELINE ===\n")
+		for _, event := range t.Timeline {
+			b.WriteString("- " + event + "\n")
+		}
+	}
+
+	if len(t.Tags) > 0 || t.Notes != "" {
+		b.WriteString("\n=== TRADER'S JOURNAL ===\n")
+		if len(t.Tags) > 0 {
+			fmt.Fprintf(&b, "Tags: %s\n", strings.Join(t.Tags, ", "))
+		}
+		if t.Notes != "" {
+			fmt.Fprintf(&b, "Notes: %s\n", t.Notes)
+		}
+	}
+
+	b.WriteString("\nProvide your retrospective in the specified JSON format.")
+	return b.String()
+}
+
+// ExplainTrade asks the LLM for a plain-English retrospective on a closed trade
+func (a *Analyzer) ExplainTrade(ctx context.Context, trade *TradeReview) (*TradeExplanation, error) {
+	if !a.config.Enabled || !a.client.IsConfigured() {
+		return nil, fmt.Errorf("LLM analyzer not enabled")
+	}
+
+	if !a.checkRateLimit() {
+		return nil, fmt.Errorf("rate limit exceeded")
+	}
+
+	var explanation TradeExplanation
+	if err := a.completeStructured(ctx, SystemPromptTradeReview, BuildTradeReviewPrompt(trade), &explanation,
+		explanation.Validate, TradeReviewRequiredFields...); err != nil {
+		return nil, err
+	}
+
+	explanation.Provider = string(a.client.config.Provider)
+	explanation.Model = a.client.config.Model
+	return &explanation, nil
+}
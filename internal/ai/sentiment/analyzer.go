@@ -1,6 +1,7 @@
 package sentiment
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -17,6 +18,7 @@ type SentimentConfig struct {
 	CryptoNewsAPIKey  string        `json:"cryptonews_api_key"` // CryptoNews API key from cryptonews-api.com
 	UpdateInterval    time.Duration `json:"update_interval"`
 	SentimentWeight   float64       `json:"sentiment_weight"` // Weight in overall decision
+	SourceWeights     map[string]float64 `json:"source_weights,omitempty"` // Per-source weight overrides by source name (0 = ignore the source)
 }
 
 // DefaultSentimentConfig returns default configuration
@@ -39,6 +41,7 @@ type SentimentScore struct {
 	TrendScore     float64   `json:"trend_score"`      // Market trend sentiment
 	UpdatedAt      time.Time `json:"updated_at"`
 	Sources        []string  `json:"sources"`
+	SourceScores   map[string]SourceScore `json:"source_scores"` // Every registered source, including ones that failed this update
 }
 
 // FearGreedResponse from alternative.me API
@@ -70,6 +73,8 @@ type Analyzer struct {
 	httpClient  *http.Client
 	lastScore   *SentimentScore
 	newsCache   []NewsItem
+	sources     []SentimentSource
+	symbolCache map[string]*SymbolSentiment
 	mu          sync.RWMutex
 	stopChan    chan struct{}
 }
@@ -79,14 +84,59 @@ func NewAnalyzer(config *SentimentConfig) *Analyzer {
 	if config == nil {
 		config = DefaultSentimentConfig()
 	}
-	return &Analyzer{
+	a := &Analyzer{
 		config: config,
 		httpClient: &http.Client{
 			Timeout: 10 * time.Second,
 		},
-		newsCache: make([]NewsItem, 0),
-		stopChan:  make(chan struct{}),
+		newsCache:   make([]NewsItem, 0),
+		symbolCache: make(map[string]*SymbolSentiment),
+		stopChan:    make(chan struct{}),
 	}
+
+	// Built-in sources
+	if config.FearGreedEnabled {
+		a.AddSource(&fearGreedSource{analyzer: a})
+	}
+	if config.NewsEnabled && config.CryptoNewsAPIKey != "" {
+		a.AddSource(&cryptoNewsSource{analyzer: a})
+	}
+	return a
+}
+
+// AddSource registers a sentiment source, replacing any source with the same name.
+// It's included from the next update.
+func (a *Analyzer) AddSource(source SentimentSource) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for i, existing := range a.sources {
+		if existing.Name() == source.Name() {
+			a.sources[i] = source
+			return
+		}
+	}
+	a.sources = append(a.sources, source)
+}
+
+// GetSources returns the names of the registered sentiment sources
+func (a *Analyzer) GetSources() []string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	names := make([]string, 0, len(a.sources))
+	for _, source := range a.sources {
+		names = append(names, source.Name())
+	}
+	return names
+}
+
+// sourceWeight returns the configured weight for a source, or its own default
+func (a *Analyzer) sourceWeight(source SentimentSource) float64 {
+	if w, ok := a.config.SourceWeights[source.Name()]; ok {
+		return w
+	}
+	return source.Weight()
 }
 
 // Start begins the sentiment analysis background updates
@@ -160,56 +210,57 @@ func (a *Analyzer) ShouldAvoidTrading() (bool, string) {
 	return false, ""
 }
 
-// updateSentiment fetches and updates sentiment data
+// updateSentiment fetches every registered source and updates the aggregate score
 func (a *Analyzer) updateSentiment() {
-	var wg sync.WaitGroup
-	var fearGreedIndex int
-	var fearGreedLabel string
-	var newsScore float64
-	sources := make([]string, 0)
+	a.mu.RLock()
+	sources := append([]SentimentSource(nil), a.sources...)
+	a.mu.RUnlock()
 
-	// Fetch Fear & Greed Index
-	if a.config.FearGreedEnabled {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			idx, label, err := a.fetchFearGreedIndex()
-			if err == nil {
-				fearGreedIndex = idx
-				fearGreedLabel = label
-				sources = append(sources, "fear_greed_index")
-			}
-		}()
-	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var scoresMu sync.Mutex
+	sourceScores := make(map[string]SourceScore, len(sources))
 
-	// Fetch news sentiment from CryptoNews API
-	if a.config.NewsEnabled && a.config.CryptoNewsAPIKey != "" {
+	for _, source := range sources {
 		wg.Add(1)
-		go func() {
+		go func(source SentimentSource) {
 			defer wg.Done()
-			news, err := a.fetchCryptoNews()
-			if err == nil && len(news) > 0 {
-				newsScore = calculateNewsScore(news)
-				sources = append(sources, "cryptonews_api")
-				a.mu.Lock()
-				a.newsCache = news
-				a.mu.Unlock()
+			score := SourceScore{Weight: a.sourceWeight(source)}
+			reading, err := source.Fetch(ctx)
+			if err != nil {
+				score.Error = err.Error()
+			} else if reading != nil {
+				score.SourceReading = *reading
 			}
-		}()
+			scoresMu.Lock()
+			sourceScores[source.Name()] = score
+			scoresMu.Unlock()
+		}(source)
 	}
 
 	wg.Wait()
 
-	// Calculate overall sentiment
-	overall := a.calculateOverallSentiment(fearGreedIndex, newsScore)
-
 	score := &SentimentScore{
-		Overall:        overall,
-		FearGreedIndex: fearGreedIndex,
-		FearGreedLabel: fearGreedLabel,
-		NewsScore:      newsScore,
-		UpdatedAt:      time.Now(),
-		Sources:        sources,
+		Overall:      aggregateSourceScores(sourceScores),
+		UpdatedAt:    time.Now(),
+		Sources:      make([]string, 0, len(sourceScores)),
+		SourceScores: sourceScores,
+	}
+	for _, source := range sources {
+		s := sourceScores[source.Name()]
+		if s.Error != "" {
+			continue
+		}
+		score.Sources = append(score.Sources, source.Name())
+		switch source.Name() {
+		case SourceFearGreed:
+			score.FearGreedIndex = int(s.Value)
+			score.FearGreedLabel = s.Label
+		case SourceCryptoNews:
+			score.NewsScore = s.Score
+		}
 	}
 
 	a.mu.Lock()
@@ -392,18 +443,30 @@ func calculateNewsScore(news []NewsItem) float64 {
 	return weightedSum / totalWeight
 }
 
-// calculateOverallSentiment aggregates all sentiment sources
-func (a *Analyzer) calculateOverallSentiment(fearGreedIndex int, newsScore float64) float64 {
-	// Convert fear/greed (0-100) to -1 to +1 scale
-	fgNormalized := (float64(fearGreedIndex) - 50) / 50
-
-	// If we have multiple sources, weight them
-	if newsScore != 0 {
-		// 70% fear/greed, 30% news
-		return fgNormalized*0.7 + newsScore*0.3
+// aggregateSourceScores is the weighted average of the sources that returned
+// a reading, clamped to -1..+1
+func aggregateSourceScores(scores map[string]SourceScore) float64 {
+	totalWeight := 0.0
+	weightedSum := 0.0
+	for _, s := range scores {
+		if s.Error != "" || s.Weight <= 0 {
+			continue
+		}
+		weightedSum += s.Score * s.Weight
+		totalWeight += s.Weight
+	}
+	if totalWeight == 0 {
+		return 0
 	}
 
-	return fgNormalized
+	overall := weightedSum / totalWeight
+	if overall > 1 {
+		return 1
+	}
+	if overall < -1 {
+		return -1
+	}
+	return overall
 }
 
 // GetRecentNews returns recent news items
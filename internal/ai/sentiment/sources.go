@@ -0,0 +1,110 @@
+package sentiment
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Built-in source names
+const (
+	SourceFearGreed  = "fear_greed_index"
+	SourceCryptoNews = "cryptonews_api"
+)
+
+// SentimentSource is one input to the aggregate sentiment score. Register
+// additional sources (Reddit, X, LunarCrush, on-chain metrics...) with
+// Analyzer.AddSource; each contributes Score weighted by its weight.
+type SentimentSource interface {
+	// Name identifies the source in SentimentScore.SourceScores and SourceWeights
+	Name() string
+	// Weight is the source's default weight in the aggregate, overridable by
+	// SentimentConfig.SourceWeights
+	Weight() float64
+	// Fetch returns the source's current reading. A source that errors is left
+	// out of the aggregate for that update.
+	Fetch(ctx context.Context) (*SourceReading, error)
+}
+
+// SourceReading is a source's sentiment at fetch time
+type SourceReading struct {
+	Score float64 `json:"score"`           // -1 (bearish) to +1 (bullish)
+	Value float64 `json:"value,omitempty"` // Raw source value, e.g. the 0-100 Fear & Greed index
+	Label string  `json:"label,omitempty"` // Source's own classification, e.g. "Extreme Fear"
+}
+
+// SourceScore is a source's contribution to the last SentimentScore
+type SourceScore struct {
+	SourceReading
+	Weight float64 `json:"weight"`
+	Error  string  `json:"error,omitempty"`
+}
+
+// fearGreedSource is the alternative.me Fear & Greed index
+type fearGreedSource struct {
+	analyzer *Analyzer
+}
+
+func (s *fearGreedSource) Name() string    { return SourceFearGreed }
+func (s *fearGreedSource) Weight() float64 { return 0.7 }
+
+func (s *fearGreedSource) Fetch(ctx context.Context) (*SourceReading, error) {
+	idx, label, err := s.analyzer.fetchFearGreedIndex()
+	if err != nil {
+		return nil, err
+	}
+	return &SourceReading{
+		Score: (float64(idx) - 50) / 50, // 0-100 to -1..+1
+		Value: float64(idx),
+		Label: label,
+	}, nil
+}
+
+// cryptoNewsSource is news sentiment from cryptonews-api.com. It also fills the
+// analyzer's news cache used by GetRecentNews and friends.
+type cryptoNewsSource struct {
+	analyzer *Analyzer
+}
+
+func (s *cryptoNewsSource) Name() string    { return SourceCryptoNews }
+func (s *cryptoNewsSource) Weight() float64 { return 0.3 }
+
+func (s *cryptoNewsSource) Fetch(ctx context.Context) (*SourceReading, error) {
+	news, err := s.analyzer.fetchCryptoNews()
+	if err != nil {
+		return nil, err
+	}
+	if len(news) == 0 {
+		return nil, fmt.Errorf("no news items")
+	}
+
+	s.analyzer.mu.Lock()
+	s.analyzer.newsCache = news
+	s.analyzer.symbolCache = make(map[string]*SymbolSentiment) // Rescore coins against the new news
+	s.analyzer.mu.Unlock()
+
+	return &SourceReading{Score: calculateNewsScore(news), Value: float64(len(news))}, nil
+}
+
+// ParseSourceWeights parses "name=weight" pairs separated by commas, e.g.
+// "fear_greed_index=0.5,cryptonews_api=0.2,reddit=0.3"
+func ParseSourceWeights(s string) (map[string]float64, error) {
+	weights := make(map[string]float64)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid source weight %q, want name=weight", pair)
+		}
+		weight, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || weight < 0 {
+			return nil, fmt.Errorf("invalid weight for source %q: %q", name, value)
+		}
+		weights[strings.TrimSpace(name)] = weight
+	}
+	return weights, nil
+}
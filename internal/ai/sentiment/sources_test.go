@@ -0,0 +1,77 @@
+package sentiment
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+)
+
+type fakeSource struct {
+	name    string
+	weight  float64
+	reading *SourceReading
+	err     error
+}
+
+func (f *fakeSource) Name() string    { return f.name }
+func (f *fakeSource) Weight() float64 { return f.weight }
+func (f *fakeSource) Fetch(ctx context.Context) (*SourceReading, error) {
+	return f.reading, f.err
+}
+
+func TestAnalyzerAggregatesWeightedSources(t *testing.T) {
+	a := NewAnalyzer(&SentimentConfig{
+		Enabled:       true,
+		SourceWeights: map[string]float64{"reddit": 3},
+	})
+	a.AddSource(&fakeSource{name: SourceFearGreed, weight: 1, reading: &SourceReading{Score: -0.5, Value: 25, Label: "Fear"}})
+	a.AddSource(&fakeSource{name: "reddit", weight: 1, reading: &SourceReading{Score: 0.5}})
+	a.AddSource(&fakeSource{name: "lunarcrush", weight: 5, err: errors.New("rate limited")})
+
+	a.updateSentiment()
+	score := a.GetSentiment()
+	if score == nil {
+		t.Fatal("no sentiment after update")
+	}
+
+	// (-0.5*1 + 0.5*3) / 4; the failed source is left out
+	if math.Abs(score.Overall-0.25) > 1e-9 {
+		t.Errorf("Overall = %v, want 0.25", score.Overall)
+	}
+	if score.FearGreedIndex != 25 || score.FearGreedLabel != "Fear" {
+		t.Errorf("fear/greed = %d %q, want 25 Fear", score.FearGreedIndex, score.FearGreedLabel)
+	}
+	if len(score.Sources) != 2 {
+		t.Errorf("Sources = %v, want the two that succeeded", score.Sources)
+	}
+	if s := score.SourceScores["reddit"]; s.Weight != 3 || s.Score != 0.5 {
+		t.Errorf("reddit source score = %+v, want weight override 3", s)
+	}
+	if s := score.SourceScores["lunarcrush"]; s.Error == "" {
+		t.Errorf("lunarcrush source score = %+v, want error recorded", s)
+	}
+}
+
+func TestAddSourceReplacesByName(t *testing.T) {
+	a := NewAnalyzer(&SentimentConfig{Enabled: true, FearGreedEnabled: true})
+	a.AddSource(&fakeSource{name: SourceFearGreed, weight: 1})
+	if got := a.GetSources(); len(got) != 1 || got[0] != SourceFearGreed {
+		t.Errorf("GetSources = %v, want the replaced built-in only", got)
+	}
+}
+
+func TestParseSourceWeights(t *testing.T) {
+	got, err := ParseSourceWeights(" fear_greed_index=0.5, reddit=0.25 ,")
+	if err != nil {
+		t.Fatalf("ParseSourceWeights: %v", err)
+	}
+	if len(got) != 2 || got["fear_greed_index"] != 0.5 || got["reddit"] != 0.25 {
+		t.Errorf("ParseSourceWeights = %v", got)
+	}
+	for _, bad := range []string{"reddit", "reddit=x", "reddit=-1"} {
+		if _, err := ParseSourceWeights(bad); err == nil {
+			t.Errorf("ParseSourceWeights(%q) succeeded, want error", bad)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package sentiment
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// symbolSentimentTTL is how long a per-symbol result is reused before it's recomputed
+const symbolSentimentTTL = 5 * time.Minute
+
+// SymbolSentimentSource is implemented by sources that can score a single coin
+// (e.g. social mentions). Registered sources that implement it contribute to
+// GetSymbolSentiment alongside the news cache.
+type SymbolSentimentSource interface {
+	SentimentSource
+	// FetchSymbol returns the source's reading for a base asset such as "BTC"
+	FetchSymbol(ctx context.Context, ticker string) (*SourceReading, error)
+}
+
+// SymbolSentiment is the sentiment for one coin
+type SymbolSentiment struct {
+	Symbol       string                 `json:"symbol"`
+	Ticker       string                 `json:"ticker"`         // Base asset the news is matched on, e.g. "PEPE" for 1000PEPEUSDT
+	Score        float64                `json:"score"`          // -1 (bearish) to +1 (bullish); 0 with no data
+	NewsCount    int                    `json:"news_count"`     // Cached news items mentioning the coin
+	NewsScore    float64                `json:"news_score"`     // Recency-weighted news sentiment
+	LatestNewsAt time.Time              `json:"latest_news_at"` // Zero when no news mentions the coin
+	SourceScores map[string]SourceScore `json:"source_scores,omitempty"`
+	UpdatedAt    time.Time              `json:"updated_at"`
+}
+
+// HasData reports whether any news or symbol source contributed to the score
+func (s *SymbolSentiment) HasData() bool {
+	if s == nil {
+		return false
+	}
+	if s.NewsCount > 0 {
+		return true
+	}
+	for _, score := range s.SourceScores {
+		if score.Error == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Freshness is how old the newest news item mentioning the coin is
+// (0 when there is none)
+func (s *SymbolSentiment) Freshness() time.Duration {
+	if s == nil || s.LatestNewsAt.IsZero() {
+		return 0
+	}
+	return time.Since(s.LatestNewsAt)
+}
+
+// SymbolTicker returns the base asset news is tagged with for a futures symbol:
+// BTCUSDT -> BTC, 1000PEPEUSDT -> PEPE
+func SymbolTicker(symbol string) string {
+	ticker := strings.ToUpper(symbol)
+	for _, quote := range []string{"USDT", "USDC", "FDUSD", "BUSD"} {
+		if strings.HasSuffix(ticker, quote) && len(ticker) > len(quote) {
+			ticker = strings.TrimSuffix(ticker, quote)
+			break
+		}
+	}
+	for _, multiplier := range []string{"1000000", "1000", "1M"} {
+		if strings.HasPrefix(ticker, multiplier) && len(ticker) > len(multiplier) {
+			return strings.TrimPrefix(ticker, multiplier)
+		}
+	}
+	return ticker
+}
+
+// GetSymbolSentiment returns the sentiment for one coin from the news that
+// mentions it plus any registered SymbolSentimentSource. Results are cached for
+// symbolSentimentTTL. Returns nil when the analyzer is disabled.
+func (a *Analyzer) GetSymbolSentiment(symbol string) *SymbolSentiment {
+	if !a.config.Enabled {
+		return nil
+	}
+	symbol = strings.ToUpper(symbol)
+
+	a.mu.RLock()
+	cached := a.symbolCache[symbol]
+	a.mu.RUnlock()
+	if cached != nil && time.Since(cached.UpdatedAt) < symbolSentimentTTL {
+		return cached
+	}
+
+	result := a.computeSymbolSentiment(symbol)
+
+	a.mu.Lock()
+	a.symbolCache[symbol] = result
+	a.mu.Unlock()
+	return result
+}
+
+// computeSymbolSentiment scores a symbol from the news cache and symbol sources
+func (a *Analyzer) computeSymbolSentiment(symbol string) *SymbolSentiment {
+	ticker := SymbolTicker(symbol)
+	result := &SymbolSentiment{
+		Symbol:    symbol,
+		Ticker:    ticker,
+		UpdatedAt: time.Now(),
+	}
+
+	a.mu.RLock()
+	var news []NewsItem
+	for _, item := range a.newsCache {
+		for _, t := range item.Tickers {
+			if strings.EqualFold(t, ticker) {
+				news = append(news, item)
+				break
+			}
+		}
+	}
+	var symbolSources []SymbolSentimentSource
+	for _, source := range a.sources {
+		if s, ok := source.(SymbolSentimentSource); ok {
+			symbolSources = append(symbolSources, s)
+		}
+	}
+	a.mu.RUnlock()
+
+	scores := make(map[string]SourceScore)
+	if len(news) > 0 {
+		result.NewsCount = len(news)
+		result.NewsScore = calculateNewsScore(news)
+		for _, item := range news {
+			if item.PublishedAt.After(result.LatestNewsAt) {
+				result.LatestNewsAt = item.PublishedAt
+			}
+		}
+		weight := 1.0
+		if w, ok := a.config.SourceWeights[SourceCryptoNews]; ok {
+			weight = w
+		}
+		scores[SourceCryptoNews] = SourceScore{
+			SourceReading: SourceReading{Score: result.NewsScore, Value: float64(len(news))},
+			Weight:        weight,
+		}
+	}
+
+	if len(symbolSources) > 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		for _, source := range symbolSources {
+			score := SourceScore{Weight: a.sourceWeight(source)}
+			reading, err := source.FetchSymbol(ctx, ticker)
+			if err != nil {
+				score.Error = err.Error()
+			} else if reading != nil {
+				score.SourceReading = *reading
+			}
+			scores[source.Name()] = score
+		}
+	}
+
+	if len(scores) > 0 {
+		result.SourceScores = scores
+		result.Score = aggregateSourceScores(scores)
+	}
+	return result
+}
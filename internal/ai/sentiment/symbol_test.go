@@ -0,0 +1,69 @@
+package sentiment
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type fakeSymbolSource struct {
+	fakeSource
+	byTicker map[string]float64
+	calls    int
+}
+
+func (f *fakeSymbolSource) FetchSymbol(ctx context.Context, ticker string) (*SourceReading, error) {
+	f.calls++
+	return &SourceReading{Score: f.byTicker[ticker]}, nil
+}
+
+func TestSymbolTicker(t *testing.T) {
+	for symbol, want := range map[string]string{
+		"BTCUSDT":        "BTC",
+		"ethusdc":        "ETH",
+		"1000PEPEUSDT":   "PEPE",
+		"1000000MOGUSDT": "MOG",
+		"USDT":           "USDT",
+	} {
+		if got := SymbolTicker(symbol); got != want {
+			t.Errorf("SymbolTicker(%q) = %q, want %q", symbol, got, want)
+		}
+	}
+}
+
+func TestGetSymbolSentiment(t *testing.T) {
+	a := NewAnalyzer(&SentimentConfig{Enabled: true})
+	now := time.Now()
+	a.newsCache = []NewsItem{
+		{Title: "PEPE rallies", Sentiment: 0.7, PublishedAt: now.Add(-30 * time.Minute), Tickers: []string{"PEPE"}},
+		{Title: "PEPE listing", Sentiment: 0.7, PublishedAt: now.Add(-2 * time.Hour), Tickers: []string{"PEPE", "DOGE"}},
+		{Title: "BTC dips", Sentiment: -0.7, PublishedAt: now, Tickers: []string{"BTC"}},
+	}
+	social := &fakeSymbolSource{fakeSource: fakeSource{name: "social", weight: 1}, byTicker: map[string]float64{"PEPE": -0.1}}
+	a.AddSource(social)
+
+	s := a.GetSymbolSentiment("1000PEPEUSDT")
+	if s == nil || !s.HasData() {
+		t.Fatalf("GetSymbolSentiment = %+v, want data", s)
+	}
+	if s.Ticker != "PEPE" || s.NewsCount != 2 || s.NewsScore != 0.7 {
+		t.Errorf("ticker=%s news=%d newsScore=%v, want PEPE 2 0.7", s.Ticker, s.NewsCount, s.NewsScore)
+	}
+	// News (weight 1) at 0.7 and the social source (weight 1) at -0.1
+	if s.Score < 0.29 || s.Score > 0.31 {
+		t.Errorf("Score = %v, want 0.3", s.Score)
+	}
+	if f := s.Freshness(); f < 29*time.Minute || f > 31*time.Minute {
+		t.Errorf("Freshness = %v, want ~30m", f)
+	}
+
+	// Served from the cache within the TTL
+	a.GetSymbolSentiment("1000pepeusdt")
+	if social.calls != 1 {
+		t.Errorf("symbol source called %d times, want 1 (cached)", social.calls)
+	}
+
+	if none := a.GetSymbolSentiment("XRPUSDT"); none.NewsCount != 0 || none.Freshness() != 0 {
+		t.Errorf("XRPUSDT = %+v, want no news", none)
+	}
+}
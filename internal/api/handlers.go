@@ -1,8 +1,10 @@
 package api
 
 import (
+	"binance-trading-bot/internal/autopilot"
 	"binance-trading-bot/internal/database"
 	"binance-trading-bot/internal/scanner"
+	"binance-trading-bot/internal/screener"
 	"context"
 	"fmt"
 	"log"
@@ -67,8 +69,14 @@ func (s *Server) handleGetPositionHistory(c *gin.Context) {
 	// Check if AI decision details should be included
 	includeAI := c.Query("include_ai") == "true"
 
-	// Get closed trades from database (user-scoped)
-	trades, err := s.repo.GetTradeHistoryForUser(ctx, userID, limit, offset)
+	// Get closed trades from database (user-scoped), or tagged trades for the journal
+	var trades []*database.Trade
+	var err error
+	if tag := journalTagQuery(c); tag != "" {
+		trades, err = s.repo.GetTradesByTag(ctx, s.tradeScope(c), tag, limit, offset)
+	} else {
+		trades, err = s.repo.GetTradeHistoryForUser(ctx, userID, limit, offset)
+	}
 	if err != nil {
 		errorResponse(c, http.StatusInternalServerError, "Failed to fetch position history")
 		return
@@ -353,6 +361,17 @@ func (s *Server) handleGetScreenerResults(c *gin.Context) {
 	successResponse(c, results)
 }
 
+// handleGetScreenerStats returns the load and yield of the last screening cycle
+func (s *Server) handleGetScreenerStats(c *gin.Context) {
+	sc, ok := s.botAPI.GetScreener().(*screener.Screener)
+	if !ok || sc == nil {
+		errorResponse(c, http.StatusServiceUnavailable, "Market screener not available")
+		return
+	}
+
+	successResponse(c, sc.GetStats())
+}
+
 // ============================================================================
 // METRICS HANDLERS
 // ============================================================================
@@ -367,9 +386,9 @@ func (s *Server) handleGetMetrics(c *gin.Context) {
 
 	if s.authEnabled {
 		userID := s.getUserID(c)
-		metrics, err = s.repo.GetTradingMetricsForUser(ctx, userID)
+		metrics, err = s.repo.ReadOnly().GetTradingMetricsForUser(ctx, userID)
 	} else {
-		metrics, err = s.repo.GetTradingMetrics(ctx)
+		metrics, err = s.repo.ReadOnly().GetTradingMetrics(ctx)
 	}
 
 	if err != nil {
@@ -380,6 +399,20 @@ func (s *Server) handleGetMetrics(c *gin.Context) {
 	successResponse(c, metrics)
 }
 
+// handleGetDBPoolMetrics returns database connection pool usage
+func (s *Server) handleGetDBPoolMetrics(c *gin.Context) {
+	successResponse(c, s.repo.PoolStats())
+}
+
+// handleGetDeadLetterMetrics returns the depth of the failed event persistence queue
+func (s *Server) handleGetDeadLetterMetrics(c *gin.Context) {
+	if s.deadLetters == nil {
+		errorResponse(c, http.StatusServiceUnavailable, "Dead-letter queue not enabled")
+		return
+	}
+	successResponse(c, s.deadLetters.Stats())
+}
+
 // ============================================================================
 // SYSTEM EVENT HANDLERS
 // ============================================================================
@@ -410,16 +443,17 @@ func (s *Server) handleGetSystemEvents(c *gin.Context) {
 
 // CreateStrategyConfigRequest represents a request to create a strategy configuration
 type CreateStrategyConfigRequest struct {
-	Name              string                 `json:"name" binding:"required"`
-	Symbol            string                 `json:"symbol" binding:"required"`
-	Timeframe         string                 `json:"timeframe" binding:"required"`
-	IndicatorType     string                 `json:"indicator_type" binding:"required"`
-	Autopilot         bool                   `json:"autopilot"`
-	Enabled           bool                   `json:"enabled"`
-	PositionSize      float64                `json:"position_size" binding:"required,gt=0"`
-	StopLossPercent   float64                `json:"stop_loss_percent" binding:"required,gt=0"`
-	TakeProfitPercent float64                `json:"take_profit_percent" binding:"required,gt=0"`
-	ConfigParams      map[string]interface{} `json:"config_params"`
+	Name              string                    `json:"name" binding:"required"`
+	Symbol            string                    `json:"symbol" binding:"required"`
+	Timeframe         string                    `json:"timeframe" binding:"required"`
+	IndicatorType     string                    `json:"indicator_type" binding:"required"`
+	Autopilot         bool                      `json:"autopilot"`
+	Enabled           bool                      `json:"enabled"`
+	PositionSize      float64                   `json:"position_size" binding:"required,gt=0"`
+	StopLossPercent   float64                   `json:"stop_loss_percent" binding:"required,gt=0"`
+	TakeProfitPercent float64                   `json:"take_profit_percent" binding:"required,gt=0"`
+	ConfigParams      map[string]interface{}    `json:"config_params"`
+	Schedule          *database.TradingSchedule `json:"schedule"`
 }
 
 // handleCreateStrategyConfig creates a new strategy configuration
@@ -431,6 +465,10 @@ func (s *Server) handleCreateStrategyConfig(c *gin.Context) {
 		errorResponse(c, http.StatusBadRequest, "Invalid request: "+err.Error())
 		return
 	}
+	if err := req.Schedule.Validate(); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Invalid schedule: "+err.Error())
+		return
+	}
 
 	config := &database.StrategyConfig{
 		Name:              req.Name,
@@ -443,6 +481,7 @@ func (s *Server) handleCreateStrategyConfig(c *gin.Context) {
 		StopLossPercent:   req.StopLossPercent,
 		TakeProfitPercent: req.TakeProfitPercent,
 		ConfigParams:      req.ConfigParams,
+		Schedule:          req.Schedule,
 	}
 
 	if err := s.repo.CreateStrategyConfig(ctx, config); err != nil {
@@ -488,15 +527,16 @@ func (s *Server) handleGetStrategyConfig(c *gin.Context) {
 
 // UpdateStrategyConfigRequest represents a request to update a strategy configuration
 type UpdateStrategyConfigRequest struct {
-	Symbol            string                 `json:"symbol"`
-	Timeframe         string                 `json:"timeframe"`
-	IndicatorType     string                 `json:"indicator_type"`
-	Autopilot         *bool                  `json:"autopilot"`
-	Enabled           *bool                  `json:"enabled"`
-	PositionSize      *float64               `json:"position_size"`
-	StopLossPercent   *float64               `json:"stop_loss_percent"`
-	TakeProfitPercent *float64               `json:"take_profit_percent"`
-	ConfigParams      map[string]interface{} `json:"config_params"`
+	Symbol            string                    `json:"symbol"`
+	Timeframe         string                    `json:"timeframe"`
+	IndicatorType     string                    `json:"indicator_type"`
+	Autopilot         *bool                     `json:"autopilot"`
+	Enabled           *bool                     `json:"enabled"`
+	PositionSize      *float64                  `json:"position_size"`
+	StopLossPercent   *float64                  `json:"stop_loss_percent"`
+	TakeProfitPercent *float64                  `json:"take_profit_percent"`
+	ConfigParams      map[string]interface{}    `json:"config_params"`
+	Schedule          *database.TradingSchedule `json:"schedule"` // Empty object clears the schedule
 }
 
 // handleUpdateStrategyConfig updates a strategy configuration
@@ -551,6 +591,16 @@ func (s *Server) handleUpdateStrategyConfig(c *gin.Context) {
 	if req.ConfigParams != nil {
 		config.ConfigParams = req.ConfigParams
 	}
+	if req.Schedule != nil {
+		if err := req.Schedule.Validate(); err != nil {
+			errorResponse(c, http.StatusBadRequest, "Invalid schedule: "+err.Error())
+			return
+		}
+		config.Schedule = req.Schedule
+		if len(req.Schedule.TradingHours) == 0 && len(req.Schedule.TradingDays) == 0 {
+			config.Schedule = nil
+		}
+	}
 
 	if err := s.repo.UpdateStrategyConfig(ctx, config); err != nil {
 		errorResponse(c, http.StatusInternalServerError, "Failed to update strategy config: "+err.Error())
@@ -579,6 +629,76 @@ func (s *Server) handleDeleteStrategyConfig(c *gin.Context) {
 	successResponse(c, gin.H{"message": "Strategy config deleted successfully"})
 }
 
+// EvaluateStrategyRequest is an unsaved strategy config to dry-run
+type EvaluateStrategyRequest struct {
+	Name              string                 `json:"name"`
+	Symbol            string                 `json:"symbol"`
+	Symbols           []string               `json:"symbols"` // Overrides symbol when set
+	Timeframe         string                 `json:"timeframe" binding:"required"`
+	IndicatorType     string                 `json:"indicator_type"`
+	PositionSize      float64                `json:"position_size"`
+	StopLossPercent   float64                `json:"stop_loss_percent" binding:"required,gt=0"`
+	TakeProfitPercent float64                `json:"take_profit_percent" binding:"required,gt=0"`
+	ConfigParams      map[string]interface{} `json:"config_params"`
+}
+
+// maxEvaluateSymbols bounds the kline fetches one evaluate request can trigger
+const maxEvaluateSymbols = 20
+
+// handleEvaluateStrategy runs a strategy config against current market data and
+// returns the signal it would generate right now, without saving or trading
+// POST /api/strategies/evaluate
+func (s *Server) handleEvaluateStrategy(c *gin.Context) {
+	var req EvaluateStrategyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+	if req.Symbol == "" && len(req.Symbols) == 0 {
+		errorResponse(c, http.StatusBadRequest, "symbol or symbols is required")
+		return
+	}
+	if len(req.Symbols) > maxEvaluateSymbols {
+		errorResponse(c, http.StatusBadRequest, fmt.Sprintf("at most %d symbols per evaluation", maxEvaluateSymbols))
+		return
+	}
+	if _, hasFlow := req.ConfigParams["visual_flow"]; req.IndicatorType == "" && !hasFlow {
+		errorResponse(c, http.StatusBadRequest, "indicator_type or config_params.visual_flow is required")
+		return
+	}
+
+	futuresClient := s.getFuturesClientForUser(c)
+	if futuresClient == nil {
+		errorResponse(c, http.StatusServiceUnavailable, "Futures client not available")
+		return
+	}
+
+	name := req.Name
+	if name == "" {
+		name = "sandbox"
+	}
+	config := &database.StrategyConfig{
+		Name:              name,
+		Symbol:            req.Symbol,
+		Timeframe:         req.Timeframe,
+		IndicatorType:     req.IndicatorType,
+		PositionSize:      req.PositionSize,
+		StopLossPercent:   req.StopLossPercent,
+		TakeProfitPercent: req.TakeProfitPercent,
+		ConfigParams:      req.ConfigParams,
+	}
+
+	evaluator := autopilot.NewStrategyEvaluator(nil, futuresClient, nil)
+	results := evaluator.EvaluateConfig(config, req.Symbols)
+
+	successResponse(c, gin.H{
+		"strategy":     name,
+		"timeframe":    req.Timeframe,
+		"results":      results,
+		"evaluated_at": time.Now(),
+	})
+}
+
 // ============================================================================
 // PENDING SIGNAL HANDLERS
 // ============================================================================
@@ -682,7 +802,7 @@ func (s *Server) handleConfirmPendingSignal(c *gin.Context) {
 		}
 
 		successResponse(c, gin.H{
-			"message": "Signal confirmed and trade executed",
+			"message":   "Signal confirmed and trade executed",
 			"signal_id": id,
 		})
 	} else {
@@ -693,7 +813,7 @@ func (s *Server) handleConfirmPendingSignal(c *gin.Context) {
 		}
 
 		successResponse(c, gin.H{
-			"message": "Signal rejected",
+			"message":   "Signal rejected",
 			"signal_id": id,
 		})
 	}
@@ -716,7 +836,7 @@ func (s *Server) handleArchivePendingSignal(c *gin.Context) {
 	}
 
 	successResponse(c, gin.H{
-		"message": "Signal archived successfully",
+		"message":   "Signal archived successfully",
 		"signal_id": id,
 	})
 }
@@ -738,7 +858,7 @@ func (s *Server) handleDeletePendingSignal(c *gin.Context) {
 	}
 
 	successResponse(c, gin.H{
-		"message": "Signal deleted successfully",
+		"message":   "Signal deleted successfully",
 		"signal_id": id,
 	})
 }
@@ -762,7 +882,7 @@ func (s *Server) handleDuplicatePendingSignal(c *gin.Context) {
 
 	successResponse(c, gin.H{
 		"message": "Signal duplicated successfully",
-		"signal": newSignal,
+		"signal":  newSignal,
 	})
 }
 
@@ -874,6 +994,30 @@ func (s *Server) handleRefreshScan(c *gin.Context) {
 	})
 }
 
+// handleGetScanMetrics returns duration, symbols scanned, errors and API calls of the last scan
+func (s *Server) handleGetScanMetrics(c *gin.Context) {
+	scannerInterface := s.botAPI.GetScanner()
+	if scannerInterface == nil {
+		errorResponse(c, http.StatusServiceUnavailable, "Strategy scanner not available")
+		return
+	}
+
+	// Type assert to *scanner.Scanner
+	sc, ok := scannerInterface.(*scanner.Scanner)
+	if !ok {
+		errorResponse(c, http.StatusInternalServerError, "Invalid scanner type")
+		return
+	}
+
+	metrics := sc.GetLastScanMetrics()
+	if metrics == nil {
+		successResponse(c, &scanner.ScanMetrics{})
+		return
+	}
+
+	successResponse(c, metrics)
+}
+
 // ============================================================================
 // WATCHLIST HANDLERS
 // ============================================================================
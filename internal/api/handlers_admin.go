@@ -543,3 +543,46 @@ func (s *Server) handleAdminSaveDefaults(c *gin.Context) {
 		"message":       "Defaults saved successfully",
 	})
 }
+
+// handleAdminListAuditLog returns the append-only audit trail of live trading actions
+// GET /api/admin/audit-log?actor=&action=&symbol=&since=&until=&limit=100&offset=0
+// since/until are RFC3339 timestamps
+func (s *Server) handleAdminListAuditLog(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "100"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+
+	filter := database.AuditLogFilter{
+		Actor:  c.Query("actor"),
+		Action: c.Query("action"),
+		Symbol: c.Query("symbol"),
+		Limit:  limit,
+		Offset: offset,
+	}
+	for param, dst := range map[string]*time.Time{"since": &filter.Since, "until": &filter.Until} {
+		if v := c.Query(param); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				errorResponse(c, http.StatusBadRequest, "Invalid "+param+" (expected RFC3339): "+err.Error())
+				return
+			}
+			*dst = t
+		}
+	}
+
+	entries, total, err := s.repo.GetAuditLogEntries(c.Request.Context(), filter)
+	if err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to fetch audit log: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"entries": entries,
+		"total":   total,
+		"limit":   limit,
+		"offset":  offset,
+	})
+}
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// handleAdminGetAccounts returns the status of every extra futures account
+// GET /api/admin/accounts
+func (s *Server) handleAdminGetAccounts(c *gin.Context) {
+	if s.accountManager == nil {
+		c.JSON(http.StatusOK, gin.H{
+			"accounts": []interface{}{},
+			"count":    0,
+		})
+		return
+	}
+
+	statuses := s.accountManager.GetStatuses()
+	c.JSON(http.StatusOK, gin.H{
+		"accounts": statuses,
+		"count":    len(statuses),
+	})
+}
+
+// handleAdminStartAccount starts an extra futures account's autopilot
+// POST /api/admin/accounts/:name/start
+func (s *Server) handleAdminStartAccount(c *gin.Context) {
+	s.setAccountRunning(c, true)
+}
+
+// handleAdminStopAccount stops an extra futures account's autopilot
+// POST /api/admin/accounts/:name/stop
+func (s *Server) handleAdminStopAccount(c *gin.Context) {
+	s.setAccountRunning(c, false)
+}
+
+func (s *Server) setAccountRunning(c *gin.Context, running bool) {
+	name := c.Param("name")
+	if s.accountManager == nil || s.accountManager.GetAccount(name) == nil {
+		errorResponse(c, http.StatusNotFound, "Account not found: "+name)
+		return
+	}
+
+	var err error
+	if running {
+		err = s.accountManager.Start(name)
+	} else {
+		err = s.accountManager.Stop(name)
+	}
+	if err != nil {
+		errorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"account": s.accountManager.GetAccount(name).Status(),
+	})
+}
@@ -30,8 +30,39 @@ type UpdateSMTPSettingsRequest struct {
 	UseTLS   string `json:"smtp_use_tls"`
 }
 
+// ConfigReloadAPI is implemented by bot wrappers that can apply a fresh
+// config.json to running components without a restart
+type ConfigReloadAPI interface {
+	ReloadConfig() (map[string]interface{}, error)
+}
+
 // ==================== HANDLERS ====================
 
+// handleAdminReloadConfig re-reads configuration and applies the reloadable
+// subset to the running bot (admin only)
+// POST /api/admin/config/reload
+func (s *Server) handleAdminReloadConfig(c *gin.Context) {
+	reloader, ok := s.botAPI.(ConfigReloadAPI)
+	if !ok {
+		errorResponse(c, http.StatusServiceUnavailable, "Config reload not available")
+		return
+	}
+
+	result, err := reloader.ReloadConfig()
+	if err != nil {
+		errorResponse(c, http.StatusBadRequest, "Config reload failed: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":          true,
+		"message":          "Configuration reloaded",
+		"applied":          result["applied"],
+		"restart_required": result["restart_required"],
+		"ginie_instances":  result["ginie_instances"],
+	})
+}
+
 // handleAdminGetAllSettings returns all system settings (admin only)
 // GET /api/admin/settings
 func (s *Server) handleAdminGetAllSettings(c *gin.Context) {
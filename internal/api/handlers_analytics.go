@@ -0,0 +1,116 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ==================== ANALYTICS ====================
+
+// confidenceAnalysisModes are the accepted ?mode= values (empty = all modes)
+var confidenceAnalysisModes = map[string]bool{
+	"":           true,
+	"ultra_fast": true,
+	"scalp":      true,
+	"swing":      true,
+	"position":   true,
+}
+
+// parseAnalyticsTime parses a from/to query value as RFC3339 or YYYY-MM-DD.
+// A date-only "to" covers that whole day.
+func parseAnalyticsTime(value string, endOfDay bool) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if endOfDay {
+		t = t.Add(24 * time.Hour)
+	}
+	return t, nil
+}
+
+// analyticsRange reads the ?from=&to= range, writing a 400 if it is invalid
+func analyticsRange(c *gin.Context) (from, to time.Time, ok bool) {
+	from, err := parseAnalyticsTime(c.Query("from"), false)
+	if err != nil {
+		errorResponse(c, http.StatusBadRequest, "Invalid from date. Use YYYY-MM-DD or RFC3339")
+		return from, to, false
+	}
+	to, err = parseAnalyticsTime(c.Query("to"), true)
+	if err != nil {
+		errorResponse(c, http.StatusBadRequest, "Invalid to date. Use YYYY-MM-DD or RFC3339")
+		return from, to, false
+	}
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		errorResponse(c, http.StatusBadRequest, "from must be before to")
+		return from, to, false
+	}
+	return from, to, true
+}
+
+// handleGetConfidenceAnalysis returns win rate and PnL by AI confidence bucket,
+// threshold comparisons and the recommended minimum confidence
+// GET /api/analytics/confidence?from=&to=&mode=
+func (s *Server) handleGetConfidenceAnalysis(c *gin.Context) {
+	from, to, ok := analyticsRange(c)
+	if !ok {
+		return
+	}
+	mode := c.Query("mode")
+	if !confidenceAnalysisModes[mode] {
+		errorResponse(c, http.StatusBadRequest, "mode must be ultra_fast, scalp, swing or position")
+		return
+	}
+
+	// Full-history aggregate: read from a replica when configured
+	db := s.repo.ReadOnly().GetDB()
+	analysis, err := db.GetConfidenceAnalysis(c.Request.Context(), s.tradeScope(c), from, to, mode)
+	if err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to analyze confidence: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"analysis": analysis,
+		"filters": gin.H{
+			"from": c.Query("from"),
+			"to":   c.Query("to"),
+			"mode": mode,
+		},
+	})
+}
+
+// handleGetPnLAttribution returns realized PnL, win rate and trade count broken
+// down by trading mode, strategy and trade source
+// GET /api/analytics/attribution?from=&to=
+func (s *Server) handleGetPnLAttribution(c *gin.Context) {
+	from, to, ok := analyticsRange(c)
+	if !ok {
+		return
+	}
+
+	db := s.repo.ReadOnly().GetDB()
+	report, err := db.GetPnLAttribution(c.Request.Context(), s.tradeScope(c), from, to)
+	if err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to get PnL attribution: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":     true,
+		"attribution": report,
+		"filters": gin.H{
+			"from": c.Query("from"),
+			"to":   c.Query("to"),
+		},
+	})
+}
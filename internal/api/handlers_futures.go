@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"binance-trading-bot/internal/audit"
 	"binance-trading-bot/internal/autopilot"
 	"binance-trading-bot/internal/binance"
 	"binance-trading-bot/internal/database"
@@ -1474,6 +1475,7 @@ func (s *Server) handleGetFuturesTradeHistory(c *gin.Context) {
 	offsetStr := c.DefaultQuery("offset", "0")
 	includeAI := c.DefaultQuery("include_ai", "false") == "true"
 	includeOpen := c.DefaultQuery("include_open", "false") == "true"
+	tag := journalTagQuery(c)
 
 	limit, _ := strconv.Atoi(limitStr)
 	offset, _ := strconv.Atoi(offsetStr)
@@ -1483,7 +1485,10 @@ func (s *Server) handleGetFuturesTradeHistory(c *gin.Context) {
 	var err error
 	var trades []database.FuturesTrade
 
-	if includeAI {
+	if tag != "" {
+		// Journal tag filter (open and closed trades)
+		trades, err = s.repo.GetDB().GetFuturesTradesByTag(ctx, s.tradeScope(c), tag, limit, offset)
+	} else if includeAI {
 		// Get trades with AI decisions
 		trades, err = s.repo.GetDB().GetFuturesTradeHistoryWithAI(ctx, limit, offset, includeOpen)
 	} else {
@@ -2458,7 +2463,7 @@ func (s *Server) getFuturesClientForUser(c *gin.Context) binance.FuturesClient {
 			log.Printf("[DEBUG] getFuturesClientForUser: Error getting user dry run mode: %v, defaulting to paper", err)
 			dryRun = true
 		}
-		if dryRun {
+		if dryRun || autopilot.IsDryRunForced() {
 			log.Printf("[DEBUG] getFuturesClientForUser: User %s in paper trading mode, using mock client", userID)
 			return s.getFuturesClient() // Returns mock client in paper mode
 		}
@@ -2484,7 +2489,7 @@ func (s *Server) getFuturesClientForUser(c *gin.Context) binance.FuturesClient {
 				// Create user-specific futures client
 				client := binance.NewFuturesClient(keys.APIKey, keys.SecretKey, keys.IsTestnet)
 				if client != nil {
-					return client
+					return audit.WrapFuturesClient(client, userID)
 				}
 			} else {
 				log.Printf("[DEBUG] getFuturesClientForUser: No valid keys found, err=%v, keys=%v", err, keys != nil)
@@ -2556,6 +2561,70 @@ func (s *Server) handleGetIncomeHistory(c *gin.Context) {
 	})
 }
 
+// incomeReconciliationDefaultDays is the range used when no ?from= is given
+const incomeReconciliationDefaultDays = 7
+
+// handleGetIncomeReconciliation reconciles realized PnL, funding and commissions from
+// Binance's own income history against the realized PnL the bot recorded for its trades
+// GET /api/futures/income/reconciliation?from=&to=&symbol=
+// from/to: YYYY-MM-DD or RFC3339 (default: last 7 days)
+func (s *Server) handleGetIncomeReconciliation(c *gin.Context) {
+	client := s.getFuturesClientForUser(c)
+	if client == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   "no_api_keys",
+			"message": "Please configure your Binance API keys in Settings",
+		})
+		return
+	}
+
+	from, to, ok := analyticsRange(c)
+	if !ok {
+		return
+	}
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.AddDate(0, 0, -incomeReconciliationDefaultDays)
+	}
+	symbol := strings.ToUpper(c.Query("symbol"))
+
+	records, err := client.GetAllIncomeHistory(symbol, "", from.UnixMilli(), to.UnixMilli())
+	if err != nil {
+		log.Printf("[ERROR] handleGetIncomeReconciliation: %v", err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to fetch income history: "+err.Error())
+		return
+	}
+	exchange, bySymbol := binance.SummarizeIncome(records)
+
+	botPnL, botTrades, err := s.repo.ReadOnly().GetDB().GetRealizedPnLTotal(c.Request.Context(), s.tradeScope(c), symbol, from, to)
+	if err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to get recorded PnL: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":   true,
+		"exchange":  exchange,
+		"by_symbol": bySymbol,
+		"bot": gin.H{
+			"realized_pnl": botPnL,
+			"trades":       botTrades,
+		},
+		// Positive drift: the bot recorded more PnL than the exchange paid out
+		"drift": gin.H{
+			"realized_pnl": botPnL - exchange.RealizedPnL,
+			"net_pnl":      botPnL - exchange.NetPnL,
+		},
+		"filters": gin.H{
+			"from":   from,
+			"to":     to,
+			"symbol": symbol,
+		},
+	})
+}
+
 // getOppositeSide returns the opposite side for TP/SL orders
 func getOppositeSide(side string) string {
 	if side == "BUY" {
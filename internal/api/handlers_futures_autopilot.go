@@ -2,6 +2,7 @@ package api
 
 import (
 	"binance-trading-bot/internal/ai/llm"
+	"binance-trading-bot/internal/audit"
 	"binance-trading-bot/internal/autopilot"
 	"binance-trading-bot/internal/binance"
 	"binance-trading-bot/internal/circuit"
@@ -82,6 +83,9 @@ func (s *Server) handleToggleFuturesAutopilot(c *gin.Context) {
 		errorResponse(c, http.StatusBadRequest, "Invalid request body")
 		return
 	}
+	if req.DryRun != nil && s.rejectIfLiveForced(c, *req.DryRun) {
+		return
+	}
 
 	userID := s.getUserID(c)
 	ctx := c.Request.Context()
@@ -302,6 +306,9 @@ func (s *Server) handleSetFuturesAutopilotDryRun(c *gin.Context) {
 		errorResponse(c, http.StatusBadRequest, "Invalid request body")
 		return
 	}
+	if s.rejectIfLiveForced(c, req.DryRun) {
+		return
+	}
 
 	controller := s.getFuturesAutopilot()
 	if controller == nil {
@@ -1008,6 +1015,33 @@ func (s *Server) handleGetSentimentNews(c *gin.Context) {
 	})
 }
 
+// handleGetSymbolSentiment returns the sentiment of news mentioning one coin
+// GET /api/futures/sentiment/symbol/:symbol
+func (s *Server) handleGetSymbolSentiment(c *gin.Context) {
+	symbol := strings.ToUpper(c.Param("symbol"))
+
+	controller := s.getFuturesAutopilot()
+	if controller == nil {
+		c.JSON(http.StatusOK, gin.H{
+			"symbol":    symbol,
+			"sentiment": nil,
+			"message":   "Autopilot not configured",
+		})
+		return
+	}
+
+	sentiment := controller.GetSymbolSentiment(symbol)
+	response := gin.H{
+		"symbol":    symbol,
+		"sentiment": sentiment,
+	}
+	if sentiment != nil {
+		response["has_data"] = sentiment.HasData()
+		response["freshness_minutes"] = sentiment.Freshness().Minutes()
+	}
+	c.JSON(http.StatusOK, response)
+}
+
 // handleGetBreakingNews returns trending/important news
 func (s *Server) handleGetBreakingNews(c *gin.Context) {
 	limitStr := c.DefaultQuery("limit", "10")
@@ -1377,6 +1411,10 @@ func (s *Server) tryInitializeLLMAnalyzer(c *gin.Context, controller *autopilot.
 // Returns the user's client if available, otherwise returns the controller's client (for dry run mode)
 func (s *Server) getUserFuturesClient(c *gin.Context) binance.FuturesClient {
 	userID := s.getUserID(c)
+	if autopilot.IsDryRunForced() {
+		// Kill switch active - never hand out a real client
+		return s.getFuturesClient()
+	}
 	if userID == "" {
 		// No user context - use controller's client
 		controller := s.getFuturesAutopilot()
@@ -1413,7 +1451,7 @@ func (s *Server) getUserFuturesClient(c *gin.Context) binance.FuturesClient {
 	}
 
 	// Create user-specific Futures client (cached by ClientFactory if available)
-	return binance.NewFuturesClient(keys.APIKey, keys.SecretKey, keys.IsTestnet)
+	return audit.WrapFuturesClient(binance.NewFuturesClient(keys.APIKey, keys.SecretKey, keys.IsTestnet), userID)
 }
 
 // handleClearFlipFlopCooldown clears the flip-flop cooldown
@@ -1730,7 +1768,7 @@ func (s *Server) handleRefreshSymbolPerformance(c *gin.Context) {
 
 	// Get performance stats from database
 	ctx := c.Request.Context()
-	dbStats, err := s.repo.GetDB().GetSymbolPerformanceStatsForUser(ctx, userID)
+	dbStats, err := s.repo.ReadOnly().GetDB().GetSymbolPerformanceStatsForUser(ctx, userID)
 	if err != nil {
 		errorResponse(c, http.StatusInternalServerError, "Failed to get performance stats: "+err.Error())
 		return
@@ -1937,6 +1975,114 @@ func (s *Server) handleUpdateCategorySettings(c *gin.Context) {
 	})
 }
 
+// handleGetSymbolClassification returns the symbol auto-classifier's last run
+// along with every symbol's computed tier and override state
+func (s *Server) handleGetSymbolClassification(c *gin.Context) {
+	sm := autopilot.GetSettingsManager()
+	settings := sm.GetDefaultSettings()
+
+	symbols := make([]gin.H, 0, len(settings.SymbolSettings))
+	for symbol, ss := range settings.SymbolSettings {
+		symbols = append(symbols, gin.H{
+			"symbol":            symbol,
+			"category":          ss.Category,
+			"auto_category":     ss.AutoCategory,
+			"category_override": ss.CategoryOverride,
+			"win_rate":          ss.WinRate,
+			"expectancy":        ss.Expectancy,
+			"total_trades":      ss.TotalTrades,
+			"total_pnl":         ss.TotalPnL,
+		})
+	}
+
+	var lastRun *autopilot.SymbolClassificationRun
+	if s.symbolClassifier != nil {
+		lastRun = s.symbolClassifier.LastRun()
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"enabled":        settings.SymbolAutoClassifyEnabled,
+		"interval_hours": settings.SymbolAutoClassifyIntervalHours,
+		"min_trades":     settings.SymbolAutoClassifyMinTrades,
+		"lookback_days":  settings.SymbolAutoClassifyLookbackDays,
+		"last_run":       lastRun,
+		"symbols":        symbols,
+	})
+}
+
+// handleRunSymbolClassification runs the symbol auto-classifier immediately
+func (s *Server) handleRunSymbolClassification(c *gin.Context) {
+	if s.symbolClassifier == nil {
+		errorResponse(c, http.StatusServiceUnavailable, "Symbol auto-classifier not available")
+		return
+	}
+
+	run, err := s.symbolClassifier.RunOnce(c.Request.Context())
+	if err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Symbol classification failed: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"run":     run,
+	})
+}
+
+// handleSetSymbolCategoryOverride pins a symbol's category so the
+// auto-classifier no longer changes it
+func (s *Server) handleSetSymbolCategoryOverride(c *gin.Context) {
+	symbol := strings.ToUpper(c.Param("symbol"))
+
+	var req struct {
+		Category string `json:"category" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Invalid request body: "+err.Error())
+		return
+	}
+
+	category := autopilot.SymbolPerformanceCategory(req.Category)
+	switch category {
+	case autopilot.PerformanceBest, autopilot.PerformanceGood, autopilot.PerformanceNeutral,
+		autopilot.PerformancePoor, autopilot.PerformanceWorst, autopilot.PerformanceBlacklist:
+	default:
+		errorResponse(c, http.StatusBadRequest, "Invalid category. Use: best, good, neutral, poor, worst, blacklist")
+		return
+	}
+
+	sm := autopilot.GetSettingsManager()
+	if err := sm.SetSymbolCategoryOverride(symbol, category); err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to set category override: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"message":  symbol + " category pinned to " + req.Category,
+		"symbol":   symbol,
+		"category": category,
+	})
+}
+
+// handleClearSymbolCategoryOverride hands a symbol's category back to the auto-classifier
+func (s *Server) handleClearSymbolCategoryOverride(c *gin.Context) {
+	symbol := strings.ToUpper(c.Param("symbol"))
+
+	sm := autopilot.GetSettingsManager()
+	if err := sm.ClearSymbolCategoryOverride(symbol); err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to clear category override: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"message":  symbol + " category is managed by the auto-classifier again",
+		"symbol":   symbol,
+		"category": sm.GetSymbolSettings(symbol).Category,
+	})
+}
+
 // handleGetSymbolsByCategory returns all symbols in a given performance category
 func (s *Server) handleGetSymbolsByCategory(c *gin.Context) {
 	category := c.Param("category")
@@ -521,6 +521,13 @@ func (s *Server) handleUpdateGinieAutopilotConfig(c *gin.Context) {
 	var dryRunUpdated bool
 	var newDryRunValue bool
 	if v, ok := updates["dry_run"].(bool); ok {
+		if s.rejectIfLiveForced(c, v) {
+			return
+		}
+		if !v && giniePilot.DrawdownGuardTripped() {
+			errorResponse(c, http.StatusForbidden, autopilot.ErrDrawdownGuardTripped.Error())
+			return
+		}
 		dryRunUpdated = true
 		newDryRunValue = v
 		fmt.Printf("[GINIE-MODE] Dry run update requested: %v (will always sync to main config)\n", v)
@@ -539,6 +546,9 @@ func (s *Server) handleUpdateGinieAutopilotConfig(c *gin.Context) {
 	if v, ok := updates["total_max_usd"].(float64); ok {
 		currentConfig.TotalMaxUSD = v
 	}
+	if v, ok := updates["max_total_notional_usd"].(float64); ok && v >= 0 {
+		currentConfig.MaxTotalNotionalUSD = v
+	}
 	if v, ok := updates["default_leverage"].(float64); ok {
 		currentConfig.DefaultLeverage = int(v)
 	}
@@ -569,6 +579,9 @@ func (s *Server) handleUpdateGinieAutopilotConfig(c *gin.Context) {
 	if v, ok := updates["tp4_percent"].(float64); ok {
 		currentConfig.TP4Percent = v
 	}
+	if v, ok := updates["dust_threshold_usd"].(float64); ok && v >= 0 {
+		currentConfig.DustThresholdUSD = v
+	}
 	if v, ok := updates["move_to_breakeven_after_tp1"].(bool); ok {
 		currentConfig.MoveToBreakevenAfterTP1 = v
 	}
@@ -593,6 +606,28 @@ func (s *Server) handleUpdateGinieAutopilotConfig(c *gin.Context) {
 	if v, ok := updates["max_daily_loss"].(float64); ok {
 		currentConfig.MaxDailyLoss = v
 	}
+	if v, ok := updates["max_daily_profit"].(float64); ok && v >= 0 {
+		currentConfig.MaxDailyProfit = v
+	}
+	if v, ok := updates["notify_daily_profit_target"].(bool); ok {
+		currentConfig.NotifyDailyProfitTarget = v
+	}
+	resetTime, hasResetTime := updates["daily_reset_time"].(string)
+	resetTimezone, hasResetTimezone := updates["daily_reset_timezone"].(string)
+	if hasResetTime || hasResetTimezone {
+		if !hasResetTime {
+			resetTime = currentConfig.DailyResetTime
+		}
+		if !hasResetTimezone {
+			resetTimezone = currentConfig.DailyResetTimezone
+		}
+		if _, _, err := autopilot.ParseDailyReset(resetTime, resetTimezone); err != nil {
+			errorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		currentConfig.DailyResetTime = resetTime
+		currentConfig.DailyResetTimezone = resetTimezone
+	}
 	// Circuit breaker config fields
 	if v, ok := updates["circuit_breaker_enabled"].(bool); ok {
 		currentConfig.CircuitBreakerEnabled = v
@@ -609,6 +644,214 @@ func (s *Server) handleUpdateGinieAutopilotConfig(c *gin.Context) {
 	if v, ok := updates["cb_cooldown_minutes"].(float64); ok {
 		currentConfig.CBCooldownMinutes = int(v)
 	}
+	if v, ok := updates["reconcile_heal_policy"].(string); ok {
+		if v != autopilot.ReconcileHealPolicyReport && v != autopilot.ReconcileHealPolicyHeal {
+			errorResponse(c, http.StatusBadRequest, "reconcile_heal_policy must be 'report' or 'heal'")
+			return
+		}
+		currentConfig.ReconcileHealPolicy = v
+	}
+	if v, ok := updates["orphan_order_alert_threshold"].(float64); ok {
+		currentConfig.OrphanOrderAlertThreshold = int(v)
+	}
+	if v, ok := updates["ramp_up_minutes"].(float64); ok && v >= 0 {
+		currentConfig.RampUpMinutes = int(v)
+	}
+	if v, ok := updates["ramp_up_start_positions"].(float64); ok && v >= 1 {
+		currentConfig.RampUpStartPositions = int(v)
+	}
+	// Adaptive win-rate throttle
+	if v, ok := updates["adaptive_throttle_enabled"].(bool); ok {
+		currentConfig.AdaptiveThrottleEnabled = v
+	}
+	if v, ok := updates["throttle_sample_size"].(float64); ok && v >= 1 {
+		currentConfig.ThrottleSampleSize = int(v)
+	}
+	if v, ok := updates["throttle_win_rate"].(float64); ok && v >= 0 && v <= 100 {
+		currentConfig.ThrottleWinRate = v
+	}
+	if v, ok := updates["throttle_recover_win_rate"].(float64); ok && v >= 0 && v <= 100 {
+		currentConfig.ThrottleRecoverWinRate = v
+	}
+	if v, ok := updates["throttle_confidence_boost"].(float64); ok && v >= 0 {
+		currentConfig.ThrottleConfidenceBoost = v
+	}
+	if v, ok := updates["throttle_size_multiplier"].(float64); ok && v > 0 && v <= 1 {
+		currentConfig.ThrottleSizeMultiplier = v
+	}
+	if v, ok := updates["throttle_pause_win_rate"].(float64); ok && v >= 0 && v <= 100 {
+		currentConfig.ThrottlePauseWinRate = v
+	}
+	if v, ok := updates["throttle_pause_minutes"].(float64); ok && v >= 0 {
+		currentConfig.ThrottlePauseMinutes = int(v)
+	}
+	if v, ok := updates["confidence_decay_half_life_seconds"].(float64); ok && v >= 0 {
+		currentConfig.ConfidenceDecayHalfLifeSeconds = int(v)
+	}
+	if v, ok := updates["protection_timeout_seconds"].(float64); ok && v >= 0 {
+		currentConfig.ProtectionTimeoutSeconds = int(v)
+	}
+	if v, ok := updates["protection_timeout_policy"].(string); ok {
+		if v != autopilot.ProtectionPolicyEmergencyClose && v != autopilot.ProtectionPolicyHeal {
+			errorResponse(c, http.StatusBadRequest, "protection_timeout_policy must be 'emergency_close' or 'heal'")
+			return
+		}
+		currentConfig.ProtectionTimeoutPolicy = v
+	}
+	if v, ok := updates["max_account_drawdown_percent"].(float64); ok && v >= 0 && v <= 100 {
+		currentConfig.MaxAccountDrawdownPercent = v
+	}
+	if v, ok := updates["sl_update_notifications"].(string); ok {
+		if v != autopilot.SLNotifyOff && v != autopilot.SLNotifySummary && v != autopilot.SLNotifyAll {
+			errorResponse(c, http.StatusBadRequest, "sl_update_notifications must be 'off', 'summary' or 'all'")
+			return
+		}
+		currentConfig.SLUpdateNotifications = v
+	}
+	if v, ok := updates["loss_alert_usd"].(float64); ok && v >= 0 {
+		currentConfig.LossAlertUSD = v
+	}
+	if v, ok := updates["loss_alert_percent"].(float64); ok && v >= 0 && v <= 100 {
+		currentConfig.LossAlertPercent = v
+	}
+	if v, ok := updates["execution_queue_enabled"].(bool); ok {
+		currentConfig.ExecutionQueueEnabled = v
+	}
+	if v, ok := updates["queue_weight_scalp"].(float64); ok && v > 0 && v <= 10 {
+		currentConfig.QueueWeightScalp = v
+	}
+	if v, ok := updates["queue_weight_swing"].(float64); ok && v > 0 && v <= 10 {
+		currentConfig.QueueWeightSwing = v
+	}
+	if v, ok := updates["queue_weight_position"].(float64); ok && v > 0 && v <= 10 {
+		currentConfig.QueueWeightPosition = v
+	}
+	if v, ok := updates["loss_cooloff_streak"].(float64); ok && v >= 0 {
+		currentConfig.LossCoolOffStreak = int(v)
+	}
+	if v, ok := updates["loss_cooloff_confidence_delta"].(float64); ok && v >= 0 && v <= 50 {
+		currentConfig.LossCoolOffConfidenceDelta = v
+	}
+	if v, ok := updates["loss_cooloff_decay_minutes"].(float64); ok && v >= 0 {
+		currentConfig.LossCoolOffDecayMinutes = int(v)
+	}
+	if v, ok := updates["require_synced_position_adoption"].(bool); ok {
+		currentConfig.RequireSyncedPositionAdoption = v
+	}
+	if v, ok := updates["equity_scaling_enabled"].(bool); ok {
+		currentConfig.EquityScalingEnabled = v
+	}
+	if v, ok := updates["equity_scaling_start_usd"].(float64); ok && v >= 0 {
+		currentConfig.EquityScalingStartUSD = v
+	}
+	if v, ok := updates["equity_scaling_gain_percent"].(float64); ok && v >= 0 && v <= 1000 {
+		currentConfig.EquityScalingGainPercent = v
+	}
+	if v, ok := updates["equity_scaling_drawdown_percent"].(float64); ok && v >= 0 && v <= 100 {
+		currentConfig.EquityScalingDrawdownPercent = v
+	}
+	if v, ok := updates["equity_scaling_floor"].(float64); ok {
+		if v <= 0 || v > 1 {
+			errorResponse(c, http.StatusBadRequest, "equity_scaling_floor must be greater than 0 and at most 1")
+			return
+		}
+		currentConfig.EquityScalingFloor = v
+	}
+	if v, ok := updates["max_daily_losses_per_symbol"].(float64); ok && v >= 0 {
+		currentConfig.MaxDailyLossesPerSymbol = int(v)
+	}
+	if v, ok := updates["use_mark_price_for_exits"].(bool); ok {
+		currentConfig.UseMarkPriceForExits = v
+	}
+	if v, ok := updates["position_drift_check_seconds"].(float64); ok && v >= 0 {
+		currentConfig.PositionDriftCheckSeconds = int(v)
+	}
+	if v, ok := updates["position_drift_qty_tolerance_percent"].(float64); ok && v >= 0 && v <= 100 {
+		currentConfig.PositionDriftQtyTolerancePercent = v
+	}
+	if v, ok := updates["position_drift_entry_tolerance_percent"].(float64); ok && v >= 0 && v <= 100 {
+		currentConfig.PositionDriftEntryTolerancePercent = v
+	}
+	if v, ok := updates["max_positions_per_minute"].(float64); ok && v >= 0 {
+		currentConfig.MaxPositionsPerMinute = int(v)
+	}
+	if v, ok := updates["trade_notify_enabled"].(bool); ok {
+		currentConfig.TradeNotifyEnabled = v
+	}
+	if v, ok := updates["trade_notify_chart_url"].(string); ok {
+		currentConfig.TradeNotifyChartURL = strings.TrimSpace(v)
+	}
+	if v, ok := updates["manage_only_mode"].(bool); ok {
+		currentConfig.ManageOnlyMode = v
+	}
+	if v, ok := updates["margin_ratio_guard_enabled"].(bool); ok {
+		currentConfig.MarginRatioGuardEnabled = v
+	}
+	if v, ok := updates["margin_ratio_reduce_percent"].(float64); ok && v >= 0 && v <= 100 {
+		currentConfig.MarginRatioReducePercent = v
+	}
+	if v, ok := updates["margin_ratio_block_percent"].(float64); ok && v >= 0 && v <= 100 {
+		currentConfig.MarginRatioBlockPercent = v
+	}
+	if v, ok := updates["symbol_sentiment_gate_enabled"].(bool); ok {
+		currentConfig.SymbolSentimentGateEnabled = v
+	}
+	if v, ok := updates["symbol_sentiment_block_score"].(float64); ok && v >= 0 && v <= 1 {
+		currentConfig.SymbolSentimentBlockScore = v
+	}
+	if v, ok := updates["symbol_sentiment_min_news"].(float64); ok && v >= 0 {
+		currentConfig.SymbolSentimentMinNews = int(v)
+	}
+	if v, ok := updates["symbol_sentiment_max_age_minutes"].(float64); ok && v >= 0 {
+		currentConfig.SymbolSentimentMaxAgeMinutes = int(v)
+	}
+	if v, ok := updates["max_btc_correlation"].(float64); ok && v >= 0 && v <= 1 {
+		currentConfig.MaxBTCCorrelation = v
+	}
+	if v, ok := updates["max_btc_correlation_always"].(bool); ok {
+		currentConfig.MaxBTCCorrelationAlways = v
+	}
+	if v, ok := updates["mode_max_positions"].(map[string]interface{}); ok {
+		caps := make(map[string]int, len(currentConfig.ModeMaxPositions))
+		for mode, n := range currentConfig.ModeMaxPositions {
+			caps[mode] = n
+		}
+		for mode, raw := range v {
+			n, isNum := raw.(float64)
+			switch autopilot.GinieTradingMode(mode) {
+			case autopilot.GinieModeUltraFast, autopilot.GinieModeScalp, autopilot.GinieModeSwing, autopilot.GinieModePosition:
+			default:
+				continue
+			}
+			if !isNum || n < 0 || n > 100 {
+				continue
+			}
+			if n == 0 {
+				delete(caps, mode) // Back to the mode's size.max_positions
+			} else {
+				caps[mode] = int(n)
+			}
+		}
+		currentConfig.ModeMaxPositions = caps
+	}
+	if v, ok := updates["auto_deleverage_enabled"].(bool); ok {
+		currentConfig.AutoDeleverageEnabled = v
+	}
+	if v, ok := updates["auto_deleverage_trigger_percent"].(float64); ok && v > 0 && v <= 50 {
+		currentConfig.AutoDeleverageTriggerPercent = v
+	}
+	if v, ok := updates["auto_deleverage_close_fraction"].(float64); ok && v > 0 && v <= 1 {
+		currentConfig.AutoDeleverageCloseFraction = v
+	}
+	if v, ok := updates["maintenance_hold_enabled"].(bool); ok {
+		currentConfig.MaintenanceHoldEnabled = v
+	}
+	if v, ok := updates["maintenance_error_threshold"].(float64); ok && v >= 1 && v <= 100 {
+		currentConfig.MaintenanceErrorThreshold = int(v)
+	}
+	if v, ok := updates["maintenance_ping_seconds"].(float64); ok && v >= 5 && v <= 600 {
+		currentConfig.MaintenancePingSeconds = int(v)
+	}
 
 	giniePilot.SetConfig(currentConfig)
 
@@ -771,7 +1014,8 @@ func (s *Server) handleStopGinieAutopilot(c *gin.Context) {
 	errorResponse(c, http.StatusUnauthorized, "User authentication required")
 }
 
-// handleGetGinieAutopilotPositions returns active Ginie positions
+// handleGetGinieAutopilotPositions returns active Ginie positions with their
+// origin tags, optionally filtered by ?mode=, ?source= and ?strategy=
 func (s *Server) handleGetGinieAutopilotPositions(c *gin.Context) {
 	autopilot := s.getGinieAutopilotWithFallback(c)
 	if autopilot == nil {
@@ -779,7 +1023,7 @@ func (s *Server) handleGetGinieAutopilotPositions(c *gin.Context) {
 		return
 	}
 
-	positions := autopilot.GetPositions()
+	positions := filterGiniePositionsByOrigin(autopilot.GetPositions(), c.Query("mode"), c.Query("source"), c.Query("strategy"))
 
 	c.JSON(http.StatusOK, gin.H{
 		"positions": positions,
@@ -787,6 +1031,28 @@ func (s *Server) handleGetGinieAutopilotPositions(c *gin.Context) {
 	})
 }
 
+// filterGiniePositionsByOrigin keeps positions matching the optional mode,
+// source and strategy name filters; an empty filter matches everything
+func filterGiniePositionsByOrigin(positions []*autopilot.GiniePosition, mode, source, strategy string) []*autopilot.GiniePosition {
+	if mode == "" && source == "" && strategy == "" {
+		return positions
+	}
+	filtered := make([]*autopilot.GiniePosition, 0, len(positions))
+	for _, pos := range positions {
+		if mode != "" && string(pos.Mode) != mode {
+			continue
+		}
+		if source != "" && pos.Source != source {
+			continue
+		}
+		if strategy != "" && (pos.StrategyName == nil || *pos.StrategyName != strategy) {
+			continue
+		}
+		filtered = append(filtered, pos)
+	}
+	return filtered
+}
+
 // handleGetGinieAutopilotTradeHistory returns Ginie trade history
 func (s *Server) handleGetGinieAutopilotTradeHistory(c *gin.Context) {
 	autopilot := s.getGinieAutopilotWithFallback(c)
@@ -918,6 +1184,33 @@ func (s *Server) handleToggleGinieCircuitBreaker(c *gin.Context) {
 	})
 }
 
+// handleToggleGinieManageOnly turns manage-only mode on or off: no new entries,
+// open positions keep being managed
+func (s *Server) handleToggleGinieManageOnly(c *gin.Context) {
+	// Use per-user Ginie autopilot instance (multi-user safe)
+	giniePilot := s.getGinieAutopilotForUser(c)
+	if giniePilot == nil {
+		errorResponse(c, http.StatusServiceUnavailable, "Ginie autopilot not available for this user")
+		return
+	}
+
+	var req struct {
+		Enabled bool `json:"enabled"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Invalid request body: "+err.Error())
+		return
+	}
+
+	giniePilot.SetManageOnlyMode(req.Enabled)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Ginie manage-only mode toggled",
+		"enabled": giniePilot.IsManageOnlyMode(),
+	})
+}
+
 // handleUpdateGinieCircuitBreakerConfig updates Ginie circuit breaker config
 func (s *Server) handleUpdateGinieCircuitBreakerConfig(c *gin.Context) {
 	// Use per-user Ginie autopilot instance (multi-user safe)
@@ -1144,6 +1437,42 @@ func (s *Server) handleSyncGiniePositions(c *gin.Context) {
 	})
 }
 
+// handleGetGinieReconciliation returns the diff between tracked and exchange positions
+// GET /api/futures/ginie/reconciliation
+func (s *Server) handleGetGinieReconciliation(c *gin.Context) {
+	giniePilot := s.getGinieAutopilotForUser(c)
+	if giniePilot == nil {
+		errorResponse(c, http.StatusServiceUnavailable, "Ginie autopilot not available for this user")
+		return
+	}
+
+	report, err := giniePilot.GetReconciliationReport()
+	if err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to reconcile positions: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"report":  report,
+	})
+}
+
+// handleGetGinieExchangeStatus returns exchange availability and the maintenance hold state
+// GET /api/futures/ginie/exchange-status
+func (s *Server) handleGetGinieExchangeStatus(c *gin.Context) {
+	giniePilot := s.getGinieAutopilotForUser(c)
+	if giniePilot == nil {
+		errorResponse(c, http.StatusServiceUnavailable, "Ginie autopilot not available for this user")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"status":  giniePilot.GetExchangeStatus(),
+	})
+}
+
 // handleCloseAllGiniePositions closes all Ginie-managed positions (panic button)
 func (s *Server) handleCloseAllGiniePositions(c *gin.Context) {
 	// Use per-user Ginie autopilot instance (multi-user safe)
@@ -1242,6 +1571,126 @@ func (s *Server) handleListSLTPJobs(c *gin.Context) {
 	})
 }
 
+// handleSetSymbolMaxSize sets a hard per-symbol position size ceiling that
+// adaptive sizing never exceeds, whatever the category or mode caps allow.
+// A max_size_usd of 0 removes it.
+// POST /api/futures/ginie/symbols/:symbol/max-size
+func (s *Server) handleSetSymbolMaxSize(c *gin.Context) {
+	symbol := strings.ToUpper(strings.TrimSpace(c.Param("symbol")))
+	if symbol == "" {
+		errorResponse(c, http.StatusBadRequest, "Symbol parameter required")
+		return
+	}
+
+	var req struct {
+		MaxSizeUSD *float64 `json:"max_size_usd"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Invalid request body: "+err.Error())
+		return
+	}
+	if req.MaxSizeUSD == nil || *req.MaxSizeUSD < 0 {
+		errorResponse(c, http.StatusBadRequest, "max_size_usd is required and must be >= 0 (0 removes the cap)")
+		return
+	}
+
+	sm := autopilot.GetSettingsManager()
+	if err := sm.SetSymbolMaxSizeCap(symbol, *req.MaxSizeUSD); err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to set max size: "+err.Error())
+		return
+	}
+
+	message := fmt.Sprintf("%s position size capped at $%.2f", symbol, *req.MaxSizeUSD)
+	if *req.MaxSizeUSD == 0 {
+		message = symbol + " max size cap removed"
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"success":          true,
+		"message":          message,
+		"symbol":           symbol,
+		"max_size_cap_usd": *req.MaxSizeUSD,
+	})
+}
+
+// handleGinieSizingPreview returns what adaptive sizing would produce for a
+// hypothetical trade (position USD, quantity, leverage and each multiplier)
+// without placing an order
+// POST /api/futures/ginie/sizing-preview
+func (s *Server) handleGinieSizingPreview(c *gin.Context) {
+	giniePilot := s.getGinieAutopilotForUser(c)
+	if giniePilot == nil {
+		errorResponse(c, http.StatusServiceUnavailable, "Ginie autopilot not available for this user")
+		return
+	}
+
+	var req struct {
+		Symbol        string  `json:"symbol"`
+		Confidence    float64 `json:"confidence"`     // 0-100
+		RiskLevel     string  `json:"risk_level"`     // Defaults to the current risk level
+		PositionCount *int    `json:"position_count"` // Defaults to the current open position count
+		Mode          string  `json:"mode"`           // Defaults to scalp
+		Side          string  `json:"side"`           // LONG/SHORT, enables the funding rate adjustment
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Invalid request body: "+err.Error())
+		return
+	}
+
+	symbol := strings.ToUpper(strings.TrimSpace(req.Symbol))
+	if symbol == "" {
+		errorResponse(c, http.StatusBadRequest, "Symbol is required")
+		return
+	}
+	if req.Confidence < 0 || req.Confidence > 100 {
+		errorResponse(c, http.StatusBadRequest, "Confidence must be between 0-100")
+		return
+	}
+
+	riskLevel := strings.ToLower(req.RiskLevel)
+	if riskLevel != "" && riskLevel != "conservative" && riskLevel != "moderate" && riskLevel != "aggressive" {
+		errorResponse(c, http.StatusBadRequest, "Invalid risk_level. Must be one of: conservative, moderate, aggressive")
+		return
+	}
+
+	side := strings.ToUpper(req.Side)
+	if side != "" && side != "LONG" && side != "SHORT" {
+		errorResponse(c, http.StatusBadRequest, "Invalid side. Must be LONG or SHORT")
+		return
+	}
+
+	validModes := map[string]autopilot.GinieTradingMode{
+		"ultra_fast": autopilot.GinieModeUltraFast,
+		"scalp":      autopilot.GinieModeScalp,
+		"swing":      autopilot.GinieModeSwing,
+		"position":   autopilot.GinieModePosition,
+	}
+	mode := autopilot.GinieModeScalp
+	if req.Mode != "" {
+		var ok bool
+		if mode, ok = validModes[req.Mode]; !ok {
+			errorResponse(c, http.StatusBadRequest, "Invalid mode. Must be one of: ultra_fast, scalp, swing, position")
+			return
+		}
+	}
+
+	positionCount := len(giniePilot.GetPositions())
+	if req.PositionCount != nil {
+		if *req.PositionCount < 0 {
+			errorResponse(c, http.StatusBadRequest, "position_count must not be negative")
+			return
+		}
+		positionCount = *req.PositionCount
+	}
+
+	preview, err := giniePilot.PreviewPositionSize(symbol, req.Confidence, positionCount, mode, side, riskLevel)
+	if err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to preview position size: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, preview)
+}
+
 // ==================== Per-Position ROI Target Handlers ====================
 
 // handleSetPositionROITarget sets custom ROI% target for a specific position
@@ -1326,6 +1775,124 @@ func (s *Server) handleSetPositionROITarget(c *gin.Context) {
 	})
 }
 
+// handleUpdateGiniePositionLevels adjusts SL, remaining TP prices and/or custom ROI%
+// of an open position and replaces the Binance algo orders to match
+func (s *Server) handleUpdateGiniePositionLevels(c *gin.Context) {
+	// Use per-user Ginie autopilot instance (multi-user safe)
+	giniePilot := s.getGinieAutopilotForUser(c)
+	if giniePilot == nil {
+		errorResponse(c, http.StatusServiceUnavailable, "Ginie autopilot not available for this user")
+		return
+	}
+
+	symbol := c.Param("symbol")
+	if symbol == "" {
+		errorResponse(c, http.StatusBadRequest, "Symbol is required")
+		return
+	}
+
+	var req struct {
+		StopLoss    *float64  `json:"stop_loss"`    // New SL trigger price
+		TakeProfits []float64 `json:"take_profits"` // New prices for remaining TP levels, nearest first
+		ROIPercent  *float64  `json:"roi_percent"`  // Custom ROI% (0 clears)
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Invalid request body: "+err.Error())
+		return
+	}
+
+	pos, err := giniePilot.UpdatePositionLevels(symbol, autopilot.PositionLevelUpdate{
+		StopLoss:    req.StopLoss,
+		TakeProfits: req.TakeProfits,
+		ROIPercent:  req.ROIPercent,
+	})
+	if err != nil {
+		status := http.StatusBadRequest
+		if strings.HasPrefix(err.Error(), "position not found") {
+			status = http.StatusNotFound
+		}
+		errorResponse(c, status, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"message":  fmt.Sprintf("Position levels updated for %s", symbol),
+		"position": pos,
+	})
+}
+
+// handleGetGinieSyncedPositions lists positions synced from the exchange that are
+// monitored only until adopted
+func (s *Server) handleGetGinieSyncedPositions(c *gin.Context) {
+	giniePilot := s.getGinieAutopilotForUser(c)
+	if giniePilot == nil {
+		errorResponse(c, http.StatusServiceUnavailable, "Ginie autopilot not available for this user")
+		return
+	}
+
+	positions := giniePilot.GetSyncedPositions()
+	c.JSON(http.StatusOK, gin.H{
+		"success":   true,
+		"positions": positions,
+		"count":     len(positions),
+	})
+}
+
+// handleAdoptGinieSyncedPosition assigns a synced position's mode, SL, TP and ROI
+// target and hands it to Ginie's management
+func (s *Server) handleAdoptGinieSyncedPosition(c *gin.Context) {
+	giniePilot := s.getGinieAutopilotForUser(c)
+	if giniePilot == nil {
+		errorResponse(c, http.StatusServiceUnavailable, "Ginie autopilot not available for this user")
+		return
+	}
+
+	symbol := c.Param("symbol")
+	if symbol == "" {
+		errorResponse(c, http.StatusBadRequest, "Symbol is required")
+		return
+	}
+
+	var req struct {
+		Mode        string    `json:"mode"`         // scalp, swing or position (empty keeps the assigned mode)
+		StopLoss    *float64  `json:"stop_loss"`    // SL trigger price
+		TakeProfits []float64 `json:"take_profits"` // TP prices, nearest first
+		ROIPercent  *float64  `json:"roi_percent"`  // Custom ROI% (0 clears)
+	}
+	// An empty body adopts with the defaults
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			errorResponse(c, http.StatusBadRequest, "Invalid request body: "+err.Error())
+			return
+		}
+	}
+
+	pos, err := giniePilot.AdoptSyncedPosition(symbol, autopilot.SyncedPositionAdoption{
+		Mode:        autopilot.GinieTradingMode(strings.ToLower(req.Mode)),
+		StopLoss:    req.StopLoss,
+		TakeProfits: req.TakeProfits,
+		ROIPercent:  req.ROIPercent,
+	})
+	if err != nil {
+		status := http.StatusBadRequest
+		if strings.HasPrefix(err.Error(), "position not found") {
+			status = http.StatusNotFound
+		} else if strings.Contains(err.Error(), "already managed") {
+			status = http.StatusConflict
+		}
+		errorResponse(c, status, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"message":  fmt.Sprintf("%s adopted in %s mode", symbol, pos.Mode),
+		"position": pos,
+	})
+}
+
 // ==================== Ginie Market Movers Handlers ====================
 
 // handleGetMarketMovers returns current market movers (gainers, losers, volume, volatility)
@@ -1445,6 +2012,79 @@ func (s *Server) handleRefreshDynamicSymbols(c *gin.Context) {
 	})
 }
 
+// ==================== Ginie Watchlist Handlers ====================
+
+// handleGetGinieWatchlist returns the symbols being scanned with their source tags
+func (s *Server) handleGetGinieWatchlist(c *gin.Context) {
+	giniePilot := s.getGinieAutopilotForUser(c)
+	if giniePilot == nil {
+		errorResponse(c, http.StatusServiceUnavailable, "Ginie autopilot not available for this user")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":   true,
+		"watchlist": giniePilot.GetWatchlistWithSources(),
+	})
+}
+
+// handlePinGinieWatchlistSymbol force-includes (or excludes) a symbol across refreshes
+func (s *Server) handlePinGinieWatchlistSymbol(c *gin.Context) {
+	giniePilot := s.getGinieAutopilotForUser(c)
+	if giniePilot == nil {
+		errorResponse(c, http.StatusServiceUnavailable, "Ginie autopilot not available for this user")
+		return
+	}
+
+	var req struct {
+		Symbol  string `json:"symbol" binding:"required"`
+		Exclude bool   `json:"exclude"` // true = never scan this symbol
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Invalid request body: "+err.Error())
+		return
+	}
+
+	if err := giniePilot.PinWatchlistSymbol(req.Symbol, req.Exclude); err != nil {
+		errorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":   true,
+		"message":   fmt.Sprintf("Watchlist override set for %s", strings.ToUpper(req.Symbol)),
+		"watchlist": giniePilot.GetWatchlistWithSources(),
+	})
+}
+
+// handleUnpinGinieWatchlistSymbol removes a manual watchlist override
+func (s *Server) handleUnpinGinieWatchlistSymbol(c *gin.Context) {
+	giniePilot := s.getGinieAutopilotForUser(c)
+	if giniePilot == nil {
+		errorResponse(c, http.StatusServiceUnavailable, "Ginie autopilot not available for this user")
+		return
+	}
+
+	var req struct {
+		Symbol string `json:"symbol" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Invalid request body: "+err.Error())
+		return
+	}
+
+	if err := giniePilot.UnpinWatchlistSymbol(req.Symbol); err != nil {
+		errorResponse(c, http.StatusNotFound, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":   true,
+		"message":   fmt.Sprintf("Watchlist override removed for %s", strings.ToUpper(req.Symbol)),
+		"watchlist": giniePilot.GetWatchlistWithSources(),
+	})
+}
+
 // ==================== Ginie Blocked Coins Handlers ====================
 
 // handleGetGinieBlockedCoins returns list of blocked coins
@@ -1458,8 +2098,9 @@ func (s *Server) handleGetGinieBlockedCoins(c *gin.Context) {
 	blockedCoins := giniePilot.GetBlockedCoins()
 
 	c.JSON(http.StatusOK, gin.H{
-		"blocked_coins": blockedCoins,
-		"count":         len(blockedCoins),
+		"blocked_coins":       blockedCoins,
+		"count":               len(blockedCoins),
+		"symbol_daily_losses": giniePilot.GetSymbolDailyLosses(),
 	})
 }
 
@@ -1588,6 +2229,69 @@ func (s *Server) handleGetGinieSignalStats(c *gin.Context) {
 	c.JSON(http.StatusOK, stats)
 }
 
+// handleExplainGinieSignal explains why a logged signal was rejected
+func (s *Server) handleExplainGinieSignal(c *gin.Context) {
+	giniePilot := s.getGinieAutopilotForUser(c)
+	if giniePilot == nil {
+		errorResponse(c, http.StatusServiceUnavailable, "Ginie autopilot not available for this user")
+		return
+	}
+
+	sig, ok := giniePilot.GetSignalLog(c.Param("id"))
+	if !ok {
+		errorResponse(c, http.StatusNotFound, "Signal not found (it may have aged out of the signal log)")
+		return
+	}
+
+	c.JSON(http.StatusOK, autopilot.ExplainSignal(sig))
+}
+
+// ==================== Ginie Drawdown Guard Handlers ====================
+
+// handleGetGinieDrawdownGuard returns the account drawdown kill switch state
+func (s *Server) handleGetGinieDrawdownGuard(c *gin.Context) {
+	giniePilot := s.getGinieAutopilotForUser(c)
+	if giniePilot == nil {
+		errorResponse(c, http.StatusServiceUnavailable, "Ginie autopilot not available for this user")
+		return
+	}
+
+	c.JSON(http.StatusOK, giniePilot.GetDrawdownGuardStatus())
+}
+
+// handleGetGinieEquityScaling returns the anti-martingale size/leverage multiplier
+// and the realized equity it was derived from
+func (s *Server) handleGetGinieEquityScaling(c *gin.Context) {
+	giniePilot := s.getGinieAutopilotForUser(c)
+	if giniePilot == nil {
+		errorResponse(c, http.StatusServiceUnavailable, "Ginie autopilot not available for this user")
+		return
+	}
+
+	c.JSON(http.StatusOK, giniePilot.GetEquityScalingStatus())
+}
+
+// handleResetGinieDrawdownGuard re-arms a tripped drawdown kill switch. The bot
+// stays in dry-run; live trading has to be re-enabled separately.
+func (s *Server) handleResetGinieDrawdownGuard(c *gin.Context) {
+	giniePilot := s.getGinieAutopilotForUser(c)
+	if giniePilot == nil {
+		errorResponse(c, http.StatusServiceUnavailable, "Ginie autopilot not available for this user")
+		return
+	}
+
+	if err := giniePilot.ResetDrawdownGuard(); err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to reset drawdown guard: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Drawdown guard reset - live trading can be re-enabled",
+		"status":  giniePilot.GetDrawdownGuardStatus(),
+	})
+}
+
 // ==================== Ginie SL Update History Handlers ====================
 
 // handleGetGinieSLHistory returns SL update history for all or specific symbol
@@ -1598,8 +2302,11 @@ func (s *Server) handleGetGinieSLHistory(c *gin.Context) {
 		return
 	}
 
-	// Check if specific symbol requested
-	symbol := c.Query("symbol")
+	// Check if specific symbol requested (path /sl-history/:symbol or ?symbol=)
+	symbol := strings.ToUpper(c.Param("symbol"))
+	if symbol == "" {
+		symbol = c.Query("symbol")
+	}
 	if symbol != "" {
 		history := giniePilot.GetSLUpdateHistory(symbol)
 		if history == nil {
@@ -1781,8 +2488,9 @@ func (s *Server) handleGetTradeHistoryBySource(c *gin.Context) {
 		return
 	}
 
-	// Get filter from query param
+	// Get filters from query params
 	source := c.DefaultQuery("source", "all")
+	tag := journalTagQuery(c)
 	limitStr := c.DefaultQuery("limit", "100")
 	limit, err := strconv.Atoi(limitStr)
 	if err != nil {
@@ -1791,11 +2499,11 @@ func (s *Server) handleGetTradeHistoryBySource(c *gin.Context) {
 
 	history := giniePilot.GetTradeHistory(limit * 2) // Get more to filter
 
-	// Filter by source if specified
-	if source != "all" && source != "" {
+	// Filter by source and/or journal tag if specified
+	if (source != "all" && source != "") || tag != "" {
 		filtered := make([]autopilot.GinieTradeResult, 0)
 		for _, trade := range history {
-			if trade.Source == source {
+			if (source == "all" || source == "" || trade.Source == source) && ginieTradeHasTag(trade, tag) {
 				filtered = append(filtered, trade)
 				if len(filtered) >= limit {
 					break
@@ -1811,8 +2519,22 @@ func (s *Server) handleGetTradeHistoryBySource(c *gin.Context) {
 		"trades": history,
 		"count":  len(history),
 		"filter": source,
+		"tag":    tag,
 	})
 }
+
+// ginieTradeHasTag reports whether a trade carries tag (empty matches all)
+func ginieTradeHasTag(trade autopilot.GinieTradeResult, tag string) bool {
+	if tag == "" {
+		return true
+	}
+	for _, t := range trade.Tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
 // parseIntParam is a helper to parse integer query parameters
 func parseIntParam(s string) (int, error) {
 	return strconv.Atoi(s)
@@ -2117,6 +2839,10 @@ func (s *Server) handleUpdateModeConfig(c *gin.Context) {
 		return
 	}
 	config.ModeName = mode
+	if err := config.Schedule.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid schedule: " + err.Error()})
+		return
+	}
 
 	// Get userID from context (JWT auth)
 	userID, exists := c.Get("user_id")
@@ -2266,6 +2992,7 @@ func (s *Server) handleResetModeConfigs(c *gin.Context) {
 	log.Printf("[MODE-CONFIG] Resetting all mode configurations to defaults for user %s", userID)
 
 	ctx := c.Request.Context()
+	s.autoSnapshotSettings(ctx, userID, "before resetting mode configs")
 
 	// Use the new per-user restore function
 	if err := s.repo.RestoreUserDefaultSettings(ctx, userID); err != nil {
@@ -5533,6 +6260,28 @@ func (s *Server) handleGetPendingOrders(c *gin.Context) {
 	})
 }
 
+// handleGetGinieOrphanOrders lists open algo orders that Ginie does not track
+// GET /api/futures/ginie/orphan-orders
+func (s *Server) handleGetGinieOrphanOrders(c *gin.Context) {
+	giniePilot := s.getGinieAutopilotForUser(c)
+	if giniePilot == nil {
+		errorResponse(c, http.StatusServiceUnavailable, "Ginie autopilot not available for this user")
+		return
+	}
+
+	report, err := giniePilot.FindOrphanAlgoOrders()
+	if err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to scan algo orders: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"report":  report,
+		"count":   len(report.Orphans),
+	})
+}
+
 // ==================== TRADE CONDITIONS ====================
 
 // handleGetTradeConditions returns detailed status of all pre-trade conditions
@@ -1,15 +1,87 @@
 package api
 
 import (
+	"log"
 	"net/http"
+	"strings"
+	"time"
+
+	"binance-trading-bot/internal/database"
+	"binance-trading-bot/internal/license"
 
 	"github.com/gin-gonic/gin"
 )
 
-// handleGetLicenseInfo returns the current license information
+// getUserLicense returns the license bound to the current user, or nil if the
+// user has not activated one (the process-wide LICENSE_KEY license applies then)
+func (s *Server) getUserLicense(c *gin.Context) *database.UserLicense {
+	userID := s.getUserID(c)
+	if userID == "" || s.repo == nil {
+		return nil
+	}
+	ul, err := s.repo.GetUserLicense(c.Request.Context(), userID)
+	if err != nil {
+		log.Printf("[LICENSE] Failed to load license for user %s: %v", userID, err)
+		return nil
+	}
+	return ul
+}
+
+// userLicenseInfo converts an account license to the validator's LicenseInfo
+func userLicenseInfo(ul *database.UserLicense) *license.LicenseInfo {
+	info := &license.LicenseInfo{
+		Key:        ul.Key,
+		Type:       license.LicenseType(ul.Type),
+		MaxSymbols: ul.MaxSymbols,
+		Features:   database.JSONToFeatures(ul.Features),
+		IsValid:    true,
+		Message:    "License activated on this account",
+	}
+	if ul.ValidUntil != nil {
+		info.ValidUntil = *ul.ValidUntil
+		if time.Now().After(*ul.ValidUntil) {
+			info.IsValid = false
+			info.Message = "License expired"
+		}
+	}
+	return info
+}
+
+// maskLicenseKey hides the middle of a license key: PRO-****-****-AB12
+func maskLicenseKey(key string) string {
+	parts := strings.Split(key, "-")
+	if len(parts) != 4 {
+		return "****"
+	}
+	return parts[0] + "-****-****-" + parts[3]
+}
+
+func userLicenseResponse(ul *database.UserLicense) gin.H {
+	info := userLicenseInfo(ul)
+	return gin.H{
+		"source":       "account",
+		"key":          maskLicenseKey(ul.Key),
+		"type":         info.Type,
+		"is_valid":     info.IsValid,
+		"valid_until":  ul.ValidUntil,
+		"max_symbols":  info.MaxSymbols,
+		"features":     info.Features,
+		"message":      info.Message,
+		"activated_at": ul.ActivatedAt,
+	}
+}
+
+// handleGetLicenseInfo returns the current user's license, falling back to the
+// server-wide license information
 func (s *Server) handleGetLicenseInfo(c *gin.Context) {
+	if ul := s.getUserLicense(c); ul != nil {
+		c.JSON(http.StatusOK, userLicenseResponse(ul))
+		return
+	}
+
 	if s.licenseInfo == nil {
 		c.JSON(http.StatusOK, gin.H{
+			"source":      "server",
 			"type":        "trial",
 			"is_valid":    true,
 			"max_symbols": 3,
@@ -20,6 +92,7 @@ func (s *Server) handleGetLicenseInfo(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, gin.H{
+		"source":       "server",
 		"type":         s.licenseInfo.Type,
 		"is_valid":     s.licenseInfo.IsValid,
 		"valid_until":  s.licenseInfo.ValidUntil,
@@ -34,6 +107,14 @@ func (s *Server) handleGetLicenseInfo(c *gin.Context) {
 func (s *Server) handleCheckFeature(c *gin.Context) {
 	feature := c.Param("feature")
 
+	if ul := s.getUserLicense(c); ul != nil {
+		c.JSON(http.StatusOK, gin.H{
+			"feature":   feature,
+			"available": userLicenseInfo(ul).HasFeature(feature),
+		})
+		return
+	}
+
 	if s.licenseInfo == nil {
 		c.JSON(http.StatusOK, gin.H{
 			"feature":   feature,
@@ -56,3 +137,177 @@ func (s *Server) handleCheckFeature(c *gin.Context) {
 		"available": available,
 	})
 }
+
+// handleActivateLicense validates a license key and binds it to the current user.
+// Admin-issued keys must still be active and unexpired; a key bound to another
+// account has to be deactivated or transferred there first.
+func (s *Server) handleActivateLicense(c *gin.Context) {
+	userID, ok := s.getUserIDRequired(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Key string `json:"key" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+	key := strings.ToUpper(strings.TrimSpace(req.Key))
+
+	validator := license.NewValidatorFromEnv()
+	validator.SetFingerprintStore(s.repo.LicenseFingerprintStore(), license.FingerprintPolicyFromEnv())
+	validator.AddRevocationList(s.repo.LicenseRevocationList())
+	info, err := validator.ValidateLicense(key)
+	if err != nil || info == nil || !info.IsValid || info.Type == license.LicenseTypeTrial {
+		msg := "Invalid license key"
+		if info != nil && info.Message != "" {
+			msg += ": " + info.Message
+		}
+		errorResponse(c, http.StatusBadRequest, msg)
+		return
+	}
+
+	ctx := c.Request.Context()
+	ul := &database.UserLicense{
+		UserID:     userID,
+		Key:        key,
+		Type:       string(info.Type),
+		MaxSymbols: info.MaxSymbols,
+		Features:   database.FeaturesToJSON(info.Features),
+	}
+	if !info.ValidUntil.IsZero() {
+		validUntil := info.ValidUntil
+		ul.ValidUntil = &validUntil
+	}
+
+	// Keys issued through the admin API carry their own status, limits and expiry
+	issued, err := s.repo.GetLicenseByKey(ctx, key)
+	if err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to look up license: "+err.Error())
+		return
+	}
+	if issued != nil {
+		if issued.RevokedAt != nil {
+			errorResponse(c, http.StatusForbidden, "License has been revoked")
+			return
+		}
+		if !issued.IsActive {
+			errorResponse(c, http.StatusForbidden, "License has been deactivated")
+			return
+		}
+		if issued.ExpiresAt != nil && time.Now().After(*issued.ExpiresAt) {
+			errorResponse(c, http.StatusForbidden, "License has expired")
+			return
+		}
+		ul.Type = issued.Type
+		ul.MaxSymbols = issued.MaxSymbols
+		ul.Features = issued.Features
+		ul.ValidUntil = issued.ExpiresAt
+	}
+
+	bound, err := s.repo.GetUserLicenseByKey(ctx, key)
+	if err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to check license binding: "+err.Error())
+		return
+	}
+	if bound != nil && bound.UserID != userID {
+		errorResponse(c, http.StatusConflict, "License is already activated on another account; deactivate or transfer it there first")
+		return
+	}
+
+	if err := s.repo.SaveUserLicense(ctx, ul); err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to activate license: "+err.Error())
+		return
+	}
+	if issued != nil {
+		if err := s.repo.ActivateLicense(ctx, issued.ID, c.ClientIP()); err != nil {
+			log.Printf("[LICENSE] Failed to record activation of license %s: %v", issued.ID, err)
+		}
+	}
+
+	log.Printf("[LICENSE] User %s activated %s license %s", userID, ul.Type, maskLicenseKey(key))
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"license": userLicenseResponse(ul),
+	})
+}
+
+// handleDeactivateLicense releases the current user's license so the key can
+// be activated on another account
+func (s *Server) handleDeactivateLicense(c *gin.Context) {
+	userID, ok := s.getUserIDRequired(c)
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	ul, err := s.repo.GetUserLicense(ctx, userID)
+	if err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to load license: "+err.Error())
+		return
+	}
+	if ul == nil {
+		errorResponse(c, http.StatusNotFound, "No license is activated on this account")
+		return
+	}
+
+	if err := s.repo.DeleteUserLicense(ctx, userID); err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to deactivate license: "+err.Error())
+		return
+	}
+
+	log.Printf("[LICENSE] User %s deactivated license %s", userID, maskLicenseKey(ul.Key))
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "License deactivated",
+	})
+}
+
+// handleTransferLicense moves the current user's license to another account by email
+func (s *Server) handleTransferLicense(c *gin.Context) {
+	userID, ok := s.getUserIDRequired(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Email string `json:"email" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Invalid request: "+err.Error())
+		return
+	}
+
+	ctx := c.Request.Context()
+	target, err := s.repo.GetUserByEmail(ctx, strings.TrimSpace(req.Email))
+	if err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to look up user: "+err.Error())
+		return
+	}
+	if target == nil {
+		errorResponse(c, http.StatusNotFound, "No account found for that email")
+		return
+	}
+	if target.ID == userID {
+		errorResponse(c, http.StatusBadRequest, "License is already on this account")
+		return
+	}
+
+	moved, err := s.repo.TransferUserLicense(ctx, userID, target.ID)
+	if err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to transfer license: "+err.Error())
+		return
+	}
+	if !moved {
+		errorResponse(c, http.StatusNotFound, "No license is activated on this account")
+		return
+	}
+
+	log.Printf("[LICENSE] User %s transferred their license to user %s", userID, target.ID)
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "License transferred to " + target.Email,
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"time"
 
+	"binance-trading-bot/internal/audit"
 	"binance-trading-bot/internal/autopilot"
 	"binance-trading-bot/internal/binance"
 	"binance-trading-bot/internal/circuit"
@@ -71,6 +72,7 @@ func (s *Server) handleGetTradingMode(c *gin.Context) {
 		errorResponse(c, http.StatusInternalServerError, "Failed to retrieve trading mode: "+err.Error())
 		return
 	}
+	dryRun = dryRun || autopilot.IsDryRunForced()
 
 	mode := "live"
 	modeLabel := "Live Trading"
@@ -83,7 +85,7 @@ func (s *Server) handleGetTradingMode(c *gin.Context) {
 		"dry_run":    dryRun,
 		"mode":       mode,
 		"mode_label": modeLabel,
-		"can_switch": true,
+		"can_switch": !autopilot.IsDryRunForced(),
 		"user_id":    userID, // Include user ID for debugging
 	})
 }
@@ -95,6 +97,9 @@ func (s *Server) handleSetTradingMode(c *gin.Context) {
 		errorResponse(c, http.StatusBadRequest, "Invalid request body")
 		return
 	}
+	if s.rejectIfLiveForced(c, req.DryRun) {
+		return
+	}
 
 	// Get user ID from auth context
 	userID := s.getUserID(c)
@@ -105,7 +110,11 @@ func (s *Server) handleSetTradingMode(c *gin.Context) {
 		log.Printf("[MODE-SWITCH] User %s switching to dry_run=%v", userID, req.DryRun)
 
 		// Save per-user trading mode to database
-		if err := s.repo.SetUserDryRunMode(ctx, userID, req.DryRun); err != nil {
+		err := s.repo.SetUserDryRunMode(ctx, userID, req.DryRun)
+		audit.RecordResult(ctx, audit.ActionModeSwitched, userID, map[string]interface{}{
+			"dry_run": req.DryRun,
+		}, err)
+		if err != nil {
 			log.Printf("[MODE-SWITCH] Failed to save user trading mode: %v", err)
 			errorResponse(c, http.StatusInternalServerError, "Failed to save trading mode: "+err.Error())
 			return
@@ -595,6 +604,16 @@ func (s *Server) handleUpdateCircuitBreakerConfig(c *gin.Context) {
 // ==================== HELPER FUNCTIONS ====================
 
 // getSettingsAPI returns the settings API if available
+// rejectIfLiveForced responds 403 and returns true when a switch to live
+// trading is requested while the operator FORCE_DRY_RUN kill switch is active
+func (s *Server) rejectIfLiveForced(c *gin.Context, dryRun bool) bool {
+	if dryRun || !autopilot.IsDryRunForced() {
+		return false
+	}
+	errorResponse(c, http.StatusForbidden, autopilot.ErrLiveTradingDisabled.Error())
+	return true
+}
+
 func (s *Server) getSettingsAPI() SettingsAPI {
 	if settingsAPI, ok := s.botAPI.(SettingsAPI); ok {
 		return settingsAPI
@@ -641,7 +660,7 @@ func (s *Server) getBinanceClientForUser(c *gin.Context) binance.BinanceClient {
 			log.Printf("[DEBUG] getBinanceClientForUser: Error getting user dry run mode: %v, defaulting to paper", err)
 			dryRun = true
 		}
-		if dryRun {
+		if dryRun || autopilot.IsDryRunForced() {
 			log.Printf("[DEBUG] getBinanceClientForUser: User %s in paper trading mode, using mock client", userID)
 			return s.getBinanceClient() // Returns mock client in paper mode
 		}
@@ -559,6 +559,8 @@ func (s *Server) handleLoadAllModeDefaults(c *gin.Context) {
 
 	// If not preview mode, apply all defaults to user's database
 	if !preview {
+		s.autoSnapshotSettings(ctx, userID, "before loading all mode defaults")
+
 		modesApplied := 0
 		for _, modeName := range modeNames {
 			defaultMode := defaultModes[modeName]
@@ -779,6 +781,8 @@ func (s *Server) handleLoadAllDefaults(c *gin.Context) {
 
 	// If not preview mode, apply all defaults
 	if !preview {
+		s.autoSnapshotSettings(c.Request.Context(), userID, "before loading all defaults")
+
 		// Apply mode configs (4 trading modes - scalp_reentry is separate)
 		currentSettings.ModeConfigs["ultra_fast"] = defaults.ModeConfigs["ultra_fast"]
 		currentSettings.ModeConfigs["scalp"] = defaults.ModeConfigs["scalp"]
@@ -2525,6 +2529,8 @@ func (s *Server) handleLoadSafetySettingsDefaults(c *gin.Context) {
 		return
 	}
 
+	s.autoSnapshotSettings(ctx, userID, "before loading safety settings defaults")
+
 	// Apply defaults from default-settings.json to database - reset all modes
 	for _, mode := range modes {
 		// Get defaults from default-settings.json (same source used for comparison)
@@ -2838,6 +2844,8 @@ func (s *Server) handleResetAllModes(c *gin.Context) {
 		return
 	}
 
+	s.autoSnapshotSettings(ctx, userID, "before resetting all modes")
+
 	// Apply defaults to all modes
 	modesApplied := 0
 	for _, modeName := range modeNames {
@@ -0,0 +1,229 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"binance-trading-bot/internal/database"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ==================== SETTINGS SNAPSHOTS ====================
+
+// settingsSnapshotRequest is the body of a manual snapshot
+type settingsSnapshotRequest struct {
+	Label string `json:"label"`
+}
+
+// autoSnapshotSettings saves the user's settings before a bulk change so it can
+// be undone. A failed snapshot is logged and doesn't block the change.
+func (s *Server) autoSnapshotSettings(ctx context.Context, userID, label string) {
+	if userID == "" {
+		return
+	}
+	snapshot, err := s.repo.CaptureUserSettingsSnapshot(ctx, userID, label, database.SnapshotSourceAuto)
+	if err != nil {
+		log.Printf("[SETTINGS-SNAPSHOT] Warning: failed to snapshot settings for user %s before %q: %v", userID, label, err)
+		return
+	}
+	log.Printf("[SETTINGS-SNAPSHOT] Saved snapshot %d for user %s: %s", snapshot.ID, userID, label)
+}
+
+// settingsSnapshotParam returns the user's snapshot named by a URL parameter
+// or query value, writing the error response when it can't
+func (s *Server) settingsSnapshotParam(c *gin.Context, userID, value string) (*database.SettingsSnapshot, bool) {
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || id <= 0 {
+		errorResponse(c, http.StatusBadRequest, fmt.Sprintf("Invalid snapshot ID %q", value))
+		return nil, false
+	}
+	snapshot, err := s.repo.GetUserSettingsSnapshot(c.Request.Context(), userID, id)
+	if err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to get settings snapshot: "+err.Error())
+		return nil, false
+	}
+	if snapshot == nil {
+		errorResponse(c, http.StatusNotFound, fmt.Sprintf("Settings snapshot %d not found", id))
+		return nil, false
+	}
+	return snapshot, true
+}
+
+// handleListSettingsSnapshots lists the user's settings snapshots, newest first
+// GET /api/futures/ginie/settings-snapshots?limit=100
+func (s *Server) handleListSettingsSnapshots(c *gin.Context) {
+	userID, ok := s.getUserIDRequired(c)
+	if !ok {
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	snapshots, err := s.repo.ListUserSettingsSnapshots(c.Request.Context(), userID, limit)
+	if err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to list settings snapshots: "+err.Error())
+		return
+	}
+	if snapshots == nil {
+		snapshots = []*database.SettingsSnapshot{}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":   true,
+		"snapshots": snapshots,
+		"count":     len(snapshots),
+	})
+}
+
+// handleCreateSettingsSnapshot saves a labelled snapshot of the user's mode
+// configs, safety settings and symbol settings
+// POST /api/futures/ginie/settings-snapshots
+func (s *Server) handleCreateSettingsSnapshot(c *gin.Context) {
+	userID, ok := s.getUserIDRequired(c)
+	if !ok {
+		return
+	}
+
+	var req settingsSnapshotRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			errorResponse(c, http.StatusBadRequest, "Invalid request body: "+err.Error())
+			return
+		}
+	}
+	req.Label = strings.TrimSpace(req.Label)
+	if len(req.Label) > 200 {
+		errorResponse(c, http.StatusBadRequest, "label must be at most 200 characters")
+		return
+	}
+
+	snapshot, err := s.repo.CaptureUserSettingsSnapshot(c.Request.Context(), userID, req.Label, database.SnapshotSourceManual)
+	if err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to save settings snapshot: "+err.Error())
+		return
+	}
+	log.Printf("[SETTINGS-SNAPSHOT] User %s saved snapshot %d: %s", userID, snapshot.ID, snapshot.Label)
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"snapshot": snapshot,
+	})
+}
+
+// handleGetSettingsSnapshot returns one snapshot with its settings
+// GET /api/futures/ginie/settings-snapshots/:id
+func (s *Server) handleGetSettingsSnapshot(c *gin.Context) {
+	userID, ok := s.getUserIDRequired(c)
+	if !ok {
+		return
+	}
+	snapshot, ok := s.settingsSnapshotParam(c, userID, c.Param("id"))
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"snapshot": snapshot,
+	})
+}
+
+// handleRestoreSettingsSnapshot writes a snapshot's settings back, taking an
+// automatic snapshot of the current settings first so the restore can be undone
+// POST /api/futures/ginie/settings-snapshots/:id/restore
+func (s *Server) handleRestoreSettingsSnapshot(c *gin.Context) {
+	userID, ok := s.getUserIDRequired(c)
+	if !ok {
+		return
+	}
+	snapshot, ok := s.settingsSnapshotParam(c, userID, c.Param("id"))
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	s.autoSnapshotSettings(ctx, userID, fmt.Sprintf("before restoring snapshot %d", snapshot.ID))
+
+	if err := s.repo.RestoreUserSettingsSnapshot(ctx, snapshot); err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to restore settings snapshot: "+err.Error())
+		return
+	}
+	log.Printf("[SETTINGS-SNAPSHOT] User %s restored snapshot %d (%s)", userID, snapshot.ID, snapshot.Label)
+
+	// Drop cached settings so the restored values are read from the database
+	if s.settingsCacheService != nil {
+		if err := s.settingsCacheService.InvalidateAllModes(ctx, userID); err != nil {
+			log.Printf("[SETTINGS-SNAPSHOT] Warning: failed to invalidate mode caches for user %s: %v", userID, err)
+		}
+		if err := s.settingsCacheService.InvalidateAllSafetySettings(ctx, userID); err != nil {
+			log.Printf("[SETTINGS-SNAPSHOT] Warning: failed to invalidate safety caches for user %s: %v", userID, err)
+		}
+	}
+
+	// Trigger immediate config reload in running autopilot
+	if s.userAutopilotManager != nil {
+		instance := s.userAutopilotManager.GetInstance(userID)
+		if instance != nil && instance.Autopilot != nil {
+			instance.Autopilot.TriggerConfigReload()
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":          true,
+		"restored_id":      snapshot.ID,
+		"modes_restored":   len(snapshot.Data.Modes),
+		"safety_restored":  len(snapshot.Data.Safety),
+		"symbols_restored": len(snapshot.Data.Symbols),
+		"message":          fmt.Sprintf("Settings restored from snapshot %d", snapshot.ID),
+	})
+}
+
+// handleDiffSettingsSnapshots lists the settings that differ between two
+// snapshots. Without "to", the snapshot is compared to the current settings.
+// GET /api/futures/ginie/settings-snapshots/diff?from=12&to=15
+func (s *Server) handleDiffSettingsSnapshots(c *gin.Context) {
+	userID, ok := s.getUserIDRequired(c)
+	if !ok {
+		return
+	}
+	from, ok := s.settingsSnapshotParam(c, userID, c.Query("from"))
+	if !ok {
+		return
+	}
+
+	toLabel := "current"
+	var toData *database.SettingsSnapshotData
+	if toParam := c.Query("to"); toParam != "" {
+		to, ok := s.settingsSnapshotParam(c, userID, toParam)
+		if !ok {
+			return
+		}
+		toLabel = strconv.FormatInt(to.ID, 10)
+		toData = to.Data
+	} else {
+		current, err := s.repo.CollectUserSettings(c.Request.Context(), userID)
+		if err != nil {
+			errorResponse(c, http.StatusInternalServerError, "Failed to read current settings: "+err.Error())
+			return
+		}
+		toData = current
+	}
+
+	changes, err := database.DiffSettingsSnapshots(from.Data, toData)
+	if err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to diff settings snapshots: "+err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"from":    from.ID,
+		"to":      toLabel,
+		"changes": changes,
+		"count":   len(changes),
+	})
+}
@@ -54,7 +54,7 @@ func (s *Server) HandleGetUserDailySummaries(w http.ResponseWriter, r *http.Requ
 		}
 	}
 
-	summaries, err := s.repo.GetDailyModeSummariesDateRange(r.Context(), userID, startDate, endDate, mode)
+	summaries, err := s.repo.ReadOnly().GetDailyModeSummariesDateRange(r.Context(), userID, startDate, endDate, mode)
 	if err != nil {
 		s.writeErrorResponse(w, http.StatusInternalServerError, "Failed to get summaries", err)
 		return
@@ -121,12 +121,12 @@ func (s *Server) HandleGetUserPerformanceSummary(w http.ResponseWriter, r *http.
 
 	switch period {
 	case "weekly":
-		summaries, err = s.repo.GetWeeklySummary(r.Context(), userID, startDate, endDate)
+		summaries, err = s.repo.ReadOnly().GetWeeklySummary(r.Context(), userID, startDate, endDate)
 	case "yearly":
 		// For yearly, we use monthly and group in frontend
-		summaries, err = s.repo.GetMonthlySummary(r.Context(), userID, startDate, endDate)
+		summaries, err = s.repo.ReadOnly().GetMonthlySummary(r.Context(), userID, startDate, endDate)
 	default: // monthly
-		summaries, err = s.repo.GetMonthlySummary(r.Context(), userID, startDate, endDate)
+		summaries, err = s.repo.ReadOnly().GetMonthlySummary(r.Context(), userID, startDate, endDate)
 	}
 
 	if err != nil {
@@ -172,7 +172,7 @@ func (s *Server) HandleGetUserModeComparison(w http.ResponseWriter, r *http.Requ
 		}
 	}
 
-	comparison, err := s.repo.GetModeComparison(r.Context(), userID, startDate, endDate)
+	comparison, err := s.repo.ReadOnly().GetModeComparison(r.Context(), userID, startDate, endDate)
 	if err != nil {
 		s.writeErrorResponse(w, http.StatusInternalServerError, "Failed to get mode comparison", err)
 		return
@@ -252,7 +252,7 @@ func (s *Server) HandleGetAdminDailySummaries(w http.ResponseWriter, r *http.Req
 		Offset:    offset,
 	}
 
-	result, err := s.repo.GetAdminDailySummaries(r.Context(), filter)
+	result, err := s.repo.ReadOnly().GetAdminDailySummaries(r.Context(), filter)
 	if err != nil {
 		s.writeErrorResponse(w, http.StatusInternalServerError, "Failed to get admin summaries", err)
 		return
@@ -309,7 +309,7 @@ func (s *Server) HandleAdminExportCSV(w http.ResponseWriter, r *http.Request) {
 		Limit:     10000, // Export up to 10000 rows
 	}
 
-	result, err := s.repo.GetAdminDailySummaries(r.Context(), filter)
+	result, err := s.repo.ReadOnly().GetAdminDailySummaries(r.Context(), filter)
 	if err != nil {
 		s.writeErrorResponse(w, http.StatusInternalServerError, "Failed to get data for export", err)
 		return
@@ -433,7 +433,7 @@ func (s *Server) HandleGetAdminSettlementStatus(w http.ResponseWriter, r *http.R
 		filter.Status = statusFilter
 	}
 
-	result, err := s.repo.GetAdminDailySummaries(r.Context(), filter)
+	result, err := s.repo.ReadOnly().GetAdminDailySummaries(r.Context(), filter)
 	if err != nil {
 		s.writeErrorResponse(w, http.StatusInternalServerError, "Failed to get settlement status", err)
 		return
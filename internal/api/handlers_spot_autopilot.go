@@ -63,6 +63,9 @@ func (s *Server) handleToggleSpotAutopilot(c *gin.Context) {
 		errorResponse(c, http.StatusBadRequest, "Invalid request body")
 		return
 	}
+	if req.DryRun != nil && s.rejectIfLiveForced(c, *req.DryRun) {
+		return
+	}
 
 	controller := s.getSpotAutopilot()
 	if controller == nil {
@@ -146,6 +149,9 @@ func (s *Server) handleSetSpotAutopilotDryRun(c *gin.Context) {
 		errorResponse(c, http.StatusBadRequest, "Invalid request body")
 		return
 	}
+	if s.rejectIfLiveForced(c, req.DryRun) {
+		return
+	}
 
 	controller := s.getSpotAutopilot()
 	if controller == nil {
@@ -81,7 +81,7 @@ func (s *Server) handleGetStrategyPerformance(c *gin.Context) {
 
 	// Get trades from database using existing method
 	ctx := c.Request.Context()
-	tradesPtr, err := s.repo.GetTradeHistory(ctx, 500, 0)
+	tradesPtr, err := s.repo.ReadOnly().GetTradeHistory(ctx, 500, 0)
 	if err != nil {
 		errorResponse(c, http.StatusInternalServerError, "Failed to get trades: "+err.Error())
 		return
@@ -255,7 +255,7 @@ func (s *Server) handleGetStrategyPerformance(c *gin.Context) {
 // handleGetOverallPerformance returns overall trading performance summary
 func (s *Server) handleGetOverallPerformance(c *gin.Context) {
 	ctx := c.Request.Context()
-	tradesPtr, err := s.repo.GetTradeHistory(ctx, 1000, 0)
+	tradesPtr, err := s.repo.ReadOnly().GetTradeHistory(ctx, 1000, 0)
 	if err != nil {
 		errorResponse(c, http.StatusInternalServerError, "Failed to get trades: "+err.Error())
 		return
@@ -356,7 +356,7 @@ func (s *Server) handleGetHistoricalSuccessRate(c *gin.Context) {
 	strategyName := c.Query("strategy")
 
 	ctx := c.Request.Context()
-	tradesPtr, err := s.repo.GetTradeHistory(ctx, 1000, 0)
+	tradesPtr, err := s.repo.ReadOnly().GetTradeHistory(ctx, 1000, 0)
 	if err != nil {
 		errorResponse(c, http.StatusInternalServerError, "Failed to get trades: "+err.Error())
 		return
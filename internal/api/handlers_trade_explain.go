@@ -0,0 +1,361 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"binance-trading-bot/internal/ai/llm"
+	"binance-trading-bot/internal/database"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ==================== TRADE EXPLANATION ====================
+
+// tradeExplainTimeout bounds the LLM call behind an explain request
+const tradeExplainTimeout = 60 * time.Second
+
+// maxTimelineEvents caps the lifecycle events sent to the LLM
+const maxTimelineEvents = 30
+
+// tradeReviewStore loads trades with their review context and caches
+// explanations on them; *database.Repository implements it
+type tradeReviewStore interface {
+	GetFuturesTradeForReview(ctx context.Context, userID string, id int64) (*database.FuturesTrade, *database.TradeExplanationCache, error)
+	GetTradeForReview(ctx context.Context, userID string, id int64) (*database.Trade, *database.TradeExplanationCache, error)
+	SaveFuturesTradeExplanation(ctx context.Context, userID string, id int64, explanation interface{}) error
+	SaveTradeExplanation(ctx context.Context, userID string, id int64, explanation interface{}) error
+	GetAIDecisionByID(ctx context.Context, id int64) (*database.AIDecision, error)
+	GetTradeLifecycleEvents(ctx context.Context, futuresTradeID int64) ([]database.TradeLifecycleEvent, error)
+}
+
+// tradeExplainer writes a trade retrospective; *llm.Analyzer implements it
+type tradeExplainer interface {
+	ExplainTrade(ctx context.Context, trade *llm.TradeReview) (*llm.TradeExplanation, error)
+}
+
+// tradeReviewStore returns the store behind trade retrospectives
+func (s *Server) tradeReviewStore() tradeReviewStore {
+	if s.tradeReviews != nil {
+		return s.tradeReviews
+	}
+	return s.repo
+}
+
+// tradeExplainer returns the explainer for trade retrospectives, nil when no
+// LLM analyzer is configured
+func (s *Server) tradeExplainer(c *gin.Context) tradeExplainer {
+	if s.tradeExplain != nil {
+		return s.tradeExplain
+	}
+	if analyzer := s.tradeExplainAnalyzer(c); analyzer != nil {
+		return analyzer
+	}
+	return nil
+}
+
+// tradeExplainAnalyzer returns the LLM analyzer used for trade retrospectives:
+// the user's Ginie autopilot's if it has one, else the shared controller's
+func (s *Server) tradeExplainAnalyzer(c *gin.Context) *llm.Analyzer {
+	if giniePilot := s.getGinieAutopilotForUser(c); giniePilot != nil {
+		if analyzer := giniePilot.GetLLMAnalyzer(); analyzer != nil && analyzer.IsEnabled() {
+			return analyzer
+		}
+	}
+	if controller := s.getFuturesAutopilot(); controller != nil {
+		if analyzer := controller.GetLLMAnalyzer(); analyzer != nil && analyzer.IsEnabled() {
+			return analyzer
+		}
+	}
+	return nil
+}
+
+// handleExplainTrade asks the LLM for a plain-English retrospective on a closed
+// trade and caches it on the trade. The cached explanation is returned unless
+// ?refresh=true. ?market=spot explains a spot trade (default futures).
+// POST /api/trades/:id/explain
+func (s *Server) handleExplainTrade(c *gin.Context) {
+	tradeID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		errorResponse(c, http.StatusBadRequest, "Invalid trade ID")
+		return
+	}
+	market := c.DefaultQuery("market", "futures")
+	refresh := c.Query("refresh") == "true"
+	ctx := c.Request.Context()
+	scope := s.tradeScope(c)
+	store := s.tradeReviewStore()
+
+	// The review context (entry decision, timeline) is only loaded once the
+	// cache has missed
+	var status string
+	var cached *database.TradeExplanationCache
+	var buildReview func() *llm.TradeReview
+	switch market {
+	case "futures":
+		var trade *database.FuturesTrade
+		trade, cached, err = store.GetFuturesTradeForReview(ctx, scope, tradeID)
+		if err == nil {
+			status = trade.Status
+			buildReview = func() *llm.TradeReview { return s.futuresTradeReview(ctx, trade) }
+		}
+	case "spot":
+		var trade *database.Trade
+		trade, cached, err = store.GetTradeForReview(ctx, scope, tradeID)
+		if err == nil {
+			status = trade.Status
+			buildReview = func() *llm.TradeReview { return s.spotTradeReview(ctx, trade) }
+		}
+	default:
+		errorResponse(c, http.StatusBadRequest, "market must be futures or spot")
+		return
+	}
+	if errors.Is(err, database.ErrTradeNotFound) {
+		errorResponse(c, http.StatusNotFound, "Trade not found")
+		return
+	}
+	if err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to load trade: "+err.Error())
+		return
+	}
+	if status == "OPEN" {
+		errorResponse(c, http.StatusBadRequest, "Only closed trades can be explained")
+		return
+	}
+
+	if cached != nil && !refresh {
+		var explanation llm.TradeExplanation
+		if err := json.Unmarshal(cached.Explanation, &explanation); err == nil {
+			c.JSON(http.StatusOK, gin.H{
+				"success":      true,
+				"trade_id":     tradeID,
+				"market":       market,
+				"explanation":  explanation,
+				"explained_at": cached.ExplainedAt,
+				"cached":       true,
+			})
+			return
+		}
+		log.Printf("[TRADE-EXPLAIN] Discarding unreadable cached explanation for %s trade %d", market, tradeID)
+	}
+
+	explainer := s.tradeExplainer(c)
+	if explainer == nil {
+		errorResponse(c, http.StatusServiceUnavailable, "No LLM analyzer is configured - add an AI provider key first")
+		return
+	}
+
+	llmCtx, cancel := context.WithTimeout(ctx, tradeExplainTimeout)
+	defer cancel()
+	explanation, err := explainer.ExplainTrade(llmCtx, buildReview())
+	if err != nil {
+		log.Printf("[TRADE-EXPLAIN] LLM failed for %s trade %d: %v", market, tradeID, err)
+		errorResponse(c, http.StatusBadGateway, "Failed to explain trade: "+err.Error())
+		return
+	}
+
+	if market == "spot" {
+		err = store.SaveTradeExplanation(ctx, scope, tradeID, explanation)
+	} else {
+		err = store.SaveFuturesTradeExplanation(ctx, scope, tradeID, explanation)
+	}
+	if err != nil {
+		// The explanation is still useful; it just won't be cached
+		log.Printf("[TRADE-EXPLAIN] Failed to cache explanation for %s trade %d: %v", market, tradeID, err)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":      true,
+		"trade_id":     tradeID,
+		"market":       market,
+		"explanation":  explanation,
+		"explained_at": time.Now(),
+		"cached":       false,
+	})
+}
+
+// futuresTradeReview gathers a closed futures trade's entry decision and
+// lifecycle events for the LLM
+func (s *Server) futuresTradeReview(ctx context.Context, trade *database.FuturesTrade) *llm.TradeReview {
+	review := &llm.TradeReview{
+		Symbol:     trade.Symbol,
+		Market:     "futures",
+		Side:       trade.PositionSide,
+		Source:     trade.TradeSource,
+		EntryPrice: trade.EntryPrice,
+		ExitPrice:  derefFloat(trade.ExitPrice),
+		Quantity:   trade.Quantity,
+		Leverage:   trade.Leverage,
+		StopLoss:   derefFloat(trade.StopLoss),
+		TakeProfit: derefFloat(trade.TakeProfit),
+		EntryTime:  trade.EntryTime,
+		ExitTime:   derefTime(trade.ExitTime, trade.EntryTime),
+		PnL:        derefFloat(trade.RealizedPnL),
+		PnLPercent: derefFloat(trade.RealizedPnLPercent),
+		MAE:        trade.MaxAdverseExcursion,
+		MFE:        trade.MaxFavorableExcursion,
+		Tags:       trade.Tags,
+		Notes:      derefString(trade.Notes),
+		Mode:       derefString(trade.TradingMode),
+		Strategy:   derefString(trade.StrategyName),
+	}
+	if review.Side == "" || review.Side == "BOTH" {
+		review.Side = trade.Side
+	}
+	review.EntryContext = s.tradeEntryContext(ctx, trade.AIDecisionID)
+
+	events, err := s.tradeReviewStore().GetTradeLifecycleEvents(ctx, trade.ID)
+	if err != nil {
+		log.Printf("[TRADE-EXPLAIN] Failed to load lifecycle events for futures trade %d: %v", trade.ID, err)
+	}
+	review.Timeline = formatTradeTimeline(events)
+	return review
+}
+
+// spotTradeReview gathers a closed spot trade's entry decision for the LLM
+func (s *Server) spotTradeReview(ctx context.Context, trade *database.Trade) *llm.TradeReview {
+	return &llm.TradeReview{
+		Symbol:       trade.Symbol,
+		Market:       "spot",
+		Side:         trade.Side,
+		Source:       trade.TradeSource,
+		EntryPrice:   trade.EntryPrice,
+		ExitPrice:    derefFloat(trade.ExitPrice),
+		Quantity:     trade.Quantity,
+		StopLoss:     derefFloat(trade.StopLoss),
+		TakeProfit:   derefFloat(trade.TakeProfit),
+		EntryTime:    trade.EntryTime,
+		ExitTime:     derefTime(trade.ExitTime, trade.EntryTime),
+		PnL:          derefFloat(trade.PnL),
+		PnLPercent:   derefFloat(trade.PnLPercent),
+		Tags:         trade.Tags,
+		Notes:        derefString(trade.Notes),
+		Strategy:     derefString(trade.StrategyName),
+		EntryContext: s.tradeEntryContext(ctx, trade.AIDecisionID),
+	}
+}
+
+// tradeEntryContext loads the AI decision behind a trade, if any, and
+// describes it for the LLM
+func (s *Server) tradeEntryContext(ctx context.Context, decisionID *int64) string {
+	if decisionID == nil {
+		return ""
+	}
+	decision, err := s.tradeReviewStore().GetAIDecisionByID(ctx, *decisionID)
+	if err != nil {
+		log.Printf("[TRADE-EXPLAIN] Failed to load AI decision %d: %v", *decisionID, err)
+		return ""
+	}
+	return formatEntryDecision(decision)
+}
+
+// formatEntryDecision describes an entry decision: action, reasoning, each
+// signal source's vote and the recorded indicators
+func formatEntryDecision(d *database.AIDecision) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Decision: %s at %.8f (confidence %.0f%%, risk %s, %d confluent signals)\n",
+		d.Action, d.CurrentPrice, d.Confidence*100, d.RiskLevel, d.ConfluenceCount)
+	if d.Reasoning != "" {
+		fmt.Fprintf(&b, "Reasoning: %s\n", d.Reasoning)
+	}
+
+	sources := []struct {
+		name       string
+		direction  *string
+		confidence *float64
+	}{
+		{"ML", d.MLDirection, d.MLConfidence},
+		{"Sentiment", d.SentimentDirection, d.SentimentConfidence},
+		{"LLM", d.LLMDirection, d.LLMConfidence},
+		{"Pattern", d.PatternDirection, d.PatternConfidence},
+		{"Big candle", d.BigCandleDirection, d.BigCandleConfidence},
+	}
+	var votes []string
+	for _, src := range sources {
+		if src.direction == nil || *src.direction == "" {
+			continue
+		}
+		vote := src.name + " " + *src.direction
+		if src.confidence != nil {
+			vote += fmt.Sprintf(" (%.0f%%)", *src.confidence*100)
+		}
+		votes = append(votes, vote)
+	}
+	if len(votes) > 0 {
+		fmt.Fprintf(&b, "Signal sources: %s\n", strings.Join(votes, ", "))
+	}
+
+	if len(d.Signals) > 0 {
+		keys := make([]string, 0, len(d.Signals))
+		for k := range d.Signals {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		conditions := make([]string, 0, len(keys))
+		for _, k := range keys {
+			conditions = append(conditions, fmt.Sprintf("%s=%v", k, d.Signals[k]))
+		}
+		fmt.Fprintf(&b, "Market conditions: %s\n", strings.Join(conditions, ", "))
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// formatTradeTimeline renders lifecycle events one line each, oldest first,
+// keeping the first and latest events when there are too many
+func formatTradeTimeline(events []database.TradeLifecycleEvent) []string {
+	if len(events) > maxTimelineEvents {
+		events = append(events[:maxTimelineEvents/2:maxTimelineEvents/2], events[len(events)-maxTimelineEvents/2:]...)
+	}
+	lines := make([]string, 0, len(events))
+	for _, e := range events {
+		line := e.Timestamp.UTC().Format("2006-01-02 15:04:05") + " " + e.EventType
+		if e.EventSubtype != nil && *e.EventSubtype != "" {
+			line += "/" + *e.EventSubtype
+		}
+		if e.OldValue != nil && e.NewValue != nil {
+			line += fmt.Sprintf(" %.8g -> %.8g", *e.OldValue, *e.NewValue)
+		} else if e.NewValue != nil {
+			line += fmt.Sprintf(" %.8g", *e.NewValue)
+		}
+		if e.TriggerPrice != nil {
+			line += fmt.Sprintf(" @ %.8g", *e.TriggerPrice)
+		}
+		if e.PnLRealized != nil {
+			line += fmt.Sprintf(", realized $%.2f", *e.PnLRealized)
+		}
+		if e.Reason != nil && *e.Reason != "" {
+			line += " (" + *e.Reason + ")"
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func derefFloat(v *float64) float64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+func derefString(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
+
+func derefTime(v *time.Time, fallback time.Time) time.Time {
+	if v == nil {
+		return fallback
+	}
+	return *v
+}
@@ -0,0 +1,198 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"binance-trading-bot/internal/ai/llm"
+	"binance-trading-bot/internal/auth"
+	"binance-trading-bot/internal/database"
+
+	"github.com/gin-gonic/gin"
+)
+
+// fakeTradeReviews is an in-memory trade review store that counts how often
+// the review context is loaded
+type fakeTradeReviews struct {
+	owners   map[int64]string
+	futures  map[int64]*database.FuturesTrade
+	cache    map[int64]*database.TradeExplanationCache
+	loads    int // Entry decision and timeline lookups
+	saved    int
+	decision int64
+}
+
+func newFakeTradeReviews() *fakeTradeReviews {
+	return &fakeTradeReviews{
+		owners:  make(map[int64]string),
+		futures: make(map[int64]*database.FuturesTrade),
+		cache:   make(map[int64]*database.TradeExplanationCache),
+	}
+}
+
+func (f *fakeTradeReviews) add(owner string, trade *database.FuturesTrade) {
+	f.owners[trade.ID] = owner
+	f.futures[trade.ID] = trade
+}
+
+func (f *fakeTradeReviews) GetFuturesTradeForReview(_ context.Context, userID string, id int64) (*database.FuturesTrade, *database.TradeExplanationCache, error) {
+	trade, ok := f.futures[id]
+	if !ok || (userID != "" && f.owners[id] != userID) {
+		return nil, nil, fmt.Errorf("futures trade %d: %w", id, database.ErrTradeNotFound)
+	}
+	return trade, f.cache[id], nil
+}
+
+func (f *fakeTradeReviews) GetTradeForReview(_ context.Context, _ string, id int64) (*database.Trade, *database.TradeExplanationCache, error) {
+	return nil, nil, fmt.Errorf("trade %d: %w", id, database.ErrTradeNotFound)
+}
+
+func (f *fakeTradeReviews) SaveFuturesTradeExplanation(_ context.Context, _ string, id int64, explanation interface{}) error {
+	data, err := json.Marshal(explanation)
+	if err != nil {
+		return err
+	}
+	f.saved++
+	f.cache[id] = &database.TradeExplanationCache{Explanation: data, ExplainedAt: time.Now()}
+	return nil
+}
+
+func (f *fakeTradeReviews) SaveTradeExplanation(_ context.Context, _ string, id int64, _ interface{}) error {
+	return fmt.Errorf("trade %d: %w", id, database.ErrTradeNotFound)
+}
+
+func (f *fakeTradeReviews) GetAIDecisionByID(_ context.Context, id int64) (*database.AIDecision, error) {
+	f.loads++
+	f.decision = id
+	return &database.AIDecision{ID: id, Action: "BUY", Confidence: 0.8}, nil
+}
+
+func (f *fakeTradeReviews) GetTradeLifecycleEvents(_ context.Context, _ int64) ([]database.TradeLifecycleEvent, error) {
+	f.loads++
+	return nil, nil
+}
+
+// fakeTradeExplainer returns a canned explanation and keeps the last review
+type fakeTradeExplainer struct {
+	calls  int
+	review *llm.TradeReview
+}
+
+func (f *fakeTradeExplainer) ExplainTrade(_ context.Context, trade *llm.TradeReview) (*llm.TradeExplanation, error) {
+	f.calls++
+	f.review = trade
+	return &llm.TradeExplanation{Summary: fmt.Sprintf("explanation %d", f.calls)}, nil
+}
+
+const (
+	explainOwner = "11111111-1111-1111-1111-111111111111"
+	explainOther = "22222222-2222-2222-2222-222222222222"
+)
+
+func newTradeExplainTestServer() (*Server, *fakeTradeReviews, *fakeTradeExplainer) {
+	decisionID := int64(7)
+	exit := 105.0
+	store := newFakeTradeReviews()
+	store.add(explainOwner, &database.FuturesTrade{ID: 1, Symbol: "BTCUSDT", Side: "BUY", Status: "CLOSED",
+		EntryPrice: 100, ExitPrice: &exit, Quantity: 1, AIDecisionID: &decisionID, EntryTime: time.Now().Add(-time.Hour)})
+	store.add(explainOwner, &database.FuturesTrade{ID: 2, Symbol: "ETHUSDT", Side: "BUY", Status: "OPEN",
+		EntryPrice: 100, Quantity: 1, EntryTime: time.Now()})
+	explainer := &fakeTradeExplainer{}
+	return &Server{authEnabled: true, tradeReviews: store, tradeExplain: explainer}, store, explainer
+}
+
+// explainTrade posts an explain request as userID and decodes the response
+func explainTrade(t *testing.T, s *Server, userID, path string) (int, map[string]interface{}) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/api/trades/:id/explain", func(c *gin.Context) {
+		c.Set(auth.ContextKeyUserID, userID)
+		s.handleExplainTrade(c)
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, path, nil))
+	var body map[string]interface{}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("Failed to parse response %q: %v", w.Body.String(), err)
+	}
+	return w.Code, body
+}
+
+func TestExplainTradeReturnsCachedExplanation(t *testing.T) {
+	s, store, explainer := newTradeExplainTestServer()
+
+	code, body := explainTrade(t, s, explainOwner, "/api/trades/1/explain")
+	if code != http.StatusOK || body["cached"] != false {
+		t.Fatalf("first explain = %d %v, want a fresh explanation", code, body)
+	}
+	if explainer.calls != 1 || store.saved != 1 || store.decision != 7 {
+		t.Fatalf("LLM calls %d, saves %d, decision %d; want 1, 1, 7", explainer.calls, store.saved, store.decision)
+	}
+
+	loads := store.loads
+	code, body = explainTrade(t, s, explainOwner, "/api/trades/1/explain")
+	if code != http.StatusOK || body["cached"] != true {
+		t.Fatalf("second explain = %d %v, want the cached explanation", code, body)
+	}
+	if summary := body["explanation"].(map[string]interface{})["summary"]; summary != "explanation 1" {
+		t.Errorf("cached summary %v, want the first explanation", summary)
+	}
+	if explainer.calls != 1 {
+		t.Errorf("LLM called %d times, want the cache to answer", explainer.calls)
+	}
+	if store.loads != loads {
+		t.Error("review context loaded for a cached explanation")
+	}
+}
+
+func TestExplainTradeRefreshRegenerates(t *testing.T) {
+	s, store, explainer := newTradeExplainTestServer()
+	explainTrade(t, s, explainOwner, "/api/trades/1/explain")
+
+	code, body := explainTrade(t, s, explainOwner, "/api/trades/1/explain?refresh=true")
+	if code != http.StatusOK || body["cached"] != false {
+		t.Fatalf("refresh = %d %v, want a fresh explanation", code, body)
+	}
+	if explainer.calls != 2 || store.saved != 2 {
+		t.Errorf("LLM calls %d, saves %d; want 2 each", explainer.calls, store.saved)
+	}
+	if explainer.review == nil || explainer.review.EntryContext == "" {
+		t.Error("refreshed review is missing the entry decision")
+	}
+
+	_, body = explainTrade(t, s, explainOwner, "/api/trades/1/explain")
+	if summary := body["explanation"].(map[string]interface{})["summary"]; summary != "explanation 2" {
+		t.Errorf("cached summary %v after refresh, want the refreshed explanation", summary)
+	}
+}
+
+func TestExplainTradeRejectsOpenTrade(t *testing.T) {
+	s, store, explainer := newTradeExplainTestServer()
+
+	code, _ := explainTrade(t, s, explainOwner, "/api/trades/2/explain")
+	if code != http.StatusBadRequest {
+		t.Errorf("open trade = %d, want 400", code)
+	}
+	if explainer.calls != 0 || store.loads != 0 || store.saved != 0 {
+		t.Errorf("open trade reached the LLM (%d), review context (%d) or cache (%d)", explainer.calls, store.loads, store.saved)
+	}
+}
+
+func TestExplainTradeRejectsOtherUsersTrade(t *testing.T) {
+	s, store, explainer := newTradeExplainTestServer()
+
+	code, _ := explainTrade(t, s, explainOther, "/api/trades/1/explain")
+	if code != http.StatusNotFound {
+		t.Errorf("another user's trade = %d, want 404", code)
+	}
+	if explainer.calls != 0 || store.saved != 0 {
+		t.Errorf("another user's trade reached the LLM (%d) or cache (%d)", explainer.calls, store.saved)
+	}
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"binance-trading-bot/internal/database"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ==================== TRADE JOURNAL ====================
+
+// tradeJournalRequest is the body of a journal update. Omitted fields are left
+// unchanged; "notes": "" clears the notes and "tags": [] clears the tags.
+type tradeJournalRequest struct {
+	Market string   `json:"market"` // futures (default) or spot
+	Notes  *string  `json:"notes"`
+	Tags   []string `json:"tags"`
+}
+
+// tradeScope returns the user ID that trade journal and analytics queries are
+// scoped to. With auth disabled trades may have no owner, so the scope is dropped.
+func (s *Server) tradeScope(c *gin.Context) string {
+	if !s.authEnabled {
+		return ""
+	}
+	return s.getUserID(c)
+}
+
+// handleUpdateTradeJournal adds or edits notes and tags on a trade
+// PATCH /api/trades/:id/journal
+func (s *Server) handleUpdateTradeJournal(c *gin.Context) {
+	tradeID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		errorResponse(c, http.StatusBadRequest, "Invalid trade ID")
+		return
+	}
+
+	var req tradeJournalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Invalid request body: "+err.Error())
+		return
+	}
+	if req.Notes == nil && req.Tags == nil {
+		errorResponse(c, http.StatusBadRequest, "Provide notes and/or tags")
+		return
+	}
+
+	update := database.TradeJournalUpdate{Notes: req.Notes, Tags: req.Tags}
+	ctx := c.Request.Context()
+	scope := s.tradeScope(c)
+
+	switch req.Market {
+	case "", "futures":
+		req.Market = "futures"
+		err = s.repo.GetDB().UpdateFuturesTradeJournal(ctx, scope, tradeID, update)
+	case "spot":
+		err = s.repo.UpdateTradeJournal(ctx, scope, tradeID, update)
+	default:
+		errorResponse(c, http.StatusBadRequest, "market must be futures or spot")
+		return
+	}
+	if errors.Is(err, database.ErrTradeNotFound) {
+		errorResponse(c, http.StatusNotFound, "Trade not found")
+		return
+	}
+	if err != nil {
+		errorResponse(c, http.StatusInternalServerError, "Failed to update trade journal: "+err.Error())
+		return
+	}
+
+	response := gin.H{
+		"success":  true,
+		"trade_id": tradeID,
+		"market":   req.Market,
+	}
+	if req.Notes != nil {
+		response["notes"] = *req.Notes
+	}
+	if req.Tags != nil {
+		response["tags"] = database.NormalizeTradeTags(req.Tags)
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// journalTagQuery returns the ?tag= report filter normalized like stored tags
+func journalTagQuery(c *gin.Context) string {
+	tags := database.NormalizeTradeTags([]string{c.Query("tag")})
+	if len(tags) == 0 {
+		return ""
+	}
+	return tags[0]
+}
@@ -0,0 +1,340 @@
+package api
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"binance-trading-bot/internal/database"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxTradingViewAlertBytes caps the webhook body read
+const maxTradingViewAlertBytes = 64 * 1024
+
+// TradingViewWebhookConfig configures the TradingView alert webhook
+type TradingViewWebhookConfig struct {
+	Enabled        bool
+	Secret         string   // HMAC key for X-Signature, or the token sent as X-Webhook-Token, ?token= or payload "secret"
+	AllowedSymbols []string // Empty = any symbol
+}
+
+// tradingViewAlert is a parsed TradingView alert
+type tradingViewAlert struct {
+	Symbol     string
+	Action     string // BUY, SELL or CLOSE
+	Price      float64
+	StopLoss   float64
+	TakeProfit float64
+	Quantity   float64
+	Strategy   string
+	Secret     string
+	Comment    string
+}
+
+// Payload keys accepted for each alert field, so alert templates can use
+// TradingView's own placeholder names ({{ticker}}, {{strategy.order.action}}, {{close}})
+var tradingViewAlertKeys = map[string][]string{
+	"symbol":      {"symbol", "ticker", "pair"},
+	"action":      {"action", "side", "signal", "order_action"},
+	"price":       {"price", "close", "entry", "entry_price"},
+	"stop_loss":   {"stop_loss", "sl", "stop", "stoploss"},
+	"take_profit": {"take_profit", "tp", "target", "takeprofit"},
+	"quantity":    {"quantity", "qty", "size", "contracts"},
+	"strategy":    {"strategy", "strategy_name", "name"},
+	"secret":      {"secret", "passphrase", "token"},
+	"comment":     {"comment", "message", "reason"},
+}
+
+// parseTradingViewAlert parses a JSON object alert, or a text alert of the form
+// "buy BTCUSDT sl=41000 tp=45000 qty=0.01" (action and symbol in any order,
+// key=value pairs using the same keys as the JSON form)
+func parseTradingViewAlert(body []byte) (*tradingViewAlert, error) {
+	fields := make(map[string]string)
+
+	trimmed := strings.TrimSpace(string(body))
+	if trimmed == "" {
+		return nil, fmt.Errorf("empty alert")
+	}
+
+	if strings.HasPrefix(trimmed, "{") {
+		var raw map[string]interface{}
+		if err := json.Unmarshal([]byte(trimmed), &raw); err != nil {
+			return nil, fmt.Errorf("invalid JSON alert: %w", err)
+		}
+		for key, value := range raw {
+			switch v := value.(type) {
+			case string:
+				fields[strings.ToLower(key)] = v
+			case float64:
+				fields[strings.ToLower(key)] = strconv.FormatFloat(v, 'f', -1, 64)
+			}
+		}
+	} else {
+		for _, token := range strings.Fields(trimmed) {
+			if key, value, ok := strings.Cut(token, "="); ok {
+				fields[strings.ToLower(key)] = value
+				continue
+			}
+			if _, isAction := normalizeTradingViewAction(token); isAction && fields["action"] == "" {
+				fields["action"] = token
+			} else if fields["symbol"] == "" {
+				fields["symbol"] = token
+			}
+		}
+	}
+
+	lookup := func(field string) string {
+		for _, key := range tradingViewAlertKeys[field] {
+			if v := strings.TrimSpace(fields[key]); v != "" {
+				return v
+			}
+		}
+		return ""
+	}
+	number := func(field string) (float64, error) {
+		v := lookup(field)
+		if v == "" {
+			return 0, nil
+		}
+		n, err := strconv.ParseFloat(v, 64)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid %s %q", field, v)
+		}
+		return n, nil
+	}
+
+	alert := &tradingViewAlert{
+		Symbol:   normalizeTradingViewSymbol(lookup("symbol")),
+		Strategy: lookup("strategy"),
+		Secret:   lookup("secret"),
+		Comment:  lookup("comment"),
+	}
+	if alert.Symbol == "" {
+		return nil, fmt.Errorf("alert has no symbol")
+	}
+
+	action, ok := normalizeTradingViewAction(lookup("action"))
+	if !ok {
+		return nil, fmt.Errorf("alert action %q must be buy, sell, long, short or close", lookup("action"))
+	}
+	alert.Action = action
+
+	var err error
+	if alert.Price, err = number("price"); err != nil {
+		return nil, err
+	}
+	if alert.StopLoss, err = number("stop_loss"); err != nil {
+		return nil, err
+	}
+	if alert.TakeProfit, err = number("take_profit"); err != nil {
+		return nil, err
+	}
+	if alert.Quantity, err = number("quantity"); err != nil {
+		return nil, err
+	}
+	return alert, nil
+}
+
+// normalizeTradingViewAction maps alert actions to BUY, SELL or CLOSE
+func normalizeTradingViewAction(action string) (string, bool) {
+	switch strings.ToLower(strings.TrimSpace(action)) {
+	case "buy", "long":
+		return "BUY", true
+	case "sell", "short":
+		return "SELL", true
+	case "close", "exit", "flat":
+		return "CLOSE", true
+	}
+	return "", false
+}
+
+// normalizeTradingViewSymbol strips TradingView's exchange prefix and
+// perpetual suffix: "BINANCE:BTCUSDT.P" -> "BTCUSDT"
+func normalizeTradingViewSymbol(symbol string) string {
+	symbol = strings.ToUpper(strings.TrimSpace(symbol))
+	if i := strings.LastIndex(symbol, ":"); i >= 0 {
+		symbol = symbol[i+1:]
+	}
+	symbol = strings.TrimSuffix(symbol, ".P")
+	symbol = strings.TrimSuffix(symbol, "PERP")
+	return symbol
+}
+
+// authenticateTradingViewAlert accepts either a hex HMAC-SHA256 of the body in
+// X-Signature, or the shared secret as X-Webhook-Token, ?token= or the payload's
+// "secret" field (TradingView itself can't set headers)
+func authenticateTradingViewAlert(c *gin.Context, secret string, body []byte, payloadSecret string) bool {
+	if signature := c.GetHeader("X-Signature"); signature != "" {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(body)
+		expected := hex.EncodeToString(mac.Sum(nil))
+		return hmac.Equal([]byte(strings.ToLower(signature)), []byte(expected))
+	}
+
+	token := c.GetHeader("X-Webhook-Token")
+	if token == "" {
+		token = c.Query("token")
+	}
+	if token == "" {
+		token = payloadSecret
+	}
+	return token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(secret)) == 1
+}
+
+// tradingViewSymbolAllowed checks the symbol against the allowlist (empty allows any)
+func tradingViewSymbolAllowed(symbol string, allowed []string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, s := range allowed {
+		if strings.EqualFold(s, symbol) {
+			return true
+		}
+	}
+	return false
+}
+
+// handleTradingViewWebhook turns a TradingView alert into a pending signal and
+// executes it through the same path as a manually confirmed signal, so the
+// bot's position sizing, risk limits and dry-run setting all apply
+func (s *Server) handleTradingViewWebhook(c *gin.Context) {
+	cfg := s.config.TradingViewWebhook
+	if !cfg.Enabled {
+		errorResponse(c, http.StatusNotFound, "TradingView webhook is not enabled")
+		return
+	}
+	if cfg.Secret == "" {
+		errorResponse(c, http.StatusServiceUnavailable, "TradingView webhook secret is not configured")
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxTradingViewAlertBytes))
+	if err != nil {
+		errorResponse(c, http.StatusBadRequest, "Failed to read alert body")
+		return
+	}
+
+	alert, parseErr := parseTradingViewAlert(body)
+	payloadSecret := ""
+	if alert != nil {
+		payloadSecret = alert.Secret
+	}
+	if !authenticateTradingViewAlert(c, cfg.Secret, body, payloadSecret) {
+		log.Printf("[TRADINGVIEW] Rejected unauthenticated alert from %s", c.ClientIP())
+		errorResponse(c, http.StatusUnauthorized, "Invalid webhook signature or token")
+		return
+	}
+	if parseErr != nil {
+		errorResponse(c, http.StatusBadRequest, "Invalid alert: "+parseErr.Error())
+		return
+	}
+
+	if !tradingViewSymbolAllowed(alert.Symbol, cfg.AllowedSymbols) {
+		log.Printf("[TRADINGVIEW] Rejected alert for %s: not in the symbol allowlist", alert.Symbol)
+		errorResponse(c, http.StatusForbidden, alert.Symbol+" is not in the webhook symbol allowlist")
+		return
+	}
+
+	if s.botAPI == nil {
+		errorResponse(c, http.StatusServiceUnavailable, "Trading bot not available")
+		return
+	}
+
+	if alert.Action == "CLOSE" {
+		if err := s.botAPI.ClosePosition(alert.Symbol); err != nil {
+			errorResponse(c, http.StatusInternalServerError, "Failed to close position: "+err.Error())
+			return
+		}
+		log.Printf("[TRADINGVIEW] Closed %s on alert", alert.Symbol)
+		successResponse(c, gin.H{"message": "Position closed", "symbol": alert.Symbol})
+		return
+	}
+
+	currentPrice := alert.Price
+	if priceClient, ok := s.botAPI.GetBinanceClient().(interface {
+		GetCurrentPrice(symbol string) (float64, error)
+	}); ok {
+		if price, err := priceClient.GetCurrentPrice(alert.Symbol); err == nil && price > 0 {
+			currentPrice = price
+		}
+	}
+	entryPrice := alert.Price
+	if entryPrice <= 0 {
+		entryPrice = currentPrice
+	}
+	if entryPrice <= 0 {
+		errorResponse(c, http.StatusBadRequest, "Alert has no price and the current price is unavailable")
+		return
+	}
+
+	strategyName := "tradingview"
+	if alert.Strategy != "" {
+		strategyName = "tradingview:" + alert.Strategy
+	}
+	reason := "TradingView alert"
+	if alert.Comment != "" {
+		reason += ": " + alert.Comment
+	}
+
+	signal := &database.PendingSignal{
+		StrategyName:  strategyName,
+		Symbol:        alert.Symbol,
+		SignalType:    alert.Action,
+		EntryPrice:    entryPrice,
+		CurrentPrice:  currentPrice,
+		Reason:        &reason,
+		ConditionsMet: map[string]interface{}{"source": "tradingview_webhook"},
+		Timestamp:     time.Now(),
+		Status:        "PENDING",
+	}
+	if alert.StopLoss > 0 {
+		signal.StopLoss = &alert.StopLoss
+	}
+	if alert.TakeProfit > 0 {
+		signal.TakeProfit = &alert.TakeProfit
+	}
+	if alert.Quantity > 0 {
+		signal.Quantity = &alert.Quantity
+	}
+
+	ctx := c.Request.Context()
+	if s.repo != nil {
+		if err := s.repo.CreatePendingSignal(ctx, signal); err != nil {
+			log.Printf("[TRADINGVIEW] Failed to record alert for %s: %v", alert.Symbol, err)
+		}
+	}
+
+	log.Printf("[TRADINGVIEW] Executing %s %s @ %.8f (sl=%.8f tp=%.8f qty=%.8f)",
+		alert.Action, alert.Symbol, entryPrice, alert.StopLoss, alert.TakeProfit, alert.Quantity)
+
+	if err := s.botAPI.ExecutePendingSignal(signal); err != nil {
+		if s.repo != nil && signal.ID != 0 {
+			s.repo.UpdatePendingSignalStatus(ctx, signal.ID, "REJECTED", currentPrice)
+		}
+		errorResponse(c, http.StatusUnprocessableEntity, "Signal rejected: "+err.Error())
+		return
+	}
+	if s.repo != nil && signal.ID != 0 {
+		if err := s.repo.UpdatePendingSignalStatus(ctx, signal.ID, "CONFIRMED", currentPrice); err != nil {
+			log.Printf("[TRADINGVIEW] Failed to update signal %d status: %v", signal.ID, err)
+		}
+	}
+
+	successResponse(c, gin.H{
+		"message":   "Alert executed",
+		"signal_id": signal.ID,
+		"symbol":    alert.Symbol,
+		"action":    alert.Action,
+	})
+}
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestParseTradingViewAlertJSON(t *testing.T) {
+	alert, err := parseTradingViewAlert([]byte(`{"ticker":"BINANCE:ETHUSDT.P","action":"long","close":2500.5,"sl":"2450","tp":2600,"qty":0.1,"secret":"s3cret"}`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if alert.Symbol != "ETHUSDT" || alert.Action != "BUY" {
+		t.Errorf("Unexpected symbol/action: %s %s", alert.Symbol, alert.Action)
+	}
+	if alert.Price != 2500.5 || alert.StopLoss != 2450 || alert.TakeProfit != 2600 || alert.Quantity != 0.1 {
+		t.Errorf("Unexpected levels: %+v", alert)
+	}
+	if alert.Secret != "s3cret" {
+		t.Errorf("Expected payload secret, got %q", alert.Secret)
+	}
+}
+
+func TestParseTradingViewAlertText(t *testing.T) {
+	alert, err := parseTradingViewAlert([]byte("BTCUSDT sell sl=45000 tp=41000"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if alert.Symbol != "BTCUSDT" || alert.Action != "SELL" || alert.StopLoss != 45000 || alert.TakeProfit != 41000 {
+		t.Errorf("Unexpected alert: %+v", alert)
+	}
+
+	for _, body := range []string{"", "BTCUSDT hold", `{"action":"buy"}`, "buy BTCUSDT sl=abc"} {
+		if _, err := parseTradingViewAlert([]byte(body)); err == nil {
+			t.Errorf("Expected error for %q", body)
+		}
+	}
+}
+
+func TestAuthenticateTradingViewAlert(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	body := []byte(`{"ticker":"BTCUSDT","action":"buy"}`)
+	mac := hmac.New(sha256.New, []byte("key"))
+	mac.Write(body)
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	cases := []struct {
+		name    string
+		header  string
+		value   string
+		url     string
+		payload string
+		want    bool
+	}{
+		{"valid signature", "X-Signature", signature, "/", "", true},
+		{"bad signature", "X-Signature", "deadbeef", "/", "key", false},
+		{"header token", "X-Webhook-Token", "key", "/", "", true},
+		{"query token", "", "", "/?token=key", "", true},
+		{"payload secret", "", "", "/", "key", true},
+		{"wrong token", "X-Webhook-Token", "nope", "/", "", false},
+		{"no credentials", "", "", "/", "", false},
+	}
+	for _, tc := range cases {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodPost, tc.url, nil)
+		if tc.header != "" {
+			c.Request.Header.Set(tc.header, tc.value)
+		}
+		if got := authenticateTradingViewAlert(c, "key", body, tc.payload); got != tc.want {
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
@@ -86,6 +86,12 @@ type Server struct {
 	// Multi-user autopilot manager (per-user autopilot instances)
 	userAutopilotManager *autopilot.UserAutopilotManager
 
+	// Extra futures accounts run alongside the admin's primary account (may be nil)
+	accountManager *autopilot.AccountManager
+
+	// Per-symbol performance category auto-classifier (nil without a database)
+	symbolClassifier *autopilot.SymbolAutoClassifier
+
 	// Story 6.5: Settings cache service for cache-first API pattern
 	settingsCacheService *cache.SettingsCacheService
 
@@ -94,14 +100,22 @@ type Server struct {
 
 	// Epic 8: Settlement service for daily P&L and analytics
 	settlementService *settlement.SettlementService
+
+	// Local queue of event records that failed to persist
+	deadLetters *database.DeadLetterQueue
+
+	// Trade retrospective overrides; nil uses the repository and the user's LLM analyzer
+	tradeReviews tradeReviewStore
+	tradeExplain tradeExplainer
 }
 
 // ServerConfig holds server configuration
 type ServerConfig struct {
-	Port            int
-	Host            string
-	ProductionMode  bool
-	StaticFilesPath string
+	Port               int
+	Host               string
+	ProductionMode     bool
+	StaticFilesPath    string
+	TradingViewWebhook TradingViewWebhookConfig
 }
 
 // BotAPI interface defines methods the bot must expose to the API
@@ -116,7 +130,8 @@ type BotAPI interface {
 	GetBinanceClient() interface{}
 	GetClient() interface{} // Returns *binance.Client for backtest
 	ExecutePendingSignal(signal *database.PendingSignal) error
-	GetScanner() interface{} // Returns *scanner.Scanner
+	GetScanner() interface{}  // Returns *scanner.Scanner
+	GetScreener() interface{} // Returns *screener.Screener
 }
 
 // NewServer creates a new API server
@@ -194,6 +209,7 @@ func (s *Server) rateLimitMiddleware() gin.HandlerFunc {
 		"/api/futures/ginie/decisions":                 true,
 		"/api/futures/ginie/blocked-coins":             true,
 		"/api/futures/ginie/rate-limiter/status":       true,
+		"/api/futures/ginie/equity-scaling":            true,
 		// LLM & Adaptive AI endpoints (internal state only - Story 2.8)
 		"/api/futures/ginie/llm-config":                true,
 		"/api/futures/ginie/adaptive-recommendations":  true,
@@ -309,6 +325,14 @@ func (s *Server) setupRoutes() {
 		api.POST("/positions/:symbol/close", s.handleClosePosition)
 		api.POST("/positions/close-all", s.handleCloseAllPositions)
 
+		// Trade journal endpoints
+		api.PATCH("/trades/:id/journal", s.handleUpdateTradeJournal)
+		api.POST("/trades/:id/explain", s.handleExplainTrade)
+
+		// Analytics endpoints
+		api.GET("/analytics/confidence", s.handleGetConfidenceAnalysis)
+		api.GET("/analytics/attribution", s.handleGetPnLAttribution)
+
 		// Order endpoints
 		api.GET("/orders", s.handleGetActiveOrders)
 		api.GET("/orders/history", s.handleGetOrderHistory)
@@ -318,6 +342,7 @@ func (s *Server) setupRoutes() {
 		// Strategy endpoints
 		api.GET("/strategies", s.handleGetStrategies)
 		api.PUT("/strategies/:name/toggle", s.handleToggleStrategy)
+		api.POST("/strategies/evaluate", s.handleEvaluateStrategy)
 
 		// Strategy config endpoints
 		api.GET("/strategy-configs", s.handleGetStrategyConfigs)
@@ -345,6 +370,7 @@ func (s *Server) setupRoutes() {
 
 		// Screener endpoints
 		api.GET("/screener/results", s.handleGetScreenerResults)
+		api.GET("/screener/stats", s.handleGetScreenerStats)
 
 		// Binance data endpoints
 		api.GET("/binance/symbols", s.handleGetBinanceSymbols)
@@ -356,6 +382,7 @@ func (s *Server) setupRoutes() {
 		// Strategy scanner endpoints
 		api.GET("/strategy-scanner/scan", s.handleGetScanResults)
 		api.POST("/strategy-scanner/refresh", s.handleRefreshScan)
+		api.GET("/strategy-scanner/metrics", s.handleGetScanMetrics)
 
 		// Watchlist endpoints
 		api.GET("/watchlist", s.handleGetWatchlist)
@@ -364,6 +391,8 @@ func (s *Server) setupRoutes() {
 
 		// Metrics endpoints
 		api.GET("/metrics", s.handleGetMetrics)
+		api.GET("/metrics/db-pool", s.handleGetDBPoolMetrics)
+		api.GET("/metrics/event-dlq", s.handleGetDeadLetterMetrics)
 
 		// System events
 		api.GET("/events", s.handleGetSystemEvents)
@@ -381,6 +410,9 @@ func (s *Server) setupRoutes() {
 		// License endpoints
 		api.GET("/license", s.handleGetLicenseInfo)
 		api.GET("/license/feature/:feature", s.handleCheckFeature)
+		api.POST("/license/activate", s.handleActivateLicense)
+		api.POST("/license/deactivate", s.handleDeactivateLicense)
+		api.POST("/license/transfer", s.handleTransferLicense)
 
 		// Settings & Control endpoints
 		settings := api.Group("/settings")
@@ -494,6 +526,7 @@ func (s *Server) setupRoutes() {
 			futures.GET("/funding-fees/history", s.handleGetFundingFeeHistory)
 			futures.GET("/transactions/history", s.handleGetFuturesTransactionHistory)
 			futures.GET("/income-history", s.handleGetIncomeHistory) // PnL, fees, funding from Binance
+			futures.GET("/income/reconciliation", s.handleGetIncomeReconciliation) // Exchange vs recorded PnL
 			futures.GET("/pnl-summary", s.handleGetPnLSummary)      // Daily/Weekly PnL with fees breakdown
 			futures.GET("/test-daily-pnl", s.handleTestDailyPnLFromTrades) // Test: Compare trades vs income history
 			futures.GET("/metrics", s.handleGetFuturesMetrics)
@@ -546,6 +579,7 @@ func (s *Server) setupRoutes() {
 			// Sentiment & News endpoints
 			futures.GET("/sentiment/news", s.handleGetSentimentNews)
 			futures.GET("/sentiment/breaking", s.handleGetBreakingNews)
+			futures.GET("/sentiment/symbol/:symbol", s.handleGetSymbolSentiment)
 
 			// Position averaging endpoints
 			futures.GET("/autopilot/averaging/status", s.handleGetAveragingStatus)
@@ -634,6 +668,10 @@ func (s *Server) setupRoutes() {
 			futures.GET("/autopilot/symbols/report", s.handleGetSymbolPerformanceReport)
 			futures.POST("/autopilot/symbols/refresh-performance", s.handleRefreshSymbolPerformance)
 			futures.GET("/autopilot/symbols/category/:category", s.handleGetSymbolsByCategory)
+			futures.GET("/autopilot/symbols/classification", s.handleGetSymbolClassification)
+			futures.POST("/autopilot/symbols/classification/run", s.handleRunSymbolClassification)
+			futures.PUT("/autopilot/symbols/:symbol/category-override", s.handleSetSymbolCategoryOverride)
+			futures.DELETE("/autopilot/symbols/:symbol/category-override", s.handleClearSymbolCategoryOverride)
 			futures.GET("/autopilot/symbols/:symbol", s.handleGetSingleSymbolSettings)
 			futures.PUT("/autopilot/symbols/:symbol", s.handleUpdateSymbolSettings)
 			futures.POST("/autopilot/symbols/:symbol/blacklist", s.handleBlacklistSymbol)
@@ -658,6 +696,9 @@ func (s *Server) setupRoutes() {
 			futures.POST("/ginie/circuit-breaker/toggle", s.handleToggleGinieCircuitBreaker)
 			futures.POST("/ginie/circuit-breaker/config", s.handleUpdateGinieCircuitBreakerConfig)
 
+			// Ginie manage-only mode (no new entries, keep managing open positions)
+			futures.POST("/ginie/manage-only", s.handleToggleGinieManageOnly)
+
 			// Ginie Per-Position ROI Target (custom early profit booking ROI%)
 			// NOTE: This must come AFTER specific routes like /close-all, /sync, /recalc-sltp
 			// because Gin matches routes in order and :symbol is a catch-all parameter
@@ -665,6 +706,12 @@ func (s *Server) setupRoutes() {
 			// Ginie Position Sync (sync with exchange)
 			futures.POST("/ginie/positions/sync", s.handleSyncGiniePositions)
 
+			// Ginie Position Reconciliation report (tracked vs exchange drift)
+			futures.GET("/ginie/reconciliation", s.handleGetGinieReconciliation)
+
+			// Ginie exchange availability / maintenance hold
+			futures.GET("/ginie/exchange-status", s.handleGetGinieExchangeStatus)
+
 			// Ginie Panic Button (closes only Ginie positions)
 			futures.POST("/ginie/positions/close-all", s.handleCloseAllGiniePositions)
 
@@ -673,14 +720,27 @@ func (s *Server) setupRoutes() {
 			futures.GET("/ginie/positions/recalc-sltp/status/:job_id", s.handleGetSLTPJobStatus)
 			futures.GET("/ginie/positions/recalc-sltp/jobs", s.handleListSLTPJobs)
 
+			// Ginie position sizing preview (dry run of adaptive sizing, no order placed)
+			futures.POST("/ginie/sizing-preview", s.handleGinieSizingPreview)
+
 			// Per-Position ROI Target (MUST be registered LAST due to :symbol parameter)
 			futures.POST("/ginie/positions/:symbol/roi-target", s.handleSetPositionROITarget)
+			futures.PATCH("/ginie/positions/:symbol", s.handleUpdateGiniePositionLevels)
 
+			// Synced positions: monitored only until adopted with a mode and SL/TP
+			futures.GET("/ginie/synced-positions", s.handleGetGinieSyncedPositions)
+			futures.POST("/ginie/synced-positions/:symbol/adopt", s.handleAdoptGinieSyncedPosition)
 
 			// Ginie Market Movers endpoints (dynamic symbol selection)
 			futures.GET("/ginie/market-movers", s.handleGetMarketMovers)
 			futures.GET("/ginie/all-gainers", s.handleGetAllMarketMovers) // No volume filter - shows real top gainers
 			futures.POST("/ginie/symbols/refresh-dynamic", s.handleRefreshDynamicSymbols)
+			futures.POST("/ginie/symbols/:symbol/max-size", s.handleSetSymbolMaxSize)
+
+			// Ginie Watchlist (current scan list with source tags, manual pin/exclude)
+			futures.GET("/ginie/watchlist", s.handleGetGinieWatchlist)
+			futures.POST("/ginie/watchlist/pin", s.handlePinGinieWatchlistSymbol)
+			futures.POST("/ginie/watchlist/unpin", s.handleUnpinGinieWatchlistSymbol)
 
 			// Ginie Blocked Coins endpoints (per-coin circuit breaker)
 			futures.GET("/ginie/blocked-coins", s.handleGetGinieBlockedCoins)
@@ -694,10 +754,19 @@ func (s *Server) setupRoutes() {
 			// Ginie Signal Logs endpoints (all signals with executed/rejected status)
 			futures.GET("/ginie/signals", s.handleGetGinieSignalLogs)
 			futures.GET("/ginie/signals/stats", s.handleGetGinieSignalStats)
+			futures.GET("/ginie/signals/:id/explain", s.handleExplainGinieSignal)
+
+			// Ginie account drawdown kill switch
+			futures.GET("/ginie/drawdown-guard", s.handleGetGinieDrawdownGuard)
+			futures.POST("/ginie/drawdown-guard/reset", s.handleResetGinieDrawdownGuard)
+
+			// Ginie anti-martingale equity scaling (effective size/leverage multiplier)
+			futures.GET("/ginie/equity-scaling", s.handleGetGinieEquityScaling)
 
 			// Ginie SL Update History endpoints
 			futures.GET("/ginie/sl-history", s.handleGetGinieSLHistory)
 			futures.GET("/ginie/sl-history/stats", s.handleGetGinieSLStats)
+			futures.GET("/ginie/sl-history/:symbol", s.handleGetGinieSLHistory)
 
 			// Ginie Diagnostics endpoint
 			futures.GET("/ginie/diagnostics", s.handleGetGinieDiagnostics)
@@ -705,6 +774,9 @@ func (s *Server) setupRoutes() {
 			// Ginie Pending Orders endpoint - shows unfilled limit orders
 			futures.GET("/ginie/pending-orders", s.handleGetPendingOrders)
 
+			// Ginie Orphan Algo Orders endpoint - open SL/TP orders not tracked by any position
+			futures.GET("/ginie/orphan-orders", s.handleGetGinieOrphanOrders)
+
 			// Ginie Trade Conditions endpoint - shows all pre-trade condition checks
 			futures.GET("/ginie/trade-conditions", s.handleGetTradeConditions)
 
@@ -775,6 +847,13 @@ func (s *Server) setupRoutes() {
 			futures.POST("/ginie/modes/reset-all", s.handleResetAllModes)
 			futures.POST("/ginie/other-settings/reset-all", s.handleResetAllOtherSettings)
 
+			// Settings snapshots (versioned config history with restore and diff)
+			futures.GET("/ginie/settings-snapshots", s.handleListSettingsSnapshots)
+			futures.POST("/ginie/settings-snapshots", s.handleCreateSettingsSnapshot)
+			futures.GET("/ginie/settings-snapshots/diff", s.handleDiffSettingsSnapshots)
+			futures.GET("/ginie/settings-snapshots/:id", s.handleGetSettingsSnapshot)
+			futures.POST("/ginie/settings-snapshots/:id/restore", s.handleRestoreSettingsSnapshot)
+
 			// Safety Settings CRUD endpoints (Story 9.4)
 			futures.GET("/ginie/safety-settings", s.handleGetUserSafetySettings)
 			futures.PUT("/ginie/safety-settings/:mode", s.handleUpdateUserSafetySettings)
@@ -901,6 +980,17 @@ func (s *Server) setupRoutes() {
 		admin.PUT("/settings/:key", s.handleAdminUpdateSetting)
 		admin.DELETE("/settings/:key", s.handleAdminDeleteSetting)
 
+		// Extra futures accounts (FUTURES_ACCOUNTS)
+		admin.GET("/accounts", s.handleAdminGetAccounts)
+		admin.POST("/accounts/:name/start", s.handleAdminStartAccount)
+		admin.POST("/accounts/:name/stop", s.handleAdminStopAccount)
+
+		// Audit trail of live trading actions (read-only)
+		admin.GET("/audit-log", s.handleAdminListAuditLog)
+
+		// Hot-reload of config.json and autopilot settings (same as SIGHUP)
+		admin.POST("/config/reload", s.handleAdminReloadConfig)
+
 		// Admin settings sync (Story 4.15)
 		admin.POST("/sync-defaults", s.handleAdminSyncDefaults)
 		admin.GET("/sync-status", s.handleAdminSyncStatus)
@@ -927,6 +1017,9 @@ func (s *Server) setupRoutes() {
 	// Stripe webhook endpoint (no auth required - uses signature verification)
 	s.router.POST("/api/billing/webhook", s.handleStripeWebhook)
 
+	// TradingView alert webhook (no JWT - TradingView can't send one; uses the shared webhook secret)
+	s.router.POST("/api/webhook/tradingview", s.handleTradingViewWebhook)
+
 	// Serve static files (React build) in production
 	if s.config.StaticFilesPath != "" {
 		s.router.Static("/assets", s.config.StaticFilesPath+"/assets")
@@ -1006,9 +1099,11 @@ func (s *Server) handleHealth(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"status":   status,
-		"database": "healthy",
-		"uptime":   time.Now().Format(time.RFC3339),
+		"status":            status,
+		"database":          "healthy",
+		"database_pool":     s.repo.PoolStats(),
+		"database_replicas": s.repo.GetDB().ReplicaCount(),
+		"uptime":            time.Now().Format(time.RFC3339),
 	})
 }
 
@@ -1071,6 +1166,16 @@ func (s *Server) SetUserAutopilotManager(mgr *autopilot.UserAutopilotManager) {
 	s.userAutopilotManager = mgr
 }
 
+// SetAccountManager sets the manager for extra futures accounts
+func (s *Server) SetAccountManager(mgr *autopilot.AccountManager) {
+	s.accountManager = mgr
+}
+
+// SetSymbolClassifier sets the per-symbol performance category auto-classifier
+func (s *Server) SetSymbolClassifier(classifier *autopilot.SymbolAutoClassifier) {
+	s.symbolClassifier = classifier
+}
+
 // GetUserAutopilotManager returns the multi-user autopilot manager
 func (s *Server) GetUserAutopilotManager() *autopilot.UserAutopilotManager {
 	return s.userAutopilotManager
@@ -1104,6 +1209,11 @@ func (s *Server) SetSettlementService(svc *settlement.SettlementService) {
 	s.settlementService = svc
 }
 
+// SetDeadLetterQueue sets the queue of event records awaiting a persistence retry
+func (s *Server) SetDeadLetterQueue(q *database.DeadLetterQueue) {
+	s.deadLetters = q
+}
+
 // GetSettlementService returns the settlement service
 func (s *Server) GetSettlementService() *settlement.SettlementService {
 	return s.settlementService
@@ -277,6 +277,10 @@ func InitUserWebSocket(eventBus *events.EventBus) *UserWSHub {
 		}
 	})
 
+	events.SetBroadcastGiniePositionEvent(func(userID string, eventType events.EventType, data interface{}) {
+		BroadcastGiniePositionEvent(userID, eventType, data)
+	})
+
 	log.Println("User-aware WebSocket hub initialized with broadcast callbacks")
 
 	return userWSHub
@@ -544,6 +548,24 @@ func BroadcastSignalUpdate(userID string, signal interface{}) {
 	userWSHub.BroadcastToUser(userID, event)
 }
 
+// BroadcastGiniePositionEvent broadcasts a Ginie position lifecycle event
+// (opened, TP hit, SL moved, trailing activated, closed) to a specific user
+func BroadcastGiniePositionEvent(userID string, eventType events.EventType, data interface{}) {
+	if userWSHub == nil {
+		return
+	}
+
+	event := events.Event{
+		Type:      eventType,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"event": data,
+		},
+	}
+
+	userWSHub.BroadcastToUser(userID, event)
+}
+
 // AuthenticatedWSHandler creates a WebSocket handler that requires authentication
 // Supports both Authorization header and query param token for WebSocket connections
 func AuthenticatedWSHandler(s *Server) gin.HandlerFunc {
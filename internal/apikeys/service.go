@@ -137,6 +137,29 @@ func (s *Service) GetActiveBinanceKey(ctx context.Context, userID string, isTest
 		return nil, fmt.Errorf("no active Binance API key found for user")
 	}
 
+	return s.decryptBinanceKey(key)
+}
+
+// GetBinanceKeyByLabel returns the active Binance API key with the given label,
+// used for the extra accounts of a multi-account setup
+func (s *Service) GetBinanceKeyByLabel(ctx context.Context, userID, label string, isTestnet bool) (*BinanceKeyResult, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user ID required: please log in to access Binance keys")
+	}
+
+	key, err := s.repo.GetActiveAPIKeyByLabel(ctx, userID, "binance", label, isTestnet)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Binance API key %q: %w", label, err)
+	}
+	if key == nil {
+		return nil, fmt.Errorf("no active Binance API key labelled %q found for user", label)
+	}
+
+	return s.decryptBinanceKey(key)
+}
+
+// decryptBinanceKey decrypts a stored Binance key pair
+func (s *Service) decryptBinanceKey(key *database.UserAPIKey) (*BinanceKeyResult, error) {
 	// Check if we have encrypted keys in the database
 	if key.EncryptedAPIKey == "" || key.EncryptedSecretKey == "" {
 		return nil, fmt.Errorf("Binance API key not stored in database - please re-add your API key")
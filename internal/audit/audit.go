@@ -0,0 +1,182 @@
+// Package audit keeps a durable, append-only record of live trading actions
+// (orders, position closes, leverage and SL/TP changes, mode switches).
+// Unlike the informational logs, every entry is written to the audit_log table.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"binance-trading-bot/internal/database"
+)
+
+// Action identifies the kind of audited mutation
+type Action string
+
+const (
+	ActionOrderPlaced     Action = "order_placed"
+	ActionOrderCancelled  Action = "order_cancelled"
+	ActionPositionClosed  Action = "position_closed"
+	ActionLeverageSet     Action = "leverage_set"
+	ActionMarginTypeSet   Action = "margin_type_set"
+	ActionCountdownSet    Action = "countdown_cancel_all_set"
+	ActionPositionModeSet Action = "position_mode_set"
+	ActionSLTPPlaced      Action = "sltp_placed"
+	ActionSLTPCancelled   Action = "sltp_cancelled"
+	ActionModeSwitched    Action = "mode_switched"
+	ActionDryRunToggled   Action = "dry_run_toggled"
+)
+
+const (
+	ResultSuccess = "success"
+	ResultError   = "error"
+)
+
+// writeTimeout bounds a single audit insert
+const writeTimeout = 3 * time.Second
+
+// queueSize is how many entries may wait for the writer before new ones
+// fall back to the process log
+const queueSize = 1024
+
+// Store persists audit entries; implemented by *database.Repository
+type Store interface {
+	InsertAuditLogEntry(ctx context.Context, entry *database.AuditLogEntry) error
+}
+
+// writer persists queued entries in order on its own goroutine, so recording
+// never waits on the database
+type writer struct {
+	store   Store
+	queue   chan *database.AuditLogEntry
+	pending sync.WaitGroup
+	done    chan struct{}
+}
+
+func newWriter(s Store) *writer {
+	w := &writer{
+		store: s,
+		queue: make(chan *database.AuditLogEntry, queueSize),
+		done:  make(chan struct{}),
+	}
+	go w.run()
+	return w
+}
+
+func (w *writer) run() {
+	defer close(w.done)
+	for entry := range w.queue {
+		ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+		if err := w.store.InsertAuditLogEntry(ctx, entry); err != nil {
+			logEntry(entry, err)
+		}
+		cancel()
+		w.pending.Done()
+	}
+}
+
+var (
+	writerMu sync.RWMutex
+	current  *writer
+)
+
+// Init sets the store used by Record and starts its writer. Until it is
+// called, entries are only logged. A previous store's queue is drained first.
+func Init(s Store) {
+	var w *writer
+	if s != nil {
+		w = newWriter(s)
+	}
+	writerMu.Lock()
+	old := current
+	current = w
+	writerMu.Unlock()
+
+	if old != nil {
+		close(old.queue)
+		<-old.done
+	}
+}
+
+// Flush waits until every entry queued so far has been written, or ctx is done
+func Flush(ctx context.Context) error {
+	writerMu.RLock()
+	w := current
+	writerMu.RUnlock()
+	if w == nil {
+		return nil
+	}
+
+	drained := make(chan struct{})
+	go func() {
+		w.pending.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Record writes a successful action to the audit log
+func Record(ctx context.Context, action Action, actor string, details map[string]interface{}) {
+	RecordResult(ctx, action, actor, details, nil)
+}
+
+// RecordResult queues an action and its outcome for the audit log; a nil err
+// is recorded as success. It never blocks: when the queue is full the entry
+// goes to the process log instead. The write is detached from ctx, so an
+// aborted request still leaves a record. A "symbol" string in details is also
+// stored in its own column for filtering.
+func RecordResult(ctx context.Context, action Action, actor string, details map[string]interface{}, err error) {
+	entry := &database.AuditLogEntry{
+		CreatedAt: time.Now(),
+		Action:    string(action),
+		Actor:     actor,
+		Details:   details,
+		Result:    ResultSuccess,
+	}
+	if symbol, ok := details["symbol"].(string); ok {
+		entry.Symbol = symbol
+	}
+	if err != nil {
+		entry.Result = ResultError
+		entry.Error = err.Error()
+	}
+
+	// Held across the send so Init cannot close the queue underneath it
+	writerMu.RLock()
+	defer writerMu.RUnlock()
+
+	if current == nil {
+		logEntry(entry, nil)
+		return
+	}
+	current.pending.Add(1)
+	select {
+	case current.queue <- entry:
+	default:
+		current.pending.Done()
+		logEntry(entry, errQueueFull)
+	}
+}
+
+// errQueueFull is logged with entries dropped because the writer fell behind
+var errQueueFull = errors.New("audit queue full")
+
+// logEntry is the fallback when the entry cannot be persisted, so it is at least
+// recoverable from the process logs
+func logEntry(entry *database.AuditLogEntry, writeErr error) {
+	data, _ := json.Marshal(entry)
+	if writeErr != nil {
+		log.Printf("[AUDIT] Failed to persist audit entry (%v): %s", writeErr, data)
+		return
+	}
+	log.Printf("[AUDIT] %s", data)
+}
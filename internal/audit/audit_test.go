@@ -0,0 +1,222 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"binance-trading-bot/internal/binance"
+	"binance-trading-bot/internal/database"
+)
+
+// memoryStore collects entries in memory
+type memoryStore struct {
+	mu      sync.Mutex
+	entries []*database.AuditLogEntry
+}
+
+func (m *memoryStore) InsertAuditLogEntry(ctx context.Context, entry *database.AuditLogEntry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = append(m.entries, entry)
+	return nil
+}
+
+// blockingStore holds every insert until release is closed
+type blockingStore struct {
+	memoryStore
+	release chan struct{}
+}
+
+func (b *blockingStore) InsertAuditLogEntry(ctx context.Context, entry *database.AuditLogEntry) error {
+	<-b.release
+	return b.memoryStore.InsertAuditLogEntry(ctx, entry)
+}
+
+// liveStub stands in for a real exchange client; only the methods under test are implemented
+type liveStub struct {
+	binance.FuturesClient
+	placeErr error
+}
+
+func (l *liveStub) PlaceFuturesOrder(params binance.FuturesOrderParams) (*binance.FuturesOrderResponse, error) {
+	if l.placeErr != nil {
+		return nil, l.placeErr
+	}
+	return &binance.FuturesOrderResponse{OrderId: 42, Symbol: params.Symbol, Status: "FILLED"}, nil
+}
+
+func (l *liveStub) CancelAlgoOrder(symbol string, algoId int64) error {
+	return nil
+}
+
+func (l *liveStub) SetMarginType(symbol string, marginType binance.MarginType) error {
+	return nil
+}
+
+func (l *liveStub) SetCountdownCancelAll(symbol string, countdownTimeMs int64) error {
+	return nil
+}
+
+func useMemoryStore(t *testing.T) *memoryStore {
+	t.Helper()
+	store := &memoryStore{}
+	Init(store)
+	t.Cleanup(func() { Init(nil) })
+	return store
+}
+
+// flush waits for queued entries to reach the store
+func flush(t *testing.T) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := Flush(ctx); err != nil {
+		t.Fatalf("Flush failed: %v", err)
+	}
+}
+
+func TestRecordResult(t *testing.T) {
+	store := useMemoryStore(t)
+
+	RecordResult(context.Background(), ActionLeverageSet, "user-1", map[string]interface{}{
+		"symbol":   "BTCUSDT",
+		"leverage": 10,
+	}, errors.New("rejected"))
+	flush(t)
+
+	if len(store.entries) != 1 {
+		t.Fatalf("Expected 1 entry, got %d", len(store.entries))
+	}
+	e := store.entries[0]
+	if e.Action != string(ActionLeverageSet) || e.Actor != "user-1" {
+		t.Errorf("Unexpected action/actor: %s/%s", e.Action, e.Actor)
+	}
+	if e.Symbol != "BTCUSDT" {
+		t.Errorf("Expected symbol BTCUSDT, got %q", e.Symbol)
+	}
+	if e.Result != ResultError || e.Error != "rejected" {
+		t.Errorf("Expected error result, got %s (%q)", e.Result, e.Error)
+	}
+}
+
+func TestRecordSurvivesCancelledContext(t *testing.T) {
+	store := useMemoryStore(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	Record(ctx, ActionModeSwitched, "user-1", map[string]interface{}{"dry_run": false})
+	flush(t)
+
+	if len(store.entries) != 1 {
+		t.Fatalf("Expected entry to be written despite cancelled context, got %d", len(store.entries))
+	}
+	if store.entries[0].Result != ResultSuccess {
+		t.Errorf("Expected success result, got %s", store.entries[0].Result)
+	}
+}
+
+func TestWrapFuturesClientSkipsMock(t *testing.T) {
+	mock := binance.NewFuturesMockClient(1000, nil)
+	if got := WrapFuturesClient(mock, "user-1"); got != binance.FuturesClient(mock) {
+		t.Error("Expected mock client to be returned unwrapped")
+	}
+
+	cached := binance.NewCachedFuturesClient(mock, nil)
+	if got := WrapFuturesClient(cached, "user-1"); got != binance.FuturesClient(cached) {
+		t.Error("Expected cached mock client to be returned unwrapped")
+	}
+
+	if WrapFuturesClient(nil, "user-1") != nil {
+		t.Error("Expected nil client to stay nil")
+	}
+}
+
+func TestWrappedClientRecordsOrders(t *testing.T) {
+	store := useMemoryStore(t)
+
+	client := WrapFuturesClient(&liveStub{}, "ginie:user-1")
+	if WrapFuturesClient(client, "other") != client {
+		t.Error("Expected already wrapped client to be returned as-is")
+	}
+
+	if _, err := client.PlaceFuturesOrder(binance.FuturesOrderParams{Symbol: "ETHUSDT", Side: "BUY", Quantity: 1}); err != nil {
+		t.Fatalf("PlaceFuturesOrder failed: %v", err)
+	}
+	if _, err := client.PlaceFuturesOrder(binance.FuturesOrderParams{Symbol: "ETHUSDT", Side: "SELL", Quantity: 1, ReduceOnly: true}); err != nil {
+		t.Fatalf("PlaceFuturesOrder failed: %v", err)
+	}
+	if err := client.CancelAlgoOrder("ETHUSDT", 7); err != nil {
+		t.Fatalf("CancelAlgoOrder failed: %v", err)
+	}
+	if err := client.SetMarginType("ETHUSDT", binance.MarginTypeIsolated); err != nil {
+		t.Fatalf("SetMarginType failed: %v", err)
+	}
+	if err := client.SetCountdownCancelAll("ETHUSDT", 60000); err != nil {
+		t.Fatalf("SetCountdownCancelAll failed: %v", err)
+	}
+	flush(t)
+
+	want := []Action{ActionOrderPlaced, ActionPositionClosed, ActionSLTPCancelled, ActionMarginTypeSet, ActionCountdownSet}
+	if len(store.entries) != len(want) {
+		t.Fatalf("Expected %d entries, got %d", len(want), len(store.entries))
+	}
+	for i, action := range want {
+		if store.entries[i].Action != string(action) {
+			t.Errorf("Entry %d: expected action %s, got %s", i, action, store.entries[i].Action)
+		}
+		if store.entries[i].Actor != "ginie:user-1" {
+			t.Errorf("Entry %d: expected actor ginie:user-1, got %s", i, store.entries[i].Actor)
+		}
+	}
+	if store.entries[0].Details["order_id"] != int64(42) {
+		t.Errorf("Expected order_id 42 in details, got %v", store.entries[0].Details["order_id"])
+	}
+}
+
+func TestWrappedClientRecordsFailures(t *testing.T) {
+	store := useMemoryStore(t)
+
+	client := WrapFuturesClient(&liveStub{placeErr: errors.New("insufficient margin")}, "user-1")
+	if _, err := client.PlaceFuturesOrder(binance.FuturesOrderParams{Symbol: "BTCUSDT"}); err == nil {
+		t.Fatal("Expected error to be passed through")
+	}
+	flush(t)
+
+	if len(store.entries) != 1 || store.entries[0].Result != ResultError {
+		t.Fatalf("Expected one error entry, got %+v", store.entries)
+	}
+	if store.entries[0].Error != "insufficient margin" {
+		t.Errorf("Expected error message to be recorded, got %q", store.entries[0].Error)
+	}
+}
+
+func TestRecordDoesNotWaitForStore(t *testing.T) {
+	store := &blockingStore{release: make(chan struct{})}
+	Init(store)
+	t.Cleanup(func() { Init(nil) })
+
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < queueSize+10; i++ {
+			Record(context.Background(), ActionOrderPlaced, "user-1", map[string]interface{}{"symbol": "BTCUSDT"})
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Record blocked on a stalled store")
+	}
+
+	close(store.release)
+	flush(t)
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	// One entry may be held by the writer while the queue fills; the overflow is only logged
+	if n := len(store.entries); n < queueSize || n > queueSize+1 {
+		t.Errorf("Expected the %d queued entries to be written, got %d", queueSize, n)
+	}
+}
@@ -0,0 +1,210 @@
+package audit
+
+import (
+	"context"
+	"errors"
+
+	"binance-trading-bot/internal/binance"
+)
+
+// FuturesClient wraps a live futures client and records every mutating call.
+// Read-only methods pass straight through to the embedded client.
+type FuturesClient struct {
+	binance.FuturesClient
+	actor string
+}
+
+// WrapFuturesClient returns client with audit recording attributed to actor.
+// Mock (paper trading) clients and already wrapped clients are returned as-is.
+func WrapFuturesClient(client binance.FuturesClient, actor string) binance.FuturesClient {
+	if client == nil || isMockClient(client) {
+		return client
+	}
+	if _, ok := client.(*FuturesClient); ok {
+		return client
+	}
+	return &FuturesClient{FuturesClient: client, actor: actor}
+}
+
+func isMockClient(client binance.FuturesClient) bool {
+	if cached, ok := client.(*binance.CachedFuturesClient); ok {
+		client = cached.Unwrap()
+	}
+	_, ok := client.(*binance.FuturesMockClient)
+	return ok
+}
+
+// PlaceFuturesOrder records order placement, or a position close for reduce-only orders
+func (c *FuturesClient) PlaceFuturesOrder(params binance.FuturesOrderParams) (*binance.FuturesOrderResponse, error) {
+	resp, err := c.FuturesClient.PlaceFuturesOrder(params)
+
+	action := ActionOrderPlaced
+	if params.ReduceOnly || params.ClosePosition {
+		action = ActionPositionClosed
+	}
+	details := map[string]interface{}{
+		"symbol":          params.Symbol,
+		"side":            params.Side,
+		"position_side":   params.PositionSide,
+		"type":            params.Type,
+		"quantity":        params.Quantity,
+		"price":           params.Price,
+		"stop_price":      params.StopPrice,
+		"reduce_only":     params.ReduceOnly,
+		"close_position":  params.ClosePosition,
+		"client_order_id": params.NewClientOrderId,
+	}
+	if resp != nil {
+		details["order_id"] = resp.OrderId
+		details["status"] = resp.Status
+		details["avg_price"] = resp.AvgPrice
+		details["executed_qty"] = resp.ExecutedQty
+	}
+	RecordResult(context.Background(), action, c.actor, details, err)
+	return resp, err
+}
+
+// PlaceProtectedMarketOrder records the entry and each SL/TP leg that was placed
+func (c *FuturesClient) PlaceProtectedMarketOrder(params binance.FuturesOrderParams, slPrice float64, tpPrices []float64) (*binance.ProtectedOrderResult, error) {
+	result, err := c.FuturesClient.PlaceProtectedMarketOrder(params, slPrice, tpPrices)
+
+	details := map[string]interface{}{
+		"symbol":          params.Symbol,
+		"side":            params.Side,
+		"position_side":   params.PositionSide,
+		"type":            params.Type,
+		"quantity":        params.Quantity,
+		"client_order_id": params.NewClientOrderId,
+		"stop_loss":       slPrice,
+		"take_profits":    tpPrices,
+	}
+	if result != nil && result.Entry != nil {
+		details["order_id"] = result.Entry.OrderId
+		details["status"] = result.Entry.Status
+		details["method"] = result.Method
+	}
+	RecordResult(context.Background(), ActionOrderPlaced, c.actor, details, err)
+	if result == nil {
+		return result, err
+	}
+
+	legs := append([]binance.ProtectiveLeg{result.StopLoss}, result.TakeProfits...)
+	for _, leg := range legs {
+		var legErr error
+		if !leg.Placed() {
+			legErr = errors.New(leg.Error)
+		}
+		RecordResult(context.Background(), ActionSLTPPlaced, c.actor, map[string]interface{}{
+			"symbol":        params.Symbol,
+			"position_side": params.PositionSide,
+			"type":          leg.Type,
+			"quantity":      leg.Quantity,
+			"trigger_price": leg.TriggerPrice,
+			"order_id":      leg.OrderId,
+			"algo_id":       leg.AlgoId,
+			"method":        result.Method,
+		}, legErr)
+	}
+	return result, err
+}
+
+// CancelFuturesOrder records order cancellation
+func (c *FuturesClient) CancelFuturesOrder(symbol string, orderId int64) error {
+	err := c.FuturesClient.CancelFuturesOrder(symbol, orderId)
+	RecordResult(context.Background(), ActionOrderCancelled, c.actor, map[string]interface{}{
+		"symbol":   symbol,
+		"order_id": orderId,
+	}, err)
+	return err
+}
+
+// CancelAllFuturesOrders records bulk order cancellation
+func (c *FuturesClient) CancelAllFuturesOrders(symbol string) error {
+	err := c.FuturesClient.CancelAllFuturesOrders(symbol)
+	RecordResult(context.Background(), ActionOrderCancelled, c.actor, map[string]interface{}{
+		"symbol": symbol,
+		"all":    true,
+	}, err)
+	return err
+}
+
+// PlaceAlgoOrder records SL/TP (conditional) order placement
+func (c *FuturesClient) PlaceAlgoOrder(params binance.AlgoOrderParams) (*binance.AlgoOrderResponse, error) {
+	resp, err := c.FuturesClient.PlaceAlgoOrder(params)
+
+	details := map[string]interface{}{
+		"symbol":         params.Symbol,
+		"side":           params.Side,
+		"position_side":  params.PositionSide,
+		"type":           params.Type,
+		"quantity":       params.Quantity,
+		"trigger_price":  params.TriggerPrice,
+		"close_position": params.ClosePosition,
+		"client_algo_id": params.ClientAlgoId,
+	}
+	if resp != nil {
+		details["algo_id"] = resp.AlgoId
+		details["algo_status"] = resp.AlgoStatus
+	}
+	RecordResult(context.Background(), ActionSLTPPlaced, c.actor, details, err)
+	return resp, err
+}
+
+// CancelAlgoOrder records SL/TP order cancellation
+func (c *FuturesClient) CancelAlgoOrder(symbol string, algoId int64) error {
+	err := c.FuturesClient.CancelAlgoOrder(symbol, algoId)
+	RecordResult(context.Background(), ActionSLTPCancelled, c.actor, map[string]interface{}{
+		"symbol":  symbol,
+		"algo_id": algoId,
+	}, err)
+	return err
+}
+
+// CancelAllAlgoOrders records bulk SL/TP order cancellation
+func (c *FuturesClient) CancelAllAlgoOrders(symbol string) error {
+	err := c.FuturesClient.CancelAllAlgoOrders(symbol)
+	RecordResult(context.Background(), ActionSLTPCancelled, c.actor, map[string]interface{}{
+		"symbol": symbol,
+		"all":    true,
+	}, err)
+	return err
+}
+
+// SetLeverage records leverage changes
+func (c *FuturesClient) SetLeverage(symbol string, leverage int) (*binance.LeverageResponse, error) {
+	resp, err := c.FuturesClient.SetLeverage(symbol, leverage)
+	RecordResult(context.Background(), ActionLeverageSet, c.actor, map[string]interface{}{
+		"symbol":   symbol,
+		"leverage": leverage,
+	}, err)
+	return resp, err
+}
+
+// SetMarginType records margin type changes
+func (c *FuturesClient) SetMarginType(symbol string, marginType binance.MarginType) error {
+	err := c.FuturesClient.SetMarginType(symbol, marginType)
+	RecordResult(context.Background(), ActionMarginTypeSet, c.actor, map[string]interface{}{
+		"symbol":      symbol,
+		"margin_type": marginType,
+	}, err)
+	return err
+}
+
+// SetPositionMode records one-way/hedge position mode changes
+func (c *FuturesClient) SetPositionMode(dualSidePosition bool) error {
+	err := c.FuturesClient.SetPositionMode(dualSidePosition)
+	RecordResult(context.Background(), ActionPositionModeSet, c.actor, map[string]interface{}{
+		"dual_side_position": dualSidePosition,
+	}, err)
+	return err
+}
+
+// SetCountdownCancelAll records arming and disarming (0) of the dead-man's switch
+func (c *FuturesClient) SetCountdownCancelAll(symbol string, countdownTimeMs int64) error {
+	err := c.FuturesClient.SetCountdownCancelAll(symbol, countdownTimeMs)
+	RecordResult(context.Background(), ActionCountdownSet, c.actor, map[string]interface{}{
+		"symbol":            symbol,
+		"countdown_time_ms": countdownTimeMs,
+	}, err)
+	return err
+}
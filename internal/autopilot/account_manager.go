@@ -0,0 +1,357 @@
+package autopilot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"binance-trading-bot/internal/binance"
+	"binance-trading-bot/internal/database"
+	"binance-trading-bot/internal/logging"
+)
+
+// AccountRoute decides which signals a trading account takes
+type AccountRoute string
+
+const (
+	AccountRouteAll   AccountRoute = "all"   // Take every signal
+	AccountRouteLong  AccountRoute = "long"  // Only open longs
+	AccountRouteShort AccountRoute = "short" // Only open shorts
+)
+
+// ParseAccountRoute parses an account route ("" means all)
+func ParseAccountRoute(s string) (AccountRoute, error) {
+	switch route := AccountRoute(strings.ToLower(strings.TrimSpace(s))); route {
+	case "":
+		return AccountRouteAll, nil
+	case AccountRouteAll, AccountRouteLong, AccountRouteShort:
+		return route, nil
+	default:
+		return "", fmt.Errorf("invalid account route %q (must be all, long, or short)", s)
+	}
+}
+
+// Allows reports whether a signal in the given direction (LONG/SHORT or
+// BUY/SELL) may be traded on an account with this route
+func (r AccountRoute) Allows(direction string) bool {
+	switch strings.ToUpper(direction) {
+	case "LONG", "BUY":
+		return r != AccountRouteShort
+	case "SHORT", "SELL":
+		return r != AccountRouteLong
+	}
+	return true
+}
+
+// TradingAccount is one Binance futures account run by its own Ginie autopilot
+type TradingAccount struct {
+	Name          string
+	Route         AccountRoute
+	FuturesClient binance.FuturesClient
+	Autopilot     *GinieAutopilot
+	CreatedAt     time.Time
+}
+
+// AccountStatus is the per-account status exposed by the API
+type AccountStatus struct {
+	Name            string       `json:"name"`
+	Route           AccountRoute `json:"route"`
+	Running         bool         `json:"running"`
+	DryRun          bool         `json:"dry_run"`
+	ActivePositions int          `json:"active_positions"`
+	TotalTrades     int          `json:"total_trades"`
+	WinRate         float64      `json:"win_rate"`
+	TotalPnL        float64      `json:"total_pnl"`
+	DailyPnL        float64      `json:"daily_pnl"`
+	UnrealizedPnL   float64      `json:"unrealized_pnl"`
+}
+
+// AccountManager runs the futures autopilot against several Binance accounts
+// owned by one user (e.g. a main and a hedge account). Every account gets its
+// own client, positions and PnL tracking, while the Ginie analyzer - and with it
+// the market data and signal layer - is shared. An account's route decides which
+// signals it trades, so longs can go to one account and shorts to another.
+type AccountManager struct {
+	ownerUserID       string
+	repo              *database.Repository
+	positionStateRepo *database.RedisPositionStateRepository
+	settingsCache     SettingsCacheReader
+	ginieAnalyzer     *GinieAnalyzer
+	logger            *logging.Logger
+	alertNotifier     AlertNotifier
+
+	accounts map[string]*TradingAccount
+	order    []string // Registration order, for stable status output
+	mu       sync.RWMutex
+}
+
+// NewAccountManager creates a manager for accounts owned by ownerUserID, whose
+// mode settings, circuit breaker and risk level all accounts share
+func NewAccountManager(
+	ownerUserID string,
+	repo *database.Repository,
+	ginieAnalyzer *GinieAnalyzer,
+	logger *logging.Logger,
+	positionStateRepo *database.RedisPositionStateRepository,
+	settingsCache SettingsCacheReader,
+) *AccountManager {
+	return &AccountManager{
+		ownerUserID:       ownerUserID,
+		repo:              repo,
+		positionStateRepo: positionStateRepo,
+		settingsCache:     settingsCache,
+		ginieAnalyzer:     ginieAnalyzer,
+		logger:            logger,
+		accounts:          make(map[string]*TradingAccount),
+	}
+}
+
+// AddAccount creates the autopilot for a named account. The autopilot is not
+// started; call Start or StartAll.
+func (m *AccountManager) AddAccount(name string, client binance.FuturesClient, route AccountRoute) (*TradingAccount, error) {
+	if name == "" {
+		return nil, fmt.Errorf("account name is required")
+	}
+	if client == nil {
+		return nil, fmt.Errorf("account %s has no futures client", name)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.accounts[name]; exists {
+		return nil, fmt.Errorf("account %s already registered", name)
+	}
+
+	ga := NewGinieAutopilot(
+		m.ginieAnalyzer,
+		client,
+		m.logger,
+		m.repo,
+		m.ownerUserID,
+		m.positionStateRepo,
+		m.settingsCache,
+		nil, // Client order IDs are sequenced per user, not per account
+	)
+	ga.SetAccount(name, route)
+
+	if m.alertNotifier != nil {
+		ga.SetAlertNotifier(m.alertNotifier)
+	}
+
+	if settingsManager := GetSettingsManager(); settingsManager != nil {
+		if riskLevel := settingsManager.GetDefaultSettings().RiskLevel; riskLevel != "" {
+			if err := ga.SetRiskLevel(riskLevel); err != nil {
+				m.logger.Warn("Failed to apply risk level to account autopilot", "account", name, "error", err)
+			}
+		}
+	}
+
+	// Persisted PnL is aggregated per user, so LoadPnLStats is deliberately not
+	// called - each account tracks its own PnL from when it was added
+
+	account := &TradingAccount{
+		Name:          name,
+		Route:         route,
+		FuturesClient: client,
+		Autopilot:     ga,
+		CreatedAt:     time.Now(),
+	}
+	m.accounts[name] = account
+	m.order = append(m.order, name)
+
+	m.logger.Info("Trading account added", "account", name, "route", route, "owner", m.ownerUserID)
+	return account, nil
+}
+
+// GetAccount returns a registered account (nil if not found)
+func (m *AccountManager) GetAccount(name string) *TradingAccount {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.accounts[name]
+}
+
+// Start starts a single account's autopilot
+func (m *AccountManager) Start(name string) error {
+	account := m.GetAccount(name)
+	if account == nil {
+		return fmt.Errorf("account %s not found", name)
+	}
+	if !account.Autopilot.IsRunning() {
+		m.logger.Info("Starting account autopilot", "account", name)
+		return account.Autopilot.Start()
+	}
+	return nil
+}
+
+// Stop stops a single account's autopilot
+func (m *AccountManager) Stop(name string) error {
+	account := m.GetAccount(name)
+	if account == nil {
+		return fmt.Errorf("account %s not found", name)
+	}
+	if account.Autopilot.IsRunning() {
+		m.logger.Info("Stopping account autopilot", "account", name)
+		return account.Autopilot.Stop()
+	}
+	return nil
+}
+
+// StartAll starts every account, returning the first error encountered
+func (m *AccountManager) StartAll() error {
+	var firstErr error
+	for _, name := range m.Names() {
+		if err := m.Start(name); err != nil {
+			m.logger.Warn("Failed to start account autopilot", "account", name, "error", err)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+// AutoStartFromSettings starts every account if the owner has autopilot
+// auto-start enabled, matching per-user instances
+func (m *AccountManager) AutoStartFromSettings(ctx context.Context) error {
+	if m.repo == nil {
+		return nil
+	}
+	tradingConfig, err := m.repo.GetUserTradingConfig(ctx, m.ownerUserID)
+	if err != nil {
+		return fmt.Errorf("failed to load trading config for %s: %w", m.ownerUserID, err)
+	}
+	if tradingConfig == nil || !tradingConfig.AutopilotEnabled {
+		return nil
+	}
+	return m.StartAll()
+}
+
+// Shutdown stops every running account autopilot
+func (m *AccountManager) Shutdown() {
+	for _, name := range m.Names() {
+		if err := m.Stop(name); err != nil {
+			m.logger.Warn("Failed to stop account autopilot during shutdown", "account", name, "error", err)
+		}
+	}
+}
+
+// ApplyShutdownPolicy applies the futures shutdown policy to every account,
+// keyed by account name
+func (m *AccountManager) ApplyShutdownPolicy(ctx context.Context, policy string) map[string][]ShutdownSymbolResult {
+	results := make(map[string][]ShutdownSymbolResult)
+	for _, name := range m.Names() {
+		if ctx.Err() != nil {
+			break
+		}
+		if account := m.GetAccount(name); account != nil {
+			if symbolResults := account.Autopilot.ApplyShutdownPolicy(ctx, policy); len(symbolResults) > 0 {
+				results[name] = symbolResults
+			}
+		}
+	}
+	return results
+}
+
+// Names returns account names in registration order
+func (m *AccountManager) Names() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]string(nil), m.order...)
+}
+
+// GetStatuses returns the status of every account in registration order
+func (m *AccountManager) GetStatuses() []AccountStatus {
+	names := m.Names()
+	statuses := make([]AccountStatus, 0, len(names))
+	for _, name := range names {
+		if account := m.GetAccount(name); account != nil {
+			statuses = append(statuses, account.Status())
+		}
+	}
+	return statuses
+}
+
+// ReloadAllConfigs asks every account autopilot to re-read its settings on its
+// next cycle. Returns the number of accounts signalled.
+func (m *AccountManager) ReloadAllConfigs() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, account := range m.accounts {
+		account.Autopilot.TriggerConfigReload()
+	}
+	return len(m.accounts)
+}
+
+// SetAlertNotifier sets the operator alert channel for current and future accounts
+func (m *AccountManager) SetAlertNotifier(notifier AlertNotifier) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.alertNotifier = notifier
+	for _, account := range m.accounts {
+		account.Autopilot.SetAlertNotifier(notifier)
+	}
+}
+
+// Status returns the account's autopilot status
+func (a *TradingAccount) Status() AccountStatus {
+	stats := a.Autopilot.GetStats()
+
+	status := AccountStatus{Name: a.Name, Route: a.Route}
+	status.Running, _ = stats["running"].(bool)
+	status.DryRun, _ = stats["dry_run"].(bool)
+	status.ActivePositions, _ = stats["active_positions"].(int)
+	status.TotalTrades, _ = stats["total_trades"].(int)
+	status.WinRate, _ = stats["win_rate"].(float64)
+	status.TotalPnL, _ = stats["total_pnl"].(float64)
+	status.DailyPnL, _ = stats["daily_pnl"].(float64)
+	status.UnrealizedPnL, _ = stats["unrealized_pnl"].(float64)
+	return status
+}
+
+// SetAccount names the trading account this autopilot runs and restricts it to
+// the route's signal directions. Call before Start.
+func (ga *GinieAutopilot) SetAccount(name string, route AccountRoute) {
+	ga.mu.Lock()
+	defer ga.mu.Unlock()
+	ga.accountName = name
+	ga.accountRoute = route
+}
+
+// AccountName returns the trading account name ("" for the user's primary account)
+func (ga *GinieAutopilot) AccountName() string {
+	ga.mu.RLock()
+	defer ga.mu.RUnlock()
+	return ga.accountName
+}
+
+// accountRouteRejection returns a rejection reason when the account's route
+// doesn't take signals in this direction
+func (ga *GinieAutopilot) accountRouteRejection(direction string) (string, bool) {
+	ga.mu.RLock()
+	name, route := ga.accountName, ga.accountRoute
+	ga.mu.RUnlock()
+
+	if route == "" || route.Allows(direction) {
+		return "", false
+	}
+	return fmt.Sprintf("account_route: %s trades %s only", name, route), true
+}
+
+// positionStateOwner is the key position state is persisted under: the user
+// ID (or "default" in legacy mode), suffixed with the account name so named
+// accounts never overwrite each other's or the primary account's positions
+func (ga *GinieAutopilot) positionStateOwner() string {
+	owner := ga.userID
+	if owner == "" {
+		owner = "default" // Legacy/shared mode
+	}
+	if ga.accountName != "" {
+		owner += ":" + ga.accountName
+	}
+	return owner
+}
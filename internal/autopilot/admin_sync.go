@@ -749,6 +749,12 @@ func (s *AdminSyncService) updateModeConfigFromFlattened(mc *ModeFullConfig, val
 	count := 0
 	for key, value := range values {
 		parts := splitKeyPath(key)
+		if len(parts) == 1 && parts[0] == "early_profit_booking_enabled" {
+			enabled := toBool(value)
+			mc.EarlyProfitBookingEnabled = &enabled
+			count++
+			continue
+		}
 		if len(parts) < 2 {
 			continue
 		}
@@ -802,6 +808,11 @@ func (s *AdminSyncService) updateModeConfigFromFlattened(mc *ModeFullConfig, val
 				mc.FundingRate = &ModeFundingRateConfig{}
 			}
 			count += updateFundingRateConfig(mc.FundingRate, field, value)
+		case "entry":
+			if mc.Entry == nil {
+				mc.Entry = &ModeEntryConfig{}
+			}
+			count += updateEntryConfig(mc.Entry, field, value)
 		case "risk":
 			if mc.Risk == nil {
 				mc.Risk = &ModeRiskConfig{}
@@ -901,6 +912,18 @@ func updateSLTPConfig(sltp *ModeSLTPConfig, field string, subparts []string, val
 	case "min_sl_distance_from_zero":
 		sltp.MinSLDistanceFromZero = toFloat64(value)
 		return 1
+	case "runner_percent":
+		sltp.RunnerPercent = toFloat64(value)
+		return 1
+	case "runner_trailing_percent":
+		sltp.RunnerTrailingPercent = toFloat64(value)
+		return 1
+	case "exit_order_type":
+		sltp.ExitOrderType = toString(value)
+		return 1
+	case "exit_limit_buffer_percent":
+		sltp.ExitLimitBufferPercent = toFloat64(value)
+		return 1
 	}
 	return 0
 }
@@ -941,6 +964,9 @@ func updateSizeConfig(size *ModeSizeConfig, field string, value interface{}) int
 	case "auto_size_min_cover_fee":
 		size.AutoSizeMinCoverFee = toFloat64(value)
 		return 1
+	case "max_entries_per_cycle":
+		size.MaxEntriesPerCycle = toInt(value)
+		return 1
 	}
 	return 0
 }
@@ -1138,6 +1164,40 @@ func updateFundingRateConfig(fr *ModeFundingRateConfig, field string, value inte
 	return 0
 }
 
+// updateEntryConfig updates Entry config from field/value
+func updateEntryConfig(entry *ModeEntryConfig, field string, value interface{}) int {
+	switch field {
+	case "limit_order_gap_percent":
+		entry.LimitOrderGapPercent = toFloat64(value)
+		return 1
+	case "use_market_entry":
+		entry.UseMarketEntry = toBool(value)
+		return 1
+	case "max_limit_gap_percent":
+		entry.MaxLimitGapPercent = toFloat64(value)
+		return 1
+	case "prefer_maker_entry":
+		entry.PreferMakerEntry = toBool(value)
+		return 1
+	case "maker_timeout_seconds":
+		entry.MakerTimeoutSeconds = toInt(value)
+		return 1
+	case "max_spread_bps":
+		entry.MaxSpreadBps = toFloat64(value)
+		return 1
+	case "min_quote_volume_24h_usd":
+		entry.MinQuoteVolume24hUSD = toFloat64(value)
+		return 1
+	case "min_volume_ratio":
+		entry.MinVolumeRatio = toFloat64(value)
+		return 1
+	case "entry_order_type":
+		entry.EntryOrderType = toString(value)
+		return 1
+	}
+	return 0
+}
+
 // updateRiskConfig updates Risk config from field/value
 func updateRiskConfig(risk *ModeRiskConfig, field string, value interface{}) int {
 	switch field {
@@ -1162,6 +1222,15 @@ func updateRiskConfig(risk *ModeRiskConfig, field string, value interface{}) int
 	case "min_adx":
 		risk.MinADX = toFloat64(value)
 		return 1
+	case "min_risk_reward":
+		risk.MinRiskReward = toFloat64(value)
+		return 1
+	case "loss_alert_usd":
+		risk.LossAlertUSD = toFloat64(value)
+		return 1
+	case "loss_alert_percent":
+		risk.LossAlertPercent = toFloat64(value)
+		return 1
 	}
 	return 0
 }
@@ -101,6 +101,7 @@ type Controller struct {
 	onTrade         func(*Trade)
 	repository      *database.Repository
 	orderManager    *OrderManager
+	spotBrackets    *SpotBracketManager
 }
 
 // Position tracks an active position
@@ -197,6 +198,18 @@ func (c *Controller) SetOrderManager(om *OrderManager) {
 	c.orderManager = om
 }
 
+// SetSpotBracketManager sets the OCO bracket manager used to protect live buys.
+// When set it takes over from the order manager for BUY entries.
+func (c *Controller) SetSpotBracketManager(sbm *SpotBracketManager) {
+	c.spotBrackets = sbm
+	sbm.OnExit(func(b *SpotBracket, reason string, exitPrice float64) {
+		c.mu.Lock()
+		delete(c.activePositions, b.Symbol)
+		c.mu.Unlock()
+		log.Printf("[Autopilot] %s position closed by spot bracket (%s @ %.8f)", b.Symbol, reason, exitPrice)
+	})
+}
+
 // OnDecision sets the callback for decisions
 func (c *Controller) OnDecision(handler func(*TradingDecision)) {
 	c.onDecision = handler
@@ -591,7 +604,7 @@ func (c *Controller) executeDecision(decision *TradingDecision) {
 		aiDecisionID = &decision.AIDecisionID
 	}
 
-	if c.config.DryRun {
+	if c.isDryRun() {
 		side := "BUY"
 		if decision.Action == "sell" {
 			side = "SELL"
@@ -667,16 +680,24 @@ func (c *Controller) executeDecision(decision *TradingDecision) {
 		return
 	}
 
-	log.Printf("[Autopilot] Order placed: %s %s @ %.8f, ID: %d (AI Decision: %d)",
-		side, decision.Symbol, order.Price, order.OrderId, decision.AIDecisionID)
+	// MARKET orders report price 0 - use the average fill, and for a buy the
+	// quantity actually received after base-asset commission
+	entryPrice := order.AvgPrice()
+	quantity := order.ExecutedQty
+	if side == "BUY" {
+		quantity = order.NetExecutedQty()
+	}
+
+	log.Printf("[Autopilot] Order placed: %s %s @ %.8f, qty: %.8f, ID: %d (AI Decision: %d)",
+		side, decision.Symbol, entryPrice, quantity, order.OrderId, decision.AIDecisionID)
 
 	// Track position
 	c.mu.Lock()
 	c.activePositions[decision.Symbol] = &Position{
 		Symbol:       decision.Symbol,
 		Side:         decision.Direction,
-		EntryPrice:   order.Price,
-		Size:         decision.PositionSize,
+		EntryPrice:   entryPrice,
+		Size:         quantity,
 		StopLoss:     decision.StopLoss,
 		TakeProfit:   decision.TakeProfit,
 		OpenedAt:     time.Now(),
@@ -687,18 +708,27 @@ func (c *Controller) executeDecision(decision *TradingDecision) {
 
 	c.stats.TotalTrades++
 
-	// Register with order manager for auto TP/SL and trailing stop
-	if c.orderManager != nil {
-		tradeID := int64(order.OrderId) // Use order ID as trade ID for now
-		c.orderManager.RegisterPosition(tradeID, decision.Symbol, side, order.Price, decision.PositionSize, aiDecisionID)
+	// Protect the entry: OCO bracket for buys when available, otherwise the
+	// order manager's separate TP/SL orders and trailing stop
+	tradeID := int64(order.OrderId) // Use order ID as trade ID for now
+	protected := false
+	if c.spotBrackets != nil && side == "BUY" {
+		if err := c.spotBrackets.RegisterPosition(tradeID, decision.Symbol, side, entryPrice, quantity, aiDecisionID); err != nil {
+			log.Printf("[Autopilot] Spot bracket failed for %s, falling back to order manager: %v", decision.Symbol, err)
+		} else {
+			protected = true
+		}
+	}
+	if !protected && c.orderManager != nil {
+		c.orderManager.RegisterPosition(tradeID, decision.Symbol, side, entryPrice, quantity, aiDecisionID)
 	}
 
 	if c.onTrade != nil {
 		c.onTrade(&Trade{
 			Symbol:       decision.Symbol,
 			Side:         side,
-			Price:        order.Price,
-			Size:         decision.PositionSize,
+			Price:        entryPrice,
+			Size:         order.ExecutedQty,
 			Source:       "autopilot",
 			Timestamp:    time.Now(),
 			AIDecisionID: aiDecisionID,
@@ -780,6 +810,19 @@ func (c *Controller) GetActivePositions() map[string]*Position {
 	for k, v := range c.activePositions {
 		result[k] = v
 	}
+
+	// Bracketed holdings report the live OCO levels, which move with
+	// breakeven and trailing
+	if c.spotBrackets != nil {
+		for symbol, b := range c.spotBrackets.GetAllBrackets() {
+			if p, ok := result[symbol]; ok {
+				live := *p
+				live.StopLoss = b.StopLossPrice
+				live.TakeProfit = b.TakeProfitPrice
+				result[symbol] = &live
+			}
+		}
+	}
 	return result
 }
 
@@ -794,5 +837,10 @@ func (c *Controller) IsRunning() bool {
 func (c *Controller) SetDryRun(enabled bool) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	c.config.DryRun = enabled
+	c.config.DryRun = enabled || IsDryRunForced()
+}
+
+// isDryRun reports paper mode, honoring a kill switch engaged after startup
+func (c *Controller) isDryRun() bool {
+	return c.config.DryRun || IsDryRunForced()
 }
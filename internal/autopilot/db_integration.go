@@ -4,6 +4,14 @@ import (
 	"binance-trading-bot/internal/database"
 	"context"
 	"log"
+	"time"
+)
+
+// Deadlines for repository calls on trading paths, so a slow database fails
+// the call instead of stalling order execution
+const (
+	dbReadTimeout  = 3 * time.Second
+	dbWriteTimeout = 5 * time.Second
 )
 
 // SetRepository sets the database repository for saving decisions
@@ -96,7 +104,8 @@ func (c *Controller) saveDecisionToDB(symbol string, currentPrice float64, decis
 	aiDecision.ConfluenceCount = confluenceCount
 
 	// Save to database
-	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(context.Background(), dbWriteTimeout)
+	defer cancel()
 	if err := c.repository.SaveAIDecision(ctx, aiDecision); err != nil {
 		log.Printf("[Autopilot] Failed to save AI decision: %v", err)
 		return nil
@@ -5,6 +5,7 @@ import (
 	"binance-trading-bot/internal/ai/llm"
 	"binance-trading-bot/internal/ai/ml"
 	"binance-trading-bot/internal/ai/sentiment"
+	"binance-trading-bot/internal/audit"
 	"binance-trading-bot/internal/binance"
 	"binance-trading-bot/internal/circuit"
 	"binance-trading-bot/internal/database"
@@ -730,7 +731,7 @@ func NewFuturesController(
 
 	return &FuturesController{
 		config:             cfg,
-		futuresClient:      futuresClient,
+		futuresClient:      audit.WrapFuturesClient(futuresClient, futuresControllerAuditActor),
 		circuitBreaker:     circuitBreaker,
 		repo:               repo,
 		logger:             logger,
@@ -816,7 +817,7 @@ func (fc *FuturesController) LoadSavedSettings() {
 	if settings.RiskLevel != "" {
 		fc.currentRiskLevel = settings.RiskLevel
 	}
-	fc.dryRun = settings.GinieDryRunMode
+	fc.dryRun = settings.GinieDryRunMode || IsDryRunForced()
 	if settings.MaxUSDAllocation > 0 {
 		fc.maxUSDAllocation = settings.MaxUSDAllocation
 	}
@@ -833,7 +834,7 @@ func (fc *FuturesController) LoadSavedSettings() {
 	// Apply Ginie Autopilot settings AFTER releasing lock to prevent API timeouts
 	if fc.ginieAutopilot != nil {
 		ginieConfig := fc.ginieAutopilot.GetConfig()
-		ginieConfig.DryRun = settings.GinieDryRunMode
+		ginieConfig.DryRun = settings.GinieDryRunMode || IsDryRunForced()
 
 		// PRIORITY: Use global RiskLevel setting if set (user's explicit preference)
 		if settings.RiskLevel != "" {
@@ -1040,6 +1041,11 @@ func (fc *FuturesController) HasLLMAnalyzer() bool {
 	return fc.llmAnalyzer != nil
 }
 
+// GetLLMAnalyzer returns the LLM analyzer, nil if none is configured
+func (fc *FuturesController) GetLLMAnalyzer() *llm.Analyzer {
+	return fc.llmAnalyzer
+}
+
 // GetLLMProvider returns the current LLM provider name (e.g., "claude", "openai", "deepseek")
 // Returns empty string if no LLM analyzer is configured
 func (fc *FuturesController) GetLLMProvider() string {
@@ -1059,6 +1065,9 @@ func (fc *FuturesController) SetSentimentAnalyzer(a *sentiment.Analyzer) {
 	if fc.signalAggregator != nil {
 		fc.signalAggregator.SetSentimentAnalyzer(a)
 	}
+	if fc.ginieAutopilot != nil {
+		fc.ginieAutopilot.SetSentimentAnalyzer(a)
+	}
 }
 
 // SetTradingStyle sets the trading style
@@ -1174,7 +1183,7 @@ func (fc *FuturesController) StopGinieAutopilot() error {
 func (fc *FuturesController) GetDryRun() bool {
 	fc.mu.RLock()
 	defer fc.mu.RUnlock()
-	return fc.dryRun
+	return fc.isDryRun()
 }
 
 // GetFuturesClient returns the actual futures client being used by the controller
@@ -1228,6 +1237,11 @@ func (fc *FuturesController) SetWebSocketCallbacks(
 
 // SetDryRun sets dry run mode and propagates to Ginie
 func (fc *FuturesController) SetDryRun(enabled bool) {
+	if !enabled && IsDryRunForced() {
+		fc.logger.Warn("Live mode requested but FORCE_DRY_RUN kill switch is active, staying in paper mode")
+		enabled = true
+	}
+
 	fc.mu.Lock()
 	defer fc.mu.Unlock()
 
@@ -1266,12 +1280,15 @@ func (fc *FuturesController) SetDryRun(enabled bool) {
 	fc.dryRun = enabled
 }
 
+// futuresControllerAuditActor attributes audited exchange actions made through the shared controller
+const futuresControllerAuditActor = "futures_autopilot"
+
 // SetFuturesClient updates the futures client (used when switching between paper/live modes)
 func (fc *FuturesController) SetFuturesClient(client binance.FuturesClient) {
 	fc.mu.Lock()
 	defer fc.mu.Unlock()
 
-	fc.futuresClient = client
+	fc.futuresClient = audit.WrapFuturesClient(client, futuresControllerAuditActor)
 	fc.logger.Info("Futures controller client updated")
 
 	// Also update Ginie's client
@@ -1285,7 +1302,12 @@ func (fc *FuturesController) SetFuturesClient(client binance.FuturesClient) {
 func (fc *FuturesController) IsDryRun() bool {
 	fc.mu.RLock()
 	defer fc.mu.RUnlock()
-	return fc.dryRun
+	return fc.isDryRun()
+}
+
+// isDryRun reports paper mode, honoring a kill switch engaged after startup
+func (fc *FuturesController) isDryRun() bool {
+	return fc.dryRun || IsDryRunForced()
 }
 
 // Start begins the futures autopilot loop
@@ -1311,7 +1333,7 @@ func (fc *FuturesController) Start() error {
 	go fc.runLoop()
 
 	fc.logger.Info("Futures autopilot started",
-		"dry_run", fc.dryRun,
+		"dry_run", fc.isDryRun(),
 		"risk_level", fc.config.RiskLevel,
 		"leverage", fc.config.DefaultLeverage,
 		"trading_style", string(fc.tradingStyle))
@@ -1369,7 +1391,7 @@ func (fc *FuturesController) GetStatus() map[string]interface{} {
 
 	return map[string]interface{}{
 		"running":          fc.running,
-		"dry_run":          fc.dryRun,
+		"dry_run":          fc.isDryRun(),
 		"risk_level":       fc.currentRiskLevel,
 		"daily_trades":     fc.dailyTrades,
 		"daily_pnl":        fc.dailyPnL,
@@ -1462,7 +1484,8 @@ func (fc *FuturesController) SetRiskLevel(level string) error {
 	fc.currentRiskLevel = level
 
 	// Story 6.6: Cache-first approach for mode config
-	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(context.Background(), dbWriteTimeout)
+	defer cancel()
 	var modeConfig *ModeFullConfig
 	var err error
 
@@ -2041,7 +2064,7 @@ func (fc *FuturesController) getAvailableAllocation() (baseAmount float64, profi
 	}
 
 	// Check actual available balance from Binance (if not in dry run)
-	if !fc.dryRun && fc.futuresClient != nil {
+	if !fc.isDryRun() && fc.futuresClient != nil {
 		accountInfo, err := fc.futuresClient.GetFuturesAccountInfo()
 		if err == nil && accountInfo != nil {
 			// Find USDT available balance
@@ -2072,7 +2095,7 @@ func (fc *FuturesController) getAvailableAllocation() (baseAmount float64, profi
 // syncWithActualPositions syncs internal state with actual Binance positions
 // Call this on startup and periodically to prevent drift
 func (fc *FuturesController) syncWithActualPositions() {
-	if fc.dryRun || fc.futuresClient == nil {
+	if fc.isDryRun() || fc.futuresClient == nil {
 		return
 	}
 
@@ -2765,7 +2788,8 @@ func (fc *FuturesController) collectSignals(symbol string, currentPrice float64,
 				"sl_price", decision.StopLoss)
 		} else {
 			// Story 6.6: Cache-first approach for SLTP settings
-			ctx := context.Background()
+			ctx, cancel := context.WithTimeout(context.Background(), dbReadTimeout)
+			defer cancel()
 			currentMode := fc.currentRiskLevel
 			if currentMode == "" {
 				currentMode = "moderate"
@@ -2970,7 +2994,7 @@ func (fc *FuturesController) executeDecision(symbol string, decision *FuturesAut
 	// Calculate position value for allocation tracking
 	positionValue := (currentPrice * decision.Quantity) / float64(decision.Leverage)
 
-	if fc.dryRun {
+	if fc.isDryRun() {
 		fc.logger.Info("DRY RUN: Would execute futures trade",
 			"symbol", symbol,
 			"action", decision.Action,
@@ -2985,7 +3009,8 @@ func (fc *FuturesController) executeDecision(symbol string, decision *FuturesAut
 		tradeSide := map[string]string{"open_long": "LONG", "open_short": "SHORT"}[decision.Action]
 
 		// Story 6.6: Cache-first approach for SLTP settings
-		ctx := context.Background()
+		ctx, cancel := context.WithTimeout(context.Background(), dbReadTimeout)
+		defer cancel()
 		currentMode := fc.currentRiskLevel
 		if currentMode == "" {
 			currentMode = "moderate"
@@ -3144,7 +3169,8 @@ func (fc *FuturesController) executeDecision(symbol string, decision *FuturesAut
 
 
 	// Story 6.6: Cache-first approach for SLTP settings
-	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(context.Background(), dbReadTimeout)
+	defer cancel()
 	currentMode := fc.currentRiskLevel
 	if currentMode == "" {
 		currentMode = "moderate"
@@ -3629,7 +3655,7 @@ func (fc *FuturesController) monitorPositions() {
 						symbol, pos, currentPrice, false, 0)
 
 					if needsHedge {
-						_, err := fc.hedgingManager.ExecuteHedge(symbol, pos, hedgePercent, trigger, fc.dryRun)
+						_, err := fc.hedgingManager.ExecuteHedge(symbol, pos, hedgePercent, trigger, fc.isDryRun())
 						if err != nil {
 							fc.logger.Error("Failed to execute hedge",
 								"symbol", symbol,
@@ -3641,7 +3667,7 @@ func (fc *FuturesController) monitorPositions() {
 					// Monitor existing hedge
 					shouldClose, reason := fc.hedgingManager.MonitorHedge(symbol, pos, currentPrice)
 					if shouldClose {
-						pnl, err := fc.hedgingManager.CloseHedge(symbol, reason, fc.dryRun)
+						pnl, err := fc.hedgingManager.CloseHedge(symbol, reason, fc.isDryRun())
 						if err != nil {
 							fc.logger.Error("Failed to close hedge", "symbol", symbol, "error", err)
 						} else {
@@ -3706,7 +3732,7 @@ func (fc *FuturesController) closePosition(symbol string, pos *FuturesAutopilotP
 	// Update the futures trade record in database
 	go fc.updateTradeOnClose(symbol, currentPrice, pnl, pnlPercent, reason)
 
-	if !fc.dryRun {
+	if !fc.isDryRun() {
 		// CRITICAL: Cancel all outstanding TP/SL algo orders FIRST
 		// This prevents the orphan order bug where remaining TP/SL opens a new position
 		if err := fc.futuresClient.CancelAllAlgoOrders(symbol); err != nil {
@@ -4028,7 +4054,7 @@ func (fc *FuturesController) GetInvestigateStatus() *InvestigateStatus {
 	// === MODE STATUS ===
 	// Autopilot
 	autopilotDetails := fc.config.RiskLevel
-	if fc.dryRun {
+	if fc.isDryRun() {
 		autopilotDetails += ", Paper"
 	} else {
 		autopilotDetails += ", Live"
@@ -4377,7 +4403,6 @@ func (fc *FuturesController) saveDecisionToDB(decision *FuturesAutopilotDecision
 		// Default threshold is 0.5 (50%) - conservative fallback
 		confluenceThreshold := 0.5
 		if fc.ownerUserID != "" && fc.repo != nil {
-			ctx := context.Background()
 			// Use current risk level to get mode config
 			currentMode := fc.currentRiskLevel
 			if currentMode == "" {
@@ -4414,7 +4439,6 @@ func (fc *FuturesController) saveDecisionToDB(decision *FuturesAutopilotDecision
 	aggressiveThreshold := 0.6
 
 	if fc.ownerUserID != "" && fc.repo != nil {
-		ctx := context.Background()
 
 		// If cache available, use cache-first pattern
 		if fc.settingsCache != nil {
@@ -4645,7 +4669,8 @@ func (fc *FuturesController) getAveragingConfidenceThreshold() float64 {
 		return defaultThreshold
 	}
 
-	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(context.Background(), dbReadTimeout)
+	defer cancel()
 
 	// Use current risk level to get mode config
 	currentMode := fc.currentRiskLevel
@@ -4909,7 +4934,7 @@ func (fc *FuturesController) executeAveraging(
 		}
 	}
 
-	if fc.dryRun {
+	if fc.isDryRun() {
 		fc.logger.Info("DRY RUN: Would average into position",
 			"symbol", symbol,
 			"side", pos.Side,
@@ -5214,7 +5239,16 @@ func (fc *FuturesController) GetSentimentScore() map[string]interface{} {
 		"trend_score":      score.TrendScore,
 		"updated_at":       score.UpdatedAt,
 		"sources":          score.Sources,
+		"source_scores":    score.SourceScores,
+	}
+}
+
+// GetSymbolSentiment returns the coin-specific sentiment for a symbol
+func (fc *FuturesController) GetSymbolSentiment(symbol string) *sentiment.SymbolSentiment {
+	if fc.sentimentAnalyzer == nil || !fc.sentimentAnalyzer.IsEnabled() {
+		return nil
 	}
+	return fc.sentimentAnalyzer.GetSymbolSentiment(symbol)
 }
 
 // GetRecentNews returns recent news items
@@ -0,0 +1,152 @@
+package autopilot
+
+import (
+	"fmt"
+	"time"
+)
+
+// Adaptive throttle levels stored on ModeSafetyState.ThrottleLevel
+const (
+	ThrottleLevelNone      = ""
+	ThrottleLevelThrottled = "throttled" // Higher min confidence, smaller size
+	ThrottleLevelPaused    = "paused"    // No new entries until the cooldown ends
+)
+
+// ModeThrottleStatus is the adaptive throttle state of one mode, for diagnostics
+type ModeThrottleStatus struct {
+	Level           string    `json:"level"`
+	WinRate         float64   `json:"win_rate"`
+	SampleSize      int       `json:"sample_size"`
+	ConfidenceBoost float64   `json:"confidence_boost"`
+	SizeMultiplier  float64   `json:"size_multiplier"`
+	Since           time.Time `json:"since,omitempty"`
+	PausedUntil     time.Time `json:"paused_until,omitempty"`
+}
+
+// rollingWinRate returns the win rate (%) over the most recent n trades, or ok=false
+// when fewer than n trades have been recorded
+func rollingWinRate(trades []SafetyTradeResult, n int) (winRate float64, ok bool) {
+	if n <= 0 || len(trades) < n {
+		return 0, false
+	}
+	wins := 0
+	for _, t := range trades[len(trades)-n:] {
+		if t.IsWinning {
+			wins++
+		}
+	}
+	return float64(wins) / float64(n) * 100, true
+}
+
+// updateAdaptiveThrottleLocked re-evaluates a mode's throttle after a trade closes.
+// Below ThrottleWinRate the mode is throttled, below ThrottlePauseWinRate it is
+// paused for ThrottlePauseMinutes, and it only relaxes once the rolling win rate
+// recovers to ThrottleRecoverWinRate. Caller must hold ga.mu.
+func (ga *GinieAutopilot) updateAdaptiveThrottleLocked(state *ModeSafetyState) {
+	if !ga.config.AdaptiveThrottleEnabled {
+		return
+	}
+	winRate, ok := rollingWinRate(state.RecentTrades, ga.config.ThrottleSampleSize)
+	if !ok {
+		return
+	}
+	state.CurrentWinRate = winRate
+
+	now := time.Now()
+	previous := state.ThrottleLevel
+	switch {
+	case ga.config.ThrottlePauseWinRate > 0 && winRate < ga.config.ThrottlePauseWinRate:
+		if previous != ThrottleLevelPaused {
+			state.ThrottleLevel = ThrottleLevelPaused
+			state.ThrottleSince = now
+			state.ThrottlePauseUntil = now.Add(time.Duration(ga.config.ThrottlePauseMinutes) * time.Minute)
+		}
+	case winRate < ga.config.ThrottleWinRate:
+		if previous == ThrottleLevelNone {
+			state.ThrottleLevel = ThrottleLevelThrottled
+			state.ThrottleSince = now
+		}
+	case winRate >= ga.config.ThrottleRecoverWinRate:
+		if previous == ThrottleLevelThrottled {
+			state.ThrottleLevel = ThrottleLevelNone
+			state.ThrottleSince = time.Time{}
+		}
+	}
+
+	if state.ThrottleLevel != previous {
+		ga.logThrottleChange(state, previous, winRate)
+	}
+}
+
+// adaptiveThrottle returns the adjustments currently applied to a mode.
+// An expired pause steps down to throttled rather than straight back to normal.
+func (ga *GinieAutopilot) adaptiveThrottle(mode GinieTradingMode) (confidenceBoost, sizeMultiplier float64, paused bool, reason string) {
+	ga.mu.Lock()
+	defer ga.mu.Unlock()
+
+	state, exists := ga.modeSafetyStates[string(mode)]
+	if !ga.config.AdaptiveThrottleEnabled || !exists || state.ThrottleLevel == ThrottleLevelNone {
+		return 0, 1, false, ""
+	}
+
+	if state.ThrottleLevel == ThrottleLevelPaused && !time.Now().Before(state.ThrottlePauseUntil) {
+		state.ThrottleLevel = ThrottleLevelThrottled
+		state.ThrottleSince = time.Now()
+		ga.logThrottleChange(state, ThrottleLevelPaused, state.CurrentWinRate)
+	}
+
+	sizeMultiplier = ga.config.ThrottleSizeMultiplier
+	if sizeMultiplier <= 0 || sizeMultiplier > 1 {
+		sizeMultiplier = 1
+	}
+	if state.ThrottleLevel == ThrottleLevelPaused {
+		remaining := time.Until(state.ThrottlePauseUntil).Round(time.Second)
+		return ga.config.ThrottleConfidenceBoost, sizeMultiplier, true,
+			fmt.Sprintf("win rate %.1f%% over last %d trades (remaining: %v)", state.CurrentWinRate, ga.config.ThrottleSampleSize, remaining)
+	}
+	return ga.config.ThrottleConfidenceBoost, sizeMultiplier, false, ""
+}
+
+// logThrottleChange logs every throttle level transition
+func (ga *GinieAutopilot) logThrottleChange(state *ModeSafetyState, previous string, winRate float64) {
+	from, to := previous, state.ThrottleLevel
+	if from == ThrottleLevelNone {
+		from = "normal"
+	}
+	if to == ThrottleLevelNone {
+		to = "normal"
+	}
+	ga.logger.Warn("Ginie adaptive throttle adjusted",
+		"mode", state.Mode,
+		"from", from,
+		"to", to,
+		"win_rate", fmt.Sprintf("%.1f%%", winRate),
+		"sample_size", ga.config.ThrottleSampleSize,
+		"confidence_boost", ga.config.ThrottleConfidenceBoost,
+		"size_multiplier", ga.config.ThrottleSizeMultiplier,
+		"paused_until", state.ThrottlePauseUntil)
+}
+
+// getThrottleDiagnosticsLocked returns throttle state per mode (must hold lock)
+func (ga *GinieAutopilot) getThrottleDiagnosticsLocked() map[string]ModeThrottleStatus {
+	statuses := make(map[string]ModeThrottleStatus, len(ga.modeSafetyStates))
+	for mode, state := range ga.modeSafetyStates {
+		status := ModeThrottleStatus{
+			Level:      state.ThrottleLevel,
+			WinRate:    state.CurrentWinRate,
+			SampleSize: ga.config.ThrottleSampleSize,
+			Since:      state.ThrottleSince,
+		}
+		if status.Level == ThrottleLevelNone {
+			status.Level = "normal"
+		} else {
+			status.ConfidenceBoost = ga.config.ThrottleConfidenceBoost
+			status.SizeMultiplier = ga.config.ThrottleSizeMultiplier
+		}
+		if state.ThrottleLevel == ThrottleLevelPaused {
+			status.PausedUntil = state.ThrottlePauseUntil
+		}
+		statuses[mode] = status
+	}
+	return statuses
+}
@@ -0,0 +1,110 @@
+package autopilot
+
+import (
+	"errors"
+	"time"
+
+	"binance-trading-bot/internal/binance"
+)
+
+// algoLimitCleanupCooldown throttles the per-symbol cleanup triggered when an
+// algo order is refused for hitting the open order cap
+const algoLimitCleanupCooldown = time.Minute
+
+// placeAlgoOrder places an algo order and, if the exchange client refused it
+// because the symbol is already at the open algo order cap, kicks off a
+// background cleanup of the leaked orders for that symbol. A -4061 position
+// side rejection is retried once with the side the position mode expects.
+func (ga *GinieAutopilot) placeAlgoOrder(params binance.AlgoOrderParams) (*binance.AlgoOrderResponse, error) {
+	order, err := ga.futuresClient.PlaceAlgoOrder(params)
+	if errors.Is(err, binance.ErrPositionSideMismatch) {
+		closing := orderCloses(params.Side, params.PositionSide, params.ReduceOnly, params.ClosePosition)
+		var reduceOnly bool
+		params.PositionSide, reduceOnly = ga.recoverPositionSide(params.Symbol, params.Side, params.PositionSide, closing)
+		params.ReduceOnly = reduceOnly && !params.ClosePosition
+		order, err = ga.futuresClient.PlaceAlgoOrder(params)
+	}
+	if err != nil && errors.Is(err, binance.ErrAlgoOrderLimit) {
+		ga.triggerAlgoLimitCleanup(params.Symbol)
+	}
+	return order, err
+}
+
+func (ga *GinieAutopilot) triggerAlgoLimitCleanup(symbol string) {
+	ga.algoLimitMu.Lock()
+	if ga.algoLimitCleanupAt == nil {
+		ga.algoLimitCleanupAt = make(map[string]time.Time)
+	}
+	if last, ok := ga.algoLimitCleanupAt[symbol]; ok && time.Since(last) < algoLimitCleanupCooldown {
+		ga.algoLimitMu.Unlock()
+		return
+	}
+	ga.algoLimitCleanupAt[symbol] = time.Now()
+	ga.algoLimitMu.Unlock()
+
+	go ga.pruneLeakedAlgoOrders(symbol)
+}
+
+// pruneLeakedAlgoOrders cancels open algo orders for symbol that the tracked
+// position doesn't reference (current SL, TPs and native trailing stop). With no
+// tracked position every algo order for the symbol is an orphan and is cancelled.
+// Must be called WITHOUT ga.mu held (network calls).
+func (ga *GinieAutopilot) pruneLeakedAlgoOrders(symbol string) {
+	ga.mu.RLock()
+	keep := make(map[int64]bool)
+	pos, tracked := ga.positions[symbol]
+	if tracked {
+		if pos.StopLossAlgoID > 0 {
+			keep[pos.StopLossAlgoID] = true
+		}
+		for _, id := range pos.TakeProfitAlgoIDs {
+			keep[id] = true
+		}
+	}
+	ga.mu.RUnlock()
+
+	if !tracked {
+		cancelled, failed, err := ga.cancelAllAlgoOrdersForSymbol(symbol)
+		ga.logger.Warn("Algo order limit hit on untracked symbol - cancelled orphan algo orders",
+			"symbol", symbol,
+			"cancelled", cancelled,
+			"failed", failed,
+			"error", err)
+		return
+	}
+
+	ga.nativeTrailingMu.Lock()
+	if state := ga.nativeTrailing[symbol]; state != nil && state.algoID > 0 {
+		keep[state.algoID] = true
+	}
+	ga.nativeTrailingMu.Unlock()
+
+	orders, err := ga.futuresClient.GetOpenAlgoOrders(symbol)
+	if err != nil {
+		ga.logger.Warn("Algo order limit cleanup: failed to list open algo orders",
+			"symbol", symbol,
+			"error", err)
+		return
+	}
+
+	cancelled := 0
+	for _, order := range orders {
+		if keep[order.AlgoId] {
+			continue
+		}
+		if err := ga.futuresClient.CancelAlgoOrder(symbol, order.AlgoId); err != nil {
+			ga.logger.Warn("Algo order limit cleanup: failed to cancel leaked order",
+				"symbol", symbol,
+				"algo_id", order.AlgoId,
+				"error", err)
+			continue
+		}
+		cancelled++
+	}
+
+	ga.logger.Warn("Algo order limit hit - cancelled leaked algo orders",
+		"symbol", symbol,
+		"open_orders", len(orders),
+		"kept", len(keep),
+		"cancelled", cancelled)
+}
@@ -291,15 +291,20 @@ type UltraFastSignal struct {
 
 // LoadLLMSelectedCoins asks DeepSeek to provide 100 coins based on market criteria
 func (g *GinieAnalyzer) LoadLLMSelectedCoins() error {
+	return g.LoadLLMSelectedCoinsContext(context.Background())
+}
+
+// LoadLLMSelectedCoinsContext is LoadLLMSelectedCoins bounded by ctx
+func (g *GinieAnalyzer) LoadLLMSelectedCoinsContext(ctx context.Context) error {
 	// Check cache first
 	if len(g.llmCoinsCache) > 0 && time.Since(g.llmCoinsCacheTime) < g.llmCoinsCacheTTL {
 		g.watchSymbols = g.llmCoinsCache
 		return nil
 	}
 
-	if g.llmClient == nil || !g.llmClient.IsConfigured() {
+	if g.llmClient == nil || !g.llmClient.IsAvailable() {
 		if g.logger != nil {
-			g.logger.Warn("LLM client not configured, falling back to market movers")
+			g.logger.Warn("LLM client not configured or degraded, falling back to market movers")
 		}
 		// Fallback to market movers
 		return g.LoadDynamicSymbols(25)
@@ -350,8 +355,16 @@ Respond ONLY with a valid JSON object in this exact format (no markdown, no expl
 
 Return EXACTLY 100 unique USDT perpetual futures symbols in the JSON format specified.`, marketSummary)
 
-	response, err := g.llmClient.Complete(systemPrompt, userPrompt)
+	response, err := g.llmClient.CompleteContext(ctx, systemPrompt, userPrompt)
 	if err != nil {
+		// Keep the previous LLM list (even if stale) rather than dropping to market movers
+		if len(g.llmCoinsCache) > 0 {
+			if g.logger != nil {
+				g.logger.Warn("LLM coin selection failed, keeping previous LLM list", "error", err, "count", len(g.llmCoinsCache))
+			}
+			g.watchSymbols = g.llmCoinsCache
+			return nil
+		}
 		if g.logger != nil {
 			g.logger.Error("LLM coin selection failed", "error", err)
 		}
@@ -1971,6 +1984,46 @@ func (g *GinieAnalyzer) generateDecisionInternal(symbol string, mode GinieTradin
 		return report, nil
 	}
 
+	// Block LONG/SHORT unless enough of the mode's confluence timeframes trend the same way
+	if modeConfig != nil {
+		if confluence := g.checkTimeframeConfluence(symbol, signals.Direction, modeConfig.TrendDivergence, scan.Trend, trendAnalysis); confluence != nil && !confluence.Passed {
+			severity := "moderate"
+			if confluence.Opposed() {
+				severity = "severe"
+			}
+			dissentTF, dissentTrend := confluence.Dissent()
+			rejectionTracker.TrendDivergence = &TrendDivergenceRejection{
+				Blocked:            true,
+				ScanTimeframe:      dissentTF,
+				ScanTrend:          dissentTrend,
+				DecisionTimeframe:  trendAnalysis.Timeframe,
+				DecisionTrend:      trendAnalysis.TrendDirection,
+				Severity:           severity,
+				Reason:             confluence.Reason(),
+				TimeframeTrends:    confluence.Trends,
+				TimeframesAgreeing: confluence.Agreeing,
+				TimeframesRequired: confluence.Required,
+			}
+			rejectionTracker.AddRejection(fmt.Sprintf("Timeframe Confluence (%s): %d/%d agree with %s, need %d",
+				severity, confluence.Agreeing, len(confluence.Timeframes), strings.ToUpper(signals.Direction), confluence.Required))
+
+			report.Recommendation = RecommendationSkip
+			report.RecommendationNote = fmt.Sprintf("BLOCKED: %s", confluence.Reason())
+			report.ConfidenceScore = 0
+
+			if g.logger != nil {
+				g.logger.Info("Trade blocked by timeframe confluence",
+					"symbol", symbol,
+					"direction", signals.Direction,
+					"agreeing", confluence.Agreeing,
+					"required", confluence.Required,
+					"trends", confluence.Summary())
+			}
+
+			return report, nil
+		}
+	}
+
 	// Track signal and scan quality issues (even if not blocking yet)
 	if !signals.PrimaryPassed {
 		// Collect failed signals
@@ -3138,29 +3191,12 @@ ATR(14): $%.8f (%.2f%% of price) - Volatility gauge
 
 // ParseLLMResponse parses the JSON response from LLM and validates it
 func (g *GinieAnalyzer) ParseLLMResponse(response string) (*LLMAnalysisResponse, error) {
-	// Clean the response - strip markdown code blocks if present
-	response = strings.TrimSpace(response)
-
-	// Handle ```json ... ``` blocks
-	re := regexp.MustCompile("(?s)^```(?:json)?\\s*\\n?(.*?)\\n?```$")
-	if matches := re.FindStringSubmatch(response); len(matches) > 1 {
-		response = strings.TrimSpace(matches[1])
-	}
-
-	// Also try to find JSON within the response if not wrapped in code blocks
-	if !strings.HasPrefix(response, "{") {
-		// Try to extract JSON from the response
-		jsonStart := strings.Index(response, "{")
-		jsonEnd := strings.LastIndex(response, "}")
-		if jsonStart >= 0 && jsonEnd > jsonStart {
-			response = response[jsonStart : jsonEnd+1]
-		}
-	}
-
+	// Strip markdown fences/prose and trailing commas; recommendation and
+	// confidence must be present rather than silently decoding as zero values
 	var parsed LLMAnalysisResponse
-	if err := json.Unmarshal([]byte(response), &parsed); err != nil {
+	if err := llm.DecodeJSONResponse(response, &parsed, "recommendation", "confidence"); err != nil {
 		if g.logger != nil {
-			g.logger.Error("[LLM] Failed to parse response", "error", err, "response_preview", response[:min(200, len(response))])
+			g.logger.Error("[LLM] Failed to parse response", "error", err, "raw_response", response)
 		}
 		return nil, fmt.Errorf("failed to parse LLM response as JSON: %w", err)
 	}
@@ -3352,8 +3388,8 @@ func (g *GinieAnalyzer) FuseConfidence(technicalConfidence int, technicalDirecti
 
 // IsLLMEnabledForMode checks if LLM analysis is enabled for the given trading mode
 func (g *GinieAnalyzer) IsLLMEnabledForMode(mode GinieTradingMode) bool {
-	// LLM is enabled if we have a configured client
-	if g.llmClient == nil || !g.llmClient.IsConfigured() {
+	// LLM is enabled if we have a configured client whose provider is not degraded
+	if g.llmClient == nil || !g.llmClient.IsAvailable() {
 		return false
 	}
 
@@ -3447,21 +3483,32 @@ func (g *GinieAnalyzer) PerformLLMAnalysis(symbol string, klines []binance.Kline
 		g.logger.Info("[LLM] Calling LLM for analysis", "symbol", symbol, "mode", mode)
 	}
 
-	response, err := g.llmClient.Complete(systemPrompt, userPrompt)
-	latencyMs := time.Since(startTime).Milliseconds()
+	// JSON mode; a malformed reply gets one retry before the decision skips LLM
+	var parsed *LLMAnalysisResponse
+	var latencyMs int64
+	for attempt := 1; attempt <= 2; attempt++ {
+		response, err := g.llmClient.CompleteJSON(systemPrompt, userPrompt)
+		latencyMs = time.Since(startTime).Milliseconds()
 
-	if err != nil {
-		ctx.SkipReason = fmt.Sprintf("LLM API error: %v", err)
-		ctx.LLMLatencyMs = latencyMs
-		if g.logger != nil {
-			g.logger.Error("[LLM] API call failed", "symbol", symbol, "error", err, "latency_ms", latencyMs)
+		if err != nil {
+			ctx.SkipReason = fmt.Sprintf("LLM API error: %v", err)
+			ctx.LLMLatencyMs = latencyMs
+			if g.logger != nil {
+				g.logger.Error("[LLM] API call failed", "symbol", symbol, "error", err, "latency_ms", latencyMs)
+			}
+			return nil, ctx, err
 		}
-		return nil, ctx, err
-	}
 
-	// Parse response
-	parsed, err := g.ParseLLMResponse(response)
-	if err != nil {
+		parsed, err = g.ParseLLMResponse(response)
+		if err == nil {
+			break
+		}
+		if attempt == 1 {
+			if g.logger != nil {
+				g.logger.Warn("[LLM] Malformed response, retrying once", "symbol", symbol, "error", err)
+			}
+			continue
+		}
 		ctx.SkipReason = fmt.Sprintf("Failed to parse response: %v", err)
 		ctx.LLMLatencyMs = latencyMs
 		return nil, ctx, err
@@ -0,0 +1,213 @@
+package autopilot
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"binance-trading-bot/internal/binance"
+)
+
+// autoDeleverageCooldown is the minimum time between deleverage closes on one
+// position, giving the exchange's liquidation price time to reflect the last one
+const autoDeleverageCooldown = time.Minute
+
+// liquidationQuote is an exchange position's liquidation price and the mark
+// price it was computed against
+type liquidationQuote struct {
+	LiquidationPrice float64
+	MarkPrice        float64
+}
+
+// liquidationKey keys liquidation quotes by symbol and LONG/SHORT side
+func liquidationKey(symbol, side string) string {
+	return symbol + ":" + side
+}
+
+// liquidationDistancePercent is how far price still has to move against the
+// position to reach the liquidation price, as a % of price (<= 0 once past it)
+func liquidationDistancePercent(side string, price, liquidationPrice float64) float64 {
+	if price <= 0 || liquidationPrice <= 0 {
+		return 0
+	}
+	if side == "SHORT" {
+		return (liquidationPrice - price) / price * 100
+	}
+	return (price - liquidationPrice) / price * 100
+}
+
+// fetchLiquidationQuotes reads the liquidation price of every open exchange
+// position from positionRisk. Returns nil when auto-deleverage is off.
+// Must not be called with ga.mu held.
+func (ga *GinieAutopilot) fetchLiquidationQuotes() map[string]liquidationQuote {
+	ga.mu.RLock()
+	enabled := ga.config.AutoDeleverageEnabled && !ga.config.DryRun
+	ga.mu.RUnlock()
+	if !enabled || ga.futuresClient == nil {
+		return nil
+	}
+
+	positions, err := ga.futuresClient.GetPositions()
+	if err != nil {
+		ga.logger.Warn("Auto-deleverage: failed to fetch positions for liquidation prices", "error", err)
+		return nil
+	}
+	quotes := make(map[string]liquidationQuote)
+	for _, p := range positions {
+		if p.PositionAmt == 0 || p.LiquidationPrice <= 0 {
+			continue
+		}
+		side := "LONG"
+		if p.PositionSide == string(binance.PositionSideShort) || (p.PositionSide != string(binance.PositionSideLong) && p.PositionAmt < 0) {
+			side = "SHORT"
+		}
+		quotes[liquidationKey(p.Symbol, side)] = liquidationQuote{LiquidationPrice: p.LiquidationPrice, MarkPrice: p.MarkPrice}
+	}
+	return quotes
+}
+
+// checkAutoDeleverage partially closes a position whose price is within
+// AutoDeleverageTriggerPercent of its liquidation price, closing
+// AutoDeleverageCloseFraction of what's left to pull the liquidation price away.
+// Runs under the symbol lock; must not be called with ga.mu held.
+func (ga *GinieAutopilot) checkAutoDeleverage(symbol string, currentPrice float64, quotes map[string]liquidationQuote) {
+	unlockSymbol := ga.symbolLocks.Lock(symbol)
+	defer unlockSymbol()
+
+	ga.mu.Lock()
+	pos, exists := ga.positions[symbol]
+	if !exists || pos.AwaitingAdoption || pos.IsClosing || pos.RemainingQty <= 0 {
+		ga.mu.Unlock()
+		return
+	}
+	quote, ok := quotes[liquidationKey(symbol, pos.Side)]
+	trigger := ga.config.AutoDeleverageTriggerPercent
+	fraction := ga.config.AutoDeleverageCloseFraction
+	if !ok || trigger <= 0 || fraction <= 0 || time.Since(pos.LastDeleverageAt) < autoDeleverageCooldown {
+		ga.mu.Unlock()
+		return
+	}
+
+	// Liquidation runs off the mark price
+	price := quote.MarkPrice
+	if price <= 0 {
+		price = currentPrice
+	}
+	distance := liquidationDistancePercent(pos.Side, price, quote.LiquidationPrice)
+	if distance > trigger {
+		ga.mu.Unlock()
+		return
+	}
+
+	closeQty := roundQuantity(symbol, pos.RemainingQty*min(fraction, 1))
+	leftover := pos.RemainingQty - closeQty
+	fullClose := closeQty <= 0 || leftover <= 0 || leftover*price < ga.config.DustThresholdUSD
+	remainingQty := pos.RemainingQty
+	pos.LastDeleverageAt = time.Now()
+	ga.mu.Unlock()
+
+	reason := fmt.Sprintf("auto_deleverage: %.2f%% from liquidation at %.6f", distance, quote.LiquidationPrice)
+	log.Printf("[AUTO-DELEVERAGE] %s %s: price %.6f is %.2f%% from liquidation %.6f (trigger %.2f%%)",
+		symbol, pos.Side, price, distance, quote.LiquidationPrice, trigger)
+
+	if fullClose {
+		// Too small to split: closing it all is still better than a liquidation
+		ga.closePosition(symbol, pos, currentPrice, reason, pos.CurrentTPLevel)
+		ga.notifyAutoDeleverage(pos, reason, remainingQty, true)
+		return
+	}
+
+	if err := ga.requireActiveWithSymbol(symbol, "auto-deleverage"); err != nil {
+		return
+	}
+
+	side := "SELL"
+	positionSide := binance.PositionSideLong
+	if pos.Side == "SHORT" {
+		side = "BUY"
+		positionSide = binance.PositionSideShort
+	}
+	effectivePositionSide := ga.getEffectivePositionSide(positionSide)
+
+	_, err := ga.placeFuturesOrder(binance.FuturesOrderParams{
+		Symbol:       symbol,
+		Side:         side,
+		PositionSide: effectivePositionSide,
+		Type:         binance.FuturesOrderTypeMarket,
+		Quantity:     closeQty,
+		ReduceOnly:   effectivePositionSide == binance.PositionSideBoth, // Hedge mode rejects reduceOnly
+	})
+	if err != nil {
+		ga.logger.Error("Auto-deleverage partial close failed", "symbol", symbol, "qty", closeQty, "error", err.Error())
+		ga.mu.Lock()
+		ga.failedOrdersLastHour++
+		ga.mu.Unlock()
+		return
+	}
+
+	var grossPnl float64
+	if pos.Side == "LONG" {
+		grossPnl = (currentPrice - pos.EntryPrice) * closeQty
+	} else {
+		grossPnl = (pos.EntryPrice - currentPrice) * closeQty
+	}
+	pnl := grossPnl - calculateTradingFee(closeQty, currentPrice)
+
+	ga.mu.Lock()
+	pos.RemainingQty -= closeQty
+	pos.RealizedPnL += pnl
+	pos.DeleverageCount++
+	ga.dailyPnL += pnl
+	ga.totalPnL += pnl
+	ga.partialClosesLastHour++
+	ga.mu.Unlock()
+
+	ga.logger.Info("Auto-deleverage partial close executed",
+		"symbol", symbol,
+		"side", pos.Side,
+		"closed_qty", closeQty,
+		"remaining_qty", pos.RemainingQty,
+		"liquidation_price", quote.LiquidationPrice,
+		"distance_pct", distance,
+		"pnl", pnl)
+
+	go ga.SavePnLStats()
+	go ga.SavePositionState()
+
+	ga.recordTrade(GinieTradeResult{
+		Symbol:    symbol,
+		Action:    "partial_close",
+		Side:      pos.Side,
+		Quantity:  closeQty,
+		Price:     currentPrice,
+		PnL:       pnl,
+		Reason:    reason,
+		TPLevel:   pos.CurrentTPLevel,
+		Timestamp: time.Now(),
+		Mode:      pos.Mode,
+	})
+	ga.notifyAutoDeleverage(pos, reason, closeQty, false)
+}
+
+// notifyAutoDeleverage sends an alert for a deleverage close
+func (ga *GinieAutopilot) notifyAutoDeleverage(pos *GiniePosition, reason string, qty float64, fullClose bool) {
+	notifier := ga.alertNotifier
+	if notifier == nil {
+		return
+	}
+	action := fmt.Sprintf("closed %.6f, %.6f left", qty, pos.RemainingQty)
+	if fullClose {
+		action = "closed the whole position"
+	}
+	title := fmt.Sprintf("%s %s auto-deleveraged", pos.Symbol, pos.Side)
+	msg := fmt.Sprintf("%s %s (%s mode) near liquidation - %s: %s.", pos.Symbol, pos.Side, pos.Mode, reason, action)
+	if ga.userID != "" {
+		msg = fmt.Sprintf("User %s: %s", ga.userID, msg)
+	}
+	symbol := pos.Symbol
+	go func() {
+		if err := notifier.SendError(title, msg); err != nil {
+			ga.logger.Warn("Failed to send auto-deleverage alert", "symbol", symbol, "error", err)
+		}
+	}()
+}
@@ -0,0 +1,70 @@
+package autopilot
+
+import (
+	"math"
+	"testing"
+
+	"binance-trading-bot/internal/binance"
+	"binance-trading-bot/internal/logging"
+)
+
+func TestLiquidationDistancePercent(t *testing.T) {
+	tests := []struct {
+		side               string
+		price, liquidation float64
+		want               float64
+	}{
+		{"LONG", 100, 90, 10},
+		{"LONG", 100, 99, 1},
+		{"LONG", 100, 101, -1}, // past liquidation
+		{"SHORT", 100, 110, 10},
+		{"SHORT", 100, 98, -2},
+		{"LONG", 100, 0, 0}, // no liquidation price (e.g. fully collateralized)
+	}
+	for _, tt := range tests {
+		if got := liquidationDistancePercent(tt.side, tt.price, tt.liquidation); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("liquidationDistancePercent(%s, %v, %v) = %v, want %v", tt.side, tt.price, tt.liquidation, got, tt.want)
+		}
+	}
+}
+
+type liquidationClient struct {
+	binance.FuturesClient
+	positions []binance.FuturesPosition
+}
+
+func (c *liquidationClient) GetPositions() ([]binance.FuturesPosition, error) {
+	return c.positions, nil
+}
+
+func TestFetchLiquidationQuotes(t *testing.T) {
+	client := &liquidationClient{positions: []binance.FuturesPosition{
+		{Symbol: "BTCUSDT", PositionAmt: 0.5, PositionSide: "BOTH", LiquidationPrice: 50000, MarkPrice: 60000},
+		{Symbol: "ETHUSDT", PositionAmt: -2, PositionSide: "BOTH", LiquidationPrice: 4000, MarkPrice: 3000},
+		{Symbol: "SOLUSDT", PositionAmt: -3, PositionSide: "SHORT", LiquidationPrice: 200, MarkPrice: 150},
+		{Symbol: "XRPUSDT", PositionAmt: 0, PositionSide: "BOTH", LiquidationPrice: 0},
+	}}
+	config := DefaultGinieAutopilotConfig()
+	config.AutoDeleverageEnabled = true
+	config.DryRun = false
+	ga := &GinieAutopilot{config: config, logger: logging.Default(), futuresClient: client}
+
+	quotes := ga.fetchLiquidationQuotes()
+	if len(quotes) != 3 {
+		t.Fatalf("got %d quotes, want 3: %+v", len(quotes), quotes)
+	}
+	if q := quotes[liquidationKey("BTCUSDT", "LONG")]; q.LiquidationPrice != 50000 || q.MarkPrice != 60000 {
+		t.Errorf("BTCUSDT LONG quote = %+v", q)
+	}
+	if _, ok := quotes[liquidationKey("ETHUSDT", "SHORT")]; !ok {
+		t.Error("one-way short not keyed as SHORT")
+	}
+	if _, ok := quotes[liquidationKey("SOLUSDT", "SHORT")]; !ok {
+		t.Error("hedge-mode short not keyed as SHORT")
+	}
+
+	ga.config.AutoDeleverageEnabled = false
+	if quotes := ga.fetchLiquidationQuotes(); quotes != nil {
+		t.Errorf("disabled auto-deleverage fetched quotes: %+v", quotes)
+	}
+}
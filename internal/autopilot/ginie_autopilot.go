@@ -2,6 +2,8 @@ package autopilot
 
 import (
 	"binance-trading-bot/internal/ai/llm"
+	"binance-trading-bot/internal/ai/sentiment"
+	"binance-trading-bot/internal/audit"
 	"binance-trading-bot/internal/binance"
 	"binance-trading-bot/internal/circuit"
 	"binance-trading-bot/internal/database"
@@ -19,6 +21,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -100,6 +103,13 @@ type PersistedPositionState struct {
 	CurrentTPLevel int                 `json:"current_tp_level"`
 	ScalpReentry   *PositionOptimizationStatus `json:"scalp_reentry,omitempty"` // Full scalp_reentry state
 	SavedAt        time.Time           `json:"saved_at"`
+
+	// Origin tags, restored so the dashboard keeps them across restarts
+	Source       string  `json:"source,omitempty"`
+	StrategyID   *int64  `json:"strategy_id,omitempty"`
+	StrategyName *string `json:"strategy_name,omitempty"`
+	Confidence   float64 `json:"confidence,omitempty"`
+	EntryReason  string  `json:"entry_reason,omitempty"`
 }
 
 // PositionStateStore holds all persisted position states
@@ -118,12 +128,21 @@ func (ga *GinieAutopilot) SavePositionState() error {
 	}
 
 	for symbol, pos := range ga.positions {
+		// Not persisted until adopted, so a restart doesn't mistake them for Ginie's own
+		if pos.AwaitingAdoption {
+			continue
+		}
 		state := PersistedPositionState{
 			Symbol:         symbol,
 			Side:           pos.Side,
 			Mode:           pos.Mode,
 			CurrentTPLevel: pos.CurrentTPLevel,
 			SavedAt:        time.Now(),
+			Source:         pos.Source,
+			StrategyID:     pos.StrategyID,
+			StrategyName:   pos.StrategyName,
+			Confidence:     pos.Confidence,
+			EntryReason:    pos.EntryReason,
 		}
 
 		// Save scalp_reentry state if present
@@ -140,11 +159,8 @@ func (ga *GinieAutopilot) SavePositionState() error {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 
-		// Determine userID - use stored userID or default for legacy mode
-		userID := ga.userID
-		if userID == "" {
-			userID = "default" // Legacy/shared mode
-		}
+		// Determine owner key - user ID (or default for legacy mode), per account
+		userID := ga.positionStateOwner()
 
 		savedToRedis := 0
 		for symbol, state := range store.Positions {
@@ -193,6 +209,11 @@ func (ga *GinieAutopilot) convertToRedisState(state *PersistedPositionState) *da
 		Mode:           string(state.Mode), // GinieTradingMode -> string
 		CurrentTPLevel: state.CurrentTPLevel,
 		SavedAt:        state.SavedAt,
+		Source:         state.Source,
+		StrategyID:     state.StrategyID,
+		StrategyName:   state.StrategyName,
+		Confidence:     state.Confidence,
+		EntryReason:    state.EntryReason,
 	}
 
 	// Convert PositionOptimizationStatus to ScalpReentryStateData if present
@@ -246,11 +267,8 @@ func (ga *GinieAutopilot) LoadPositionState() (map[string]PersistedPositionState
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 
-		// Determine userID - use stored userID or default for legacy mode
-		userID := ga.userID
-		if userID == "" {
-			userID = "default" // Legacy/shared mode
-		}
+		// Determine owner key - user ID (or default for legacy mode), per account
+		userID := ga.positionStateOwner()
 
 		redisStates, err := ga.positionStateRepo.LoadAllPositions(ctx, userID)
 		if err != nil {
@@ -302,6 +320,11 @@ func (ga *GinieAutopilot) convertFromRedisState(redisState *database.PersistedPo
 		Mode:           GinieTradingMode(redisState.Mode), // string -> GinieTradingMode
 		CurrentTPLevel: redisState.CurrentTPLevel,
 		SavedAt:        redisState.SavedAt,
+		Source:         redisState.Source,
+		StrategyID:     redisState.StrategyID,
+		StrategyName:   redisState.StrategyName,
+		Confidence:     redisState.Confidence,
+		EntryReason:    redisState.EntryReason,
 	}
 
 	// Convert ScalpReentryStateData to PositionOptimizationStatus if present
@@ -388,6 +411,8 @@ func (ga *GinieAutopilot) RestorePositionState(pos *GiniePosition, savedState Pe
 		// Only restore the position optimization state
 		pos.ScalpReentry = savedState.ScalpReentry
 	}
+
+	restorePositionOrigin(pos, savedState)
 }
 
 // ==================== END POSITION STATE PERSISTENCE ====================
@@ -449,6 +474,10 @@ type GinieAutopilotConfig struct {
 	DryRun            bool    `json:"dry_run"`              // Paper trading mode
 	RiskLevel         string  `json:"risk_level"`           // conservative, moderate, aggressive
 
+	// Cap on total notional (qty x price) across open positions, enforced at entry.
+	// Margin caps allow leverage x that much exposure (0 disables).
+	MaxTotalNotionalUSD float64 `json:"max_total_notional_usd"`
+
 	// Mode-specific settings
 	EnableScalpMode     bool `json:"enable_scalp_mode"`
 	EnableSwingMode     bool `json:"enable_swing_mode"`
@@ -461,6 +490,10 @@ type GinieAutopilotConfig struct {
 	TP3Percent float64 `json:"tp3_percent"` // % at TP3
 	TP4Percent float64 `json:"tp4_percent"` // % trailing at TP4
 
+	// Remaining quantity worth less than this after a TP or close is swept with a
+	// single market order instead of being left to trail (0 disables)
+	DustThresholdUSD float64 `json:"dust_threshold_usd"`
+
 	// Breakeven settings
 	MoveToBreakevenAfterTP1 bool    `json:"move_to_breakeven_after_tp1"`
 	BreakevenBuffer         float64 `json:"breakeven_buffer"` // Add small buffer above entry
@@ -489,6 +522,14 @@ type GinieAutopilotConfig struct {
 	MaxDailyTrades int     `json:"max_daily_trades"`
 	MaxDailyLoss   float64 `json:"max_daily_loss"`
 
+	// Stop opening positions once realized daily PnL reaches this (USD, 0 disables)
+	MaxDailyProfit          float64 `json:"max_daily_profit"`
+	NotifyDailyProfitTarget bool    `json:"notify_daily_profit_target"`
+
+	// Start of the trading day for daily counters and limits ("HH:MM" in an IANA timezone)
+	DailyResetTime     string `json:"daily_reset_time"`
+	DailyResetTimezone string `json:"daily_reset_timezone"`
+
 	// Circuit breaker settings (separate from FuturesController)
 	CircuitBreakerEnabled  bool    `json:"circuit_breaker_enabled"`
 	CBMaxLossPerHour       float64 `json:"cb_max_loss_per_hour"`
@@ -504,6 +545,153 @@ type GinieAutopilotConfig struct {
 	ScalpROIThreshold          float64 `json:"scalp_roi_threshold"`            // Book at 5%+ ROI (after fees)
 	SwingROIThreshold          float64 `json:"swing_roi_threshold"`            // Book at 8%+ ROI (after fees)
 	PositionROIThreshold       float64 `json:"position_roi_threshold"`         // Book at 10%+ ROI (after fees)
+
+	// Position reconciliation: "report" only logs drift, "heal" also fixes tracked state to match the exchange
+	ReconcileHealPolicy string `json:"reconcile_heal_policy"`
+
+	// Alert when a single orphan scan finds more than this many algo orders (0 disables)
+	OrphanOrderAlertThreshold int `json:"orphan_order_alert_threshold"`
+
+	// Ramp-up after a circuit breaker reset: the position cap starts at RampUpStartPositions
+	// and climbs to MaxPositions over RampUpMinutes (0 disables)
+	RampUpMinutes        int `json:"ramp_up_minutes"`
+	RampUpStartPositions int `json:"ramp_up_start_positions"`
+
+	// Adaptive throttle: when a mode's win rate over the last ThrottleSampleSize closed trades
+	// drops below ThrottleWinRate, raise its min confidence and shrink its size; below
+	// ThrottlePauseWinRate (0 disables) pause it for ThrottlePauseMinutes. Relaxes at ThrottleRecoverWinRate.
+	AdaptiveThrottleEnabled bool    `json:"adaptive_throttle_enabled"`
+	ThrottleSampleSize      int     `json:"throttle_sample_size"`
+	ThrottleWinRate         float64 `json:"throttle_win_rate"`
+	ThrottleRecoverWinRate  float64 `json:"throttle_recover_win_rate"`
+	ThrottleConfidenceBoost float64 `json:"throttle_confidence_boost"`
+	ThrottleSizeMultiplier  float64 `json:"throttle_size_multiplier"`
+	ThrottlePauseWinRate    float64 `json:"throttle_pause_win_rate"`
+	ThrottlePauseMinutes    int     `json:"throttle_pause_minutes"`
+
+	// Slot allocation: when a scan finds more qualifying signals than free slots, rank them by
+	// confidence that halves every ConfidenceDecayHalfLifeSeconds of signal age (0 disables decay)
+	ConfidenceDecayHalfLifeSeconds int `json:"confidence_decay_half_life_seconds"`
+
+	// Post-fill protection: a position whose SL still isn't verified on the exchange
+	// ProtectionTimeoutSeconds after entry is market-closed under the "emergency_close"
+	// policy; "heal" keeps re-placing SL/TP indefinitely (0 disables the timeout)
+	ProtectionTimeoutSeconds int    `json:"protection_timeout_seconds"`
+	ProtectionTimeoutPolicy  string `json:"protection_timeout_policy"`
+
+	// Account drawdown kill switch: when equity falls this far below its high-water mark, every
+	// position is flattened and live trading stays disabled until manually reset (0 disables)
+	MaxAccountDrawdownPercent float64 `json:"max_account_drawdown_percent"`
+
+	// SL change notifications (breakeven, trailing, LLM): "off", "summary" (hourly digest) or "all"
+	SLUpdateNotifications string `json:"sl_update_notifications"`
+
+	// Alert once per position when its unrealized loss reaches this USD amount or adverse
+	// price move % (0 disables; mode risk settings override)
+	LossAlertUSD     float64 `json:"loss_alert_usd"`
+	LossAlertPercent float64 `json:"loss_alert_percent"`
+
+	// Execution queue: scalp/swing/position signals from a scan cycle are queued and executed
+	// best-first across modes by priority = decayed confidence x RR x mode weight (0 weight = 1)
+	ExecutionQueueEnabled bool    `json:"execution_queue_enabled"`
+	QueueWeightScalp      float64 `json:"queue_weight_scalp"`
+	QueueWeightSwing      float64 `json:"queue_weight_swing"`
+	QueueWeightPosition   float64 `json:"queue_weight_position"`
+
+	// Loss cool-off: after LossCoolOffStreak consecutive losing trades (0 disables), raise
+	// MinConfidenceToTrade by LossCoolOffConfidenceDelta. The boost decays linearly to 0 over
+	// LossCoolOffDecayMinutes (0 = no decay) and clears on the next winning trade.
+	LossCoolOffStreak          int     `json:"loss_cooloff_streak"`
+	LossCoolOffConfidenceDelta float64 `json:"loss_cooloff_confidence_delta"`
+	LossCoolOffDecayMinutes    int     `json:"loss_cooloff_decay_minutes"`
+
+	// Positions found on the exchange that Ginie didn't open stay monitor-only until adopted
+	// via the synced-positions API with a mode and SL/TP; off = auto-assign and manage at once
+	RequireSyncedPositionAdoption bool `json:"require_synced_position_adoption"`
+
+	// Anti-martingale equity scaling: position size and leverage shrink linearly toward
+	// EquityScalingFloor as realized equity (wallet balance) rises EquityScalingGainPercent
+	// above its start, and as it falls EquityScalingDrawdownPercent below its peak (0 turns
+	// either side off). A 0 start uses the wallet balance when first sampled.
+	EquityScalingEnabled         bool    `json:"equity_scaling_enabled"`
+	EquityScalingStartUSD        float64 `json:"equity_scaling_start_usd"`
+	EquityScalingGainPercent     float64 `json:"equity_scaling_gain_percent"`
+	EquityScalingDrawdownPercent float64 `json:"equity_scaling_drawdown_percent"`
+	EquityScalingFloor           float64 `json:"equity_scaling_floor"`
+
+	// Block a symbol for the rest of the trading day once it has this many losing
+	// trades today, however small (0 disables)
+	MaxDailyLossesPerSymbol int `json:"max_daily_losses_per_symbol"`
+
+	// Drive client-side SL/TP, trailing and early-profit checks off the streamed mark price
+	// (what the exchange's MARK_PRICE stop orders use) instead of last price, so brief
+	// last-price wicks don't trigger closes the exchange stops would not have
+	UseMarkPriceForExits bool `json:"use_mark_price_for_exits"`
+
+	// Pacing: at most this many new positions (including pending limit entries) in any
+	// rolling minute, so a burst of signals can't deploy the allocation at once (0 disables).
+	// Also seeds the circuit breaker's trades-per-minute limit when no saved value exists.
+	MaxPositionsPerMinute int `json:"max_positions_per_minute"`
+
+	// Mirror opens and full closes to the notification channels with the signal summary and
+	// a chart link. TradeNotifyChartURL is "tradingview", "binance" or a custom template using
+	// {symbol}, {base}, {quote}, {interval} and {tv_interval} (empty sends no link).
+	TradeNotifyEnabled  bool   `json:"trade_notify_enabled"`
+	TradeNotifyChartURL string `json:"trade_notify_chart_url"`
+
+	// Safe mode: refuse new entries in every mode while open positions keep being
+	// monitored, protected, trailed and reconciled (unlike stopping Ginie or pausing modes)
+	ManageOnlyMode bool `json:"manage_only_mode"`
+
+	// Margin ratio guard: as the account margin ratio (maintenance margin / margin balance,
+	// liquidation at 100%) rises past MarginRatioReducePercent, new position sizes shrink
+	// exponentially; at MarginRatioBlockPercent new entries are refused (0 turns a step off)
+	MarginRatioGuardEnabled  bool    `json:"margin_ratio_guard_enabled"`
+	MarginRatioReducePercent float64 `json:"margin_ratio_reduce_percent"`
+	MarginRatioBlockPercent  float64 `json:"margin_ratio_block_percent"`
+
+	// Symbol sentiment gate: skip entries against the coin's own news/social sentiment.
+	// A LONG is refused when the symbol score is at or below -SymbolSentimentBlockScore
+	// (a SHORT at or above it), only with at least SymbolSentimentMinNews items and the
+	// newest one under SymbolSentimentMaxAgeMinutes old (0 = any age)
+	SymbolSentimentGateEnabled   bool    `json:"symbol_sentiment_gate_enabled"`
+	SymbolSentimentBlockScore    float64 `json:"symbol_sentiment_block_score"`
+	SymbolSentimentMinNews       int     `json:"symbol_sentiment_min_news"`
+	SymbolSentimentMaxAgeMinutes int     `json:"symbol_sentiment_max_age_minutes"`
+
+	// BTC correlation filter (0 = off): skip alt entries whose BTC correlation is above
+	// MaxBTCCorrelation while a same-side position above it is open, or always with
+	// MaxBTCCorrelationAlways (only diversifying, low-beta alts are entered)
+	MaxBTCCorrelation       float64 `json:"max_btc_correlation"`
+	MaxBTCCorrelationAlways bool    `json:"max_btc_correlation_always"`
+
+	// Per-mode position caps keyed by mode ("ultra_fast", "scalp", "swing", "position").
+	// A mode at its cap skips its scans. Unset or 0 falls back to the mode's
+	// size.max_positions, then MaxPositions.
+	ModeMaxPositions map[string]int `json:"mode_max_positions,omitempty"`
+
+	// Auto-deleverage (live mode): once price is within AutoDeleverageTriggerPercent of a
+	// position's liquidation price, close AutoDeleverageCloseFraction of it (at most once a
+	// minute) to pull the liquidation price away - covers gaps through the stop loss
+	AutoDeleverageEnabled        bool    `json:"auto_deleverage_enabled"`
+	AutoDeleverageTriggerPercent float64 `json:"auto_deleverage_trigger_percent"`
+	AutoDeleverageCloseFraction  float64 `json:"auto_deleverage_close_fraction"`
+
+	// Position drift check (live mode): every PositionDriftCheckSeconds (0 disables) tracked
+	// positions are diffed against the exchange and a position_drift alert is sent when side,
+	// quantity or entry differ beyond the tolerances. ReconcileHealPolicy "heal" also corrects
+	// tracked state to match the exchange.
+	PositionDriftCheckSeconds          int     `json:"position_drift_check_seconds"`
+	PositionDriftQtyTolerancePercent   float64 `json:"position_drift_qty_tolerance_percent"`
+	PositionDriftEntryTolerancePercent float64 `json:"position_drift_entry_tolerance_percent"`
+
+	// Exchange maintenance hold: the futures API is pinged every MaintenancePingSeconds. A
+	// maintenance reply (HTTP 503, SERVICE_SHUTTING_DOWN), or MaintenanceErrorThreshold failures
+	// in a row, pauses new entries and position checks, backs the ping off and alerts; once the
+	// ping answers again positions are reconciled and trading resumes
+	MaintenanceHoldEnabled    bool `json:"maintenance_hold_enabled"`
+	MaintenanceErrorThreshold int  `json:"maintenance_error_threshold"`
+	MaintenancePingSeconds    int  `json:"maintenance_ping_seconds"`
 }
 
 // DefaultGinieAutopilotConfig returns default configuration
@@ -527,6 +715,8 @@ func DefaultGinieAutopilotConfig() *GinieAutopilotConfig {
 		TP3Percent: 25,
 		TP4Percent: 25, // Trailing
 
+		DustThresholdUSD: 5, // Binance minimum notional - anything smaller can't be closed on its own
+
 		MoveToBreakevenAfterTP1: true,
 		BreakevenBuffer:         0.1, // 0.1% above entry
 
@@ -567,6 +757,80 @@ func DefaultGinieAutopilotConfig() *GinieAutopilotConfig {
 		ScalpROIThreshold:          0, // DEPRECATED: Use settings.GinieTPPercentScalp × leverage
 		SwingROIThreshold:          0, // DEPRECATED: Use settings.GinieTPPercentSwing × leverage
 		PositionROIThreshold:       0, // DEPRECATED: Use settings.GinieTPPercentPosition × leverage
+
+		ReconcileHealPolicy:       ReconcileHealPolicyReport,
+
+		PositionDriftCheckSeconds:          60,
+		PositionDriftQtyTolerancePercent:   1,
+		PositionDriftEntryTolerancePercent: 0.5,
+
+		MaintenanceHoldEnabled:    true,
+		MaintenanceErrorThreshold: 3,
+		MaintenancePingSeconds:    30,
+
+		MaxPositionsPerMinute: 10,
+
+		TradeNotifyEnabled:  false,
+		TradeNotifyChartURL: "tradingview",
+
+		ManageOnlyMode: false,
+
+		MarginRatioGuardEnabled:  true,
+		MarginRatioReducePercent: 50,
+		MarginRatioBlockPercent:  80,
+
+		SymbolSentimentGateEnabled:   false,
+		SymbolSentimentBlockScore:    0.4,
+		SymbolSentimentMinNews:       3,
+		SymbolSentimentMaxAgeMinutes: 360,
+
+		MaxBTCCorrelation:       0,
+		MaxBTCCorrelationAlways: false,
+
+		AutoDeleverageEnabled:        false,
+		AutoDeleverageTriggerPercent: 2.0,
+		AutoDeleverageCloseFraction:  0.25,
+
+		OrphanOrderAlertThreshold: 5,
+		RampUpMinutes:             30,
+		RampUpStartPositions:      1,
+		MaxDailyProfit:            0, // Disabled by default
+		NotifyDailyProfitTarget:   true,
+		DailyResetTime:            defaultDailyResetTime,
+		DailyResetTimezone:        defaultDailyResetTimezone,
+		AdaptiveThrottleEnabled:   true,
+		ThrottleSampleSize:        20,
+		ThrottleWinRate:           40,
+		ThrottleRecoverWinRate:    50,
+		ThrottleConfidenceBoost:   10,
+		ThrottleSizeMultiplier:    0.5,
+		ThrottlePauseWinRate:      25,
+		ThrottlePauseMinutes:      60,
+
+		ConfidenceDecayHalfLifeSeconds: 300,
+
+		ProtectionTimeoutSeconds: 30,
+		ProtectionTimeoutPolicy:  ProtectionPolicyEmergencyClose,
+
+		SLUpdateNotifications: SLNotifyOff,
+
+		ExecutionQueueEnabled: false,
+		QueueWeightScalp:      1.0,
+		QueueWeightSwing:      1.0,
+		QueueWeightPosition:   1.0,
+
+		LossCoolOffStreak:          3,
+		LossCoolOffConfidenceDelta: 5,
+		LossCoolOffDecayMinutes:    120,
+
+		RequireSyncedPositionAdoption: true,
+
+		EquityScalingEnabled:         false,
+		EquityScalingGainPercent:     50,
+		EquityScalingDrawdownPercent: 20,
+		EquityScalingFloor:           defaultEquityScalingFloor,
+
+		MaxDailyLossesPerSymbol: 4,
 	}
 }
 
@@ -594,19 +858,26 @@ const (
 	StateEmergencyClose ProtectionState = "EMERGENCY"
 )
 
+// Protection timeout policies (GinieAutopilotConfig.ProtectionTimeoutPolicy)
+const (
+	ProtectionPolicyEmergencyClose = "emergency_close" // Market-close a position left without a verified SL
+	ProtectionPolicyHeal           = "heal"            // Keep re-placing SL/TP, never close
+)
+
 // ProtectionStatus tracks the SL/TP protection state of a position
 type ProtectionStatus struct {
-	State           ProtectionState `json:"state"`
-	SLOrderID       int64           `json:"sl_order_id"`
-	SLVerified      bool            `json:"sl_verified"`
-	SLVerifiedAt    time.Time       `json:"sl_verified_at,omitempty"`
-	TPOrderIDs      []int64         `json:"tp_order_ids,omitempty"`
-	TPVerified      bool            `json:"tp_verified"`
-	TPVerifiedAt    time.Time       `json:"tp_verified_at,omitempty"`
-	FailureCount    int             `json:"failure_count"`
-	LastFailure     string          `json:"last_failure,omitempty"`
-	LastStateChange time.Time       `json:"last_state_change"`
-	HealAttempts    int             `json:"heal_attempts"`
+	State            ProtectionState `json:"state"`
+	SLOrderID        int64           `json:"sl_order_id"`
+	SLVerified       bool            `json:"sl_verified"`
+	SLVerifiedAt     time.Time       `json:"sl_verified_at,omitempty"`
+	TPOrderIDs       []int64         `json:"tp_order_ids,omitempty"`
+	TPVerified       bool            `json:"tp_verified"`
+	TPVerifiedAt     time.Time       `json:"tp_verified_at,omitempty"`
+	FailureCount     int             `json:"failure_count"`
+	LastFailure      string          `json:"last_failure,omitempty"`
+	LastStateChange  time.Time       `json:"last_state_change"`
+	HealAttempts     int             `json:"heal_attempts"`
+	UnprotectedSince time.Time       `json:"unprotected_since,omitempty"` // Zero while the SL is verified
 }
 
 // NewProtectionStatus creates a new protection status in OPENING state
@@ -649,6 +920,7 @@ type GiniePosition struct {
 	OriginalQty  float64          `json:"original_qty"`  // Original position size
 	RemainingQty float64          `json:"remaining_qty"` // Remaining after partial closes
 	Leverage     int              `json:"leverage"`
+	MarginType   string           `json:"margin_type,omitempty"` // CROSSED or ISOLATED (empty = CROSSED)
 	EntryTime    time.Time        `json:"entry_time"`
 
 	// Take Profit Levels
@@ -661,6 +933,10 @@ type GiniePosition struct {
 	MovedToBreakeven bool    `json:"moved_to_breakeven"`
 	IsClosing        bool    `json:"is_closing"` // Prevents duplicate close calls
 
+	// Trigger price of the SL order last placed on the exchange (0 = none yet).
+	// SL updates may never move the stop against the position from this level.
+	PlacedSL float64 `json:"placed_sl,omitempty"`
+
 	// Trailing
 	TrailingActive        bool    `json:"trailing_active"`
 	HighestPrice          float64 `json:"highest_price"`
@@ -668,6 +944,17 @@ type GiniePosition struct {
 	TrailingPercent       float64 `json:"trailing_percent"`        // Dynamic trailing %
 	TrailingActivationPct float64 `json:"trailing_activation_pct"` // % profit needed to activate trailing
 
+	// Runner left open past the final TP (nil = ladder closes the whole position)
+	Runner *RunnerState `json:"runner,omitempty"`
+
+	// Excursion tracking (% price move from entry, unleveraged, always >= 0)
+	MaxAdverseExcursion   float64 `json:"max_adverse_excursion"`   // MAE: deepest drawdown before exit
+	MaxFavorableExcursion float64 `json:"max_favorable_excursion"` // MFE: best unrealized profit before exit
+
+	// Auto-deleverage: partial closes taken near the liquidation price
+	DeleverageCount  int       `json:"deleverage_count,omitempty"`
+	LastDeleverageAt time.Time `json:"last_deleverage_at,omitempty"`
+
 	// Algo Order IDs (for Binance SL/TP orders)
 	StopLossAlgoID    int64     `json:"stop_loss_algo_id,omitempty"`    // Binance algo order ID for SL
 	TakeProfitAlgoIDs []int64   `json:"take_profit_algo_ids,omitempty"` // Binance algo order IDs for TPs
@@ -694,10 +981,11 @@ type GiniePosition struct {
 	ExitWasMaker  bool    `json:"exit_was_maker,omitempty"`  // true if exit was maker order
 	TotalFeesUSD  float64 `json:"total_fees_usd,omitempty"`  // EntryFeeUSD + ExitFeeUSD
 
-	// Trade Source Tracking
-	Source       string  `json:"source"`                  // "ai" or "strategy"
-	StrategyID   *int64  `json:"strategy_id,omitempty"`   // Strategy ID if source is "strategy"
-	StrategyName *string `json:"strategy_name,omitempty"` // Strategy name for display
+	// Trade Source Tracking (shown and filtered on in the dashboard)
+	Source       string  `json:"source"`                // One of the PositionSource* values
+	StrategyID   *int64  `json:"strategy_id,omitempty"` // Strategy ID if source is "strategy"
+	StrategyName *string `json:"strategy_name"`         // Strategy name for display, null unless source is "strategy"
+	EntryReason  string  `json:"entry_reason"`          // Short human-readable reason the position was opened
 
 	// Ultra-Fast Scalping Mode
 	UltraFastSignal        *UltraFastSignal `json:"ultra_fast_signal,omitempty"`         // Signal that triggered entry
@@ -705,7 +993,7 @@ type GiniePosition struct {
 	MaxHoldTime            time.Duration    `json:"max_hold_time,omitempty"`             // 3s for ultra-fast
 
 	// Adaptive Learning Fields (for tracking and learning)
-	Confidence    float64   `json:"confidence,omitempty"`     // Entry confidence score
+	Confidence    float64   `json:"confidence"`               // Entry confidence score (0-100), 0 when not scored
 	TrendStrength float64   `json:"trend_strength,omitempty"` // Trend strength at entry
 	TrendAligned  bool      `json:"trend_aligned,omitempty"`  // Was 5m/1h trend aligned?
 	OpenedAt      time.Time `json:"opened_at,omitempty"`      // Position open time for hold tracking
@@ -741,6 +1029,12 @@ type GiniePosition struct {
 
 	// Dust Position Tracking
 	IsDustPosition bool `json:"is_dust_position,omitempty"` // Position qty too small to protect with SL/TP orders
+
+	// Synced from the exchange and not yet adopted: monitored only (price, PnL), no SL/TP orders or exits
+	AwaitingAdoption bool `json:"awaiting_adoption,omitempty"`
+
+	// Unrealized loss alert already sent; re-armed when the position is back at breakeven
+	LossAlertSent bool `json:"loss_alert_sent,omitempty"`
 }
 
 // GinieTradeResult tracks the result of a trade action with full signal info for study
@@ -756,6 +1050,10 @@ type GinieTradeResult struct {
 	TPLevel    int       `json:"tp_level,omitempty"`
 	Timestamp  time.Time `json:"timestamp"`
 
+	// Excursions over the position's life (full_close only, % from entry)
+	MaxAdverseExcursion   float64 `json:"max_adverse_excursion,omitempty"`
+	MaxFavorableExcursion float64 `json:"max_favorable_excursion,omitempty"`
+
 	// Full decision info for study purposes
 	Mode             GinieTradingMode     `json:"mode,omitempty"`
 	Confidence       float64              `json:"confidence,omitempty"`
@@ -767,6 +1065,10 @@ type GinieTradeResult struct {
 	Source       string  `json:"source"`                  // "ai" or "strategy"
 	StrategyID   *int64  `json:"strategy_id,omitempty"`   // Strategy ID if source is "strategy"
 	StrategyName *string `json:"strategy_name,omitempty"` // Strategy name for display
+
+	// Trade journal (auto-tagged with mode, source and strategy when recorded)
+	Notes string   `json:"notes,omitempty"`
+	Tags  []string `json:"tags,omitempty"`
 }
 
 // GinieMarketSnapshot captures market state at trade time
@@ -833,6 +1135,19 @@ type GinieSignalLog struct {
 	ATRPercent   float64 `json:"atr_percent"`
 	Trend        string  `json:"trend"`
 	Volatility   string  `json:"volatility"`
+	SpreadBps    float64 `json:"spread_bps,omitempty"` // Bid/ask spread when the entry checks ran
+
+	// Liquidity when the entry checks ran (only fetched when the mode has a volume gate)
+	QuoteVolume24h float64 `json:"quote_volume_24h,omitempty"`
+	VolumeRatio    float64 `json:"volume_ratio,omitempty"` // Last candle volume / 20-candle average
+
+	// Coin-specific sentiment when the entry checks ran (only fetched with the symbol sentiment gate on)
+	SymbolSentiment *float64 `json:"symbol_sentiment,omitempty"`
+	BTCCorrelation  float64  `json:"btc_correlation,omitempty"` // Only recorded with MaxBTCCorrelation set
+
+	// Position among the cycle's qualifying signals when they were ranked (0 = not ranked)
+	CycleRank       int `json:"cycle_rank,omitempty"`
+	CycleCandidates int `json:"cycle_candidates,omitempty"`
 
 	// Signals that contributed
 	SignalNames     []string `json:"signal_names"`
@@ -887,6 +1202,11 @@ type TrendDivergenceInfo struct {
 	DecisionTimeframe string `json:"decision_timeframe"`
 	DecisionTrend     string `json:"decision_trend"`
 	Severity          string `json:"severity"`
+
+	// Multi-timeframe confluence details, set when confluence blocked the trade
+	TimeframeTrends    map[string]string `json:"timeframe_trends,omitempty"`
+	TimeframesAgreeing int               `json:"timeframes_agreeing,omitempty"`
+	TimeframesRequired int               `json:"timeframes_required,omitempty"`
 }
 
 // SignalQualityInfo shows signal quality blocking details
@@ -931,12 +1251,14 @@ type CoinBlockInfo struct {
 	Symbol       string    `json:"symbol"`
 	BlockReason  string    `json:"block_reason"`
 	BlockTime    time.Time `json:"block_time"`
-	LossAmount   float64   `json:"loss_amount"`   // Actual loss that triggered block
-	LossROI      float64   `json:"loss_roi"`      // ROI % at time of block
-	ConsecLosses int       `json:"consec_losses"` // Consecutive losses for this coin
-	AutoUnblock  time.Time `json:"auto_unblock"`  // When coin will auto-unblock (zero if manual required)
-	BlockCount   int       `json:"block_count"`   // How many times this coin was blocked
-	ManualOnly   bool      `json:"manual_only"`   // If true, requires manual unblock
+	LossAmount   float64   `json:"loss_amount"`            // Actual loss that triggered block
+	LossROI      float64   `json:"loss_roi"`               // ROI % at time of block
+	ConsecLosses int       `json:"consec_losses"`          // Consecutive losses for this coin
+	AutoUnblock  time.Time `json:"auto_unblock"`           // When coin will auto-unblock (zero if manual required)
+	BlockCount   int       `json:"block_count"`            // How many times this coin was blocked
+	ManualOnly   bool      `json:"manual_only"`            // If true, requires manual unblock
+	DailyLosses  int       `json:"daily_losses,omitempty"` // Losing trades today when blocked by the daily limit
+	DailyLimit   bool      `json:"daily_limit,omitempty"`  // Blocked by MaxDailyLossesPerSymbol until the daily reset
 }
 
 // ==================== Diagnostic Types ====================
@@ -949,14 +1271,23 @@ type GinieDiagnostics struct {
 	CanTrade         bool      `json:"can_trade"`
 	CanTradeReason   string    `json:"can_trade_reason"`
 
-	CircuitBreaker CBDiagnostics       `json:"circuit_breaker"`
-	Positions      PositionDiagnostics `json:"positions"`
-	Scanning       ScanDiagnostics     `json:"scanning"`
-	Signals        SignalDiagnostics   `json:"signals"`
-	ProfitBooking  ProfitDiagnostics   `json:"profit_booking"`
-	BlockedCoins   []*CoinBlockInfo    `json:"blocked_coins"`
-	LLMStatus      LLMDiagnostics      `json:"llm_status"`
-	Issues         []DiagnosticIssue   `json:"issues"`
+	CircuitBreaker CBDiagnostics                 `json:"circuit_breaker"`
+	Positions      PositionDiagnostics           `json:"positions"`
+	Scanning       ScanDiagnostics               `json:"scanning"`
+	Signals        SignalDiagnostics             `json:"signals"`
+	ProfitBooking  ProfitDiagnostics             `json:"profit_booking"`
+	BlockedCoins   []*CoinBlockInfo              `json:"blocked_coins"`
+	LLMStatus      LLMDiagnostics                `json:"llm_status"`
+	OrphanOrders   OrphanOrderDiagnostics        `json:"orphan_orders"`
+	PositionDrift  PositionDriftDiagnostics      `json:"position_drift"`
+	Exchange       ExchangeStatus                `json:"exchange_status"`
+	MarginRatio    MarginRatioDiagnostics        `json:"margin_ratio"`
+	DailyProfit    DailyProfitTargetDiagnostics  `json:"daily_profit_target"`
+	ModeThrottle   map[string]ModeThrottleStatus `json:"mode_throttle"`
+	ExecutionQueue ExecutionQueueDiagnostics     `json:"execution_queue"`
+	LossCoolOff    LossCoolOffDiagnostics        `json:"loss_cooloff"`
+	SymbolLosses   SymbolDailyLossDiagnostics    `json:"symbol_daily_losses"`
+	Issues         []DiagnosticIssue             `json:"issues"`
 }
 
 // CBDiagnostics shows circuit breaker state
@@ -977,6 +1308,11 @@ type PositionDiagnostics struct {
 	MaxAllowed         int     `json:"max_allowed"`
 	SlotsAvailable     int     `json:"slots_available"`
 	TotalUnrealizedPnL float64 `json:"total_unrealized_pnl"`
+	TotalNotionalUSD   float64 `json:"total_notional_usd"`
+	MaxNotionalUSD     float64 `json:"max_notional_usd"` // 0 = no cap
+
+	// Per-mode caps, keyed by mode
+	Modes map[string]ModePositionSlots `json:"modes"`
 }
 
 // ScanDiagnostics shows scanning activity
@@ -1033,6 +1369,19 @@ type LLMDiagnostics struct {
 	CoinListCached  bool      `json:"coin_list_cached"`
 	CoinListAge     string    `json:"coin_list_age"`
 	DisabledSymbols []string  `json:"disabled_symbols"`
+
+	// Provider health (circuit breaker)
+	Degraded            bool      `json:"degraded"`
+	ConsecutiveFailures int       `json:"consecutive_failures"`
+	LastError           string    `json:"last_error,omitempty"`
+	LastErrorTime       time.Time `json:"last_error_time,omitempty"`
+	DegradedUntil       time.Time `json:"degraded_until,omitempty"`
+
+	// Recent call latency for the provider
+	Latency llm.LatencyStats `json:"latency"`
+
+	// In-flight and queued provider requests
+	Concurrency llm.ConcurrencyStats `json:"concurrency"`
 }
 
 // DiagnosticIssue represents a problem with suggested fix
@@ -1055,6 +1404,8 @@ type GinieAutopilot struct {
 	positionStateRepo *database.RedisPositionStateRepository // Redis-based position state storage
 	eventLogger       *TradeEventLogger                      // Trade lifecycle event logging
 	userID            string                                 // User ID for multi-tenant PnL isolation
+	accountName       string                                 // Named trading account ("" = the user's primary account)
+	accountRoute      AccountRoute                           // Which signal directions this account trades
 	clientOrderIdGen    *orders.ClientOrderIdGenerator         // Epic 7: Client order ID generator
 	positionStateInt    *PositionStateIntegration              // Story 7.11: Position state tracking
 	modificationTracker *orders.ModificationTracker            // Story 7.12: Order modification event log
@@ -1071,14 +1422,42 @@ type GinieAutopilot struct {
 	configReloadChan chan struct{} // Trigger immediate config reload
 	wg               sync.WaitGroup
 	mu               sync.RWMutex
+	symbolLocks      *symbolLocks // Serializes entries, monitoring, closes and edits per symbol
+
+	// Account commission rates for fee-aware breakeven stops
+	feeRates commissionRateCache
+
+	// Account drawdown kill switch (high-water mark persisted per user)
+	drawdown drawdownGuard
+
+	// Anti-martingale size/leverage scaler (own lock)
+	equityScaling equityScalingState
+
+	// Account margin ratio sampled for new-entry sizing
+	marginGuard marginGuardState
+
+	// Account position mode (ONE_WAY/HEDGE), cached with a short TTL (own lock)
+	positionMode positionModeCache
+
+	// Symbols with a maker-first entry resting on the book (ga.mu released meanwhile)
+	makerEntriesInFlight map[string]bool
+
+	// Exchange-native trailing stop orders by symbol (own lock, see nativeTrailingState)
+	nativeTrailingMu sync.Mutex
+	nativeTrailing   map[string]*nativeTrailingState
+
+	// Last leaked-order cleanup per symbol after an algo order limit refusal
+	algoLimitMu        sync.Mutex
+	algoLimitCleanupAt map[string]time.Time
 
 	// Position tracking
 	positions map[string]*GiniePosition
 
 	// Per-coin blocking for big losses
-	blockedCoins     map[string]*CoinBlockInfo // Coins blocked due to big losses
-	coinConsecLosses map[string]int            // Track consecutive losses per coin
-	coinBlockHistory map[string]int            // Historical count of times each coin was blocked
+	blockedCoins      map[string]*CoinBlockInfo // Coins blocked due to big losses
+	coinConsecLosses  map[string]int            // Track consecutive losses per coin
+	coinBlockHistory  map[string]int            // Historical count of times each coin was blocked
+	symbolDailyLosses map[string]int            // Losing trades per coin in the current trading day
 
 	// LLM SL validation tracking (kill switch after 3 bad calls)
 	badLLMCallCount map[string]int  // symbol -> consecutive bad LLM SL calls
@@ -1090,6 +1469,16 @@ type GinieAutopilot struct {
 
 	// SL update history per position
 	slUpdateHistory map[string]*SLUpdateHistory // symbol -> SL update history
+	slUpdateDigest  map[string]*slUpdateDigestEntry // Pending summary-level SL notifications
+
+	// Cross-mode execution queue, drained by the main loop after each scan cycle
+	executionQueue      []*queuedSignal
+	executionQueueStats ExecutionQueueDiagnostics
+
+	// Loss cool-off: consecutive losing trades and the boost armed by the last streak
+	lossStreak       int
+	lossCoolOffPeak  float64
+	lossCoolOffSince time.Time
 
 	// Trade history
 	tradeHistory []GinieTradeResult
@@ -1118,6 +1507,7 @@ type GinieAutopilot struct {
 	modeAllocationStates map[string]*ModeAllocationState // Current allocation state per mode
 	modeUsedUSD          map[string]float64              // Total USD used per mode
 	modePositionCounts   map[string]int                  // Current position count per mode
+	modeCapSkips         map[GinieTradingMode]time.Time  // Last scan skipped per mode for being at its position cap (guarded by mu)
 
 	// Per-mode safety controls tracking
 	modeSafetyStates  map[string]*ModeSafetyState  // Runtime safety state per mode
@@ -1182,6 +1572,45 @@ type GinieAutopilot struct {
 
 	// Redis-based order tracker for timeout management
 	orderTracker *database.RedisOrderTracker
+
+	// Orphan algo-order detection stats and alerting
+	orphanStats     OrphanOrderDiagnostics
+	lastOrphanAlert time.Time
+	alertNotifier   AlertNotifier
+
+	// Rich trade open/close alerts, when alertNotifier supports them (see notifyTrade)
+	tradeAlerts atomic.Pointer[tradeAlertSink]
+
+	// Per-symbol news sentiment for the entry gate (may be unset, see SetSentimentAnalyzer)
+	sentimentAnalyzer atomic.Pointer[sentiment.Analyzer]
+
+	// Entry times in the last minute for MaxPositionsPerMinute, and entries refused by it (guarded by mu)
+	recentOpens      []time.Time
+	rateLimitedOpens int
+
+	// Position drift checks against the exchange (guarded by mu)
+	driftStats         PositionDriftDiagnostics
+	lastDriftSignature string
+
+	// Set once the daily profit target alert has fired, cleared at daily reset
+	dailyProfitTargetNotified bool
+
+	// Exchange availability and maintenance hold (guarded by mu)
+	exchangeStatus         ExchangeStatus
+	maintenancePingBackoff time.Duration
+
+	// Last logged post-circuit-breaker ramp-up cap (0 = not ramping)
+	lastRampUpCap atomic.Int32
+
+	// Dead-man's switch (countdown cancel-all) refresh state, position monitor goroutine only
+	lastDeadManRefresh time.Time
+	deadManArmed       map[string]bool
+
+	// Watchlist source tags from the last coin source refresh, plus manual pin/exclude
+	// overrides that are re-applied on every refresh (guarded by mu)
+	watchlistSources     map[string][]string
+	watchlistOverrides   map[string]string
+	watchlistRefreshedAt time.Time
 }
 
 // generateClientOrderId generates a new client order ID for an entry order.
@@ -1287,6 +1716,9 @@ func NewGinieAutopilot(
 	clientOrderIdGen *orders.ClientOrderIdGenerator,
 ) *GinieAutopilot {
 	config := DefaultGinieAutopilotConfig()
+	if IsDryRunForced() {
+		config.DryRun = true
+	}
 
 	// Create Ginie's own circuit breaker (Story 5.3: Load from database if available)
 	// Default values (fallback if database is unavailable)
@@ -1296,7 +1728,7 @@ func NewGinieAutopilot(
 		MaxDailyLoss:         config.CBMaxDailyLoss,
 		MaxConsecutiveLosses: config.CBMaxConsecutiveLosses,
 		CooldownMinutes:      config.CBCooldownMinutes,
-		MaxTradesPerMinute:   10,  // Default fallback
+		MaxTradesPerMinute:   config.MaxPositionsPerMinute, // Default fallback
 		MaxDailyTrades:       100, // Default fallback
 	}
 
@@ -1326,7 +1758,7 @@ func NewGinieAutopilot(
 	ga := &GinieAutopilot{
 		config:               config,
 		analyzer:             analyzer,
-		futuresClient:        futuresClient,
+		futuresClient:        audit.WrapFuturesClient(futuresClient, ginieAuditActor(userID)),
 		logger:               logger,
 		repo:                 repo,
 		settingsCache:        settingsCache, // Story 6.6: Cache-only settings reads
@@ -1339,9 +1771,11 @@ func NewGinieAutopilot(
 		stopChan:             make(chan struct{}),
 		configReloadChan:     make(chan struct{}, 1), // Buffered channel for non-blocking sends
 		positions:            make(map[string]*GiniePosition),
+		symbolLocks:          newSymbolLocks(),
 		blockedCoins:         make(map[string]*CoinBlockInfo),
 		coinConsecLosses:     make(map[string]int),
 		coinBlockHistory:     make(map[string]int),
+		symbolDailyLosses:    make(map[string]int),
 		badLLMCallCount:      make(map[string]int),
 		llmSLDisabled:        make(map[string]bool),
 		signalLogs:           make([]GinieSignalLog, 0, 500),
@@ -1351,7 +1785,7 @@ func NewGinieAutopilot(
 		maxHistory:           1000, // Increased for study purposes
 		llmSwitches:          make([]LLMSwitchEvent, 0, 500),
 		maxLLMSwitches:       500, // Keep last 500 LLM switch events
-		dayStart:             time.Now().Truncate(24 * time.Hour),
+		dayStart:             config.TradingDayStart(time.Now()),
 		volatilityRegimes:    make(map[string]*VolatilityRegime),
 		lastRegimeUpdate:     make(map[string]time.Time),
 		modeAllocationStates: make(map[string]*ModeAllocationState),
@@ -1359,7 +1793,7 @@ func NewGinieAutopilot(
 		modePositionCounts:   make(map[string]int),
 		modeSafetyStates:     make(map[string]*ModeSafetyState),
 		modeSafetyConfigs:    make(map[string]*ModeSafetyConfig),
-		lastDayReset:         time.Now().Truncate(24 * time.Hour),
+		lastDayReset:         config.TradingDayStart(time.Now()),
 		modeCircuitBreakers:  make(map[GinieTradingMode]*ModeCircuitBreaker),
 		pendingLimitOrders:   make(map[string]*PendingLimitOrder),
 	}
@@ -1560,7 +1994,8 @@ func (ga *GinieAutopilot) isModeEnabled(mode GinieTradingMode) bool {
 	// Read from cache for real-time mode status (same as main scan loop)
 	// This ensures toggling a mode in UI takes effect immediately
 	if isSettingsCacheValid(ga.settingsCache) && ga.userID != "" {
-		ctx := context.Background()
+		ctx, cancel := context.WithTimeout(context.Background(), dbReadTimeout)
+		defer cancel()
 		modeConfig, err := ga.settingsCache.GetModeConfig(ctx, ga.userID, modeKey)
 		if err != nil {
 			// Config not found or error - mode is disabled
@@ -1614,8 +2049,11 @@ func (ga *GinieAutopilot) LoadPnLStats() {
 	}
 
 	// Load from database for multi-user isolation
-	ctx := context.Background()
-	db := ga.repo.GetDB()
+	ctx, cancel := context.WithTimeout(context.Background(), dbReadTimeout)
+	defer cancel()
+	// Startup-only aggregate load: read from a replica when configured, replica
+	// lag is harmless here and it keeps the scan off the trading primary
+	db := ga.repo.ReadOnly().GetDB()
 
 	// Get comprehensive trading metrics for this user
 	metrics, err := db.GetFuturesTradingMetricsForUser(ctx, ga.userID)
@@ -1624,14 +2062,14 @@ func (ga *GinieAutopilot) LoadPnLStats() {
 		return
 	}
 
-	// Get daily PnL and trade count for user (from database)
-	dailyPnL, err := db.GetDailyFuturesPnLForUser(ctx, ga.userID)
+	// Get daily PnL and trade count for user (from database) since the trading day started
+	dailyPnL, err := db.GetDailyFuturesPnLForUser(ctx, ga.userID, ga.dayStart)
 	if err != nil {
 		ga.logger.Warn("Failed to get daily PnL from database", "error", err)
 		dailyPnL = 0
 	}
 
-	dailyTrades, err := db.GetDailyFuturesTradeCountForUser(ctx, ga.userID)
+	dailyTrades, err := db.GetDailyFuturesTradeCountForUser(ctx, ga.userID, ga.dayStart)
 	if err != nil {
 		ga.logger.Warn("Failed to get daily trade count from database", "error", err)
 		dailyTrades = 0
@@ -1682,12 +2120,40 @@ func (ga *GinieAutopilot) SavePnLStats() {
 func (ga *GinieAutopilot) SetConfig(config *GinieAutopilotConfig) {
 	ga.mu.Lock()
 	defer ga.mu.Unlock()
+	if !config.DryRun && IsDryRunForced() {
+		log.Println("[GINIE] Live mode requested but FORCE_DRY_RUN kill switch is active, staying in paper mode")
+		config.DryRun = true
+	}
 	ga.config = config
 }
 
+// ginieAuditActor attributes audited exchange actions to the owning user
+func ginieAuditActor(userID string) string {
+	if userID == "" {
+		return "ginie"
+	}
+	return "ginie:" + userID
+}
+
+// enforceForcedDryRun pins Ginie to paper mode while the operator kill switch
+// is active. Checked every cycle so a sentinel file created at runtime takes
+// effect without a restart.
+func (ga *GinieAutopilot) enforceForcedDryRun() {
+	if !IsDryRunForced() {
+		return
+	}
+	ga.mu.Lock()
+	defer ga.mu.Unlock()
+	if !ga.config.DryRun {
+		ga.config.DryRun = true
+		ga.logger.Warn("FORCE_DRY_RUN kill switch engaged, Ginie switched to paper mode", "user_id", ga.userID)
+	}
+}
+
 // SetLLMAnalyzer sets the LLM analyzer for adaptive SL/TP
 // SetFuturesClient updates the futures client (used when switching between paper/live modes)
 func (ga *GinieAutopilot) SetFuturesClient(client binance.FuturesClient) {
+	client = audit.WrapFuturesClient(client, ginieAuditActor(ga.userID))
 	ga.mu.Lock()
 	ga.futuresClient = client
 	ga.logger.Info("Ginie futures client updated")
@@ -1795,6 +2261,13 @@ func (ga *GinieAutopilot) HasLLMAnalyzer() bool {
 	return ga.llmAnalyzer != nil && ga.llmAnalyzer.IsEnabled()
 }
 
+// GetLLMAnalyzer returns the LLM analyzer, nil if none is configured
+func (ga *GinieAutopilot) GetLLMAnalyzer() *llm.Analyzer {
+	ga.mu.RLock()
+	defer ga.mu.RUnlock()
+	return ga.llmAnalyzer
+}
+
 // SetUserID updates the user ID for multi-tenant PnL isolation and database-first configuration
 func (ga *GinieAutopilot) SetUserID(userID string) {
 	ga.mu.Lock()
@@ -1831,14 +2304,15 @@ func (ga *GinieAutopilot) GetPositionStateIntegration() *PositionStateIntegratio
 
 // getEffectivePositionSide determines the correct position side based on Binance account's position mode
 // Returns PositionSideBoth for ONE_WAY mode, or the provided positionSide for HEDGE mode
+// The mode is cached for positionModeCacheTTL; a -4061 rejection invalidates it.
 func (ga *GinieAutopilot) getEffectivePositionSide(positionSide binance.PositionSide) binance.PositionSide {
-	posMode, err := ga.futuresClient.GetPositionMode()
+	hedge, err := ga.isHedgeMode()
 	if err != nil {
 		log.Printf("[GINIE] Warning: Failed to get position mode, assuming ONE_WAY: %v", err)
 		return binance.PositionSideBoth
 	}
 
-	if !posMode.DualSidePosition {
+	if !hedge {
 		// ONE_WAY mode - must use BOTH
 		log.Printf("[GINIE] One-Way mode detected, using PositionSideBoth")
 		return binance.PositionSideBoth
@@ -1873,7 +2347,8 @@ func (ga *GinieAutopilot) SetRiskLevel(level string) error {
 
 	// Adjust parameters based on risk level
 	// Try to load from user_capital_allocation table first, fall back to defaults if not available
-	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(context.Background(), dbWriteTimeout)
+	defer cancel()
 	var minConfidence, maxUSD float64
 	var defaultLeverage int
 
@@ -2106,6 +2581,7 @@ func (ga *GinieAutopilot) ClearPositions() {
 	ga.tradeHistory = make([]GinieTradeResult, 0)
 	ga.dailyTrades = 0
 	ga.dailyPnL = 0
+	ga.dailyProfitTargetNotified = false
 	ga.totalPnL = 0
 	ga.totalTrades = 0
 	ga.winningTrades = 0
@@ -2221,6 +2697,9 @@ func (ga *GinieAutopilot) Start() error {
 
 	ga.running = true
 	ga.config.Enabled = true // Set enabled flag to reflect running state
+	if IsDryRunForced() {
+		ga.config.DryRun = true
+	}
 	ga.stopChan = make(chan struct{})
 
 	// Story 6.6: Cache warm-up on Ginie startup
@@ -2310,6 +2789,14 @@ func (ga *GinieAutopilot) Start() error {
 	ga.wg.Add(1)
 	go ga.runProtectionGuardian()
 
+	// Start account drawdown guard (flattens and disables live trading on a breach)
+	ga.wg.Add(1)
+	go ga.runDrawdownGuard()
+
+	// Start SL update digest (only sends when sl_update_notifications is "summary")
+	ga.wg.Add(1)
+	go ga.runSLUpdateDigest()
+
 	// Start pending LIMIT order monitor (reversal entries with 120s timeout)
 	ga.wg.Add(1)
 	go ga.monitorPendingLimitOrders()
@@ -2566,6 +3053,8 @@ func (ga *GinieAutopilot) runMainLoop() {
 		case <-baseTicker.C:
 			now := time.Now()
 
+			ga.enforceForcedDryRun()
+
 			// Check if we can trade
 			canTrade := ga.canTrade()
 			log.Printf("[GINIE-SCAN] canTrade=%v, positions=%d/%d", canTrade, len(ga.positions), ga.config.MaxPositions)
@@ -2668,6 +3157,9 @@ func (ga *GinieAutopilot) runMainLoop() {
 				scansPerformed++
 			}
 
+			// Execute queued scalp/swing/position signals best-first across modes
+			ga.drainExecutionQueue()
+
 			// Ultra-fast mode: 5-second scan for rapid scalping opportunities
 			// Uses milliseconds for interval, converts to duration
 			// Read settings for interval configuration
@@ -2748,36 +3240,40 @@ func (ga *GinieAutopilot) runMainLoop() {
 func (ga *GinieAutopilot) LoadUserCoinSources(ctx context.Context) error {
 	if ga.userID == "" || ga.repo == nil {
 		ga.logger.Warn("No user ID or repo, falling back to market movers")
-		return ga.analyzer.LoadDynamicSymbols(50)
+		return ga.loadDynamicWatchlist(50)
 	}
 
 	// Get user's scan source settings
 	settings, err := ga.repo.GetUserScanSourceSettings(ctx, ga.userID)
 	if err != nil {
 		ga.logger.Warn("Failed to get user scan source settings, falling back to market movers", "error", err)
-		return ga.analyzer.LoadDynamicSymbols(50)
+		return ga.loadDynamicWatchlist(50)
 	}
 
-	// Build coin list from enabled sources
-	coinSet := make(map[string]bool)
+	// Build coin list from enabled sources, tagging each coin with what selected it
+	coinSet := make(map[string][]string)
 
 	// 1. Saved Coins (highest priority - user's explicit selections)
 	if settings.UseSavedCoins && len(settings.SavedCoins) > 0 {
 		for _, coin := range settings.SavedCoins {
-			coinSet[coin] = true
+			coinSet[coin] = append(coinSet[coin], WatchlistSourceSaved)
 		}
 		ga.logger.Info("Loaded saved coins from user config", "count", len(settings.SavedCoins))
 	}
 
 	// 2. LLM Selection (if enabled)
 	if settings.UseLLMList {
-		// Try to load LLM coins (uses cache if valid)
-		if err := ga.analyzer.LoadLLMSelectedCoins(); err != nil {
+		// Try to load LLM coins (uses cache if valid). Not bound by ctx: the
+		// selection request can outlast the settings lookup budget.
+		llmCtx, cancel := ga.llmContext()
+		err := ga.analyzer.LoadLLMSelectedCoinsContext(llmCtx)
+		cancel()
+		if err != nil {
 			ga.logger.Warn("Failed to load LLM coins", "error", err)
 		} else {
 			llmCoins := ga.analyzer.GetLLMSelectedCoins()
 			for _, coin := range llmCoins {
-				coinSet[coin] = true
+				coinSet[coin] = append(coinSet[coin], WatchlistSourceLLM)
 			}
 			ga.logger.Info("Added LLM-selected coins", "count", len(llmCoins))
 		}
@@ -2809,7 +3305,7 @@ func (ga *GinieAutopilot) LoadUserCoinSources(ctx context.Context) error {
 					if i >= settings.GainersLimit {
 						break
 					}
-					coinSet[coin] = true
+					coinSet[coin] = append(coinSet[coin], WatchlistSourceGainers)
 				}
 			}
 			if settings.MoverLosers {
@@ -2817,7 +3313,7 @@ func (ga *GinieAutopilot) LoadUserCoinSources(ctx context.Context) error {
 					if i >= settings.LosersLimit {
 						break
 					}
-					coinSet[coin] = true
+					coinSet[coin] = append(coinSet[coin], WatchlistSourceLosers)
 				}
 			}
 			if settings.MoverVolume {
@@ -2825,7 +3321,7 @@ func (ga *GinieAutopilot) LoadUserCoinSources(ctx context.Context) error {
 					if i >= settings.VolumeLimit {
 						break
 					}
-					coinSet[coin] = true
+					coinSet[coin] = append(coinSet[coin], WatchlistSourceVolume)
 				}
 			}
 			if settings.MoverVolatility {
@@ -2833,7 +3329,7 @@ func (ga *GinieAutopilot) LoadUserCoinSources(ctx context.Context) error {
 					if i >= settings.VolatilityLimit {
 						break
 					}
-					coinSet[coin] = true
+					coinSet[coin] = append(coinSet[coin], WatchlistSourceVolatility)
 				}
 			}
 			ga.logger.Info("Added market mover coins from user config")
@@ -2852,15 +3348,19 @@ func (ga *GinieAutopilot) LoadUserCoinSources(ctx context.Context) error {
 	if len(coins) > settings.MaxCoins && settings.MaxCoins > 0 {
 		coins = coins[:settings.MaxCoins]
 	}
+	sources := make(map[string][]string, len(coins))
+	for _, coin := range coins {
+		sources[coin] = coinSet[coin]
+	}
 
 	// If no coins found from user settings, fall back to core coins
 	if len(coins) == 0 {
 		ga.logger.Warn("No coins from user config, using default market movers")
-		return ga.analyzer.LoadDynamicSymbols(50)
+		return ga.loadDynamicWatchlist(50)
 	}
 
-	// Update the analyzer's watch list
-	ga.analyzer.SetWatchSymbols(coins)
+	// Update the analyzer's watch list (manual pin/exclude overrides re-applied)
+	ga.setWatchlist(sources)
 	ga.logger.Info("Loaded coins from user scan source config",
 		"total", len(coins),
 		"saved_enabled", settings.UseSavedCoins,
@@ -2876,6 +3376,19 @@ func (ga *GinieAutopilot) canTrade() bool {
 	ga.mu.RLock()
 	defer ga.mu.RUnlock()
 
+	// No new risk: existing positions are still managed
+	if ga.config.ManageOnlyMode {
+		ga.logger.Warn("Ginie manage-only mode blocking new entries", "reason", RejectionManageOnly, "positions", len(ga.positions))
+		return false
+	}
+
+	// Exchange down for maintenance: nothing can be placed until it's back
+	if ga.inMaintenanceHoldLocked() {
+		ga.logger.Warn("Ginie maintenance hold blocking new entries", "reason", RejectionExchangeMaintenance,
+			"since", ga.exchangeStatus.HoldSince)
+		return false
+	}
+
 	// Check circuit breaker first (if enabled)
 	if ga.config.CircuitBreakerEnabled && ga.circuitBreaker != nil {
 		canTrade, reason := ga.circuitBreaker.CanTrade()
@@ -2896,12 +3409,33 @@ func (ga *GinieAutopilot) canTrade() bool {
 		return false
 	}
 
+	// Lock in a strong day: existing positions are still managed, no new entries
+	if ga.dailyProfitTargetReachedLocked() {
+		ga.logger.Warn("Ginie daily profit target reached", "reason", RejectionDailyProfitTarget,
+			"daily_pnl", ga.dailyPnL, "target", ga.config.MaxDailyProfit)
+		if !ga.dailyProfitTargetNotified {
+			go ga.notifyDailyProfitTargetReached()
+		}
+		return false
+	}
+
 	// Check max positions
 	if len(ga.positions) >= ga.config.MaxPositions {
 		ga.logger.Warn("Ginie max positions reached", "current", len(ga.positions), "max", ga.config.MaxPositions)
 		return false
 	}
 
+	// Staggered resume after a circuit breaker reset
+	rampCap, rampingUp := ga.rampUpPositionCapLocked()
+	if !rampingUp {
+		rampCap = 0
+	}
+	ga.logRampUpCap(rampCap)
+	if rampingUp && len(ga.positions) >= rampCap {
+		ga.logger.Warn("Ginie ramp-up position cap reached", "current", len(ga.positions), "cap", rampCap, "max", ga.config.MaxPositions)
+		return false
+	}
+
 	return true
 }
 
@@ -2931,6 +3465,12 @@ func (ga *GinieAutopilot) scanForUltraFast() {
 		return
 	}
 
+	// Skip the scan while ultra-fast is at its position cap
+	_, maxUFPositions, atCap := ga.modeAtPositionCap(GinieModeUltraFast)
+	if atCap {
+		return
+	}
+
 	// Track scan time for diagnostics
 	ga.mu.Lock()
 	ga.lastUltraFastScan = time.Now()
@@ -2943,22 +3483,6 @@ func (ga *GinieAutopilot) scanForUltraFast() {
 	log.Printf("[ULTRA-FAST-SCAN] Scanning %d symbols, min_confidence=%d%%, min_profit=$%.2f",
 		len(symbols), int(currentSettings.UltraFastMinConfidence), currentSettings.UltraFastMinProfitUSD)
 
-	// Count current ultra-fast positions
-	ga.mu.RLock()
-	currentUFCount := 0
-	for _, pos := range ga.positions {
-		if pos.Mode == GinieModeUltraFast {
-			currentUFCount++
-		}
-	}
-	maxUFPositions := currentSettings.UltraFastMaxPositions
-	ga.mu.RUnlock()
-
-	if currentUFCount >= maxUFPositions {
-		log.Printf("[ULTRA-FAST-SCAN] Position limit reached: %d/%d, skipping scan", currentUFCount, maxUFPositions)
-		return
-	}
-
 	// Check daily trade limit (0 = unlimited)
 	if currentSettings.UltraFastMaxDailyTrades > 0 && ga.dailyTrades >= currentSettings.UltraFastMaxDailyTrades {
 		log.Printf("[ULTRA-FAST-SCAN] Daily trade limit reached: %d/%d, skipping scan", ga.dailyTrades, currentSettings.UltraFastMaxDailyTrades)
@@ -3065,6 +3589,69 @@ func (ga *GinieAutopilot) scanForUltraFast() {
 				SignalNames:  []string{"TrendBias", "EntryConfidence", "VolatilityRegime", "MinProfitTarget", "MarginEfficiency"},
 			}
 
+			// Adaptive throttle pause (rolling win rate collapsed)
+			if _, _, paused, throttleReason := ga.adaptiveThrottle(GinieModeUltraFast); paused {
+				log.Printf("[ULTRA-FAST-SCAN] %s: Adaptive throttle pause active - %s, SKIP", symbol, throttleReason)
+				signalLog.Status = "rejected"
+				signalLog.RejectionReason = "adaptive_throttle_paused: " + throttleReason
+				ga.LogSignal(signalLog)
+				break
+			}
+
+			// Mode trading schedule (hours/days window)
+			if !modeConfig.Schedule.IsOpen(time.Now()) {
+				log.Printf("[ULTRA-FAST-SCAN] %s: Outside mode trading hours, SKIP", symbol)
+				signalLog.Status = "rejected"
+				signalLog.RejectionReason = RejectionOutsideTradingHours
+				ga.LogSignal(signalLog)
+				break // Window applies to every symbol in this scan
+			}
+
+			// Account route (e.g. a shorts-only hedge account)
+			if reason, rejected := ga.accountRouteRejection(signalLog.Direction); rejected {
+				log.Printf("[ULTRA-FAST-SCAN] %s: %s, SKIP", symbol, reason)
+				signalLog.Status = "rejected"
+				signalLog.RejectionReason = reason
+				ga.LogSignal(signalLog)
+				continue
+			}
+
+			// Bid/ask spread (a wide spread can eat the whole ultra-fast target)
+			if reason, rejected := ga.spreadRejection(symbol, modeConfig, signalLog); rejected {
+				log.Printf("[ULTRA-FAST-SCAN] %s: %s, SKIP", symbol, reason)
+				signalLog.Status = "rejected"
+				signalLog.RejectionReason = reason
+				ga.LogSignal(signalLog)
+				continue
+			}
+
+			// Liquidity gate (thin conditions give unreliable signals and poor fills)
+			if reason, rejected := ga.volumeRejection(symbol, GinieModeUltraFast, modeConfig, signalLog); rejected {
+				log.Printf("[ULTRA-FAST-SCAN] %s: %s, SKIP", symbol, reason)
+				signalLog.Status = "rejected"
+				signalLog.RejectionReason = reason
+				ga.LogSignal(signalLog)
+				continue
+			}
+
+			// Coin-specific news sentiment against the trade direction
+			if reason, rejected := ga.symbolSentimentRejection(symbol, signalLog); rejected {
+				log.Printf("[ULTRA-FAST-SCAN] %s: %s, SKIP", symbol, reason)
+				signalLog.Status = "rejected"
+				signalLog.RejectionReason = reason
+				ga.LogSignal(signalLog)
+				continue
+			}
+
+			// Redundant BTC beta (alt highly correlated with BTC on top of correlated exposure)
+			if reason, rejected := ga.btcCorrelationRejection(symbol, ga.symbolBTCCorrelation(symbol), signalLog); rejected {
+				log.Printf("[ULTRA-FAST-SCAN] %s: %s, SKIP", symbol, reason)
+				signalLog.Status = "rejected"
+				signalLog.RejectionReason = reason
+				ga.LogSignal(signalLog)
+				continue
+			}
+
 			tradesAttempted++
 
 			// Execute the ultra-fast entry with dynamic position size
@@ -3076,7 +3663,7 @@ func (ga *GinieAutopilot) scanForUltraFast() {
 				ga.LogSignal(signalLog)
 
 				// If margin error, don't try more expensive coins
-				if strings.Contains(err.Error(), "insufficient") || strings.Contains(err.Error(), "-2019") {
+				if errors.Is(err, binance.ErrInsufficientMargin) {
 					log.Printf("[ULTRA-FAST-SCAN] Margin exhausted, stopping scan early")
 					break
 				}
@@ -3328,25 +3915,8 @@ func (ga *GinieAutopilot) scanForMode(mode GinieTradingMode) {
 	// This prevents the scan from wasting resources when the limit is already reached
 	// Use getModeConfigForSizing to handle scalp_reentry -> scalp fallback for sizing config
 	modeConfig := ga.getModeConfigForSizing(mode)
-	maxPositions := ga.config.MaxPositions // Default to global config
-	if modeConfig != nil && modeConfig.Size != nil && modeConfig.Size.MaxPositions > 0 {
-		maxPositions = modeConfig.Size.MaxPositions
-	}
-
-	// Count current positions for THIS mode specifically
-	ga.mu.RLock()
-	currentModePositions := 0
-	for _, pos := range ga.positions {
-		if pos.Mode == mode {
-			currentModePositions++
-		}
-	}
-	ga.mu.RUnlock()
-
-	// Early exit if position limit already reached for this mode
-	if currentModePositions >= maxPositions {
-		log.Printf("[%s-SCAN] POSITION LIMIT REACHED: %d/%d positions for this mode, skipping entire scan",
-			mode, currentModePositions, maxPositions)
+	currentModePositions, maxPositions, atCap := ga.modeAtPositionCap(mode)
+	if atCap {
 		return
 	}
 
@@ -3389,6 +3959,7 @@ func (ga *GinieAutopilot) scanForMode(mode GinieTradingMode) {
 	}
 
 	var scalpSignals, swingSignals, positionSignals int
+	var candidates []*scanCandidate
 
 	for _, symbol := range symbols {
 		select {
@@ -3554,11 +4125,14 @@ func (ga *GinieAutopilot) scanForMode(mode GinieTradingMode) {
 					// Map trend divergence if present
 					if decision.RejectionTracking.TrendDivergence != nil {
 						signalLog.RejectionDetails.TrendDivergence = &TrendDivergenceInfo{
-							ScanTimeframe:     decision.RejectionTracking.TrendDivergence.ScanTimeframe,
-							ScanTrend:         decision.RejectionTracking.TrendDivergence.ScanTrend,
-							DecisionTimeframe: decision.RejectionTracking.TrendDivergence.DecisionTimeframe,
-							DecisionTrend:     decision.RejectionTracking.TrendDivergence.DecisionTrend,
-							Severity:          decision.RejectionTracking.TrendDivergence.Severity,
+							ScanTimeframe:      decision.RejectionTracking.TrendDivergence.ScanTimeframe,
+							ScanTrend:          decision.RejectionTracking.TrendDivergence.ScanTrend,
+							DecisionTimeframe:  decision.RejectionTracking.TrendDivergence.DecisionTimeframe,
+							DecisionTrend:      decision.RejectionTracking.TrendDivergence.DecisionTrend,
+							Severity:           decision.RejectionTracking.TrendDivergence.Severity,
+							TimeframeTrends:    decision.RejectionTracking.TrendDivergence.TimeframeTrends,
+							TimeframesAgreeing: decision.RejectionTracking.TrendDivergence.TimeframesAgreeing,
+							TimeframesRequired: decision.RejectionTracking.TrendDivergence.TimeframesRequired,
 						}
 					}
 					// Map counter-trend rejection if present
@@ -3596,6 +4170,10 @@ func (ga *GinieAutopilot) scanForMode(mode GinieTradingMode) {
 
 			// Get effective confidence threshold for this symbol (considers performance category)
 			effectiveMinConfidence := settingsManager.GetEffectiveConfidence(symbol, ga.config.MinConfidenceToTrade)
+			if throttleBoost, _, _, _ := ga.adaptiveThrottle(mode); throttleBoost > 0 {
+				effectiveMinConfidence += throttleBoost
+			}
+			effectiveMinConfidence += ga.lossCoolOffBoost()
 
 			// Get symbol category for logging
 			symbolSettings := settingsManager.GetSymbolSettings(symbol)
@@ -3638,6 +4216,15 @@ func (ga *GinieAutopilot) scanForMode(mode GinieTradingMode) {
 				signalLog.Status = "rejected"
 				signalLog.RejectionReason = fmt.Sprintf("low_confidence (%.1f < %.1f)%s",
 					decision.ConfidenceScore, effectiveMinConfidence, boostInfo)
+				signalLog.RejectionDetails = &SignalRejectionDetails{
+					AllReasons: []string{signalLog.RejectionReason},
+					SignalQuality: &SignalQualityInfo{
+						SignalsMet:      signalLog.PrimaryMet,
+						SignalsRequired: signalLog.PrimaryRequired,
+						ConfidenceScore: decision.ConfidenceScore,
+						MinConfidence:   effectiveMinConfidence,
+					},
+				}
 				// Update the stored decision so UI shows correct rejected status
 				ga.analyzer.UpdateDecisionRecommendation(symbol, RecommendationSkip, signalLog.RejectionReason)
 				ga.LogSignal(signalLog)
@@ -3709,22 +4296,77 @@ func (ga *GinieAutopilot) scanForMode(mode GinieTradingMode) {
 				"action", decision.TradeExecution.Action,
 				"mode", decision.SelectedMode)
 
-			// CRITICAL FIX: Re-check mode-specific position limit before EACH trade execution
-			// This prevents race conditions where multiple signals pass initial checks
-			ga.mu.RLock()
-			currentModePositionsNow := 0
-			for _, pos := range ga.positions {
-				if pos.Mode == mode {
-					currentModePositionsNow++
+			// Adaptive throttle pause (rolling win rate collapsed)
+			if _, _, paused, throttleReason := ga.adaptiveThrottle(mode); paused {
+				log.Printf("[%s-SCAN] %s: Adaptive throttle pause active - %s, SKIP trade", mode, symbol, throttleReason)
+				signalLog.Status = "rejected"
+				signalLog.RejectionReason = "adaptive_throttle_paused: " + throttleReason
+				ga.LogSignal(signalLog)
+				continue
+			}
+
+			// Mode trading schedule (hours/days window)
+			if modeConfig != nil && !modeConfig.Schedule.IsOpen(time.Now()) {
+				log.Printf("[%s-SCAN] %s: Outside mode trading hours, SKIP trade", mode, symbol)
+				signalLog.Status = "rejected"
+				signalLog.RejectionReason = RejectionOutsideTradingHours
+				ga.LogSignal(signalLog)
+				continue
+			}
+
+			// Mode minimum risk:reward (0 RR means the analyzer couldn't compute one)
+			if modeConfig != nil && modeConfig.Risk != nil && modeConfig.Risk.MinRiskReward > 0 {
+				rr := decision.TradeExecution.RiskReward
+				if rr > 0 && rr < modeConfig.Risk.MinRiskReward {
+					log.Printf("[%s-SCAN] %s: Risk:reward %.2f below mode minimum %.2f, SKIP trade", mode, symbol, rr, modeConfig.Risk.MinRiskReward)
+					signalLog.Status = "rejected"
+					signalLog.RejectionReason = fmt.Sprintf("%s (%.1f < %.1f)", RejectionLowRiskReward, rr, modeConfig.Risk.MinRiskReward)
+					ga.LogSignal(signalLog)
+					continue
 				}
 			}
-			ga.mu.RUnlock()
 
-			if currentModePositionsNow >= maxPositions {
-				log.Printf("[%s-SCAN] %s: POSITION LIMIT REACHED during scan: %d/%d, SKIP trade",
-					mode, symbol, currentModePositionsNow, maxPositions)
+			// Account route (e.g. a shorts-only hedge account)
+			if reason, rejected := ga.accountRouteRejection(signalLog.Direction); rejected {
+				log.Printf("[%s-SCAN] %s: %s, SKIP trade", mode, symbol, reason)
 				signalLog.Status = "rejected"
-				signalLog.RejectionReason = fmt.Sprintf("position_limit_reached: %d/%d", currentModePositionsNow, maxPositions)
+				signalLog.RejectionReason = reason
+				ga.LogSignal(signalLog)
+				continue
+			}
+
+			// Bid/ask spread
+			if reason, rejected := ga.spreadRejection(symbol, modeConfig, signalLog); rejected {
+				log.Printf("[%s-SCAN] %s: %s, SKIP trade", mode, symbol, reason)
+				signalLog.Status = "rejected"
+				signalLog.RejectionReason = reason
+				ga.LogSignal(signalLog)
+				continue
+			}
+
+			// Liquidity gate: 24h volume floor and per-candle volume vs its recent average
+			if reason, rejected := ga.volumeRejection(symbol, mode, modeConfig, signalLog); rejected {
+				log.Printf("[%s-SCAN] %s: %s, SKIP trade", mode, symbol, reason)
+				signalLog.Status = "rejected"
+				signalLog.RejectionReason = reason
+				ga.LogSignal(signalLog)
+				continue
+			}
+
+			// Coin-specific news sentiment against the trade direction
+			if reason, rejected := ga.symbolSentimentRejection(symbol, signalLog); rejected {
+				log.Printf("[%s-SCAN] %s: %s, SKIP trade", mode, symbol, reason)
+				signalLog.Status = "rejected"
+				signalLog.RejectionReason = reason
+				ga.LogSignal(signalLog)
+				continue
+			}
+
+			// Redundant BTC beta, using the correlation from the decision's market snapshot
+			if reason, rejected := ga.btcCorrelationRejection(symbol, decision.MarketConditions.BTCCorr, signalLog); rejected {
+				log.Printf("[%s-SCAN] %s: %s, SKIP trade", mode, symbol, reason)
+				signalLog.Status = "rejected"
+				signalLog.RejectionReason = reason
 				ga.LogSignal(signalLog)
 				continue
 			}
@@ -3803,69 +4445,148 @@ func (ga *GinieAutopilot) scanForMode(mode GinieTradingMode) {
 				}
 			}
 
-			// Execute the trade and get result
-			tradeSuccess, tradeReason := ga.executeTradeWithResult(decision)
-
-			// Log signal status based on ACTUAL trade result (not before)
-			if tradeSuccess {
-				signalLog.Status = "executed"
-				ga.LogSignal(signalLog)
-
-				// Mode-specific success logging
-				if isScalpMode {
-					log.Printf("[SCALP-SCAN] %s: Trade execution successful: %s", symbol, tradeReason)
-				}
-				if mode == GinieModeSwing {
-					log.Printf("[SWING-SCAN] %s: ✓ Trade execution successful: %s", symbol, tradeReason)
-				}
-				if mode == GinieModePosition {
-					log.Printf("[POSITION-SCAN] %s: Trade execution successful: %s", symbol, tradeReason)
-				}
-			} else {
-				signalLog.Status = "rejected"
-				signalLog.RejectionReason = tradeReason
-				ga.LogSignal(signalLog)
-
-				// Mode-specific failure logging
-				if isScalpMode {
-					log.Printf("[SCALP-SCAN] %s: Trade execution REJECTED: %s", symbol, tradeReason)
-				}
-				if mode == GinieModeSwing {
-					log.Printf("[SWING-SCAN] %s: Trade execution REJECTED: %s", symbol, tradeReason)
-				}
-				if mode == GinieModePosition {
-					log.Printf("[POSITION-SCAN] %s: Trade execution REJECTED: %s", symbol, tradeReason)
-				}
+			// Queue for slot allocation once the whole cycle has been scanned
+			generatedAt := decision.Timestamp
+			if generatedAt.IsZero() {
+				generatedAt = time.Now()
 			}
+			candidates = append(candidates, &scanCandidate{
+				symbol:      symbol,
+				decision:    decision,
+				signalLog:   signalLog,
+				generatedAt: generatedAt,
+			})
 		}
 	}
 
-	// Mode-specific scan cycle completion summary (Epic 2 Stories 2.2-2.4)
-	switch mode {
-	case GinieModeScalp:
-		log.Printf("[SCALP-SCAN] Scan complete: %d signals evaluated, %d trade attempts", scalpSignals, scalpTrades)
-	case GinieModeSwing:
-		log.Printf("[SWING-SCAN] Scan complete: %d symbols scanned, %d signals evaluated", len(symbols), swingSignals)
-	case GinieModePosition:
-		log.Printf("[POSITION-SCAN] Scan cycle complete: %d symbols scanned, %d signals generated", len(symbols), positionSignals)
-	}
-
-	// Update progress to show scan completed (Issue 2B)
-	ga.mu.Lock()
-	ga.scannedThisCycle = len(symbols)
-	ga.mu.Unlock()
-}
+	// Per-cycle cap: only the mode's top N signals go on, even with slots to spare
+	candidates = ga.capTopRankedCandidates(mode, candidates, time.Now())
 
-// getAvailableBalance fetches the actual available balance from Binance
-func (ga *GinieAutopilot) getAvailableBalance() (float64, error) {
-	accountInfo, err := ga.futuresClient.GetFuturesAccountInfo()
-	if err != nil {
-		return 0, fmt.Errorf("failed to get account info: %w", err)
+	// Execution queue: the cycle's executor takes these once every mode has scanned
+	if ga.enqueueScanCandidates(mode, candidates) {
+		candidates = nil
 	}
-	return accountInfo.AvailableBalance, nil
-}
 
-// calculateAdaptivePositionSize calculates position size based on available balance and current state
+	// Slot allocation: with more qualifying signals than free slots, the freshest and
+	// strongest win instead of whichever symbol happened to be scanned first
+	ga.mu.RLock()
+	freeSlots := maxPositions
+	for _, pos := range ga.positions {
+		if pos.Mode == mode {
+			freeSlots--
+		}
+	}
+	ga.mu.RUnlock()
+	if len(candidates) > freeSlots && freeSlots > 0 {
+		ga.rankScanCandidates(candidates, time.Now())
+		log.Printf("[%s-SCAN] %d qualifying signals for %d free slots, ranked by decayed confidence (half-life %ds) and RR",
+			mode, len(candidates), freeSlots, ga.config.ConfidenceDecayHalfLifeSeconds)
+	}
+
+	for rank, candidate := range candidates {
+		select {
+		case <-ga.stopChan:
+			return
+		default:
+		}
+		symbol, decision, signalLog := candidate.symbol, candidate.decision, candidate.signalLog
+
+		// CRITICAL FIX: Re-check mode-specific position limit before EACH trade execution
+		// This prevents race conditions where multiple signals pass initial checks
+		ga.mu.RLock()
+		currentModePositionsNow := 0
+		for _, pos := range ga.positions {
+			if pos.Mode == mode {
+				currentModePositionsNow++
+			}
+		}
+		totalPositionsNow := len(ga.positions)
+		ga.mu.RUnlock()
+
+		if currentModePositionsNow >= maxPositions {
+			log.Printf("[%s-SCAN] %s: POSITION LIMIT REACHED during scan: %d/%d, SKIP trade (rank %d/%d)",
+				mode, symbol, currentModePositionsNow, maxPositions, rank+1, len(candidates))
+			signalLog.Status = "rejected"
+			signalLog.RejectionReason = fmt.Sprintf("position_limit_reached: %d/%d", currentModePositionsNow, maxPositions)
+			if candidate.decayedConfidence > 0 {
+				signalLog.RejectionReason = fmt.Sprintf("outranked: rank %d/%d, decayed confidence %.1f%% (slots %d/%d)",
+					rank+1, len(candidates), candidate.decayedConfidence, currentModePositionsNow, maxPositions)
+			}
+			signalLog.RejectionDetails = &SignalRejectionDetails{
+				AllReasons: []string{signalLog.RejectionReason},
+				PositionLimit: &PositionLimitInfo{
+					CurrentPositions: totalPositionsNow,
+					MaxPositions:     maxPositions,
+					ModePositions:    currentModePositionsNow,
+					ModeName:         string(mode),
+				},
+			}
+			ga.LogSignal(signalLog)
+			continue
+		}
+
+		// Execute the trade and get result
+		tradeSuccess, tradeReason := ga.executeTradeWithResult(decision)
+
+		// Log signal status based on ACTUAL trade result (not before)
+		if tradeSuccess {
+			signalLog.Status = "executed"
+			ga.LogSignal(signalLog)
+
+			// Mode-specific success logging
+			if isScalpMode {
+				log.Printf("[SCALP-SCAN] %s: Trade execution successful: %s", symbol, tradeReason)
+			}
+			if mode == GinieModeSwing {
+				log.Printf("[SWING-SCAN] %s: ✓ Trade execution successful: %s", symbol, tradeReason)
+			}
+			if mode == GinieModePosition {
+				log.Printf("[POSITION-SCAN] %s: Trade execution successful: %s", symbol, tradeReason)
+			}
+		} else {
+			signalLog.Status = "rejected"
+			signalLog.RejectionReason = tradeReason
+			ga.LogSignal(signalLog)
+
+			// Mode-specific failure logging
+			if isScalpMode {
+				log.Printf("[SCALP-SCAN] %s: Trade execution REJECTED: %s", symbol, tradeReason)
+			}
+			if mode == GinieModeSwing {
+				log.Printf("[SWING-SCAN] %s: Trade execution REJECTED: %s", symbol, tradeReason)
+			}
+			if mode == GinieModePosition {
+				log.Printf("[POSITION-SCAN] %s: Trade execution REJECTED: %s", symbol, tradeReason)
+			}
+		}
+	}
+
+	// Mode-specific scan cycle completion summary (Epic 2 Stories 2.2-2.4)
+	switch mode {
+	case GinieModeScalp:
+		log.Printf("[SCALP-SCAN] Scan complete: %d signals evaluated, %d trade attempts", scalpSignals, scalpTrades)
+	case GinieModeSwing:
+		log.Printf("[SWING-SCAN] Scan complete: %d symbols scanned, %d signals evaluated", len(symbols), swingSignals)
+	case GinieModePosition:
+		log.Printf("[POSITION-SCAN] Scan cycle complete: %d symbols scanned, %d signals generated", len(symbols), positionSignals)
+	}
+
+	// Update progress to show scan completed (Issue 2B)
+	ga.mu.Lock()
+	ga.scannedThisCycle = len(symbols)
+	ga.mu.Unlock()
+}
+
+// getAvailableBalance fetches the actual available balance from Binance
+func (ga *GinieAutopilot) getAvailableBalance() (float64, error) {
+	accountInfo, err := ga.futuresClient.GetFuturesAccountInfo()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get account info: %w", err)
+	}
+	return accountInfo.AvailableBalance, nil
+}
+
+// calculateAdaptivePositionSize calculates position size based on available balance and current state
 // This is a pragmatic, human-like approach that considers:
 // 1. Actual available balance (not fixed config)
 // 2. Number of open positions vs max positions
@@ -3887,81 +4608,159 @@ func (ga *GinieAutopilot) getAvailableBalance() (float64, error) {
 // - modeConfig.Size.AutoSizeEnabled: Use AI/LLM suggested size
 // - modeConfig.Size.Leverage: Leverage setting for this mode
 func (ga *GinieAutopilot) calculateAdaptivePositionSize(symbol string, confidence float64, currentPositionCount int, mode GinieTradingMode, llmSuggestedSize float64) (positionUSD float64, canTrade bool, reason string) {
+	s := ga.sizePosition(symbol, confidence, currentPositionCount, mode, llmSuggestedSize, ga.currentRiskLevel)
+
+	if s.Misconfigured {
+		ga.logger.Error("Position sizing not configured - cannot trade",
+			"mode", mode,
+			"reason", s.RejectReason)
+	}
+	if !s.CanTrade {
+		return 0, false, s.RejectReason
+	}
+
+	if s.SizingMethod == "ai_llm" {
+		ga.logger.Info("Using AI/LLM suggested position size",
+			"symbol", symbol,
+			"mode", mode,
+			"llm_suggested_usd", fmt.Sprintf("$%.2f", llmSuggestedSize),
+			"auto_size_enabled", s.AutoSizeEnabled)
+	}
+	if s.ThrottleMultiplier < 1 {
+		ga.logger.Info("Adaptive throttle reducing position size",
+			"symbol", symbol,
+			"mode", mode,
+			"multiplier", s.ThrottleMultiplier)
+	}
+	if s.EquityMultiplier < 1 {
+		ga.logger.Info("Equity scaling reducing position size and leverage",
+			"symbol", symbol,
+			"mode", mode,
+			"multiplier", s.EquityMultiplier)
+	}
+	if s.MarginMultiplier < 1 {
+		ga.logger.Warn("Margin ratio guard reducing position size",
+			"symbol", symbol,
+			"mode", mode,
+			"margin_ratio", fmt.Sprintf("%.1f%%", s.MarginRatio),
+			"multiplier", s.MarginMultiplier)
+	}
+	if s.MaxSizeUSD != ga.config.MaxUSDPerPosition {
+		ga.logger.Debug("Position size cap applied",
+			"symbol", symbol,
+			"mode", mode,
+			"category", s.SymbolCategory,
+			"global_max_usd", ga.config.MaxUSDPerPosition,
+			"symbol_cap_usd", s.SymbolCapUSD,
+			"cap_source", s.MaxSizeSource,
+			"effective_max_usd", s.MaxSizeUSD)
+	}
+	if s.MinSizeEnforced {
+		ga.logger.Info("Enforcing minimum position size",
+			"symbol", symbol,
+			"mode", mode,
+			"calculated_usd", fmt.Sprintf("$%.2f", s.CalculatedUSD),
+			"enforced_min_usd", fmt.Sprintf("$%.2f", s.MinPositionSizeUSD))
+	}
+
+	ga.logger.Info("Adaptive position sizing",
+		"mode", mode,
+		"sizing_method", s.SizingMethod,
+		"available_balance", fmt.Sprintf("$%.2f", s.AvailableBalance),
+		"usable_balance", fmt.Sprintf("$%.2f", s.UsableBalance),
+		"leverage", fmt.Sprintf("%dx", s.Leverage),
+		"available_notional", fmt.Sprintf("$%.2f", s.AvailableNotional),
+		"safety_margin", fmt.Sprintf("%.0f%%", s.SafetyMargin*100),
+		"current_positions", currentPositionCount,
+		"available_slots", s.AvailableSlots,
+		"base_allocation", fmt.Sprintf("$%.2f", s.BaseSizeUSD),
+		"risk_level", s.RiskLevel,
+		"risk_multiplier", fmt.Sprintf("%.2f", s.RiskMultiplier),
+		"confidence", fmt.Sprintf("%.1f%%", confidence),
+		"confidence_multiplier", fmt.Sprintf("%.2f", s.ConfidenceMultiplier),
+		"llm_suggested_size", fmt.Sprintf("$%.2f", llmSuggestedSize),
+		"auto_size_enabled", s.AutoSizeEnabled,
+		"max_size_usd", fmt.Sprintf("$%.2f", s.MaxSizeUSD),
+		"final_position_usd", fmt.Sprintf("$%.2f", s.PositionUSD))
+
+	return s.PositionUSD, true, ""
+}
+
+// sizePosition runs the adaptive sizing formula for riskLevel and records every
+// intermediate value. It has no side effects beyond the balance fetch, so the
+// sizing preview endpoint can reuse it.
+func (ga *GinieAutopilot) sizePosition(symbol string, confidence float64, currentPositionCount int, mode GinieTradingMode, llmSuggestedSize float64, riskLevel string) *PositionSizingBreakdown {
+	s := &PositionSizingBreakdown{
+		Symbol:           symbol,
+		Mode:             mode,
+		RiskLevel:        riskLevel,
+		Confidence:       confidence,
+		CurrentPositions: currentPositionCount,
+		SizingMethod:     "formula",
+	}
+	reject := func(reason string) *PositionSizingBreakdown {
+		s.RejectReason = reason
+		return s
+	}
+	misconfigured := func(reason string) *PositionSizingBreakdown {
+		s.Misconfigured = true
+		return reject(reason)
+	}
+
 	// Get mode configuration for mode-specific sizing parameters (from database)
-	// Use getModeConfigForSizing to handle scalp_reentry -> scalp fallback for sizing config
 	modeConfig := ga.getModeConfigForSizing(mode)
 
 	// Get actual available balance from Binance
 	availableBalance, err := ga.getAvailableBalance()
 	if err != nil {
 		ga.logger.Error("Failed to get available balance", "error", err)
-		return 0, false, "cannot fetch balance"
+		return reject("cannot fetch balance")
 	}
+	s.AvailableBalance = availableBalance
 
 	// Safety margin: STRICT REQUIREMENT - MUST be configured - NO FALLBACK
 	if modeConfig == nil || modeConfig.Size == nil || modeConfig.Size.SafetyMargin <= 0 {
-		ga.logger.Error("Safety margin not configured - cannot trade",
-			"mode", mode,
-			"reason", "safety_margin not set in user settings")
-		return 0, false, fmt.Sprintf("mode %s safety_margin not configured - skipping trade", mode)
+		return misconfigured(fmt.Sprintf("mode %s safety_margin not configured - skipping trade", mode))
 	}
-	safetyMargin := modeConfig.Size.SafetyMargin
-	usableBalance := availableBalance * safetyMargin
+	s.SafetyMargin = modeConfig.Size.SafetyMargin
+	s.UsableBalance = availableBalance * s.SafetyMargin
 
 	// Get leverage for position sizing calculations
-	leverage := ga.config.DefaultLeverage
-	if modeConfig != nil && modeConfig.Size != nil && modeConfig.Size.Leverage > 0 {
-		leverage = modeConfig.Size.Leverage
+	s.Leverage = ga.config.DefaultLeverage
+	if modeConfig.Size.Leverage > 0 {
+		s.Leverage = modeConfig.Size.Leverage
 	}
-	if leverage <= 0 {
-		leverage = 10 // Fallback to 10x if not configured
+	if s.Leverage <= 0 {
+		s.Leverage = 10 // Fallback to 10x if not configured
 	}
 	// Calculate available notional value considering leverage
-	availableNotional := usableBalance * float64(leverage)
+	s.AvailableNotional = s.UsableBalance * float64(s.Leverage)
 
 	// Check minimum balance threshold: STRICT REQUIREMENT - MUST be configured - NO FALLBACK
 	if modeConfig.Size.MinBalanceUSD <= 0 {
-		ga.logger.Error("Minimum balance threshold not configured - cannot trade",
-			"mode", mode,
-			"reason", "min_balance_usd not set in user settings")
-		return 0, false, fmt.Sprintf("mode %s min_balance_usd not configured - skipping trade", mode)
-	}
-	minBalanceRequired := modeConfig.Size.MinBalanceUSD
-	if usableBalance < minBalanceRequired {
-		return 0, false, fmt.Sprintf("insufficient balance: $%.2f (need $%.2f)", usableBalance, minBalanceRequired)
+		return misconfigured(fmt.Sprintf("mode %s min_balance_usd not configured - skipping trade", mode))
 	}
-
-	// Use position count passed from caller (captured while holding lock)
-	// Use mode-specific max positions if available, otherwise global config
-	maxPositions := ga.config.MaxPositions
-	if modeConfig != nil && modeConfig.Size != nil && modeConfig.Size.MaxPositions > 0 {
-		maxPositions = modeConfig.Size.MaxPositions
+	if s.UsableBalance < modeConfig.Size.MinBalanceUSD {
+		return reject(fmt.Sprintf("insufficient balance: $%.2f (need $%.2f)", s.UsableBalance, modeConfig.Size.MinBalanceUSD))
 	}
-	availableSlots := maxPositions - currentPositionCount
 
-	if availableSlots <= 0 {
-		return 0, false, fmt.Sprintf("max positions reached: %d/%d", currentPositionCount, maxPositions)
+	// Mode position cap: mode_max_positions, then size.max_positions, then global config
+	s.MaxPositions, _ = ga.modePositionCap(mode)
+	s.AvailableSlots = s.MaxPositions - currentPositionCount
+	if s.AvailableSlots <= 0 {
+		return reject(fmt.Sprintf("max positions reached: %d/%d", currentPositionCount, s.MaxPositions))
 	}
 
-	// Calculate allocation per potential new position
 	// STRICT REQUIREMENT: Use base_size_usd from mode config - NO FALLBACK
-	if modeConfig == nil || modeConfig.Size == nil || modeConfig.Size.BaseSizeUSD <= 0 {
-		ga.logger.Error("Position size not configured - cannot trade",
-			"mode", mode,
-			"reason", "base_size_usd not set in user settings")
-		return 0, false, fmt.Sprintf("mode %s base_size_usd not configured - skipping trade", mode)
+	if modeConfig.Size.BaseSizeUSD <= 0 {
+		return misconfigured(fmt.Sprintf("mode %s base_size_usd not configured - skipping trade", mode))
 	}
-	baseAllocationPerPosition := modeConfig.Size.BaseSizeUSD
-	ga.logger.Debug("Using configured base_size_usd",
-		"mode", mode,
-		"base_size_usd", modeConfig.Size.BaseSizeUSD)
+	s.BaseSizeUSD = modeConfig.Size.BaseSizeUSD
 
 	// Get risk multipliers from mode config - use sensible defaults if not configured
 	riskMultiplierConservative := modeConfig.Size.RiskMultiplierConservative
 	riskMultiplierModerate := modeConfig.Size.RiskMultiplierModerate
 	riskMultiplierAggressive := modeConfig.Size.RiskMultiplierAggressive
-
-	// Apply sensible defaults if not configured
 	if riskMultiplierConservative <= 0 {
 		riskMultiplierConservative = 0.6
 	}
@@ -3973,21 +4772,17 @@ func (ga *GinieAutopilot) calculateAdaptivePositionSize(symbol string, confidenc
 	}
 
 	// Adjust based on risk level using mode-specific multipliers
-	riskMultiplier := riskMultiplierAggressive
-	switch ga.currentRiskLevel {
+	s.RiskMultiplier = riskMultiplierAggressive
+	switch riskLevel {
 	case "conservative":
-		riskMultiplier = riskMultiplierConservative
+		s.RiskMultiplier = riskMultiplierConservative
 	case "moderate":
-		riskMultiplier = riskMultiplierModerate
-	case "aggressive":
-		riskMultiplier = riskMultiplierAggressive
+		s.RiskMultiplier = riskMultiplierModerate
 	}
 
 	// Get confidence multipliers from mode config - use sensible defaults if not configured
 	confidenceBase := modeConfig.Size.ConfidenceMultiplierBase
 	confidenceScale := modeConfig.Size.ConfidenceMultiplierScale
-
-	// Apply sensible defaults if not configured
 	if confidenceBase <= 0 {
 		confidenceBase = 0.5
 	}
@@ -3997,113 +4792,94 @@ func (ga *GinieAutopilot) calculateAdaptivePositionSize(symbol string, confidenc
 
 	// Adjust based on confidence (higher confidence = more allocation)
 	// Scale: 65% confidence = 0.8x, 80% confidence = 1.0x, 95% confidence = 1.15x
-	confidenceMultiplier := confidenceBase + (confidence / 100.0 * confidenceScale)
+	s.ConfidenceMultiplier = confidenceBase + (confidence / 100.0 * confidenceScale)
 
-	// Get per-symbol size multiplier based on performance category
+	// Get per-symbol size cap based on performance category
 	settingsManager := GetSettingsManager()
 	effectiveMaxUSD := settingsManager.GetEffectivePositionSize(symbol, ga.config.MaxUSDPerPosition)
 	symbolSettings := settingsManager.GetSymbolSettings(symbol)
-
-	// Check if AI/LLM sizing is enabled for this mode
-	autoSizeEnabled := false
-	if modeConfig != nil && modeConfig.Size != nil {
-		autoSizeEnabled = modeConfig.Size.AutoSizeEnabled
-	}
+	s.SymbolCategory = string(symbolSettings.Category)
 
 	// Calculate position size - use LLM suggestion if auto_size_enabled and valid LLM size provided
-	useLLMSize := autoSizeEnabled && llmSuggestedSize > 0
-	if useLLMSize {
+	s.AutoSizeEnabled = modeConfig.Size.AutoSizeEnabled
+	if s.AutoSizeEnabled && llmSuggestedSize > 0 {
 		// Use LLM suggested size as base, but still apply safety limits
-		positionUSD = llmSuggestedSize
-		ga.logger.Info("Using AI/LLM suggested position size",
-			"symbol", symbol,
-			"mode", mode,
-			"llm_suggested_usd", fmt.Sprintf("$%.2f", llmSuggestedSize),
-			"auto_size_enabled", autoSizeEnabled)
+		s.SizingMethod = "ai_llm"
+		s.CalculatedUSD = llmSuggestedSize
 	} else {
-		// Calculate using formula-based approach
-		positionUSD = baseAllocationPerPosition * riskMultiplier * confidenceMultiplier
+		s.CalculatedUSD = s.BaseSizeUSD * s.RiskMultiplier * s.ConfidenceMultiplier
 	}
+	positionUSD := s.CalculatedUSD
 
 	// Cap at mode-specific MaxSizeUSD if configured, otherwise use effective max USD
 	// FIX: Mode config should be PRIMARY source, not just used when lower
 	// User's mode-specific max_size_usd setting takes precedence over global/category defaults
-	maxSizeUSD := effectiveMaxUSD
-	if modeConfig != nil && modeConfig.Size != nil && modeConfig.Size.MaxSizeUSD > 0 {
-		// Use mode-specific max directly - user explicitly configured this for this mode
-		maxSizeUSD = modeConfig.Size.MaxSizeUSD
+	s.CategoryMaxUSD = effectiveMaxUSD
+	s.MaxSizeUSD = effectiveMaxUSD
+	s.MaxSizeSource = "category"
+	if modeConfig.Size.MaxSizeUSD > 0 {
+		s.ModeMaxUSD = modeConfig.Size.MaxSizeUSD
+		s.MaxSizeUSD = modeConfig.Size.MaxSizeUSD
+		s.MaxSizeSource = "mode"
+	}
+	// Per-symbol hard cap is an absolute ceiling: the lowest of it and the above wins
+	if symbolSettings.MaxSizeCapUSD > 0 {
+		s.SymbolCapUSD = symbolSettings.MaxSizeCapUSD
+		if s.SymbolCapUSD < s.MaxSizeUSD {
+			s.MaxSizeUSD = s.SymbolCapUSD
+			s.MaxSizeSource = "symbol_cap"
+		}
 	}
-	if positionUSD > maxSizeUSD {
-		positionUSD = maxSizeUSD
+	if positionUSD > s.MaxSizeUSD {
+		positionUSD = s.MaxSizeUSD
 	}
 
-	// Log per-symbol or mode adjustment if different from global
-	if maxSizeUSD != ga.config.MaxUSDPerPosition {
-		ga.logger.Debug("Position size cap applied",
-			"symbol", symbol,
-			"mode", mode,
-			"category", symbolSettings.Category,
-			"global_max_usd", ga.config.MaxUSDPerPosition,
-			"effective_max_usd", maxSizeUSD)
+	// Adaptive throttle: smaller size while the mode's rolling win rate is poor
+	s.ThrottleMultiplier = 1
+	if _, throttleMultiplier, _, _ := ga.adaptiveThrottle(mode); throttleMultiplier < 1 {
+		s.ThrottleMultiplier = throttleMultiplier
+		positionUSD *= throttleMultiplier
+	}
+
+	// Equity scaling: trade smaller after a run-up and into a drawdown
+	s.EquityMultiplier = ga.sampleEquityScaling()
+	positionUSD *= s.EquityMultiplier
+
+	// Margin ratio guard: shrink new entries as the account nears liquidation, then stop them
+	marginMultiplier, marginRatio, marginReject := ga.sampleMarginRatio()
+	s.MarginMultiplier = marginMultiplier
+	s.MarginRatio = marginRatio
+	if marginReject != "" {
+		return reject(marginReject)
 	}
+	positionUSD *= marginMultiplier
 
 	// Minimum position size enforcement: ENFORCE minimum instead of rejecting
 	// This ensures we always use at least the minimum notional size for visible profits
 	// STRICT REQUIREMENT: min_position_size_usd MUST be configured - NO FALLBACK
-	if modeConfig == nil || modeConfig.Size == nil || modeConfig.Size.MinPositionSizeUSD <= 0 {
-		ga.logger.Error("Minimum position size not configured - cannot trade",
-			"mode", mode,
-			"reason", "min_position_size_usd not set in user settings")
-		return 0, false, fmt.Sprintf("mode %s min_position_size_usd not configured - skipping trade", mode)
+	if modeConfig.Size.MinPositionSizeUSD <= 0 {
+		return misconfigured(fmt.Sprintf("mode %s min_position_size_usd not configured - skipping trade", mode))
 	}
-	minPositionSize := modeConfig.Size.MinPositionSizeUSD
+	s.MinPositionSizeUSD = modeConfig.Size.MinPositionSizeUSD
 
 	// If calculated position is below minimum, enforce the minimum
-	if positionUSD < minPositionSize {
+	if positionUSD < s.MinPositionSizeUSD {
 		// Check if we can afford the minimum position (considering leverage)
-		if minPositionSize > availableNotional*0.9 {
-			return 0, false, fmt.Sprintf("insufficient balance for minimum position: need $%.2f notional, have $%.2f available (margin: $%.2f x leverage: %dx)",
-				minPositionSize, availableNotional*0.9, usableBalance, leverage)
+		if s.MinPositionSizeUSD > s.AvailableNotional*0.9 {
+			return reject(fmt.Sprintf("insufficient balance for minimum position: need $%.2f notional, have $%.2f available (margin: $%.2f x leverage: %dx)",
+				s.MinPositionSizeUSD, s.AvailableNotional*0.9, s.UsableBalance, s.Leverage))
 		}
 		// Also check against max size cap
-		if minPositionSize > maxSizeUSD {
-			return 0, false, fmt.Sprintf("minimum position $%.2f exceeds max size $%.2f for %s", minPositionSize, maxSizeUSD, symbol)
+		if s.MinPositionSizeUSD > s.MaxSizeUSD {
+			return reject(fmt.Sprintf("minimum position $%.2f exceeds max size $%.2f for %s", s.MinPositionSizeUSD, s.MaxSizeUSD, symbol))
 		}
-		ga.logger.Info("Enforcing minimum position size",
-			"symbol", symbol,
-			"mode", mode,
-			"calculated_usd", fmt.Sprintf("$%.2f", positionUSD),
-			"enforced_min_usd", fmt.Sprintf("$%.2f", minPositionSize))
-		positionUSD = minPositionSize
+		s.MinSizeEnforced = true
+		positionUSD = s.MinPositionSizeUSD
 	}
 
-	// Determine sizing method for logging
-	sizingMethod := "formula"
-	if useLLMSize {
-		sizingMethod = "ai_llm"
-	}
-
-	ga.logger.Info("Adaptive position sizing",
-		"mode", mode,
-		"sizing_method", sizingMethod,
-		"available_balance", fmt.Sprintf("$%.2f", availableBalance),
-		"usable_balance", fmt.Sprintf("$%.2f", usableBalance),
-		"leverage", fmt.Sprintf("%dx", leverage),
-		"available_notional", fmt.Sprintf("$%.2f", availableNotional),
-		"safety_margin", fmt.Sprintf("%.0f%%", safetyMargin*100),
-		"current_positions", currentPositionCount,
-		"available_slots", availableSlots,
-		"base_allocation", fmt.Sprintf("$%.2f", baseAllocationPerPosition),
-		"risk_level", ga.currentRiskLevel,
-		"risk_multiplier", fmt.Sprintf("%.2f", riskMultiplier),
-		"confidence", fmt.Sprintf("%.1f%%", confidence),
-		"confidence_multiplier", fmt.Sprintf("%.2f", confidenceMultiplier),
-		"llm_suggested_size", fmt.Sprintf("$%.2f", llmSuggestedSize),
-		"auto_size_enabled", autoSizeEnabled,
-		"max_size_usd", fmt.Sprintf("$%.2f", maxSizeUSD),
-		"final_position_usd", fmt.Sprintf("$%.2f", positionUSD))
-
-	return positionUSD, true, ""
+	s.PositionUSD = positionUSD
+	s.CanTrade = true
+	return s
 }
 
 // ==================== FUNDING RATE AWARENESS ====================
@@ -4581,7 +5357,7 @@ func (ga *GinieAutopilot) executeStagedEntryOrder(pos *GiniePosition, quantity f
 			Quantity:     quantity,
 		}
 
-		order, err := ga.futuresClient.PlaceFuturesOrder(orderParams)
+		order, err := ga.placeFuturesOrder(orderParams)
 		if err != nil {
 			ga.logger.Error("Staged entry order failed",
 				"symbol", symbol,
@@ -4658,12 +5434,17 @@ func (ga *GinieAutopilot) executeStagedEntryOrder(pos *GiniePosition, quantity f
 // Returns (success bool, reason string) where:
 // - success=true: order was placed (may be MARKET fill or pending LIMIT)
 // - success=false: trade was rejected with reason
-func (ga *GinieAutopilot) executeTradeWithResult(decision *GinieDecisionReport) (bool, string) {
+func (ga *GinieAutopilot) executeTradeWithResult(decision *GinieDecisionReport) (opened bool, outcome string) {
 	// STANDBY CHECK: Block trade execution if this instance is in standby mode (Story 9.6)
 	if err := ga.requireActiveWithSymbol(decision.Symbol, "trade entry"); err != nil {
 		return false, "instance_standby"
 	}
 
+	// Hold the symbol lock for the whole entry so a monitor pass, close or level
+	// edit for the same symbol cannot interleave with it
+	unlockSymbol := ga.symbolLocks.Lock(decision.Symbol)
+	defer unlockSymbol()
+
 	ga.mu.Lock()
 	defer ga.mu.Unlock()
 
@@ -4677,6 +5458,22 @@ func (ga *GinieAutopilot) executeTradeWithResult(decision *GinieDecisionReport)
 		return false, fmt.Sprintf("coin_blocked: %s", reason)
 	}
 
+	// Re-checked under the lock: the toggle may have flipped since the scan's canTrade
+	if ga.config.ManageOnlyMode {
+		ga.logger.Warn("Ginie skipping trade - manage-only mode", "symbol", symbol)
+		return false, RejectionManageOnly
+	}
+
+	// Pacing: skip before sizing if this minute's entry budget is already spent
+	if ga.openRateLimitedLocked(time.Now()) {
+		ga.rateLimitedOpens++
+		ga.logger.Warn("Ginie skipping trade - rate_limited_opens",
+			"symbol", symbol,
+			"max_positions_per_minute", ga.config.MaxPositionsPerMinute,
+			"rate_limited_total", ga.rateLimitedOpens)
+		return false, "rate_limited_opens"
+	}
+
 	// CRITICAL: Skip trades where action is WAIT or CLOSE - these are not entry signals
 	action := decision.TradeExecution.Action
 	if action != "LONG" && action != "SHORT" {
@@ -4805,6 +5602,14 @@ func (ga *GinieAutopilot) executeTradeWithResult(decision *GinieDecisionReport)
 		return false, "position_exists" // Still skip opening new position if one exists
 	}
 
+	// Notional exposure cap: no room left means no point sizing
+	if ok, notionalReason := ga.checkNotionalCapLocked(0); !ok {
+		ga.logger.Warn("Ginie cannot trade - notional exposure cap reached",
+			"symbol", symbol,
+			"reason", notionalReason)
+		return false, notionalReason
+	}
+
 	// Capture MODE-SPECIFIC position count while holding lock for adaptive sizing
 	// BUG FIX: Previously used total position count, but mode-specific max requires mode-specific count
 	modePositionCount := 0
@@ -4834,12 +5639,30 @@ func (ga *GinieAutopilot) executeTradeWithResult(decision *GinieDecisionReport)
 	ga.mu.Lock()
 
 	// CRITICAL: Re-check position doesn't exist after re-acquiring lock
-	// Another goroutine could have opened a position for this symbol while we were unlocked
+	// Other entries wait on the symbol lock, but exchange sync adds positions without it
 	if _, exists := ga.positions[symbol]; exists {
 		ga.logger.Warn("Ginie race condition avoided - position created while sizing",
 			"symbol", symbol)
 		return false, "race_condition_position_created"
 	}
+	if ga.makerEntriesInFlight[symbol] {
+		return false, "maker_entry_in_progress"
+	}
+
+	// Claim this entry's slot now; other entries may have opened while we were sizing
+	releaseOpen, ok := ga.reserveOpenLocked(time.Now())
+	if !ok {
+		ga.logger.Warn("Ginie skipping trade - rate_limited_opens",
+			"symbol", symbol,
+			"max_positions_per_minute", ga.config.MaxPositionsPerMinute,
+			"rate_limited_total", ga.rateLimitedOpens)
+		return false, "rate_limited_opens"
+	}
+	defer func() {
+		if !opened {
+			releaseOpen() // Runs before the deferred ga.mu.Unlock
+		}
+	}()
 
 	if !canTrade {
 		ga.logger.Warn("Ginie cannot trade - adaptive sizing rejected",
@@ -4874,6 +5697,9 @@ func (ga *GinieAutopilot) executeTradeWithResult(decision *GinieDecisionReport)
 	if leverage == 0 {
 		leverage = ga.config.DefaultLeverage
 	}
+	if ga.config.EquityScalingEnabled {
+		leverage = ga.scaleLeverageForEquity(leverage)
+	}
 
 	// [Story 9.9] Position optimization sizing: Use minimum 10x leverage for positions
 	// with position optimization enabled (any mode can have position_optimization)
@@ -4900,6 +5726,15 @@ func (ga *GinieAutopilot) executeTradeWithResult(decision *GinieDecisionReport)
 		return false, "zero_quantity"
 	}
 
+	// Re-check the cap with the sized trade (full target, so staged levels can't exceed it)
+	if ok, notionalReason := ga.checkNotionalCapLocked(quantity * price); !ok {
+		ga.logger.Warn("Ginie cannot trade - trade would exceed notional exposure cap",
+			"symbol", symbol,
+			"notional_usd", quantity*price,
+			"reason", notionalReason)
+		return false, notionalReason
+	}
+
 	// === 3-LEVEL STAGED ENTRY: Reduce initial quantity if enabled ===
 	// Check if staged entry is enabled for this mode
 	var stagedEntryActive bool
@@ -4979,6 +5814,8 @@ func (ga *GinieAutopilot) executeTradeWithResult(decision *GinieDecisionReport)
 	actualPrice := price
 	actualQty := quantity
 	var entryOrderId int64 // Story 7.11: Track entry order ID for position state
+	var entryWasMaker bool  // Maker-first entries: fee accounting for the entry leg
+	var entryFeeUSD float64
 
 	// Epic 7: Generate clientOrderId for entry order tracking
 	// This must be generated BEFORE any order is placed so all entry types use the same baseID
@@ -4991,6 +5828,8 @@ func (ga *GinieAutopilot) executeTradeWithResult(decision *GinieDecisionReport)
 			"baseID", clientOrderBaseID)
 	}
 
+	entryMarginType := ga.modeMarginType(decision.SelectedMode)
+
 	if !ga.config.DryRun {
 		// Set leverage first
 		_, err = ga.futuresClient.SetLeverage(symbol, leverage)
@@ -4999,9 +5838,20 @@ func (ga *GinieAutopilot) executeTradeWithResult(decision *GinieDecisionReport)
 			return false, fmt.Sprintf("leverage_failed: %v", err)
 		}
 
+		// Then the mode's margin type (sltp.margin_type)
+		entryMarginType, err = ga.applyMarginType(symbol, decision.SelectedMode)
+		if err != nil {
+			ga.logger.Error("Failed to set margin type", "symbol", symbol, "mode", decision.SelectedMode, "error", err.Error())
+			return false, fmt.Sprintf("margin_type_failed: %v", err)
+		}
+
+		// entry.entry_order_type: MARKET skips every LIMIT entry, LIMIT forces the
+		// prev-candle LIMIT and falls back to MARKET if it can't be placed
+		entryOrderType := ga.entryOrderType(decision.SelectedMode)
+
 		// === REVERSAL LIMIT ORDER HANDLING ===
 		// If this is a reversal entry, place LIMIT order and track for timeout
-		if decision.TradeExecution.UseReversal && decision.TradeExecution.EntryType == "LIMIT" {
+		if decision.TradeExecution.UseReversal && decision.TradeExecution.EntryType == "LIMIT" && entryOrderType != ExecOrderTypeMarket {
 			limitPrice := decision.TradeExecution.LimitEntryPrice
 			if limitPrice <= 0 {
 				ga.logger.Error("Invalid LIMIT price for reversal entry", "symbol", symbol, "price", limitPrice)
@@ -5068,6 +5918,12 @@ func (ga *GinieAutopilot) executeTradeWithResult(decision *GinieDecisionReport)
 		if modeConfig := ga.getModeConfig(decision.SelectedMode); modeConfig != nil && modeConfig.Reversal != nil {
 			useMarketEntry = modeConfig.Reversal.UseMarketEntry
 		}
+		switch entryOrderType {
+		case ExecOrderTypeMarket:
+			useMarketEntry = true
+		case ExecOrderTypeLimit:
+			useMarketEntry = false
+		}
 
 		// === LIMIT ORDER ENTRY AT PREVIOUS CANDLE EXTREME ===
 		// For ALL modes: Place LIMIT order at previous candle's low (LONG) or high (SHORT)
@@ -5079,54 +5935,13 @@ func (ga *GinieAutopilot) executeTradeWithResult(decision *GinieDecisionReport)
 		if !useMarketEntry {
 			limitEntryPrice, priceErr = ga.getPrevCandleEntryPrice(symbol, decision.SelectedMode, isLongTrade)
 		} else {
-			priceErr = fmt.Errorf("UseMarketEntry enabled - skipping LIMIT order")
-			ga.logger.Info("UseMarketEntry enabled - using MARKET order for immediate fill",
-				"symbol", symbol,
-				"mode", decision.SelectedMode)
-		}
-		if priceErr != nil {
-			ga.logger.Error("Failed to get prev candle entry price, falling back to MARKET order",
+			priceErr = fmt.Errorf("MARKET entry configured - skipping LIMIT order")
+			ga.logger.Info("MARKET entry configured - using MARKET order for immediate fill",
 				"symbol", symbol,
 				"mode", decision.SelectedMode,
-				"error", priceErr.Error())
-
-			// Fallback to MARKET order if we can't get prev candle price
-			// Include clientOrderId (Epic 7) for trade tracking
-			orderParams := binance.FuturesOrderParams{
-				Symbol:           symbol,
-				Side:             side,
-				PositionSide:     effectivePositionSide,
-				Type:             binance.FuturesOrderTypeMarket,
-				Quantity:         quantity,
-				NewClientOrderId: entryClientOrderId,
-			}
-
-			order, err := ga.futuresClient.PlaceFuturesOrder(orderParams)
-			if err != nil {
-				ga.logger.Error("Ginie MARKET trade execution failed", "symbol", symbol, "error", err.Error())
-				return false, fmt.Sprintf("market_order_failed: %v", err)
-			}
-
-			// Verify order fill
-			fillPrice, fillQty, fillErr := ga.verifyOrderFill(order, quantity)
-			if fillErr != nil {
-				ga.logger.Error("Ginie order fill verification failed",
-					"symbol", symbol,
-					"order_id", order.OrderId,
-					"error", fillErr.Error())
-				return false, fmt.Sprintf("order_fill_verification_failed: %v", fillErr)
-			}
-
-			actualPrice = fillPrice
-			actualQty = fillQty
-			entryOrderId = order.OrderId // Story 7.11: Capture for position state
-
-			ga.logger.Info("Ginie MARKET trade executed (fallback)",
-				"symbol", symbol,
-				"order_id", order.OrderId,
-				"side", side,
-				"fill_price", actualPrice)
-		} else {
+				"entry_order_type", entryOrderType)
+		}
+		if priceErr == nil {
 			// Round limit price to symbol precision
 			limitEntryPrice = roundPrice(symbol, limitEntryPrice)
 
@@ -5151,7 +5966,14 @@ func (ga *GinieAutopilot) executeTradeWithResult(decision *GinieDecisionReport)
 			}
 
 			limitOrder, err := ga.futuresClient.PlaceFuturesOrder(limitOrderParams)
-			if err != nil {
+			if err != nil && entryOrderType == ExecOrderTypeLimit {
+				// Explicit LIMIT entries fall through to the MARKET path below
+				ga.logger.Warn("Ginie LIMIT order failed - falling back to MARKET order",
+					"symbol", symbol,
+					"limit_price", limitEntryPrice,
+					"error", err.Error())
+				priceErr = err
+			} else if err != nil {
 				ga.logger.Error("Ginie LIMIT order failed - not falling back to MARKET order",
 					"symbol", symbol,
 					"limit_price", limitEntryPrice,
@@ -5196,6 +6018,73 @@ func (ga *GinieAutopilot) executeTradeWithResult(decision *GinieDecisionReport)
 				return true, "limit_order_pending"
 			}
 		}
+
+		if priceErr != nil {
+			ga.logger.Error("No prev candle LIMIT entry - falling back to MARKET order",
+				"symbol", symbol,
+				"mode", decision.SelectedMode,
+				"error", priceErr.Error())
+
+			// Prefer a post-only maker fill when the mode allows a few seconds of latency
+			preferMaker, makerTimeout := ga.makerEntrySettings(decision.SelectedMode)
+			if preferMaker {
+				// Release the lock while the maker order rests; the in-flight marker keeps
+				// other entries for this symbol out until the position is recorded
+				ga.markMakerEntry(symbol, true)
+				ga.mu.Unlock()
+				makerResult, makerErr := ga.placeMakerFirstEntry(symbol, side, effectivePositionSide, quantity, makerTimeout, entryClientOrderId)
+				ga.mu.Lock()
+				ga.markMakerEntry(symbol, false)
+
+				if makerErr != nil {
+					ga.logger.Error("Ginie maker-first entry failed", "symbol", symbol, "error", makerErr.Error())
+					return false, fmt.Sprintf("maker_entry_failed: %v", makerErr)
+				}
+
+				actualPrice = makerResult.AvgPrice()
+				actualQty = makerResult.Qty()
+				entryOrderId = makerResult.OrderID
+				entryWasMaker = makerResult.WasMaker()
+				entryFeeUSD = makerResult.FeeUSD
+			} else {
+				// Fallback to MARKET order if we can't get prev candle price
+				// Include clientOrderId (Epic 7) for trade tracking
+				orderParams := binance.FuturesOrderParams{
+					Symbol:           symbol,
+					Side:             side,
+					PositionSide:     effectivePositionSide,
+					Type:             binance.FuturesOrderTypeMarket,
+					Quantity:         quantity,
+					NewClientOrderId: entryClientOrderId,
+				}
+
+				order, err := ga.placeFuturesOrder(orderParams)
+				if err != nil {
+					ga.logger.Error("Ginie MARKET trade execution failed", "symbol", symbol, "error", err.Error())
+					return false, fmt.Sprintf("market_order_failed: %v", err)
+				}
+
+				// Verify order fill
+				fillPrice, fillQty, fillErr := ga.verifyOrderFill(order, quantity)
+				if fillErr != nil {
+					ga.logger.Error("Ginie order fill verification failed",
+						"symbol", symbol,
+						"order_id", order.OrderId,
+						"error", fillErr.Error())
+					return false, fmt.Sprintf("order_fill_verification_failed: %v", fillErr)
+				}
+
+				actualPrice = fillPrice
+				actualQty = fillQty
+				entryOrderId = order.OrderId // Story 7.11: Capture for position state
+
+				ga.logger.Info("Ginie MARKET trade executed (fallback)",
+					"symbol", symbol,
+					"order_id", order.OrderId,
+					"side", side,
+					"fill_price", actualPrice)
+			}
+		}
 	}
 
 	// Create position record with ACTUAL fill price and quantity
@@ -5216,6 +6105,7 @@ func (ga *GinieAutopilot) executeTradeWithResult(decision *GinieDecisionReport)
 		OriginalQty:           actualQty,
 		RemainingQty:          actualQty,
 		Leverage:              leverage,
+		MarginType:            string(entryMarginType),
 		EntryTime:             time.Now(),
 		TakeProfits:           takeProfits,
 		CurrentTPLevel:        0,
@@ -5228,9 +6118,14 @@ func (ga *GinieAutopilot) executeTradeWithResult(decision *GinieDecisionReport)
 		TrailingPercent:       trailingPercent,
 		TrailingActivationPct: trailingActivation, // Now properly initialized from Mode Config
 		DecisionReport:        decision,
-		Source:                "ai",                  // AI-based trade
+		Source:                PositionSourceAI,      // AI-based trade
+		Confidence:            decision.ConfidenceScore,
+		EntryReason:           decisionEntryReason(decision),
 		Protection:            NewProtectionStatus(), // Initialize bulletproof protection tracking
 		ChainBaseID:     clientOrderBaseID,     // Epic 7: Store for linking SL/TP/DCA orders
+		EntryWasMaker:         entryWasMaker,
+		EntryFeeUSD:           entryFeeUSD,
+		TotalFeesUSD:          entryFeeUSD,
 		// === 3-LEVEL STAGED ENTRY TRACKING ===
 		StagedEntryActive:    stagedEntryActive,
 		StagedEntryLevel:     1, // First level
@@ -5243,6 +6138,7 @@ func (ga *GinieAutopilot) executeTradeWithResult(decision *GinieDecisionReport)
 	}
 
 	ga.positions[symbol] = position
+	ga.publishPositionEvent(events.EventGiniePositionOpened, position, map[string]interface{}{"mode": position.Mode, "source": position.Source})
 	ga.dailyTrades++
 	ga.totalTrades++
 
@@ -5317,13 +6213,17 @@ func (ga *GinieAutopilot) executeTradeWithResult(decision *GinieDecisionReport)
 				EntryPrice:   actualPrice,
 				Quantity:     actualQty,
 				Leverage:     leverage,
-				MarginType:   "CROSSED",
+				MarginType:   string(entryMarginType),
 				Status:       "OPEN",
 				EntryTime:    time.Now(),
 				TradeSource:  "ginie",
 				TradingMode:  &tradingMode,
+				Tags:         ga.dryRunTradeTags(),
 			}
-			if err := ga.repo.CreateFuturesTrade(context.Background(), trade); err != nil {
+			ctx, cancel := context.WithTimeout(context.Background(), dbWriteTimeout)
+			err := ga.repo.CreateFuturesTrade(ctx, trade)
+			cancel()
+			if err != nil {
 				ga.logger.Warn("Failed to create futures trade record", "error", err, "symbol", symbol)
 			} else {
 				tradeID = trade.ID
@@ -5378,6 +6278,7 @@ func (ga *GinieAutopilot) executeTradeWithResult(decision *GinieDecisionReport)
 	// Place SL/TP orders on Binance (if not dry run)
 	if !ga.config.DryRun {
 		position.Protection.SetState(StatePlacingSL)
+		position.Protection.UnprotectedSince = time.Now() // Protection timeout counts from the fill
 		ga.placeSLTPOrders(position)
 
 		// CRITICAL: Verify protection was established
@@ -5393,14 +6294,6 @@ func (ga *GinieAutopilot) executeTradeWithResult(decision *GinieDecisionReport)
 		position.Protection.SetState(StateFullyProtected)
 	}
 
-	// Build signal names for summary
-	signalNames := make([]string, 0)
-	for _, sig := range decision.SignalAnalysis.PrimarySignals {
-		if sig.Met {
-			signalNames = append(signalNames, sig.Name)
-		}
-	}
-
 	// Build TP prices array from recalculated takeProfits (not stale decision values)
 	tpPrices := make([]float64, len(takeProfits))
 	for i, tp := range takeProfits {
@@ -5426,14 +6319,7 @@ func (ga *GinieAutopilot) executeTradeWithResult(decision *GinieDecisionReport)
 			Volume:     decision.MarketConditions.Volume,
 			BTCCorr:    decision.MarketConditions.BTCCorr,
 		},
-		SignalSummary: &GinieSignalSummary{
-			Direction:       decision.SignalAnalysis.Direction,
-			Strength:        decision.SignalAnalysis.SignalStrength,
-			StrengthScore:   decision.SignalAnalysis.StrengthScore,
-			PrimaryMet:      decision.SignalAnalysis.PrimaryMet,
-			PrimaryRequired: decision.SignalAnalysis.PrimaryRequired,
-			SignalNames:     signalNames,
-		},
+		SignalSummary: signalSummaryFromDecision(decision),
 		EntryParams: &GinieEntryParams{
 			EntryPrice:  actualPrice, // Use actual fill price, not estimated
 			StopLoss:    decision.TradeExecution.StopLoss,
@@ -5492,7 +6378,18 @@ func (ga *GinieAutopilot) runPositionMonitor() {
 					"scan_count", scanCount,
 					"positions", posCount)
 			}
-			ga.monitorAllPositions()
+			// Exchange down for maintenance: skip price checks, reconciliation and
+			// order cleanup until the ping answers again
+			ga.checkExchangeStatusIfDue()
+			if ga.inMaintenanceHold() {
+				continue
+			}
+
+			ga.monitorAllPositions()
+
+			// Re-arm the dead-man's switch in the monitor loop itself, so a hung
+			// monitor lets the countdown expire
+			ga.refreshDeadManSwitch()
 
 			// Reconcile positions with Binance every 30 seconds (6 scans * 5 seconds)
 			// This catches positions closed manually or modified externally
@@ -5539,369 +6436,419 @@ func (ga *GinieAutopilot) monitorAllPositions() {
 	// PHASE 2: Fetch prices OUTSIDE the lock (network calls)
 	prices := make(map[string]float64)
 	for _, snap := range snapshots {
-		currentPrice, err := ga.futuresClient.GetFuturesCurrentPrice(snap.symbol)
+		currentPrice, err := ga.exitCheckPrice(snap.symbol)
 		if err != nil {
+			ga.recordExchangeError(err)
 			continue
 		}
 		prices[snap.symbol] = currentPrice
 	}
 
-	// PHASE 3: Process positions with lock for state updates
+	// Liquidation prices for auto-deleverage (nil when it's off)
+	liquidationQuotes := ga.fetchLiquidationQuotes()
+
+	// PHASE 3: Process each position under its symbol lock, so entries, closes and
+	// edits for the same symbol wait for this pass instead of interleaving with it
 	for _, snap := range snapshots {
-		symbol := snap.symbol
-		currentPrice, ok := prices[symbol]
+		currentPrice, ok := prices[snap.symbol]
 		if !ok {
 			continue
 		}
-
-		// Acquire lock for this position's state update
-		ga.mu.Lock()
-		pos, exists := ga.positions[symbol]
-		if !exists {
-			ga.mu.Unlock()
-			continue
-		}
-
-		// === 3-LEVEL STAGED ENTRY CHECK ===
-		// Check if position needs more staged entries at improved prices
-		if pos.StagedEntryActive {
-			ga.checkAndExecuteStagedEntry(pos)
+		if liquidationQuotes != nil {
+			ga.checkAutoDeleverage(snap.symbol, currentPrice, liquidationQuotes)
 		}
+		ga.monitorPosition(snap.symbol, currentPrice)
+	}
+}
 
-		// === POSITION OPTIMIZATION ACTIVATION ===
-		// [Story 9.9] Activate position optimization for ANY mode with position_optimization enabled
-		// Position mode NEVER changes - scalp stays scalp, swing stays swing
-		// Position optimization is a FEATURE FLAG per-mode, not a mode change
-		if pos.ScalpReentry == nil {
-			posOptConfig := ga.getModeConfig(pos.Mode)
-			if posOptConfig != nil && posOptConfig.PositionOptimization != nil && posOptConfig.PositionOptimization.Enabled {
-				pos.ScalpReentry = ga.initPositionOptimizationFromModeConfig(pos, posOptConfig.PositionOptimization)
-				log.Printf("[POS-OPTIMIZATION] %s [%s]: Activated position optimization", symbol, pos.Mode)
-				log.Printf("[POS-OPTIMIZATION] %s: Entry=%.8f, Qty=%.4f, Side=%s - now using progressive TP",
-					symbol, pos.EntryPrice, pos.OriginalQty, pos.Side)
-			}
-		}
+// monitorPosition runs one position's breakeven, trailing, SL and TP checks. It
+// holds the symbol lock throughout; ga.mu is released around network calls, but
+// every path that removes a position takes the symbol lock, so pos stays tracked.
+func (ga *GinieAutopilot) monitorPosition(symbol string, currentPrice float64) {
+	unlockSymbol := ga.symbolLocks.Lock(symbol)
+	defer unlockSymbol()
 
-		// === POSITION OPTIMIZATION MONITORING ===
-		// [Story 9.9] Check if position optimization is active by checking ScalpReentry != nil
-		// This replaces the old mode-based check (pos.Mode == GinieModeScalpReentry)
-		if pos.ScalpReentry != nil {
-			ga.mu.Unlock()
-			if err := ga.monitorPositionOptimization(pos); err != nil {
-				log.Printf("[POS-OPTIMIZATION-MONITOR] %s: Error monitoring position: %v", symbol, err)
-			}
-			continue // Skip regular monitoring - position optimization has its own logic
-		}
+	// Acquire lock for this position's state update
+	ga.mu.Lock()
+	pos, exists := ga.positions[symbol]
+	if !exists {
+		ga.mu.Unlock()
+		return
+	}
 
-		// Update high/low tracking
-		if currentPrice > pos.HighestPrice {
-			pos.HighestPrice = currentPrice
-		}
-		if currentPrice < pos.LowestPrice {
-			pos.LowestPrice = currentPrice
-		}
+	// Track MAE/MFE for every position, including optimized ones below
+	pos.updateExcursions(currentPrice)
 
-		// Calculate current PnL
-		var pnlPercent float64
-		if pos.EntryPrice <= 0 {
-			pnlPercent = 0
-			pos.UnrealizedPnL = 0
-		} else if pos.Side == "LONG" {
-			pnlPercent = (currentPrice - pos.EntryPrice) / pos.EntryPrice * 100
+	// Synced positions awaiting adoption are only watched - no SL/TP, trailing or exits
+	if pos.AwaitingAdoption {
+		if pos.Side == "LONG" {
 			pos.UnrealizedPnL = (currentPrice - pos.EntryPrice) * pos.RemainingQty
 		} else {
-			pnlPercent = (pos.EntryPrice - currentPrice) / pos.EntryPrice * 100
 			pos.UnrealizedPnL = (pos.EntryPrice - currentPrice) * pos.RemainingQty
 		}
+		ga.mu.Unlock()
+		return
+	}
 
-		// === STALE POSITION RELEASE ===
-		// Close positions that have exceeded their max hold duration
-		if shouldClose, holdDuration, maxHold := ga.shouldCloseStalePosition(pos); shouldClose {
-			log.Printf("[STALE-RELEASE] %s: Position held %.1f mins exceeds max %.1f mins - CLOSING",
-				symbol, holdDuration.Minutes(), maxHold.Minutes())
-			ga.logger.Info("Stale position release triggered",
-				"symbol", symbol,
-				"hold_duration", holdDuration.Round(time.Second),
-				"max_hold", maxHold,
-				"pnl_percent", pnlPercent,
-				"mode", pos.Mode)
-			ga.mu.Unlock()
-			ga.closePosition(symbol, pos, currentPrice, "stale_release", pos.CurrentTPLevel)
-			continue
+	// === 3-LEVEL STAGED ENTRY CHECK ===
+	// Check if position needs more staged entries at improved prices
+	if pos.StagedEntryActive {
+		ga.checkAndExecuteStagedEntry(pos)
+	}
+
+	// === POSITION OPTIMIZATION ACTIVATION ===
+	// [Story 9.9] Activate position optimization for ANY mode with position_optimization enabled
+	// Position mode NEVER changes - scalp stays scalp, swing stays swing
+	// Position optimization is a FEATURE FLAG per-mode, not a mode change
+	if pos.ScalpReentry == nil {
+		posOptConfig := ga.getModeConfig(pos.Mode)
+		if posOptConfig != nil && posOptConfig.PositionOptimization != nil && posOptConfig.PositionOptimization.Enabled {
+			pos.ScalpReentry = ga.initPositionOptimizationFromModeConfig(pos, posOptConfig.PositionOptimization)
+			log.Printf("[POS-OPTIMIZATION] %s [%s]: Activated position optimization", symbol, pos.Mode)
+			log.Printf("[POS-OPTIMIZATION] %s: Entry=%.8f, Qty=%.4f, Side=%s - now using progressive TP",
+				symbol, pos.EntryPrice, pos.OriginalQty, pos.Side)
 		}
+	}
 
-		// === FUNDING RATE EARLY EXIT ===
-		// Check if we should exit before funding payment to save fees
-		if shouldExit, reason := ga.shouldExitBeforeFunding(pos); shouldExit {
-			ga.logger.Info("Exiting position before funding",
-				"symbol", symbol,
-				"pnl", pos.UnrealizedPnL,
-				"pnl_percent", pnlPercent,
-				"reason", reason)
-			ga.mu.Unlock()
-			ga.closePosition(symbol, pos, currentPrice, "funding_rate_exit", pos.CurrentTPLevel)
-			continue
+	// === POSITION OPTIMIZATION MONITORING ===
+	// [Story 9.9] Check if position optimization is active by checking ScalpReentry != nil
+	// This replaces the old mode-based check (pos.Mode == GinieModeScalpReentry)
+	if pos.ScalpReentry != nil {
+		ga.mu.Unlock()
+		if err := ga.monitorPositionOptimization(pos); err != nil {
+			log.Printf("[POS-OPTIMIZATION-MONITOR] %s: Error monitoring position: %v", symbol, err)
 		}
+		return // Skip regular monitoring - position optimization has its own logic
+	}
 
-		// === PROACTIVE PROFIT PROTECTION (NEW - fixes BCHUSDT-style losses) ===
+	// Update high/low tracking
+	if currentPrice > pos.HighestPrice {
+		pos.HighestPrice = currentPrice
+	}
+	if currentPrice < pos.LowestPrice {
+		pos.LowestPrice = currentPrice
+	}
 
-		// Log position status for debugging
-		if pnlPercent > 0.3 {
-			log.Printf("[GINIE-MONITOR] %s: PnL=%.2f%%, TrailingActive=%v, MovedToBreakeven=%v, TPLevel=%d, SL=%.4f",
-				symbol, pnlPercent, pos.TrailingActive, pos.MovedToBreakeven, pos.CurrentTPLevel, pos.StopLoss)
-		}
+	// Calculate current PnL
+	var pnlPercent float64
+	if pos.EntryPrice <= 0 {
+		pnlPercent = 0
+		pos.UnrealizedPnL = 0
+	} else if pos.Side == "LONG" {
+		pnlPercent = (currentPrice - pos.EntryPrice) / pos.EntryPrice * 100
+		pos.UnrealizedPnL = (currentPrice - pos.EntryPrice) * pos.RemainingQty
+	} else {
+		pnlPercent = (pos.EntryPrice - currentPrice) / pos.EntryPrice * 100
+		pos.UnrealizedPnL = (pos.EntryPrice - currentPrice) * pos.RemainingQty
+	}
 
-		// 1. Proactive breakeven: Move SL to entry when profit >= threshold (before TP1)
-		// NOTE: If ProactiveBreakevenPercent is 0, this feature is disabled
-		if ga.config.ProactiveBreakevenPercent > 0 && !pos.MovedToBreakeven && pnlPercent >= ga.config.ProactiveBreakevenPercent && pos.CurrentTPLevel == 0 {
-			log.Printf("[GINIE-MONITOR] %s: Triggering proactive breakeven at %.2f%% profit", symbol, pnlPercent)
-			ga.logger.Info("Proactive breakeven triggered",
-				"symbol", pos.Symbol,
-				"pnl_percent", pnlPercent,
-				"threshold", ga.config.ProactiveBreakevenPercent)
-			breakevenReason := fmt.Sprintf("Proactive breakeven at %.2f%% profit (before TP1)", pnlPercent)
-			ga.moveToBreakeven(pos, breakevenReason)
-			// FIX: Release lock BEFORE network call to prevent blocking GetStatus API
-			ga.mu.Unlock()
-			// Story 7.12: Track breakeven move as LLM_AUTO modification
-			ga.updateBinanceSLOrderWithReason(pos, orders.ModificationSourceLLMAuto, breakevenReason)
-			// Re-acquire lock and check if position still exists
-			ga.mu.Lock()
-			pos, exists = ga.positions[symbol]
-			if !exists {
-				ga.mu.Unlock()
-				continue
-			}
-		}
+	// Early warning before the SL hits
+	ga.checkLossAlertLocked(pos, currentPrice, pnlPercent)
 
-		// 2. Trailing SL: Activate ONLY after TP1 hit AND SL moved to breakeven (for swing/position)
-		// Ultra-fast and Scalp modes: NO trailing (disabled in settings)
-		if !pos.TrailingActive {
-			settingsManager := GetSettingsManager()
-			settings, settingsLoadErr := settingsManager.LoadSettings()
-	if settingsLoadErr != nil {
-		log.Printf("[SETTINGS] ERROR: Failed to load settings: %v", settingsLoadErr)
+	// === STALE POSITION RELEASE ===
+	// Close positions that have exceeded their max hold duration
+	if shouldClose, holdDuration, maxHold := ga.shouldCloseStalePosition(pos); shouldClose {
+		log.Printf("[STALE-RELEASE] %s: Position held %.1f mins exceeds max %.1f mins - CLOSING",
+			symbol, holdDuration.Minutes(), maxHold.Minutes())
+		ga.logger.Info("Stale position release triggered",
+			"symbol", symbol,
+			"hold_duration", holdDuration.Round(time.Second),
+			"max_hold", maxHold,
+			"pnl_percent", pnlPercent,
+			"mode", pos.Mode)
+		ga.mu.Unlock()
+		ga.closePosition(symbol, pos, currentPrice, "stale_release", pos.CurrentTPLevel)
 		return
 	}
 
-			// Check if trailing is enabled for this mode (read from ModeConfigs)
-			// [Story 9.9] Removed scalp_reentry - positions use their original mode's config
-			modeToConfigKey := map[string]string{
-				string(GinieModeUltraFast): "ultra_fast",
-				string(GinieModeScalp):     "scalp",
-				string(GinieModeSwing):     "swing",
-				string(GinieModePosition):  "position",
-			}
-			trailingEnabled := false
-			if modeKey, ok := modeToConfigKey[string(pos.Mode)]; ok {
-				if modeConfig := settings.ModeConfigs[modeKey]; modeConfig != nil {
-					if modeConfig.SLTP != nil {
-						trailingEnabled = modeConfig.SLTP.TrailingStopEnabled
-					}
-				}
-			}
+	// === FUNDING RATE EARLY EXIT ===
+	// Check if we should exit before funding payment to save fees
+	if shouldExit, reason := ga.shouldExitBeforeFunding(pos); shouldExit {
+		ga.logger.Info("Exiting position before funding",
+			"symbol", symbol,
+			"pnl", pos.UnrealizedPnL,
+			"pnl_percent", pnlPercent,
+			"reason", reason)
+		ga.mu.Unlock()
+		ga.closePosition(symbol, pos, currentPrice, "funding_rate_exit", pos.CurrentTPLevel)
+		return
+	}
 
-			if trailingEnabled {
-				// Trailing activation conditions (multiple paths):
-				// 1. TP1 hit AND breakeven moved (conservative)
-				// 2. OR profit threshold reached (if TrailingActivationPct > 0)
-				canActivate := false
-				activationReason := ""
-
-				if pos.Mode == GinieModeSwing || pos.Mode == GinieModePosition {
-					// Allow activation via multiple conditions:
-					// 1. TP1 hit AND breakeven moved (conservative - protects after partial TP)
-					// 2. OR profit threshold reached (respects user's TrailingActivationPct setting)
-					if pos.CurrentTPLevel >= 1 && pos.MovedToBreakeven {
-						canActivate = true
-						activationReason = "after_tp1_and_breakeven"
-					} else if pos.TrailingActivationPct > 0 && pnlPercent >= pos.TrailingActivationPct {
-						// FIX: Allow profit-threshold activation even before TP1
-						// This prevents scenarios where price runs up significantly but trailing never activates
-						canActivate = true
-						activationReason = "profit_threshold"
-						log.Printf("[GINIE-TRAILING] %s: Activating via profit threshold (%.2f%% >= %.2f%%)",
-							symbol, pnlPercent, pos.TrailingActivationPct)
-					}
-				} else {
-					// For other modes (ultra-fast/scalp if enabled), use profit threshold
-					if pos.TrailingActivationPct > 0 && pnlPercent >= pos.TrailingActivationPct {
-						canActivate = true
-						activationReason = "profit_threshold"
-					}
-				}
+	// === PROACTIVE PROFIT PROTECTION (NEW - fixes BCHUSDT-style losses) ===
 
-				if canActivate {
-					pos.TrailingActive = true
-					ga.logger.Info("Trailing stop activated",
-						"symbol", pos.Symbol,
-						"mode", pos.Mode,
-						"reason", activationReason,
-						"tp_level", pos.CurrentTPLevel,
-						"at_breakeven", pos.MovedToBreakeven,
-						"pnl_percent", pnlPercent)
-
-					// Log trailing activation to trade lifecycle
-					if ga.eventLogger != nil && pos.FuturesTradeID > 0 {
-						go ga.eventLogger.LogTrailingActivated(
-							context.Background(),
-							pos.FuturesTradeID,
-							pos.Symbol,
-							string(pos.Mode),
-							activationReason,
-							currentPrice,
-							pnlPercent,
-							pos.CurrentTPLevel,
-						)
-					}
-				}
-			}
-		}
+	// Log position status for debugging
+	if pnlPercent > 0.3 {
+		log.Printf("[GINIE-MONITOR] %s: PnL=%.2f%%, TrailingActive=%v, MovedToBreakeven=%v, TPLevel=%d, SL=%.4f",
+			symbol, pnlPercent, pos.TrailingActive, pos.MovedToBreakeven, pos.CurrentTPLevel, pos.StopLoss)
+	}
+
+	// 1. Proactive breakeven: Move SL to entry when profit >= threshold (before TP1)
+	// NOTE: If ProactiveBreakevenPercent is 0, this feature is disabled
+	if ga.config.ProactiveBreakevenPercent > 0 && !pos.MovedToBreakeven && pnlPercent >= ga.config.ProactiveBreakevenPercent && pos.CurrentTPLevel == 0 {
+		log.Printf("[GINIE-MONITOR] %s: Triggering proactive breakeven at %.2f%% profit", symbol, pnlPercent)
+		ga.logger.Info("Proactive breakeven triggered",
+			"symbol", pos.Symbol,
+			"pnl_percent", pnlPercent,
+			"threshold", ga.config.ProactiveBreakevenPercent)
+		breakevenReason := fmt.Sprintf("Proactive breakeven at %.2f%% profit (before TP1)", pnlPercent)
+		ga.moveToBreakeven(pos, breakevenReason)
+		// FIX: Release lock BEFORE network call to prevent blocking GetStatus API
+		ga.mu.Unlock()
+		// Story 7.12: Track breakeven move as LLM_AUTO modification
+		ga.updateBinanceSLOrderWithReason(pos, orders.ModificationSourceLLMAuto, breakevenReason)
+		ga.mu.Lock()
+	}
 
-		// 3. Trail SL upward: Update SL as price moves favorably
-		// Use configured trailing percent (per-mode), or fall back to global config
-		trailingPercent := pos.TrailingPercent
-		if trailingPercent == 0 {
-			trailingPercent = ga.config.TrailingStepPercent
+	// 2. Trailing SL: Activate ONLY after TP1 hit AND SL moved to breakeven (for swing/position)
+	// Ultra-fast and Scalp modes: NO trailing (disabled in settings)
+	if !pos.TrailingActive {
+		settingsManager := GetSettingsManager()
+		settings, settingsLoadErr := settingsManager.LoadSettings()
+		if settingsLoadErr != nil {
+			log.Printf("[SETTINGS] ERROR: Failed to load settings: %v", settingsLoadErr)
+			ga.mu.Unlock()
+			return
 		}
 
-		if pos.TrailingActive && trailingPercent > 0 {
-			var newTrailingSL float64
-			if pos.Side == "LONG" {
-				// For longs: trail from highest price
-				newTrailingSL = pos.HighestPrice * (1 - trailingPercent/100)
-			} else {
-				// For shorts: trail from lowest price
-				newTrailingSL = pos.LowestPrice * (1 + trailingPercent/100)
-			}
-
-			// Only move SL in profitable direction (never lower for longs, never higher for shorts)
-			slImproved := false
-			var trailingOldSL float64
-			var trailingImprovement float64
-
-			if pos.Side == "LONG" && newTrailingSL > pos.StopLoss && pos.EntryPrice > 0 {
-				slImprovement := (newTrailingSL - pos.StopLoss) / pos.EntryPrice * 100
-				if slImprovement >= ga.config.TrailingSLUpdateThreshold {
-					trailingOldSL = pos.StopLoss
-					trailingImprovement = slImprovement
-					pos.StopLoss = newTrailingSL
-					slImproved = true
-					ga.logger.Info("Trailing SL moved up (LONG)",
-						"symbol", pos.Symbol,
-						"old_sl", trailingOldSL,
-						"new_sl", newTrailingSL,
-						"highest_price", pos.HighestPrice,
-						"improvement_pct", slImprovement)
-				}
-			} else if pos.Side == "SHORT" && newTrailingSL < pos.StopLoss && pos.EntryPrice > 0 {
-				slImprovement := (pos.StopLoss - newTrailingSL) / pos.EntryPrice * 100
-				if slImprovement >= ga.config.TrailingSLUpdateThreshold {
-					trailingOldSL = pos.StopLoss
-					trailingImprovement = slImprovement
-					pos.StopLoss = newTrailingSL
-					slImproved = true
-					ga.logger.Info("Trailing SL moved down (SHORT)",
-						"symbol", pos.Symbol,
-						"old_sl", trailingOldSL,
-						"new_sl", newTrailingSL,
-						"lowest_price", pos.LowestPrice,
-						"improvement_pct", slImprovement)
+		// Check if trailing is enabled for this mode (read from ModeConfigs)
+		// [Story 9.9] Removed scalp_reentry - positions use their original mode's config
+		modeToConfigKey := map[string]string{
+			string(GinieModeUltraFast): "ultra_fast",
+			string(GinieModeScalp):     "scalp",
+			string(GinieModeSwing):     "swing",
+			string(GinieModePosition):  "position",
+		}
+		trailingEnabled := false
+		if modeKey, ok := modeToConfigKey[string(pos.Mode)]; ok {
+			if modeConfig := settings.ModeConfigs[modeKey]; modeConfig != nil {
+				if modeConfig.SLTP != nil {
+					trailingEnabled = modeConfig.SLTP.TrailingStopEnabled
 				}
 			}
+		}
 
-			// Update Binance order if SL improved significantly
-			if slImproved {
-				// Capture values needed for event logging before releasing lock
-				eventLogger := ga.eventLogger
-				futuresTradeID := pos.FuturesTradeID
-				posSymbol := pos.Symbol
-				posSide := pos.Side
-				posStopLoss := pos.StopLoss
-				highWaterMark := pos.HighestPrice
-				if pos.Side == "SHORT" {
-					highWaterMark = pos.LowestPrice
+		if trailingEnabled {
+			// Trailing activation conditions (multiple paths):
+			// 1. TP1 hit AND breakeven moved (conservative)
+			// 2. OR profit threshold reached (if TrailingActivationPct > 0)
+			canActivate := false
+			activationReason := ""
+
+			if pos.Mode == GinieModeSwing || pos.Mode == GinieModePosition {
+				// Allow activation via multiple conditions:
+				// 1. TP1 hit AND breakeven moved (conservative - protects after partial TP)
+				// 2. OR profit threshold reached (respects user's TrailingActivationPct setting)
+				if pos.CurrentTPLevel >= 1 && pos.MovedToBreakeven {
+					canActivate = true
+					activationReason = "after_tp1_and_breakeven"
+				} else if pos.TrailingActivationPct > 0 && pnlPercent >= pos.TrailingActivationPct {
+					// FIX: Allow profit-threshold activation even before TP1
+					// This prevents scenarios where price runs up significantly but trailing never activates
+					canActivate = true
+					activationReason = "profit_threshold"
+					log.Printf("[GINIE-TRAILING] %s: Activating via profit threshold (%.2f%% >= %.2f%%)",
+						symbol, pnlPercent, pos.TrailingActivationPct)
 				}
+			} else {
+				// For other modes (ultra-fast/scalp if enabled), use profit threshold
+				if pos.TrailingActivationPct > 0 && pnlPercent >= pos.TrailingActivationPct {
+					canActivate = true
+					activationReason = "profit_threshold"
+				}
+			}
 
-				// FIX: Release lock BEFORE network call to prevent blocking GetStatus API
-				ga.mu.Unlock()
-				// Story 7.12: Use trailing stop source for modification tracking
-				ga.updateBinanceSLOrderWithReason(pos, orders.ModificationSourceTrailingStop, fmt.Sprintf("Trailing stop update: improved by %.2f%%", trailingImprovement))
-
-				// Log trailing update to trade lifecycle (uses captured values, no lock needed)
-				if eventLogger != nil && futuresTradeID > 0 {
-					go eventLogger.LogTrailingUpdated(
+			if canActivate {
+				pos.TrailingActive = true
+				ga.publishPositionEvent(events.EventGiniePositionTrailingActivated, pos, map[string]interface{}{"reason": activationReason, "pnl_percent": pnlPercent})
+				ga.logger.Info("Trailing stop activated",
+					"symbol", pos.Symbol,
+					"mode", pos.Mode,
+					"reason", activationReason,
+					"tp_level", pos.CurrentTPLevel,
+					"at_breakeven", pos.MovedToBreakeven,
+					"pnl_percent", pnlPercent)
+
+				// Log trailing activation to trade lifecycle
+				if ga.eventLogger != nil && pos.FuturesTradeID > 0 {
+					go ga.eventLogger.LogTrailingActivated(
 						context.Background(),
-						futuresTradeID,
-						posSymbol,
-						posSide,
-						trailingOldSL,
-						posStopLoss,
-						highWaterMark,
-						trailingImprovement,
+						pos.FuturesTradeID,
+						pos.Symbol,
+						string(pos.Mode),
+						activationReason,
+						currentPrice,
+						pnlPercent,
+						pos.CurrentTPLevel,
 					)
 				}
+			}
+		}
+	}
 
-				// Re-acquire lock and check if position still exists
-				ga.mu.Lock()
-				pos, exists = ga.positions[symbol]
-				if !exists {
-					ga.mu.Unlock()
-					continue
-				}
+	// 3. Trail SL upward: Update SL as price moves favorably
+	// Use configured trailing percent (per-mode), or fall back to global config
+	// Tighten the trail as TP levels are hit when the mode sets per-level steps
+	if step := ga.tpLevelTrailingPercent(pos); step > 0 && step != pos.TrailingPercent {
+		ga.logger.Info("Trailing step adjusted for TP level",
+			"symbol", pos.Symbol,
+			"tp_level", pos.CurrentTPLevel,
+			"old_trailing_pct", pos.TrailingPercent,
+			"new_trailing_pct", step)
+		pos.TrailingPercent = step
+	}
+	trailingPercent := pos.TrailingPercent
+	if trailingPercent == 0 {
+		trailingPercent = ga.config.TrailingStepPercent
+	}
+
+	// Exchange-native trailing: Binance trails the stop itself, so the
+	// client-side cancel/replace below is skipped once the order is live
+	if pos.TrailingActive && trailingPercent > 0 && ga.useNativeTrailing(pos, trailingPercent) &&
+		!ga.hasNativeTrailing(symbol, trailingPercent) {
+		// FIX: Release lock BEFORE network call to prevent blocking GetStatus API
+		ga.mu.Unlock()
+		ga.placeNativeTrailingStop(pos, trailingPercent)
+		ga.mu.Lock()
+	}
+
+	if pos.TrailingActive && trailingPercent > 0 && !ga.hasNativeTrailing(symbol, 0) {
+		var newTrailingSL float64
+		if pos.Side == "LONG" {
+			// For longs: trail from highest price
+			newTrailingSL = pos.HighestPrice * (1 - trailingPercent/100)
+		} else {
+			// For shorts: trail from lowest price
+			newTrailingSL = pos.LowestPrice * (1 + trailingPercent/100)
+		}
+
+		// Only move SL in profitable direction (never lower for longs, never higher for shorts)
+		slImproved := false
+		var trailingOldSL float64
+		var trailingImprovement float64
+
+		if pos.Side == "LONG" && newTrailingSL > pos.StopLoss && pos.EntryPrice > 0 {
+			slImprovement := (newTrailingSL - pos.StopLoss) / pos.EntryPrice * 100
+			if slImprovement >= ga.config.TrailingSLUpdateThreshold {
+				trailingOldSL = pos.StopLoss
+				trailingImprovement = slImprovement
+				pos.StopLoss = newTrailingSL
+				slImproved = true
+				ga.logger.Info("Trailing SL moved up (LONG)",
+					"symbol", pos.Symbol,
+					"old_sl", trailingOldSL,
+					"new_sl", newTrailingSL,
+					"highest_price", pos.HighestPrice,
+					"improvement_pct", slImprovement)
+			}
+		} else if pos.Side == "SHORT" && newTrailingSL < pos.StopLoss && pos.EntryPrice > 0 {
+			slImprovement := (pos.StopLoss - newTrailingSL) / pos.EntryPrice * 100
+			if slImprovement >= ga.config.TrailingSLUpdateThreshold {
+				trailingOldSL = pos.StopLoss
+				trailingImprovement = slImprovement
+				pos.StopLoss = newTrailingSL
+				slImproved = true
+				ga.logger.Info("Trailing SL moved down (SHORT)",
+					"symbol", pos.Symbol,
+					"old_sl", trailingOldSL,
+					"new_sl", newTrailingSL,
+					"lowest_price", pos.LowestPrice,
+					"improvement_pct", slImprovement)
 			}
 		}
-		// === END PROACTIVE PROFIT PROTECTION ===
 
-		// === EARLY PROFIT BOOKING (ROI-BASED) - DISABLED ===
-		// AI auto exit removed - now only using SL/TP and trailing stop from mode config
-		// === END EARLY PROFIT BOOKING ===
+		// Update Binance order if SL improved significantly
+		if slImproved {
+			ga.recordSLUpdateLocked(pos.Symbol, trailingOldSL, pos.StopLoss, currentPrice, "applied", "", "trailing", 0)
 
-		// Check Stop Loss
-		if ga.checkStopLoss(pos, currentPrice) {
+			// Capture values needed for event logging before releasing lock
+			eventLogger := ga.eventLogger
+			futuresTradeID := pos.FuturesTradeID
+			posSymbol := pos.Symbol
+			posSide := pos.Side
+			posStopLoss := pos.StopLoss
+			highWaterMark := pos.HighestPrice
+			if pos.Side == "SHORT" {
+				highWaterMark = pos.LowestPrice
+			}
+
+			// FIX: Release lock BEFORE network call to prevent blocking GetStatus API
 			ga.mu.Unlock()
-			ga.closePosition(symbol, pos, currentPrice, "stop_loss", 0)
-			continue
+			// Story 7.12: Use trailing stop source for modification tracking
+			ga.updateBinanceSLOrderWithReason(pos, orders.ModificationSourceTrailingStop, fmt.Sprintf("Trailing stop update: improved by %.2f%%", trailingImprovement))
+
+			// Log trailing update to trade lifecycle (uses captured values, no lock needed)
+			if eventLogger != nil && futuresTradeID > 0 {
+				go eventLogger.LogTrailingUpdated(
+					context.Background(),
+					futuresTradeID,
+					posSymbol,
+					posSide,
+					trailingOldSL,
+					posStopLoss,
+					highWaterMark,
+					trailingImprovement,
+				)
+			}
+
+			ga.mu.Lock()
 		}
+	}
+	// === END PROACTIVE PROFIT PROTECTION ===
 
-		// Check Take Profit levels (process one at a time)
-		// FIX: Release lock BEFORE checkTakeProfits since it makes network calls
-		// (executePartialClose, updateBinanceSLOrder, placeNextTPOrder)
+	// Check Stop Loss
+	if ga.checkStopLoss(pos, currentPrice) {
 		ga.mu.Unlock()
-		tpHit := ga.checkTakeProfits(pos, currentPrice, pnlPercent)
-		if tpHit > 0 && tpHit <= len(pos.TakeProfits) {
-			// Partial close for TP1-3, handled by checkTakeProfits
-			// Lock already released, just continue
-			continue
-		}
-		// Re-acquire lock for remaining checks
-		ga.mu.Lock()
-		pos, exists = ga.positions[symbol]
-		if !exists {
-			ga.mu.Unlock()
-			continue
-		}
+		ga.closePosition(symbol, pos, currentPrice, "stop_loss", 0)
+		return
+	}
 
-		// Check trailing stop (for TP4 / final portion) - now also triggers earlier if trailing active
-		if pos.TrailingActive {
-			if ga.checkTrailingStop(pos, currentPrice) {
-				reason := "trailing_stop"
-				if pos.CurrentTPLevel >= 3 {
-					reason = "trailing_stop_tp4"
-				}
-				ga.mu.Unlock()
-				ga.closePosition(symbol, pos, currentPrice, reason, pos.CurrentTPLevel)
-				continue
+	// Check Take Profit levels (process one at a time)
+	// FIX: Release lock BEFORE checkTakeProfits since it makes network calls
+	// (executePartialClose, updateBinanceSLOrder, placeNextTPOrder)
+	ga.mu.Unlock()
+
+	// === EARLY PROFIT BOOKING (ROI-BASED) ===
+	// Close the whole position once ROI after fees reaches the threshold, before
+	// TP1. Checked outside ga.mu since the threshold may come from the database;
+	// the symbol lock keeps other writers off this position.
+	if book, roi, source := ga.shouldBookEarlyProfit(pos, currentPrice); book {
+		ga.logger.Info("Early profit booking triggered",
+			"symbol", symbol,
+			"mode", pos.Mode,
+			"roi_percent", roi,
+			"threshold_source", source)
+		ga.closePosition(symbol, pos, currentPrice, "early_profit_booking", pos.CurrentTPLevel)
+		return
+	}
+
+	tpHit := ga.checkTakeProfits(pos, currentPrice, pnlPercent)
+	if tpHit > 0 && tpHit <= len(pos.TakeProfits) {
+		// Partial close for TP1-3, handled by checkTakeProfits
+		// Lock already released, just continue
+		return
+	}
+	// Re-acquire lock for remaining checks; the symbol lock kept the position in place
+	ga.mu.Lock()
+
+	// Check trailing stop (for TP4 / final portion) - now also triggers earlier if trailing active
+	if pos.TrailingActive {
+		if ga.checkTrailingStop(pos, currentPrice) {
+			reason := "trailing_stop"
+			if pos.CurrentTPLevel >= 3 {
+				reason = "trailing_stop_tp4"
 			}
+			ga.mu.Unlock()
+			ga.closePosition(symbol, pos, currentPrice, reason, pos.CurrentTPLevel)
+			return
 		}
+	}
 
-		// ====== DYNAMIC AI EXIT (DISABLED) ======
-		// AI auto exit removed - now only using SL/TP and trailing stop from mode config
-		// ====== END DYNAMIC AI EXIT ======
+	// ====== DYNAMIC AI EXIT (DISABLED) ======
+	// AI auto exit removed - now only using SL/TP and trailing stop from mode config
+	// ====== END DYNAMIC AI EXIT ======
 
-		// Release lock at end of this position's processing
-		ga.mu.Unlock()
-	}
+	// Release lock at end of this position's processing
+	ga.mu.Unlock()
 }
 
 // checkStopLoss checks if stop loss is hit
@@ -5917,8 +6864,16 @@ func (ga *GinieAutopilot) checkStopLoss(pos *GiniePosition, currentPrice float64
 	return priceGreaterOrEqual(pos.Symbol, currentPrice, pos.StopLoss)
 }
 
-// checkTakeProfits checks and executes take profit levels
-// Uses tolerance-based comparison to avoid floating point precision issues
+// earlyProfitBookingPolicy reports whether early profit booking applies to a
+// mode and which setting decided it: the mode's early_profit_booking_enabled
+// when set, otherwise the global EarlyProfitBookingEnabled
+func (ga *GinieAutopilot) earlyProfitBookingPolicy(mode GinieTradingMode) (bool, string) {
+	if modeConfig := ga.getModeConfig(mode); modeConfig != nil && modeConfig.EarlyProfitBookingEnabled != nil {
+		return *modeConfig.EarlyProfitBookingEnabled, "mode:" + string(mode)
+	}
+	return ga.config.EarlyProfitBookingEnabled, "global"
+}
+
 // shouldBookEarlyProfit checks if position should be closed early based on ROI threshold
 // Priority order for threshold selection:
 //   1. Position.CustomROIPercent (temporary, per-position override)
@@ -5926,29 +6881,24 @@ func (ga *GinieAutopilot) checkStopLoss(pos *GiniePosition, currentPrice float64
 //   3. Mode-based thresholds (SCALP=5%, SWING=8%, POSITION=10%, ULTRAFAST=3%)
 // Returns (shouldBook, currentROI, source) where source indicates which threshold was used
 func (ga *GinieAutopilot) shouldBookEarlyProfit(pos *GiniePosition, currentPrice float64) (bool, float64, string) {
-	// Debug: Log early profit booking check start
-	fmt.Printf("[EARLY-PROFIT-DEBUG] Checking %s | entry=%.8f current=%.8f | enabled=%v tpLevel=%d\n",
-		pos.Symbol, pos.EntryPrice, currentPrice, ga.config.EarlyProfitBookingEnabled, pos.CurrentTPLevel)
-
-	if !ga.config.EarlyProfitBookingEnabled || pos.CurrentTPLevel > 0 {
-		if !ga.config.EarlyProfitBookingEnabled {
-			fmt.Printf("[EARLY-PROFIT-DEBUG] %s: Early profit booking DISABLED in config\n", pos.Symbol)
-		}
-		if pos.CurrentTPLevel > 0 {
-			fmt.Printf("[EARLY-PROFIT-DEBUG] %s: Skipping early profit - TP level already hit (CurrentTPLevel=%d)\n",
-				pos.Symbol, pos.CurrentTPLevel)
-		}
+	enabled, policy := ga.earlyProfitBookingPolicy(pos.Mode)
+	if !enabled {
+		ga.logger.Debug("Early profit booking disabled",
+			"symbol", pos.Symbol,
+			"mode", pos.Mode,
+			"policy", policy)
+		return false, 0, ""
+	}
+	if pos.CurrentTPLevel > 0 {
+		// The TP ladder has started - let it run
 		return false, 0, ""
 	}
 
 	// Calculate ROI after fees (including leverage effect)
 	roiPercent := calculateROIAfterFees(pos.EntryPrice, currentPrice, pos.RemainingQty, pos.Side, pos.Leverage)
 
-	fmt.Printf("[EARLY-PROFIT-DEBUG] %s: Calculated ROI after fees = %.4f%%\n", pos.Symbol, roiPercent)
-
 	// Only book if profitable after fees
 	if roiPercent <= 0 {
-		fmt.Printf("[EARLY-PROFIT-DEBUG] %s: ROI not profitable (%.4f%%), skipping\n", pos.Symbol, roiPercent)
 		return false, 0, ""
 	}
 
@@ -5960,15 +6910,7 @@ func (ga *GinieAutopilot) shouldBookEarlyProfit(pos *GiniePosition, currentPrice
 	if pos.CustomROIPercent != nil && *pos.CustomROIPercent > 0 {
 		threshold = *pos.CustomROIPercent
 		source = "position_custom"
-		fmt.Printf("[EARLY-PROFIT-DEBUG] %s: Using POSITION-LEVEL custom ROI = %.4f%%\n", pos.Symbol, threshold)
 	} else {
-		if pos.CustomROIPercent == nil {
-			fmt.Printf("[EARLY-PROFIT-DEBUG] %s: Position custom ROI is NIL (not set)\n", pos.Symbol)
-		} else {
-			fmt.Printf("[EARLY-PROFIT-DEBUG] %s: Position custom ROI is <= 0 (%.4f), checking symbol settings\n",
-				pos.Symbol, *pos.CustomROIPercent)
-		}
-
 		// 2. Check symbol-level custom ROI from PER-USER database (second priority)
 		var userSymbolROI float64
 		if ga.userID != "" && ga.repo != nil {
@@ -5977,16 +6919,12 @@ func (ga *GinieAutopilot) shouldBookEarlyProfit(pos *GiniePosition, currentPrice
 			cancel()
 			if err == nil && roi > 0 {
 				userSymbolROI = roi
-				fmt.Printf("[EARLY-PROFIT-DEBUG] %s: Found PER-USER custom ROI = %.4f%% from database\n",
-					pos.Symbol, userSymbolROI)
 			}
 		}
 
 		if userSymbolROI > 0 {
 			threshold = userSymbolROI
 			source = "user_symbol_custom"
-			fmt.Printf("[EARLY-PROFIT-DEBUG] %s: Using USER-SPECIFIC custom ROI = %.4f%% from database\n",
-				pos.Symbol, threshold)
 		} else {
 			// Fallback: Check shared symbol settings (legacy mode)
 			settingsManager := GetSettingsManager()
@@ -5994,16 +6932,7 @@ func (ga *GinieAutopilot) shouldBookEarlyProfit(pos *GiniePosition, currentPrice
 			if symbolSettings != nil && symbolSettings.CustomROIPercent > 0 {
 				threshold = symbolSettings.CustomROIPercent
 				source = "symbol_custom"
-				fmt.Printf("[EARLY-PROFIT-DEBUG] %s: Using SHARED symbol custom ROI = %.4f%% from settings\n",
-					pos.Symbol, threshold)
 			} else {
-				if symbolSettings == nil {
-					fmt.Printf("[EARLY-PROFIT-DEBUG] %s: No symbol settings found (shared or user)\n", pos.Symbol)
-				} else {
-					fmt.Printf("[EARLY-PROFIT-DEBUG] %s: Symbol settings found but CustomROIPercent = %.4f (not set)\n",
-						pos.Symbol, symbolSettings.CustomROIPercent)
-				}
-
 				// 3. Fallback to mode-based threshold from ModeConfigs (not hardcoded)
 				// Use mode-specific TP% from settings, converted to ROI by multiplying by leverage
 				settings, settingsLoadErr := settingsManager.LoadSettings()
@@ -6058,9 +6987,6 @@ func (ga *GinieAutopilot) shouldBookEarlyProfit(pos *GiniePosition, currentPrice
 				} else if threshold > maxEarlyThreshold {
 					threshold = maxEarlyThreshold
 				}
-
-				fmt.Printf("[EARLY-PROFIT-DEBUG] %s: Using %s TP=%.2f%% × lev=%d = fullROI=%.2f%%, early threshold=%.2f%% (40%% of full, capped 3-15%%)\n",
-					pos.Symbol, source, tpPercent, pos.Leverage, fullTPROI, threshold)
 			}
 		}
 	}
@@ -6069,24 +6995,18 @@ func (ga *GinieAutopilot) shouldBookEarlyProfit(pos *GiniePosition, currentPrice
 	// This guards against misconfigured settings where threshold could be 0
 	const minThreshold = 0.1 // Minimum 0.1% ROI required to book profit
 	if threshold < minThreshold {
-		fmt.Printf("[EARLY-PROFIT-DEBUG] %s: ⚠️ Threshold %.4f%% below minimum, using %.4f%%\n",
-			pos.Symbol, threshold, minThreshold)
 		threshold = minThreshold
 	}
 
-	// Check if ROI exceeds threshold
-	fmt.Printf("[EARLY-PROFIT-DEBUG] %s: Comparing ROI %.4f%% >= Threshold %.4f%% (source: %s)\n",
-		pos.Symbol, roiPercent, threshold, source)
-
-	if roiPercent >= threshold {
-		fmt.Printf("[EARLY-PROFIT-DEBUG] %s: ✅ YES - BOOKING EARLY PROFIT! ROI %.4f%% exceeds threshold %.4f%%\n",
-			pos.Symbol, roiPercent, threshold)
-		return true, roiPercent, source
-	}
+	ga.logger.Debug("Early profit booking check",
+		"symbol", pos.Symbol,
+		"mode", pos.Mode,
+		"policy", policy,
+		"roi_percent", roiPercent,
+		"threshold", threshold,
+		"threshold_source", source)
 
-	fmt.Printf("[EARLY-PROFIT-DEBUG] %s: ❌ NO - ROI %.4f%% below threshold %.4f%%\n",
-		pos.Symbol, roiPercent, threshold)
-	return false, roiPercent, source
+	return roiPercent >= threshold, roiPercent, source
 }
 
 func (ga *GinieAutopilot) checkTakeProfits(pos *GiniePosition, currentPrice float64, pnlPercent float64) int {
@@ -6116,10 +7036,19 @@ func (ga *GinieAutopilot) checkTakeProfits(pos *GiniePosition, currentPrice floa
 			// This prevents residual quantity from being left unsold
 			ga.executePartialClose(pos, currentPrice, tpLevel)
 
-			// After TP4 (final level), activate trailing for any dust remaining due to rounding
-			if tpLevel >= 4 && pos.RemainingQty > 0 {
+			// Rounding dust left by the partial close is swept rather than trailed
+			if ga.isDustRemainder(pos, currentPrice) {
+				pos.TakeProfits[i].Status = "hit"
+				pos.CurrentTPLevel = tpLevel
+				ga.publishPositionEvent(events.EventGiniePositionTPHit, pos, map[string]interface{}{"tp_level": tpLevel, "price": currentPrice})
+				ga.closePosition(pos.Symbol, pos, currentPrice, fmt.Sprintf("TP%d hit - dust remainder swept", tpLevel), tpLevel)
+				return tpLevel
+			}
+
+			// After TP4 (final level), activate trailing for any remainder left over
+			if tpLevel >= 4 && pos.RemainingQty > 0 && pos.Runner == nil {
 				pos.TrailingActive = true
-				ga.logger.Info("Ginie TP4 hit - closed portion and activated trailing for dust",
+				ga.logger.Info("Ginie TP4 hit - closed portion and activated trailing for remainder",
 					"symbol", pos.Symbol,
 					"price", currentPrice,
 					"remaining_qty", pos.RemainingQty)
@@ -6128,6 +7057,15 @@ func (ga *GinieAutopilot) checkTakeProfits(pos *GiniePosition, currentPrice floa
 			// Mark TP as hit
 			pos.TakeProfits[i].Status = "hit"
 			pos.CurrentTPLevel = tpLevel
+			ga.publishPositionEvent(events.EventGiniePositionTPHit, pos, map[string]interface{}{"tp_level": tpLevel, "price": currentPrice})
+			if ga.activateRunner(pos, currentPrice) {
+				// The runner is only protected by its trailing SL from here on
+				if pos.StopLossAlgoID == 0 {
+					ga.placeSLOrder(pos)
+				}
+			} else if pos.TrailingActive {
+				ga.publishPositionEvent(events.EventGiniePositionTrailingActivated, pos, map[string]interface{}{"reason": "final_tp_hit"})
+			}
 
 			// Move SL to breakeven after TP1 and update Binance order
 			if tpLevel == 1 && ga.config.MoveToBreakevenAfterTP1 && !pos.MovedToBreakeven {
@@ -6281,40 +7219,11 @@ func (ga *GinieAutopilot) executePartialClose(pos *GiniePosition, currentPrice f
 		"net_pnl", pnl)
 
 	if !ga.config.DryRun {
-		// Place close order using LIMIT to avoid slippage
-		side := "SELL"
-		positionSide := binance.PositionSideLong
-		if pos.Side == "SHORT" {
-			side = "BUY"
-			positionSide = binance.PositionSideShort
-		}
-
-		// Check actual Binance position mode to avoid API error -4061
-		effectivePositionSide := ga.getEffectivePositionSide(positionSide)
-
-		// Use LIMIT order at slightly worse price (0.1% buffer) to ensure execution
-		// This avoids slippage on volatile movements during order execution
-		// For LONG: sell at 0.1% below current price
-		// For SHORT: buy at 0.1% above current price
-		closePrice := currentPrice
-		if pos.Side == "LONG" {
-			closePrice = currentPrice * 0.999 // 0.1% buffer below
-		} else {
-			closePrice = currentPrice * 1.001 // 0.1% buffer above
-		}
-
-		orderParams := binance.FuturesOrderParams{
-			Symbol:       pos.Symbol,
-			Side:         side,
-			PositionSide: effectivePositionSide,
-			Type:         binance.FuturesOrderTypeLimit,
-			Quantity:     closeQty,
-			Price:        closePrice, // LIMIT order with 0.1% buffer
-		}
-
-		_, err := ga.futuresClient.PlaceFuturesOrder(orderParams)
+		// Close with the mode's exit order type - by default a LIMIT 0.1% through
+		// the current price, which avoids slippage on volatile movements
+		orderType, err := ga.placeExitOrder(pos, closeQty, currentPrice)
 		if err != nil {
-			ga.logger.Error("Ginie partial close failed", "symbol", pos.Symbol, "error", err)
+			ga.logger.Error("Ginie partial close failed", "symbol", pos.Symbol, "order_type", orderType, "error", err)
 			// Track failed order for diagnostics
 			ga.mu.Lock()
 			ga.failedOrdersLastHour++
@@ -6322,11 +7231,10 @@ func (ga *GinieAutopilot) executePartialClose(pos *GiniePosition, currentPrice f
 			return
 		}
 
-		ga.logger.Info("Ginie partial close order placed (LIMIT)",
+		ga.logger.Info("Ginie partial close order placed",
 			"symbol", pos.Symbol,
-			"side", side,
+			"order_type", orderType,
 			"current_price", currentPrice,
-			"limit_price", closePrice,
 			"quantity", closeQty)
 	}
 
@@ -6409,18 +7317,12 @@ func (ga *GinieAutopilot) executePartialClose(pos *GiniePosition, currentPrice f
 //   - LONG: SL = entry + buffer (STOP_MARKET SELL triggers when price falls TO 100.1, we exit with +0.1)
 //   - SHORT: SL = entry - buffer (STOP_MARKET BUY triggers when price rises TO 99.9, we exit with +0.1)
 func (ga *GinieAutopilot) moveToBreakeven(pos *GiniePosition, reason string) {
-	buffer := pos.EntryPrice * (ga.config.BreakevenBuffer / 100)
-
-	if pos.Side == "LONG" {
-		// LONG: STOP_MARKET SELL triggers when price FALLS to trigger.
-		// Set SL above entry so we exit with tiny profit to cover fees.
-		pos.StopLoss = pos.EntryPrice + buffer
-	} else {
-		// SHORT: STOP_MARKET BUY triggers when price RISES to trigger.
-		// Set SL below entry so we exit with tiny profit to cover fees.
-		// (When we buy back at 99.9 after selling at 100, profit = 0.1 covers fees)
-		pos.StopLoss = pos.EntryPrice - buffer
-	}
+	// LONG: STOP_MARKET SELL triggers when price FALLS, so the SL sits above entry.
+	// SHORT: STOP_MARKET BUY triggers when price RISES, so the SL sits below entry.
+	// Either way the offset covers round-trip fees (fee-aware modes) plus the buffer.
+	stop, bufferPct, feeAware := ga.breakevenStopPrice(pos)
+	ga.recordSLUpdateLocked(pos.Symbol, pos.StopLoss, stop, 0, "applied", "", "breakeven", 0)
+	pos.StopLoss = stop
 
 	pos.MovedToBreakeven = true
 
@@ -6428,7 +7330,8 @@ func (ga *GinieAutopilot) moveToBreakeven(pos *GiniePosition, reason string) {
 		"symbol", pos.Symbol,
 		"entry", pos.EntryPrice,
 		"new_sl", pos.StopLoss,
-		"buffer", ga.config.BreakevenBuffer,
+		"buffer", bufferPct,
+		"fee_aware", feeAware,
 		"reason", reason)
 
 	// Log breakeven event to trade lifecycle
@@ -6439,7 +7342,7 @@ func (ga *GinieAutopilot) moveToBreakeven(pos *GiniePosition, reason string) {
 			pos.Symbol,
 			pos.EntryPrice,
 			pos.StopLoss,
-			ga.config.BreakevenBuffer,
+			bufferPct,
 			reason,
 		)
 	}
@@ -6463,6 +7366,9 @@ func (ga *GinieAutopilot) placeNextTPOrder(pos *GiniePosition, currentTPLevel in
 			"current_tp_count", len(pos.TakeProfits))
 		isLong := pos.Side == "LONG"
 		pos.TakeProfits = ga.generateDefaultTPs(pos.Symbol, pos.EntryPrice, pos.Mode, isLong)
+		if pos.Runner != nil {
+			scaleTPsForRunner(pos.TakeProfits, pos.Runner.Percent)
+		}
 		ga.logger.Info("Regenerated TakeProfits for position",
 			"symbol", pos.Symbol,
 			"new_tp_count", len(pos.TakeProfits))
@@ -6536,6 +7442,11 @@ func (ga *GinieAutopilot) placeNextTPOrder(pos *GiniePosition, currentTPLevel in
 	// Calculate quantity for next TP
 	tpQty := roundQuantity(pos.Symbol, pos.OriginalQty*(nextTP.Percent/100.0))
 
+	// The final TP leaves the runner open
+	if pos.Runner != nil && nextTPIndex >= len(pos.TakeProfits)-1 {
+		tpQty = runnerFinalTPQty(pos)
+	}
+
 	// Ensure we don't try to close more than remaining
 	if tpQty > pos.RemainingQty {
 		tpQty = pos.RemainingQty
@@ -6607,7 +7518,7 @@ func (ga *GinieAutopilot) placeNextTPOrder(pos *GiniePosition, currentTPLevel in
 			ReduceOnly:   true,
 		}
 
-		order, err := ga.futuresClient.PlaceFuturesOrder(orderParams)
+		order, err := ga.placeFuturesOrder(orderParams)
 		if err != nil {
 			ga.logger.Error("Failed to execute immediate TP market order",
 				"symbol", pos.Symbol,
@@ -6630,9 +7541,15 @@ func (ga *GinieAutopilot) placeNextTPOrder(pos *GiniePosition, currentTPLevel in
 			ga.totalPnL += pnl
 
 			// If TP4, activate trailing
-			if nextTPIndex+1 == 4 {
+			if nextTPIndex+1 == 4 && pos.Runner == nil {
 				pos.TrailingActive = true
 			}
+			ga.publishPositionEvent(events.EventGiniePositionTPHit, pos, map[string]interface{}{"tp_level": nextTPIndex + 1, "price": currentPrice})
+			if ga.activateRunner(pos, currentPrice) {
+				ga.placeSLOrder(pos)
+			} else if pos.TrailingActive {
+				ga.publishPositionEvent(events.EventGiniePositionTrailingActivated, pos, map[string]interface{}{"reason": "final_tp_hit"})
+			}
 
 			ga.logger.Info("Immediate TP executed successfully",
 				"symbol", pos.Symbol,
@@ -6659,7 +7576,8 @@ func (ga *GinieAutopilot) placeNextTPOrder(pos *GiniePosition, currentTPLevel in
 
 	// Normal case - place TP algo order
 	// CRITICAL FIX: For final TP level, use ClosePosition=true to prevent residual quantity
-	isFinalTPLevel := nextTPIndex >= len(pos.TakeProfits)-1
+	// (unless a runner is to be left open)
+	isFinalTPLevel := nextTPIndex >= len(pos.TakeProfits)-1 && pos.Runner == nil
 
 	// Use LIMIT orders to save on taker fees (maker rebate instead)
 	// For LONG (SELL): limit price slightly below trigger to ensure fill
@@ -6720,57 +7638,41 @@ func (ga *GinieAutopilot) placeNextTPOrder(pos *GiniePosition, currentTPLevel in
 		}
 	}
 
-	// Place TP with retry logic
-	const maxTPRetries = 3
-	tpRetryDelay := 500 * time.Millisecond
-	var tpOrderPlaced bool
-
-	for attempt := 1; attempt <= maxTPRetries; attempt++ {
-		tpOrder, err := ga.futuresClient.PlaceAlgoOrder(tpParams)
-		if err == nil && tpOrder != nil && tpOrder.AlgoId > 0 {
-			pos.TakeProfitAlgoIDs = append(pos.TakeProfitAlgoIDs, tpOrder.AlgoId)
-			if isFinalTPLevel {
-				ga.logger.Info("Final take profit order placed (ClosePosition=true)",
-					"symbol", pos.Symbol,
-					"tp_level", nextTPIndex+1,
-					"algo_id", tpOrder.AlgoId,
-					"trigger_price", roundedTPPrice,
-					"close_position", true,
-					"client_order_id", tpClientOrderId,
-					"chain_base_id", pos.ChainBaseID,
-					"attempt", attempt)
-			} else {
-				ga.logger.Info("Next take profit order placed",
-					"symbol", pos.Symbol,
-					"tp_level", nextTPIndex+1,
-					"algo_id", tpOrder.AlgoId,
-					"trigger_price", roundedTPPrice,
-					"quantity", tpQty,
-					"client_order_id", tpClientOrderId,
-					"chain_base_id", pos.ChainBaseID,
-					"attempt", attempt)
-			}
-			tpOrderPlaced = true
-			break
-		}
-		ga.logger.Error("Failed to place next take profit order",
+	// Transient failures are retried inside the client under the global Binance
+	// retry policy; terminal rejections (e.g. -2021 would immediately trigger) are not repeated
+	tpOrder, err := ga.placeAlgoOrder(tpParams)
+	if err == nil && (tpOrder == nil || tpOrder.AlgoId == 0) {
+		err = fmt.Errorf("exchange returned no algo order ID")
+	}
+	if err != nil {
+		ga.logger.Error("CRITICAL: Next TP order NOT placed",
 			"symbol", pos.Symbol,
 			"tp_level", nextTPIndex+1,
-			"tp_price", nextTP.Price,
-			"attempt", attempt,
-			"max_retries", maxTPRetries,
+			"tp_price", roundedTPPrice,
+			"retryable", binance.IsRetryableError(err),
 			"error", err.Error())
-		if attempt < maxTPRetries {
-			time.Sleep(tpRetryDelay * time.Duration(attempt))
-		}
+		return
 	}
 
-	if !tpOrderPlaced {
-		ga.logger.Error("CRITICAL: Next TP order NOT placed after all retries",
+	pos.TakeProfitAlgoIDs = append(pos.TakeProfitAlgoIDs, tpOrder.AlgoId)
+	if isFinalTPLevel {
+		ga.logger.Info("Final take profit order placed (ClosePosition=true)",
 			"symbol", pos.Symbol,
 			"tp_level", nextTPIndex+1,
-			"tp_price", roundedTPPrice)
-		return
+			"algo_id", tpOrder.AlgoId,
+			"trigger_price", roundedTPPrice,
+			"close_position", true,
+			"client_order_id", tpClientOrderId,
+			"chain_base_id", pos.ChainBaseID)
+	} else {
+		ga.logger.Info("Next take profit order placed",
+			"symbol", pos.Symbol,
+			"tp_level", nextTPIndex+1,
+			"algo_id", tpOrder.AlgoId,
+			"trigger_price", roundedTPPrice,
+			"quantity", tpQty,
+			"client_order_id", tpClientOrderId,
+			"chain_base_id", pos.ChainBaseID)
 	}
 
 	// CRITICAL FIX: Place a new SL order for remaining quantity
@@ -6788,6 +7690,13 @@ func (ga *GinieAutopilot) updateBinanceSLOrder(pos *GiniePosition) {
 // source: orders.ModificationSourceLLMAuto, orders.ModificationSourceUserManual, orders.ModificationSourceTrailingStop
 // reason: human-readable reason for the modification
 func (ga *GinieAutopilot) updateBinanceSLOrderWithReason(pos *GiniePosition, source, reason string) {
+	// Refuse a worsening move before cancelling: the placed SL stays live
+	if ga.rejectSLWorsening(pos, pos.StopLoss, source) {
+		return
+	}
+
+	defer ga.publishPositionEvent(events.EventGiniePositionSLMoved, pos, map[string]interface{}{"source": source, "reason": reason})
+
 	if ga.config.DryRun {
 		ga.logger.Info("Dry run: would update Binance SL order",
 			"symbol", pos.Symbol,
@@ -6848,6 +7757,10 @@ func (ga *GinieAutopilot) placeSLOrder(pos *GiniePosition) {
 		return
 	}
 
+	// Never re-place the SL worse than the last placed one; the old order may
+	// already be cancelled here, so fall back to its level instead of skipping
+	ga.rejectSLWorsening(pos, pos.StopLoss, "place_sl")
+
 	closeSide := "SELL"
 	positionSide := binance.PositionSideLong
 	if pos.Side == "SHORT" {
@@ -6878,42 +7791,30 @@ func (ga *GinieAutopilot) placeSLOrder(pos *GiniePosition) {
 		ClientAlgoId:  slClientOrderId, // Epic 7: Link SL to entry order chain
 	}
 
-	// Place SL with retry logic - CRITICAL for position protection
-	const maxSLRetries = 3
-	slRetryDelay := 500 * time.Millisecond
-	var slOrderPlaced bool
-
-	for attempt := 1; attempt <= maxSLRetries; attempt++ {
-		slOrder, err := ga.futuresClient.PlaceAlgoOrder(slParams)
-		if err == nil && slOrder != nil && slOrder.AlgoId > 0 {
-			pos.StopLossAlgoID = slOrder.AlgoId
-			ga.logger.Info("Updated SL order placed (ClosePosition=true)",
-				"symbol", pos.Symbol,
-				"new_algo_id", slOrder.AlgoId,
-				"trigger_price", roundedSL,
-				"close_position", true,
-				"client_order_id", slClientOrderId,
-				"chain_base_id", pos.ChainBaseID,
-				"attempt", attempt)
-			slOrderPlaced = true
-			break
-		}
-		ga.logger.Error("Failed to place updated SL order",
+	// Place SL - CRITICAL for position protection. Transient failures are retried
+	// inside the client under the global Binance retry policy.
+	slOrder, err := ga.placeAlgoOrder(slParams)
+	if err == nil && (slOrder == nil || slOrder.AlgoId == 0) {
+		err = fmt.Errorf("exchange returned no algo order ID")
+	}
+	if err != nil {
+		ga.logger.Error("CRITICAL: Updated SL order NOT placed - position unprotected!",
 			"symbol", pos.Symbol,
 			"sl_price", roundedSL,
-			"attempt", attempt,
-			"max_retries", maxSLRetries,
+			"retryable", binance.IsRetryableError(err),
 			"error", err.Error())
-		if attempt < maxSLRetries {
-			time.Sleep(slRetryDelay * time.Duration(attempt))
-		}
+		return
 	}
 
-	if !slOrderPlaced {
-		ga.logger.Error("CRITICAL: Updated SL order NOT placed after all retries - position unprotected!",
-			"symbol", pos.Symbol,
-			"sl_price", roundedSL)
-	}
+	pos.StopLossAlgoID = slOrder.AlgoId
+	pos.PlacedSL = pos.StopLoss
+	ga.logger.Info("Updated SL order placed (ClosePosition=true)",
+		"symbol", pos.Symbol,
+		"new_algo_id", slOrder.AlgoId,
+		"trigger_price", roundedSL,
+		"close_position", true,
+		"client_order_id", slClientOrderId,
+		"chain_base_id", pos.ChainBaseID)
 }
 
 // logOrderModificationEvent logs a modification event for SL/TP orders (Story 7.12)
@@ -7057,20 +7958,29 @@ func (ga *GinieAutopilot) persistTradeClosure(pos *GiniePosition, exitPrice floa
 	// Convert mode to string pointer
 	modeStr := string(pos.Mode)
 
+	// Include the exit price so a move that never hit a monitor tick still counts
+	ga.mu.Lock()
+	pos.updateExcursions(exitPrice)
+	mae, mfe := pos.MaxAdverseExcursion, pos.MaxFavorableExcursion
+	ga.mu.Unlock()
+
 	now := time.Now()
 	trade := &database.FuturesTrade{
-		ID:                 pos.FuturesTradeID,
-		ExitPrice:          &exitPrice,
-		RealizedPnL:        &totalPnL,
-		RealizedPnLPercent: &pnlPercent,
-		Status:             "CLOSED",
-		ExitTime:           &now,
-		Notes:              &reason,
-		TradingMode:        &modeStr,
-		HedgeModeActive:    hedgeModeActive,
-	}
-
-	ctx := context.Background()
+		ID:                    pos.FuturesTradeID,
+		ExitPrice:             &exitPrice,
+		RealizedPnL:           &totalPnL,
+		RealizedPnLPercent:    &pnlPercent,
+		Status:                "CLOSED",
+		ExitTime:              &now,
+		Notes:                 &reason,
+		TradingMode:           &modeStr,
+		HedgeModeActive:       hedgeModeActive,
+		MaxAdverseExcursion:   &mae,
+		MaxFavorableExcursion: &mfe,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbWriteTimeout)
+	defer cancel()
 	err := ga.repo.GetDB().UpdateFuturesTradeForUser(ctx, ga.userID, trade)
 	if err != nil {
 		ga.logger.Error("Failed to persist trade closure to database",
@@ -7088,9 +7998,29 @@ func (ga *GinieAutopilot) persistTradeClosure(pos *GiniePosition, exitPrice floa
 		"pnl_percent", pnlPercent,
 		"mode", modeStr,
 		"hedge_mode_active", hedgeModeActive,
+		"mae_percent", mae,
+		"mfe_percent", mfe,
 		"reason", reason)
 }
 
+// updateExcursions records the deepest adverse and best favorable price move
+// from entry seen so far. Callers must hold ga.mu.
+func (pos *GiniePosition) updateExcursions(price float64) {
+	if pos.EntryPrice <= 0 || price <= 0 {
+		return
+	}
+	move := (price - pos.EntryPrice) / pos.EntryPrice * 100
+	if pos.Side != "LONG" {
+		move = -move
+	}
+	if move < 0 && -move > pos.MaxAdverseExcursion {
+		pos.MaxAdverseExcursion = -move
+	}
+	if move > pos.MaxFavorableExcursion {
+		pos.MaxFavorableExcursion = move
+	}
+}
+
 // closePosition closes the entire remaining position
 func (ga *GinieAutopilot) closePosition(symbol string, pos *GiniePosition, currentPrice float64, reason string, tpLevel int) {
 	// STANDBY CHECK: Block position close if this instance is in standby mode (Story 9.6)
@@ -7166,72 +8096,46 @@ func (ga *GinieAutopilot) closePosition(symbol string, pos *GiniePosition, curre
 			totalPnL,
 			pnlPercent,
 			reason,
-			database.EventSourceGinie,
-		)
-	}
-
-	if !ga.config.DryRun && pos.RemainingQty > 0 {
-		// Place close order using LIMIT to avoid slippage on SL/Trailing closes
-		// This is critical for SL/Trailing stop to avoid worst-case execution
-		side := "SELL"
-		positionSide := binance.PositionSideLong
-		if pos.Side == "SHORT" {
-			side = "BUY"
-			positionSide = binance.PositionSideShort
-		}
-
-		// Check actual Binance position mode to avoid API error -4061
-		effectivePositionSide := ga.getEffectivePositionSide(positionSide)
+			database.EventSourceGinie,
+		)
+	}
 
-		// Use LIMIT order at slightly worse price (0.1% buffer) to ensure execution
-		// Avoids slippage especially on volatile coins where price moves between detection and execution
-		// For LONG: sell at 0.1% below current price to ensure fill
-		// For SHORT: buy at 0.1% above current price to ensure fill
-		closePrice := currentPrice
-		if pos.Side == "LONG" {
-			closePrice = currentPrice * 0.999 // 0.1% buffer below for LONG
-		} else {
-			closePrice = currentPrice * 1.001 // 0.1% buffer above for SHORT
+	if !ga.config.DryRun && ga.isDustRemainder(pos, currentPrice) {
+		// Rounding dust is below the minimum order size, so a LIMIT for RemainingQty
+		// would be rejected or leave a micro-position behind
+		if err := ga.sweepDustRemainder(pos, reason); err != nil {
+			ga.logger.Error("Dust sweep failed", "symbol", symbol, "error", err.Error(), "reason", reason)
+			return
 		}
-
-		// CRITICAL FIX: Round quantity and price to match Binance's precision requirements
+	} else if !ga.config.DryRun && pos.RemainingQty > 0 {
+		// Close with the mode's exit order type. The default LIMIT 0.1% through the
+		// current price avoids worst-case execution on SL/Trailing closes, especially
+		// on volatile coins where price moves between detection and execution
+		// CRITICAL FIX: Round quantity to match Binance's precision requirements
 		// Without this, orders are rejected with precision errors
 		roundedQty := roundQuantity(symbol, pos.RemainingQty)
-		roundedPrice := roundPriceForTP(symbol, closePrice, pos.Side) // Ensure tick-size alignment
 
 		ga.logger.Info("Placing close order",
 			"symbol", symbol,
-			"side", side,
 			"qty", roundedQty,
-			"price", roundedPrice,
 			"current_price", currentPrice,
-			"raw_qty", pos.RemainingQty,
-			"raw_price", closePrice)
-
-		orderParams := binance.FuturesOrderParams{
-			Symbol:       symbol,
-			Side:         side,
-			PositionSide: effectivePositionSide,
-			Type:         binance.FuturesOrderTypeLimit,
-			Quantity:     roundedQty,
-			Price:        roundedPrice, // LIMIT order with 0.1% buffer
-		}
+			"raw_qty", pos.RemainingQty)
 
-		_, err := ga.futuresClient.PlaceFuturesOrder(orderParams)
+		orderType, err := ga.placeExitOrder(pos, roundedQty, currentPrice)
 		if err != nil {
-			ga.logger.Error("LIMIT close order failed - not falling back to MARKET order",
+			ga.logger.Error("Close order failed",
 				"symbol", symbol,
+				"order_type", orderType,
 				"error", err.Error(),
-				"limit_price", roundedPrice,
 				"qty", roundedQty,
 				"reason", reason)
 			return
 		} else {
-			ga.logger.Info("Ginie full close order placed (LIMIT - SL/Trailing)",
+			ga.logger.Info("Ginie full close order placed",
 				"symbol", symbol,
+				"order_type", orderType,
 				"reason", reason,
 				"current_price", currentPrice,
-				"limit_price", closePrice,
 				"quantity", pos.RemainingQty)
 		}
 	}
@@ -7273,9 +8177,16 @@ func (ga *GinieAutopilot) closePosition(symbol string, pos *GiniePosition, curre
 	// Record to MODE circuit breaker for mode-specific loss tracking
 	ga.RecordModeTradeResult(pos.Mode, totalPnL)
 
+	// Feed the mode safety state (rolling win rate drives the adaptive throttle)
+	ga.recordModeTradeClosure(pos.Mode, symbol, totalPnL, pnlPercent)
+
 	// Per-coin consecutive loss tracking and blocking
 	ga.updateCoinLossTracking(symbol, totalPnL, pnlPercent)
 
+	ga.mu.Lock()
+	pos.updateExcursions(currentPrice)
+	ga.mu.Unlock()
+
 	// Record trade with original signal info for study
 	tradeResult := GinieTradeResult{
 		Symbol:     symbol,
@@ -7289,6 +8200,9 @@ func (ga *GinieAutopilot) closePosition(symbol string, pos *GiniePosition, curre
 		TPLevel:    tpLevel,
 		Timestamp:  time.Now(),
 		Mode:       pos.Mode,
+
+		MaxAdverseExcursion:   pos.MaxAdverseExcursion,
+		MaxFavorableExcursion: pos.MaxFavorableExcursion,
 	}
 
 	// Add original entry and signal info if available
@@ -7302,6 +8216,7 @@ func (ga *GinieAutopilot) closePosition(symbol string, pos *GiniePosition, curre
 			Volume:     pos.DecisionReport.MarketConditions.Volume,
 			BTCCorr:    pos.DecisionReport.MarketConditions.BTCCorr,
 		}
+		tradeResult.SignalSummary = signalSummaryFromDecision(pos.DecisionReport)
 		tradeResult.EntryParams = &GinieEntryParams{
 			EntryPrice: pos.EntryPrice,
 			StopLoss:   pos.OriginalSL,
@@ -7322,6 +8237,7 @@ func (ga *GinieAutopilot) closePosition(symbol string, pos *GiniePosition, curre
 
 	// Broadcast position closure to WebSocket clients for real-time UI update
 	ga.broadcastPositionClosure(symbol)
+	ga.publishPositionEvent(events.EventGiniePositionClosed, pos, map[string]interface{}{"reason": reason, "exit_price": currentPrice, "pnl": totalPnL, "pnl_percent": pnlPercent})
 }
 
 // closePositionAtMarket closes a position immediately using a TRUE MARKET order
@@ -7424,7 +8340,7 @@ func (ga *GinieAutopilot) closePositionAtMarket(pos *GiniePosition, reason strin
 			Quantity:     roundedQty,
 		}
 
-		_, err := ga.futuresClient.PlaceFuturesOrder(orderParams)
+		_, err := ga.placeFuturesOrder(orderParams)
 		if err != nil {
 			ga.logger.Error("MARKET close order failed",
 				"symbol", symbol,
@@ -7475,6 +8391,7 @@ func (ga *GinieAutopilot) closePositionAtMarket(pos *GiniePosition, reason strin
 
 	// Broadcast position closure to WebSocket clients for real-time UI update
 	ga.broadcastPositionClosure(symbol)
+	ga.publishPositionEvent(events.EventGiniePositionClosed, pos, map[string]interface{}{"reason": reason, "exit_price": currentPrice, "pnl": totalPnL, "pnl_percent": pnlPercent})
 
 	// Log position closed to trade lifecycle
 	if ga.eventLogger != nil && pos.FuturesTradeID > 0 {
@@ -7507,13 +8424,17 @@ func (ga *GinieAutopilot) broadcastPositionClosure(symbol string) {
 	positions := make([]map[string]interface{}, 0)
 	ga.mu.RLock()
 	for sym, pos := range ga.positions {
-		positions = append(positions, map[string]interface{}{
+		update := map[string]interface{}{
 			"symbol":            sym,
 			"side":              pos.Side,
 			"entry_price":       pos.EntryPrice,
 			"position_amt":      pos.RemainingQty,
 			"unrealized_profit": pos.UnrealizedPnL,
-		})
+		}
+		for k, v := range positionOriginFields(pos) {
+			update[k] = v
+		}
+		positions = append(positions, update)
 	}
 	ga.mu.RUnlock()
 
@@ -7565,6 +8486,9 @@ func (ga *GinieAutopilot) updateCoinLossTracking(symbol string, pnl float64, pnl
 		"pnl", pnl,
 		"roi_percent", pnlPercent)
 
+	// Many small losses in one day block the coin until the daily reset
+	defer ga.recordSymbolDailyLoss(symbol, pnl, pnlPercent, consecLosses)
+
 	// Check if this is a big single loss (>50% negative ROI with leverage)
 	// The pnlPercent is already the leveraged ROI from price movement
 	shouldBlock := false
@@ -7742,7 +8666,8 @@ func (ga *GinieAutopilot) getModeConfig(mode GinieTradingMode) *ModeFullConfig {
 
 	// CACHE-FIRST: Try to load user-specific config from cache
 	if isSettingsCacheValid(ga.settingsCache) && ga.userID != "" {
-		ctx := context.Background()
+		ctx, cancel := context.WithTimeout(context.Background(), dbReadTimeout)
+		defer cancel()
 		modeConfig, err := ga.settingsCache.GetModeConfig(ctx, ga.userID, modeStr)
 		if err != nil {
 			if isCacheUnavailableError(err) {
@@ -8260,6 +9185,30 @@ func (ga *GinieAutopilot) calculatePivotPoints(high, low, close float64) *PivotP
 	}
 }
 
+// tpLevelTrailingPercent returns the mode's trailing step for the position's
+// current TP level: the last non-zero TPLevelTrailingPercents entry at or below
+// CurrentTPLevel. An active runner trails at its own distance instead. 0 means
+// no override. Caller must hold ga.mu.
+func (ga *GinieAutopilot) tpLevelTrailingPercent(pos *GiniePosition) float64 {
+	if pos.Runner != nil && pos.Runner.Active {
+		return pos.Runner.TrailingPercent
+	}
+	if pos.CurrentTPLevel <= 0 {
+		return 0
+	}
+	modeConfig := ga.getModeConfig(pos.Mode)
+	if modeConfig == nil || modeConfig.SLTP == nil {
+		return 0
+	}
+	steps := modeConfig.SLTP.TPLevelTrailingPercents
+	for level := min(pos.CurrentTPLevel, len(steps)); level > 0; level-- {
+		if steps[level-1] > 0 {
+			return steps[level-1]
+		}
+	}
+	return 0
+}
+
 // getTrailingPercent reads trailing stop percent from Mode Config
 // Falls back to defaults if Mode Config is unavailable
 func (ga *GinieAutopilot) getTrailingPercent(mode GinieTradingMode) float64 {
@@ -8513,6 +9462,8 @@ func (ga *GinieAutopilot) calculateCurrentAllocation() float64 {
 }
 
 func (ga *GinieAutopilot) recordTrade(result GinieTradeResult) {
+	result.Tags = ga.tradeJournalTags(result)
+
 	// Add to in-memory history
 	ga.tradeHistory = append(ga.tradeHistory, result)
 	if len(ga.tradeHistory) > ga.maxHistory {
@@ -8521,6 +9472,45 @@ func (ga *GinieAutopilot) recordTrade(result GinieTradeResult) {
 
 	// Persist to database for analysis
 	ga.persistTradeToDatabase(result)
+
+	ga.notifyTrade(result)
+}
+
+// signalSummaryFromDecision summarizes the signals that triggered a decision for the trade record
+func signalSummaryFromDecision(decision *GinieDecisionReport) *GinieSignalSummary {
+	signalNames := make([]string, 0)
+	for _, sig := range decision.SignalAnalysis.PrimarySignals {
+		if sig.Met {
+			signalNames = append(signalNames, sig.Name)
+		}
+	}
+	return &GinieSignalSummary{
+		Direction:       decision.SignalAnalysis.Direction,
+		Strength:        decision.SignalAnalysis.SignalStrength,
+		StrengthScore:   decision.SignalAnalysis.StrengthScore,
+		PrimaryMet:      decision.SignalAnalysis.PrimaryMet,
+		PrimaryRequired: decision.SignalAnalysis.PrimaryRequired,
+		SignalNames:     signalNames,
+	}
+}
+
+// tradeJournalTags auto-tags a trade with its trigger source: Ginie, trading
+// mode, signal source and strategy, plus "shadow" for dry-run trades
+func (ga *GinieAutopilot) tradeJournalTags(result GinieTradeResult) []string {
+	tags := []string{"ginie", string(result.Mode), result.Source}
+	if result.StrategyName != nil {
+		tags = append(tags, "strategy:"+*result.StrategyName)
+	}
+	tags = append(tags, ga.dryRunTradeTags()...)
+	return database.NormalizeTradeTags(append(tags, result.Tags...))
+}
+
+// dryRunTradeTags tags trades Ginie only simulated so the journal can tell them apart
+func (ga *GinieAutopilot) dryRunTradeTags() []string {
+	if ga.config.DryRun {
+		return []string{"shadow"}
+	}
+	return nil
 }
 
 // persistTradeToDatabase saves trade result with confidence to database for analysis
@@ -8577,6 +9567,11 @@ func (ga *GinieAutopilot) persistTradeToDatabase(result GinieTradeResult) {
 			TradeSource:        "ginie",
 			AIDecisionID:       &aiDecision.ID,
 			TradingMode:        &tradingMode,
+			StrategyName:       result.StrategyName,
+			Tags:               result.Tags,
+		}
+		if result.Notes != "" {
+			trade.Notes = &result.Notes
 		}
 
 		if err := ga.repo.CreateFuturesTrade(ctx, trade); err != nil {
@@ -8604,9 +9599,15 @@ func (ga *GinieAutopilot) resetDailyCounters() {
 	}()
 
 	for {
-		now := time.Now()
-		next := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, now.Location())
+		ga.mu.RLock()
+		next := ga.config.NextDailyReset(time.Now())
+		ga.mu.RUnlock()
+
+		// Wake at least every minute so reset time/timezone changes apply without a restart
 		sleepDuration := time.Until(next)
+		if sleepDuration > time.Minute {
+			sleepDuration = time.Minute
+		}
 
 		// Use select to allow goroutine to stop when autopilot stops
 		select {
@@ -8614,16 +9615,16 @@ func (ga *GinieAutopilot) resetDailyCounters() {
 			ga.logger.Info("Ginie daily reset goroutine stopping")
 			return
 		case <-time.After(sleepDuration):
-			// Time to reset daily counters
 		}
 
 		ga.mu.Lock()
-		ga.dailyTrades = 0
-		ga.dailyPnL = 0
-		ga.dayStart = time.Now().Truncate(24 * time.Hour)
+		reset := ga.rollTradingDayLocked(time.Now())
+		dayStart := ga.dayStart
 		ga.mu.Unlock()
 
-		ga.logger.Info("Ginie autopilot daily counters reset")
+		if reset {
+			ga.logger.Info("Ginie autopilot daily counters reset", "day_start", dayStart)
+		}
 	}
 }
 
@@ -9036,6 +10037,7 @@ func (ga *GinieAutopilot) ForceSyncWithExchange(client ...binance.FuturesClient)
 			OriginalQty:  qty,
 			RemainingQty: qty,
 			Leverage:     pos.Leverage,
+			MarginType:   string(normalizeMarginType(pos.MarginType)),
 			EntryTime:    time.Now(), // We don't know actual entry time
 
 			// Generate default TPs based on entry price
@@ -9059,6 +10061,9 @@ func (ga *GinieAutopilot) ForceSyncWithExchange(client ...binance.FuturesClient)
 
 			// Initialize protection tracking (will be verified by guardian)
 			Protection: NewProtectionStatus(),
+
+			Source:      PositionSourceForceSync,
+			EntryReason: "Force-synced from exchange",
 		}
 
 		// Create FuturesTrade record in database for lifecycle tracking
@@ -9091,13 +10096,16 @@ func (ga *GinieAutopilot) ForceSyncWithExchange(client ...binance.FuturesClient)
 					EntryPrice:   pos.EntryPrice,
 					Quantity:     qty,
 					Leverage:     pos.Leverage,
-					MarginType:   "CROSSED",
+					MarginType:   string(normalizeMarginType(pos.MarginType)),
 					Status:       "OPEN",
 					EntryTime:    time.Now(),
 					TradeSource:  "force_sync", // Mark as force synced from exchange
 					TradingMode:  &tradingMode,
 				}
-				if err := ga.repo.CreateFuturesTrade(context.Background(), trade); err != nil {
+				ctx, cancel := context.WithTimeout(context.Background(), dbWriteTimeout)
+				err := ga.repo.CreateFuturesTrade(ctx, trade)
+				cancel()
+				if err != nil {
 					ga.logger.Warn("Failed to create futures trade record for force-synced position", "error", err, "symbol", symbol)
 				} else {
 					tradeID = trade.ID
@@ -9220,6 +10228,7 @@ func (ga *GinieAutopilot) SyncWithExchange() (int, error) {
 
 	// ========== PHASE 4: Remove stale positions with brief lock ==========
 	if len(toRemove) > 0 {
+		unlockSymbols := ga.symbolLocks.LockAll(toRemove)
 		ga.mu.Lock()
 		for _, symbol := range toRemove {
 			if _, exists := ga.positions[symbol]; exists {
@@ -9229,6 +10238,7 @@ func (ga *GinieAutopilot) SyncWithExchange() (int, error) {
 			}
 		}
 		ga.mu.Unlock()
+		unlockSymbols()
 		if removed > 0 {
 			ga.logger.Info("Cleaned up stale positions", "removed_count", removed)
 		}
@@ -9263,6 +10273,16 @@ func (ga *GinieAutopilot) SyncWithExchange() (int, error) {
 		})
 	}
 
+	// Positions with persisted state were opened or adopted by Ginie; the rest await adoption
+	savedStates := make(map[string]PersistedPositionState)
+	if len(toSync) > 0 && ga.config.RequireSyncedPositionAdoption {
+		if states, err := ga.LoadPositionState(); err != nil {
+			ga.logger.Warn("Failed to load saved position state for sync", "error", err)
+		} else {
+			savedStates = states
+		}
+	}
+
 	// ========== PHASE 6: Create positions and add to map WITH proper locking ==========
 	synced := 0
 	for _, data := range toSync {
@@ -9294,6 +10314,7 @@ func (ga *GinieAutopilot) SyncWithExchange() (int, error) {
 			OriginalQty:  qty,
 			RemainingQty: qty,
 			Leverage:     pos.Leverage,
+			MarginType:   string(normalizeMarginType(pos.MarginType)),
 			EntryTime:    time.Now(), // We don't know actual entry time
 
 			// Generate default TPs based on entry price
@@ -9317,6 +10338,11 @@ func (ga *GinieAutopilot) SyncWithExchange() (int, error) {
 
 			// Initialize protection tracking (will be verified by guardian)
 			Protection: NewProtectionStatus(),
+
+			AwaitingAdoption: ga.syncedPositionNeedsAdoption(symbol, side, savedStates),
+
+			Source:      PositionSourceSync,
+			EntryReason: "Synced from exchange",
 		}
 
 		// Create FuturesTrade record in database for lifecycle tracking (outside lock)
@@ -9382,13 +10408,16 @@ func (ga *GinieAutopilot) SyncWithExchange() (int, error) {
 					EntryPrice:   pos.EntryPrice,
 					Quantity:     qty,
 					Leverage:     pos.Leverage,
-					MarginType:   "CROSSED",
+					MarginType:   string(normalizeMarginType(pos.MarginType)),
 					Status:       "OPEN",
 					EntryTime:    time.Now(),
 					TradeSource:  "sync", // Mark as synced from exchange
 					TradingMode:  &tradingMode,
 				}
-				if err := ga.repo.CreateFuturesTrade(context.Background(), trade); err != nil {
+				ctx, cancel := context.WithTimeout(context.Background(), dbWriteTimeout)
+				err := ga.repo.CreateFuturesTrade(ctx, trade)
+				cancel()
+				if err != nil {
 					ga.logger.Warn("Failed to create futures trade record for synced position", "error", err, "symbol", symbol)
 				} else {
 					tradeID = trade.ID
@@ -9432,7 +10461,7 @@ func (ga *GinieAutopilot) SyncWithExchange() (int, error) {
 
 			// [Story 9.9] Initialize position optimization for synced positions if enabled for their mode
 			syncOptConfig := ga.getModeConfig(position.Mode)
-			if syncOptConfig != nil && syncOptConfig.PositionOptimization != nil && syncOptConfig.PositionOptimization.Enabled {
+			if !position.AwaitingAdoption && syncOptConfig != nil && syncOptConfig.PositionOptimization != nil && syncOptConfig.PositionOptimization.Enabled {
 				position.ScalpReentry = ga.initPositionOptimizationFromModeConfig(position, syncOptConfig.PositionOptimization)
 				ga.logger.Info("Position optimization initialized for synced position",
 					"symbol", symbol,
@@ -9445,7 +10474,8 @@ func (ga *GinieAutopilot) SyncWithExchange() (int, error) {
 				"qty", qty,
 				"entry_price", pos.EntryPrice,
 				"unrealized_pnl", pos.UnrealizedProfit,
-				"trade_id", position.FuturesTradeID)
+				"trade_id", position.FuturesTradeID,
+				"awaiting_adoption", position.AwaitingAdoption)
 		}
 		ga.mu.Unlock()
 	}
@@ -9466,10 +10496,11 @@ func (ga *GinieAutopilot) SyncPnLFromBinance() error {
 		return nil
 	}
 
-	// Calculate time boundaries
+	// Calculate time boundaries ("daily" is the configured trading day)
 	now := time.Now().UTC()
-	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
-	startOfDayMs := startOfDay.UnixMilli()
+	ga.mu.RLock()
+	startOfDayMs := ga.dayStart.UnixMilli()
+	ga.mu.RUnlock()
 
 	// For "total" PnL, fetch last 7 days (matches Binance UI default view)
 	sevenDaysAgo := now.AddDate(0, 0, -7)
@@ -9720,6 +10751,9 @@ func (ga *GinieAutopilot) placeSLTPOrders(pos *GiniePosition) {
 	// This guards against bugs where prices are calculated incorrectly (e.g., 97.xx instead of 12.xx)
 	pos = ga.validateAndFixSLTPPrices(pos)
 
+	// Leave the mode's runner out of the TP ladder
+	ga.applyRunner(pos)
+
 	// CRITICAL: Cancel ALL existing algo orders for this symbol FIRST
 	// This prevents accumulation of orphan orders when updating SL/TP
 	log.Printf("[GINIE] %s: Cancelling existing algo orders before placing new SL/TP", pos.Symbol)
@@ -9854,9 +10888,10 @@ func (ga *GinieAutopilot) placeSLTPOrders(pos *GiniePosition) {
 	var slOrderPlaced bool
 
 	for attempt := 1; attempt <= maxSLRetries; attempt++ {
-		slOrder, err := ga.futuresClient.PlaceAlgoOrder(slParams)
+		slOrder, err := ga.placeAlgoOrder(slParams)
 		if err == nil && slOrder != nil && slOrder.AlgoId > 0 {
 			pos.StopLossAlgoID = slOrder.AlgoId
+			pos.PlacedSL = pos.StopLoss
 			ga.logger.Info("Stop loss order placed",
 				"symbol", pos.Symbol,
 				"algo_id", slOrder.AlgoId,
@@ -9946,7 +10981,7 @@ func (ga *GinieAutopilot) placeSLTPOrders(pos *GiniePosition) {
 					ReduceOnly:   true,
 				}
 
-				order, err := ga.futuresClient.PlaceFuturesOrder(orderParams)
+				order, err := ga.placeFuturesOrder(orderParams)
 				if err != nil {
 					ga.logger.Error("Failed to execute immediate TP1 market order",
 						"symbol", pos.Symbol,
@@ -9966,6 +11001,7 @@ func (ga *GinieAutopilot) placeSLTPOrders(pos *GiniePosition) {
 					pos.RealizedPnL += pnl
 					ga.dailyPnL += pnl
 					ga.totalPnL += pnl
+					ga.publishPositionEvent(events.EventGiniePositionTPHit, pos, map[string]interface{}{"tp_level": 1, "price": currentPrice})
 
 					// Move to breakeven after TP1
 					if ga.config.MoveToBreakevenAfterTP1 && !pos.MovedToBreakeven {
@@ -10017,7 +11053,7 @@ func (ga *GinieAutopilot) placeSLTPOrders(pos *GiniePosition) {
 				var tpOrderPlaced bool
 
 				for attempt := 1; attempt <= maxTPRetries; attempt++ {
-					tpOrder, err := ga.futuresClient.PlaceAlgoOrder(tpParams)
+					tpOrder, err := ga.placeAlgoOrder(tpParams)
 					if err == nil && tpOrder != nil && tpOrder.AlgoId > 0 {
 						pos.TakeProfitAlgoIDs = append(pos.TakeProfitAlgoIDs, tpOrder.AlgoId)
 						ga.logger.Info("Take profit order placed",
@@ -10078,7 +11114,10 @@ func (ga *GinieAutopilot) placeSLTPOrdersForSyncedPositions() {
 	ga.mu.RLock()
 	positions := make([]*GiniePosition, 0, len(ga.positions))
 	for _, pos := range ga.positions {
-		positions = append(positions, pos)
+		// Unadopted positions keep whatever orders the user already has
+		if !pos.AwaitingAdoption {
+			positions = append(positions, pos)
+		}
 	}
 	ga.mu.RUnlock()
 
@@ -10266,8 +11305,12 @@ func (ga *GinieAutopilot) verifyPositionProtection(pos *GiniePosition) {
 		pos.Protection.SLOrderID = slOrderID
 		pos.Protection.SLVerified = true
 		pos.Protection.SLVerifiedAt = time.Now()
+		pos.Protection.UnprotectedSince = time.Time{}
 	} else {
 		pos.Protection.SLVerified = false
+		if pos.Protection.UnprotectedSince.IsZero() {
+			pos.Protection.UnprotectedSince = time.Now()
+		}
 	}
 
 	// Verify TP exists on Binance
@@ -10422,7 +11465,7 @@ func (ga *GinieAutopilot) checkAllPositionsProtection() {
 
 // checkSinglePositionProtection checks and handles protection for a single position
 func (ga *GinieAutopilot) checkSinglePositionProtection(pos *GiniePosition) {
-	if pos == nil {
+	if pos == nil || pos.AwaitingAdoption {
 		return
 	}
 
@@ -10440,17 +11483,30 @@ func (ga *GinieAutopilot) checkSinglePositionProtection(pos *GiniePosition) {
 	// Verify current protection status
 	ga.verifyPositionProtection(pos)
 
+	emergencyPolicy := ga.config.ProtectionTimeoutPolicy != ProtectionPolicyHeal
+
+	// Hard bound on naked exposure: SL still not verified this long after the fill
+	if !pos.Protection.SLVerified && !pos.Protection.UnprotectedSince.IsZero() && ga.config.ProtectionTimeoutSeconds > 0 {
+		unprotectedDuration := time.Since(pos.Protection.UnprotectedSince)
+		if unprotectedDuration > time.Duration(ga.config.ProtectionTimeoutSeconds)*time.Second {
+			if emergencyPolicy {
+				reason := fmt.Sprintf("SL not verified within %ds (unprotected for %v, heal attempts: %d)",
+					ga.config.ProtectionTimeoutSeconds, unprotectedDuration.Round(time.Second), pos.Protection.HealAttempts)
+				ga.emergencyClosePosition(pos, reason)
+				return
+			}
+			log.Printf("[PROTECTION] %s: Unprotected for %v (heal policy - keeps healing, heal attempts: %d)",
+				pos.Symbol, unprotectedDuration.Round(time.Second), pos.Protection.HealAttempts)
+		}
+	}
+
 	// Handle unprotected positions
 	if pos.Protection.State == StateUnprotected {
-		unprotectedDuration := pos.Protection.TimeSinceStateChange()
-
-		// Configuration: Max unprotected time before emergency close
-		const maxUnprotectedTime = 30 * time.Second
 		const maxHealAttempts = 3
 
-		if unprotectedDuration > maxUnprotectedTime || pos.Protection.HealAttempts >= maxHealAttempts {
-			// EMERGENCY: Position has been unprotected too long or too many heal attempts
-			reason := fmt.Sprintf("Unprotected for %v, heal attempts: %d", unprotectedDuration.Round(time.Second), pos.Protection.HealAttempts)
+		if emergencyPolicy && pos.Protection.HealAttempts >= maxHealAttempts {
+			// EMERGENCY: Too many failed heal attempts
+			reason := fmt.Sprintf("SL heal failed %d times", pos.Protection.HealAttempts)
 			ga.emergencyClosePosition(pos, reason)
 			return
 		}
@@ -10520,7 +11576,8 @@ func (ga *GinieAutopilot) placeTPOrderOnly(pos *GiniePosition) {
 	effectivePositionSide := ga.getEffectivePositionSide(positionSide)
 
 	// Determine if this is the final TP level - use ClosePosition=true to avoid residual
-	isFinalTPLevel := tpLevel >= len(pos.TakeProfits)-1
+	// (unless a runner is to be left open)
+	isFinalTPLevel := tpLevel >= len(pos.TakeProfits)-1 && pos.Runner == nil
 
 	roundedTP := roundPriceForTP(pos.Symbol, tp.Price, pos.Side)
 
@@ -10553,6 +11610,9 @@ func (ga *GinieAutopilot) placeTPOrderOnly(pos *GiniePosition) {
 	} else {
 		// For intermediate TP levels (TP1-3), calculate quantity for partial close
 		tpQty := roundQuantity(pos.Symbol, pos.RemainingQty*(tp.Percent/100.0))
+		if pos.Runner != nil && tpLevel >= len(pos.TakeProfits)-1 {
+			tpQty = runnerFinalTPQty(pos) // Final TP leaves the runner open
+		}
 		if tpQty <= 0 {
 			// For small positions, use full remaining quantity (converts to single TP mode)
 			tpQty = roundQuantity(pos.Symbol, pos.RemainingQty)
@@ -10579,7 +11639,7 @@ func (ga *GinieAutopilot) placeTPOrderOnly(pos *GiniePosition) {
 		}
 	}
 
-	tpOrder, err := ga.futuresClient.PlaceAlgoOrder(tpParams)
+	tpOrder, err := ga.placeAlgoOrder(tpParams)
 	if err == nil && tpOrder != nil && tpOrder.AlgoId > 0 {
 		pos.TakeProfitAlgoIDs = append(pos.TakeProfitAlgoIDs, tpOrder.AlgoId)
 		pos.Protection.TPOrderIDs = append(pos.Protection.TPOrderIDs, tpOrder.AlgoId)
@@ -10655,6 +11715,10 @@ func (ga *GinieAutopilot) cancelAllAlgoOrdersForSymbol(symbol string) (int, int,
 		return 0, 0, err
 	}
 
+	// Any native trailing stop is among the orders being cancelled; the monitor
+	// re-places it if trailing is still active
+	ga.clearNativeTrailing(symbol)
+
 	if len(openOrders) == 0 {
 		return 0, 0, nil
 	}
@@ -10832,9 +11896,13 @@ func (ga *GinieAutopilot) reconcilePositions() {
 		return // Only reconcile in live mode
 	}
 
+	// Surface drift before the corrections below silently absorb it
+	ga.checkPositionDriftIfDue()
+
 	// Get all positions from Binance
 	exchangePositions, err := ga.futuresClient.GetPositions()
 	if err != nil {
+		ga.recordExchangeError(err)
 		ga.logger.Debug("Failed to get exchange positions for reconciliation", "error", err)
 		return
 	}
@@ -10869,6 +11937,14 @@ func (ga *GinieAutopilot) reconcilePositions() {
 	positionsToRemove := make([]string, 0)
 
 	for symbol, internalPos := range ga.positions {
+		// Skip positions another operation holds (an entry may not have reached the
+		// exchange snapshot yet); TryLock because ga.mu is already held
+		unlockSymbol, ok := ga.symbolLocks.TryLock(symbol)
+		if !ok {
+			continue
+		}
+		defer unlockSymbol()
+
 		// Determine position side for lookup
 		exchangeSide := "LONG"
 		if internalPos.Side == "SHORT" {
@@ -11006,6 +12082,7 @@ func (ga *GinieAutopilot) reconcilePositions() {
 		}
 
 		delete(ga.positions, symbol)
+		ga.publishPositionEvent(events.EventGiniePositionClosed, pos, map[string]interface{}{"reason": closeReason, "exit_price": closePrice, "pnl": realizedPnL, "pnl_percent": pnlPercent})
 
 		// Cancel any remaining algo orders
 		go func(sym string) {
@@ -11077,12 +12154,7 @@ func (ga *GinieAutopilot) reconcilePositions() {
 			}
 
 			// BUG FIX: Check mode-specific position limit before adding
-			// Use getModeConfigForSizing to handle scalp_reentry -> scalp fallback for sizing config
-			modeConfig := ga.getModeConfigForSizing(externalMode)
-			maxPositions := ga.config.MaxPositions
-			if modeConfig != nil && modeConfig.Size != nil && modeConfig.Size.MaxPositions > 0 {
-				maxPositions = modeConfig.Size.MaxPositions
-			}
+			maxPositions, _ := ga.modePositionCap(externalMode)
 
 			// Count current positions for this mode (including ones we just added in this loop)
 			currentModePositions := addedPerMode[externalMode]
@@ -11134,6 +12206,10 @@ func (ga *GinieAutopilot) reconcilePositions() {
 
 				// PnL from exchange
 				UnrealizedPnL: exchangePos.UnrealizedProfit,
+
+				// Restored below if Ginie opened it before a restart
+				Source:      PositionSourceExternal,
+				EntryReason: "Adopted from exchange during reconciliation",
 			}
 
 			// CRITICAL FIX: Restore saved state BEFORE adding position to map
@@ -11141,10 +12217,11 @@ func (ga *GinieAutopilot) reconcilePositions() {
 			if savedState, found := savedStates[exchangePos.Symbol]; found {
 				ga.RestorePositionState(newPos, savedState)
 			}
+			newPos.AwaitingAdoption = ga.syncedPositionNeedsAdoption(exchangePos.Symbol, side, savedStates)
 
 			// [Story 9.9] Initialize position optimization for reconciled positions if enabled for their mode
 			// Do this after RestorePositionState in case saved state doesn't have ScalpReentry
-			if newPos.ScalpReentry == nil {
+			if newPos.ScalpReentry == nil && !newPos.AwaitingAdoption {
 				reconOptConfig := ga.getModeConfig(newPos.Mode)
 				if reconOptConfig != nil && reconOptConfig.PositionOptimization != nil && reconOptConfig.PositionOptimization.Enabled {
 					newPos.ScalpReentry = ga.initPositionOptimizationFromModeConfig(newPos, reconOptConfig.PositionOptimization)
@@ -11204,6 +12281,9 @@ func (ga *GinieAutopilot) cleanupOrphanAlgoOrders() {
 		return // Only cleanup in live mode
 	}
 
+	// Record what is about to be treated as orphaned before anything is cancelled
+	ga.scanOrphanAlgoOrders()
+
 	// Get all symbols with tracked positions
 	ga.mu.RLock()
 	trackedSymbols := make(map[string]bool)
@@ -11293,6 +12373,9 @@ func (ga *GinieAutopilot) cleanupAllOrphanOrders() {
 		return
 	}
 
+	// Record what is about to be treated as orphaned before anything is cancelled
+	ga.scanOrphanAlgoOrders()
+
 	// Get all open positions from Binance
 	exchangePositions, err := ga.futuresClient.GetPositions()
 	if err != nil {
@@ -11505,6 +12588,11 @@ func (ga *GinieAutopilot) modifySLTPOrders(pos *GiniePosition, newSL float64, ne
 		return
 	}
 
+	// A worsening SL is dropped; the TPs are still updated around the placed SL
+	if newSL > 0 && ga.rejectSLWorsening(pos, newSL, "llm") {
+		newSL = 0
+	}
+
 	// Cancel ALL existing algo orders from Binance (more robust than stored IDs)
 	success, failed, err := ga.cancelAllAlgoOrdersForSymbol(pos.Symbol)
 	if err != nil || failed > 0 {
@@ -11665,6 +12753,10 @@ func (ga *GinieAutopilot) checkPositionsForEarlyWarning() {
 	globalMinConfidence := settings.EarlyWarningMinConfidence
 
 	for _, pos := range positionsToCheck {
+		if pos.AwaitingAdoption {
+			continue
+		}
+
 		// [Story 9.9] REMOVED: Early warning skip for scalp_reentry mode
 		// All positions now go through early warning regardless of position optimization status
 		// Position optimization is a feature, not a mode - original mode rules still apply
@@ -11885,6 +12977,7 @@ func (ga *GinieAutopilot) checkPositionsForEarlyWarning() {
 					"consecutive_warnings", consecutiveWarnings)
 
 				// Close the position
+				unlockSymbol := ga.symbolLocks.Lock(pos.Symbol)
 				ga.mu.Lock()
 				if p, exists := ga.positions[pos.Symbol]; exists {
 					ga.mu.Unlock()
@@ -11902,6 +12995,7 @@ func (ga *GinieAutopilot) checkPositionsForEarlyWarning() {
 				} else {
 					ga.mu.Unlock()
 				}
+				unlockSymbol()
 			}
 
 		case "tighten_sl":
@@ -11980,14 +13074,18 @@ func (ga *GinieAutopilot) checkPositionsForEarlyWarning() {
 
 // updateStopLossOrder updates the stop loss order on the exchange
 func (ga *GinieAutopilot) updateStopLossOrder(symbol string, newSL float64) {
-	ga.mu.RLock()
+	ga.mu.Lock()
 	pos, exists := ga.positions[symbol]
 	if !exists {
-		ga.mu.RUnlock()
+		ga.mu.Unlock()
+		return
+	}
+	if ga.rejectSLWorsening(pos, newSL, "early_warning") {
+		ga.mu.Unlock()
 		return
 	}
 	slOrderID := pos.StopLossAlgoID
-	ga.mu.RUnlock()
+	ga.mu.Unlock()
 
 	// Cancel existing SL order
 	if slOrderID > 0 {
@@ -12024,7 +13122,7 @@ func (ga *GinieAutopilot) updateStopLossOrder(symbol string, newSL float64) {
 		ReduceOnly:   true,
 	}
 
-	resp, err := ga.futuresClient.PlaceAlgoOrder(slParams)
+	resp, err := ga.placeAlgoOrder(slParams)
 	if err != nil {
 		ga.logger.Error("Failed to place new SL order", "symbol", symbol, "error", err.Error())
 		return
@@ -12034,6 +13132,7 @@ func (ga *GinieAutopilot) updateStopLossOrder(symbol string, newSL float64) {
 	if p, exists := ga.positions[symbol]; exists {
 		p.StopLossAlgoID = resp.AlgoId
 		p.StopLoss = newSL
+		p.PlacedSL = newSL
 	}
 	ga.mu.Unlock()
 
@@ -12052,6 +13151,10 @@ func (ga *GinieAutopilot) checkAndUpdateAdaptiveSLTP() {
 	now := time.Now()
 
 	for symbol, pos := range ga.positions {
+		if pos.AwaitingAdoption {
+			continue
+		}
+
 		// Determine update interval based on mode
 		var updateInterval time.Duration
 		switch pos.Mode {
@@ -12069,13 +13172,30 @@ func (ga *GinieAutopilot) checkAndUpdateAdaptiveSLTP() {
 		if now.Sub(pos.LastLLMUpdate) >= updateInterval {
 			// Update SL/TP from LLM (run without lock to avoid blocking)
 			ga.mu.Unlock()
+			unlockSymbol := ga.symbolLocks.Lock(symbol)
 			ga.updatePositionSLTPFromLLM(symbol, pos)
+			unlockSymbol()
 			ga.mu.Lock()
 		}
 	}
 }
 
 // updatePositionSLTPFromLLM gets updated SL/TP suggestions from LLM and modifies orders
+// llmContext returns a context for LLM calls that is cancelled when the
+// autopilot stops; each call is additionally bounded by the analyzer's RequestTimeout
+func (ga *GinieAutopilot) llmContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stopChan := ga.stopChan
+	go func() {
+		select {
+		case <-stopChan:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
 func (ga *GinieAutopilot) updatePositionSLTPFromLLM(symbol string, pos *GiniePosition) {
 	if pos == nil || ga.llmAnalyzer == nil {
 		return
@@ -12151,13 +13271,22 @@ func (ga *GinieAutopilot) updatePositionSLTPFromLLM(symbol string, pos *GiniePos
 	}
 
 	// Call LLM analyzer with FULL position context
-	sltpAnalysis, err := ga.llmAnalyzer.AnalyzePositionSLTP(posInfo, klines)
+	llmCtx, cancel := ga.llmContext()
+	sltpAnalysis, err := ga.llmAnalyzer.AnalyzePositionSLTPContext(llmCtx, posInfo, klines)
+	cancel()
 
 	// Track LLM call time for diagnostics
 	ga.mu.Lock()
 	ga.lastLLMCallTime = time.Now()
 	ga.mu.Unlock()
 
+	if errors.Is(err, llm.ErrRequestTimeout) {
+		ga.logger.Warn("LLM SL/TP analysis timed out, keeping current SL/TP",
+			"symbol", symbol,
+			"current_sl", pos.StopLoss,
+			"current_tp", currentTP)
+		return
+	}
 	if err != nil {
 		ga.logger.Debug("LLM SL/TP analysis failed",
 			"symbol", symbol,
@@ -12311,13 +13440,9 @@ func (ga *GinieAutopilot) updatePositionSLTPFromLLM(symbol string, pos *GiniePos
 		ga.mu.Lock()
 		// Check if we should move to breakeven (after TP1 hit)
 		if pos.CurrentTPLevel >= 1 && !pos.MovedToBreakeven && ga.config.MoveToBreakevenAfterTP1 {
-			// Move SL to breakeven (entry price + small buffer)
-			breakevenSL := pos.EntryPrice
-			if pos.Side == "LONG" {
-				breakevenSL = pos.EntryPrice * (1 + ga.config.BreakevenBuffer/100)
-			} else {
-				breakevenSL = pos.EntryPrice * (1 - ga.config.BreakevenBuffer/100)
-			}
+			// Move SL to breakeven (entry price + fees + small buffer)
+			breakevenSL, _, _ := ga.breakevenStopPrice(pos)
+			ga.recordSLUpdateLocked(symbol, pos.StopLoss, breakevenSL, currentPrice, "applied", "", "breakeven", 0)
 			newSL = breakevenSL
 			pos.MovedToBreakeven = true
 			ga.logger.Info("Moving SL to breakeven after TP1",
@@ -12392,6 +13517,10 @@ func (ga *GinieAutopilot) RecalculateAdaptiveSLTP() (int, error) {
 	updated := 0
 
 	for symbol, pos := range ga.positions {
+		if pos.AwaitingAdoption {
+			continue
+		}
+
 		// Get klines for ATR calculation - use position's mode entry timeframe
 		timeframe := ga.getEntryTimeframe(pos.Mode) // default based on mode
 		klines, err := ga.futuresClient.GetFuturesKlines(symbol, timeframe, 50)
@@ -12871,8 +14000,9 @@ func (ga *GinieAutopilot) RecalculateAdaptiveSLTP() (int, error) {
 			var slOrderPlaced bool
 
 			for attempt := 1; attempt <= maxSLRetries; attempt++ {
-				if slOrder, err := ga.futuresClient.PlaceAlgoOrder(slParams); err == nil && slOrder != nil && slOrder.AlgoId > 0 {
+				if slOrder, err := ga.placeAlgoOrder(slParams); err == nil && slOrder != nil && slOrder.AlgoId > 0 {
 					pos.StopLossAlgoID = slOrder.AlgoId
+					pos.PlacedSL = slPrice
 					ga.logger.Info("SLTP: SL order placed", "symbol", posSymbol, "price", slPrice, "attempt", attempt)
 					slOrderPlaced = true
 					break
@@ -12928,7 +14058,7 @@ func (ga *GinieAutopilot) RecalculateAdaptiveSLTP() (int, error) {
 
 				var tpOrderPlaced bool
 				for attempt := 1; attempt <= maxTPRetries; attempt++ {
-					if tpOrder, err := ga.futuresClient.PlaceAlgoOrder(tpParams); err == nil && tpOrder != nil && tpOrder.AlgoId > 0 {
+					if tpOrder, err := ga.placeAlgoOrder(tpParams); err == nil && tpOrder != nil && tpOrder.AlgoId > 0 {
 						newTPIDs = append(newTPIDs, tpOrder.AlgoId)
 						ga.logger.Info("SLTP: TP order placed", "symbol", posSymbol, "level", i+1, "price", tpPrice, "qty", tpQty, "attempt", attempt)
 						tpOrderPlaced = true
@@ -12991,7 +14121,9 @@ func (ga *GinieAutopilot) RecalculateAdaptiveSLTPAsync() string {
 	ga.mu.RLock()
 	positions := make([]*GiniePosition, 0, len(ga.positions))
 	for _, pos := range ga.positions {
-		positions = append(positions, pos)
+		if !pos.AwaitingAdoption {
+			positions = append(positions, pos)
+		}
 	}
 	ga.mu.RUnlock()
 
@@ -13520,6 +14652,20 @@ func (ga *GinieAutopilot) GetSignalLogs(limit int) []GinieSignalLog {
 	return result
 }
 
+// GetSignalLog returns the signal log entry with the given ID, if still retained
+func (ga *GinieAutopilot) GetSignalLog(id string) (*GinieSignalLog, bool) {
+	ga.mu.RLock()
+	defer ga.mu.RUnlock()
+
+	for i := len(ga.signalLogs) - 1; i >= 0; i-- {
+		if ga.signalLogs[i].ID == id {
+			sig := ga.signalLogs[i]
+			return &sig, true
+		}
+	}
+	return nil, false
+}
+
 // GetSignalStats returns signal statistics for the last hour (consistent with diagnostics)
 func (ga *GinieAutopilot) GetSignalStats() map[string]interface{} {
 	ga.mu.RLock()
@@ -13569,42 +14715,7 @@ func (ga *GinieAutopilot) GetSignalStats() map[string]interface{} {
 func (ga *GinieAutopilot) RecordSLUpdate(symbol string, oldSL, newSL, currentPrice float64, status, rejectionRule, source string, llmConfidence float64) {
 	ga.mu.Lock()
 	defer ga.mu.Unlock()
-
-	// Initialize history if needed
-	if ga.slUpdateHistory[symbol] == nil {
-		ga.slUpdateHistory[symbol] = &SLUpdateHistory{
-			Symbol:  symbol,
-			Updates: make([]SLUpdateRecord, 0, 100),
-		}
-	}
-
-	history := ga.slUpdateHistory[symbol]
-
-	// Add record
-	record := SLUpdateRecord{
-		Timestamp:     time.Now(),
-		OldSL:         oldSL,
-		NewSL:         newSL,
-		CurrentPrice:  currentPrice,
-		Status:        status,
-		RejectionRule: rejectionRule,
-		Source:        source,
-		LLMConfidence: llmConfidence,
-	}
-
-	history.Updates = append(history.Updates, record)
-	history.TotalAttempts++
-
-	if status == "applied" {
-		history.Applied++
-	} else {
-		history.Rejected++
-	}
-
-	// Trim if over limit (keep last 100 updates per symbol)
-	if len(history.Updates) > 100 {
-		history.Updates = history.Updates[len(history.Updates)-100:]
-	}
+	ga.recordSLUpdateLocked(symbol, oldSL, newSL, currentPrice, status, rejectionRule, source, llmConfidence)
 }
 
 // GetSLUpdateHistory returns SL update history for a symbol
@@ -13698,7 +14809,45 @@ func (ga *GinieAutopilot) CloseAllPositions() (int, float64, error) {
 		return 0, 0, err
 	}
 
-	ga.mu.Lock()
+	// Wait for in-flight operations on each symbol before closing it. An entry
+	// may open another position before ga.mu is taken, so retry until every
+	// tracked position is covered by a held symbol lock.
+	var unlockSymbols func()
+	for {
+		ga.mu.RLock()
+		symbols := make([]string, 0, len(ga.positions))
+		locked := make(map[string]bool, len(ga.positions))
+		for symbol := range ga.positions {
+			symbols = append(symbols, symbol)
+			locked[symbol] = true
+		}
+		ga.mu.RUnlock()
+		unlockSymbols = ga.symbolLocks.LockAll(symbols)
+
+		ga.mu.Lock()
+		covered := true
+		for symbol := range ga.positions {
+			if !locked[symbol] {
+				covered = false
+				break
+			}
+		}
+		if covered {
+			break
+		}
+		ga.mu.Unlock()
+		unlockSymbols()
+	}
+	defer unlockSymbols()
+
+	// Mode circuit breaker and safety bookkeeping take ga.mu themselves, so it
+	// runs once ga.mu is released (deferred calls run last-in, first-out)
+	var modeResults []func()
+	defer func() {
+		for _, record := range modeResults {
+			record()
+		}
+	}()
 	defer ga.mu.Unlock()
 
 	closedCount := 0
@@ -13752,7 +14901,7 @@ func (ga *GinieAutopilot) CloseAllPositions() (int, float64, error) {
 				Quantity:     pos.RemainingQty,
 			}
 
-			_, err := ga.futuresClient.PlaceFuturesOrder(orderParams)
+			_, err := ga.placeFuturesOrder(orderParams)
 			if err != nil {
 				ga.logger.Error("Ginie panic close failed", "symbol", symbol, "error", err)
 				continue
@@ -13770,7 +14919,11 @@ func (ga *GinieAutopilot) CloseAllPositions() (int, float64, error) {
 		}
 
 		// Record to MODE circuit breaker for mode-specific loss tracking
-		ga.RecordModeTradeResult(pos.Mode, pnl)
+		mode := pos.Mode
+		modeResults = append(modeResults, func() {
+			ga.RecordModeTradeResult(mode, pnl)
+			ga.recordModeTradeClosure(mode, symbol, pnl, pnlPercent)
+		})
 
 		// Record trade
 		ga.recordTrade(GinieTradeResult{
@@ -13827,11 +14980,14 @@ func (ga *GinieAutopilot) GetDiagnostics() *GinieDiagnostics {
 		OpenCount:      len(ga.positions),
 		MaxAllowed:     ga.config.MaxPositions,
 		SlotsAvailable: ga.config.MaxPositions - len(ga.positions),
+		Modes:          ga.getModePositionDiagnosticsLocked(),
 	}
 	// Calculate total unrealized PnL
 	for _, pos := range ga.positions {
 		diag.Positions.TotalUnrealizedPnL += pos.UnrealizedPnL
 	}
+	diag.Positions.TotalNotionalUSD = ga.totalNotionalLocked()
+	diag.Positions.MaxNotionalUSD = ga.config.MaxTotalNotionalUSD
 
 	// Scanning status
 	diag.Scanning = ga.getScanDiagnosticsLocked()
@@ -13846,10 +15002,36 @@ func (ga *GinieAutopilot) GetDiagnostics() *GinieDiagnostics {
 	for _, info := range ga.blockedCoins {
 		diag.BlockedCoins = append(diag.BlockedCoins, info)
 	}
+	diag.SymbolLosses = ga.getSymbolDailyLossDiagnosticsLocked()
 
 	// LLM status
 	diag.LLMStatus = ga.getLLMDiagnosticsLocked()
 
+	// Orphan algo-order scans
+	diag.OrphanOrders = ga.orphanStats
+	diag.OrphanOrders.AlertThreshold = ga.config.OrphanOrderAlertThreshold
+
+	// Position drift against the exchange
+	diag.PositionDrift = ga.driftStats
+
+	// Exchange availability / maintenance hold
+	diag.Exchange = ga.getExchangeStatusLocked()
+
+	// Account margin ratio and its sizing effect
+	diag.MarginRatio = ga.getMarginRatioDiagnosticsLocked()
+
+	// Daily profit target progress
+	diag.DailyProfit = ga.getDailyProfitTargetDiagnosticsLocked()
+
+	// Adaptive win-rate throttle per mode
+	diag.ModeThrottle = ga.getThrottleDiagnosticsLocked()
+
+	// Cross-mode execution queue
+	diag.ExecutionQueue = ga.getExecutionQueueDiagnosticsLocked()
+
+	// Loss-streak cool-off and the resulting confidence threshold
+	diag.LossCoolOff = ga.getLossCoolOffDiagnosticsLocked()
+
 	// Generate issue recommendations
 	diag.Issues = ga.generateIssueRecommendationsLocked(diag)
 
@@ -13863,6 +15045,16 @@ func (ga *GinieAutopilot) canTradeWithReasonLocked() (bool, string) {
 		return false, "autopilot_stopped: Ginie autopilot is not running"
 	}
 
+	if ga.config.ManageOnlyMode {
+		return false, fmt.Sprintf("%s: new entries paused, %d open positions still managed",
+			RejectionManageOnly, len(ga.positions))
+	}
+
+	if ga.inMaintenanceHoldLocked() {
+		return false, fmt.Sprintf("%s: exchange unavailable since %s, waiting for it to come back",
+			RejectionExchangeMaintenance, ga.exchangeStatus.HoldSince.Format(time.RFC3339))
+	}
+
 	// Circuit breaker check
 	if ga.config.CircuitBreakerEnabled && ga.circuitBreaker != nil {
 		canTrade, reason := ga.circuitBreaker.CanTrade()
@@ -13876,6 +15068,10 @@ func (ga *GinieAutopilot) canTradeWithReasonLocked() (bool, string) {
 		return false, fmt.Sprintf("max_positions: %d/%d slots used",
 			len(ga.positions), ga.config.MaxPositions)
 	}
+	if rampCap, rampingUp := ga.rampUpPositionCapLocked(); rampingUp && len(ga.positions) >= rampCap {
+		return false, fmt.Sprintf("ramp_up: %d/%d slots used (ramping to %d after circuit breaker reset)",
+			len(ga.positions), rampCap, ga.config.MaxPositions)
+	}
 
 	// Daily trade limit check
 	if ga.dailyTrades >= ga.config.MaxDailyTrades {
@@ -13889,6 +15085,12 @@ func (ga *GinieAutopilot) canTradeWithReasonLocked() (bool, string) {
 			-ga.dailyPnL, ga.config.MaxDailyLoss)
 	}
 
+	// Daily profit target check
+	if ga.dailyProfitTargetReachedLocked() {
+		return false, fmt.Sprintf("%s: $%.2f >= target $%.2f",
+			RejectionDailyProfitTarget, ga.dailyPnL, ga.config.MaxDailyProfit)
+	}
+
 	// Check if any mode is enabled - uses isModeEnabled() for real-time DB read
 	if !ga.isModeEnabled(GinieModeUltraFast) && !ga.isModeEnabled(GinieModeScalp) && !ga.isModeEnabled(GinieModeSwing) && !ga.isModeEnabled(GinieModePosition) {
 		return false, "no_modes: No trading modes enabled (ultra_fast/scalp/swing/position)"
@@ -14091,11 +15293,19 @@ func (ga *GinieAutopilot) getLLMDiagnosticsLocked() LLMDiagnostics {
 		DisabledSymbols: make([]string, 0),
 	}
 
-	// Check LLM availability
-	if ga.llmAnalyzer != nil && ga.llmAnalyzer.IsEnabled() {
-		diag.Connected = true
+	// Check LLM availability (false while the provider is degraded)
+	if ga.llmAnalyzer != nil {
+		diag.Connected = ga.llmAnalyzer.IsEnabled()
 		if client := ga.llmAnalyzer.GetClient(); client != nil {
 			diag.Provider = string(client.GetProvider())
+			health := client.Health()
+			diag.Degraded = health.Degraded
+			diag.ConsecutiveFailures = health.ConsecutiveFailures
+			diag.LastError = health.LastError
+			diag.LastErrorTime = health.LastErrorTime
+			diag.DegradedUntil = health.DegradedUntil
+			diag.Latency = client.LatencyStats()
+			diag.Concurrency = client.ConcurrencyStats()
 		}
 	}
 
@@ -14160,6 +15370,16 @@ func (ga *GinieAutopilot) generateIssueRecommendationsLocked(diag *GinieDiagnost
 		})
 	}
 
+	// Critical: Exchange down for maintenance
+	if diag.Exchange.State == ExchangeStateMaintenance {
+		issues = append(issues, DiagnosticIssue{
+			Severity:   "critical",
+			Category:   "trading",
+			Message:    fmt.Sprintf("Exchange maintenance hold since %s: %s", diag.Exchange.HoldSince.Format(time.RFC3339), diag.Exchange.LastError),
+			Suggestion: "Nothing to do - new entries resume automatically once the exchange answers again",
+		})
+	}
+
 	// Critical: Circuit breaker open
 	if diag.CircuitBreaker.State == "open" {
 		issues = append(issues, DiagnosticIssue{
@@ -14180,6 +15400,16 @@ func (ga *GinieAutopilot) generateIssueRecommendationsLocked(diag *GinieDiagnost
 		})
 	}
 
+	// Critical: Notional cap reached
+	if diag.Positions.MaxNotionalUSD > 0 && diag.Positions.TotalNotionalUSD >= diag.Positions.MaxNotionalUSD {
+		issues = append(issues, DiagnosticIssue{
+			Severity:   "critical",
+			Category:   "trading",
+			Message:    fmt.Sprintf("Notional exposure cap reached ($%.2f/$%.2f)", diag.Positions.TotalNotionalUSD, diag.Positions.MaxNotionalUSD),
+			Suggestion: "Wait for positions to close or increase max_total_notional_usd config",
+		})
+	}
+
 	// Critical: No modes enabled
 	if !diag.Scanning.UltraFastEnabled && !diag.Scanning.ScalpEnabled && !diag.Scanning.SwingEnabled && !diag.Scanning.PositionEnabled {
 		issues = append(issues, DiagnosticIssue{
@@ -14281,8 +15511,15 @@ func (ga *GinieAutopilot) generateIssueRecommendationsLocked(diag *GinieDiagnost
 		})
 	}
 
-	// Info: LLM not connected
-	if !diag.LLMStatus.Connected {
+	// Warning: LLM provider failing, rule-based fallback in use
+	if diag.LLMStatus.Degraded {
+		issues = append(issues, DiagnosticIssue{
+			Severity:   "warning",
+			Category:   "config",
+			Message:    fmt.Sprintf("LLM provider degraded after %d consecutive failures: %s", diag.LLMStatus.ConsecutiveFailures, diag.LLMStatus.LastError),
+			Suggestion: fmt.Sprintf("Using rule-based analysis until %s; check provider status and API key", diag.LLMStatus.DegradedUntil.Format("15:04:05")),
+		})
+	} else if !diag.LLMStatus.Connected {
 		issues = append(issues, DiagnosticIssue{
 			Severity:   "info",
 			Category:   "config",
@@ -14405,6 +15642,22 @@ func (ga *GinieAutopilot) executeStrategyTrade(signal *StrategySignal) {
 		return
 	}
 
+	if ga.config.ManageOnlyMode {
+		ga.logger.Warn("Strategy trade skipped - manage-only mode",
+			"symbol", symbol,
+			"strategy", signal.StrategyName)
+		return
+	}
+
+	if ga.openRateLimitedLocked(time.Now()) {
+		ga.rateLimitedOpens++
+		ga.logger.Warn("Strategy trade skipped - rate_limited_opens",
+			"symbol", symbol,
+			"strategy", signal.StrategyName,
+			"max_positions_per_minute", ga.config.MaxPositionsPerMinute)
+		return
+	}
+
 	// Check if coin is blocked due to big losses
 	if blocked, reason := ga.isCoinBlocked(symbol); blocked {
 		ga.logger.Warn("Strategy trade skipped - coin is blocked",
@@ -14545,6 +15798,8 @@ func (ga *GinieAutopilot) executeStrategyTrade(signal *StrategySignal) {
 	actualPrice := price
 	actualQty := quantity
 
+	entryMarginType := ga.modeMarginType(strategyMode)
+
 	if !ga.config.DryRun {
 		// Need to unlock for API calls
 		ga.mu.Unlock()
@@ -14559,6 +15814,16 @@ func (ga *GinieAutopilot) executeStrategyTrade(signal *StrategySignal) {
 			return
 		}
 
+		entryMarginType, err = ga.applyMarginType(symbol, strategyMode)
+		if err != nil {
+			ga.logger.Error("Failed to set margin type for strategy trade",
+				"symbol", symbol,
+				"mode", strategyMode,
+				"error", err.Error())
+			ga.mu.Lock()
+			return
+		}
+
 		// Place market order
 		orderParams := binance.FuturesOrderParams{
 			Symbol:       symbol,
@@ -14568,7 +15833,7 @@ func (ga *GinieAutopilot) executeStrategyTrade(signal *StrategySignal) {
 			Quantity:     quantity,
 		}
 
-		order, orderErr := ga.futuresClient.PlaceFuturesOrder(orderParams)
+		order, orderErr := ga.placeFuturesOrder(orderParams)
 		if orderErr != nil {
 			ga.logger.Error("Strategy trade execution failed",
 				"symbol", symbol,
@@ -14625,6 +15890,7 @@ func (ga *GinieAutopilot) executeStrategyTrade(signal *StrategySignal) {
 		OriginalQty:           actualQty,
 		RemainingQty:          actualQty,
 		Leverage:              leverage,
+		MarginType:            string(entryMarginType),
 		EntryTime:             time.Now(),
 		TakeProfits:           takeProfits,
 		CurrentTPLevel:        0,
@@ -14637,13 +15903,16 @@ func (ga *GinieAutopilot) executeStrategyTrade(signal *StrategySignal) {
 		TrailingPercent:       ga.getTrailingPercent(strategyMode),
 		TrailingActivationPct: ga.getTrailingActivation(strategyMode),
 		DecisionReport:        nil, // No AI decision report for strategy trades
-		Source:                "strategy",
+		Source:                PositionSourceStrategy,
 		StrategyID:            &stratID,
 		StrategyName:          &stratName,
+		EntryReason:           strategyEntryReason(signal),
 		Protection:            NewProtectionStatus(), // Initialize protection tracking
 	}
 
 	ga.positions[symbol] = position
+	ga.recentOpens = append(ga.recentOpens, time.Now())
+	ga.publishPositionEvent(events.EventGiniePositionOpened, position, map[string]interface{}{"mode": position.Mode, "source": position.Source})
 	ga.dailyTrades++
 	ga.totalTrades++
 
@@ -14697,7 +15966,8 @@ func (ga *GinieAutopilot) executeStrategyTrade(signal *StrategySignal) {
 // Returns (canTrade, reason)
 func (ga *GinieAutopilot) canAllocateForMode(mode GinieTradingMode, requestedUSD float64) (bool, string) {
 	settings := GetSettingsManager()
-	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(context.Background(), dbReadTimeout)
+	defer cancel()
 
 	// Load user allocation from database
 	allocationConfig, err := settings.GetUserModeAllocation(ctx, ga.repo, ga.userID)
@@ -14847,7 +16117,8 @@ func (ga *GinieAutopilot) releaseCapital(mode GinieTradingMode, positionUSD floa
 // GetModeAllocationStatus returns the current allocation status for all modes
 func (ga *GinieAutopilot) GetModeAllocationStatus() map[string]interface{} {
 	settings := GetSettingsManager()
-	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(context.Background(), dbReadTimeout)
+	defer cancel()
 	balance, _ := ga.getAvailableBalance()
 
 	ga.mu.RLock()
@@ -14879,7 +16150,8 @@ func (ga *GinieAutopilot) GetModeAllocationStatus() map[string]interface{} {
 // This is used when the API needs to show allocations based on a user's real Binance balance.
 func (ga *GinieAutopilot) GetModeAllocationStatusWithBalance(balance float64) map[string]interface{} {
 	settings := GetSettingsManager()
-	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(context.Background(), dbReadTimeout)
+	defer cancel()
 
 	ga.mu.RLock()
 	defer ga.mu.RUnlock()
@@ -14926,8 +16198,8 @@ func (ga *GinieAutopilot) checkRateLimit(mode GinieTradingMode) (bool, string) {
 
 	now := time.Now()
 
-	// Reset daily counter at midnight
-	if ga.lastDayReset.Day() != now.Day() {
+	// Reset daily counter at the start of each trading day
+	if ga.lastDayReset.Before(ga.config.TradingDayStart(now)) {
 		ga.lastDayReset = now
 		for _, s := range ga.modeSafetyStates {
 			s.TradesToday = 0
@@ -15154,6 +16426,8 @@ func (ga *GinieAutopilot) recordModeTradeClosure(mode GinieTradingMode, symbol s
 	ga.mu.Lock()
 	defer ga.mu.Unlock()
 
+	ga.recordLossCoolOffLocked(symbol, pnlUSD)
+
 	modeStr := string(mode)
 	state, exists := ga.modeSafetyStates[modeStr]
 	if !exists {
@@ -15177,10 +16451,16 @@ func (ga *GinieAutopilot) recordModeTradeClosure(mode GinieTradingMode, symbol s
 	if exists && config != nil {
 		state.RecentTrades = append(state.RecentTrades, tradeResult)
 		// Keep only the most recent trades (circular buffer)
-		if len(state.RecentTrades) > config.WinRateSampleSize*2 {
+		keep := config.WinRateSampleSize
+		if ga.config.ThrottleSampleSize > keep {
+			keep = ga.config.ThrottleSampleSize
+		}
+		if len(state.RecentTrades) > keep*2 {
 			state.RecentTrades = state.RecentTrades[1:]
 		}
 	}
+
+	ga.updateAdaptiveThrottleLocked(state)
 }
 
 // === ULTRA-FAST SCALPING MODE METHODS ===
@@ -15276,6 +16556,18 @@ func (ga *GinieAutopilot) checkUltraFastExits() {
 		}
 	}
 
+	// Skip positions another operation holds; TryLock because ga.mu is already held
+	lockedPositions := ultraFastPositions[:0]
+	for _, pos := range ultraFastPositions {
+		unlockSymbol, ok := ga.symbolLocks.TryLock(pos.Symbol)
+		if !ok {
+			continue
+		}
+		defer unlockSymbol()
+		lockedPositions = append(lockedPositions, pos)
+	}
+	ultraFastPositions = lockedPositions
+
 	if len(ultraFastPositions) == 0 {
 		return
 	}
@@ -15330,7 +16622,7 @@ func (ga *GinieAutopilot) checkUltraFastExits() {
 
 	for _, pos := range ultraFastPositions {
 		// Get current price
-		currentPrice, err := ga.futuresClient.GetFuturesCurrentPrice(pos.Symbol)
+		currentPrice, err := ga.exitCheckPrice(pos.Symbol)
 		if err != nil {
 			ga.logger.Warn("Failed to get price for ultra-fast exit check",
 				"symbol", pos.Symbol,
@@ -15449,6 +16741,7 @@ func (ga *GinieAutopilot) checkUltraFastExits() {
 
 			// Remove position from tracking
 			delete(ga.positions, pos.Symbol)
+			ga.publishPositionEvent(events.EventGiniePositionClosed, pos, map[string]interface{}{"reason": "ultra_fast_tp3", "exit_price": currentPrice, "pnl": totalPnL, "pnl_percent": pnlPercent})
 			ga.dailyTrades++
 			ga.winningTrades++
 			ga.totalTrades++
@@ -15522,6 +16815,7 @@ func (ga *GinieAutopilot) updateUltraFastTrailingStop(pos *GiniePosition, curren
 			if !pos.TrailingActive {
 				pos.TrailingActive = true
 				pos.TrailingPercent = 0.5 // Trail by 0.5% from high
+				ga.publishPositionEvent(events.EventGiniePositionTrailingActivated, pos, map[string]interface{}{"reason": "ultra_fast_profit"})
 				ga.logger.Info("Ultra-fast: Trailing stop activated",
 					"symbol", pos.Symbol,
 					"highest_price", pos.HighestPrice,
@@ -15539,6 +16833,7 @@ func (ga *GinieAutopilot) updateUltraFastTrailingStop(pos *GiniePosition, curren
 			if !pos.TrailingActive {
 				pos.TrailingActive = true
 				pos.TrailingPercent = 0.5 // Trail by 0.5% from low
+				ga.publishPositionEvent(events.EventGiniePositionTrailingActivated, pos, map[string]interface{}{"reason": "ultra_fast_profit"})
 				ga.logger.Info("Ultra-fast: Trailing stop activated (SHORT)",
 					"symbol", pos.Symbol,
 					"lowest_price", pos.LowestPrice,
@@ -15994,12 +17289,11 @@ func (ga *GinieAutopilot) executeUltraFastExit(pos *GiniePosition, currentPrice
 			Price:        limitPrice,
 		}
 
-		order, err := ga.futuresClient.PlaceFuturesOrder(orderParams)
+		order, err := ga.placeFuturesOrder(orderParams)
 		if err != nil {
 			// Check if error is due to price precision (-1111, -4014)
 			errStr := err.Error()
-			if strings.Contains(errStr, "-1111") || strings.Contains(errStr, "-4014") ||
-				strings.Contains(errStr, "Precision") || strings.Contains(errStr, "tick size") {
+			if errors.Is(err, binance.ErrPricePrecision) {
 				// Fallback to MARKET order for precision errors
 				ga.logger.Warn("Ultra-fast LIMIT failed on precision, falling back to MARKET",
 					"symbol", symbol,
@@ -16013,7 +17307,7 @@ func (ga *GinieAutopilot) executeUltraFastExit(pos *GiniePosition, currentPrice
 					Type:         binance.FuturesOrderTypeMarket,
 					Quantity:     closeQty,
 				}
-				marketOrder, marketErr := ga.futuresClient.PlaceFuturesOrder(marketParams)
+				marketOrder, marketErr := ga.placeFuturesOrder(marketParams)
 				if marketErr != nil {
 					ga.logger.Error("Ultra-fast exit MARKET order also failed",
 						"symbol", symbol,
@@ -16044,6 +17338,7 @@ func (ga *GinieAutopilot) executeUltraFastExit(pos *GiniePosition, currentPrice
 
 	// Remove position from tracking
 	delete(ga.positions, symbol)
+	ga.publishPositionEvent(events.EventGiniePositionClosed, pos, map[string]interface{}{"reason": fmt.Sprintf("ultra_fast_%s", reason), "exit_price": currentPrice, "pnl": pnlUSD, "pnl_percent": pnlPercent})
 
 	// Update daily tracking
 	ga.dailyTrades++
@@ -16160,12 +17455,10 @@ func (ga *GinieAutopilot) executeUltraFastPartialClose(pos *GiniePosition, curre
 			Price:        limitPrice,
 		}
 
-		order, err := ga.futuresClient.PlaceFuturesOrder(orderParams)
+		order, err := ga.placeFuturesOrder(orderParams)
 		if err != nil {
 			// Fallback to MARKET order
-			errStr := err.Error()
-			if strings.Contains(errStr, "-1111") || strings.Contains(errStr, "-4014") ||
-				strings.Contains(errStr, "Precision") || strings.Contains(errStr, "tick size") {
+			if errors.Is(err, binance.ErrPricePrecision) {
 				marketParams := binance.FuturesOrderParams{
 					Symbol:       symbol,
 					Side:         side,
@@ -16173,7 +17466,7 @@ func (ga *GinieAutopilot) executeUltraFastPartialClose(pos *GiniePosition, curre
 					Type:         binance.FuturesOrderTypeMarket,
 					Quantity:     closeQty,
 				}
-				marketOrder, marketErr := ga.futuresClient.PlaceFuturesOrder(marketParams)
+				marketOrder, marketErr := ga.placeFuturesOrder(marketParams)
 				if marketErr != nil {
 					ga.logger.Error("Ultra-fast partial close MARKET order failed",
 						"symbol", symbol,
@@ -16291,7 +17584,7 @@ func (ga *GinieAutopilot) executeUltraFastEntry(symbol string, signal *UltraFast
 		log.Printf("[SETTINGS] ERROR: Failed to load settings: %v", settingsLoadErr)
 		return fmt.Errorf("failed to load settings: %w", settingsLoadErr)
 	}
-	maxUltraFastPositions := currentSettings.UltraFastMaxPositions
+	maxUltraFastPositions, _ := ga.modePositionCap(GinieModeUltraFast)
 	if currentUltraFastCount >= maxUltraFastPositions {
 		return fmt.Errorf("ultra-fast position limit reached: %d/%d", currentUltraFastCount, maxUltraFastPositions)
 	}
@@ -16374,6 +17667,8 @@ func (ga *GinieAutopilot) executeUltraFastEntry(symbol string, signal *UltraFast
 	actualPrice := price
 	actualQty := quantity
 
+	entryMarginType := ga.modeMarginType(GinieModeUltraFast)
+
 	if !ga.config.DryRun {
 		// Set leverage
 		_, err = ga.futuresClient.SetLeverage(symbol, leverage)
@@ -16381,6 +17676,12 @@ func (ga *GinieAutopilot) executeUltraFastEntry(symbol string, signal *UltraFast
 			return fmt.Errorf("failed to set leverage: %w", err)
 		}
 
+		// Set the ultra-fast mode's margin type
+		entryMarginType, err = ga.applyMarginType(symbol, GinieModeUltraFast)
+		if err != nil {
+			return fmt.Errorf("failed to set margin type: %w", err)
+		}
+
 		// === LIMIT ORDER ENTRY AT PREVIOUS CANDLE EXTREME (Ultra-fast) ===
 		// For LONG: Entry at previous 1m candle's LOW
 		// For SHORT: Entry at previous 1m candle's HIGH
@@ -16399,7 +17700,7 @@ func (ga *GinieAutopilot) executeUltraFastEntry(symbol string, signal *UltraFast
 				Quantity:     quantity,
 			}
 
-			order, orderErr := ga.futuresClient.PlaceFuturesOrder(orderParams)
+			order, orderErr := ga.placeFuturesOrder(orderParams)
 			if orderErr != nil {
 				return fmt.Errorf("failed to place MARKET order: %w", orderErr)
 			}
@@ -16430,6 +17731,10 @@ func (ga *GinieAutopilot) executeUltraFastEntry(symbol string, signal *UltraFast
 			}
 
 			limitOrder, limitErr := ga.futuresClient.PlaceFuturesOrder(limitOrderParams)
+			if errors.Is(limitErr, binance.ErrInsufficientMargin) {
+				// A MARKET order needs the same margin
+				return fmt.Errorf("failed to place LIMIT order: %w", limitErr)
+			}
 			if limitErr != nil {
 				ga.logger.Warn("Ultra-fast LIMIT order failed, using MARKET",
 					"symbol", symbol,
@@ -16445,7 +17750,7 @@ func (ga *GinieAutopilot) executeUltraFastEntry(symbol string, signal *UltraFast
 					Quantity:     quantity,
 				}
 
-				order, marketErr := ga.futuresClient.PlaceFuturesOrder(marketParams)
+				order, marketErr := ga.placeFuturesOrder(marketParams)
 				if marketErr != nil {
 					return fmt.Errorf("failed to place MARKET order: %w", marketErr)
 				}
@@ -16510,6 +17815,7 @@ func (ga *GinieAutopilot) executeUltraFastEntry(symbol string, signal *UltraFast
 		OriginalQty:            actualQty,
 		RemainingQty:           actualQty,
 		Leverage:               leverage,
+		MarginType:             string(entryMarginType),
 		EntryTime:              time.Now(),
 		TakeProfits:            []GinieTakeProfitLevel{}, // Ultra-fast uses tiered TPs now
 		CurrentTPLevel:         0,
@@ -16520,7 +17826,8 @@ func (ga *GinieAutopilot) executeUltraFastEntry(symbol string, signal *UltraFast
 		HighestPrice:           actualPrice,
 		LowestPrice:            actualPrice,
 		TrailingPercent:        0,
-		Source:                 "ai",
+		Source:                 PositionSourceAI,
+		EntryReason:            ultraFastEntryReason(signal),
 		UltraFastSignal:        signal,
 		UltraFastTargetPercent: signal.MinProfitTarget,
 		MaxHoldTime:            3 * time.Second,
@@ -16542,6 +17849,7 @@ func (ga *GinieAutopilot) executeUltraFastEntry(symbol string, signal *UltraFast
 	}
 
 	ga.positions[symbol] = position
+	ga.publishPositionEvent(events.EventGiniePositionOpened, position, map[string]interface{}{"mode": position.Mode, "source": position.Source})
 	ga.dailyTrades++
 	ga.totalTrades++
 
@@ -16584,6 +17892,14 @@ func (ga *GinieAutopilot) executeUltraFastEntryWithSize(symbol string, signal *U
 	if _, exists := ga.positions[symbol]; exists {
 		return fmt.Errorf("position already exists for %s", symbol)
 	}
+	if ga.config.ManageOnlyMode {
+		return fmt.Errorf("%s: new entries paused", RejectionManageOnly)
+	}
+	if ga.openRateLimitedLocked(time.Now()) {
+		ga.rateLimitedOpens++
+		log.Printf("[ULTRA-FAST] %s: entry skipped - rate_limited_opens (max %d per minute)", symbol, ga.config.MaxPositionsPerMinute)
+		return fmt.Errorf("rate_limited_opens: %d positions opened in the last minute", ga.config.MaxPositionsPerMinute)
+	}
 
 	// Check position limits
 	currentUltraFastCount := 0
@@ -16599,7 +17915,7 @@ func (ga *GinieAutopilot) executeUltraFastEntryWithSize(symbol string, signal *U
 		log.Printf("[SETTINGS] ERROR: Failed to load settings: %v", settingsLoadErr)
 		return fmt.Errorf("failed to load settings: %w", settingsLoadErr)
 	}
-	maxUltraFastPositions := currentSettings.UltraFastMaxPositions
+	maxUltraFastPositions, _ := ga.modePositionCap(GinieModeUltraFast)
 	if currentUltraFastCount >= maxUltraFastPositions {
 		return fmt.Errorf("ultra-fast position limit reached: %d/%d", currentUltraFastCount, maxUltraFastPositions)
 	}
@@ -16690,6 +18006,8 @@ func (ga *GinieAutopilot) executeUltraFastEntryWithSize(symbol string, signal *U
 	actualPrice := price
 	actualQty := quantity
 
+	entryMarginType := ga.modeMarginType(GinieModeUltraFast)
+
 	if !ga.config.DryRun {
 		// Set leverage
 		_, err = ga.futuresClient.SetLeverage(symbol, leverage)
@@ -16697,6 +18015,12 @@ func (ga *GinieAutopilot) executeUltraFastEntryWithSize(symbol string, signal *U
 			return fmt.Errorf("failed to set leverage: %w", err)
 		}
 
+		// Set the ultra-fast mode's margin type
+		entryMarginType, err = ga.applyMarginType(symbol, GinieModeUltraFast)
+		if err != nil {
+			return fmt.Errorf("failed to set margin type: %w", err)
+		}
+
 		// === LIMIT ORDER ENTRY AT PREVIOUS CANDLE EXTREME (Ultra-fast smart margin) ===
 		limitEntryPrice, priceErr := ga.getPrevCandleEntryPrice(symbol, GinieModeUltraFast, isLong)
 		if priceErr != nil {
@@ -16713,7 +18037,7 @@ func (ga *GinieAutopilot) executeUltraFastEntryWithSize(symbol string, signal *U
 				Quantity:     quantity,
 			}
 
-			order, orderErr := ga.futuresClient.PlaceFuturesOrder(orderParams)
+			order, orderErr := ga.placeFuturesOrder(orderParams)
 			if orderErr != nil {
 				return fmt.Errorf("failed to place MARKET order: %w", orderErr)
 			}
@@ -16744,6 +18068,10 @@ func (ga *GinieAutopilot) executeUltraFastEntryWithSize(symbol string, signal *U
 			}
 
 			limitOrder, limitErr := ga.futuresClient.PlaceFuturesOrder(limitOrderParams)
+			if errors.Is(limitErr, binance.ErrInsufficientMargin) {
+				// A MARKET order needs the same margin
+				return fmt.Errorf("failed to place LIMIT order: %w", limitErr)
+			}
 			if limitErr != nil {
 				ga.logger.Warn("Ultra-fast LIMIT order failed, using MARKET",
 					"symbol", symbol,
@@ -16759,7 +18087,7 @@ func (ga *GinieAutopilot) executeUltraFastEntryWithSize(symbol string, signal *U
 					Quantity:     quantity,
 				}
 
-				order, marketErr := ga.futuresClient.PlaceFuturesOrder(marketParams)
+				order, marketErr := ga.placeFuturesOrder(marketParams)
 				if marketErr != nil {
 					return fmt.Errorf("failed to place MARKET order: %w", marketErr)
 				}
@@ -16825,6 +18153,7 @@ func (ga *GinieAutopilot) executeUltraFastEntryWithSize(symbol string, signal *U
 		OriginalQty:            actualQty,
 		RemainingQty:           actualQty,
 		Leverage:               leverage,
+		MarginType:             string(entryMarginType),
 		EntryTime:              time.Now(),
 		TakeProfits:            []GinieTakeProfitLevel{}, // Ultra-fast uses tiered TPs now
 		CurrentTPLevel:         0,
@@ -16835,7 +18164,8 @@ func (ga *GinieAutopilot) executeUltraFastEntryWithSize(symbol string, signal *U
 		HighestPrice:           actualPrice,
 		LowestPrice:            actualPrice,
 		TrailingPercent:        0,
-		Source:                 "ai",
+		Source:                 PositionSourceAI,
+		EntryReason:            ultraFastEntryReason(signal),
 		UltraFastSignal:        signal,
 		UltraFastTargetPercent: signal.MinProfitTarget,
 		MaxHoldTime:            3 * time.Second,
@@ -16857,6 +18187,8 @@ func (ga *GinieAutopilot) executeUltraFastEntryWithSize(symbol string, signal *U
 	}
 
 	ga.positions[symbol] = position
+	ga.recentOpens = append(ga.recentOpens, time.Now())
+	ga.publishPositionEvent(events.EventGiniePositionOpened, position, map[string]interface{}{"mode": position.Mode, "source": position.Source})
 	ga.dailyTrades++
 	ga.totalTrades++
 
@@ -16870,13 +18202,17 @@ func (ga *GinieAutopilot) executeUltraFastEntryWithSize(symbol string, signal *U
 			EntryPrice:   actualPrice,
 			Quantity:     actualQty,
 			Leverage:     leverage,
-			MarginType:   "CROSSED",
+			MarginType:   string(entryMarginType),
 			Status:       "OPEN",
 			EntryTime:    time.Now(),
 			TradeSource:  "ginie",
 			TradingMode:  &tradingMode,
+			Tags:         ga.dryRunTradeTags(),
 		}
-		if err := ga.repo.CreateFuturesTrade(context.Background(), trade); err != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), dbWriteTimeout)
+		err := ga.repo.CreateFuturesTrade(ctx, trade)
+		cancel()
+		if err != nil {
 			ga.logger.Warn("Failed to create futures trade record for ultra-fast", "error", err, "symbol", symbol)
 		} else {
 			position.FuturesTradeID = trade.ID
@@ -18055,12 +19391,14 @@ func (ga *GinieAutopilot) createPositionFromLimitFill(pending *PendingLimitOrder
 		LowestPrice:           fillPrice,
 		TrailingPercent:       trailingPercent,
 		TrailingActivationPct: trailingActivation,
-		Source:                "reversal", // Mark as reversal entry
+		Source:                PositionSourceReversal, // Mark as reversal entry
+		EntryReason:           fmt.Sprintf("%s reversal LIMIT filled at %.8g", pending.Mode, fillPrice),
 		Protection:            NewProtectionStatus(),
 		ChainBaseID:     pending.ChainBaseID, // Epic 7: Carry forward from pending order
 	}
 
 	ga.positions[pending.Symbol] = position
+	ga.publishPositionEvent(events.EventGiniePositionOpened, position, map[string]interface{}{"mode": position.Mode, "source": position.Source})
 	ga.dailyTrades++
 
 	ga.logger.Info("Position created from reversal LIMIT fill",
@@ -0,0 +1,114 @@
+package autopilot
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+// feeRateCacheTTL is how long the account commission rates fetched from
+// Binance are reused before refreshing
+const feeRateCacheTTL = time.Hour
+
+// commissionRateCache holds the account's maker/taker rates (as decimals).
+// Binance rates are per account, not per symbol.
+type commissionRateCache struct {
+	mu        sync.Mutex
+	maker     float64
+	taker     float64
+	fetchedAt time.Time
+	fetching  bool
+}
+
+// commissionRates returns the cached maker/taker rates without blocking.
+// A stale or empty cache triggers a background refresh and the TakerFeeRate /
+// MakerFeeRate fallbacks are used until it lands.
+func (ga *GinieAutopilot) commissionRates() (maker, taker float64) {
+	c := &ga.feeRates
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	maker, taker = MakerFeeRate, TakerFeeRate
+	if !c.fetchedAt.IsZero() {
+		maker, taker = c.maker, c.taker
+	}
+
+	if time.Since(c.fetchedAt) > feeRateCacheTTL && !c.fetching && ga.futuresClient != nil {
+		c.fetching = true
+		go ga.refreshCommissionRates()
+	}
+	return maker, taker
+}
+
+func (ga *GinieAutopilot) refreshCommissionRates() {
+	rate, err := ga.futuresClient.GetCommissionRate("BTCUSDT")
+
+	c := &ga.feeRates
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.fetching = false
+
+	if err != nil || rate == nil || rate.TakerCommissionRate <= 0 {
+		log.Printf("[BREAKEVEN] Failed to fetch commission rates, using defaults: %v", err)
+		// Back off a full TTL rather than retrying on every breakeven move
+		if c.fetchedAt.IsZero() {
+			c.maker, c.taker = MakerFeeRate, TakerFeeRate
+		}
+		c.fetchedAt = time.Now()
+		return
+	}
+
+	c.maker, c.taker = rate.MakerCommissionRate, rate.TakerCommissionRate
+	c.fetchedAt = time.Now()
+}
+
+// breakevenFeeRates returns the entry and exit fee rates for a position. The
+// entry uses the fee actually charged when known; the exit is a STOP_MARKET
+// order and always pays taker.
+func (ga *GinieAutopilot) breakevenFeeRates(pos *GiniePosition) (entryRate, exitRate float64) {
+	maker, taker := ga.commissionRates()
+
+	entryRate = taker
+	if pos.EntryWasMaker {
+		entryRate = maker
+	}
+	if pos.EntryFeeUSD > 0 && pos.EntryPrice > 0 && pos.OriginalQty > 0 {
+		entryRate = pos.EntryFeeUSD / (pos.EntryPrice * pos.OriginalQty)
+	}
+	return entryRate, taker
+}
+
+// breakevenStopPrice returns the stop price for moving pos to breakeven and the
+// buffer % applied. With FeeAwareBreakeven on for the position's mode the stop
+// is the price at which the exit nets zero after entry and exit fees:
+//
+//	LONG:  entry * (1 + entryFee) / (1 - exitFee)
+//	SHORT: entry * (1 - entryFee) / (1 + exitFee)
+//
+// The buffer is then applied on top. Caller must hold ga.mu.
+func (ga *GinieAutopilot) breakevenStopPrice(pos *GiniePosition) (stop, bufferPct float64, feeAware bool) {
+	bufferPct = ga.config.BreakevenBuffer
+	if modeConfig := ga.getModeConfig(pos.Mode); modeConfig != nil && modeConfig.SLTP != nil {
+		feeAware = modeConfig.SLTP.FeeAwareBreakeven
+		if modeConfig.SLTP.BreakevenBufferPercent > 0 {
+			bufferPct = modeConfig.SLTP.BreakevenBufferPercent
+		}
+	}
+
+	stop = pos.EntryPrice
+	if feeAware {
+		entryRate, exitRate := ga.breakevenFeeRates(pos)
+		if pos.Side == "LONG" {
+			stop = pos.EntryPrice * (1 + entryRate) / (1 - exitRate)
+		} else {
+			stop = pos.EntryPrice * (1 - entryRate) / (1 + exitRate)
+		}
+	}
+
+	if pos.Side == "LONG" {
+		stop *= 1 + bufferPct/100
+	} else {
+		stop *= 1 - bufferPct/100
+	}
+	return stop, bufferPct, feeAware
+}
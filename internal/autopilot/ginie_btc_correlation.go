@@ -0,0 +1,60 @@
+package autopilot
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RejectionBTCCorrelation is the skip reason for alt entries more correlated with
+// BTC than MaxBTCCorrelation allows
+const RejectionBTCCorrelation = "btc_correlation_too_high"
+
+// symbolBTCCorrelation returns the analyzer's BTC correlation for a symbol
+// (what GinieMarketSnapshot.BTCCorr records), or 0 without an analyzer
+func (ga *GinieAutopilot) symbolBTCCorrelation(symbol string) float64 {
+	if ga.analyzer == nil {
+		return 0
+	}
+	return ga.analyzer.analyzeCorrelation(symbol).BTCCorrelation
+}
+
+// positionBTCCorrelation returns the BTC correlation captured when the position
+// was opened, falling back to the analyzer's current value
+func (ga *GinieAutopilot) positionBTCCorrelation(pos *GiniePosition) float64 {
+	if pos.DecisionReport != nil && pos.DecisionReport.MarketConditions.BTCCorr > 0 {
+		return pos.DecisionReport.MarketConditions.BTCCorr
+	}
+	return ga.symbolBTCCorrelation(pos.Symbol)
+}
+
+// btcCorrelationRejection records the entry's BTC correlation on the signal log and
+// rejects an alt above MaxBTCCorrelation when a same-side position above it is
+// already open (always, with MaxBTCCorrelationAlways). BTC itself is never filtered.
+func (ga *GinieAutopilot) btcCorrelationRejection(symbol string, corr float64, signalLog *GinieSignalLog) (string, bool) {
+	ga.mu.RLock()
+	defer ga.mu.RUnlock()
+
+	maxCorr := ga.config.MaxBTCCorrelation
+	if maxCorr <= 0 || strings.HasPrefix(symbol, "BTC") {
+		return "", false
+	}
+	signalLog.BTCCorrelation = corr
+	if corr <= maxCorr {
+		return "", false
+	}
+
+	if ga.config.MaxBTCCorrelationAlways {
+		return fmt.Sprintf("%s (%.2f > %.2f)", RejectionBTCCorrelation, corr, maxCorr), true
+	}
+
+	correlated := 0
+	for _, pos := range ga.positions {
+		if pos.Side == signalLog.Direction && ga.positionBTCCorrelation(pos) > maxCorr {
+			correlated++
+		}
+	}
+	if correlated == 0 {
+		return "", false
+	}
+	return fmt.Sprintf("%s (%.2f > %.2f with %d correlated %s open)", RejectionBTCCorrelation, corr, maxCorr, correlated, signalLog.Direction), true
+}
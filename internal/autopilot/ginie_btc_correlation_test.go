@@ -0,0 +1,52 @@
+package autopilot
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBTCCorrelationRejection(t *testing.T) {
+	ga := &GinieAutopilot{
+		config: &GinieAutopilotConfig{MaxBTCCorrelation: 0.75},
+		positions: map[string]*GiniePosition{
+			"ETHUSDT": {Symbol: "ETHUSDT", Side: "LONG", DecisionReport: &GinieDecisionReport{}},
+		},
+	}
+	ga.positions["ETHUSDT"].DecisionReport.MarketConditions.BTCCorr = 0.9
+
+	// Correlated alt on the same side as correlated exposure
+	long := &GinieSignalLog{Direction: "LONG"}
+	reason, rejected := ga.btcCorrelationRejection("SOLUSDT", 0.8, long)
+	if !rejected || !strings.HasPrefix(reason, RejectionBTCCorrelation) {
+		t.Errorf("correlated LONG: rejected=%v reason=%q", rejected, reason)
+	}
+	if long.BTCCorrelation != 0.8 {
+		t.Errorf("signal log btc correlation = %v, want 0.8", long.BTCCorrelation)
+	}
+
+	// Opposite side isn't redundant beta, low correlation diversifies, BTC is never filtered
+	if _, rejected := ga.btcCorrelationRejection("SOLUSDT", 0.8, &GinieSignalLog{Direction: "SHORT"}); rejected {
+		t.Error("SHORT rejected with only LONG exposure open")
+	}
+	if _, rejected := ga.btcCorrelationRejection("XMRUSDT", 0.5, &GinieSignalLog{Direction: "LONG"}); rejected {
+		t.Error("low-correlation alt rejected")
+	}
+	if _, rejected := ga.btcCorrelationRejection("BTCUSDT", 1.0, &GinieSignalLog{Direction: "LONG"}); rejected {
+		t.Error("BTCUSDT rejected by the alt filter")
+	}
+
+	// Without correlated exposure only the "always" variant rejects
+	ga.positions = map[string]*GiniePosition{}
+	if _, rejected := ga.btcCorrelationRejection("SOLUSDT", 0.8, &GinieSignalLog{Direction: "LONG"}); rejected {
+		t.Error("rejected with no open exposure")
+	}
+	ga.config.MaxBTCCorrelationAlways = true
+	if _, rejected := ga.btcCorrelationRejection("SOLUSDT", 0.8, &GinieSignalLog{Direction: "LONG"}); !rejected {
+		t.Error("MaxBTCCorrelationAlways did not reject a correlated alt")
+	}
+
+	explained := explainRejectionReason(&GinieSignalLog{RejectionReason: RejectionBTCCorrelation + " (0.80 > 0.75 with 1 correlated LONG open)"})
+	if explained.Gate != "btc_correlation" || explained.Actual != "0.80" || explained.Threshold != "<= 0.75" {
+		t.Errorf("explanation = %+v", explained)
+	}
+}
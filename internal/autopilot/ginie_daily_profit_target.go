@@ -0,0 +1,78 @@
+package autopilot
+
+import (
+	"fmt"
+	"time"
+)
+
+// RejectionDailyProfitTarget is the canTrade reason once MaxDailyProfit is reached
+const RejectionDailyProfitTarget = "daily_profit_target_reached"
+
+// DailyProfitTargetDiagnostics shows progress toward the daily profit target
+type DailyProfitTargetDiagnostics struct {
+	Enabled         bool    `json:"enabled"`
+	Target          float64 `json:"target"`
+	DailyPnL        float64 `json:"daily_pnl"`
+	ProgressPercent float64 `json:"progress_percent"`
+	Reached         bool    `json:"reached"`
+	Notified        bool    `json:"notified"`
+
+	// Trading day the daily PnL covers (configured reset time and timezone)
+	DayStart  time.Time `json:"day_start"`
+	NextReset time.Time `json:"next_reset"`
+}
+
+// dailyProfitTargetReachedLocked reports whether realized daily PnL has hit
+// MaxDailyProfit (0 disables). Caller must hold ga.mu.
+func (ga *GinieAutopilot) dailyProfitTargetReachedLocked() bool {
+	return ga.config.MaxDailyProfit > 0 && ga.dailyPnL >= ga.config.MaxDailyProfit
+}
+
+// getDailyProfitTargetDiagnosticsLocked returns target progress (must hold lock)
+func (ga *GinieAutopilot) getDailyProfitTargetDiagnosticsLocked() DailyProfitTargetDiagnostics {
+	diag := DailyProfitTargetDiagnostics{
+		Enabled:  ga.config.MaxDailyProfit > 0,
+		Target:   ga.config.MaxDailyProfit,
+		DailyPnL: ga.dailyPnL,
+		Reached:  ga.dailyProfitTargetReachedLocked(),
+		Notified: ga.dailyProfitTargetNotified,
+
+		DayStart:  ga.dayStart,
+		NextReset: ga.config.NextDailyReset(time.Now()),
+	}
+	if diag.Enabled {
+		diag.ProgressPercent = ga.dailyPnL / ga.config.MaxDailyProfit * 100
+	}
+	return diag
+}
+
+// notifyDailyProfitTargetReached sends a one-off alert per day once the target is hit
+func (ga *GinieAutopilot) notifyDailyProfitTargetReached() {
+	ga.mu.Lock()
+	if ga.dailyProfitTargetNotified || !ga.dailyProfitTargetReachedLocked() {
+		ga.mu.Unlock()
+		return
+	}
+	ga.dailyProfitTargetNotified = true
+	dailyPnL := ga.dailyPnL
+	target := ga.config.MaxDailyProfit
+	notifier := ga.alertNotifier
+	notify := ga.config.NotifyDailyProfitTarget
+	ga.mu.Unlock()
+
+	ga.logger.Info("Ginie daily profit target reached - no new positions until daily reset",
+		"daily_pnl", dailyPnL,
+		"target", target)
+
+	if !notify || notifier == nil {
+		return
+	}
+	message := fmt.Sprintf("Daily PnL $%.2f reached the $%.2f target. New entries are paused until the daily reset; open positions are still managed.",
+		dailyPnL, target)
+	if ga.userID != "" {
+		message = fmt.Sprintf("User %s: %s", ga.userID, message)
+	}
+	if err := notifier.SendInfo("Ginie daily profit target reached", message); err != nil {
+		ga.logger.Warn("Failed to send daily profit target notification", "error", err)
+	}
+}
@@ -0,0 +1,80 @@
+package autopilot
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Daily counters reset at 00:00 UTC unless the config sets another boundary
+const (
+	defaultDailyResetTime     = "00:00"
+	defaultDailyResetTimezone = "UTC"
+)
+
+// ParseDailyReset validates a daily reset time ("HH:MM") and IANA timezone and
+// returns the reset minute of the day and its location. Empty values default to 00:00 UTC.
+func ParseDailyReset(resetTime, timezone string) (int, *time.Location, error) {
+	if resetTime == "" {
+		resetTime = defaultDailyResetTime
+	}
+	t, err := time.Parse("15:04", resetTime)
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid daily reset time %q (expected HH:MM)", resetTime)
+	}
+
+	loc := time.UTC
+	if timezone != "" && !strings.EqualFold(timezone, defaultDailyResetTimezone) {
+		loc, err = time.LoadLocation(timezone)
+		if err != nil {
+			return 0, nil, fmt.Errorf("invalid daily reset timezone %q: %w", timezone, err)
+		}
+	}
+	return t.Hour()*60 + t.Minute(), loc, nil
+}
+
+// dailyResetClock returns the configured reset boundary, falling back to
+// 00:00 UTC if the stored values don't parse
+func (c *GinieAutopilotConfig) dailyResetClock() (int, *time.Location) {
+	minute, loc, err := ParseDailyReset(c.DailyResetTime, c.DailyResetTimezone)
+	if err != nil {
+		return 0, time.UTC
+	}
+	return minute, loc
+}
+
+// TradingDayStart returns the start of the trading day containing now: the most
+// recent daily reset boundary at or before it
+func (c *GinieAutopilotConfig) TradingDayStart(now time.Time) time.Time {
+	minute, loc := c.dailyResetClock()
+	local := now.In(loc)
+	start := time.Date(local.Year(), local.Month(), local.Day(), minute/60, minute%60, 0, 0, loc)
+	if start.After(now) {
+		start = time.Date(local.Year(), local.Month(), local.Day()-1, minute/60, minute%60, 0, 0, loc)
+	}
+	return start
+}
+
+// NextDailyReset returns the first daily reset boundary after now
+func (c *GinieAutopilotConfig) NextDailyReset(now time.Time) time.Time {
+	minute, _ := c.dailyResetClock()
+	start := c.TradingDayStart(now)
+	return time.Date(start.Year(), start.Month(), start.Day()+1, minute/60, minute%60, 0, 0, start.Location())
+}
+
+// rollTradingDayLocked starts a new trading day once the reset boundary has
+// passed, clearing the daily counters. Returns true if it reset them. Caller must hold ga.mu.
+func (ga *GinieAutopilot) rollTradingDayLocked(now time.Time) bool {
+	start := ga.config.TradingDayStart(now)
+	if !start.After(ga.dayStart) {
+		// Same trading day, or the boundary was moved earlier: keep today's counters
+		ga.dayStart = start
+		return false
+	}
+	ga.dayStart = start
+	ga.dailyTrades = 0
+	ga.dailyPnL = 0
+	ga.dailyProfitTargetNotified = false
+	ga.resetSymbolDailyLossesLocked()
+	return true
+}
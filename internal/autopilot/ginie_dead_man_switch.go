@@ -0,0 +1,84 @@
+package autopilot
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+// Dead-man's switch: the position monitor keeps Binance's countdownCancelAll
+// timer armed for every symbol it manages. If the process crashes or the
+// monitor hangs, the timer runs out and Binance cancels that symbol's open
+// orders (pending entries that nobody would manage once filled). Positions are
+// not closed and SL/TP algo orders are not affected, so protection stays in place.
+var (
+	deadManMu      sync.RWMutex
+	deadManTimeout time.Duration
+)
+
+// ConfigureDeadManSwitch sets the countdown from startup configuration (0 disables it)
+func ConfigureDeadManSwitch(timeout time.Duration) {
+	deadManMu.Lock()
+	defer deadManMu.Unlock()
+	deadManTimeout = timeout
+}
+
+// DeadManSwitchTimeout returns the configured countdown, 0 when disabled
+func DeadManSwitchTimeout() time.Duration {
+	deadManMu.RLock()
+	defer deadManMu.RUnlock()
+	return deadManTimeout
+}
+
+// refreshDeadManSwitch re-arms the countdown for every symbol with a tracked
+// position or pending entry order and disarms symbols no longer managed.
+// Called synchronously from the position monitor so that a stuck monitor stops
+// refreshing. Refreshes are spaced to a third of the timeout to limit API weight.
+func (ga *GinieAutopilot) refreshDeadManSwitch() {
+	timeout := DeadManSwitchTimeout()
+	if timeout <= 0 || ga.config.DryRun || ga.requireActive() != nil {
+		return
+	}
+	if time.Since(ga.lastDeadManRefresh) < timeout/3 {
+		return
+	}
+	ga.lastDeadManRefresh = time.Now()
+
+	ga.mu.RLock()
+	symbols := make(map[string]bool, len(ga.positions)+len(ga.pendingLimitOrders))
+	for symbol := range ga.positions {
+		symbols[symbol] = true
+	}
+	for _, pending := range ga.pendingLimitOrders {
+		symbols[pending.Symbol] = true
+	}
+	ga.mu.RUnlock()
+
+	if ga.deadManArmed == nil {
+		ga.deadManArmed = make(map[string]bool)
+	}
+
+	countdownMs := timeout.Milliseconds()
+	for symbol := range symbols {
+		if err := ga.futuresClient.SetCountdownCancelAll(symbol, countdownMs); err != nil {
+			ga.logger.Warn("Failed to refresh dead-man's switch", "symbol", symbol, "error", err)
+			continue
+		}
+		if !ga.deadManArmed[symbol] {
+			log.Printf("[GINIE-DEADMAN] %s: armed countdown cancel-all (%v)", symbol, timeout)
+		}
+		ga.deadManArmed[symbol] = true
+	}
+
+	for symbol := range ga.deadManArmed {
+		if symbols[symbol] {
+			continue
+		}
+		if err := ga.futuresClient.SetCountdownCancelAll(symbol, 0); err != nil {
+			ga.logger.Warn("Failed to disarm dead-man's switch", "symbol", symbol, "error", err)
+			continue
+		}
+		delete(ga.deadManArmed, symbol)
+		log.Printf("[GINIE-DEADMAN] %s: disarmed countdown cancel-all (no longer managed)", symbol)
+	}
+}
@@ -0,0 +1,357 @@
+package autopilot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"binance-trading-bot/internal/audit"
+	"binance-trading-bot/internal/database"
+)
+
+// drawdownGuardInterval is how often account equity is sampled against the high-water mark
+const drawdownGuardInterval = time.Minute
+
+// ErrDrawdownGuardTripped is returned when a switch to live trading is refused
+// because the account drawdown kill switch has tripped and not been reset
+var ErrDrawdownGuardTripped = errors.New("live trading is disabled: account drawdown limit was breached (reset the drawdown guard to re-enable)")
+
+// drawdownGuard is the in-memory state of the account drawdown kill switch,
+// mirrored to the user_drawdown_guard table so a restart doesn't reset it
+type drawdownGuard struct {
+	mu            sync.Mutex
+	loaded        bool
+	highWaterMark float64
+	lastEquity    float64
+	lastSample    time.Time
+	tripped       bool
+	trippedAt     *time.Time
+	tripReason    string
+
+	// End of the last wallet transfer lookup; transfers before it are already
+	// reflected in the high-water mark
+	transfersCheckedAt *time.Time
+}
+
+// DrawdownGuardStatus is the drawdown kill switch state exposed by the API
+type DrawdownGuardStatus struct {
+	Enabled            bool       `json:"enabled"`
+	MaxDrawdownPercent float64    `json:"max_drawdown_percent"`
+	HighWaterMark      float64    `json:"high_water_mark"`
+	Equity             float64    `json:"equity"`
+	DrawdownPercent    float64    `json:"drawdown_percent"`
+	LastSample         time.Time  `json:"last_sample,omitempty"`
+	Tripped            bool       `json:"tripped"`
+	TrippedAt          *time.Time `json:"tripped_at,omitempty"`
+	TripReason         string     `json:"trip_reason,omitempty"`
+}
+
+// runDrawdownGuard samples account equity and trips the kill switch when it
+// falls MaxAccountDrawdownPercent below the high-water mark
+func (ga *GinieAutopilot) runDrawdownGuard() {
+	defer ga.wg.Done()
+
+	ticker := time.NewTicker(drawdownGuardInterval)
+	defer ticker.Stop()
+
+	ga.checkAccountDrawdown()
+	for {
+		select {
+		case <-ga.stopChan:
+			return
+		case <-ticker.C:
+			ga.checkAccountDrawdown()
+		}
+	}
+}
+
+// checkAccountDrawdown takes one equity sample, shifts the high-water mark by
+// any wallet transfers since the last sample, raises it and trips the kill
+// switch on a breach. A tripped switch keeps the instance in dry-run until
+// ResetDrawdownGuard is called.
+func (ga *GinieAutopilot) checkAccountDrawdown() {
+	ga.mu.RLock()
+	maxDrawdown := ga.config.MaxAccountDrawdownPercent
+	dryRun := ga.config.DryRun
+	ga.mu.RUnlock()
+
+	ga.loadDrawdownGuard()
+
+	ga.drawdown.mu.Lock()
+	tripped := ga.drawdown.tripped
+	ga.drawdown.mu.Unlock()
+
+	if tripped {
+		if !dryRun {
+			log.Printf("[DRAWDOWN-GUARD] Kill switch is tripped - forcing dry-run until it is reset")
+			ga.forceDryRun()
+		}
+		return
+	}
+	if maxDrawdown <= 0 || dryRun {
+		return
+	}
+
+	sampledAt := time.Now()
+	accountInfo, err := ga.futuresClient.GetFuturesAccountInfo()
+	if err != nil {
+		ga.logger.Warn("Drawdown guard: failed to get account equity", "error", err)
+		return
+	}
+	equity := accountInfo.TotalMarginBalance // Wallet balance + unrealized PnL
+	if equity <= 0 {
+		return
+	}
+
+	// Without the transfers a withdrawal would read as a loss, so skip the sample
+	transfers, err := ga.drawdownTransfersUntil(sampledAt)
+	if err != nil {
+		ga.logger.Warn("Drawdown guard: failed to get wallet transfers, skipping sample", "error", err)
+		return
+	}
+
+	ga.drawdown.mu.Lock()
+	ga.drawdown.lastEquity = equity
+	ga.drawdown.lastSample = sampledAt
+	firstCheck := ga.drawdown.transfersCheckedAt == nil
+	ga.drawdown.transfersCheckedAt = &sampledAt
+	adjusted := transfers != 0 && ga.drawdown.highWaterMark > 0
+	if adjusted {
+		// Deposits raise the mark and withdrawals lower it; an emptied account restarts from equity
+		ga.drawdown.highWaterMark = math.Max(ga.drawdown.highWaterMark+transfers, 0)
+		log.Printf("[DRAWDOWN-GUARD] Net wallet transfers %+.2f - high-water mark adjusted to $%.2f",
+			transfers, ga.drawdown.highWaterMark)
+	}
+	raised := equity > ga.drawdown.highWaterMark
+	if raised {
+		ga.drawdown.highWaterMark = equity
+	}
+	hwm := ga.drawdown.highWaterMark
+	drawdownPct := (hwm - equity) / hwm * 100
+	breached := drawdownPct >= maxDrawdown
+	if breached {
+		now := time.Now()
+		ga.drawdown.tripped = true
+		ga.drawdown.trippedAt = &now
+		ga.drawdown.tripReason = fmt.Sprintf("equity $%.2f is %.2f%% below high-water mark $%.2f (limit %.2f%%)",
+			equity, drawdownPct, hwm, maxDrawdown)
+	}
+	reason := ga.drawdown.tripReason
+	ga.drawdown.mu.Unlock()
+
+	if raised || breached || adjusted || firstCheck {
+		ga.saveDrawdownGuard()
+	}
+	if breached {
+		ga.tripDrawdownGuard(reason)
+	}
+}
+
+// drawdownTransfersUntil returns the net futures wallet transfers (deposits
+// positive, withdrawals negative) since the last check. The first check has
+// nothing to replay: the high-water mark starts from the current equity.
+func (ga *GinieAutopilot) drawdownTransfersUntil(until time.Time) (float64, error) {
+	ga.drawdown.mu.Lock()
+	since := ga.drawdown.transfersCheckedAt
+	ga.drawdown.mu.Unlock()
+
+	if since == nil || !until.After(*since) {
+		return 0, nil
+	}
+
+	records, err := ga.futuresClient.GetAllIncomeHistory("", "TRANSFER", since.UnixMilli()+1, until.UnixMilli())
+	if err != nil {
+		return 0, err
+	}
+	net := 0.0
+	for _, r := range records {
+		net += r.Income
+	}
+	return net, nil
+}
+
+// tripDrawdownGuard flattens every position, switches to dry-run and alerts the operator
+func (ga *GinieAutopilot) tripDrawdownGuard(reason string) {
+	log.Printf("[DRAWDOWN-GUARD] ACCOUNT DRAWDOWN LIMIT BREACHED: %s - flattening and switching to dry-run", reason)
+	ga.logger.Error("Account drawdown kill switch tripped",
+		"user_id", ga.userID,
+		"reason", reason)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	results := ga.ApplyShutdownPolicy(ctx, ShutdownPolicyFlatten)
+	cancel()
+
+	ga.forceDryRun()
+
+	ga.mu.RLock()
+	notifier := ga.alertNotifier
+	ga.mu.RUnlock()
+	if notifier != nil {
+		msg := fmt.Sprintf("Account drawdown kill switch tripped for user %s: %s. %d symbols flattened and live trading disabled - reset the drawdown guard to re-enable.",
+			ga.userID, reason, len(results))
+		if err := notifier.SendError("Account drawdown limit breached", msg); err != nil {
+			ga.logger.Warn("Failed to send drawdown alert", "error", err)
+		}
+	}
+}
+
+// forceDryRun switches this instance to paper trading and persists the user's
+// trading mode, so a restart or settings reload doesn't bring it back live
+func (ga *GinieAutopilot) forceDryRun() {
+	ga.mu.Lock()
+	ga.config.DryRun = true
+	ga.mu.Unlock()
+
+	if ga.repo == nil || ga.userID == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbReadTimeout)
+	defer cancel()
+
+	err := ga.repo.SetUserDryRunMode(ctx, ga.userID, true)
+	if err == nil {
+		var ginieSettings *database.UserGinieSettings
+		ginieSettings, err = ga.repo.GetUserGinieSettings(ctx, ga.userID)
+		if err == nil && ginieSettings != nil && !ginieSettings.DryRunMode {
+			ginieSettings.DryRunMode = true
+			err = ga.repo.SaveUserGinieSettings(ctx, ginieSettings)
+		}
+	}
+	audit.RecordResult(ctx, audit.ActionModeSwitched, ga.userID, map[string]interface{}{
+		"dry_run": true,
+		"reason":  "drawdown_guard",
+	}, err)
+	if err != nil {
+		ga.logger.Warn("Failed to persist drawdown guard dry-run mode", "user_id", ga.userID, "error", err)
+	}
+}
+
+// DrawdownGuardTripped reports whether the account drawdown kill switch is tripped
+func (ga *GinieAutopilot) DrawdownGuardTripped() bool {
+	ga.loadDrawdownGuard()
+
+	ga.drawdown.mu.Lock()
+	defer ga.drawdown.mu.Unlock()
+	return ga.drawdown.tripped
+}
+
+// ResetDrawdownGuard re-arms a tripped kill switch and restarts the high-water
+// mark from current equity. Live trading must still be re-enabled separately.
+func (ga *GinieAutopilot) ResetDrawdownGuard() error {
+	ga.loadDrawdownGuard()
+
+	equity := 0.0
+	if accountInfo, err := ga.futuresClient.GetFuturesAccountInfo(); err == nil {
+		equity = accountInfo.TotalMarginBalance
+	} else {
+		ga.logger.Warn("Drawdown guard reset: failed to get account equity, high-water mark restarts on next sample", "error", err)
+	}
+
+	ga.drawdown.mu.Lock()
+	ga.drawdown.tripped = false
+	ga.drawdown.trippedAt = nil
+	ga.drawdown.tripReason = ""
+	ga.drawdown.highWaterMark = equity
+	if equity > 0 {
+		now := time.Now()
+		ga.drawdown.transfersCheckedAt = &now
+	} else {
+		ga.drawdown.transfersCheckedAt = nil
+	}
+	ga.drawdown.mu.Unlock()
+
+	log.Printf("[DRAWDOWN-GUARD] Kill switch reset, high-water mark restarted at $%.2f", equity)
+	return ga.saveDrawdownGuard()
+}
+
+// GetDrawdownGuardStatus returns the current kill switch state
+func (ga *GinieAutopilot) GetDrawdownGuardStatus() DrawdownGuardStatus {
+	ga.loadDrawdownGuard()
+
+	ga.mu.RLock()
+	maxDrawdown := ga.config.MaxAccountDrawdownPercent
+	ga.mu.RUnlock()
+
+	ga.drawdown.mu.Lock()
+	defer ga.drawdown.mu.Unlock()
+
+	status := DrawdownGuardStatus{
+		Enabled:            maxDrawdown > 0,
+		MaxDrawdownPercent: maxDrawdown,
+		HighWaterMark:      ga.drawdown.highWaterMark,
+		Equity:             ga.drawdown.lastEquity,
+		LastSample:         ga.drawdown.lastSample,
+		Tripped:            ga.drawdown.tripped,
+		TrippedAt:          ga.drawdown.trippedAt,
+		TripReason:         ga.drawdown.tripReason,
+	}
+	if status.HighWaterMark > 0 && status.Equity > 0 {
+		status.DrawdownPercent = (status.HighWaterMark - status.Equity) / status.HighWaterMark * 100
+	}
+	return status
+}
+
+// loadDrawdownGuard reads the persisted state once per instance
+func (ga *GinieAutopilot) loadDrawdownGuard() {
+	ga.drawdown.mu.Lock()
+	defer ga.drawdown.mu.Unlock()
+
+	if ga.drawdown.loaded {
+		return
+	}
+	ga.drawdown.loaded = true
+
+	if ga.repo == nil || ga.userID == "" {
+		return // Legacy mode: state lives for the process only
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbReadTimeout)
+	defer cancel()
+
+	state, err := ga.repo.GetUserDrawdownGuard(ctx, ga.userID)
+	if err != nil {
+		ga.logger.Warn("Failed to load drawdown guard state", "user_id", ga.userID, "error", err)
+		return
+	}
+	if state == nil {
+		return
+	}
+	ga.drawdown.highWaterMark = state.HighWaterMark
+	ga.drawdown.tripped = state.Tripped
+	ga.drawdown.trippedAt = state.TrippedAt
+	ga.drawdown.tripReason = state.TripReason
+	ga.drawdown.transfersCheckedAt = state.TransfersCheckedAt
+}
+
+// saveDrawdownGuard persists the high-water mark and trip state
+func (ga *GinieAutopilot) saveDrawdownGuard() error {
+	if ga.repo == nil || ga.userID == "" {
+		return nil
+	}
+
+	ga.drawdown.mu.Lock()
+	state := &database.UserDrawdownGuard{
+		UserID:        ga.userID,
+		HighWaterMark: ga.drawdown.highWaterMark,
+		Tripped:       ga.drawdown.tripped,
+		TrippedAt:     ga.drawdown.trippedAt,
+		TripReason:    ga.drawdown.tripReason,
+		// Transfers before this are already applied to the high-water mark
+		TransfersCheckedAt: ga.drawdown.transfersCheckedAt,
+	}
+	ga.drawdown.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbReadTimeout)
+	defer cancel()
+
+	if err := ga.repo.SaveUserDrawdownGuard(ctx, state); err != nil {
+		ga.logger.Warn("Failed to save drawdown guard state", "user_id", ga.userID, "error", err)
+		return err
+	}
+	return nil
+}
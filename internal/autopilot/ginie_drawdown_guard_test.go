@@ -0,0 +1,88 @@
+package autopilot
+
+import (
+	"sync"
+	"testing"
+
+	"binance-trading-bot/internal/binance"
+)
+
+// equityStubClient is a mock futures client with a settable account equity
+// and a queue of wallet transfers returned by the next income lookup
+type equityStubClient struct {
+	*binance.FuturesMockClient
+
+	mu        sync.Mutex
+	equity    float64
+	transfers []float64
+}
+
+func (c *equityStubClient) set(equity float64, transfers ...float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.equity = equity
+	c.transfers = append(c.transfers, transfers...)
+}
+
+func (c *equityStubClient) GetFuturesAccountInfo() (*binance.FuturesAccountInfo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return &binance.FuturesAccountInfo{TotalMarginBalance: c.equity}, nil
+}
+
+func (c *equityStubClient) GetAllIncomeHistory(symbol, incomeType string, startTime, endTime int64) ([]binance.IncomeRecord, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var records []binance.IncomeRecord
+	if incomeType == "TRANSFER" {
+		for _, amount := range c.transfers {
+			records = append(records, binance.IncomeRecord{IncomeType: "TRANSFER", Income: amount, Asset: "USDT"})
+		}
+		c.transfers = nil
+	}
+	return records, nil
+}
+
+func TestDrawdownGuardAdjustsForTransfers(t *testing.T) {
+	client := &equityStubClient{FuturesMockClient: binance.NewFuturesMockClient(10000, nil)}
+	ga := newMonitorTestAutopilot(t, client, nil)
+	ga.config.DryRun = false
+	ga.config.MaxAccountDrawdownPercent = 10
+
+	client.set(10000)
+	ga.checkAccountDrawdown()
+	if status := ga.GetDrawdownGuardStatus(); status.HighWaterMark != 10000 {
+		t.Fatalf("high-water mark %.2f after the first sample, want 10000", status.HighWaterMark)
+	}
+
+	// Withdrawing half the account is not a drawdown
+	client.set(5000, -5000)
+	ga.checkAccountDrawdown()
+	status := ga.GetDrawdownGuardStatus()
+	if status.Tripped || status.HighWaterMark != 5000 {
+		t.Fatalf("after a 5000 withdrawal: %+v, want high-water mark 5000 and not tripped", status)
+	}
+
+	// A deposit raises the mark, so it doesn't hide a later loss
+	client.set(8000, 3000)
+	ga.checkAccountDrawdown()
+	if status = ga.GetDrawdownGuardStatus(); status.HighWaterMark != 8000 {
+		t.Fatalf("after a 3000 deposit: high-water mark %.2f, want 8000", status.HighWaterMark)
+	}
+
+	client.set(7500)
+	ga.checkAccountDrawdown()
+	if ga.DrawdownGuardTripped() || ga.config.DryRun {
+		t.Fatal("tripped at a 6.25% drawdown with a 10% limit")
+	}
+
+	// A trading loss still trips the guard
+	client.set(7000)
+	ga.checkAccountDrawdown()
+	if !ga.DrawdownGuardTripped() {
+		t.Fatalf("not tripped at a 12.5%% drawdown: %+v", ga.GetDrawdownGuardStatus())
+	}
+	if !ga.config.DryRun {
+		t.Error("still live after the drawdown guard tripped")
+	}
+}
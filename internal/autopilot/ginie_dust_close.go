@@ -0,0 +1,80 @@
+package autopilot
+
+import (
+	"fmt"
+	"math"
+
+	"binance-trading-bot/internal/binance"
+)
+
+// isDustRemainder reports whether a position's remaining quantity is worth less
+// than DustThresholdUSD at price, i.e. what rounding left behind after a close
+func (ga *GinieAutopilot) isDustRemainder(pos *GiniePosition, price float64) bool {
+	threshold := ga.config.DustThresholdUSD
+	if threshold <= 0 || pos.RemainingQty <= 0 || price <= 0 {
+		return false
+	}
+	return pos.RemainingQty*price < threshold
+}
+
+// sweepDustRemainder closes whatever is left of pos on the exchange with one
+// reduce-only MARKET order. The quantity comes from the exchange rather than
+// RemainingQty, which has drifted by the rounding of each partial close.
+// Binance only accepts closePosition on conditional orders, so a reduce-only
+// order for the exact position size is the market equivalent.
+func (ga *GinieAutopilot) sweepDustRemainder(pos *GiniePosition, reason string) error {
+	side := "SELL"
+	positionSide := binance.PositionSideLong
+	if pos.Side == "SHORT" {
+		side = "BUY"
+		positionSide = binance.PositionSideShort
+	}
+	effectivePositionSide := ga.getEffectivePositionSide(positionSide)
+
+	qty := 0.0
+	positions, err := ga.futuresClient.GetPositions()
+	if err != nil {
+		return fmt.Errorf("failed to fetch %s position for dust sweep: %w", pos.Symbol, err)
+	}
+	for _, p := range positions {
+		if p.Symbol != pos.Symbol || p.PositionAmt == 0 {
+			continue
+		}
+		if p.PositionSide != "" && p.PositionSide != "BOTH" && p.PositionSide != string(positionSide) {
+			continue
+		}
+		if (p.PositionAmt > 0) != (pos.Side == "LONG") {
+			continue
+		}
+		qty = math.Abs(p.PositionAmt)
+		break
+	}
+	if qty == 0 {
+		ga.logger.Info("Dust remainder already flat on exchange",
+			"symbol", pos.Symbol,
+			"local_remaining_qty", pos.RemainingQty,
+			"reason", reason)
+		return nil
+	}
+
+	_, err = ga.placeFuturesOrder(binance.FuturesOrderParams{
+		Symbol:       pos.Symbol,
+		Side:         side,
+		PositionSide: effectivePositionSide,
+		Type:         binance.FuturesOrderTypeMarket,
+		Quantity:     qty,
+		ReduceOnly:   effectivePositionSide == binance.PositionSideBoth, // Hedge mode rejects reduceOnly
+	})
+	if err != nil {
+		return fmt.Errorf("dust sweep order for %s failed: %w", pos.Symbol, err)
+	}
+
+	ga.logger.Info("Ginie swept dust remainder with MARKET order",
+		"symbol", pos.Symbol,
+		"side", side,
+		"qty", qty,
+		"local_remaining_qty", pos.RemainingQty,
+		"threshold_usd", ga.config.DustThresholdUSD,
+		"reason", reason)
+	return nil
+}
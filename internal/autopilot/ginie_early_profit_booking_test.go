@@ -0,0 +1,164 @@
+package autopilot
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"binance-trading-bot/internal/binance"
+	"binance-trading-bot/internal/database"
+	"binance-trading-bot/internal/logging"
+)
+
+// modeConfigCache is a healthy settings cache serving fixed mode configs
+type modeConfigCache struct {
+	SettingsCacheReader
+	modes map[string]*ModeFullConfig
+}
+
+func (c *modeConfigCache) IsHealthy() bool { return true }
+
+func (c *modeConfigCache) GetModeConfig(ctx context.Context, userID, mode string) (*ModeFullConfig, error) {
+	return c.modes[mode], nil
+}
+
+func (c *modeConfigCache) GetCircuitBreaker(ctx context.Context, userID string) (*database.UserGlobalCircuitBreaker, error) {
+	return nil, nil
+}
+
+// newMonitorTestAutopilot returns a dry-run Ginie with just enough state for
+// the position monitor and close paths, trading against a mock futures client.
+// Shared settings are read from (and written to) a temp file, and the test runs
+// in a temp directory so the position state backup file lands there too.
+func newMonitorTestAutopilot(t *testing.T, client binance.FuturesClient, modes map[string]*ModeFullConfig) *GinieAutopilot {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+
+	sm := GetSettingsManager()
+	sm.mu.Lock()
+	settingsPath := sm.settingsPath
+	sm.settingsPath = filepath.Join(t.TempDir(), "autopilot_settings.json")
+	sm.mu.Unlock()
+	t.Cleanup(func() {
+		sm.mu.Lock()
+		sm.settingsPath = settingsPath
+		sm.mu.Unlock()
+	})
+
+	config := DefaultGinieAutopilotConfig()
+	config.DryRun = true
+	return &GinieAutopilot{
+		config:               config,
+		futuresClient:        client,
+		logger:               logging.Default(),
+		userID:               "monitor-test",
+		settingsCache:        &modeConfigCache{modes: modes},
+		positions:            make(map[string]*GiniePosition),
+		symbolLocks:          newSymbolLocks(),
+		blockedCoins:         make(map[string]*CoinBlockInfo),
+		coinConsecLosses:     make(map[string]int),
+		coinBlockHistory:     make(map[string]int),
+		symbolDailyLosses:    make(map[string]int),
+		badLLMCallCount:      make(map[string]int),
+		llmSLDisabled:        make(map[string]bool),
+		slUpdateHistory:      make(map[string]*SLUpdateHistory),
+		maxSignalLogs:        500,
+		maxHistory:           1000,
+		maxLLMSwitches:       500,
+		volatilityRegimes:    make(map[string]*VolatilityRegime),
+		lastRegimeUpdate:     make(map[string]time.Time),
+		modeAllocationStates: make(map[string]*ModeAllocationState),
+		modeUsedUSD:          make(map[string]float64),
+		modePositionCounts:   make(map[string]int),
+		modeSafetyStates:     make(map[string]*ModeSafetyState),
+		modeSafetyConfigs:    make(map[string]*ModeSafetyConfig),
+		modeCircuitBreakers:  make(map[GinieTradingMode]*ModeCircuitBreaker),
+		pendingLimitOrders:   make(map[string]*PendingLimitOrder),
+		earlyWarningCounter:  make(map[string]int),
+		lastWarningTime:      make(map[string]time.Time),
+		stopChan:             make(chan struct{}),
+	}
+}
+
+// newMonitorTestPosition returns an open 5x long with a TP ladder well above entry
+func newMonitorTestPosition(symbol string, mode GinieTradingMode, entry float64) *GiniePosition {
+	return &GiniePosition{
+		Symbol:       symbol,
+		Side:         "LONG",
+		Mode:         mode,
+		EntryPrice:   entry,
+		OriginalQty:  1,
+		RemainingQty: 1,
+		Leverage:     5,
+		EntryTime:    time.Now(),
+		StopLoss:     entry * 0.9,
+		OriginalSL:   entry * 0.9,
+		HighestPrice: entry,
+		LowestPrice:  entry,
+		TakeProfits: []GinieTakeProfitLevel{
+			{Level: 1, Price: entry * 1.2, Percent: 100, Status: "pending"},
+		},
+	}
+}
+
+func TestEarlyProfitBookingPolicy(t *testing.T) {
+	off := false
+	ga := newMonitorTestAutopilot(t, nil, map[string]*ModeFullConfig{
+		"swing": {ModeName: "swing", EarlyProfitBookingEnabled: &off},
+		"scalp": {ModeName: "scalp"},
+	})
+
+	if enabled, policy := ga.earlyProfitBookingPolicy(GinieModeSwing); enabled || policy != "mode:swing" {
+		t.Errorf("swing policy = %v (%s), want disabled by mode:swing", enabled, policy)
+	}
+	if enabled, policy := ga.earlyProfitBookingPolicy(GinieModeScalp); !enabled || policy != "global" {
+		t.Errorf("scalp policy = %v (%s), want enabled by global", enabled, policy)
+	}
+	ga.config.EarlyProfitBookingEnabled = false
+	if enabled, _ := ga.earlyProfitBookingPolicy(GinieModeScalp); enabled {
+		t.Error("scalp booked early with the global switch off and no mode override")
+	}
+}
+
+func TestMonitorPositionBooksEarlyProfit(t *testing.T) {
+	off := false
+	price := 100.0
+	client := binance.NewFuturesMockClient(10000, func(string) (float64, error) { return price, nil })
+	ga := newMonitorTestAutopilot(t, client, map[string]*ModeFullConfig{
+		"swing": {ModeName: "swing", EarlyProfitBookingEnabled: &off},
+		"scalp": {ModeName: "scalp"},
+	})
+
+	ga.positions["BTCUSDT"] = newMonitorTestPosition("BTCUSDT", GinieModeScalp, 100)
+	ga.positions["ETHUSDT"] = newMonitorTestPosition("ETHUSDT", GinieModeSwing, 100)
+
+	// +4% at 5x is ~20% ROI after fees: above every early booking threshold,
+	// below TP1 and the trailing activation
+	price = 104
+	ga.monitorPosition("BTCUSDT", price)
+	ga.monitorPosition("ETHUSDT", price)
+
+	ga.mu.RLock()
+	_, btcOpen := ga.positions["BTCUSDT"]
+	_, ethOpen := ga.positions["ETHUSDT"]
+	history := append([]GinieTradeResult(nil), ga.tradeHistory...)
+	ga.mu.RUnlock()
+
+	if btcOpen {
+		t.Error("scalp position not booked at ~20% ROI")
+	}
+	if !ethOpen {
+		t.Error("swing position booked early although its mode disables early booking")
+	}
+	if len(history) != 1 || history[0].Symbol != "BTCUSDT" || history[0].Reason != "early_profit_booking" {
+		t.Errorf("trade history = %+v, want one early_profit_booking close of BTCUSDT", history)
+	}
+}
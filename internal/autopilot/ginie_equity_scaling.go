@@ -0,0 +1,172 @@
+package autopilot
+
+import (
+	"log"
+	"math"
+	"sync"
+	"time"
+)
+
+// defaultEquityScalingFloor is used when EquityScalingFloor is unset or out of range
+const defaultEquityScalingFloor = 0.5
+
+// equityScalingState tracks realized equity (wallet balance, no unrealized PnL)
+// against its starting point and peak for anti-martingale sizing. It lives for
+// the process: with EquityScalingStartUSD unset, the start is re-captured after
+// a restart.
+type equityScalingState struct {
+	mu         sync.Mutex
+	start      float64
+	peak       float64
+	equity     float64
+	multiplier float64
+	lastSample time.Time
+}
+
+// EquityScalingStatus is the anti-martingale scaler state exposed by the API
+type EquityScalingStatus struct {
+	Enabled         bool      `json:"enabled"`
+	StartEquity     float64   `json:"start_equity"`
+	PeakEquity      float64   `json:"peak_equity"`
+	Equity          float64   `json:"equity"`
+	GainPercent     float64   `json:"gain_percent"`
+	DrawdownPercent float64   `json:"drawdown_percent"`
+	GainLimit       float64   `json:"gain_limit_percent"`
+	DrawdownLimit   float64   `json:"drawdown_limit_percent"`
+	Floor           float64   `json:"floor"`
+	Multiplier      float64   `json:"multiplier"` // Applied to position size and leverage
+	LastSample      time.Time `json:"last_sample,omitempty"`
+}
+
+// equityScalingMultiplier returns the size/leverage multiplier for the current
+// realized equity. It falls linearly from 1 to floor as equity grows gainPct
+// above start (locking in gains) and as it falls drawdownPct below peak (not
+// pressing into a drawdown); the smaller of the two applies. A 0 limit turns
+// that side off.
+func equityScalingMultiplier(start, peak, equity, gainPct, drawdownPct, floor float64) float64 {
+	if floor <= 0 || floor > 1 {
+		floor = defaultEquityScalingFloor
+	}
+	reduce := func(move, limit float64) float64 {
+		return 1 - (1-floor)*math.Min(move/limit, 1)
+	}
+
+	multiplier := 1.0
+	if gainPct > 0 && start > 0 && equity > start {
+		multiplier = math.Min(multiplier, reduce((equity-start)/start*100, gainPct))
+	}
+	if drawdownPct > 0 && peak > 0 && equity < peak {
+		multiplier = math.Min(multiplier, reduce((peak-equity)/peak*100, drawdownPct))
+	}
+	return multiplier
+}
+
+// sampleEquityScaling fetches realized equity, updates the start and peak and
+// returns the current multiplier (1 when disabled or equity is unavailable).
+// Must not be called with ga.mu held.
+func (ga *GinieAutopilot) sampleEquityScaling() float64 {
+	ga.mu.RLock()
+	enabled := ga.config.EquityScalingEnabled
+	startUSD := ga.config.EquityScalingStartUSD
+	gainPct := ga.config.EquityScalingGainPercent
+	drawdownPct := ga.config.EquityScalingDrawdownPercent
+	floor := ga.config.EquityScalingFloor
+	ga.mu.RUnlock()
+
+	if !enabled {
+		ga.equityScaling.mu.Lock()
+		ga.equityScaling.multiplier = 1
+		ga.equityScaling.mu.Unlock()
+		return 1
+	}
+
+	accountInfo, err := ga.futuresClient.GetFuturesAccountInfo()
+	if err != nil || accountInfo.TotalWalletBalance <= 0 {
+		ga.logger.Warn("Equity scaling: failed to get wallet balance, keeping last multiplier", "error", err)
+		return ga.EquityScalingMultiplier()
+	}
+	equity := accountInfo.TotalWalletBalance
+
+	ga.equityScaling.mu.Lock()
+	defer ga.equityScaling.mu.Unlock()
+
+	st := &ga.equityScaling
+	if startUSD > 0 {
+		st.start = startUSD
+	} else if st.start <= 0 {
+		st.start = equity
+		log.Printf("[EQUITY-SCALING] Starting equity captured at $%.2f", equity)
+	}
+	if equity > st.peak {
+		st.peak = equity
+	}
+	st.equity = equity
+	st.lastSample = time.Now()
+
+	previous := st.multiplier
+	st.multiplier = equityScalingMultiplier(st.start, st.peak, equity, gainPct, drawdownPct, floor)
+	if previous != 0 && math.Abs(st.multiplier-previous) >= 0.05 {
+		ga.logger.Info("Equity scaling multiplier changed",
+			"from", previous,
+			"to", st.multiplier,
+			"equity", equity,
+			"start", st.start,
+			"peak", st.peak)
+	}
+	return st.multiplier
+}
+
+// EquityScalingMultiplier returns the multiplier from the last equity sample
+func (ga *GinieAutopilot) EquityScalingMultiplier() float64 {
+	ga.equityScaling.mu.Lock()
+	defer ga.equityScaling.mu.Unlock()
+
+	if ga.equityScaling.multiplier <= 0 {
+		return 1
+	}
+	return ga.equityScaling.multiplier
+}
+
+// scaleLeverageForEquity applies the last equity multiplier to a leverage, never below 1x
+func (ga *GinieAutopilot) scaleLeverageForEquity(leverage int) int {
+	multiplier := ga.EquityScalingMultiplier()
+	if multiplier >= 1 {
+		return leverage
+	}
+	scaled := int(math.Floor(float64(leverage) * multiplier))
+	if scaled < 1 {
+		scaled = 1
+	}
+	return scaled
+}
+
+// GetEquityScalingStatus returns the current anti-martingale scaler state
+func (ga *GinieAutopilot) GetEquityScalingStatus() EquityScalingStatus {
+	ga.mu.RLock()
+	status := EquityScalingStatus{
+		Enabled:       ga.config.EquityScalingEnabled,
+		GainLimit:     ga.config.EquityScalingGainPercent,
+		DrawdownLimit: ga.config.EquityScalingDrawdownPercent,
+		Floor:         ga.config.EquityScalingFloor,
+	}
+	ga.mu.RUnlock()
+
+	ga.equityScaling.mu.Lock()
+	defer ga.equityScaling.mu.Unlock()
+
+	status.StartEquity = ga.equityScaling.start
+	status.PeakEquity = ga.equityScaling.peak
+	status.Equity = ga.equityScaling.equity
+	status.LastSample = ga.equityScaling.lastSample
+	status.Multiplier = 1
+	if status.Enabled && ga.equityScaling.multiplier > 0 {
+		status.Multiplier = ga.equityScaling.multiplier
+	}
+	if status.StartEquity > 0 && status.Equity > status.StartEquity {
+		status.GainPercent = (status.Equity - status.StartEquity) / status.StartEquity * 100
+	}
+	if status.PeakEquity > 0 && status.Equity < status.PeakEquity {
+		status.DrawdownPercent = (status.PeakEquity - status.Equity) / status.PeakEquity * 100
+	}
+	return status
+}
@@ -0,0 +1,53 @@
+package autopilot
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEquityScalingMultiplier(t *testing.T) {
+	tests := []struct {
+		name        string
+		start       float64
+		peak        float64
+		equity      float64
+		gainPct     float64
+		drawdownPct float64
+		floor       float64
+		want        float64
+	}{
+		{"at start", 1000, 1000, 1000, 50, 20, 0.5, 1},
+		{"halfway to gain limit", 1000, 1250, 1250, 50, 20, 0.5, 0.75},
+		{"gain beyond limit stops at floor", 1000, 2000, 2000, 50, 20, 0.5, 0.5},
+		{"gain side disabled", 1000, 2000, 2000, 0, 20, 0.5, 1},
+		{"drawdown from peak", 1000, 1000, 900, 50, 20, 0.5, 0.75},
+		{"drawdown beyond limit stops at floor", 1000, 1000, 500, 50, 20, 0.5, 0.5},
+		{"drawdown side disabled", 1000, 1000, 900, 50, 0, 0.5, 1},
+		{"smaller of gain and drawdown applies", 1000, 1500, 1350, 50, 20, 0.5, 0.65},
+		{"invalid floor uses default", 1000, 2000, 2000, 50, 20, 0, defaultEquityScalingFloor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := equityScalingMultiplier(tt.start, tt.peak, tt.equity, tt.gainPct, tt.drawdownPct, tt.floor)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("equityScalingMultiplier() = %.4f, want %.4f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScaleLeverageForEquity(t *testing.T) {
+	ga := &GinieAutopilot{}
+	if got := ga.scaleLeverageForEquity(10); got != 10 {
+		t.Errorf("unsampled scaler changed leverage to %dx", got)
+	}
+
+	ga.equityScaling.multiplier = 0.55
+	if got := ga.scaleLeverageForEquity(10); got != 5 {
+		t.Errorf("scaleLeverageForEquity(10) at 0.55 = %dx, want 5x", got)
+	}
+	if got := ga.scaleLeverageForEquity(1); got != 1 {
+		t.Errorf("scaleLeverageForEquity(1) = %dx, want 1x floor", got)
+	}
+}
@@ -0,0 +1,203 @@
+package autopilot
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"binance-trading-bot/internal/binance"
+)
+
+// Exchange states reported by GetExchangeStatus
+const (
+	ExchangeStateNormal      = "normal"
+	ExchangeStateMaintenance = "maintenance"
+)
+
+// RejectionExchangeMaintenance is the canTrade reason during a maintenance hold
+const RejectionExchangeMaintenance = "exchange_maintenance"
+
+// maintenanceMaxPingInterval caps the ping backoff during a maintenance hold
+const maintenanceMaxPingInterval = 5 * time.Minute
+
+// ExchangeStatus is Ginie's view of whether the exchange is reachable
+type ExchangeStatus struct {
+	State               string    `json:"state"` // "normal" or "maintenance"
+	HoldEnabled         bool      `json:"hold_enabled"`
+	HoldSince           time.Time `json:"hold_since,omitempty"`
+	ConsecutiveFailures int       `json:"consecutive_failures"`
+	LastError           string    `json:"last_error,omitempty"`
+	LastErrorTime       time.Time `json:"last_error_time,omitempty"`
+	LastPingTime        time.Time `json:"last_ping_time,omitempty"`
+	LastPingOK          bool      `json:"last_ping_ok"`
+	NextPingAt          time.Time `json:"next_ping_at,omitempty"`
+	HoldCount           int       `json:"hold_count"` // Maintenance holds entered since start
+	LastResumedAt       time.Time `json:"last_resumed_at,omitempty"`
+	LastHoldDuration    string    `json:"last_hold_duration,omitempty"`
+}
+
+// GetExchangeStatus returns the exchange availability and maintenance hold state
+func (ga *GinieAutopilot) GetExchangeStatus() ExchangeStatus {
+	ga.mu.RLock()
+	defer ga.mu.RUnlock()
+	return ga.getExchangeStatusLocked()
+}
+
+// getExchangeStatusLocked returns a copy of the exchange status (must hold lock)
+func (ga *GinieAutopilot) getExchangeStatusLocked() ExchangeStatus {
+	status := ga.exchangeStatus
+	if status.State == "" {
+		status.State = ExchangeStateNormal
+	}
+	status.HoldEnabled = ga.config.MaintenanceHoldEnabled
+	if !status.LastPingTime.IsZero() {
+		status.NextPingAt = status.LastPingTime.Add(ga.maintenancePingIntervalLocked())
+	}
+	return status
+}
+
+// inMaintenanceHoldLocked reports whether new entries and position checks are
+// paused for exchange maintenance. Caller must hold ga.mu.
+func (ga *GinieAutopilot) inMaintenanceHoldLocked() bool {
+	return ga.exchangeStatus.State == ExchangeStateMaintenance
+}
+
+// inMaintenanceHold reports whether a maintenance hold is active
+func (ga *GinieAutopilot) inMaintenanceHold() bool {
+	ga.mu.RLock()
+	defer ga.mu.RUnlock()
+	return ga.inMaintenanceHoldLocked()
+}
+
+// maintenancePingIntervalLocked is the time between pings: MaintenancePingSeconds
+// normally, doubling on each failed ping while held. Caller must hold ga.mu.
+func (ga *GinieAutopilot) maintenancePingIntervalLocked() time.Duration {
+	interval := time.Duration(ga.config.MaintenancePingSeconds) * time.Second
+	if ga.inMaintenanceHoldLocked() && ga.maintenancePingBackoff > interval {
+		interval = ga.maintenancePingBackoff
+	}
+	return interval
+}
+
+// checkExchangeStatusIfDue pings the exchange once the ping interval has passed
+func (ga *GinieAutopilot) checkExchangeStatusIfDue() {
+	ga.mu.RLock()
+	enabled := ga.config.MaintenanceHoldEnabled && ga.config.MaintenancePingSeconds > 0
+	due := time.Since(ga.exchangeStatus.LastPingTime) >= ga.maintenancePingIntervalLocked()
+	ga.mu.RUnlock()
+	if !enabled || !due || ga.futuresClient == nil {
+		return
+	}
+	ga.pingExchange()
+}
+
+// pingExchange probes the futures API. A maintenance reply enters the hold at
+// once, other failures count toward MaintenanceErrorThreshold, and a
+// successful ping during a hold resumes trading and reconciles positions.
+func (ga *GinieAutopilot) pingExchange() {
+	err := ga.futuresClient.Ping()
+
+	ga.mu.Lock()
+	ga.exchangeStatus.LastPingTime = time.Now()
+	ga.exchangeStatus.LastPingOK = err == nil
+	if err != nil {
+		ga.mu.Unlock()
+		ga.recordExchangeFailure(err, errors.Is(err, binance.ErrExchangeMaintenance))
+		return
+	}
+
+	ga.exchangeStatus.ConsecutiveFailures = 0
+	if !ga.inMaintenanceHoldLocked() {
+		ga.mu.Unlock()
+		return
+	}
+	held := time.Since(ga.exchangeStatus.HoldSince).Round(time.Second)
+	ga.exchangeStatus.State = ExchangeStateNormal
+	ga.exchangeStatus.LastResumedAt = time.Now()
+	ga.exchangeStatus.LastHoldDuration = held.String()
+	ga.maintenancePingBackoff = 0
+	notifier := ga.alertNotifier
+	ga.mu.Unlock()
+
+	ga.logger.Info("Exchange is back - resuming trading after maintenance hold", "held_for", held.String())
+	log.Printf("[EXCHANGE-STATUS] Exchange reachable again after %s, reconciling positions and resuming", held)
+
+	// Positions may have been stopped out or liquidated while the exchange was down
+	go ga.reconcilePositions()
+
+	ga.sendExchangeStatusAlert(notifier, false, fmt.Sprintf(
+		"The exchange is reachable again after %s. Positions are being reconciled and new entries are resumed.", held))
+}
+
+// recordExchangeError counts a failed exchange call toward the maintenance hold.
+// Only maintenance replies (HTTP 503, SERVICE_SHUTTING_DOWN) count; ordinary
+// rejections say nothing about the exchange being down.
+func (ga *GinieAutopilot) recordExchangeError(err error) {
+	if err == nil || !errors.Is(err, binance.ErrExchangeMaintenance) {
+		return
+	}
+	ga.recordExchangeFailure(err, false)
+}
+
+// recordExchangeFailure records a failure and enters the maintenance hold when
+// immediate is set or MaintenanceErrorThreshold failures have happened in a row
+func (ga *GinieAutopilot) recordExchangeFailure(err error, immediate bool) {
+	ga.mu.Lock()
+	if !ga.config.MaintenanceHoldEnabled {
+		ga.mu.Unlock()
+		return
+	}
+	ga.exchangeStatus.ConsecutiveFailures++
+	ga.exchangeStatus.LastError = err.Error()
+	ga.exchangeStatus.LastErrorTime = time.Now()
+
+	if ga.inMaintenanceHoldLocked() {
+		// Still down: back the ping off further
+		ga.maintenancePingBackoff = min(ga.maintenancePingBackoff*2, maintenanceMaxPingInterval)
+		ga.mu.Unlock()
+		return
+	}
+	failures := ga.exchangeStatus.ConsecutiveFailures
+	threshold := max(ga.config.MaintenanceErrorThreshold, 1)
+	if !immediate && failures < threshold {
+		ga.mu.Unlock()
+		return
+	}
+	ga.exchangeStatus.State = ExchangeStateMaintenance
+	ga.exchangeStatus.HoldSince = time.Now()
+	ga.exchangeStatus.HoldCount++
+	ga.maintenancePingBackoff = time.Duration(ga.config.MaintenancePingSeconds) * time.Second
+	notifier := ga.alertNotifier
+	positions := len(ga.positions)
+	ga.mu.Unlock()
+
+	ga.logger.Warn("Exchange unavailable - entering maintenance hold",
+		"reason", RejectionExchangeMaintenance,
+		"consecutive_failures", failures,
+		"error", err)
+	log.Printf("[EXCHANGE-STATUS] Maintenance hold: %d consecutive failure(s), last error: %v", failures, err)
+
+	ga.sendExchangeStatusAlert(notifier, true, fmt.Sprintf(
+		"The exchange looks down for maintenance (%v). New entries and position checks are paused; %d open position(s) keep their exchange-side SL/TP. Trading resumes automatically once the exchange answers again.",
+		err, positions))
+}
+
+// sendExchangeStatusAlert notifies about entering (down) or leaving a maintenance hold
+func (ga *GinieAutopilot) sendExchangeStatusAlert(notifier AlertNotifier, down bool, message string) {
+	if notifier == nil {
+		return
+	}
+	if ga.userID != "" {
+		message = fmt.Sprintf("User %s: %s", ga.userID, message)
+	}
+	var err error
+	if down {
+		err = notifier.SendError("Ginie exchange maintenance hold", message)
+	} else {
+		err = notifier.SendInfo("Ginie exchange back online", message)
+	}
+	if err != nil {
+		ga.logger.Warn("Failed to send exchange status alert", "error", err)
+	}
+}
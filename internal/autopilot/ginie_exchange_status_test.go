@@ -0,0 +1,82 @@
+package autopilot
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"binance-trading-bot/internal/binance"
+	"binance-trading-bot/internal/logging"
+)
+
+type pingClient struct {
+	binance.FuturesClient
+	err error
+}
+
+func (c *pingClient) Ping() error { return c.err }
+
+func maintenanceError() error {
+	return fmt.Errorf("error pinging futures API: %w", &binance.APIError{StatusCode: 503, Body: "Service Unavailable"})
+}
+
+func TestMaintenanceHoldEnterAndResume(t *testing.T) {
+	client := &pingClient{err: maintenanceError()}
+	config := DefaultGinieAutopilotConfig()
+	config.DryRun = true // reconcilePositions is a no-op on resume
+	ga := &GinieAutopilot{config: config, logger: logging.Default(), futuresClient: client, running: true}
+
+	ga.pingExchange()
+	status := ga.GetExchangeStatus()
+	if status.State != ExchangeStateMaintenance || status.HoldCount != 1 || status.LastPingOK {
+		t.Fatalf("after maintenance ping: %+v", status)
+	}
+	if ok, reason := ga.canTradeWithReasonLocked(); ok || !strings.HasPrefix(reason, RejectionExchangeMaintenance) {
+		t.Errorf("canTrade during hold = %v, %q", ok, reason)
+	}
+
+	// Still down: the ping backs off
+	ga.pingExchange()
+	if ga.maintenancePingBackoff != time.Minute {
+		t.Errorf("backoff = %v, want 1m", ga.maintenancePingBackoff)
+	}
+
+	client.err = nil
+	ga.pingExchange()
+	status = ga.GetExchangeStatus()
+	if status.State != ExchangeStateNormal || status.ConsecutiveFailures != 0 || status.LastResumedAt.IsZero() {
+		t.Errorf("after recovery: %+v", status)
+	}
+}
+
+func TestMaintenanceHoldThreshold(t *testing.T) {
+	config := DefaultGinieAutopilotConfig()
+	config.MaintenanceErrorThreshold = 3
+	ga := &GinieAutopilot{config: config, logger: logging.Default(), futuresClient: &pingClient{err: errors.New("connection refused")}}
+
+	// Ordinary rejections don't count toward the hold
+	ga.recordExchangeError(fmt.Errorf("error placing order: %w", &binance.APIError{StatusCode: 400, Code: -2019}))
+	if n := ga.GetExchangeStatus().ConsecutiveFailures; n != 0 {
+		t.Fatalf("rejection counted as exchange failure: %d", n)
+	}
+
+	// Non-maintenance ping failures need the threshold
+	ga.pingExchange()
+	ga.pingExchange()
+	if ga.inMaintenanceHold() {
+		t.Fatal("hold entered below the threshold")
+	}
+	ga.recordExchangeError(maintenanceError())
+	if !ga.inMaintenanceHold() {
+		t.Fatalf("no hold after 3 failures: %+v", ga.GetExchangeStatus())
+	}
+
+	config.MaintenanceHoldEnabled = false
+	other := &GinieAutopilot{config: config, logger: logging.Default(), futuresClient: &pingClient{err: maintenanceError()}}
+	other.pingExchange()
+	if other.inMaintenanceHold() {
+		t.Error("hold entered with MaintenanceHoldEnabled off")
+	}
+}
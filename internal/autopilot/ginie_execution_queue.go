@@ -0,0 +1,186 @@
+package autopilot
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+)
+
+// queuedSignal is a scan candidate waiting in the cross-mode execution queue
+type queuedSignal struct {
+	*scanCandidate
+	mode       GinieTradingMode
+	priority   float64
+	enqueuedAt time.Time
+}
+
+// ExecutionQueueDiagnostics shows the cross-mode execution queue's state
+type ExecutionQueueDiagnostics struct {
+	Enabled       bool      `json:"enabled"`
+	Depth         int       `json:"depth"` // Signals waiting for the next drain
+	LastDrainTime time.Time `json:"last_drain_time,omitempty"`
+	LastDrainSize int       `json:"last_drain_size"`
+	LastExecuted  int       `json:"last_executed"`
+	LastSkipped   int       `json:"last_skipped"`
+	TotalEnqueued int       `json:"total_enqueued"`
+	TotalExecuted int       `json:"total_executed"`
+}
+
+// queueModeWeight returns the mode's execution queue priority weight (0 means 1)
+func (ga *GinieAutopilot) queueModeWeight(mode GinieTradingMode) float64 {
+	weight := 0.0
+	switch mode {
+	case GinieModeScalp:
+		weight = ga.config.QueueWeightScalp
+	case GinieModeSwing:
+		weight = ga.config.QueueWeightSwing
+	case GinieModePosition:
+		weight = ga.config.QueueWeightPosition
+	}
+	if weight <= 0 {
+		return 1.0
+	}
+	return weight
+}
+
+// executionPriority scores a signal for the queue: decayed confidence x RR x mode weight.
+// A signal without an RR counts as 1:1.
+func (ga *GinieAutopilot) executionPriority(candidate *scanCandidate, mode GinieTradingMode, now time.Time) float64 {
+	halfLife := time.Duration(ga.config.ConfidenceDecayHalfLifeSeconds) * time.Second
+	candidate.decayedConfidence = decayedConfidence(candidate.decision.ConfidenceScore, now.Sub(candidate.generatedAt), halfLife)
+
+	rr := candidate.decision.TradeExecution.RiskReward
+	if rr <= 0 {
+		rr = 1
+	}
+	return candidate.decayedConfidence * rr * ga.queueModeWeight(mode)
+}
+
+// enqueueScanCandidates hands a mode's qualifying signals to the execution queue.
+// Returns false when the queue is disabled and the caller should execute them directly.
+func (ga *GinieAutopilot) enqueueScanCandidates(mode GinieTradingMode, candidates []*scanCandidate) bool {
+	ga.mu.Lock()
+	defer ga.mu.Unlock()
+
+	if !ga.config.ExecutionQueueEnabled {
+		return false
+	}
+
+	now := time.Now()
+	for _, candidate := range candidates {
+		ga.executionQueue = append(ga.executionQueue, &queuedSignal{
+			scanCandidate: candidate,
+			mode:          mode,
+			priority:      ga.executionPriority(candidate, mode, now),
+			enqueuedAt:    now,
+		})
+	}
+	ga.executionQueueStats.TotalEnqueued += len(candidates)
+
+	if len(candidates) > 0 {
+		log.Printf("[EXEC-QUEUE] %s: %d signals queued, depth %d", mode, len(candidates), len(ga.executionQueue))
+	}
+	return true
+}
+
+// modeMaxPositions returns the mode's position slot limit, falling back to MaxPositions
+func (ga *GinieAutopilot) modeMaxPositions(mode GinieTradingMode) int {
+	maxPositions := ga.config.MaxPositions
+	if modeConfig := ga.getModeConfigForSizing(mode); modeConfig != nil && modeConfig.Size != nil && modeConfig.Size.MaxPositions > 0 {
+		maxPositions = modeConfig.Size.MaxPositions
+	}
+	return maxPositions
+}
+
+// drainExecutionQueue executes every queued signal from this scan cycle, highest
+// priority first, so the best signals across all modes get slots and balance
+// before weaker ones. It runs on the main loop only, making it the single
+// executor: slot counts can't change under it except by its own trades.
+// Balance is enforced per trade by executeTradeWithResult's sizing and
+// allocation checks. Signals that don't get a slot are logged as outranked.
+func (ga *GinieAutopilot) drainExecutionQueue() {
+	ga.mu.Lock()
+	queue := ga.executionQueue
+	ga.executionQueue = nil
+	ga.mu.Unlock()
+
+	if len(queue) == 0 {
+		return
+	}
+
+	sort.SliceStable(queue, func(i, j int) bool { return queue[i].priority > queue[j].priority })
+
+	executed, skipped := 0, 0
+	for rank, item := range queue {
+		select {
+		case <-ga.stopChan:
+			return
+		default:
+		}
+		signalLog := item.signalLog
+
+		maxPositions := ga.modeMaxPositions(item.mode)
+		ga.mu.RLock()
+		modePositions := 0
+		for _, pos := range ga.positions {
+			if pos.Mode == item.mode {
+				modePositions++
+			}
+		}
+		totalPositions := len(ga.positions)
+		globalMax := ga.config.MaxPositions
+		ga.mu.RUnlock()
+
+		if modePositions >= maxPositions || totalPositions >= globalMax {
+			skipped++
+			signalLog.Status = "rejected"
+			signalLog.RejectionReason = fmt.Sprintf("outranked: queue rank %d/%d, priority %.1f (%s slots %d/%d, total %d/%d)",
+				rank+1, len(queue), item.priority, item.mode, modePositions, maxPositions, totalPositions, globalMax)
+			signalLog.RejectionDetails = &SignalRejectionDetails{
+				AllReasons: []string{signalLog.RejectionReason},
+				PositionLimit: &PositionLimitInfo{
+					CurrentPositions: totalPositions,
+					MaxPositions:     maxPositions,
+					ModePositions:    modePositions,
+					ModeName:         string(item.mode),
+				},
+			}
+			ga.LogSignal(signalLog)
+			continue
+		}
+
+		tradeSuccess, tradeReason := ga.executeTradeWithResult(item.decision)
+		if tradeSuccess {
+			executed++
+			signalLog.Status = "executed"
+			log.Printf("[EXEC-QUEUE] %s (%s) rank %d/%d priority %.1f: executed: %s",
+				item.symbol, item.mode, rank+1, len(queue), item.priority, tradeReason)
+		} else {
+			skipped++
+			signalLog.Status = "rejected"
+			signalLog.RejectionReason = tradeReason
+			log.Printf("[EXEC-QUEUE] %s (%s) rank %d/%d priority %.1f: rejected: %s",
+				item.symbol, item.mode, rank+1, len(queue), item.priority, tradeReason)
+		}
+		ga.LogSignal(signalLog)
+	}
+
+	ga.mu.Lock()
+	ga.executionQueueStats.LastDrainTime = time.Now()
+	ga.executionQueueStats.LastDrainSize = len(queue)
+	ga.executionQueueStats.LastExecuted = executed
+	ga.executionQueueStats.LastSkipped = skipped
+	ga.executionQueueStats.TotalExecuted += executed
+	ga.mu.Unlock()
+
+	log.Printf("[EXEC-QUEUE] Drained %d signals: %d executed, %d skipped", len(queue), executed, skipped)
+}
+
+// getExecutionQueueDiagnosticsLocked returns the execution queue state (must hold lock)
+func (ga *GinieAutopilot) getExecutionQueueDiagnosticsLocked() ExecutionQueueDiagnostics {
+	diag := ga.executionQueueStats
+	diag.Enabled = ga.config.ExecutionQueueEnabled
+	diag.Depth = len(ga.executionQueue)
+	return diag
+}
@@ -0,0 +1,23 @@
+package autopilot
+
+import (
+	"log"
+)
+
+// exitCheckPrice returns the price the client-side SL/TP, trailing and
+// early-profit checks compare against. With UseMarkPriceForExits it is the
+// mark price - streamed into the market data cache, REST on a cache miss -
+// which is what the exchange's MARK_PRICE stop orders trigger on, so a
+// last-price wick the mark price never reached can't close a position
+// client-side. If no mark price is available the last price is used rather
+// than skipping the check.
+func (ga *GinieAutopilot) exitCheckPrice(symbol string) (float64, error) {
+	if ga.config.UseMarkPriceForExits {
+		markPrice, err := ga.futuresClient.GetMarkPrice(symbol)
+		if err == nil && markPrice != nil && markPrice.MarkPrice > 0 {
+			return markPrice.MarkPrice, nil
+		}
+		log.Printf("[EXIT-PRICE] %s: mark price unavailable (%v), using last price", symbol, err)
+	}
+	return ga.futuresClient.GetFuturesCurrentPrice(symbol)
+}
@@ -0,0 +1,68 @@
+package autopilot
+
+import (
+	"fmt"
+	"log"
+)
+
+// lossAlertThresholdsLocked returns the unrealized loss alert thresholds for a
+// mode: USD loss and adverse price move %. A mode's risk settings override the
+// global ones field by field; 0 disables a threshold. Caller must hold ga.mu.
+func (ga *GinieAutopilot) lossAlertThresholdsLocked(mode GinieTradingMode) (usd, percent float64) {
+	usd, percent = ga.config.LossAlertUSD, ga.config.LossAlertPercent
+	if modeConfig := ga.getModeConfig(mode); modeConfig != nil && modeConfig.Risk != nil {
+		if modeConfig.Risk.LossAlertUSD > 0 {
+			usd = modeConfig.Risk.LossAlertUSD
+		}
+		if modeConfig.Risk.LossAlertPercent > 0 {
+			percent = modeConfig.Risk.LossAlertPercent
+		}
+	}
+	return usd, percent
+}
+
+// checkLossAlertLocked sends one early-warning alert when a position's unrealized
+// loss crosses its mode's threshold. The alert re-arms once the position is back
+// at breakeven, so a trade that recovers and turns against us again warns again.
+// Caller must hold ga.mu.
+func (ga *GinieAutopilot) checkLossAlertLocked(pos *GiniePosition, currentPrice, pnlPercent float64) {
+	if pos.UnrealizedPnL >= 0 {
+		pos.LossAlertSent = false
+		return
+	}
+	if pos.LossAlertSent {
+		return
+	}
+
+	usd, percent := ga.lossAlertThresholdsLocked(pos.Mode)
+	var reason string
+	switch {
+	case usd > 0 && -pos.UnrealizedPnL >= usd:
+		reason = fmt.Sprintf("unrealized loss $%.2f >= $%.2f", -pos.UnrealizedPnL, usd)
+	case percent > 0 && -pnlPercent >= percent:
+		reason = fmt.Sprintf("price moved %.2f%% against entry >= %.2f%%", -pnlPercent, percent)
+	default:
+		return
+	}
+	pos.LossAlertSent = true
+
+	log.Printf("[LOSS-ALERT] %s %s [%s]: %s (entry %.6f, price %.6f, SL %.6f)",
+		pos.Symbol, pos.Side, pos.Mode, reason, pos.EntryPrice, currentPrice, pos.StopLoss)
+
+	notifier := ga.alertNotifier
+	if notifier == nil {
+		return
+	}
+	title := fmt.Sprintf("%s %s position losing", pos.Symbol, pos.Side)
+	msg := fmt.Sprintf("%s %s (%s mode): %s. Entry %.6f, price %.6f, stop loss %.6f, unrealized PnL $%.2f.",
+		pos.Symbol, pos.Side, pos.Mode, reason, pos.EntryPrice, currentPrice, pos.StopLoss, pos.UnrealizedPnL)
+	if ga.userID != "" {
+		msg = fmt.Sprintf("User %s: %s", ga.userID, msg)
+	}
+	symbol := pos.Symbol
+	go func() {
+		if err := notifier.SendInfo(title, msg); err != nil {
+			ga.logger.Warn("Failed to send loss alert", "symbol", symbol, "error", err)
+		}
+	}()
+}
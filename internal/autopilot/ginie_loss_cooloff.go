@@ -0,0 +1,126 @@
+package autopilot
+
+import (
+	"time"
+)
+
+// LossCoolOffDiagnostics shows the loss-streak cool-off and the resulting
+// global confidence threshold
+type LossCoolOffDiagnostics struct {
+	Enabled                bool      `json:"enabled"`
+	ConsecutiveLosses      int       `json:"consecutive_losses"`
+	Streak                 int       `json:"streak"` // Losses that trigger the cool-off
+	Active                 bool      `json:"active"`
+	ConfidenceBoost        float64   `json:"confidence_boost"` // Current boost after decay
+	Since                  time.Time `json:"since,omitempty"`
+	ExpiresAt              time.Time `json:"expires_at,omitempty"` // Zero when it only clears on a win
+	BaseMinConfidence      float64   `json:"base_min_confidence"`
+	EffectiveMinConfidence float64   `json:"effective_min_confidence"` // Before per-symbol category and mode throttle adjustments
+}
+
+// lossCoolOffBoostAtLocked returns the cool-off boost at now: the full delta when
+// it was armed, decaying linearly to 0 over LossCoolOffDecayMinutes (0 = no decay,
+// only a win clears it). Caller must hold ga.mu (read or write).
+func (ga *GinieAutopilot) lossCoolOffBoostAtLocked(now time.Time) float64 {
+	if ga.config.LossCoolOffStreak <= 0 || ga.lossCoolOffPeak <= 0 {
+		return 0
+	}
+	decay := time.Duration(ga.config.LossCoolOffDecayMinutes) * time.Minute
+	if decay <= 0 {
+		return ga.lossCoolOffPeak
+	}
+	elapsed := now.Sub(ga.lossCoolOffSince)
+	if elapsed >= decay {
+		return 0
+	}
+	return ga.lossCoolOffPeak * (1 - float64(elapsed)/float64(decay))
+}
+
+// recordLossCoolOffLocked updates the consecutive-loss streak after a trade
+// closes. The Kth loss in a row arms the cool-off, each further loss re-arms
+// it at full strength, and a win clears it. Caller must hold ga.mu.
+func (ga *GinieAutopilot) recordLossCoolOffLocked(symbol string, pnlUSD float64) {
+	if ga.config.LossCoolOffStreak <= 0 {
+		return
+	}
+
+	now := time.Now()
+	previous := ga.lossCoolOffBoostAtLocked(now)
+
+	if pnlUSD > 0 {
+		ga.lossStreak = 0
+		if ga.lossCoolOffPeak > 0 {
+			ga.lossCoolOffPeak = 0
+			ga.lossCoolOffSince = time.Time{}
+			if previous > 0 {
+				ga.logLossCoolOffChange("cleared by win", symbol, previous, 0)
+			}
+		}
+		return
+	}
+
+	ga.lossStreak++
+	if ga.lossStreak < ga.config.LossCoolOffStreak || ga.config.LossCoolOffConfidenceDelta <= 0 {
+		return
+	}
+
+	ga.lossCoolOffPeak = ga.config.LossCoolOffConfidenceDelta
+	ga.lossCoolOffSince = now
+	reason := "armed by loss streak"
+	if previous > 0 {
+		reason = "re-armed by loss streak"
+	}
+	ga.logLossCoolOffChange(reason, symbol, previous, ga.lossCoolOffPeak)
+}
+
+// lossCoolOffBoost returns the confidence boost currently added to
+// MinConfidenceToTrade, logging once when a decayed cool-off expires
+func (ga *GinieAutopilot) lossCoolOffBoost() float64 {
+	ga.mu.Lock()
+	defer ga.mu.Unlock()
+
+	if ga.lossCoolOffPeak <= 0 {
+		return 0
+	}
+	boost := ga.lossCoolOffBoostAtLocked(time.Now())
+	if boost <= 0 {
+		ga.logLossCoolOffChange("decayed", "", ga.lossCoolOffPeak, 0)
+		ga.lossCoolOffPeak = 0
+		ga.lossCoolOffSince = time.Time{}
+	}
+	return boost
+}
+
+// logLossCoolOffChange logs every cool-off transition with the resulting threshold
+func (ga *GinieAutopilot) logLossCoolOffChange(reason, symbol string, from, to float64) {
+	ga.logger.Warn("Ginie loss cool-off adjusted",
+		"reason", reason,
+		"symbol", symbol,
+		"consecutive_losses", ga.lossStreak,
+		"streak", ga.config.LossCoolOffStreak,
+		"boost_from", from,
+		"boost_to", to,
+		"min_confidence", ga.config.MinConfidenceToTrade+to,
+		"decay_minutes", ga.config.LossCoolOffDecayMinutes)
+}
+
+// getLossCoolOffDiagnosticsLocked returns the cool-off state (must hold lock)
+func (ga *GinieAutopilot) getLossCoolOffDiagnosticsLocked() LossCoolOffDiagnostics {
+	boost := ga.lossCoolOffBoostAtLocked(time.Now())
+	diag := LossCoolOffDiagnostics{
+		Enabled:                ga.config.LossCoolOffStreak > 0,
+		ConsecutiveLosses:      ga.lossStreak,
+		Streak:                 ga.config.LossCoolOffStreak,
+		Active:                 boost > 0,
+		ConfidenceBoost:        boost,
+		BaseMinConfidence:      ga.config.MinConfidenceToTrade,
+		EffectiveMinConfidence: ga.config.MinConfidenceToTrade + boost,
+	}
+	if diag.Active {
+		diag.Since = ga.lossCoolOffSince
+		if ga.config.LossCoolOffDecayMinutes > 0 {
+			diag.ExpiresAt = ga.lossCoolOffSince.Add(time.Duration(ga.config.LossCoolOffDecayMinutes) * time.Minute)
+		}
+	}
+	return diag
+}
@@ -0,0 +1,228 @@
+package autopilot
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"binance-trading-bot/internal/binance"
+)
+
+// defaultMakerTimeoutSeconds is how long a maker-first entry rests on the book
+// when the mode doesn't set maker_timeout_seconds
+const defaultMakerTimeoutSeconds = 10
+
+// makerFillPollInterval is how often a resting maker entry is checked for fills
+const makerFillPollInterval = time.Second
+
+// makerEntryResult is the combined fill of a maker-first entry: the post-only
+// limit leg and, if it didn't fill completely, the market leg for the rest
+type makerEntryResult struct {
+	OrderID    int64   // Last entry order that filled
+	MakerQty   float64 // Quantity filled by the post-only limit
+	MakerPrice float64
+	TakerQty   float64 // Quantity filled by the market fallback
+	TakerPrice float64
+	FeeUSD     float64 // Estimated from the account commission rates
+}
+
+// Qty returns the total filled quantity
+func (r *makerEntryResult) Qty() float64 {
+	return r.MakerQty + r.TakerQty
+}
+
+// AvgPrice returns the volume-weighted entry price across both legs
+func (r *makerEntryResult) AvgPrice() float64 {
+	if r.Qty() <= 0 {
+		return 0
+	}
+	return (r.MakerQty*r.MakerPrice + r.TakerQty*r.TakerPrice) / r.Qty()
+}
+
+// WasMaker reports whether the whole entry filled as maker
+func (r *makerEntryResult) WasMaker() bool {
+	return r.MakerQty > 0 && r.TakerQty == 0
+}
+
+// makerEntrySettings returns whether the mode prefers maker entries and how
+// long the maker order may rest before falling back to market
+func (ga *GinieAutopilot) makerEntrySettings(mode GinieTradingMode) (bool, time.Duration) {
+	modeConfig := ga.getModeConfig(mode)
+	if modeConfig == nil || modeConfig.Entry == nil || !modeConfig.Entry.PreferMakerEntry {
+		return false, 0
+	}
+	timeoutSec := modeConfig.Entry.MakerTimeoutSeconds
+	if timeoutSec <= 0 {
+		timeoutSec = defaultMakerTimeoutSeconds
+	}
+	return true, time.Duration(timeoutSec) * time.Second
+}
+
+// markMakerEntry flags or clears a symbol's maker-first entry as in flight.
+// Caller must hold ga.mu.
+func (ga *GinieAutopilot) markMakerEntry(symbol string, inFlight bool) {
+	if !inFlight {
+		delete(ga.makerEntriesInFlight, symbol)
+		return
+	}
+	if ga.makerEntriesInFlight == nil {
+		ga.makerEntriesInFlight = make(map[string]bool)
+	}
+	ga.makerEntriesInFlight[symbol] = true
+}
+
+// placeMakerFirstEntry enters with a post-only (GTX) limit at the best bid
+// (BUY) or ask (SELL), waits up to timeout for it to fill, then cancels it and
+// sends whatever is left as a market order. A maker order that can't be placed
+// falls straight through to market, so the entry is never skipped.
+// Must be called without ga.mu held - it blocks for up to timeout.
+func (ga *GinieAutopilot) placeMakerFirstEntry(symbol, side string, positionSide binance.PositionSide, quantity float64, timeout time.Duration, clientOrderId string) (*makerEntryResult, error) {
+	result := &makerEntryResult{}
+
+	makerPrice, err := ga.bestMakerPrice(symbol, side)
+	if err != nil {
+		ga.logger.Warn("Maker entry: no book price, falling back to MARKET", "symbol", symbol, "error", err)
+	} else {
+		order, err := ga.futuresClient.PlaceFuturesOrder(binance.FuturesOrderParams{
+			Symbol:           symbol,
+			Side:             side,
+			PositionSide:     positionSide,
+			Type:             binance.FuturesOrderTypeLimit,
+			Quantity:         quantity,
+			Price:            makerPrice,
+			TimeInForce:      binance.TimeInForceGTX, // Post-only: rejected rather than filled as taker
+			NewClientOrderId: clientOrderId,
+		})
+		if err != nil {
+			ga.logger.Warn("Maker entry: post-only order failed, falling back to MARKET", "symbol", symbol, "error", err)
+		} else {
+			log.Printf("[MAKER-ENTRY] %s %s post-only LIMIT %.8f @ %.8f placed, waiting up to %s",
+				symbol, side, quantity, makerPrice, timeout)
+
+			filled, err := ga.awaitMakerFill(symbol, order.OrderId, timeout)
+			if err != nil {
+				// The maker leg's fill is unknown - sending the full size to market could double the position
+				return nil, fmt.Errorf("maker order %d state unknown: %w", order.OrderId, err)
+			}
+			if filled.ExecutedQty > 0 {
+				result.OrderID = order.OrderId
+				result.MakerQty = filled.ExecutedQty
+				result.MakerPrice = filled.AvgPrice
+				if result.MakerPrice <= 0 {
+					result.MakerPrice = makerPrice
+				}
+			}
+		}
+	}
+
+	if remaining := roundQuantity(symbol, quantity-result.MakerQty); remaining > 0 {
+		params := binance.FuturesOrderParams{
+			Symbol:           symbol,
+			Side:             side,
+			PositionSide:     positionSide,
+			Type:             binance.FuturesOrderTypeMarket,
+			Quantity:         remaining,
+			NewClientOrderId: clientOrderId,
+		}
+		if result.MakerQty > 0 {
+			params.NewClientOrderId = "" // The maker leg already carries the entry's client order ID
+		}
+
+		order, err := ga.futuresClient.PlaceFuturesOrder(params)
+		if err != nil {
+			if result.MakerQty > 0 {
+				ga.logger.Error("Maker entry: MARKET remainder failed, keeping partial maker fill",
+					"symbol", symbol, "maker_qty", result.MakerQty, "error", err)
+			} else {
+				return nil, fmt.Errorf("market order failed: %w", err)
+			}
+		} else {
+			fillPrice, fillQty, err := ga.verifyOrderFill(order, remaining)
+			if err != nil && result.MakerQty == 0 {
+				return nil, fmt.Errorf("market order fill verification failed: %w", err)
+			}
+			if err == nil {
+				result.OrderID = order.OrderId
+				result.TakerQty = fillQty
+				result.TakerPrice = fillPrice
+			}
+		}
+	}
+
+	maker, taker := ga.commissionRates()
+	result.FeeUSD = result.MakerQty*result.MakerPrice*maker + result.TakerQty*result.TakerPrice*taker
+
+	ga.logger.Info("Maker-first entry filled",
+		"symbol", symbol,
+		"side", side,
+		"maker_qty", result.MakerQty,
+		"maker_price", result.MakerPrice,
+		"taker_qty", result.TakerQty,
+		"taker_price", result.TakerPrice,
+		"avg_price", result.AvgPrice(),
+		"est_fee_usd", result.FeeUSD)
+	return result, nil
+}
+
+// bestMakerPrice returns the best bid for a BUY or the best ask for a SELL -
+// the most aggressive price that still rests on the book as maker
+func (ga *GinieAutopilot) bestMakerPrice(symbol, side string) (float64, error) {
+	book, err := ga.futuresClient.GetOrderBookDepth(symbol, 5)
+	if err != nil {
+		return 0, err
+	}
+	levels := book.Bids
+	if side == "SELL" {
+		levels = book.Asks
+	}
+	if len(levels) == 0 || len(levels[0]) == 0 {
+		return 0, fmt.Errorf("empty order book")
+	}
+	price, err := strconv.ParseFloat(levels[0][0], 64)
+	if err != nil || price <= 0 {
+		return 0, fmt.Errorf("invalid book price %q", levels[0][0])
+	}
+	return roundPrice(symbol, price), nil
+}
+
+// awaitMakerFill polls a resting maker order until it fills or timeout passes,
+// then cancels it and returns its final state so the caller knows exactly how
+// much filled. A post-only order that would have crossed comes back EXPIRED
+// with nothing filled.
+func (ga *GinieAutopilot) awaitMakerFill(symbol string, orderID int64, timeout time.Duration) (*binance.FuturesOrder, error) {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		time.Sleep(makerFillPollInterval)
+
+		order, err := ga.futuresClient.GetOrder(symbol, orderID)
+		if err != nil {
+			ga.logger.Warn("Maker entry: failed to query order", "symbol", symbol, "order_id", orderID, "error", err)
+			continue
+		}
+		switch binance.FuturesOrderStatus(order.Status) {
+		case binance.FuturesOrderStatusFilled, binance.FuturesOrderStatusCanceled, binance.FuturesOrderStatusExpired:
+			return order, nil
+		}
+	}
+
+	// Timed out: cancel, then re-read - a fill can land between the last poll and the cancel
+	if err := ga.futuresClient.CancelFuturesOrder(symbol, orderID); err != nil {
+		ga.logger.Warn("Maker entry: cancel failed (may have just filled)", "symbol", symbol, "order_id", orderID, "error", err)
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < 3; attempt++ {
+		order, err := ga.futuresClient.GetOrder(symbol, orderID)
+		if err == nil {
+			status := binance.FuturesOrderStatus(order.Status)
+			if status != binance.FuturesOrderStatusNew && status != binance.FuturesOrderStatusPartiallyFilled {
+				return order, nil
+			}
+			err = fmt.Errorf("order still %s after cancel", order.Status)
+		}
+		lastErr = err
+		time.Sleep(makerFillPollInterval)
+	}
+	return nil, lastErr
+}
@@ -0,0 +1,22 @@
+package autopilot
+
+// RejectionManageOnly is the canTrade reason while ManageOnlyMode is on
+const RejectionManageOnly = "manage_only_mode"
+
+// SetManageOnlyMode turns the "no new risk, keep managing" state on or off.
+// New entries are refused in every mode; the position monitor, SL/TP,
+// trailing and reconciliation keep running.
+func (ga *GinieAutopilot) SetManageOnlyMode(enabled bool) {
+	ga.mu.Lock()
+	defer ga.mu.Unlock()
+
+	ga.config.ManageOnlyMode = enabled
+	ga.logger.Info("Ginie manage-only mode changed", "enabled", enabled, "open_positions", len(ga.positions))
+}
+
+// IsManageOnlyMode reports whether new entries are blocked by ManageOnlyMode
+func (ga *GinieAutopilot) IsManageOnlyMode() bool {
+	ga.mu.RLock()
+	defer ga.mu.RUnlock()
+	return ga.config.ManageOnlyMode
+}
@@ -0,0 +1,45 @@
+package autopilot
+
+import (
+	"strings"
+	"testing"
+
+	"binance-trading-bot/internal/logging"
+)
+
+func TestManageOnlyModeBlocksNewEntries(t *testing.T) {
+	ga := &GinieAutopilot{
+		config:      DefaultGinieAutopilotConfig(),
+		logger:      logging.Default(),
+		positions:   map[string]*GiniePosition{"BTCUSDT": {Symbol: "BTCUSDT"}},
+		running:     true,
+		symbolLocks: newSymbolLocks(),
+	}
+	if !ga.canTrade() {
+		t.Fatal("canTrade refused with manage-only mode off")
+	}
+
+	ga.SetManageOnlyMode(true)
+	if !ga.IsManageOnlyMode() || ga.canTrade() {
+		t.Fatal("canTrade allowed a new entry in manage-only mode")
+	}
+	ga.mu.RLock()
+	_, reason := ga.canTradeWithReasonLocked()
+	ga.mu.RUnlock()
+	if !strings.HasPrefix(reason, RejectionManageOnly) {
+		t.Errorf("reason = %q, want %s", reason, RejectionManageOnly)
+	}
+	if opened, outcome := ga.executeTradeWithResult(&GinieDecisionReport{Symbol: "ETHUSDT"}); opened || outcome != RejectionManageOnly {
+		t.Errorf("executeTradeWithResult = %v, %q, want refused with %s", opened, outcome, RejectionManageOnly)
+	}
+
+	// Open positions are untouched
+	if len(ga.positions) != 1 {
+		t.Errorf("positions = %d, want 1", len(ga.positions))
+	}
+
+	ga.SetManageOnlyMode(false)
+	if !ga.canTrade() {
+		t.Error("canTrade still refused after manage-only mode was turned off")
+	}
+}
@@ -0,0 +1,136 @@
+package autopilot
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// RejectionMarginRatio is the sizing reason once the margin ratio reaches MarginRatioBlockPercent
+const RejectionMarginRatio = "margin_ratio_high"
+
+// marginRatioHalvings is how many times new position sizes are halved between
+// MarginRatioReducePercent and MarginRatioBlockPercent (1/16 just below the block)
+const marginRatioHalvings = 4
+
+// marginGuardState is the last sampled account margin ratio and the size
+// multiplier derived from it
+type marginGuardState struct {
+	mu         sync.Mutex
+	ratio      float64
+	multiplier float64
+	blocked    bool
+	lastSample time.Time
+	lastError  string
+}
+
+// MarginRatioDiagnostics shows the margin ratio guard's last sample
+type MarginRatioDiagnostics struct {
+	Enabled       bool      `json:"enabled"`
+	MarginRatio   float64   `json:"margin_ratio"` // Maintenance margin / margin balance, percent
+	ReducePercent float64   `json:"reduce_percent"`
+	BlockPercent  float64   `json:"block_percent"`
+	Multiplier    float64   `json:"multiplier"`
+	Blocked       bool      `json:"blocked"`
+	LastSample    time.Time `json:"last_sample,omitempty"`
+	LastError     string    `json:"last_error,omitempty"`
+}
+
+// marginRatioMultiplier returns the new-position size multiplier for a margin
+// ratio: 1 below reducePct, then halving marginRatioHalvings times on the way
+// to blockPct, where new entries are refused. A 0 threshold turns that step off.
+func marginRatioMultiplier(ratio, reducePct, blockPct float64) (multiplier float64, blocked bool) {
+	if blockPct > 0 && ratio >= blockPct {
+		return 0, true
+	}
+	if reducePct <= 0 || ratio <= reducePct {
+		return 1, false
+	}
+	if blockPct <= reducePct {
+		// No block step (or a misordered one): reduce over the remaining way to liquidation
+		blockPct = 100
+	}
+	progress := math.Min((ratio-reducePct)/(blockPct-reducePct), 1)
+	return math.Pow(0.5, marginRatioHalvings*progress), false
+}
+
+// sampleMarginRatio fetches the account margin ratio and returns it with the
+// size multiplier for new entries, plus a reject reason once the block threshold
+// is reached. Fails open (keeps the last multiplier) when the account is unavailable.
+// Must not be called with ga.mu held.
+func (ga *GinieAutopilot) sampleMarginRatio() (multiplier, ratio float64, reject string) {
+	ga.mu.RLock()
+	enabled := ga.config.MarginRatioGuardEnabled
+	reducePct := ga.config.MarginRatioReducePercent
+	blockPct := ga.config.MarginRatioBlockPercent
+	ga.mu.RUnlock()
+
+	st := &ga.marginGuard
+	if !enabled || ga.futuresClient == nil {
+		st.mu.Lock()
+		st.multiplier, st.blocked = 1, false
+		ratio = st.ratio
+		st.mu.Unlock()
+		return 1, ratio, ""
+	}
+
+	accountInfo, err := ga.futuresClient.GetFuturesAccountInfo()
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if err != nil {
+		ga.logger.Warn("Margin ratio guard: failed to get account info, keeping last multiplier", "error", err)
+		st.lastError = err.Error()
+		if st.multiplier <= 0 && !st.blocked {
+			return 1, st.ratio, ""
+		}
+	} else {
+		st.ratio = accountInfo.MarginRatio()
+		st.lastSample = time.Now()
+		st.lastError = ""
+
+		previous := st.multiplier
+		st.multiplier, st.blocked = marginRatioMultiplier(st.ratio, reducePct, blockPct)
+		if previous != 0 && math.Abs(st.multiplier-previous) >= 0.05 {
+			ga.logger.Info("Margin ratio multiplier changed",
+				"from", previous,
+				"to", st.multiplier,
+				"margin_ratio", st.ratio,
+				"reduce_percent", reducePct,
+				"block_percent", blockPct)
+		}
+	}
+
+	if st.blocked {
+		return 0, st.ratio, fmt.Sprintf("%s: margin ratio %.1f%% >= %.1f%%, new entries blocked", RejectionMarginRatio, st.ratio, blockPct)
+	}
+	return st.multiplier, st.ratio, ""
+}
+
+// getMarginRatioDiagnosticsLocked returns the margin ratio guard state. Reads config,
+// so the caller must hold ga.mu (the guard has its own lock).
+func (ga *GinieAutopilot) getMarginRatioDiagnosticsLocked() MarginRatioDiagnostics {
+	diag := MarginRatioDiagnostics{
+		Enabled:       ga.config.MarginRatioGuardEnabled,
+		ReducePercent: ga.config.MarginRatioReducePercent,
+		BlockPercent:  ga.config.MarginRatioBlockPercent,
+		Multiplier:    1,
+	}
+
+	ga.marginGuard.mu.Lock()
+	defer ga.marginGuard.mu.Unlock()
+
+	diag.MarginRatio = ga.marginGuard.ratio
+	diag.Blocked = diag.Enabled && ga.marginGuard.blocked
+	diag.LastSample = ga.marginGuard.lastSample
+	diag.LastError = ga.marginGuard.lastError
+	if diag.Enabled && ga.marginGuard.multiplier > 0 {
+		diag.Multiplier = ga.marginGuard.multiplier
+	}
+	if diag.Blocked {
+		diag.Multiplier = 0
+	}
+	return diag
+}
@@ -0,0 +1,73 @@
+package autopilot
+
+import (
+	"math"
+	"testing"
+
+	"binance-trading-bot/internal/binance"
+	"binance-trading-bot/internal/logging"
+)
+
+func TestMarginRatioMultiplier(t *testing.T) {
+	tests := []struct {
+		ratio, reduce, block float64
+		want                 float64
+		blocked              bool
+	}{
+		{10, 50, 80, 1, false},
+		{50, 50, 80, 1, false},
+		{57.5, 50, 80, 0.5, false},
+		{65, 50, 80, 0.25, false},
+		{79.9, 50, 80, 0.0629, false},
+		{80, 50, 80, 0, true},
+		{90, 0, 80, 0, true},
+		{70, 0, 80, 1, false},
+		{75, 50, 0, 0.25, false}, // no block step: reduce over the way to 100%
+	}
+	for _, tt := range tests {
+		got, blocked := marginRatioMultiplier(tt.ratio, tt.reduce, tt.block)
+		if blocked != tt.blocked || math.Abs(got-tt.want) > 0.001 {
+			t.Errorf("marginRatioMultiplier(%v, %v, %v) = %.4f, %v; want %.4f, %v",
+				tt.ratio, tt.reduce, tt.block, got, blocked, tt.want, tt.blocked)
+		}
+	}
+}
+
+type marginRatioClient struct {
+	binance.FuturesClient
+	info *binance.FuturesAccountInfo
+}
+
+func (c *marginRatioClient) GetFuturesAccountInfo() (*binance.FuturesAccountInfo, error) {
+	return c.info, nil
+}
+
+func TestSampleMarginRatio(t *testing.T) {
+	client := &marginRatioClient{info: &binance.FuturesAccountInfo{TotalMaintMargin: 65, TotalMarginBalance: 100}}
+	ga := &GinieAutopilot{
+		config:        DefaultGinieAutopilotConfig(),
+		logger:        logging.Default(),
+		futuresClient: client,
+	}
+
+	multiplier, ratio, reject := ga.sampleMarginRatio()
+	if ratio != 65 || math.Abs(multiplier-0.25) > 0.001 || reject != "" {
+		t.Fatalf("sample = %v, %v, %q; want 0.25 at 65%%", multiplier, ratio, reject)
+	}
+
+	client.info = &binance.FuturesAccountInfo{TotalMaintMargin: 85, TotalMarginBalance: 100}
+	if _, _, reject := ga.sampleMarginRatio(); reject == "" {
+		t.Fatal("expected new entries blocked at 85%")
+	}
+	ga.mu.RLock()
+	diag := ga.getMarginRatioDiagnosticsLocked()
+	ga.mu.RUnlock()
+	if !diag.Blocked || diag.MarginRatio != 85 || diag.Multiplier != 0 {
+		t.Errorf("diagnostics = %+v", diag)
+	}
+
+	ga.config.MarginRatioGuardEnabled = false
+	if multiplier, _, reject := ga.sampleMarginRatio(); multiplier != 1 || reject != "" {
+		t.Errorf("disabled guard = %v, %q; want 1", multiplier, reject)
+	}
+}
@@ -0,0 +1,72 @@
+package autopilot
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"binance-trading-bot/internal/binance"
+)
+
+// normalizeMarginType maps the spellings in use - mode configs ("CROSS"),
+// orders ("CROSSED") and positionRisk ("cross"/"isolated") - to a Binance margin type
+func normalizeMarginType(marginType string) binance.MarginType {
+	if strings.EqualFold(strings.TrimSpace(marginType), string(binance.MarginTypeIsolated)) {
+		return binance.MarginTypeIsolated
+	}
+	return binance.MarginTypeCrossed
+}
+
+// validMarginTypeSetting reports whether sltp.margin_type is a recognized value ("" means CROSS)
+func validMarginTypeSetting(marginType string) bool {
+	switch strings.ToUpper(strings.TrimSpace(marginType)) {
+	case "", "CROSS", string(binance.MarginTypeCrossed), string(binance.MarginTypeIsolated):
+		return true
+	}
+	return false
+}
+
+// modeMarginType returns the margin type the mode's entries use (sltp.margin_type, default cross)
+func (ga *GinieAutopilot) modeMarginType(mode GinieTradingMode) binance.MarginType {
+	modeConfig := ga.getModeConfig(mode)
+	if modeConfig == nil || modeConfig.SLTP == nil {
+		return binance.MarginTypeCrossed
+	}
+	return normalizeMarginType(modeConfig.SLTP.MarginType)
+}
+
+// applyMarginType switches the symbol to the mode's margin type before an entry
+// and returns the margin type the position will actually use. Binance refuses
+// the switch while the symbol has an open position or orders; the entry then
+// goes ahead on the current margin type, except that an ISOLATED mode never
+// falls back to cross - that would put the whole cross collateral behind it.
+func (ga *GinieAutopilot) applyMarginType(symbol string, mode GinieTradingMode) (binance.MarginType, error) {
+	desired := ga.modeMarginType(mode)
+
+	err := ga.futuresClient.SetMarginType(symbol, desired)
+	if err == nil {
+		return desired, nil
+	}
+	if !errors.Is(err, binance.ErrMarginTypeLocked) {
+		return "", err
+	}
+
+	pos, posErr := ga.futuresClient.GetPositionBySymbol(symbol)
+	if posErr != nil {
+		return "", fmt.Errorf("%w (current margin type unknown: %v)", err, posErr)
+	}
+	actual := normalizeMarginType(pos.MarginType)
+	if actual == desired {
+		return actual, nil
+	}
+	if desired == binance.MarginTypeIsolated {
+		return "", fmt.Errorf("%s is %s and can't be switched to ISOLATED while it has an open position or orders", symbol, actual)
+	}
+
+	ga.logger.Warn("Margin type locked by open position/orders, entering on current margin type",
+		"symbol", symbol,
+		"mode", mode,
+		"wanted", desired,
+		"actual", actual)
+	return actual, nil
+}
@@ -0,0 +1,81 @@
+package autopilot
+
+import (
+	"log"
+	"time"
+)
+
+// ginieTradingModes are the modes with their own position caps, in scan order
+var ginieTradingModes = []GinieTradingMode{GinieModeUltraFast, GinieModeScalp, GinieModeSwing, GinieModePosition}
+
+// ModePositionSlots shows one mode's position cap usage
+type ModePositionSlots struct {
+	Open        int       `json:"open"`
+	Max         int       `json:"max"`
+	Available   int       `json:"available"`
+	Saturated   bool      `json:"saturated"`              // At the cap - the mode's scans are skipped
+	CapSource   string    `json:"cap_source"`             // "mode_max_positions", "size.max_positions" or "global"
+	LastSkipped time.Time `json:"last_skipped,omitempty"` // Last scan skipped for being at the cap
+}
+
+// modePositionCap returns how many positions a mode may hold at once and where
+// the cap comes from: ModeMaxPositions[mode] when set, else the mode's
+// size.max_positions, else the global MaxPositions
+func (ga *GinieAutopilot) modePositionCap(mode GinieTradingMode) (int, string) {
+	if n := ga.config.ModeMaxPositions[string(mode)]; n > 0 {
+		return n, "mode_max_positions"
+	}
+	if modeConfig := ga.getModeConfigForSizing(mode); modeConfig != nil && modeConfig.Size != nil && modeConfig.Size.MaxPositions > 0 {
+		return modeConfig.Size.MaxPositions, "size.max_positions"
+	}
+	return ga.config.MaxPositions, "global"
+}
+
+// countModePositionsLocked counts open positions in a mode. Caller must hold ga.mu.
+func (ga *GinieAutopilot) countModePositionsLocked(mode GinieTradingMode) int {
+	count := 0
+	for _, pos := range ga.positions {
+		if pos.Mode == mode {
+			count++
+		}
+	}
+	return count
+}
+
+// modeAtPositionCap reports whether a mode is at its position cap, so its scan
+// can be skipped before any symbol is analyzed. Logs and records the skip.
+func (ga *GinieAutopilot) modeAtPositionCap(mode GinieTradingMode) (open, limit int, atCap bool) {
+	limit, source := ga.modePositionCap(mode)
+
+	ga.mu.Lock()
+	defer ga.mu.Unlock()
+	open = ga.countModePositionsLocked(mode)
+	if open < limit {
+		return open, limit, false
+	}
+	if ga.modeCapSkips == nil {
+		ga.modeCapSkips = make(map[GinieTradingMode]time.Time)
+	}
+	ga.modeCapSkips[mode] = time.Now()
+	log.Printf("[%s-SCAN] POSITION LIMIT REACHED: %d/%d positions for this mode (%s), skipping entire scan",
+		mode, open, limit, source)
+	return open, limit, true
+}
+
+// getModePositionDiagnosticsLocked returns each mode's cap usage. Caller must hold ga.mu.
+func (ga *GinieAutopilot) getModePositionDiagnosticsLocked() map[string]ModePositionSlots {
+	slots := make(map[string]ModePositionSlots, len(ginieTradingModes))
+	for _, mode := range ginieTradingModes {
+		limit, source := ga.modePositionCap(mode)
+		open := ga.countModePositionsLocked(mode)
+		slots[string(mode)] = ModePositionSlots{
+			Open:        open,
+			Max:         limit,
+			Available:   max(limit-open, 0),
+			Saturated:   open >= limit,
+			CapSource:   source,
+			LastSkipped: ga.modeCapSkips[mode],
+		}
+	}
+	return slots
+}
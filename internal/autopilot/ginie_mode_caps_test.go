@@ -0,0 +1,48 @@
+package autopilot
+
+import (
+	"testing"
+
+	"binance-trading-bot/internal/logging"
+)
+
+func TestModePositionCapOverride(t *testing.T) {
+	config := DefaultGinieAutopilotConfig()
+	config.ModeMaxPositions = map[string]int{
+		string(GinieModeUltraFast): 1,
+		string(GinieModeScalp):     2,
+		string(GinieModeSwing):     3,
+		string(GinieModePosition):  4,
+	}
+	ga := &GinieAutopilot{
+		config: config,
+		logger: logging.Default(),
+		positions: map[string]*GiniePosition{
+			"BTCUSDT": {Symbol: "BTCUSDT", Mode: GinieModeScalp},
+			"ETHUSDT": {Symbol: "ETHUSDT", Mode: GinieModeScalp},
+			"SOLUSDT": {Symbol: "SOLUSDT", Mode: GinieModeSwing},
+		},
+	}
+
+	if limit, source := ga.modePositionCap(GinieModeScalp); limit != 2 || source != "mode_max_positions" {
+		t.Errorf("scalp cap = %d (%s), want 2 (mode_max_positions)", limit, source)
+	}
+
+	if open, limit, atCap := ga.modeAtPositionCap(GinieModeScalp); !atCap || open != 2 || limit != 2 {
+		t.Errorf("scalp = %d/%d atCap=%v, want 2/2 at cap", open, limit, atCap)
+	}
+	if _, _, atCap := ga.modeAtPositionCap(GinieModeSwing); atCap {
+		t.Error("swing at 1/3 reported at cap")
+	}
+
+	slots := ga.getModePositionDiagnosticsLocked()
+	if s := slots[string(GinieModeScalp)]; !s.Saturated || s.Available != 0 || s.LastSkipped.IsZero() {
+		t.Errorf("scalp slots = %+v, want saturated with a recorded skip", s)
+	}
+	if s := slots[string(GinieModeSwing)]; s.Saturated || s.Open != 1 || s.Available != 2 || !s.LastSkipped.IsZero() {
+		t.Errorf("swing slots = %+v, want 1 open, 2 available", s)
+	}
+	if s := slots[string(GinieModeUltraFast)]; s.Open != 0 || s.Available != 1 {
+		t.Errorf("ultra_fast slots = %+v, want 0 open, 1 available", s)
+	}
+}
@@ -0,0 +1,142 @@
+package autopilot
+
+import (
+	"fmt"
+	"math"
+
+	"binance-trading-bot/internal/binance"
+)
+
+// nativeTrailingState tracks an exchange-side TRAILING_STOP_MARKET order for a
+// symbol. It lives outside GiniePosition under its own mutex so the algo order
+// cleanup paths (cancelAllAlgoOrdersForSymbol) can clear it whatever ga.mu
+// state their caller is in.
+type nativeTrailingState struct {
+	algoID       int64
+	callbackRate float64
+	failed       bool // Placement rejected - stay client-side for this symbol
+}
+
+// nativeCallbackRate rounds a trailing % to Binance's 0.1 callbackRate step
+func nativeCallbackRate(trailingPercent float64) float64 {
+	return math.Round(trailingPercent*10) / 10
+}
+
+// useNativeTrailing reports whether pos should trail on the exchange rather
+// than by cancel/replace of the SL. It falls back to client-side trailing for
+// dry runs, dust positions, trails outside Binance's callbackRate bounds and
+// symbols where native placement already failed. Caller must hold ga.mu.
+func (ga *GinieAutopilot) useNativeTrailing(pos *GiniePosition, trailingPercent float64) bool {
+	if ga.config.DryRun || pos.IsDustPosition || pos.RemainingQty <= 0 {
+		return false
+	}
+	modeConfig := ga.getModeConfig(pos.Mode)
+	if modeConfig == nil || modeConfig.SLTP == nil || !modeConfig.SLTP.NativeTrailing {
+		return false
+	}
+	rate := nativeCallbackRate(trailingPercent)
+	if rate < binance.MinTrailingCallbackRate || rate > binance.MaxTrailingCallbackRate {
+		return false
+	}
+
+	ga.nativeTrailingMu.Lock()
+	defer ga.nativeTrailingMu.Unlock()
+	state := ga.nativeTrailing[pos.Symbol]
+	return state == nil || !state.failed
+}
+
+// hasNativeTrailing reports whether a native trailing order is live for symbol
+// at the given trailing % (0 = any rate)
+func (ga *GinieAutopilot) hasNativeTrailing(symbol string, trailingPercent float64) bool {
+	ga.nativeTrailingMu.Lock()
+	defer ga.nativeTrailingMu.Unlock()
+	state := ga.nativeTrailing[symbol]
+	if state == nil || state.algoID == 0 {
+		return false
+	}
+	return trailingPercent == 0 || state.callbackRate == nativeCallbackRate(trailingPercent)
+}
+
+// clearNativeTrailing forgets the native trailing order for symbol, e.g. after
+// all of its algo orders were cancelled
+func (ga *GinieAutopilot) clearNativeTrailing(symbol string) {
+	ga.nativeTrailingMu.Lock()
+	defer ga.nativeTrailingMu.Unlock()
+	delete(ga.nativeTrailing, symbol)
+}
+
+// placeNativeTrailingStop places (or replaces, when the rate changed) a
+// TRAILING_STOP_MARKET order for the remaining position. The existing SL algo
+// order is left in place as the floor. On failure the symbol is marked so
+// monitorAllPositions falls back to client-side trailing.
+// Must be called WITHOUT ga.mu held (network calls).
+func (ga *GinieAutopilot) placeNativeTrailingStop(pos *GiniePosition, trailingPercent float64) {
+	if err := ga.requireActiveWithSymbol(pos.Symbol, "native trailing stop placement"); err != nil {
+		return
+	}
+
+	ga.nativeTrailingMu.Lock()
+	var oldAlgoID int64
+	if state := ga.nativeTrailing[pos.Symbol]; state != nil {
+		oldAlgoID = state.algoID
+	}
+	ga.nativeTrailingMu.Unlock()
+
+	if oldAlgoID > 0 {
+		if err := ga.futuresClient.CancelAlgoOrder(pos.Symbol, oldAlgoID); err != nil {
+			ga.logger.Warn("Failed to cancel previous native trailing stop",
+				"symbol", pos.Symbol,
+				"algo_id", oldAlgoID,
+				"error", err)
+		}
+	}
+
+	closeSide := "SELL"
+	positionSide := binance.PositionSideLong
+	if pos.Side == "SHORT" {
+		closeSide = "BUY"
+		positionSide = binance.PositionSideShort
+	}
+	effectivePositionSide := ga.getEffectivePositionSide(positionSide)
+
+	rate := nativeCallbackRate(trailingPercent)
+	params := binance.AlgoOrderParams{
+		Symbol:       pos.Symbol,
+		Side:         closeSide,
+		PositionSide: effectivePositionSide,
+		Type:         binance.FuturesOrderTypeTrailingStop,
+		Quantity:     roundQuantity(pos.Symbol, pos.RemainingQty),
+		CallbackRate: rate,
+		WorkingType:  binance.WorkingTypeMarkPrice,
+		// One-way mode needs reduceOnly; hedge mode rejects it alongside positionSide
+		ReduceOnly: effectivePositionSide == binance.PositionSideBoth,
+	}
+
+	order, err := ga.placeAlgoOrder(params)
+	if err == nil && (order == nil || order.AlgoId == 0) {
+		err = fmt.Errorf("exchange returned no algo order ID")
+	}
+
+	ga.nativeTrailingMu.Lock()
+	defer ga.nativeTrailingMu.Unlock()
+	if ga.nativeTrailing == nil {
+		ga.nativeTrailing = make(map[string]*nativeTrailingState)
+	}
+
+	if err != nil {
+		ga.nativeTrailing[pos.Symbol] = &nativeTrailingState{failed: true}
+		ga.logger.Warn("Native trailing stop rejected - falling back to client-side trailing",
+			"symbol", pos.Symbol,
+			"callback_rate", rate,
+			"error", err)
+		return
+	}
+
+	ga.nativeTrailing[pos.Symbol] = &nativeTrailingState{algoID: order.AlgoId, callbackRate: rate}
+	ga.logger.Info("Native trailing stop placed",
+		"symbol", pos.Symbol,
+		"algo_id", order.AlgoId,
+		"callback_rate", rate,
+		"quantity", params.Quantity,
+		"replaced_algo_id", oldAlgoID)
+}
@@ -0,0 +1,45 @@
+package autopilot
+
+import (
+	"fmt"
+)
+
+// positionNotionalUSD returns a position's notional at the last monitored price.
+// UnrealizedPnL is kept current by the monitor loop, so qty x entry adjusted by
+// it gives qty x current price without a price fetch.
+func positionNotionalUSD(pos *GiniePosition) float64 {
+	notional := pos.RemainingQty * pos.EntryPrice
+	if pos.Side == "SHORT" {
+		notional -= pos.UnrealizedPnL
+	} else {
+		notional += pos.UnrealizedPnL
+	}
+	if notional < 0 {
+		return 0
+	}
+	return notional
+}
+
+// totalNotionalLocked sums the notional of every open position. Caller must hold ga.mu.
+func (ga *GinieAutopilot) totalNotionalLocked() float64 {
+	total := 0.0
+	for _, pos := range ga.positions {
+		total += positionNotionalUSD(pos)
+	}
+	return total
+}
+
+// checkNotionalCapLocked reports whether adding additionalUSD of notional keeps
+// total exposure within MaxTotalNotionalUSD (0 disables the cap). Caller must hold ga.mu.
+func (ga *GinieAutopilot) checkNotionalCapLocked(additionalUSD float64) (bool, string) {
+	maxNotional := ga.config.MaxTotalNotionalUSD
+	if maxNotional <= 0 {
+		return true, ""
+	}
+	current := ga.totalNotionalLocked()
+	if current+additionalUSD > maxNotional {
+		return false, fmt.Sprintf("max_notional_exceeded: open $%.2f + new $%.2f > cap $%.2f",
+			current, additionalUSD, maxNotional)
+	}
+	return true, ""
+}
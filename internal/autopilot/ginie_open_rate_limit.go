@@ -0,0 +1,45 @@
+package autopilot
+
+import (
+	"time"
+)
+
+// openRateWindow is the sliding window MaxPositionsPerMinute is counted over
+const openRateWindow = time.Minute
+
+// openRateLimitedLocked reports whether MaxPositionsPerMinute entries were
+// already opened, or are being opened, in the last minute (must hold lock)
+func (ga *GinieAutopilot) openRateLimitedLocked(now time.Time) bool {
+	limit := ga.config.MaxPositionsPerMinute
+	if limit <= 0 {
+		return false
+	}
+	cutoff := now.Add(-openRateWindow)
+	kept := ga.recentOpens[:0]
+	for _, t := range ga.recentOpens {
+		if t.After(cutoff) {
+			kept = append(kept, t)
+		}
+	}
+	ga.recentOpens = kept
+	return len(ga.recentOpens) >= limit
+}
+
+// reserveOpenLocked claims a slot in the per-minute open budget, so entries
+// sizing concurrently can't all pass the check. The returned func gives the
+// slot back if no order goes out (must hold lock for both).
+func (ga *GinieAutopilot) reserveOpenLocked(now time.Time) (release func(), ok bool) {
+	if ga.openRateLimitedLocked(now) {
+		ga.rateLimitedOpens++
+		return nil, false
+	}
+	ga.recentOpens = append(ga.recentOpens, now)
+	return func() {
+		for i, t := range ga.recentOpens {
+			if t.Equal(now) {
+				ga.recentOpens = append(ga.recentOpens[:i], ga.recentOpens[i+1:]...)
+				return
+			}
+		}
+	}, true
+}
@@ -0,0 +1,47 @@
+package autopilot
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOpenRateLimit(t *testing.T) {
+	ga := &GinieAutopilot{config: &GinieAutopilotConfig{MaxPositionsPerMinute: 2}}
+	now := time.Now()
+
+	releaseFirst, ok := ga.reserveOpenLocked(now)
+	if !ok {
+		t.Fatal("first entry refused")
+	}
+	if _, ok := ga.reserveOpenLocked(now.Add(time.Second)); !ok {
+		t.Fatal("second entry refused")
+	}
+	if _, ok := ga.reserveOpenLocked(now.Add(2 * time.Second)); ok {
+		t.Fatal("third entry within a minute was allowed")
+	}
+	if ga.rateLimitedOpens != 1 {
+		t.Errorf("rateLimitedOpens = %d, want 1", ga.rateLimitedOpens)
+	}
+
+	// A failed entry gives its slot back
+	releaseFirst()
+	if ga.openRateLimitedLocked(now.Add(2 * time.Second)) {
+		t.Error("released slot still counted")
+	}
+
+	// Slots expire after the window
+	ga.recentOpens = append(ga.recentOpens, now)
+	if !ga.openRateLimitedLocked(now.Add(30 * time.Second)) {
+		t.Error("expected limit within the window")
+	}
+	if ga.openRateLimitedLocked(now.Add(openRateWindow + 2*time.Second)) {
+		t.Error("entries older than the window still counted")
+	}
+
+	ga.config.MaxPositionsPerMinute = 0
+	for i := 0; i < 20; i++ {
+		if _, ok := ga.reserveOpenLocked(now); !ok {
+			t.Fatal("limit applied while disabled")
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package autopilot
+
+import (
+	"fmt"
+	"strings"
+
+	"binance-trading-bot/internal/binance"
+)
+
+// Per-mode execution styles for entry.entry_order_type and sltp.exit_order_type.
+// An empty setting keeps Ginie's built-in behavior for that mode.
+const (
+	ExecOrderTypeMarket = "MARKET"
+	ExecOrderTypeLimit  = "LIMIT"
+)
+
+// defaultExitLimitBufferPercent is how far through the current price a LIMIT
+// exit is priced when the mode doesn't set exit_limit_buffer_percent
+const defaultExitLimitBufferPercent = 0.1
+
+// maxExitLimitBufferPercent caps exit_limit_buffer_percent
+const maxExitLimitBufferPercent = 5.0
+
+// normalizeExecOrderType maps a configured order type to MARKET, LIMIT or ""
+func normalizeExecOrderType(orderType string) string {
+	return strings.ToUpper(strings.TrimSpace(orderType))
+}
+
+// validExecOrderType reports whether an entry/exit order type setting is recognized
+func validExecOrderType(orderType string) bool {
+	switch normalizeExecOrderType(orderType) {
+	case "", ExecOrderTypeMarket, ExecOrderTypeLimit:
+		return true
+	}
+	return false
+}
+
+// entryOrderType returns the mode's entry.entry_order_type ("" = built-in:
+// LIMIT at the previous candle extreme unless reversal.use_market_entry)
+func (ga *GinieAutopilot) entryOrderType(mode GinieTradingMode) string {
+	modeConfig := ga.getModeConfig(mode)
+	if modeConfig == nil || modeConfig.Entry == nil {
+		return ""
+	}
+	return normalizeExecOrderType(modeConfig.Entry.EntryOrderType)
+}
+
+// exitOrderSettings returns the mode's sltp.exit_order_type ("" = built-in
+// LIMIT without fallback) and the LIMIT buffer %
+func (ga *GinieAutopilot) exitOrderSettings(mode GinieTradingMode) (string, float64) {
+	modeConfig := ga.getModeConfig(mode)
+	if modeConfig == nil || modeConfig.SLTP == nil {
+		return "", defaultExitLimitBufferPercent
+	}
+	buffer := modeConfig.SLTP.ExitLimitBufferPercent
+	if buffer <= 0 {
+		buffer = defaultExitLimitBufferPercent
+	}
+	return normalizeExecOrderType(modeConfig.SLTP.ExitOrderType), buffer
+}
+
+// exitLimitPrice prices a LIMIT exit bufferPercent through the current price so
+// it fills like a market order with bounded slippage: below for a LONG's SELL,
+// above for a SHORT's BUY
+func exitLimitPrice(symbol, positionSide string, currentPrice, bufferPercent float64) float64 {
+	price := currentPrice * (1 - bufferPercent/100)
+	if positionSide == "SHORT" {
+		price = currentPrice * (1 + bufferPercent/100)
+	}
+	return roundPriceForTP(symbol, price, positionSide)
+}
+
+// placeExitOrder closes qty of pos with the mode's exit order type and returns
+// the type that was placed. A LIMIT that fails falls back to MARKET only when
+// the mode asks for LIMIT explicitly; the built-in LIMIT exit never does.
+func (ga *GinieAutopilot) placeExitOrder(pos *GiniePosition, qty, currentPrice float64) (string, error) {
+	side := "SELL"
+	positionSide := binance.PositionSideLong
+	if pos.Side == "SHORT" {
+		side = "BUY"
+		positionSide = binance.PositionSideShort
+	}
+
+	// Check actual Binance position mode to avoid API error -4061
+	effectivePositionSide := ga.getEffectivePositionSide(positionSide)
+
+	orderType, buffer := ga.exitOrderSettings(pos.Mode)
+	if orderType != ExecOrderTypeMarket {
+		limitPrice := exitLimitPrice(pos.Symbol, pos.Side, currentPrice, buffer)
+		_, err := ga.placeFuturesOrder(binance.FuturesOrderParams{
+			Symbol:       pos.Symbol,
+			Side:         side,
+			PositionSide: effectivePositionSide,
+			Type:         binance.FuturesOrderTypeLimit,
+			Quantity:     qty,
+			Price:        limitPrice,
+		})
+		if err == nil {
+			return ExecOrderTypeLimit, nil
+		}
+		if orderType != ExecOrderTypeLimit {
+			return ExecOrderTypeLimit, err
+		}
+		ga.logger.Warn("LIMIT exit failed - falling back to MARKET",
+			"symbol", pos.Symbol,
+			"mode", pos.Mode,
+			"limit_price", limitPrice,
+			"qty", qty,
+			"error", err.Error())
+	}
+
+	_, err := ga.placeFuturesOrder(binance.FuturesOrderParams{
+		Symbol:       pos.Symbol,
+		Side:         side,
+		PositionSide: effectivePositionSide,
+		Type:         binance.FuturesOrderTypeMarket,
+		Quantity:     qty,
+		ReduceOnly:   effectivePositionSide == binance.PositionSideBoth, // Hedge mode rejects reduceOnly
+	})
+	if err != nil {
+		return ExecOrderTypeMarket, fmt.Errorf("MARKET exit failed: %w", err)
+	}
+	return ExecOrderTypeMarket, nil
+}
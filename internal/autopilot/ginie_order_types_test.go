@@ -0,0 +1,50 @@
+package autopilot
+
+import (
+	"math"
+	"testing"
+)
+
+func TestValidExecOrderType(t *testing.T) {
+	for _, orderType := range []string{"", "MARKET", "LIMIT", "market", " Limit "} {
+		if !validExecOrderType(orderType) {
+			t.Errorf("validExecOrderType(%q) = false, want true", orderType)
+		}
+	}
+	for _, orderType := range []string{"STOP", "POST_ONLY", "GTX"} {
+		if validExecOrderType(orderType) {
+			t.Errorf("validExecOrderType(%q) = true, want false", orderType)
+		}
+	}
+}
+
+func TestExitLimitPrice(t *testing.T) {
+	if got := exitLimitPrice("TESTUSDT", "LONG", 100, 0.5); math.Abs(got-99.5) > 1e-6 {
+		t.Errorf("LONG exit limit = %v, want 99.5", got)
+	}
+	if got := exitLimitPrice("TESTUSDT", "SHORT", 100, 0.5); math.Abs(got-100.5) > 1e-6 {
+		t.Errorf("SHORT exit limit = %v, want 100.5", got)
+	}
+}
+
+func TestValidateModeConfigOrderTypes(t *testing.T) {
+	config := &ModeFullConfig{
+		ModeName: "scalp",
+		Entry:    &ModeEntryConfig{EntryOrderType: "MARKET"},
+		SLTP:     &ModeSLTPConfig{ExitOrderType: "LIMIT", ExitLimitBufferPercent: 0.2},
+	}
+	if err := ValidateModeConfig(config); err != nil {
+		t.Fatalf("valid order types rejected: %v", err)
+	}
+
+	config.Entry.EntryOrderType = "STOP"
+	if err := ValidateModeConfig(config); err == nil {
+		t.Error("entry_order_type STOP accepted")
+	}
+	config.Entry.EntryOrderType = ""
+
+	config.SLTP.ExitLimitBufferPercent = 10
+	if err := ValidateModeConfig(config); err == nil {
+		t.Error("exit_limit_buffer_percent 10 accepted")
+	}
+}
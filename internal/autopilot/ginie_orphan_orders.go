@@ -0,0 +1,188 @@
+package autopilot
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+)
+
+// orphanAlertCooldown limits how often the orphan-count alert can fire per instance
+const orphanAlertCooldown = time.Hour
+
+// AlertNotifier delivers operator alerts (satisfied by *notification.Manager)
+type AlertNotifier interface {
+	SendError(title, message string) error
+	SendInfo(title, message string) error
+}
+
+// OrphanAlgoOrder is an open algo order on the exchange that Ginie does not track
+type OrphanAlgoOrder struct {
+	Symbol       string  `json:"symbol"`
+	AlgoID       int64   `json:"algo_id"`
+	OrderType    string  `json:"order_type"`
+	Side         string  `json:"side"`
+	TriggerPrice float64 `json:"trigger_price"`
+	Reason       string  `json:"reason"` // no_position or untracked_id
+}
+
+// OrphanOrderReport lists the orphan algo orders found in one scan
+type OrphanOrderReport struct {
+	GeneratedAt    time.Time         `json:"generated_at"`
+	DryRun         bool              `json:"dry_run"`
+	ScannedOrders  int               `json:"scanned_orders"`
+	ManagedSymbols int               `json:"managed_symbols"`
+	Orphans        []OrphanAlgoOrder `json:"orphans"`
+}
+
+// OrphanOrderDiagnostics summarises orphan scans for GetDiagnostics
+type OrphanOrderDiagnostics struct {
+	LastScanTime    time.Time `json:"last_scan_time"`
+	LastOrphanCount int       `json:"last_orphan_count"`
+	TotalDetected   int       `json:"total_detected"`
+	AlertThreshold  int       `json:"alert_threshold"`
+	LastAlertTime   time.Time `json:"last_alert_time,omitempty"`
+}
+
+// SetAlertNotifier sets where operator alerts (e.g. orphan order spikes) are sent
+func (ga *GinieAutopilot) SetAlertNotifier(notifier AlertNotifier) {
+	ga.mu.Lock()
+	defer ga.mu.Unlock()
+	ga.alertNotifier = notifier
+	ga.setTradeAlertNotifier(notifier)
+}
+
+// FindOrphanAlgoOrders lists every open algo order on the exchange and
+// cross-references it against the SL/TP algo IDs of tracked positions.
+// An order is orphaned when its symbol has no position at all, or when its
+// symbol is managed by Ginie but the order ID is not one Ginie placed.
+// Nothing is cancelled.
+func (ga *GinieAutopilot) FindOrphanAlgoOrders() (*OrphanOrderReport, error) {
+	report := &OrphanOrderReport{
+		GeneratedAt: time.Now(),
+		DryRun:      ga.config.DryRun,
+		Orphans:     make([]OrphanAlgoOrder, 0),
+	}
+	if ga.config.DryRun {
+		return report, nil
+	}
+
+	exchangePositions, err := ga.futuresClient.GetPositions()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange positions: %w", err)
+	}
+	exchangeSymbols := make(map[string]bool)
+	for _, pos := range exchangePositions {
+		if pos.PositionAmt != 0 {
+			exchangeSymbols[pos.Symbol] = true
+		}
+	}
+
+	openOrders, err := ga.futuresClient.GetOpenAlgoOrders("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open algo orders: %w", err)
+	}
+	report.ScannedOrders = len(openOrders)
+
+	// Collect the algo IDs Ginie knows about for each managed symbol
+	ga.mu.RLock()
+	trackedIDs := make(map[string]map[int64]bool, len(ga.positions))
+	for symbol, pos := range ga.positions {
+		ids := make(map[int64]bool, len(pos.TakeProfitAlgoIDs)+1)
+		if pos.StopLossAlgoID > 0 {
+			ids[pos.StopLossAlgoID] = true
+		}
+		for _, id := range pos.TakeProfitAlgoIDs {
+			if id > 0 {
+				ids[id] = true
+			}
+		}
+		trackedIDs[symbol] = ids
+	}
+	ga.mu.RUnlock()
+	report.ManagedSymbols = len(trackedIDs)
+
+	for _, order := range openOrders {
+		reason := ""
+		if ids, managed := trackedIDs[order.Symbol]; managed {
+			if !ids[order.AlgoId] {
+				reason = "untracked_id"
+			}
+		} else if !exchangeSymbols[order.Symbol] {
+			reason = "no_position"
+		}
+		if reason == "" {
+			continue
+		}
+		report.Orphans = append(report.Orphans, OrphanAlgoOrder{
+			Symbol:       order.Symbol,
+			AlgoID:       order.AlgoId,
+			OrderType:    order.OrderType,
+			Side:         order.Side,
+			TriggerPrice: order.TriggerPrice,
+			Reason:       reason,
+		})
+	}
+
+	sort.Slice(report.Orphans, func(i, j int) bool {
+		if report.Orphans[i].Symbol != report.Orphans[j].Symbol {
+			return report.Orphans[i].Symbol < report.Orphans[j].Symbol
+		}
+		return report.Orphans[i].AlgoID < report.Orphans[j].AlgoID
+	})
+
+	return report, nil
+}
+
+// scanOrphanAlgoOrders runs FindOrphanAlgoOrders ahead of a cleanup pass,
+// logs each orphan, updates diagnostics and alerts when the count exceeds
+// the configured threshold (a spike usually means SL/TP IDs are not being tracked)
+func (ga *GinieAutopilot) scanOrphanAlgoOrders() {
+	report, err := ga.FindOrphanAlgoOrders()
+	if err != nil {
+		ga.logger.Debug("Orphan algo order scan failed", "error", err)
+		return
+	}
+
+	for _, o := range report.Orphans {
+		log.Printf("[GINIE-CLEANUP] Orphan algo order %s #%d (%s %s @ %.6f): %s",
+			o.Symbol, o.AlgoID, o.OrderType, o.Side, o.TriggerPrice, o.Reason)
+	}
+
+	count := len(report.Orphans)
+	ga.mu.Lock()
+	ga.orphanStats.LastScanTime = report.GeneratedAt
+	ga.orphanStats.LastOrphanCount = count
+	ga.orphanStats.TotalDetected += count
+	threshold := ga.config.OrphanOrderAlertThreshold
+	notifier := ga.alertNotifier
+	shouldAlert := threshold > 0 && count > threshold && notifier != nil &&
+		time.Since(ga.lastOrphanAlert) >= orphanAlertCooldown
+	if shouldAlert {
+		ga.lastOrphanAlert = time.Now()
+		ga.orphanStats.LastAlertTime = ga.lastOrphanAlert
+	}
+	ga.mu.Unlock()
+
+	if !shouldAlert {
+		return
+	}
+
+	symbols := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, o := range report.Orphans {
+		if !seen[o.Symbol] {
+			seen[o.Symbol] = true
+			symbols = append(symbols, o.Symbol)
+		}
+	}
+	message := fmt.Sprintf("%d orphan algo orders found (threshold %d) on %s. This usually means SL/TP order IDs are not being tracked.",
+		count, threshold, strings.Join(symbols, ", "))
+	if ga.userID != "" {
+		message = fmt.Sprintf("User %s: %s", ga.userID, message)
+	}
+	if err := notifier.SendError("Ginie orphan algo orders", message); err != nil {
+		ga.logger.Warn("Failed to send orphan order alert", "error", err)
+	}
+}
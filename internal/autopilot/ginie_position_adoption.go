@@ -0,0 +1,165 @@
+package autopilot
+
+import (
+	"fmt"
+)
+
+// SyncedPositionAdoption is the management plan a user assigns to a synced
+// position before Ginie takes it over. Empty fields keep the defaults
+// generated for the mode.
+type SyncedPositionAdoption struct {
+	Mode        GinieTradingMode // scalp, swing or position (empty keeps the sync-assigned mode)
+	StopLoss    *float64         // SL trigger price
+	TakeProfits []float64        // TP prices, nearest first
+	ROIPercent  *float64         // Custom early profit booking ROI% (0 clears)
+}
+
+// syncedPositionNeedsAdoption reports whether a position found on the exchange
+// should stay monitor-only until adopted: adoption is required and there is no
+// persisted state showing Ginie opened or already adopted it
+func (ga *GinieAutopilot) syncedPositionNeedsAdoption(symbol, side string, savedStates map[string]PersistedPositionState) bool {
+	if !ga.config.RequireSyncedPositionAdoption {
+		return false
+	}
+	saved, found := savedStates[symbol]
+	return !found || saved.Side != side
+}
+
+// GetSyncedPositions returns the positions synced from the exchange that are
+// waiting to be adopted
+func (ga *GinieAutopilot) GetSyncedPositions() []*GiniePosition {
+	ga.mu.RLock()
+	defer ga.mu.RUnlock()
+
+	positions := make([]*GiniePosition, 0)
+	for _, pos := range ga.positions {
+		if pos.AwaitingAdoption {
+			positions = append(positions, pos)
+		}
+	}
+	return positions
+}
+
+// AdoptSyncedPosition applies the user's mode, SL, TP and ROI target to a synced
+// position and hands it to Ginie's normal management, placing its SL/TP orders.
+// Levels are validated against the current price like manual level updates; a
+// default SL that the market has already passed is refused, so adoption never
+// closes the position on the spot.
+func (ga *GinieAutopilot) AdoptSyncedPosition(symbol string, adoption SyncedPositionAdoption) (*GiniePosition, error) {
+	switch adoption.Mode {
+	case "", GinieModeScalp, GinieModeSwing, GinieModePosition:
+	default:
+		return nil, fmt.Errorf("mode must be scalp, swing or position")
+	}
+	if adoption.ROIPercent != nil && (*adoption.ROIPercent < 0 || *adoption.ROIPercent > 1000) {
+		return nil, fmt.Errorf("roi_percent must be between 0-1000%%")
+	}
+
+	unlockSymbol := ga.symbolLocks.Lock(symbol)
+	defer unlockSymbol()
+
+	ga.mu.RLock()
+	pos, exists := ga.positions[symbol]
+	ga.mu.RUnlock()
+	if !exists {
+		return nil, fmt.Errorf("position not found: %s", symbol)
+	}
+
+	// Validate against the live price, falling back to entry if it is unavailable
+	refPrice := pos.EntryPrice
+	if currentPrice, err := ga.futuresClient.GetFuturesCurrentPrice(symbol); err == nil && currentPrice > 0 {
+		refPrice = currentPrice
+	}
+
+	ga.mu.Lock()
+	pos, exists = ga.positions[symbol]
+	if !exists {
+		ga.mu.Unlock()
+		return nil, fmt.Errorf("position not found: %s", symbol)
+	}
+	if !pos.AwaitingAdoption {
+		ga.mu.Unlock()
+		return nil, fmt.Errorf("position %s is already managed by Ginie", symbol)
+	}
+	if pos.IsClosing {
+		ga.mu.Unlock()
+		return nil, fmt.Errorf("position %s is being closed", symbol)
+	}
+
+	isLong := pos.Side == "LONG"
+	mode := pos.Mode
+	if adoption.Mode != "" {
+		mode = adoption.Mode
+	}
+
+	// Validate on a copy so a rejected adoption leaves the position untouched
+	candidate := *pos
+	candidate.CurrentTPLevel = 0
+	if mode != pos.Mode {
+		candidate.TakeProfits = ga.generateDefaultTPs(symbol, pos.EntryPrice, mode, isLong)
+	}
+	if len(adoption.TakeProfits) > len(candidate.TakeProfits) {
+		ga.mu.Unlock()
+		return nil, fmt.Errorf("got %d take_profits but %s mode uses %d TP levels",
+			len(adoption.TakeProfits), mode, len(candidate.TakeProfits))
+	}
+	stopLoss := candidate.StopLoss
+	if adoption.StopLoss != nil {
+		stopLoss = *adoption.StopLoss
+	}
+	if err := validatePositionLevels(&candidate, PositionLevelUpdate{
+		StopLoss:    &stopLoss,
+		TakeProfits: adoption.TakeProfits,
+	}, refPrice); err != nil {
+		ga.mu.Unlock()
+		if adoption.StopLoss == nil {
+			return nil, fmt.Errorf("default %v - provide stop_loss", err)
+		}
+		return nil, err
+	}
+
+	oldMode := pos.Mode
+	pos.Mode = mode
+	pos.TakeProfits = candidate.TakeProfits
+	pos.CurrentTPLevel = 0
+	for i, price := range adoption.TakeProfits {
+		pos.TakeProfits[i].Price = price
+	}
+	pos.StopLoss = stopLoss
+	pos.OriginalSL = stopLoss
+	pos.TrailingPercent = ga.getTrailingPercent(mode)
+	pos.TrailingActivationPct = ga.getTrailingActivation(mode)
+	if adoption.ROIPercent != nil {
+		if *adoption.ROIPercent > 0 {
+			roi := *adoption.ROIPercent
+			pos.CustomROIPercent = &roi
+		} else {
+			pos.CustomROIPercent = nil
+		}
+	}
+	if pos.Protection == nil {
+		pos.Protection = NewProtectionStatus()
+	}
+	pos.AwaitingAdoption = false
+
+	if optConfig := ga.getModeConfig(mode); optConfig != nil && optConfig.PositionOptimization != nil && optConfig.PositionOptimization.Enabled {
+		pos.ScalpReentry = ga.initPositionOptimizationFromModeConfig(pos, optConfig.PositionOptimization)
+	}
+	ga.mu.Unlock()
+
+	ga.logger.Info("Synced position adopted",
+		"symbol", symbol,
+		"side", pos.Side,
+		"mode_from", oldMode,
+		"mode", mode,
+		"reference_price", refPrice,
+		"stop_loss", pos.StopLoss,
+		"take_profits", len(pos.TakeProfits),
+		"roi_percent", adoption.ROIPercent)
+
+	// Network calls happen outside the lock; placeSLTPOrders replaces any existing algo orders
+	ga.placeSLTPOrders(pos)
+
+	go ga.SavePositionState()
+	return pos, nil
+}
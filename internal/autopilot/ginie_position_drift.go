@@ -0,0 +1,120 @@
+package autopilot
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// positionDriftRealertInterval is how long an unchanged drift stays quiet after its alert
+const positionDriftRealertInterval = time.Hour
+
+// PositionDriftDiagnostics summarises drift checks for GetDiagnostics
+type PositionDriftDiagnostics struct {
+	LastCheckTime   time.Time `json:"last_check_time"`
+	LastDriftCount  int       `json:"last_drift_count"`
+	TotalDetected   int       `json:"total_detected"`
+	LastDrift       []string  `json:"last_drift,omitempty"`
+	LastCorrections []string  `json:"last_corrections,omitempty"`
+	LastAlertTime   time.Time `json:"last_alert_time,omitempty"`
+}
+
+// checkPositionDriftIfDue runs checkPositionDrift once PositionDriftCheckSeconds
+// have passed since the last check
+func (ga *GinieAutopilot) checkPositionDriftIfDue() {
+	ga.mu.RLock()
+	interval := time.Duration(ga.config.PositionDriftCheckSeconds) * time.Second
+	lastCheck := ga.driftStats.LastCheckTime
+	ga.mu.RUnlock()
+	if interval <= 0 || time.Since(lastCheck) < interval {
+		return
+	}
+	ga.checkPositionDrift()
+}
+
+// checkPositionDrift diffs tracked positions against the exchange and sends a
+// position_drift alert listing every ghost, orphan and side/quantity/entry
+// mismatch. Under the "heal" policy the report has already corrected tracked
+// state, and the corrections are included in the alert.
+func (ga *GinieAutopilot) checkPositionDrift() {
+	report, err := ga.GetReconciliationReport()
+	if err != nil {
+		ga.logger.Debug("Position drift check failed", "error", err)
+		return
+	}
+	if report.DryRun {
+		return
+	}
+
+	drift := positionDriftLines(report)
+	for _, line := range drift {
+		log.Printf("[POSITION-DRIFT] %s", line)
+	}
+	for _, action := range report.HealActions {
+		log.Printf("[POSITION-DRIFT] Corrected %s", action)
+	}
+
+	signature := strings.Join(drift, "|")
+	ga.mu.Lock()
+	ga.driftStats.LastCheckTime = report.GeneratedAt
+	ga.driftStats.LastDriftCount = len(drift)
+	ga.driftStats.LastDrift = drift
+	if len(report.HealActions) > 0 {
+		ga.driftStats.LastCorrections = report.HealActions
+	}
+	notifier := ga.alertNotifier
+	// Alert on new drift, and again if the same drift is still there an hour later
+	shouldAlert := len(drift) > 0 && notifier != nil &&
+		(signature != ga.lastDriftSignature || time.Since(ga.driftStats.LastAlertTime) >= positionDriftRealertInterval)
+	if len(drift) > 0 && signature != ga.lastDriftSignature {
+		ga.driftStats.TotalDetected += len(drift)
+	}
+	ga.lastDriftSignature = signature
+	if shouldAlert {
+		ga.driftStats.LastAlertTime = time.Now()
+	}
+	ga.mu.Unlock()
+
+	if !shouldAlert {
+		return
+	}
+
+	message := fmt.Sprintf("position_drift: %d tracked position(s) disagree with the exchange:\n%s",
+		len(drift), strings.Join(drift, "\n"))
+	if report.Healed {
+		message += "\nCorrected to match the exchange:\n" + strings.Join(report.HealActions, "\n")
+	} else {
+		message += "\nTracked state was not changed (reconcile_heal_policy=report)."
+	}
+	if ga.userID != "" {
+		message = fmt.Sprintf("User %s: %s", ga.userID, message)
+	}
+	if err := notifier.SendError("Ginie position drift", message); err != nil {
+		ga.logger.Warn("Failed to send position drift alert", "error", err)
+	}
+}
+
+// positionDriftLines renders one line per drifted position, in report order
+func positionDriftLines(report *ReconciliationReport) []string {
+	lines := make([]string, 0, len(report.Ghosts)+len(report.Orphans)+len(report.Mismatches))
+	for _, g := range report.Ghosts {
+		lines = append(lines, fmt.Sprintf("%s: tracked %s %.6f @ %.6f, none on exchange",
+			g.Symbol, g.Side, g.Quantity, g.EntryPrice))
+	}
+	for _, o := range report.Orphans {
+		lines = append(lines, fmt.Sprintf("%s: exchange %s %.6f @ %.6f, not tracked",
+			o.Symbol, o.Side, o.Quantity, o.EntryPrice))
+	}
+	for _, m := range report.Mismatches {
+		switch m.Reason {
+		case "side":
+			lines = append(lines, fmt.Sprintf("%s: side tracked %s, exchange %s", m.Symbol, m.TrackedSide, m.ExchangeSide))
+		case "quantity":
+			lines = append(lines, fmt.Sprintf("%s: quantity tracked %.6f, exchange %.6f", m.Symbol, m.TrackedQty, m.ExchangeQty))
+		default:
+			lines = append(lines, fmt.Sprintf("%s: entry tracked %.6f, exchange %.6f", m.Symbol, m.TrackedEntry, m.ExchangeEntry))
+		}
+	}
+	return lines
+}
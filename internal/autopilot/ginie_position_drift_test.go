@@ -0,0 +1,48 @@
+package autopilot
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPositionDriftLines(t *testing.T) {
+	report := &ReconciliationReport{
+		Ghosts:  []ReconciliationPosition{{Symbol: "ADAUSDT", Side: "LONG", Quantity: 100, EntryPrice: 0.5}},
+		Orphans: []ReconciliationPosition{{Symbol: "XRPUSDT", Side: "SHORT", Quantity: 50, EntryPrice: 0.6}},
+		Mismatches: []ReconciliationMismatch{
+			{Symbol: "BTCUSDT", TrackedSide: "LONG", ExchangeSide: "SHORT", Reason: "side"},
+			{Symbol: "ETHUSDT", TrackedQty: 2, ExchangeQty: 1, Reason: "quantity"},
+			{Symbol: "SOLUSDT", TrackedEntry: 150, ExchangeEntry: 152, Reason: "entry"},
+		},
+	}
+
+	lines := positionDriftLines(report)
+	want := []string{
+		"ADAUSDT: tracked LONG",
+		"XRPUSDT: exchange SHORT",
+		"BTCUSDT: side tracked LONG, exchange SHORT",
+		"ETHUSDT: quantity tracked 2.000000, exchange 1.000000",
+		"SOLUSDT: entry tracked 150.000000, exchange 152.000000",
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("got %d lines, want %d: %v", len(lines), len(want), lines)
+	}
+	for i, prefix := range want {
+		if !strings.HasPrefix(lines[i], prefix) {
+			t.Errorf("line %d = %q, want prefix %q", i, lines[i], prefix)
+		}
+	}
+}
+
+func TestReconcileTolerances(t *testing.T) {
+	ga := &GinieAutopilot{config: &GinieAutopilotConfig{}}
+	if qty, entry := ga.reconcileTolerancesLocked(); qty != reconcileQtyTolerance || entry != reconcileEntryTolerance {
+		t.Errorf("unset tolerances = %v/%v, want defaults", qty, entry)
+	}
+
+	ga.config.PositionDriftQtyTolerancePercent = 2
+	ga.config.PositionDriftEntryTolerancePercent = 0.25
+	if qty, entry := ga.reconcileTolerancesLocked(); qty != 0.02 || entry != 0.0025 {
+		t.Errorf("tolerances = %v/%v, want 0.02/0.0025", qty, entry)
+	}
+}
@@ -0,0 +1,33 @@
+package autopilot
+
+import (
+	"binance-trading-bot/internal/events"
+)
+
+// GiniePositionEvent is the WebSocket payload for a position lifecycle event.
+// Position is a snapshot taken when the event fired, so the UI can replace its
+// copy instead of reconstructing state from polling.
+type GiniePositionEvent struct {
+	Symbol   string                 `json:"symbol"`
+	Position *GiniePosition         `json:"position"`
+	Details  map[string]interface{} `json:"details,omitempty"`
+}
+
+// publishPositionEvent broadcasts a position lifecycle event to this user's
+// WebSocket clients. The snapshot is copied synchronously because the monitor
+// keeps mutating pos after the broadcast goroutine is started.
+func (ga *GinieAutopilot) publishPositionEvent(eventType events.EventType, pos *GiniePosition, details map[string]interface{}) {
+	if ga.userID == "" || pos == nil {
+		return
+	}
+
+	snapshot := *pos
+	snapshot.TakeProfits = append([]GinieTakeProfitLevel(nil), pos.TakeProfits...)
+	snapshot.TakeProfitAlgoIDs = append([]int64(nil), pos.TakeProfitAlgoIDs...)
+
+	events.BroadcastGiniePositionEvent(ga.userID, eventType, GiniePositionEvent{
+		Symbol:   pos.Symbol,
+		Position: &snapshot,
+		Details:  details,
+	})
+}
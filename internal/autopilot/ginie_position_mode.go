@@ -0,0 +1,111 @@
+package autopilot
+
+import (
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"binance-trading-bot/internal/binance"
+)
+
+// positionModeCacheTTL bounds how long a position mode lookup is trusted. A
+// -4061 rejection invalidates it early.
+const positionModeCacheTTL = 30 * time.Second
+
+// positionModeCache holds the account's last known position mode
+type positionModeCache struct {
+	mu        sync.Mutex
+	dualSide  bool
+	fetchedAt time.Time
+}
+
+// isHedgeMode reports whether the account is in HEDGE (dual side) position
+// mode, querying Binance when the cached mode is missing or older than the TTL
+func (ga *GinieAutopilot) isHedgeMode() (bool, error) {
+	c := &ga.positionMode
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.fetchedAt.IsZero() && time.Since(c.fetchedAt) < positionModeCacheTTL {
+		return c.dualSide, nil
+	}
+
+	posMode, err := ga.futuresClient.GetPositionMode()
+	if err != nil {
+		return false, err
+	}
+	c.dualSide = posMode.DualSidePosition
+	c.fetchedAt = time.Now()
+	return c.dualSide, nil
+}
+
+// invalidatePositionMode forces the next isHedgeMode call to query Binance
+func (ga *GinieAutopilot) invalidatePositionMode() {
+	ga.positionMode.mu.Lock()
+	ga.positionMode.fetchedAt = time.Time{}
+	ga.positionMode.mu.Unlock()
+}
+
+// hedgePositionSide returns the HEDGE mode position side an order belongs to:
+// opening BUY / closing SELL are LONG, opening SELL / closing BUY are SHORT
+func hedgePositionSide(side string, closing bool) binance.PositionSide {
+	if (side == "BUY") != closing {
+		return binance.PositionSideLong
+	}
+	return binance.PositionSideShort
+}
+
+// recoverPositionSide is called after Binance rejected an order's position
+// side (-4061). It drops the cached mode, re-queries it and returns the position
+// side and reduce-only flag to retry with. HEDGE mode rejects reduceOnly (the
+// side already says it's a close); ONE_WAY needs it to keep a close from opening
+// the opposite way. If the re-query fails or still agrees with the rejected
+// side, the other mode's side is used.
+func (ga *GinieAutopilot) recoverPositionSide(symbol, side string, rejected binance.PositionSide, closing bool) (binance.PositionSide, bool) {
+	if rejected == "" {
+		rejected = binance.PositionSideBoth
+	}
+	rejectedHedge := rejected != binance.PositionSideBoth
+
+	ga.invalidatePositionMode()
+	hedge, err := ga.isHedgeMode()
+	if err != nil || hedge == rejectedHedge {
+		hedge = !rejectedHedge
+	}
+
+	retrySide, mode := binance.PositionSideBoth, "ONE_WAY"
+	if hedge {
+		retrySide, mode = hedgePositionSide(side, closing), "HEDGE"
+	}
+	log.Printf("[POSITION-MODE] %s: position side %s rejected (-4061), account is in %s mode, retrying with %s",
+		symbol, rejected, mode, retrySide)
+	return retrySide, closing && !hedge
+}
+
+// orderCloses reports whether an order reduces a position: reduce-only or
+// close-position in ONE_WAY mode, side opposite to its position side in HEDGE mode
+func orderCloses(side string, positionSide binance.PositionSide, reduceOnly, closePosition bool) bool {
+	if reduceOnly || closePosition {
+		return true
+	}
+	if positionSide == "" || positionSide == binance.PositionSideBoth {
+		return false
+	}
+	return hedgePositionSide(side, true) == positionSide
+}
+
+// placeFuturesOrder places an order and recovers once from a -4061 position
+// side rejection by re-querying the position mode and retrying with the side it expects
+func (ga *GinieAutopilot) placeFuturesOrder(params binance.FuturesOrderParams) (*binance.FuturesOrderResponse, error) {
+	order, err := ga.futuresClient.PlaceFuturesOrder(params)
+	if !errors.Is(err, binance.ErrPositionSideMismatch) {
+		return order, err
+	}
+
+	closing := orderCloses(params.Side, params.PositionSide, params.ReduceOnly, params.ClosePosition)
+	var reduceOnly bool
+	params.PositionSide, reduceOnly = ga.recoverPositionSide(params.Symbol, params.Side, params.PositionSide, closing)
+	params.ReduceOnly = reduceOnly && !params.ClosePosition
+	return ga.futuresClient.PlaceFuturesOrder(params)
+}
@@ -0,0 +1,39 @@
+package autopilot
+
+import (
+	"testing"
+
+	"binance-trading-bot/internal/binance"
+)
+
+func TestOrderClosesAndHedgePositionSide(t *testing.T) {
+	tests := []struct {
+		name          string
+		side          string
+		positionSide  binance.PositionSide
+		reduceOnly    bool
+		closePosition bool
+		wantClosing   bool
+		wantHedgeSide binance.PositionSide
+	}{
+		{"ONE_WAY open long", "BUY", binance.PositionSideBoth, false, false, false, binance.PositionSideLong},
+		{"ONE_WAY open short", "SELL", "", false, false, false, binance.PositionSideShort},
+		{"ONE_WAY close long", "SELL", binance.PositionSideBoth, true, false, true, binance.PositionSideLong},
+		{"ONE_WAY close short via closePosition", "BUY", binance.PositionSideBoth, false, true, true, binance.PositionSideShort},
+		{"HEDGE open long", "BUY", binance.PositionSideLong, false, false, false, binance.PositionSideLong},
+		{"HEDGE close long", "SELL", binance.PositionSideLong, false, false, true, binance.PositionSideLong},
+		{"HEDGE close short", "BUY", binance.PositionSideShort, false, false, true, binance.PositionSideShort},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			closing := orderCloses(tt.side, tt.positionSide, tt.reduceOnly, tt.closePosition)
+			if closing != tt.wantClosing {
+				t.Fatalf("orderCloses() = %v, want %v", closing, tt.wantClosing)
+			}
+			if got := hedgePositionSide(tt.side, closing); got != tt.wantHedgeSide {
+				t.Errorf("hedgePositionSide(%s, %v) = %s, want %s", tt.side, closing, got, tt.wantHedgeSide)
+			}
+		})
+	}
+}
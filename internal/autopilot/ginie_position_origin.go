@@ -0,0 +1,98 @@
+package autopilot
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Position sources. Ginie's own entries are "ai", "strategy" or "reversal";
+// the others were picked up from the exchange.
+const (
+	PositionSourceAI        = "ai"
+	PositionSourceStrategy  = "strategy"
+	PositionSourceReversal  = "reversal"
+	PositionSourceSync      = "sync"
+	PositionSourceForceSync = "force_sync"
+	PositionSourceExternal  = "external"
+)
+
+// maxEntryReasonLen keeps EntryReason short enough for a table cell
+const maxEntryReasonLen = 120
+
+// decisionEntryReason summarizes the AI decision behind an entry, e.g.
+// "swing LONG: 4/5 signals, BULLISH trend, ADX 28"
+func decisionEntryReason(decision *GinieDecisionReport) string {
+	if decision == nil {
+		return ""
+	}
+	parts := []string{fmt.Sprintf("%s %s", decision.SelectedMode, decision.TradeExecution.Action)}
+	if decision.SignalAnalysis.PrimaryRequired > 0 {
+		parts = append(parts, fmt.Sprintf("%d/%d signals", decision.SignalAnalysis.PrimaryMet, decision.SignalAnalysis.PrimaryRequired))
+	}
+	if trend := decision.MarketConditions.Trend; trend != "" {
+		parts = append(parts, trend+" trend")
+	}
+	if decision.MarketConditions.ADX > 0 {
+		parts = append(parts, fmt.Sprintf("ADX %.0f", decision.MarketConditions.ADX))
+	}
+	if len(parts) == 1 {
+		return parts[0]
+	}
+	return truncateEntryReason(parts[0] + ": " + strings.Join(parts[1:], ", "))
+}
+
+// ultraFastEntryReason summarizes the ultra-fast signal behind an entry
+func ultraFastEntryReason(signal *UltraFastSignal) string {
+	if signal == nil {
+		return ""
+	}
+	reason := fmt.Sprintf("ultra_fast %s: trend %.0f, volume %.1fx", signal.TrendBias, signal.TrendStrength, signal.VolumeMultiplier)
+	if signal.TrendAligned {
+		reason += ", timeframes aligned"
+	}
+	return truncateEntryReason(reason)
+}
+
+// strategyEntryReason names the strategy and the reason it gave
+func strategyEntryReason(signal *StrategySignal) string {
+	if signal.Reason == "" {
+		return truncateEntryReason(signal.StrategyName)
+	}
+	return truncateEntryReason(signal.StrategyName + ": " + signal.Reason)
+}
+
+func truncateEntryReason(reason string) string {
+	if len(reason) <= maxEntryReasonLen {
+		return reason
+	}
+	return strings.TrimSpace(reason[:maxEntryReasonLen-3]) + "..."
+}
+
+// positionOriginFields returns the origin tags of a position for the map-based
+// WebSocket position updates, matching the GiniePosition JSON names
+func positionOriginFields(pos *GiniePosition) map[string]interface{} {
+	fields := map[string]interface{}{
+		"mode":          pos.Mode,
+		"source":        pos.Source,
+		"strategy_name": pos.StrategyName,
+		"confidence":    pos.Confidence,
+		"entry_reason":  pos.EntryReason,
+	}
+	if pos.StrategyID != nil {
+		fields["strategy_id"] = *pos.StrategyID
+	}
+	return fields
+}
+
+// restorePositionOrigin copies persisted origin tags onto a position rebuilt
+// from the exchange, so a restart doesn't relabel Ginie's own positions
+func restorePositionOrigin(pos *GiniePosition, saved PersistedPositionState) {
+	if saved.Source == "" {
+		return
+	}
+	pos.Source = saved.Source
+	pos.StrategyID = saved.StrategyID
+	pos.StrategyName = saved.StrategyName
+	pos.Confidence = saved.Confidence
+	pos.EntryReason = saved.EntryReason
+}
@@ -0,0 +1,60 @@
+package autopilot
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDecisionEntryReason(t *testing.T) {
+	decision := &GinieDecisionReport{SelectedMode: GinieModeSwing}
+	decision.TradeExecution.Action = "LONG"
+	decision.SignalAnalysis.PrimaryMet = 4
+	decision.SignalAnalysis.PrimaryRequired = 5
+	decision.MarketConditions.Trend = "BULLISH"
+	decision.MarketConditions.ADX = 27.6
+
+	if got, want := decisionEntryReason(decision), "swing LONG: 4/5 signals, BULLISH trend, ADX 28"; got != want {
+		t.Errorf("decisionEntryReason = %q, want %q", got, want)
+	}
+	if got := decisionEntryReason(&GinieDecisionReport{SelectedMode: GinieModeScalp, TradeExecution: GinieTradeExecution{Action: "SHORT"}}); got != "scalp SHORT" {
+		t.Errorf("bare decision reason = %q, want %q", got, "scalp SHORT")
+	}
+
+	long := strategyEntryReason(&StrategySignal{StrategyName: "RSI dip", Reason: strings.Repeat("x", 300)})
+	if len(long) != maxEntryReasonLen || !strings.HasPrefix(long, "RSI dip: ") || !strings.HasSuffix(long, "...") {
+		t.Errorf("long strategy reason not truncated: %q (%d)", long, len(long))
+	}
+}
+
+// TestPositionOriginSurvivesRestart checks that origin tags make the round trip
+// through the Redis state and are restored onto a reconciled position
+func TestPositionOriginSurvivesRestart(t *testing.T) {
+	ga := &GinieAutopilot{}
+	strategyID := int64(7)
+	strategyName := "Breakout 15m"
+	saved := PersistedPositionState{
+		Symbol:       "ETHUSDT",
+		Side:         "LONG",
+		Mode:         GinieModeSwing,
+		Source:       PositionSourceStrategy,
+		StrategyID:   &strategyID,
+		StrategyName: &strategyName,
+		Confidence:   64,
+		EntryReason:  "Breakout 15m: range break",
+	}
+	restored := ga.convertFromRedisState(ga.convertToRedisState(&saved))
+
+	pos := &GiniePosition{Symbol: "ETHUSDT", Side: "LONG", Source: PositionSourceExternal, EntryReason: "Adopted from exchange during reconciliation"}
+	ga.RestorePositionState(pos, restored)
+	if pos.Source != PositionSourceStrategy || pos.StrategyName == nil || *pos.StrategyName != strategyName ||
+		pos.StrategyID == nil || *pos.StrategyID != 7 || pos.Confidence != 64 || pos.EntryReason != saved.EntryReason {
+		t.Errorf("origin not restored: %+v", pos)
+	}
+
+	// State saved before origin tags existed leaves the reconciled labels alone
+	legacy := &GiniePosition{Symbol: "ETHUSDT", Side: "LONG", Source: PositionSourceExternal}
+	ga.RestorePositionState(legacy, PersistedPositionState{Symbol: "ETHUSDT", Side: "LONG"})
+	if legacy.Source != PositionSourceExternal {
+		t.Errorf("legacy state overwrote source: %q", legacy.Source)
+	}
+}
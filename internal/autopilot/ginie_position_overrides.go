@@ -0,0 +1,163 @@
+package autopilot
+
+import (
+	"fmt"
+
+	"binance-trading-bot/internal/orders"
+)
+
+// PositionLevelUpdate holds manual SL/TP/ROI overrides for an open position.
+// Nil fields are left unchanged.
+type PositionLevelUpdate struct {
+	StopLoss    *float64  // New stop loss trigger price
+	TakeProfits []float64 // New prices for the remaining (not yet hit) TP levels, nearest first
+	ROIPercent  *float64  // Custom early profit booking ROI% (0 clears)
+}
+
+// UpdatePositionLevels applies manual SL/TP/ROI overrides to an open position and
+// replaces the matching Binance algo orders. New levels are validated against the
+// position side and current price: for a LONG the SL must be below and the TPs
+// above the market, and the reverse for a SHORT.
+func (ga *GinieAutopilot) UpdatePositionLevels(symbol string, update PositionLevelUpdate) (*GiniePosition, error) {
+	if update.StopLoss == nil && len(update.TakeProfits) == 0 && update.ROIPercent == nil {
+		return nil, fmt.Errorf("nothing to update: provide stop_loss, take_profits or roi_percent")
+	}
+	if update.ROIPercent != nil && (*update.ROIPercent < 0 || *update.ROIPercent > 1000) {
+		return nil, fmt.Errorf("roi_percent must be between 0-1000%%")
+	}
+
+	unlockSymbol := ga.symbolLocks.Lock(symbol)
+	defer unlockSymbol()
+
+	ga.mu.RLock()
+	pos, exists := ga.positions[symbol]
+	ga.mu.RUnlock()
+	if !exists {
+		return nil, fmt.Errorf("position not found: %s", symbol)
+	}
+
+	// Validate against the live price, falling back to entry if it is unavailable
+	refPrice := pos.EntryPrice
+	if currentPrice, err := ga.futuresClient.GetFuturesCurrentPrice(symbol); err == nil && currentPrice > 0 {
+		refPrice = currentPrice
+	}
+
+	ga.mu.Lock()
+	pos, exists = ga.positions[symbol]
+	if !exists {
+		ga.mu.Unlock()
+		return nil, fmt.Errorf("position not found: %s", symbol)
+	}
+	if pos.IsClosing {
+		ga.mu.Unlock()
+		return nil, fmt.Errorf("position %s is being closed", symbol)
+	}
+	if err := validatePositionLevels(pos, update, refPrice); err != nil {
+		ga.mu.Unlock()
+		return nil, err
+	}
+
+	oldSL := pos.StopLoss
+	if update.StopLoss != nil {
+		pos.StopLoss = *update.StopLoss
+	}
+	for i, price := range update.TakeProfits {
+		pos.TakeProfits[pos.CurrentTPLevel+i].Price = price
+	}
+	if update.ROIPercent != nil {
+		if *update.ROIPercent > 0 {
+			roi := *update.ROIPercent
+			pos.CustomROIPercent = &roi
+		} else {
+			pos.CustomROIPercent = nil
+		}
+	}
+	slChanged := update.StopLoss != nil && pos.StopLoss != oldSL
+	tpChanged := len(update.TakeProfits) > 0
+	ga.mu.Unlock()
+
+	ga.logger.Info("Ginie position levels updated manually",
+		"symbol", symbol,
+		"side", pos.Side,
+		"reference_price", refPrice,
+		"old_sl", oldSL,
+		"new_sl", pos.StopLoss,
+		"take_profits_updated", len(update.TakeProfits),
+		"roi_percent", update.ROIPercent)
+
+	// Network calls happen outside the lock, like the monitor loop does.
+	// placeNextTPOrder cancels all algo orders and re-places both the TP and the SL.
+	switch {
+	case tpChanged:
+		ga.placeNextTPOrder(pos, pos.CurrentTPLevel)
+		if slChanged {
+			ga.logOrderModificationEvent(pos, "SL", &oldSL, pos.StopLoss, pos.StopLossAlgoID,
+				orders.ModificationSourceUserManual, "Manual SL update via API", nil)
+		}
+	case slChanged:
+		ga.updateBinanceSLOrderWithReason(pos, orders.ModificationSourceUserManual, "Manual SL update via API")
+	}
+
+	go ga.SavePositionState()
+	return pos, nil
+}
+
+// validatePositionLevels checks that the requested levels are on the correct side
+// of refPrice for the position direction. Caller must hold ga.mu.
+func validatePositionLevels(pos *GiniePosition, update PositionLevelUpdate, refPrice float64) error {
+	isLong := pos.Side == "LONG"
+
+	if update.StopLoss != nil {
+		sl := *update.StopLoss
+		if sl <= 0 {
+			return fmt.Errorf("stop_loss must be positive")
+		}
+		if isLong && sl >= refPrice {
+			return fmt.Errorf("stop_loss %.6f must be below current price %.6f for a LONG", sl, refPrice)
+		}
+		if !isLong && sl <= refPrice {
+			return fmt.Errorf("stop_loss %.6f must be above current price %.6f for a SHORT", sl, refPrice)
+		}
+	}
+
+	if len(update.TakeProfits) == 0 {
+		return nil
+	}
+	remaining := len(pos.TakeProfits) - pos.CurrentTPLevel
+	if remaining <= 0 {
+		return fmt.Errorf("position %s has no remaining take profit levels", pos.Symbol)
+	}
+	if len(update.TakeProfits) > remaining {
+		return fmt.Errorf("got %d take_profits but only %d TP levels remain", len(update.TakeProfits), remaining)
+	}
+
+	sl := pos.StopLoss
+	if update.StopLoss != nil {
+		sl = *update.StopLoss
+	}
+	prev := refPrice
+	for i, tp := range update.TakeProfits {
+		level := pos.CurrentTPLevel + i + 1
+		if tp <= 0 {
+			return fmt.Errorf("TP%d price must be positive", level)
+		}
+		if isLong && tp <= prev {
+			return fmt.Errorf("TP%d %.6f must be above %.6f for a LONG", level, tp, prev)
+		}
+		if !isLong && tp >= prev {
+			return fmt.Errorf("TP%d %.6f must be below %.6f for a SHORT", level, tp, prev)
+		}
+		prev = tp
+	}
+	// Levels not being replaced must stay beyond the last new one
+	if next := pos.CurrentTPLevel + len(update.TakeProfits); next < len(pos.TakeProfits) {
+		tp := pos.TakeProfits[next].Price
+		if (isLong && tp <= prev) || (!isLong && tp >= prev) {
+			return fmt.Errorf("TP%d would overtake the existing TP%d at %.6f", next, next+1, tp)
+		}
+	}
+	if sl > 0 && ((isLong && update.TakeProfits[0] <= sl) || (!isLong && update.TakeProfits[0] >= sl)) {
+		return fmt.Errorf("take profit must be on the profit side of the stop loss")
+	}
+	return nil
+}
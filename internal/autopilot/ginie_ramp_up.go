@@ -0,0 +1,55 @@
+package autopilot
+
+import (
+	"time"
+)
+
+// rampUpPositionCapLocked returns the position cap in effect while trading ramps
+// back up after the circuit breaker resumes. For the first RampUpMinutes after a
+// reset the cap starts at RampUpStartPositions and climbs linearly to
+// MaxPositions, so a cooldown ending does not immediately re-open every slot.
+// ok is false when no ramp-up is in progress. Caller must hold ga.mu.
+func (ga *GinieAutopilot) rampUpPositionCapLocked() (limit int, ok bool) {
+	if ga.config.RampUpMinutes <= 0 || !ga.config.CircuitBreakerEnabled || ga.circuitBreaker == nil {
+		return ga.config.MaxPositions, false
+	}
+
+	resumedAt := ga.circuitBreaker.ResumedAt()
+	if resumedAt.IsZero() {
+		return ga.config.MaxPositions, false
+	}
+
+	window := time.Duration(ga.config.RampUpMinutes) * time.Minute
+	elapsed := time.Since(resumedAt)
+	if elapsed >= window {
+		return ga.config.MaxPositions, false
+	}
+
+	start := ga.config.RampUpStartPositions
+	if start < 1 {
+		start = 1
+	}
+	if start >= ga.config.MaxPositions {
+		return ga.config.MaxPositions, false
+	}
+
+	limit = start + int(float64(ga.config.MaxPositions-start)*elapsed.Seconds()/window.Seconds())
+	return limit, true
+}
+
+// logRampUpCap logs the ramp-up cap whenever it changes (0 = ramp-up finished)
+func (ga *GinieAutopilot) logRampUpCap(limit int) {
+	previous := ga.lastRampUpCap.Swap(int32(limit))
+	if previous == int32(limit) {
+		return
+	}
+	if limit == 0 {
+		ga.logger.Info("Ginie ramp-up complete, full position limit restored",
+			"max_positions", ga.config.MaxPositions)
+		return
+	}
+	ga.logger.Info("Ginie post-circuit-breaker ramp-up",
+		"position_cap", limit,
+		"max_positions", ga.config.MaxPositions,
+		"ramp_up_minutes", ga.config.RampUpMinutes)
+}
@@ -0,0 +1,244 @@
+package autopilot
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// Reconciliation heal policies (GinieAutopilotConfig.ReconcileHealPolicy)
+const (
+	ReconcileHealPolicyReport = "report" // Log drift only
+	ReconcileHealPolicyHeal   = "heal"   // Fix tracked state to match the exchange
+)
+
+// reconcileQtyTolerance is the relative quantity difference tolerated before a
+// tracked position is reported as mismatched (same threshold as reconcilePositions);
+// PositionDriftQtyTolerancePercent overrides it
+const reconcileQtyTolerance = 0.01
+
+// reconcileEntryTolerance is the relative entry price difference tolerated when
+// PositionDriftEntryTolerancePercent is not set
+const reconcileEntryTolerance = 0.005
+
+// ReconciliationPosition is one side of a position as seen by Ginie or the exchange
+type ReconciliationPosition struct {
+	Symbol     string  `json:"symbol"`
+	Side       string  `json:"side"` // LONG or SHORT
+	Quantity   float64 `json:"quantity"`
+	EntryPrice float64 `json:"entry_price"`
+}
+
+// ReconciliationMismatch describes a position that exists on both sides but disagrees
+type ReconciliationMismatch struct {
+	Symbol        string  `json:"symbol"`
+	TrackedSide   string  `json:"tracked_side"`
+	ExchangeSide  string  `json:"exchange_side"`
+	TrackedQty    float64 `json:"tracked_qty"`
+	ExchangeQty   float64 `json:"exchange_qty"`
+	TrackedEntry  float64 `json:"tracked_entry"`
+	ExchangeEntry float64 `json:"exchange_entry"`
+	Reason        string  `json:"reason"` // side, quantity or entry
+}
+
+// ReconciliationReport is the diff between Ginie's tracked positions and the exchange
+type ReconciliationReport struct {
+	GeneratedAt   time.Time                `json:"generated_at"`
+	DryRun        bool                     `json:"dry_run"` // Paper mode is not reconciled against the exchange
+	HealPolicy    string                   `json:"heal_policy"`
+	TrackedCount  int                      `json:"tracked_count"`
+	ExchangeCount int                      `json:"exchange_count"`
+	Ghosts        []ReconciliationPosition `json:"ghosts"`  // Tracked but not on the exchange
+	Orphans       []ReconciliationPosition `json:"orphans"` // On the exchange but not tracked
+	Mismatches    []ReconciliationMismatch `json:"mismatches"`
+	InSync        bool                     `json:"in_sync"`
+	Healed        bool                     `json:"healed"`
+	HealActions   []string                 `json:"heal_actions,omitempty"`
+}
+
+// GetReconciliationReport compares tracked positions with the exchange and
+// returns ghosts, orphans and side/quantity/entry mismatches. Drift is logged, and
+// when the heal policy is "heal" tracked state is corrected to match the exchange.
+func (ga *GinieAutopilot) GetReconciliationReport() (*ReconciliationReport, error) {
+	ga.mu.RLock()
+	dryRun := ga.config.DryRun
+	policy := ga.config.ReconcileHealPolicy
+	qtyTolerance, entryTolerance := ga.reconcileTolerancesLocked()
+	ga.mu.RUnlock()
+	if policy == "" {
+		policy = ReconcileHealPolicyReport
+	}
+
+	report := &ReconciliationReport{
+		GeneratedAt: time.Now(),
+		DryRun:      dryRun,
+		HealPolicy:  policy,
+		Ghosts:      make([]ReconciliationPosition, 0),
+		Orphans:     make([]ReconciliationPosition, 0),
+		Mismatches:  make([]ReconciliationMismatch, 0),
+	}
+
+	if dryRun {
+		report.TrackedCount = len(ga.GetPositions())
+		report.InSync = true
+		return report, nil
+	}
+
+	exchangePositions, err := ga.futuresClient.GetPositions()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange positions: %w", err)
+	}
+
+	exchange := make(map[string]ReconciliationPosition)
+	for _, pos := range exchangePositions {
+		if pos.PositionAmt == 0 {
+			continue
+		}
+		side := "LONG"
+		if pos.PositionAmt < 0 {
+			side = "SHORT"
+		}
+		exchange[pos.Symbol] = ReconciliationPosition{
+			Symbol:     pos.Symbol,
+			Side:       side,
+			Quantity:   math.Abs(pos.PositionAmt),
+			EntryPrice: pos.EntryPrice,
+		}
+	}
+	report.ExchangeCount = len(exchange)
+
+	ga.mu.RLock()
+	report.TrackedCount = len(ga.positions)
+	for symbol, tracked := range ga.positions {
+		onExchange, exists := exchange[symbol]
+		if !exists {
+			report.Ghosts = append(report.Ghosts, ReconciliationPosition{
+				Symbol:     symbol,
+				Side:       tracked.Side,
+				Quantity:   tracked.RemainingQty,
+				EntryPrice: tracked.EntryPrice,
+			})
+			continue
+		}
+
+		mismatch := ReconciliationMismatch{
+			Symbol:        symbol,
+			TrackedSide:   tracked.Side,
+			ExchangeSide:  onExchange.Side,
+			TrackedQty:    tracked.RemainingQty,
+			ExchangeQty:   onExchange.Quantity,
+			TrackedEntry:  tracked.EntryPrice,
+			ExchangeEntry: onExchange.EntryPrice,
+		}
+		if tracked.Side != onExchange.Side {
+			mismatch.Reason = "side"
+			report.Mismatches = append(report.Mismatches, mismatch)
+		} else if tracked.RemainingQty <= 0 ||
+			math.Abs(onExchange.Quantity-tracked.RemainingQty)/tracked.RemainingQty > qtyTolerance {
+			mismatch.Reason = "quantity"
+			report.Mismatches = append(report.Mismatches, mismatch)
+		} else if onExchange.EntryPrice > 0 && (tracked.EntryPrice <= 0 ||
+			math.Abs(onExchange.EntryPrice-tracked.EntryPrice)/tracked.EntryPrice > entryTolerance) {
+			mismatch.Reason = "entry"
+			report.Mismatches = append(report.Mismatches, mismatch)
+		}
+	}
+	for symbol, pos := range exchange {
+		if _, tracked := ga.positions[symbol]; !tracked {
+			report.Orphans = append(report.Orphans, pos)
+		}
+	}
+	ga.mu.RUnlock()
+
+	sort.Slice(report.Ghosts, func(i, j int) bool { return report.Ghosts[i].Symbol < report.Ghosts[j].Symbol })
+	sort.Slice(report.Orphans, func(i, j int) bool { return report.Orphans[i].Symbol < report.Orphans[j].Symbol })
+	sort.Slice(report.Mismatches, func(i, j int) bool { return report.Mismatches[i].Symbol < report.Mismatches[j].Symbol })
+
+	report.InSync = len(report.Ghosts) == 0 && len(report.Orphans) == 0 && len(report.Mismatches) == 0
+	if report.InSync {
+		return report, nil
+	}
+
+	ga.logger.Warn("Position reconciliation drift detected",
+		"ghosts", len(report.Ghosts),
+		"orphans", len(report.Orphans),
+		"mismatches", len(report.Mismatches),
+		"heal_policy", policy)
+
+	if policy == ReconcileHealPolicyHeal {
+		report.HealActions = ga.healReconciliationDrift(report, exchange)
+		report.Healed = true
+	}
+
+	return report, nil
+}
+
+// healReconciliationDrift corrects tracked state so it matches the exchange:
+// quantity and entry mismatches take the exchange values, side mismatches are
+// dropped and re-imported, and SyncWithExchange removes ghosts and adopts orphans.
+func (ga *GinieAutopilot) healReconciliationDrift(report *ReconciliationReport, exchange map[string]ReconciliationPosition) []string {
+	actions := make([]string, 0)
+
+	symbols := make([]string, 0, len(report.Mismatches))
+	for _, m := range report.Mismatches {
+		symbols = append(symbols, m.Symbol)
+	}
+	unlockSymbols := ga.symbolLocks.LockAll(symbols)
+	ga.mu.Lock()
+	for _, m := range report.Mismatches {
+		pos, exists := ga.positions[m.Symbol]
+		if !exists {
+			continue
+		}
+		if m.Reason == "side" {
+			delete(ga.positions, m.Symbol)
+			actions = append(actions, fmt.Sprintf("%s: dropped tracked %s position, exchange holds %s", m.Symbol, m.TrackedSide, m.ExchangeSide))
+			continue
+		}
+		if m.Reason == "entry" {
+			pos.EntryPrice = m.ExchangeEntry
+			actions = append(actions, fmt.Sprintf("%s: entry %.6f -> %.6f", m.Symbol, m.TrackedEntry, m.ExchangeEntry))
+			continue
+		}
+		pos.RemainingQty = m.ExchangeQty
+		if entry := exchange[m.Symbol].EntryPrice; entry > 0 {
+			pos.EntryPrice = entry
+		}
+		actions = append(actions, fmt.Sprintf("%s: quantity %.6f -> %.6f", m.Symbol, m.TrackedQty, m.ExchangeQty))
+	}
+	ga.mu.Unlock()
+	unlockSymbols()
+
+	if len(report.Ghosts) > 0 || len(report.Orphans) > 0 || len(actions) > 0 {
+		synced, err := ga.SyncWithExchange()
+		if err != nil {
+			actions = append(actions, "sync with exchange failed: "+err.Error())
+		} else {
+			for _, g := range report.Ghosts {
+				actions = append(actions, fmt.Sprintf("%s: removed ghost %s position", g.Symbol, g.Side))
+			}
+			if synced > 0 {
+				actions = append(actions, fmt.Sprintf("imported %d untracked exchange position(s)", synced))
+			}
+		}
+	}
+
+	for _, action := range actions {
+		ga.logger.Warn("Position reconciliation healed", "action", action)
+	}
+	return actions
+}
+
+// reconcileTolerancesLocked returns the relative quantity and entry price
+// differences tolerated before a position counts as drifted (must hold lock)
+func (ga *GinieAutopilot) reconcileTolerancesLocked() (qty, entry float64) {
+	qty, entry = reconcileQtyTolerance, reconcileEntryTolerance
+	if ga.config.PositionDriftQtyTolerancePercent > 0 {
+		qty = ga.config.PositionDriftQtyTolerancePercent / 100
+	}
+	if ga.config.PositionDriftEntryTolerancePercent > 0 {
+		entry = ga.config.PositionDriftEntryTolerancePercent / 100
+	}
+	return qty, entry
+}
@@ -0,0 +1,120 @@
+package autopilot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"binance-trading-bot/internal/binance"
+	"binance-trading-bot/internal/logging"
+)
+
+// GinieReplayConfig controls a replay of recorded market data through the
+// Ginie analyzer
+type GinieReplayConfig struct {
+	Symbols []string         // Symbols to analyze; empty means every recorded symbol
+	Start   time.Time        // First decision time
+	End     time.Time        // Last decision time (inclusive)
+	Step    time.Duration    // Replay clock advance between decision rounds
+	Mode    GinieTradingMode // Fixed mode; empty auto-selects like GenerateDecision
+	Speed   float64          // Replay speed as a multiple of real time; 0 runs as fast as possible
+}
+
+// GinieReplayDecision is one decision produced during a replay, stamped with
+// the replay time rather than the wall clock
+type GinieReplayDecision struct {
+	Time           time.Time           `json:"time"`
+	Symbol         string              `json:"symbol"`
+	Mode           GinieTradingMode    `json:"mode,omitempty"`
+	ScanStatus     GinieScanStatus     `json:"scan_status,omitempty"`
+	Action         string              `json:"action,omitempty"`
+	Recommendation GenieRecommendation `json:"recommendation,omitempty"`
+	Confidence     float64             `json:"confidence"`
+	Price          float64             `json:"price"`
+	StopLoss       float64             `json:"stop_loss,omitempty"`
+	RiskReward     float64             `json:"risk_reward,omitempty"`
+	PrimaryMet     int                 `json:"primary_met"`
+	Note           string              `json:"note,omitempty"`
+	Error          string              `json:"error,omitempty"`
+}
+
+// RunGinieReplay steps the replay client's clock from Start to End and asks a
+// fresh Ginie analyzer for a decision on every symbol at each step. The
+// analyzer reads market data only through the client, so a replay goes
+// through the same code path as live scanning and is deterministic for the
+// same recording and settings. onDecision is called for every decision,
+// including failed ones. It returns the number of decisions produced.
+func RunGinieReplay(ctx context.Context, client *binance.ReplayFuturesClient, logger *logging.Logger, config GinieReplayConfig, onDecision func(GinieReplayDecision)) (int, error) {
+	if client == nil {
+		return 0, errors.New("replay client is required")
+	}
+	if config.Step <= 0 {
+		return 0, errors.New("replay step must be positive")
+	}
+	if config.End.Before(config.Start) {
+		return 0, fmt.Errorf("replay end %s is before start %s", config.End.Format(time.RFC3339), config.Start.Format(time.RFC3339))
+	}
+	symbols := config.Symbols
+	if len(symbols) == 0 {
+		symbols, _ = client.GetFuturesSymbols()
+	}
+	if len(symbols) == 0 {
+		return 0, errors.New("no symbols to replay")
+	}
+
+	// No signal aggregator, repository or user: settings come from the
+	// defaults and settings file, and nothing is written back
+	analyzer := NewGinieAnalyzer(client, nil, logger, nil, "")
+
+	count := 0
+	for now := config.Start; !now.After(config.End); now = now.Add(config.Step) {
+		if err := ctx.Err(); err != nil {
+			return count, err
+		}
+		client.AdvanceTo(now)
+
+		for _, symbol := range symbols {
+			onDecision(replayDecision(analyzer, client, symbol, config.Mode, now))
+			count++
+		}
+
+		if config.Speed > 0 {
+			select {
+			case <-ctx.Done():
+				return count, ctx.Err()
+			case <-time.After(time.Duration(float64(config.Step) / config.Speed)):
+			}
+		}
+	}
+	return count, nil
+}
+
+// replayDecision generates one decision at the current replay time
+func replayDecision(analyzer *GinieAnalyzer, client *binance.ReplayFuturesClient, symbol string, mode GinieTradingMode, now time.Time) GinieReplayDecision {
+	decision := GinieReplayDecision{Time: now, Symbol: symbol, Mode: mode}
+	decision.Price, _ = client.GetFuturesCurrentPrice(symbol)
+
+	var report *GinieDecisionReport
+	var err error
+	if mode == "" {
+		report, err = analyzer.GenerateDecision(symbol)
+	} else {
+		report, err = analyzer.GenerateDecisionForMode(symbol, mode)
+	}
+	if err != nil {
+		decision.Error = err.Error()
+		return decision
+	}
+
+	decision.Mode = report.SelectedMode
+	decision.ScanStatus = report.ScanStatus
+	decision.Action = report.TradeExecution.Action
+	decision.Recommendation = report.Recommendation
+	decision.Confidence = report.ConfidenceScore
+	decision.StopLoss = report.TradeExecution.StopLoss
+	decision.RiskReward = report.TradeExecution.RiskReward
+	decision.PrimaryMet = report.SignalAnalysis.PrimaryMet
+	decision.Note = report.RecommendationNote
+	return decision
+}
@@ -0,0 +1,72 @@
+package autopilot
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"binance-trading-bot/internal/binance"
+	"binance-trading-bot/internal/logging"
+)
+
+// replayRecording builds a replay client with a synthetic BTCUSDT trend on
+// every interval the analyzer reads
+func replayRecording(start time.Time) *binance.ReplayFuturesClient {
+	client := binance.NewReplayFuturesClient(10000, start)
+	intervals := map[string]time.Duration{
+		"1m": time.Minute, "3m": 3 * time.Minute, "5m": 5 * time.Minute,
+		"15m": 15 * time.Minute, "1h": time.Hour, "4h": 4 * time.Hour, "1d": 24 * time.Hour,
+	}
+	from := start.Add(-250 * 24 * time.Hour)
+	for interval, width := range intervals {
+		var klines []binance.Kline
+		for open := from; open.Before(start.Add(2 * time.Hour)); open = open.Add(width) {
+			x := float64(open.Unix()) / 3600
+			price := 100 + x/1000 + 2*math.Sin(x/5)
+			klines = append(klines, binance.Kline{
+				OpenTime:  open.UnixMilli(),
+				Open:      price - 0.1,
+				High:      price + 0.3,
+				Low:       price - 0.3,
+				Close:     price,
+				Volume:    1000,
+				CloseTime: open.Add(width).UnixMilli() - 1,
+			})
+		}
+		client.LoadKlines("BTCUSDT", interval, klines)
+	}
+	return client
+}
+
+// TestGinieReplayDeterministic checks that replaying the same recording twice
+// yields the same decisions, stamped with replay time
+func TestGinieReplayDeterministic(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	config := GinieReplayConfig{Start: start, End: start.Add(30 * time.Minute), Step: 15 * time.Minute, Mode: GinieModeSwing}
+
+	replay := func() []GinieReplayDecision {
+		var decisions []GinieReplayDecision
+		n, err := RunGinieReplay(context.Background(), replayRecording(start), logging.Default(), config, func(d GinieReplayDecision) {
+			decisions = append(decisions, d)
+		})
+		if err != nil || n != 3 {
+			t.Fatalf("replay = %d decisions, %v; want 3", n, err)
+		}
+		return decisions
+	}
+
+	first, second := replay(), replay()
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("replays differ:\n%+v\n%+v", first, second)
+	}
+	for i, d := range first {
+		if want := start.Add(time.Duration(i) * 15 * time.Minute); !d.Time.Equal(want) || d.Symbol != "BTCUSDT" {
+			t.Errorf("decision %d at %v for %s, want %v BTCUSDT", i, d.Time, d.Symbol, want)
+		}
+		if d.Error != "" {
+			t.Errorf("decision %d failed: %s", i, d.Error)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package autopilot
+
+import (
+	"time"
+
+	"binance-trading-bot/internal/events"
+)
+
+// maxRunnerPercent caps the share of a position that can be left running
+const maxRunnerPercent = 50.0
+
+// RunnerState is the portion of a position the TP ladder leaves open after the
+// final TP, closed only by a wide trailing stop
+type RunnerState struct {
+	Percent         float64   `json:"percent"`          // % of OriginalQty left to run
+	TrailingPercent float64   `json:"trailing_percent"` // Trail distance once the runner is active
+	Active          bool      `json:"active"`           // Final TP hit, only the runner is left
+	ActivatedAt     time.Time `json:"activated_at,omitempty"`
+	ActivationPrice float64   `json:"activation_price,omitempty"`
+}
+
+// runnerConfig returns the mode's runner % and trail distance. The trail defaults
+// to twice the mode's trailing stop so the runner survives normal pullbacks.
+func (ga *GinieAutopilot) runnerConfig(mode GinieTradingMode) (percent, trailingPercent float64) {
+	modeConfig := ga.getModeConfig(mode)
+	if modeConfig == nil || modeConfig.SLTP == nil || modeConfig.SLTP.RunnerPercent <= 0 {
+		return 0, 0
+	}
+	percent = min(modeConfig.SLTP.RunnerPercent, maxRunnerPercent)
+	trailingPercent = modeConfig.SLTP.RunnerTrailingPercent
+	if trailingPercent <= 0 {
+		trailingPercent = 2 * modeConfig.SLTP.TrailingStopPercent
+	}
+	if trailingPercent <= 0 {
+		trailingPercent = 2 * ga.config.TrailingStepPercent
+	}
+	return percent, trailingPercent
+}
+
+// applyRunner reserves the mode's runner on a position that has none yet and
+// scales the TP allocations down so the ladder closes (100 - runner)%. Safe to
+// call again after the TPs are regenerated.
+func (ga *GinieAutopilot) applyRunner(pos *GiniePosition) {
+	if pos.Runner == nil {
+		if pos.CurrentTPLevel > 0 {
+			return // Don't add a runner to a ladder that's already part-closed
+		}
+		percent, trailingPercent := ga.runnerConfig(pos.Mode)
+		if percent <= 0 {
+			return
+		}
+		pos.Runner = &RunnerState{Percent: percent, TrailingPercent: trailingPercent}
+	}
+	scaleTPsForRunner(pos.TakeProfits, pos.Runner.Percent)
+}
+
+// scaleTPsForRunner scales the TP allocations proportionally so they sum to at
+// most 100 - runnerPercent
+func scaleTPsForRunner(tps []GinieTakeProfitLevel, runnerPercent float64) {
+	total := 0.0
+	for _, tp := range tps {
+		total += tp.Percent
+	}
+	ladder := 100 - runnerPercent
+	if total <= ladder || total <= 0 {
+		return
+	}
+	factor := ladder / total
+	for i := range tps {
+		tps[i].Percent *= factor
+	}
+}
+
+// runnerFinalTPQty is what the final TP closes on a position with a runner:
+// everything left except the runner
+func runnerFinalTPQty(pos *GiniePosition) float64 {
+	runnerQty := pos.OriginalQty * pos.Runner.Percent / 100
+	return roundQuantity(pos.Symbol, pos.RemainingQty-runnerQty)
+}
+
+// activateRunner switches a position to runner mode once its final TP is hit:
+// the remainder trails at the runner's wide distance until price reverses.
+// Reports whether the runner was activated.
+func (ga *GinieAutopilot) activateRunner(pos *GiniePosition, price float64) bool {
+	if pos.Runner == nil || pos.Runner.Active || pos.RemainingQty <= 0 || pos.CurrentTPLevel < len(pos.TakeProfits) {
+		return false
+	}
+	pos.Runner.Active = true
+	pos.Runner.ActivatedAt = time.Now()
+	pos.Runner.ActivationPrice = price
+	pos.TrailingActive = true
+	pos.TrailingPercent = pos.Runner.TrailingPercent
+
+	ga.logger.Info("Final TP hit - runner left open with wide trailing stop",
+		"symbol", pos.Symbol,
+		"mode", pos.Mode,
+		"price", price,
+		"runner_percent", pos.Runner.Percent,
+		"remaining_qty", pos.RemainingQty,
+		"trailing_percent", pos.Runner.TrailingPercent)
+	ga.publishPositionEvent(events.EventGiniePositionTrailingActivated, pos, map[string]interface{}{"reason": "runner", "trailing_percent": pos.Runner.TrailingPercent})
+	return true
+}
@@ -0,0 +1,62 @@
+package autopilot
+
+import (
+	"math"
+	"testing"
+
+	"binance-trading-bot/internal/logging"
+)
+
+func TestScaleTPsForRunner(t *testing.T) {
+	tps := []GinieTakeProfitLevel{{Percent: 25}, {Percent: 25}, {Percent: 25}, {Percent: 25}}
+	scaleTPsForRunner(tps, 10)
+	total := 0.0
+	for _, tp := range tps {
+		total += tp.Percent
+	}
+	if math.Abs(total-90) > 1e-9 || math.Abs(tps[0].Percent-22.5) > 1e-9 {
+		t.Errorf("scaled ladder = %+v (total %v), want 4 x 22.5", tps, total)
+	}
+
+	// Already leaves room for the runner: untouched, so re-applying is a no-op
+	scaleTPsForRunner(tps, 10)
+	if math.Abs(tps[0].Percent-22.5) > 1e-9 {
+		t.Errorf("re-applied ladder = %+v, want unchanged", tps)
+	}
+
+	single := []GinieTakeProfitLevel{{Percent: 100}}
+	scaleTPsForRunner(single, 20)
+	if single[0].Percent != 80 {
+		t.Errorf("single TP = %v, want 80", single[0].Percent)
+	}
+}
+
+func TestActivateRunner(t *testing.T) {
+	ga := &GinieAutopilot{config: &GinieAutopilotConfig{}, logger: logging.Default()}
+	pos := &GiniePosition{
+		Symbol:       "ETHUSDT",
+		OriginalQty:  1,
+		RemainingQty: 0.1,
+		TakeProfits:  []GinieTakeProfitLevel{{Percent: 45}, {Percent: 45}},
+		Runner:       &RunnerState{Percent: 10, TrailingPercent: 4},
+	}
+
+	pos.CurrentTPLevel = 1
+	if ga.activateRunner(pos, 100) {
+		t.Fatal("runner activated before the final TP")
+	}
+
+	pos.CurrentTPLevel = 2
+	if !ga.activateRunner(pos, 110) {
+		t.Fatal("runner not activated after the final TP")
+	}
+	if !pos.Runner.Active || !pos.TrailingActive || pos.TrailingPercent != 4 || pos.Runner.ActivationPrice != 110 {
+		t.Errorf("runner state = %+v, trailing active=%v pct=%v", pos.Runner, pos.TrailingActive, pos.TrailingPercent)
+	}
+	if got := ga.tpLevelTrailingPercent(pos); got != 4 {
+		t.Errorf("trailing step for active runner = %v, want 4", got)
+	}
+	if ga.activateRunner(pos, 120) {
+		t.Error("runner activated twice")
+	}
+}
@@ -0,0 +1,143 @@
+package autopilot
+
+import (
+	"context"
+	"log"
+	"math"
+	"sort"
+
+	"binance-trading-bot/internal/binance"
+)
+
+// Shutdown policies applied to live positions and algo orders when the bot exits
+const (
+	ShutdownPolicyLeave       = "leave"        // Leave positions and SL/TP orders on the exchange
+	ShutdownPolicyCancelAlgos = "cancel_algos" // Cancel all algo (SL/TP) orders, keep positions open
+	ShutdownPolicyFlatten     = "flatten"      // Cancel algo orders and close every position
+)
+
+// ShutdownSymbolResult describes what the shutdown policy did for one symbol
+type ShutdownSymbolResult struct {
+	Symbol         string `json:"symbol"`
+	AlgosCancelled int    `json:"algos_cancelled"`
+	AlgosFailed    int    `json:"algos_failed"`
+	PositionClosed bool   `json:"position_closed"`
+	Skipped        bool   `json:"skipped"` // Deadline reached before the symbol was handled
+	Error          string `json:"error,omitempty"`
+}
+
+// ApplyShutdownPolicy cancels algo orders and/or closes positions on the
+// exchange according to policy. The exchange is used as the source of truth
+// so untracked positions and orphan algo orders are covered too. Symbols are
+// handled one at a time and any left when ctx expires are reported as skipped.
+// Dry-run and standby instances never touch the exchange.
+func (ga *GinieAutopilot) ApplyShutdownPolicy(ctx context.Context, policy string) []ShutdownSymbolResult {
+	if policy != ShutdownPolicyCancelAlgos && policy != ShutdownPolicyFlatten {
+		return nil
+	}
+	if ga.config.DryRun || ga.futuresClient == nil {
+		return nil
+	}
+	if err := ga.requireActive(); err != nil {
+		log.Printf("[GINIE-SHUTDOWN] Policy %s skipped: %v", policy, err)
+		return nil
+	}
+
+	symbols := make(map[string]bool)
+	openPositions := make(map[string][]binance.FuturesPosition)
+
+	algoOrders, err := ga.futuresClient.GetOpenAlgoOrders("")
+	if err != nil {
+		log.Printf("[GINIE-SHUTDOWN] Failed to list open algo orders: %v", err)
+	}
+	for _, order := range algoOrders {
+		symbols[order.Symbol] = true
+	}
+
+	if policy == ShutdownPolicyFlatten {
+		positions, err := ga.futuresClient.GetPositions()
+		if err != nil {
+			log.Printf("[GINIE-SHUTDOWN] Failed to list positions: %v", err)
+		}
+		for _, pos := range positions {
+			if pos.PositionAmt == 0 {
+				continue
+			}
+			symbols[pos.Symbol] = true
+			openPositions[pos.Symbol] = append(openPositions[pos.Symbol], pos)
+		}
+	}
+
+	ordered := make([]string, 0, len(symbols))
+	for symbol := range symbols {
+		ordered = append(ordered, symbol)
+	}
+	sort.Strings(ordered)
+
+	results := make([]ShutdownSymbolResult, 0, len(ordered))
+	for _, symbol := range ordered {
+		result := ShutdownSymbolResult{Symbol: symbol}
+
+		if ctx.Err() != nil {
+			result.Skipped = true
+			log.Printf("[GINIE-SHUTDOWN] %s: skipped, shutdown deadline reached", symbol)
+			results = append(results, result)
+			continue
+		}
+
+		// Keep the monitor from re-placing orders or touching the position meanwhile
+		unlockSymbol := ga.symbolLocks.Lock(symbol)
+		success, failed, err := ga.cancelAllAlgoOrdersForSymbol(symbol)
+		result.AlgosCancelled = success
+		result.AlgosFailed = failed
+		if err != nil {
+			result.Error = err.Error()
+		}
+
+		if policy == ShutdownPolicyFlatten {
+			result.PositionClosed = len(openPositions[symbol]) > 0
+			for _, pos := range openPositions[symbol] {
+				if err := ga.closeExchangePosition(pos); err != nil {
+					result.PositionClosed = false
+					result.Error = err.Error()
+				}
+			}
+			if result.PositionClosed {
+				ga.mu.Lock()
+				delete(ga.positions, symbol)
+				ga.mu.Unlock()
+			}
+		}
+		unlockSymbol()
+
+		log.Printf("[GINIE-SHUTDOWN] %s: policy=%s algos_cancelled=%d algos_failed=%d position_closed=%v error=%q",
+			symbol, policy, result.AlgosCancelled, result.AlgosFailed, result.PositionClosed, result.Error)
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// closeExchangePosition market-closes a position as reported by the exchange
+func (ga *GinieAutopilot) closeExchangePosition(pos binance.FuturesPosition) error {
+	side := "SELL"
+	if pos.PositionAmt < 0 {
+		side = "BUY"
+	}
+
+	positionSide := binance.PositionSide(pos.PositionSide)
+	if positionSide == "" {
+		positionSide = binance.PositionSideBoth
+	}
+
+	_, err := ga.placeFuturesOrder(binance.FuturesOrderParams{
+		Symbol:       pos.Symbol,
+		Side:         side,
+		PositionSide: positionSide,
+		Type:         binance.FuturesOrderTypeMarket,
+		Quantity:     math.Abs(pos.PositionAmt),
+		// reduceOnly is rejected in hedge mode, where positionSide already scopes the order
+		ReduceOnly: positionSide == binance.PositionSideBoth,
+	})
+	return err
+}
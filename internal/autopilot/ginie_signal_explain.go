@@ -0,0 +1,245 @@
+package autopilot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SignalGateExplanation describes one gate that blocked a signal
+type SignalGateExplanation struct {
+	Gate      string `json:"gate"`                // position_limit, confidence, counter_trend, ...
+	Detail    string `json:"detail"`              // What happened, in plain words
+	Actual    string `json:"actual,omitempty"`    // Value the signal had
+	Threshold string `json:"threshold,omitempty"` // Value the gate required
+	ToExecute string `json:"to_execute"`          // What would have needed to change
+}
+
+// SignalExplanation is a human-readable breakdown of a logged signal's outcome
+type SignalExplanation struct {
+	SignalID        string                  `json:"signal_id"`
+	Symbol          string                  `json:"symbol"`
+	Mode            string                  `json:"mode"`
+	Direction       string                  `json:"direction"`
+	Status          string                  `json:"status"`
+	Confidence      float64                 `json:"confidence"`
+	RejectionReason string                  `json:"rejection_reason,omitempty"`
+	Summary         string                  `json:"summary"`
+	Gates           []SignalGateExplanation `json:"gates"`
+	AllReasons      []string                `json:"all_reasons,omitempty"`
+}
+
+// ExplainSignal assembles a signal log's rejection reason and details into an
+// explanation of which gates failed, by how much, and what would have let the
+// trade through. Structured RejectionDetails are used where recorded; otherwise
+// the gate is recovered from the rejection reason string.
+func ExplainSignal(sig *GinieSignalLog) *SignalExplanation {
+	exp := &SignalExplanation{
+		SignalID:        sig.ID,
+		Symbol:          sig.Symbol,
+		Mode:            sig.Mode,
+		Direction:       sig.Direction,
+		Status:          sig.Status,
+		Confidence:      sig.Confidence,
+		RejectionReason: sig.RejectionReason,
+		Gates:           []SignalGateExplanation{},
+	}
+
+	if sig.Status != "rejected" {
+		exp.Summary = fmt.Sprintf("%s %s signal was %s - no gate blocked it", sig.Symbol, sig.Direction, sig.Status)
+		return exp
+	}
+
+	if d := sig.RejectionDetails; d != nil {
+		exp.AllReasons = d.AllReasons
+		if pl := d.PositionLimit; pl != nil {
+			exp.Gates = append(exp.Gates, SignalGateExplanation{
+				Gate:      "position_limit",
+				Detail:    fmt.Sprintf("%s mode already had %d open positions (%d total)", pl.ModeName, pl.ModePositions, pl.CurrentPositions),
+				Actual:    fmt.Sprintf("%d", pl.ModePositions),
+				Threshold: fmt.Sprintf("< %d", pl.MaxPositions),
+				ToExecute: fmt.Sprintf("A %s slot had to be free - close a position or raise max positions above %d", pl.ModeName, pl.MaxPositions),
+			})
+		}
+		if f := d.InsufficientFunds; f != nil {
+			exp.Gates = append(exp.Gates, SignalGateExplanation{
+				Gate:      "insufficient_funds",
+				Detail:    fmt.Sprintf("Position of %.2f at %dx needed $%.2f margin", f.PositionSize, f.Leverage, f.RequiredUSD),
+				Actual:    fmt.Sprintf("$%.2f available", f.AvailableUSD),
+				Threshold: fmt.Sprintf("$%.2f required", f.RequiredUSD),
+				ToExecute: fmt.Sprintf("$%.2f more available margin, or a smaller position / higher leverage", f.RequiredUSD-f.AvailableUSD),
+			})
+		}
+		if cb := d.CircuitBreaker; cb != nil {
+			exp.Gates = append(exp.Gates, SignalGateExplanation{
+				Gate:      "circuit_breaker",
+				Detail:    fmt.Sprintf("Circuit breaker tripped: %s", cb.Reason),
+				Actual:    fmt.Sprintf("daily loss $%.2f", cb.DailyLoss),
+				Threshold: fmt.Sprintf("max $%.2f", cb.MaxDailyLoss),
+				ToExecute: fmt.Sprintf("Wait for the %d minute cooldown or reset the circuit breaker", cb.CooldownMins),
+			})
+		}
+		if td := d.TrendDivergence; td != nil && td.TimeframesRequired > 0 {
+			exp.Gates = append(exp.Gates, SignalGateExplanation{
+				Gate:      "timeframe_confluence",
+				Detail:    fmt.Sprintf("Only %d/%d timeframes trended with the trade: %s", td.TimeframesAgreeing, len(td.TimeframeTrends), formatTimeframeTrends(td.TimeframeTrends)),
+				Actual:    fmt.Sprintf("%d agreeing", td.TimeframesAgreeing),
+				Threshold: fmt.Sprintf(">= %d agreeing", td.TimeframesRequired),
+				ToExecute: fmt.Sprintf("%d more timeframes trending with the trade, or a lower min_timeframes_agreeing for this mode", td.TimeframesRequired-td.TimeframesAgreeing),
+			})
+		} else if td != nil {
+			exp.Gates = append(exp.Gates, SignalGateExplanation{
+				Gate: "trend_divergence",
+				Detail: fmt.Sprintf("%s trend (%s) disagreed with %s trend (%s), severity %s",
+					td.ScanTimeframe, td.ScanTrend, td.DecisionTimeframe, td.DecisionTrend, td.Severity),
+				Actual:    fmt.Sprintf("%s vs %s", td.ScanTrend, td.DecisionTrend),
+				Threshold: "aligned trends",
+				ToExecute: fmt.Sprintf("The %s and %s trends had to agree, or trend divergence blocking disabled for this mode", td.ScanTimeframe, td.DecisionTimeframe),
+			})
+		}
+		if q := d.SignalQuality; q != nil {
+			gate := SignalGateExplanation{
+				Gate:      "confidence",
+				Detail:    fmt.Sprintf("Confidence %.1f%% was below the %.1f%% minimum", q.ConfidenceScore, q.MinConfidence),
+				Actual:    fmt.Sprintf("%.1f%%", q.ConfidenceScore),
+				Threshold: fmt.Sprintf(">= %.1f%%", q.MinConfidence),
+				ToExecute: fmt.Sprintf("%.1f more confidence points, or a mode minimum of %.1f%% or lower", q.MinConfidence-q.ConfidenceScore, q.ConfidenceScore),
+			}
+			if q.SignalsRequired > 0 {
+				gate.Detail += fmt.Sprintf(" (%d/%d primary signals met)", q.SignalsMet, q.SignalsRequired)
+			}
+			if len(q.FailedSignals) > 0 {
+				gate.Detail += "; failed: " + strings.Join(q.FailedSignals, ", ")
+			}
+			exp.Gates = append(exp.Gates, gate)
+		}
+		if ct := d.CounterTrend; ct != nil {
+			gate := SignalGateExplanation{
+				Gate:      "counter_trend",
+				Detail:    fmt.Sprintf("%s signal against a %s trend without enough confirmation", ct.SignalDirection, ct.TrendDirection),
+				Actual:    ct.SignalDirection,
+				Threshold: fmt.Sprintf("with trend (%s) or fully confirmed", ct.TrendDirection),
+				ToExecute: "The counter-trend requirements had to be met",
+			}
+			if len(ct.MissingSignals) > 0 {
+				gate.ToExecute = "Missing: " + strings.Join(ct.MissingSignals, ", ")
+			}
+			exp.Gates = append(exp.Gates, gate)
+		}
+	}
+
+	if len(exp.Gates) == 0 {
+		exp.Gates = append(exp.Gates, explainRejectionReason(sig))
+	}
+
+	names := make([]string, len(exp.Gates))
+	for i, g := range exp.Gates {
+		names[i] = g.Gate
+	}
+	exp.Summary = fmt.Sprintf("%s %s signal (%.1f%% confidence, %s mode) was rejected by: %s",
+		sig.Symbol, sig.Direction, sig.Confidence, sig.Mode, strings.Join(names, ", "))
+	return exp
+}
+
+// explainRejectionReason recovers the failed gate from the rejection reason
+// string for signals logged without structured details
+func explainRejectionReason(sig *GinieSignalLog) SignalGateExplanation {
+	reason := sig.RejectionReason
+	var actual, threshold float64
+
+	switch {
+	case strings.HasPrefix(reason, "low_confidence"):
+		gate := SignalGateExplanation{Gate: "confidence", Detail: reason,
+			ToExecute: "Higher confidence, or a lower mode minimum confidence"}
+		if _, err := fmt.Sscanf(reason, "low_confidence (%f < %f)", &actual, &threshold); err == nil {
+			gate.Actual = fmt.Sprintf("%.1f%%", actual)
+			gate.Threshold = fmt.Sprintf(">= %.1f%%", threshold)
+			gate.ToExecute = fmt.Sprintf("%.1f more confidence points, or a mode minimum of %.1f%% or lower", threshold-actual, actual)
+		}
+		return gate
+	case strings.HasPrefix(reason, RejectionLowRiskReward):
+		gate := SignalGateExplanation{Gate: "risk_reward", Detail: reason,
+			ToExecute: "A wider take profit or tighter stop loss, or a lower min_risk_reward"}
+		if _, err := fmt.Sscanf(reason, RejectionLowRiskReward+" (%f < %f)", &actual, &threshold); err == nil {
+			gate.Actual = fmt.Sprintf("%.2f", actual)
+			gate.Threshold = fmt.Sprintf(">= %.2f", threshold)
+			gate.ToExecute = fmt.Sprintf("Risk:reward of %.2f or better, or a mode min_risk_reward of %.2f or lower", threshold, actual)
+		}
+		return gate
+	case strings.HasPrefix(reason, RejectionSpreadTooWide):
+		gate := SignalGateExplanation{Gate: "spread", Detail: reason,
+			ToExecute: "A tighter bid/ask spread, or a higher max_spread_bps"}
+		if _, err := fmt.Sscanf(reason, RejectionSpreadTooWide+" (%f > %f bps)", &actual, &threshold); err == nil {
+			gate.Actual = fmt.Sprintf("%.1f bps", actual)
+			gate.Threshold = fmt.Sprintf("<= %.1f bps", threshold)
+			gate.ToExecute = fmt.Sprintf("Spread of %.1f bps or less, or a mode max_spread_bps of %.1f or higher", threshold, actual)
+		}
+		return gate
+	case strings.HasPrefix(reason, RejectionInsufficientVolume):
+		gate := SignalGateExplanation{Gate: "volume", Detail: reason,
+			ToExecute: "More trading volume, or a lower min_quote_volume_24h_usd / min_volume_ratio"}
+		if _, err := fmt.Sscanf(reason, RejectionInsufficientVolume+" (24h quote volume %f < %f)", &actual, &threshold); err == nil {
+			gate.Actual = fmt.Sprintf("$%.0f", actual)
+			gate.Threshold = fmt.Sprintf(">= $%.0f", threshold)
+			gate.ToExecute = fmt.Sprintf("24h quote volume of $%.0f or more, or a mode min_quote_volume_24h_usd of $%.0f or lower", threshold, actual)
+		} else if _, err := fmt.Sscanf(reason, RejectionInsufficientVolume+" (candle volume ratio %f < %f)", &actual, &threshold); err == nil {
+			gate.Actual = fmt.Sprintf("%.2fx average", actual)
+			gate.Threshold = fmt.Sprintf(">= %.2fx average", threshold)
+			gate.ToExecute = fmt.Sprintf("Candle volume of %.2fx its average or more, or a mode min_volume_ratio of %.2f or lower", threshold, actual)
+		}
+		return gate
+	case strings.HasPrefix(reason, RejectionBTCCorrelation):
+		gate := SignalGateExplanation{Gate: "btc_correlation", Detail: reason,
+			ToExecute: "A less BTC-correlated coin, no correlated position on the same side, or a higher max_btc_correlation"}
+		if _, err := fmt.Sscanf(reason, RejectionBTCCorrelation+" (%f > %f", &actual, &threshold); err == nil {
+			gate.Actual = fmt.Sprintf("%.2f", actual)
+			gate.Threshold = fmt.Sprintf("<= %.2f", threshold)
+		}
+		return gate
+	case strings.HasPrefix(reason, RejectionSymbolSentiment):
+		return SignalGateExplanation{Gate: "symbol_sentiment", Detail: reason,
+			ToExecute: "News on the coin less opposed to the trade direction, or a higher symbol_sentiment_block_score"}
+	case strings.HasPrefix(reason, "position_limit_reached"), strings.HasPrefix(reason, "outranked"):
+		return SignalGateExplanation{Gate: "position_limit", Detail: reason,
+			ToExecute: "A free position slot in this mode, or a higher-ranked signal"}
+	case reason == RejectionOutsideTradingHours:
+		return SignalGateExplanation{Gate: "schedule", Detail: "Signal arrived outside the mode's trading hours",
+			ToExecute: "The signal had to arrive inside the mode schedule, or the schedule widened"}
+	case strings.HasPrefix(reason, "coin_blocked"):
+		return SignalGateExplanation{Gate: "coin_blocked", Detail: reason,
+			ToExecute: "The coin had to be unblocked"}
+	case reason == "symbol_disabled":
+		return SignalGateExplanation{Gate: "symbol_disabled", Detail: "Symbol is disabled in per-symbol settings",
+			ToExecute: "Enable the symbol"}
+	case strings.HasPrefix(reason, "mode_circuit_breaker"):
+		return SignalGateExplanation{Gate: "circuit_breaker", Detail: reason,
+			ToExecute: "Wait for the mode circuit breaker to reset"}
+	case strings.HasPrefix(reason, "mtf_misaligned"):
+		return SignalGateExplanation{Gate: "mtf_alignment", Detail: reason,
+			ToExecute: "Higher timeframes had to align with the signal direction"}
+	case strings.HasPrefix(reason, "adaptive_throttle_paused"):
+		return SignalGateExplanation{Gate: "adaptive_throttle", Detail: reason,
+			ToExecute: "Wait for the adaptive throttle to resume trading"}
+	case strings.HasPrefix(reason, "execution_failed"):
+		return SignalGateExplanation{Gate: "execution", Detail: reason,
+			ToExecute: "Passed all gates - the order itself failed on the exchange"}
+	}
+
+	return SignalGateExplanation{Gate: "recommendation", Detail: reason,
+		ToExecute: "The analyzer had to recommend executing this signal"}
+}
+
+// formatTimeframeTrends renders a timeframe -> trend map in a stable order,
+// e.g. "15m bullish, 1h neutral, 4h bearish"
+func formatTimeframeTrends(trends map[string]string) string {
+	timeframes := make([]string, 0, len(trends))
+	for tf := range trends {
+		timeframes = append(timeframes, tf)
+	}
+	sort.Strings(timeframes)
+	parts := make([]string, len(timeframes))
+	for i, tf := range timeframes {
+		parts[i] = tf + " " + trends[tf]
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,105 @@
+package autopilot
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PositionSizingBreakdown records every step of the adaptive position sizing
+// formula so a sizing decision can be explained (or previewed) after the fact
+type PositionSizingBreakdown struct {
+	Symbol           string           `json:"symbol"`
+	Mode             GinieTradingMode `json:"mode"`
+	Side             string           `json:"side,omitempty"`
+	Confidence       float64          `json:"confidence"`
+	CurrentPositions int              `json:"current_positions"`
+
+	// Balance
+	AvailableBalance  float64 `json:"available_balance"`
+	SafetyMargin      float64 `json:"safety_margin"`
+	UsableBalance     float64 `json:"usable_balance"`
+	Leverage          int     `json:"leverage"`
+	AvailableNotional float64 `json:"available_notional"`
+
+	// Slots
+	MaxPositions   int `json:"max_positions"`
+	AvailableSlots int `json:"available_slots"`
+
+	// Multipliers
+	BaseSizeUSD          float64 `json:"base_size_usd"`
+	RiskLevel            string  `json:"risk_level"`
+	RiskMultiplier       float64 `json:"risk_multiplier"`
+	ConfidenceMultiplier float64 `json:"confidence_multiplier"`
+	ThrottleMultiplier   float64 `json:"throttle_multiplier"`
+	EquityMultiplier     float64 `json:"equity_multiplier"`
+	MarginRatio          float64 `json:"margin_ratio"`
+	MarginMultiplier     float64 `json:"margin_multiplier"`
+	AutoSizeEnabled      bool    `json:"auto_size_enabled"`
+	SizingMethod         string  `json:"sizing_method"` // "formula" or "ai_llm"
+	SymbolCategory       string  `json:"symbol_category,omitempty"`
+
+	// Caps
+	CalculatedUSD      float64 `json:"calculated_usd"`            // Before max cap, throttle, equity/margin scaling and min enforcement
+	CategoryMaxUSD     float64 `json:"category_max_usd"`          // Global max scaled by symbol category (or the symbol's max_position_usd)
+	ModeMaxUSD         float64 `json:"mode_max_usd,omitempty"`    // Mode max_size_usd, replaces the category cap when set
+	SymbolCapUSD       float64 `json:"symbol_cap_usd,omitempty"`  // Per-symbol hard ceiling
+	MaxSizeUSD         float64 `json:"max_size_usd"`              // Effective cap
+	MaxSizeSource      string  `json:"max_size_source,omitempty"` // "category", "mode" or "symbol_cap"
+	MinPositionSizeUSD float64 `json:"min_position_size_usd"`
+	MinSizeEnforced    bool    `json:"min_size_enforced"`
+
+	// Post-sizing adjustments (preview only)
+	AllocationAllowed bool    `json:"allocation_allowed"`
+	AllocationReason  string  `json:"allocation_reason,omitempty"`
+	FundingAdjusted   bool    `json:"funding_adjusted"`
+	Price             float64 `json:"price,omitempty"`
+	Quantity          float64 `json:"quantity,omitempty"`
+
+	// Result
+	PositionUSD   float64 `json:"position_usd"`
+	CanTrade      bool    `json:"can_trade"`
+	RejectReason  string  `json:"reject_reason,omitempty"`
+	Misconfigured bool    `json:"-"`
+}
+
+// PreviewPositionSize runs the same sizing path as a live entry - adaptive
+// sizing, capital allocation check, funding adjustment and quantity rounding -
+// for a hypothetical trade without placing any order. riskLevel overrides the
+// current risk level when set.
+func (ga *GinieAutopilot) PreviewPositionSize(symbol string, confidence float64, positionCount int, mode GinieTradingMode, side, riskLevel string) (*PositionSizingBreakdown, error) {
+	if ga.futuresClient == nil {
+		return nil, fmt.Errorf("futures client not initialized")
+	}
+	if riskLevel == "" {
+		riskLevel = ga.GetRiskLevel()
+	}
+
+	s := ga.sizePosition(symbol, confidence, positionCount, mode, 0, riskLevel)
+	s.Side = strings.ToUpper(side)
+	if !s.CanTrade {
+		return s, nil
+	}
+
+	s.AllocationAllowed, s.AllocationReason = ga.canAllocateForMode(mode, s.PositionUSD)
+	if !s.AllocationAllowed {
+		s.CanTrade = false
+		s.RejectReason = fmt.Sprintf("allocation_limit: %s", s.AllocationReason)
+		return s, nil
+	}
+
+	if s.Side != "" {
+		adjusted := ga.adjustSizeForFunding(symbol, s.PositionUSD, s.Side == "LONG", mode)
+		s.FundingAdjusted = adjusted != s.PositionUSD
+		s.PositionUSD = adjusted
+	}
+
+	price, err := ga.futuresClient.GetFuturesCurrentPrice(symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get price for %s: %w", symbol, err)
+	}
+	if price > 0 {
+		s.Price = price
+		s.Quantity = roundQuantity(symbol, s.PositionUSD/price)
+	}
+	return s, nil
+}
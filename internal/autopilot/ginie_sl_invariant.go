@@ -0,0 +1,50 @@
+package autopilot
+
+import (
+	"log"
+)
+
+// RejectionSLWorsening is the SLUpdateRecord.RejectionRule for a stop loss move
+// refused because it would widen the loss on the position
+const RejectionSLWorsening = "sl_worsening_rejected"
+
+// slMoveWorsens reports whether moving a stop from currentSL to newSL moves it
+// against the position: lower for a LONG, higher for a SHORT. A currentSL of 0
+// means no stop has been placed yet, so initial placement never worsens.
+func slMoveWorsens(side string, currentSL, newSL float64) bool {
+	if currentSL <= 0 || newSL <= 0 {
+		return false
+	}
+	if side == "SHORT" {
+		return newSL > currentSL
+	}
+	return newSL < currentSL
+}
+
+// rejectSLWorsening enforces the "never move SL against the position" invariant
+// for a stop about to be sent to the exchange. newSL is compared with the stop
+// last placed on the exchange (pos.PlacedSL); a worsening move is logged,
+// recorded as sl_worsening_rejected and pos.StopLoss is restored to the placed
+// stop. Returns true when the move was rejected.
+//
+// SL order paths run both with and without ga.mu held, so the rejection is
+// recorded from a goroutine.
+func (ga *GinieAutopilot) rejectSLWorsening(pos *GiniePosition, newSL float64, source string) bool {
+	placedSL := pos.PlacedSL
+	if !slMoveWorsens(pos.Side, placedSL, newSL) {
+		return false
+	}
+	pos.StopLoss = placedSL
+
+	log.Printf("[SL-INVARIANT] %s %s: rejected SL move %.6f -> %.6f (source=%s) - keeping %.6f",
+		pos.Symbol, pos.Side, placedSL, newSL, source, placedSL)
+	ga.logger.Warn("SL move against position rejected",
+		"symbol", pos.Symbol,
+		"side", pos.Side,
+		"placed_sl", placedSL,
+		"rejected_sl", newSL,
+		"source", source)
+
+	go ga.RecordSLUpdate(pos.Symbol, placedSL, newSL, 0, "rejected", RejectionSLWorsening, source, 0)
+	return true
+}
@@ -0,0 +1,83 @@
+package autopilot
+
+import (
+	"testing"
+	"time"
+
+	"binance-trading-bot/internal/logging"
+)
+
+func TestSLMoveWorsens(t *testing.T) {
+	tests := []struct {
+		name      string
+		side      string
+		currentSL float64
+		newSL     float64
+		want      bool
+	}{
+		{"LONG initial placement", "LONG", 0, 95, false},
+		{"LONG tighten", "LONG", 95, 97, false},
+		{"LONG unchanged", "LONG", 95, 95, false},
+		{"LONG widen", "LONG", 95, 93, true},
+		{"SHORT initial placement", "SHORT", 0, 105, false},
+		{"SHORT tighten", "SHORT", 105, 103, false},
+		{"SHORT unchanged", "SHORT", 105, 105, false},
+		{"SHORT widen", "SHORT", 105, 107, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slMoveWorsens(tt.side, tt.currentSL, tt.newSL); got != tt.want {
+				t.Errorf("slMoveWorsens(%s, %.2f, %.2f) = %v, want %v",
+					tt.side, tt.currentSL, tt.newSL, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRejectSLWorsening(t *testing.T) {
+	ga := &GinieAutopilot{
+		logger:          logging.Default(),
+		slUpdateHistory: make(map[string]*SLUpdateHistory),
+	}
+
+	pos := &GiniePosition{Symbol: "BTCUSDT", Side: "LONG", StopLoss: 97, PlacedSL: 95}
+	if ga.rejectSLWorsening(pos, pos.StopLoss, "trailing") {
+		t.Fatal("tightening a LONG stop was rejected")
+	}
+	if pos.StopLoss != 97 {
+		t.Errorf("accepted move changed StopLoss to %.2f", pos.StopLoss)
+	}
+
+	pos.StopLoss = 90
+	if !ga.rejectSLWorsening(pos, pos.StopLoss, "llm") {
+		t.Fatal("widening a LONG stop was not rejected")
+	}
+	if pos.StopLoss != 95 {
+		t.Errorf("StopLoss = %.2f after rejection, want placed SL 95", pos.StopLoss)
+	}
+
+	// The rejection is recorded asynchronously
+	deadline := time.Now().Add(time.Second)
+	for {
+		history := ga.GetSLUpdateHistory("BTCUSDT")
+		if history != nil && len(history.Updates) == 1 {
+			record := history.Updates[0]
+			if record.Status != "rejected" || record.RejectionRule != RejectionSLWorsening ||
+				record.OldSL != 95 || record.NewSL != 90 || record.Source != "llm" {
+				t.Errorf("recorded %+v, want rejected %s 95 -> 90 from llm", record, RejectionSLWorsening)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("rejected SL move was not recorded")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	// No stop placed yet: initial placement is always allowed
+	fresh := &GiniePosition{Symbol: "ETHUSDT", Side: "SHORT", StopLoss: 3100}
+	if ga.rejectSLWorsening(fresh, fresh.StopLoss, "place_sl") {
+		t.Error("initial SL placement was rejected")
+	}
+}
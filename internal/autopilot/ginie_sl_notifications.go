@@ -0,0 +1,157 @@
+package autopilot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SL update notification levels (GinieAutopilotConfig.SLUpdateNotifications)
+const (
+	SLNotifyOff     = "off"     // History only, no notifications
+	SLNotifySummary = "summary" // One digest per slUpdateDigestInterval
+	SLNotifyAll     = "all"     // Notify on every applied SL change
+)
+
+// slUpdateDigestInterval is how often the summary-level digest is sent
+const slUpdateDigestInterval = time.Hour
+
+// slUpdateDigestEntry aggregates one symbol's applied SL changes for the digest
+type slUpdateDigestEntry struct {
+	FirstSL  float64
+	LatestSL float64
+	Sources  map[string]int
+}
+
+// recordSLUpdateLocked appends an SL update to the symbol's history and
+// notifies per SLUpdateNotifications when it was applied. Caller must hold ga.mu.
+func (ga *GinieAutopilot) recordSLUpdateLocked(symbol string, oldSL, newSL, currentPrice float64, status, rejectionRule, source string, llmConfidence float64) {
+	// Initialize history if needed
+	if ga.slUpdateHistory[symbol] == nil {
+		ga.slUpdateHistory[symbol] = &SLUpdateHistory{
+			Symbol:  symbol,
+			Updates: make([]SLUpdateRecord, 0, 100),
+		}
+	}
+
+	history := ga.slUpdateHistory[symbol]
+
+	// Add record
+	record := SLUpdateRecord{
+		Timestamp:     time.Now(),
+		OldSL:         oldSL,
+		NewSL:         newSL,
+		CurrentPrice:  currentPrice,
+		Status:        status,
+		RejectionRule: rejectionRule,
+		Source:        source,
+		LLMConfidence: llmConfidence,
+	}
+
+	history.Updates = append(history.Updates, record)
+	history.TotalAttempts++
+
+	if status == "applied" {
+		history.Applied++
+		ga.notifySLUpdateLocked(symbol, record)
+	} else {
+		history.Rejected++
+	}
+
+	// Trim if over limit (keep last 100 updates per symbol)
+	if len(history.Updates) > 100 {
+		history.Updates = history.Updates[len(history.Updates)-100:]
+	}
+}
+
+// notifySLUpdateLocked sends an applied SL change right away ("all") or adds it
+// to the next digest ("summary"). Caller must hold ga.mu.
+func (ga *GinieAutopilot) notifySLUpdateLocked(symbol string, record SLUpdateRecord) {
+	if ga.alertNotifier == nil {
+		return
+	}
+
+	switch ga.config.SLUpdateNotifications {
+	case SLNotifyAll:
+		notifier := ga.alertNotifier
+		title := fmt.Sprintf("%s SL update (%s)", symbol, record.Source)
+		msg := fmt.Sprintf("%s stop loss moved %.6f -> %.6f by %s", symbol, record.OldSL, record.NewSL, record.Source)
+		if record.CurrentPrice > 0 {
+			msg += fmt.Sprintf(" at price %.6f", record.CurrentPrice)
+		}
+		if record.Source == "llm" && record.LLMConfidence > 0 {
+			msg += fmt.Sprintf(" (LLM confidence %.0f%%)", record.LLMConfidence*100)
+		}
+		go func() {
+			if err := notifier.SendInfo(title, msg); err != nil {
+				ga.logger.Warn("Failed to send SL update notification", "symbol", symbol, "error", err)
+			}
+		}()
+	case SLNotifySummary:
+		if ga.slUpdateDigest == nil {
+			ga.slUpdateDigest = make(map[string]*slUpdateDigestEntry)
+		}
+		entry := ga.slUpdateDigest[symbol]
+		if entry == nil {
+			entry = &slUpdateDigestEntry{FirstSL: record.OldSL, Sources: make(map[string]int)}
+			ga.slUpdateDigest[symbol] = entry
+		}
+		entry.LatestSL = record.NewSL
+		entry.Sources[record.Source]++
+	}
+}
+
+// runSLUpdateDigest sends the summary-level SL update digest every interval
+func (ga *GinieAutopilot) runSLUpdateDigest() {
+	defer ga.wg.Done()
+
+	ticker := time.NewTicker(slUpdateDigestInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ga.stopChan:
+			return
+		case <-ticker.C:
+			ga.flushSLUpdateDigest()
+		}
+	}
+}
+
+// flushSLUpdateDigest sends and clears the pending SL update digest
+func (ga *GinieAutopilot) flushSLUpdateDigest() {
+	ga.mu.Lock()
+	digest := ga.slUpdateDigest
+	ga.slUpdateDigest = nil
+	notifier := ga.alertNotifier
+	ga.mu.Unlock()
+
+	if len(digest) == 0 || notifier == nil {
+		return
+	}
+
+	symbols := make([]string, 0, len(digest))
+	for symbol := range digest {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+
+	var b strings.Builder
+	total := 0
+	for _, symbol := range symbols {
+		entry := digest[symbol]
+		sources := make([]string, 0, len(entry.Sources))
+		for source, n := range entry.Sources {
+			sources = append(sources, fmt.Sprintf("%d %s", n, source))
+			total += n
+		}
+		sort.Strings(sources)
+		fmt.Fprintf(&b, "%s: SL %.6f -> %.6f (%s)\n", symbol, entry.FirstSL, entry.LatestSL, strings.Join(sources, ", "))
+	}
+
+	title := fmt.Sprintf("SL update summary: %d changes on %d symbols", total, len(symbols))
+	if err := notifier.SendInfo(title, b.String()); err != nil {
+		ga.logger.Warn("Failed to send SL update digest", "error", err)
+	}
+}
@@ -0,0 +1,75 @@
+package autopilot
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"time"
+)
+
+// scanCandidate is a signal that passed every filter in a scan cycle and is
+// waiting for a free position slot
+type scanCandidate struct {
+	symbol            string
+	decision          *GinieDecisionReport
+	signalLog         *GinieSignalLog
+	generatedAt       time.Time
+	decayedConfidence float64
+}
+
+// decayedConfidence halves confidence every halfLife of signal age (0 disables decay)
+func decayedConfidence(confidence float64, age, halfLife time.Duration) float64 {
+	if halfLife <= 0 || age <= 0 {
+		return confidence
+	}
+	return confidence * math.Pow(0.5, age.Seconds()/halfLife.Seconds())
+}
+
+// rankScanCandidates orders candidates for slot allocation when there are more
+// qualifying signals than free slots. Signals generated early in a long scan
+// lose priority to fresh ones: highest decayed confidence wins, then best RR.
+// Each candidate's rank is recorded on its signal log.
+func (ga *GinieAutopilot) rankScanCandidates(candidates []*scanCandidate, now time.Time) {
+	halfLife := time.Duration(ga.config.ConfidenceDecayHalfLifeSeconds) * time.Second
+	for _, c := range candidates {
+		c.decayedConfidence = decayedConfidence(c.decision.ConfidenceScore, now.Sub(c.generatedAt), halfLife)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].decayedConfidence != candidates[j].decayedConfidence {
+			return candidates[i].decayedConfidence > candidates[j].decayedConfidence
+		}
+		return candidates[i].decision.TradeExecution.RiskReward > candidates[j].decision.TradeExecution.RiskReward
+	})
+	for rank, c := range candidates {
+		c.signalLog.CycleRank = rank + 1
+		c.signalLog.CycleCandidates = len(candidates)
+	}
+}
+
+// capTopRankedCandidates keeps only the mode's top MaxEntriesPerCycle signals
+// for this scan cycle, ranked like slot allocation. Unlike the slot limit it
+// applies even when slots are free, so capital goes to the best setups rather
+// than the first ones scanned. The rest are logged as not_top_ranked.
+func (ga *GinieAutopilot) capTopRankedCandidates(mode GinieTradingMode, candidates []*scanCandidate, now time.Time) []*scanCandidate {
+	modeConfig := ga.getModeConfigForSizing(mode)
+	if modeConfig == nil || modeConfig.Size == nil || modeConfig.Size.MaxEntriesPerCycle <= 0 {
+		return candidates
+	}
+	topN := modeConfig.Size.MaxEntriesPerCycle
+
+	ga.rankScanCandidates(candidates, now)
+	if len(candidates) <= topN {
+		return candidates
+	}
+
+	for rank, c := range candidates[topN:] {
+		c.signalLog.Status = "rejected"
+		c.signalLog.RejectionReason = fmt.Sprintf("%s: rank %d/%d, confidence %.1f%%, RR %.2f (top %d per cycle)",
+			RejectionNotTopRanked, topN+rank+1, len(candidates), c.decayedConfidence, c.decision.TradeExecution.RiskReward, topN)
+		ga.LogSignal(c.signalLog)
+	}
+	log.Printf("[%s-SCAN] %d qualifying signals, executing top %d by confidence and RR, %d not top ranked",
+		mode, len(candidates), topN, len(candidates)-topN)
+	return candidates[:topN]
+}
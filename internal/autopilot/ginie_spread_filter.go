@@ -0,0 +1,51 @@
+package autopilot
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+)
+
+// RejectionSpreadTooWide is the skip reason for signals whose bid/ask spread exceeds the mode's max_spread_bps
+const RejectionSpreadTooWide = "spread_too_wide"
+
+// currentSpreadBps fetches the best bid/ask and returns the spread in basis
+// points of the mid price
+func (ga *GinieAutopilot) currentSpreadBps(symbol string) (float64, error) {
+	book, err := ga.futuresClient.GetOrderBookDepth(symbol, 5)
+	if err != nil {
+		return 0, err
+	}
+	if len(book.Bids) == 0 || len(book.Bids[0]) == 0 || len(book.Asks) == 0 || len(book.Asks[0]) == 0 {
+		return 0, fmt.Errorf("empty order book")
+	}
+	bid, err := strconv.ParseFloat(book.Bids[0][0], 64)
+	if err != nil || bid <= 0 {
+		return 0, fmt.Errorf("invalid best bid %q", book.Bids[0][0])
+	}
+	ask, err := strconv.ParseFloat(book.Asks[0][0], 64)
+	if err != nil || ask < bid {
+		return 0, fmt.Errorf("invalid best ask %q", book.Asks[0][0])
+	}
+	return (ask - bid) / ((ask + bid) / 2) * 10000, nil
+}
+
+// spreadRejection records the current spread on the signal log and returns a
+// rejection reason when it exceeds the mode's entry.max_spread_bps (0 = no limit).
+// A failed book fetch doesn't block the trade.
+func (ga *GinieAutopilot) spreadRejection(symbol string, modeConfig *ModeFullConfig, signalLog *GinieSignalLog) (string, bool) {
+	spreadBps, err := ga.currentSpreadBps(symbol)
+	if err != nil {
+		log.Printf("[SPREAD] %s: Could not fetch bid/ask, skipping spread check: %v", symbol, err)
+		return "", false
+	}
+	signalLog.SpreadBps = spreadBps
+
+	if modeConfig == nil || modeConfig.Entry == nil || modeConfig.Entry.MaxSpreadBps <= 0 {
+		return "", false
+	}
+	if spreadBps > modeConfig.Entry.MaxSpreadBps {
+		return fmt.Sprintf("%s (%.1f > %.1f bps)", RejectionSpreadTooWide, spreadBps, modeConfig.Entry.MaxSpreadBps), true
+	}
+	return "", false
+}
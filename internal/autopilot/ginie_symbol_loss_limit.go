@@ -0,0 +1,85 @@
+package autopilot
+
+import (
+	"fmt"
+	"time"
+)
+
+// SymbolDailyLossDiagnostics shows the per-symbol losing trade count for the
+// current trading day against MaxDailyLossesPerSymbol
+type SymbolDailyLossDiagnostics struct {
+	MaxPerSymbol int            `json:"max_per_symbol"` // 0 = disabled
+	Losses       map[string]int `json:"losses"`
+	NextReset    time.Time      `json:"next_reset"`
+}
+
+// recordSymbolDailyLoss counts a losing trade for symbol in the current trading
+// day. Once the count reaches MaxDailyLossesPerSymbol the symbol is blocked
+// until the next daily reset, catching a coin that bleeds through many small
+// losses the big-loss block never sees. An existing block is left as is.
+func (ga *GinieAutopilot) recordSymbolDailyLoss(symbol string, pnl, pnlPercent float64, consecLosses int) {
+	if ga.symbolDailyLosses == nil {
+		ga.symbolDailyLosses = make(map[string]int)
+	}
+	ga.symbolDailyLosses[symbol]++
+	losses := ga.symbolDailyLosses[symbol]
+
+	maxLosses := ga.config.MaxDailyLossesPerSymbol
+	if maxLosses <= 0 || losses < maxLosses {
+		return
+	}
+	if _, blocked := ga.blockedCoins[symbol]; blocked {
+		return
+	}
+
+	blockInfo := &CoinBlockInfo{
+		Symbol:       symbol,
+		BlockReason:  fmt.Sprintf("%d losing trades today (max %d per symbol)", losses, maxLosses),
+		BlockTime:    time.Now(),
+		LossAmount:   pnl,
+		LossROI:      pnlPercent,
+		ConsecLosses: consecLosses,
+		AutoUnblock:  ga.config.NextDailyReset(time.Now()),
+		BlockCount:   ga.coinBlockHistory[symbol],
+		DailyLosses:  losses,
+		DailyLimit:   true,
+	}
+	ga.blockedCoins[symbol] = blockInfo
+
+	ga.logger.Warn("Ginie BLOCKING coin for the rest of the day (daily losing trade limit)",
+		"symbol", symbol,
+		"daily_losses", losses,
+		"max_daily_losses", maxLosses,
+		"auto_unblock", blockInfo.AutoUnblock.Format(time.RFC3339))
+}
+
+// resetSymbolDailyLossesLocked clears the per-symbol losing trade counts and
+// lifts the blocks they caused. Caller must hold ga.mu.
+func (ga *GinieAutopilot) resetSymbolDailyLossesLocked() {
+	ga.symbolDailyLosses = make(map[string]int)
+	for symbol, info := range ga.blockedCoins {
+		if info.DailyLimit {
+			delete(ga.blockedCoins, symbol)
+		}
+	}
+}
+
+// getSymbolDailyLossDiagnosticsLocked returns today's losing trade counts. Caller must hold ga.mu.
+func (ga *GinieAutopilot) getSymbolDailyLossDiagnosticsLocked() SymbolDailyLossDiagnostics {
+	diag := SymbolDailyLossDiagnostics{
+		MaxPerSymbol: ga.config.MaxDailyLossesPerSymbol,
+		Losses:       make(map[string]int, len(ga.symbolDailyLosses)),
+		NextReset:    ga.config.NextDailyReset(time.Now()),
+	}
+	for symbol, losses := range ga.symbolDailyLosses {
+		diag.Losses[symbol] = losses
+	}
+	return diag
+}
+
+// GetSymbolDailyLosses returns today's per-symbol losing trade counts
+func (ga *GinieAutopilot) GetSymbolDailyLosses() SymbolDailyLossDiagnostics {
+	ga.mu.RLock()
+	defer ga.mu.RUnlock()
+	return ga.getSymbolDailyLossDiagnosticsLocked()
+}
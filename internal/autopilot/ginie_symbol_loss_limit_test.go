@@ -0,0 +1,56 @@
+package autopilot
+
+import (
+	"testing"
+	"time"
+
+	"binance-trading-bot/internal/logging"
+)
+
+func TestSymbolDailyLossLimit(t *testing.T) {
+	config := DefaultGinieAutopilotConfig()
+	config.MaxDailyLossesPerSymbol = 3
+	ga := &GinieAutopilot{
+		config:       config,
+		logger:       logging.Default(),
+		blockedCoins: make(map[string]*CoinBlockInfo),
+		dayStart:     config.TradingDayStart(time.Now()),
+	}
+
+	// Small losses never trip the big-loss block, only the daily count
+	for i := 0; i < 2; i++ {
+		ga.updateCoinLossTracking("BTCUSDT", -1, -2)
+	}
+	if blocked, _ := ga.isCoinBlocked("BTCUSDT"); blocked {
+		t.Fatal("symbol blocked before reaching the daily limit")
+	}
+
+	ga.updateCoinLossTracking("BTCUSDT", -1, -2)
+	info := ga.blockedCoins["BTCUSDT"]
+	if info == nil || !info.DailyLimit || info.DailyLosses != 3 || info.ManualOnly {
+		t.Fatalf("block after 3 losses = %+v, want daily-limit block", info)
+	}
+	if !info.AutoUnblock.Equal(config.NextDailyReset(time.Now())) {
+		t.Errorf("auto unblock at %v, want next daily reset", info.AutoUnblock)
+	}
+
+	// A win resets the consecutive count but not the daily one
+	ga.updateCoinLossTracking("ETHUSDT", -1, -2)
+	ga.updateCoinLossTracking("ETHUSDT", 5, 10)
+	if got := ga.symbolDailyLosses["ETHUSDT"]; got != 1 {
+		t.Errorf("ETHUSDT daily losses = %d, want 1", got)
+	}
+
+	// The next trading day clears the counts and lifts only daily-limit blocks
+	ga.blockedCoins["SOLUSDT"] = &CoinBlockInfo{Symbol: "SOLUSDT", ManualOnly: true}
+	ga.rollTradingDayLocked(time.Now().Add(25 * time.Hour))
+	if _, ok := ga.blockedCoins["BTCUSDT"]; ok {
+		t.Error("daily-limit block survived the daily reset")
+	}
+	if _, ok := ga.blockedCoins["SOLUSDT"]; !ok {
+		t.Error("daily reset lifted a manual block")
+	}
+	if len(ga.symbolDailyLosses) != 0 {
+		t.Errorf("daily losses after reset = %v", ga.symbolDailyLosses)
+	}
+}
@@ -0,0 +1,59 @@
+package autopilot
+
+import (
+	"fmt"
+	"time"
+
+	"binance-trading-bot/internal/ai/sentiment"
+)
+
+// RejectionSymbolSentiment is the skip reason when the coin's own sentiment
+// opposes the trade direction
+const RejectionSymbolSentiment = "symbol_sentiment"
+
+// SetSentimentAnalyzer sets the analyzer used for the per-symbol sentiment gate
+func (ga *GinieAutopilot) SetSentimentAnalyzer(analyzer *sentiment.Analyzer) {
+	ga.sentimentAnalyzer.Store(analyzer)
+	if analyzer != nil {
+		ga.logger.Info("Sentiment analyzer set for Ginie symbol sentiment gate")
+	}
+}
+
+// symbolSentimentRejection records the coin's sentiment on the signal log and
+// returns a rejection reason when it opposes the signal direction by at least
+// SymbolSentimentBlockScore. Symbols with too little or too old news pass.
+func (ga *GinieAutopilot) symbolSentimentRejection(symbol string, signalLog *GinieSignalLog) (string, bool) {
+	ga.mu.RLock()
+	enabled := ga.config.SymbolSentimentGateEnabled
+	blockScore := ga.config.SymbolSentimentBlockScore
+	minNews := ga.config.SymbolSentimentMinNews
+	maxAge := time.Duration(ga.config.SymbolSentimentMaxAgeMinutes) * time.Minute
+	ga.mu.RUnlock()
+
+	analyzer := ga.sentimentAnalyzer.Load()
+	if !enabled || blockScore <= 0 || analyzer == nil {
+		return "", false
+	}
+
+	s := analyzer.GetSymbolSentiment(symbol)
+	if !s.HasData() || s.NewsCount < minNews {
+		return "", false
+	}
+	if maxAge > 0 && s.NewsCount > 0 && s.Freshness() > maxAge {
+		return "", false
+	}
+	score := s.Score
+	signalLog.SymbolSentiment = &score
+
+	switch signalLog.Direction {
+	case "LONG":
+		if score <= -blockScore {
+			return fmt.Sprintf("%s (%s sentiment %.2f <= -%.2f from %d news)", RejectionSymbolSentiment, s.Ticker, score, blockScore, s.NewsCount), true
+		}
+	case "SHORT":
+		if score >= blockScore {
+			return fmt.Sprintf("%s (%s sentiment %.2f >= %.2f from %d news)", RejectionSymbolSentiment, s.Ticker, score, blockScore, s.NewsCount), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,62 @@
+package autopilot
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"binance-trading-bot/internal/ai/sentiment"
+	"binance-trading-bot/internal/logging"
+)
+
+type stubSymbolSentimentSource struct {
+	score float64
+}
+
+func (s *stubSymbolSentimentSource) Name() string    { return "stub" }
+func (s *stubSymbolSentimentSource) Weight() float64 { return 1 }
+func (s *stubSymbolSentimentSource) Fetch(ctx context.Context) (*sentiment.SourceReading, error) {
+	return &sentiment.SourceReading{Score: s.score}, nil
+}
+func (s *stubSymbolSentimentSource) FetchSymbol(ctx context.Context, ticker string) (*sentiment.SourceReading, error) {
+	return &sentiment.SourceReading{Score: s.score}, nil
+}
+
+func TestSymbolSentimentRejection(t *testing.T) {
+	analyzer := sentiment.NewAnalyzer(&sentiment.SentimentConfig{Enabled: true})
+	analyzer.AddSource(&stubSymbolSentimentSource{score: -0.6})
+
+	ga := &GinieAutopilot{
+		logger: logging.Default(),
+		config: &GinieAutopilotConfig{
+			SymbolSentimentGateEnabled: true,
+			SymbolSentimentBlockScore:  0.4,
+		},
+	}
+
+	// No analyzer: the gate is a no-op
+	if _, rejected := ga.symbolSentimentRejection("BTCUSDT", &GinieSignalLog{Direction: "LONG"}); rejected {
+		t.Fatal("rejected without a sentiment analyzer")
+	}
+
+	ga.SetSentimentAnalyzer(analyzer)
+
+	long := &GinieSignalLog{Direction: "LONG"}
+	reason, rejected := ga.symbolSentimentRejection("BTCUSDT", long)
+	if !rejected || !strings.HasPrefix(reason, RejectionSymbolSentiment) {
+		t.Errorf("LONG against -0.6 sentiment: rejected=%v reason=%q", rejected, reason)
+	}
+	if long.SymbolSentiment == nil || *long.SymbolSentiment != -0.6 {
+		t.Errorf("signal log sentiment = %v, want -0.6", long.SymbolSentiment)
+	}
+
+	if _, rejected := ga.symbolSentimentRejection("BTCUSDT", &GinieSignalLog{Direction: "SHORT"}); rejected {
+		t.Error("SHORT with bearish sentiment was rejected")
+	}
+
+	// Too few news items for the gate to act
+	ga.config.SymbolSentimentMinNews = 3
+	if _, rejected := ga.symbolSentimentRejection("BTCUSDT", &GinieSignalLog{Direction: "LONG"}); rejected {
+		t.Error("rejected with fewer news items than symbol_sentiment_min_news")
+	}
+}
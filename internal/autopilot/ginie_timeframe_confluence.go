@@ -0,0 +1,133 @@
+package autopilot
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TimeframeConfluence is the result of checking a trade direction against the
+// trend on each of a mode's confluence timeframes
+type TimeframeConfluence struct {
+	Direction  string            // Trade direction checked: long or short
+	Timeframes []string          // Timeframes checked, in configured order
+	Trends     map[string]string // Trend per timeframe: bullish, bearish, neutral or unavailable
+	Agreeing   int               // Timeframes whose trend matches the direction
+	Required   int               // Timeframes that had to agree
+	Passed     bool
+}
+
+// Opposed reports whether any timeframe trends against the direction, as
+// opposed to merely being neutral or unavailable
+func (c *TimeframeConfluence) Opposed() bool {
+	against := "bearish"
+	if c.Direction == "short" {
+		against = "bullish"
+	}
+	for _, trend := range c.Trends {
+		if trend == against {
+			return true
+		}
+	}
+	return false
+}
+
+// Dissent returns the first timeframe, in configured order, whose trend does
+// not agree with the direction
+func (c *TimeframeConfluence) Dissent() (timeframe, trend string) {
+	want := confluenceTrendFor(c.Direction)
+	for _, tf := range c.Timeframes {
+		if c.Trends[tf] != want {
+			return tf, c.Trends[tf]
+		}
+	}
+	return "", ""
+}
+
+// Summary lists each timeframe's trend, e.g. "15m bullish, 1h neutral, 4h bearish"
+func (c *TimeframeConfluence) Summary() string {
+	parts := make([]string, 0, len(c.Timeframes))
+	for _, tf := range c.Timeframes {
+		parts = append(parts, tf+" "+c.Trends[tf])
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Reason explains a failed confluence check
+func (c *TimeframeConfluence) Reason() string {
+	return fmt.Sprintf("Only %d/%d timeframes agree with %s (need %d): %s",
+		c.Agreeing, len(c.Timeframes), strings.ToUpper(c.Direction), c.Required, c.Summary())
+}
+
+// confluenceTrendFor maps a trade direction to the trend that confirms it
+func confluenceTrendFor(direction string) string {
+	if direction == "short" {
+		return "bearish"
+	}
+	return "bullish"
+}
+
+// evaluateTimeframeConfluence counts the timeframes whose trend agrees with
+// direction. required <= 0 or above the number of timeframes means all must agree.
+func evaluateTimeframeConfluence(direction string, timeframes []string, trends map[string]string, required int) *TimeframeConfluence {
+	if required <= 0 || required > len(timeframes) {
+		required = len(timeframes)
+	}
+	c := &TimeframeConfluence{
+		Direction:  direction,
+		Timeframes: timeframes,
+		Trends:     make(map[string]string, len(timeframes)),
+		Required:   required,
+	}
+	want := confluenceTrendFor(direction)
+	for _, tf := range timeframes {
+		trend := trends[tf]
+		if trend == "" {
+			trend = "unavailable"
+		}
+		c.Trends[tf] = trend
+		if trend == want {
+			c.Agreeing++
+		}
+	}
+	c.Passed = c.Agreeing >= c.Required
+	return c
+}
+
+// checkTimeframeConfluence analyzes the trend on each confluence timeframe of
+// the mode and checks it against the signal direction. Trends already computed
+// for this decision are passed in known and reused. Returns nil when the
+// filter is off or the direction is neutral. A timeframe that cannot be
+// analyzed counts as not agreeing.
+func (g *GinieAnalyzer) checkTimeframeConfluence(symbol, direction string, config *ModeTrendDivergenceConfig, known ...TrendHealth) *TimeframeConfluence {
+	if config == nil || !config.RequireConfluence || len(config.ConfluenceTimeframes) == 0 {
+		return nil
+	}
+	if direction != "long" && direction != "short" {
+		return nil
+	}
+
+	trends := make(map[string]string, len(config.ConfluenceTimeframes))
+	for _, th := range known {
+		if th.Timeframe != "" {
+			trends[th.Timeframe] = th.TrendDirection
+		}
+	}
+	for _, tf := range config.ConfluenceTimeframes {
+		if _, ok := trends[tf]; ok {
+			continue
+		}
+		klines, err := g.futuresClient.GetFuturesKlines(symbol, tf, 200)
+		if err != nil || len(klines) < 50 {
+			if g.logger != nil {
+				g.logger.Warn("Confluence timeframe unavailable, counting it as not agreeing",
+					"symbol", symbol,
+					"timeframe", tf,
+					"klines", len(klines))
+			}
+			continue
+		}
+		trends[tf] = g.analyzeTrend(klines, tf).TrendDirection
+	}
+
+	return evaluateTimeframeConfluence(direction, config.ConfluenceTimeframes, trends, config.MinTimeframesAgreeing)
+}
@@ -0,0 +1,90 @@
+package autopilot
+
+import (
+	"testing"
+)
+
+func TestEvaluateTimeframeConfluence(t *testing.T) {
+	timeframes := []string{"15m", "1h", "4h"}
+	trends := map[string]string{"15m": "bullish", "1h": "bullish", "4h": "bearish"}
+
+	c := evaluateTimeframeConfluence("long", timeframes, trends, 2)
+	if !c.Passed || c.Agreeing != 2 || c.Required != 2 {
+		t.Errorf("long with 2/3 bullish = %+v, want passed 2/2", c)
+	}
+	if !c.Opposed() {
+		t.Error("bearish 4h not reported as opposing a long")
+	}
+
+	c = evaluateTimeframeConfluence("long", timeframes, trends, 0)
+	if c.Passed || c.Required != 3 {
+		t.Errorf("long needing all = %+v, want blocked with 3 required", c)
+	}
+	if tf, trend := c.Dissent(); tf != "4h" || trend != "bearish" {
+		t.Errorf("dissent = %s %s, want 4h bearish", tf, trend)
+	}
+
+	c = evaluateTimeframeConfluence("short", timeframes, trends, 2)
+	if c.Passed || c.Agreeing != 1 {
+		t.Errorf("short with 1/3 bearish = %+v, want blocked", c)
+	}
+
+	c = evaluateTimeframeConfluence("short", timeframes, map[string]string{"15m": "bearish", "1h": "neutral"}, 2)
+	if c.Passed || c.Opposed() || c.Trends["4h"] != "unavailable" {
+		t.Errorf("short with neutral 1h and missing 4h = %+v, want blocked, not opposed, 4h unavailable", c)
+	}
+}
+
+func TestCheckTimeframeConfluenceSkips(t *testing.T) {
+	g := &GinieAnalyzer{}
+	known := []TrendHealth{{Timeframe: "15m", TrendDirection: "bearish"}, {Timeframe: "1h", TrendDirection: "bearish"}}
+	config := &ModeTrendDivergenceConfig{ConfluenceTimeframes: []string{"15m", "1h"}, MinTimeframesAgreeing: 2}
+
+	if c := g.checkTimeframeConfluence("BTCUSDT", "long", config, known...); c != nil {
+		t.Errorf("confluence checked with require_confluence off: %+v", c)
+	}
+
+	config.RequireConfluence = true
+	if c := g.checkTimeframeConfluence("BTCUSDT", "neutral", config, known...); c != nil {
+		t.Errorf("confluence checked for a neutral direction: %+v", c)
+	}
+
+	// Every timeframe is already known, so nothing is fetched
+	if c := g.checkTimeframeConfluence("BTCUSDT", "long", config, known...); c == nil || c.Passed {
+		t.Errorf("long against two bearish timeframes = %+v, want blocked", c)
+	}
+	if c := g.checkTimeframeConfluence("BTCUSDT", "short", config, known...); c == nil || !c.Passed {
+		t.Errorf("short with two bearish timeframes = %+v, want passed", c)
+	}
+}
+
+func TestValidateModeConfigConfluence(t *testing.T) {
+	config := &ModeFullConfig{
+		ModeName: "swing",
+		TrendDivergence: &ModeTrendDivergenceConfig{
+			RequireConfluence:     true,
+			ConfluenceTimeframes:  []string{"15m", "1h", "4h"},
+			MinTimeframesAgreeing: 2,
+		},
+	}
+	if err := ValidateModeConfig(config); err != nil {
+		t.Fatalf("valid confluence config rejected: %v", err)
+	}
+
+	config.TrendDivergence.MinTimeframesAgreeing = 4
+	if err := ValidateModeConfig(config); err == nil {
+		t.Error("min_timeframes_agreeing above the timeframe count accepted")
+	}
+	config.TrendDivergence.MinTimeframesAgreeing = 2
+
+	config.TrendDivergence.ConfluenceTimeframes = []string{"15m", "2d"}
+	if err := ValidateModeConfig(config); err == nil {
+		t.Error("invalid timeframe 2d accepted")
+	}
+
+	config.TrendDivergence.ConfluenceTimeframes = []string{"1h"}
+	config.TrendDivergence.MinTimeframesAgreeing = 1
+	if err := ValidateModeConfig(config); err == nil {
+		t.Error("a single confluence timeframe accepted")
+	}
+}
@@ -0,0 +1,101 @@
+package autopilot
+
+import (
+	"fmt"
+	"strings"
+
+	"binance-trading-bot/internal/notification"
+)
+
+// TradeAlertNotifier formats rich trade open/close alerts (satisfied by *notification.Manager)
+type TradeAlertNotifier interface {
+	SendTradeAlert(alert notification.TradeAlert) error
+}
+
+// tradeAlertSink wraps the notifier so it can live in an atomic.Pointer;
+// recordTrade runs both with and without ga.mu held
+type tradeAlertSink struct {
+	TradeAlertNotifier
+}
+
+// setTradeAlertNotifier picks up rich trade alerts when the alert notifier supports them
+func (ga *GinieAutopilot) setTradeAlertNotifier(notifier AlertNotifier) {
+	if tn, ok := notifier.(TradeAlertNotifier); ok {
+		ga.tradeAlerts.Store(&tradeAlertSink{tn})
+		return
+	}
+	ga.tradeAlerts.Store(nil)
+}
+
+// notifyTrade mirrors an open or full close to the notification channels when
+// TradeNotifyEnabled is set. Partial closes are left to the trade history.
+func (ga *GinieAutopilot) notifyTrade(result GinieTradeResult) {
+	if !ga.config.TradeNotifyEnabled {
+		return
+	}
+	sink := ga.tradeAlerts.Load()
+	if sink == nil {
+		return
+	}
+
+	action := "close"
+	switch result.Action {
+	case "open":
+		action = "open"
+	case "full_close", "close", "panic_close":
+	default:
+		return
+	}
+
+	chartTemplate := ga.config.TradeNotifyChartURL
+	dryRun := ga.config.DryRun
+
+	// The mode's timeframe may come from the settings cache, so resolve it off the caller's lock
+	go func() {
+		interval := ga.getEntryTimeframe(result.Mode)
+		alert := notification.TradeAlert{
+			Action:     action,
+			Symbol:     result.Symbol,
+			Side:       result.Side,
+			Mode:       string(result.Mode),
+			Interval:   interval,
+			Price:      result.Price,
+			Quantity:   result.Quantity,
+			PnL:        result.PnL,
+			PnLPercent: result.PnLPercent,
+			Reason:     result.Reason,
+			Rationale:  tradeRationale(result),
+			ChartURL:   notification.ChartURL(chartTemplate, result.Symbol, interval),
+			DryRun:     dryRun,
+			UserID:     ga.userID,
+		}
+		if action == "close" && result.EntryParams != nil {
+			alert.EntryPrice = result.EntryParams.EntryPrice
+		}
+		if err := sink.SendTradeAlert(alert); err != nil {
+			ga.logger.Warn("Failed to send trade notification", "symbol", result.Symbol, "action", result.Action, "error", err)
+		}
+	}()
+}
+
+// tradeRationale summarizes why the trade was taken from its signal summary and entry context
+func tradeRationale(result GinieTradeResult) string {
+	var parts []string
+	if s := result.SignalSummary; s != nil {
+		summary := fmt.Sprintf("%s %s signal (%.0f), %d/%d primary met", s.Strength, s.Direction, s.StrengthScore, s.PrimaryMet, s.PrimaryRequired)
+		if len(s.SignalNames) > 0 {
+			summary += ": " + strings.Join(s.SignalNames, ", ")
+		}
+		parts = append(parts, summary)
+	}
+	if result.Confidence > 0 {
+		parts = append(parts, fmt.Sprintf("confidence %.1f%%", result.Confidence))
+	}
+	if mc := result.MarketConditions; mc != nil {
+		parts = append(parts, fmt.Sprintf("trend %s, ADX %.1f, volatility %s", mc.Trend, mc.ADX, mc.Volatility))
+	}
+	if ep := result.EntryParams; ep != nil && ep.RiskReward > 0 {
+		parts = append(parts, fmt.Sprintf("R:R %.2f", ep.RiskReward))
+	}
+	return strings.Join(parts, "; ")
+}
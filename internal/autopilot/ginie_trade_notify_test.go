@@ -0,0 +1,29 @@
+package autopilot
+
+import (
+	"testing"
+)
+
+func TestTradeRationale(t *testing.T) {
+	result := GinieTradeResult{
+		Confidence: 72.5,
+		SignalSummary: &GinieSignalSummary{
+			Direction:       "LONG",
+			Strength:        "strong",
+			StrengthScore:   81,
+			PrimaryMet:      4,
+			PrimaryRequired: 3,
+			SignalNames:     []string{"EMA cross", "RSI"},
+		},
+		MarketConditions: &GinieMarketSnapshot{Trend: "bullish", ADX: 28.4, Volatility: "medium"},
+		EntryParams:      &GinieEntryParams{RiskReward: 2.5},
+	}
+
+	want := "strong LONG signal (81), 4/3 primary met: EMA cross, RSI; confidence 72.5%; trend bullish, ADX 28.4, volatility medium; R:R 2.50"
+	if got := tradeRationale(result); got != want {
+		t.Errorf("tradeRationale() = %q\nwant %q", got, want)
+	}
+	if got := tradeRationale(GinieTradeResult{}); got != "" {
+		t.Errorf("empty result rationale = %q", got)
+	}
+}
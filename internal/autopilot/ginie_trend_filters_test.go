@@ -76,6 +76,12 @@ func (m *mockFuturesClient) PlaceFuturesOrder(params binance.FuturesOrderParams)
 }
 func (m *mockFuturesClient) CancelFuturesOrder(symbol string, orderId int64) error { return nil }
 func (m *mockFuturesClient) CancelAllFuturesOrders(symbol string) error            { return nil }
+func (m *mockFuturesClient) SetCountdownCancelAll(symbol string, countdownTimeMs int64) error {
+	return nil
+}
+func (m *mockFuturesClient) PlaceProtectedMarketOrder(params binance.FuturesOrderParams, slPrice float64, tpPrices []float64) (*binance.ProtectedOrderResult, error) {
+	return nil, nil
+}
 func (m *mockFuturesClient) GetOpenOrders(symbol string) ([]binance.FuturesOrder, error) {
 	return nil, nil
 }
@@ -90,6 +96,7 @@ func (m *mockFuturesClient) PlaceAlgoOrder(params binance.AlgoOrderParams) (*bin
 func (m *mockFuturesClient) GetOpenAlgoOrders(symbol string) ([]binance.AlgoOrder, error) {
 	return nil, nil
 }
+func (m *mockFuturesClient) GetAlgoOrderCount(symbol string) (int, error)      { return 0, nil }
 func (m *mockFuturesClient) CancelAlgoOrder(symbol string, algoId int64) error { return nil }
 func (m *mockFuturesClient) CancelAllAlgoOrders(symbol string) error           { return nil }
 func (m *mockFuturesClient) GetAllAlgoOrders(symbol string, limit int) ([]binance.AlgoOrder, error) {
@@ -119,6 +126,7 @@ func (m *mockFuturesClient) GetFuturesExchangeInfo() (*binance.FuturesExchangeIn
 	return nil, nil
 }
 func (m *mockFuturesClient) GetFuturesSymbols() ([]string, error) { return nil, nil }
+func (m *mockFuturesClient) Ping() error                          { return nil }
 
 // ==================== History ====================
 func (m *mockFuturesClient) GetTradeHistory(symbol string, limit int) ([]binance.FuturesTrade, error) {
@@ -133,6 +141,15 @@ func (m *mockFuturesClient) GetAllOrders(symbol string, limit int) ([]binance.Fu
 func (m *mockFuturesClient) GetIncomeHistory(incomeType string, startTime, endTime int64, limit int) ([]binance.IncomeRecord, error) {
 	return nil, nil
 }
+func (m *mockFuturesClient) GetTradeHistoryByDateRange(symbol string, startTime, endTime int64, limit int) ([]binance.FuturesTrade, error) {
+	return nil, nil
+}
+func (m *mockFuturesClient) GetAllOrdersByDateRange(symbol string, startTime, endTime int64, limit int) ([]binance.FuturesOrder, error) {
+	return nil, nil
+}
+func (m *mockFuturesClient) GetAllIncomeHistory(symbol, incomeType string, startTime, endTime int64) ([]binance.IncomeRecord, error) {
+	return nil, nil
+}
 
 // ==================== WebSocket ====================
 func (m *mockFuturesClient) GetListenKey() (string, error)             { return "", nil }
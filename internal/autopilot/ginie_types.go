@@ -877,6 +877,11 @@ type TrendDivergenceRejection struct {
 	DecisionTrend    string `json:"decision_trend"`
 	Severity         string `json:"severity"`
 	Reason           string `json:"reason"`
+
+	// Set when the multi-timeframe confluence filter blocked the trade
+	TimeframeTrends    map[string]string `json:"timeframe_trends,omitempty"`
+	TimeframesAgreeing int               `json:"timeframes_agreeing,omitempty"`
+	TimeframesRequired int               `json:"timeframes_required,omitempty"`
 }
 
 // SignalStrengthRejection tracks insufficient signals
@@ -0,0 +1,81 @@
+package autopilot
+
+import (
+	"fmt"
+	"log"
+)
+
+// RejectionInsufficientVolume is the skip reason for signals in thin conditions:
+// 24h quote volume under the mode's min_quote_volume_24h_usd, or the last candle's
+// volume under min_volume_ratio of its recent average
+const RejectionInsufficientVolume = "insufficient_volume"
+
+// volumeAverageCandles is how many closed candles the per-candle volume is compared against
+const volumeAverageCandles = 20
+
+// candleVolumeRatio returns the last closed candle's quote volume as a fraction
+// of the average over the volumeAverageCandles before it, on the given timeframe
+func (ga *GinieAutopilot) candleVolumeRatio(symbol, timeframe string) (float64, error) {
+	// +2: the forming candle is skipped, and the last closed one is measured against the rest
+	klines, err := ga.futuresClient.GetFuturesKlines(symbol, timeframe, volumeAverageCandles+2)
+	if err != nil {
+		return 0, err
+	}
+	if len(klines) < 3 {
+		return 0, fmt.Errorf("only %d candles", len(klines))
+	}
+	closed := klines[:len(klines)-1]
+	last := closed[len(closed)-1]
+
+	var sum float64
+	for _, k := range closed[:len(closed)-1] {
+		sum += k.QuoteAssetVolume
+	}
+	avg := sum / float64(len(closed)-1)
+	if avg <= 0 {
+		return 0, fmt.Errorf("no volume in the last %d candles", len(closed)-1)
+	}
+	return last.QuoteAssetVolume / avg, nil
+}
+
+// volumeRejection records the symbol's volume on the signal log and returns a
+// rejection reason when it is under the mode's entry.min_quote_volume_24h_usd or
+// entry.min_volume_ratio (0 = no floor). A failed fetch doesn't block the trade.
+func (ga *GinieAutopilot) volumeRejection(symbol string, mode GinieTradingMode, modeConfig *ModeFullConfig, signalLog *GinieSignalLog) (string, bool) {
+	if modeConfig == nil || modeConfig.Entry == nil {
+		return "", false
+	}
+	entry := modeConfig.Entry
+
+	if entry.MinQuoteVolume24hUSD > 0 {
+		ticker, err := ga.futuresClient.Get24hrTicker(symbol)
+		if err != nil {
+			log.Printf("[VOLUME-GATE] %s: Could not fetch 24h ticker, skipping volume floor: %v", symbol, err)
+		} else {
+			signalLog.QuoteVolume24h = ticker.QuoteVolume
+			if ticker.QuoteVolume < entry.MinQuoteVolume24hUSD {
+				return fmt.Sprintf("%s (24h quote volume %.0f < %.0f)", RejectionInsufficientVolume, ticker.QuoteVolume, entry.MinQuoteVolume24hUSD), true
+			}
+		}
+	}
+
+	if entry.MinVolumeRatio > 0 {
+		timeframe := ""
+		if modeConfig.Timeframe != nil {
+			timeframe = modeConfig.Timeframe.EntryTimeframe
+		}
+		if timeframe == "" {
+			timeframe = ga.getEntryTimeframe(mode)
+		}
+		ratio, err := ga.candleVolumeRatio(symbol, timeframe)
+		if err != nil {
+			log.Printf("[VOLUME-GATE] %s: Could not fetch candles, skipping volume ratio: %v", symbol, err)
+			return "", false
+		}
+		signalLog.VolumeRatio = ratio
+		if ratio < entry.MinVolumeRatio {
+			return fmt.Sprintf("%s (candle volume ratio %.2f < %.2f)", RejectionInsufficientVolume, ratio, entry.MinVolumeRatio), true
+		}
+	}
+	return "", false
+}
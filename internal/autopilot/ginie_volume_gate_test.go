@@ -0,0 +1,64 @@
+package autopilot
+
+import (
+	"strings"
+	"testing"
+
+	"binance-trading-bot/internal/binance"
+)
+
+type volumeGateClient struct {
+	binance.FuturesClient
+	quoteVolume24h float64
+	klines         []binance.Kline
+}
+
+func (c *volumeGateClient) Get24hrTicker(symbol string) (*binance.Futures24hrTicker, error) {
+	return &binance.Futures24hrTicker{Symbol: symbol, QuoteVolume: c.quoteVolume24h}, nil
+}
+
+func (c *volumeGateClient) GetFuturesKlines(symbol, interval string, limit int) ([]binance.Kline, error) {
+	return c.klines, nil
+}
+
+func TestVolumeRejection(t *testing.T) {
+	// 20 candles averaging 1000, a thin last closed candle, then the forming one
+	klines := make([]binance.Kline, 0, 22)
+	for i := 0; i < 20; i++ {
+		klines = append(klines, binance.Kline{QuoteAssetVolume: 1000})
+	}
+	klines = append(klines, binance.Kline{QuoteAssetVolume: 200}, binance.Kline{QuoteAssetVolume: 5})
+
+	client := &volumeGateClient{quoteVolume24h: 5_000_000, klines: klines}
+	ga := &GinieAutopilot{futuresClient: client}
+	modeConfig := &ModeFullConfig{
+		Timeframe: &ModeTimeframeConfig{EntryTimeframe: "15m"},
+		Entry:     &ModeEntryConfig{MinQuoteVolume24hUSD: 10_000_000},
+	}
+
+	signalLog := &GinieSignalLog{}
+	reason, rejected := ga.volumeRejection("BTCUSDT", GinieModeSwing, modeConfig, signalLog)
+	if !rejected || !strings.HasPrefix(reason, RejectionInsufficientVolume+" (24h") {
+		t.Fatalf("24h floor: rejected=%v reason=%q", rejected, reason)
+	}
+	if signalLog.QuoteVolume24h != 5_000_000 {
+		t.Errorf("QuoteVolume24h = %v", signalLog.QuoteVolume24h)
+	}
+
+	client.quoteVolume24h = 50_000_000
+	modeConfig.Entry.MinVolumeRatio = 0.3
+	reason, rejected = ga.volumeRejection("BTCUSDT", GinieModeSwing, modeConfig, signalLog)
+	if !rejected || reason != RejectionInsufficientVolume+" (candle volume ratio 0.20 < 0.30)" {
+		t.Fatalf("volume ratio: rejected=%v reason=%q", rejected, reason)
+	}
+
+	modeConfig.Entry.MinVolumeRatio = 0.2
+	if reason, rejected := ga.volumeRejection("BTCUSDT", GinieModeSwing, modeConfig, signalLog); rejected {
+		t.Errorf("rejected at the ratio floor: %q", reason)
+	}
+
+	explained := explainRejectionReason(&GinieSignalLog{RejectionReason: "insufficient_volume (candle volume ratio 0.20 < 0.30)"})
+	if explained.Gate != "volume" || explained.Actual != "0.20x average" {
+		t.Errorf("explanation = %+v", explained)
+	}
+}
@@ -0,0 +1,188 @@
+package autopilot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Watchlist source tags
+const (
+	WatchlistSourceSaved      = "saved"
+	WatchlistSourceLLM        = "llm"
+	WatchlistSourceGainers    = "gainers"
+	WatchlistSourceLosers     = "losers"
+	WatchlistSourceVolume     = "volume"
+	WatchlistSourceVolatility = "volatility"
+	WatchlistSourceDynamic    = "dynamic" // Fallback market movers list
+	WatchlistSourcePinned     = "pinned"
+)
+
+// Manual watchlist overrides
+const (
+	WatchlistOverrideInclude = "include"
+	WatchlistOverrideExclude = "exclude"
+)
+
+// WatchlistEntry is one scanned symbol and the sources that selected it
+type WatchlistEntry struct {
+	Symbol  string   `json:"symbol"`
+	Sources []string `json:"sources"`
+	Pinned  bool     `json:"pinned"`
+}
+
+// Watchlist is the current scan list with manual overrides
+type Watchlist struct {
+	Symbols     []WatchlistEntry `json:"symbols"`
+	Count       int              `json:"count"`
+	Pinned      []string         `json:"pinned"`
+	Excluded    []string         `json:"excluded"`
+	RefreshedAt time.Time        `json:"refreshed_at,omitempty"`
+}
+
+// setWatchlist applies pin/exclude overrides to a freshly built source map and
+// installs the result as the analyzer's watch list
+func (ga *GinieAutopilot) setWatchlist(sources map[string][]string) {
+	ga.mu.Lock()
+	for symbol, override := range ga.watchlistOverrides {
+		switch override {
+		case WatchlistOverrideInclude:
+			sources[symbol] = append(sources[symbol], WatchlistSourcePinned)
+		case WatchlistOverrideExclude:
+			delete(sources, symbol)
+		}
+	}
+	ga.watchlistSources = sources
+	ga.watchlistRefreshedAt = time.Now()
+	ga.mu.Unlock()
+
+	symbols := make([]string, 0, len(sources))
+	for symbol := range sources {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	ga.analyzer.SetWatchSymbols(symbols)
+}
+
+// loadDynamicWatchlist falls back to the market movers list, keeping overrides applied
+func (ga *GinieAutopilot) loadDynamicWatchlist(topN int) error {
+	if err := ga.analyzer.LoadDynamicSymbols(topN); err != nil {
+		return err
+	}
+	sources := make(map[string][]string)
+	for _, symbol := range ga.analyzer.GetWatchSymbols() {
+		sources[symbol] = []string{WatchlistSourceDynamic}
+	}
+	ga.setWatchlist(sources)
+	return nil
+}
+
+// GetWatchlistWithSources returns the symbols currently being scanned with the
+// sources that selected them
+func (ga *GinieAutopilot) GetWatchlistWithSources() Watchlist {
+	symbols := ga.analyzer.GetWatchSymbols()
+
+	ga.mu.RLock()
+	defer ga.mu.RUnlock()
+
+	wl := Watchlist{
+		Symbols:     make([]WatchlistEntry, 0, len(symbols)),
+		Count:       len(symbols),
+		Pinned:      make([]string, 0),
+		Excluded:    make([]string, 0),
+		RefreshedAt: ga.watchlistRefreshedAt,
+	}
+	for _, symbol := range symbols {
+		sources := ga.watchlistSources[symbol]
+		if len(sources) == 0 {
+			sources = []string{WatchlistSourceDynamic}
+		}
+		wl.Symbols = append(wl.Symbols, WatchlistEntry{
+			Symbol:  symbol,
+			Sources: sources,
+			Pinned:  ga.watchlistOverrides[symbol] == WatchlistOverrideInclude,
+		})
+	}
+	for symbol, override := range ga.watchlistOverrides {
+		if override == WatchlistOverrideInclude {
+			wl.Pinned = append(wl.Pinned, symbol)
+		} else {
+			wl.Excluded = append(wl.Excluded, symbol)
+		}
+	}
+	sort.Strings(wl.Pinned)
+	sort.Strings(wl.Excluded)
+	return wl
+}
+
+// PinWatchlistSymbol force-includes (or with exclude=true force-excludes) a symbol.
+// The override applies immediately and survives the periodic coin source refresh.
+func (ga *GinieAutopilot) PinWatchlistSymbol(symbol string, exclude bool) error {
+	symbol = strings.ToUpper(strings.TrimSpace(symbol))
+	if symbol == "" {
+		return fmt.Errorf("symbol is required")
+	}
+
+	override := WatchlistOverrideInclude
+	if exclude {
+		override = WatchlistOverrideExclude
+	}
+
+	ga.mu.Lock()
+	if ga.watchlistOverrides == nil {
+		ga.watchlistOverrides = make(map[string]string)
+	}
+	ga.watchlistOverrides[symbol] = override
+	sources := ga.currentWatchlistSourcesLocked()
+	ga.mu.Unlock()
+
+	ga.logger.Info("Ginie watchlist override set", "symbol", symbol, "override", override)
+	ga.setWatchlist(sources)
+	return nil
+}
+
+// UnpinWatchlistSymbol removes a manual override; the symbol goes back to being
+// selected (or not) by the configured coin sources on the next refresh
+func (ga *GinieAutopilot) UnpinWatchlistSymbol(symbol string) error {
+	symbol = strings.ToUpper(strings.TrimSpace(symbol))
+
+	ga.mu.Lock()
+	override, exists := ga.watchlistOverrides[symbol]
+	if !exists {
+		ga.mu.Unlock()
+		return fmt.Errorf("no watchlist override for %s", symbol)
+	}
+	delete(ga.watchlistOverrides, symbol)
+	// A formerly pinned symbol stays only if a real source selected it
+	sources := ga.currentWatchlistSourcesLocked()
+	ga.mu.Unlock()
+
+	ga.logger.Info("Ginie watchlist override removed", "symbol", symbol, "was", override)
+	ga.setWatchlist(sources)
+	return nil
+}
+
+// currentWatchlistSourcesLocked copies the current source map without pin tags
+// (setWatchlist re-adds them). Caller must hold ga.mu.
+func (ga *GinieAutopilot) currentWatchlistSourcesLocked() map[string][]string {
+	sources := make(map[string][]string, len(ga.watchlistSources))
+	if len(ga.watchlistSources) == 0 {
+		for _, symbol := range ga.analyzer.GetWatchSymbols() {
+			sources[symbol] = []string{WatchlistSourceDynamic}
+		}
+		return sources
+	}
+	for symbol, tags := range ga.watchlistSources {
+		kept := make([]string, 0, len(tags))
+		for _, tag := range tags {
+			if tag != WatchlistSourcePinned {
+				kept = append(kept, tag)
+			}
+		}
+		if len(kept) > 0 || ga.watchlistOverrides[symbol] == WatchlistOverrideInclude {
+			sources[symbol] = kept
+		}
+	}
+	return sources
+}
@@ -0,0 +1,46 @@
+package autopilot
+
+import (
+	"errors"
+	"os"
+	"sync"
+)
+
+// ErrLiveTradingDisabled is returned when a switch to live trading is refused
+// because the operator kill switch is active
+var ErrLiveTradingDisabled = errors.New("live trading is disabled by the operator (FORCE_DRY_RUN kill switch is active)")
+
+// Operator kill switch that pins every trading component to paper mode.
+// It is configured from the environment/config at startup only, so nothing
+// reachable from the web UI or API can turn it off.
+var (
+	forceDryRunMu   sync.RWMutex
+	forceDryRun     bool
+	forceDryRunFile string
+)
+
+// ConfigureForceDryRun sets the kill switch from startup configuration.
+// When sentinelFile is non-empty, the switch is also active whenever that
+// file exists, so an operator can engage it at runtime with `touch`.
+func ConfigureForceDryRun(enabled bool, sentinelFile string) {
+	forceDryRunMu.Lock()
+	defer forceDryRunMu.Unlock()
+	forceDryRun = enabled
+	forceDryRunFile = sentinelFile
+}
+
+// IsDryRunForced reports whether live trading is currently blocked
+func IsDryRunForced() bool {
+	forceDryRunMu.RLock()
+	enabled, file := forceDryRun, forceDryRunFile
+	forceDryRunMu.RUnlock()
+
+	if enabled {
+		return true
+	}
+	if file == "" {
+		return false
+	}
+	_, err := os.Stat(file)
+	return err == nil
+}
@@ -0,0 +1,41 @@
+package autopilot
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestSentinelFileForcesDryRunEverywhere checks that a sentinel file created
+// after startup pins every controller to paper mode, not just Ginie
+func TestSentinelFileForcesDryRunEverywhere(t *testing.T) {
+	sentinel := filepath.Join(t.TempDir(), "force_dry_run")
+	ConfigureForceDryRun(false, sentinel)
+	defer ConfigureForceDryRun(false, "")
+
+	c := &Controller{config: &AutopilotConfig{DryRun: false}}
+	sc := &SpotController{config: &SpotControllerConfig{DryRun: false}}
+	fc := &FuturesController{dryRun: false}
+
+	if c.isDryRun() || sc.isDryRun() || fc.isDryRun() {
+		t.Fatal("controllers in paper mode before the sentinel file exists")
+	}
+
+	if err := os.WriteFile(sentinel, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if !c.isDryRun() {
+		t.Error("spot autopilot controller still live with the sentinel file present")
+	}
+	if !sc.isDryRun() {
+		t.Error("spot controller still live with the sentinel file present")
+	}
+	if !fc.isDryRun() || !fc.IsDryRun() || !fc.GetDryRun() {
+		t.Error("futures controller still live with the sentinel file present")
+	}
+
+	os.Remove(sentinel)
+	if fc.isDryRun() {
+		t.Error("futures controller still in paper mode after the sentinel file was removed")
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"binance-trading-bot/internal/binance"
+	"binance-trading-bot/internal/events"
 	"binance-trading-bot/internal/orders"
 )
 
@@ -278,6 +279,7 @@ func (g *GinieAutopilot) processExchangeTPFill(pos *GiniePosition, tpLevel int,
 	if tpLevel > 0 && tpLevel <= len(pos.TakeProfits) {
 		pos.TakeProfits[tpLevel-1].Status = "hit"
 	}
+	g.publishPositionEvent(events.EventGiniePositionTPHit, pos, map[string]interface{}{"tp_level": tpLevel, "price": currentPrice})
 
 	// Handle position fully closed
 	if sr.RemainingQuantity <= 0 {
@@ -511,6 +513,7 @@ func (g *GinieAutopilot) executeTPSell(pos *GiniePosition, tpLevel int) error {
 	if tpLevel > 0 && tpLevel <= len(pos.TakeProfits) {
 		pos.TakeProfits[tpLevel-1].Status = "hit"
 	}
+	g.publishPositionEvent(events.EventGiniePositionTPHit, pos, map[string]interface{}{"tp_level": tpLevel, "price": currentPrice})
 
 	// Handle small position full close - no re-entry when position fully closed
 	if sr.RemainingQuantity <= 0 || sellPercent >= 100.0 {
@@ -1175,13 +1178,14 @@ func (g *GinieAutopilot) recordFinalExitForLearning(pos *GiniePosition, reason s
 // ============ PLACEHOLDER METHODS ============
 // These methods should be implemented or already exist in ginie_autopilot.go
 
-// getCurrentPrice gets current price for a symbol from Binance API
+// getCurrentPrice gets the exit check price for a symbol (mark price when
+// UseMarkPriceForExits is on, otherwise last price)
 func (g *GinieAutopilot) getCurrentPrice(symbol string) float64 {
 	if g.futuresClient == nil {
 		log.Printf("[POSITION-OPT] %s: futuresClient is nil, cannot get price", symbol)
 		return 0
 	}
-	price, err := g.futuresClient.GetFuturesCurrentPrice(symbol)
+	price, err := g.exitCheckPrice(symbol)
 	if err != nil {
 		log.Printf("[POSITION-OPT] %s: Failed to get current price: %v", symbol, err)
 		return 0
@@ -1367,6 +1371,9 @@ func (g *GinieAutopilot) updatePositionStopLoss(pos *GiniePosition, newSL float6
 	if newSL <= 0 {
 		return fmt.Errorf("invalid stop loss price: %.8f", newSL)
 	}
+	if g.rejectSLWorsening(pos, newSL, "scalp_reentry") {
+		return fmt.Errorf("%s: stop loss %.8f is worse than placed %.8f", RejectionSLWorsening, newSL, pos.PlacedSL)
+	}
 
 	// Update position's stop loss
 	oldSL := pos.StopLoss
@@ -1417,13 +1424,14 @@ func (g *GinieAutopilot) updatePositionStopLoss(pos *GiniePosition, newSL float6
 		WorkingType:  binance.WorkingTypeMarkPrice,
 	}
 
-	slOrder, err := g.futuresClient.PlaceAlgoOrder(slParams)
+	slOrder, err := g.placeAlgoOrder(slParams)
 	if err != nil {
 		log.Printf("[SCALP-SL] %s: Failed to place new SL order: %v", pos.Symbol, err)
 		return fmt.Errorf("failed to place new SL order: %w", err)
 	}
 
 	pos.StopLossAlgoID = slOrder.AlgoId
+	pos.PlacedSL = newSL
 	log.Printf("[SCALP-SL] %s: New SL order placed, algoId=%d, triggerPrice=%.8f",
 		pos.Symbol, slOrder.AlgoId, roundedSL)
 
@@ -2308,7 +2316,7 @@ func (g *GinieAutopilot) placeHedgeProtectedSL(pos *GiniePosition, slPrice float
 	log.Printf("[HEDGE-PROTECT-SL] %s: Placing protected SL for %s hedge at %.8f, qty=%.4f, clientAlgoId=%s",
 		pos.Symbol, hm.HedgeSide, roundedSL, roundedQty, hedgeSLClientOrderId)
 
-	slOrder, err := g.futuresClient.PlaceAlgoOrder(slParams)
+	slOrder, err := g.placeAlgoOrder(slParams)
 	if err != nil {
 		log.Printf("[HEDGE-PROTECT-SL] %s: Failed to place protected SL: %v", pos.Symbol, err)
 		return fmt.Errorf("failed to place hedge protected SL: %w", err)
@@ -115,6 +115,11 @@ type ModeSafetyState struct {
 	IsPausedWinRate   bool
 	WinRatePauseUntil time.Time
 
+	// Adaptive throttle (see ginie_adaptive_throttle.go)
+	ThrottleLevel      string // "", "throttled" or "paused"
+	ThrottleSince      time.Time
+	ThrottlePauseUntil time.Time
+
 	// Overall
 	IsPaused    bool
 	PauseReason string
@@ -144,9 +149,15 @@ type ModeTimeframeConfig struct{
 
 // ModeEntryConfig holds entry order settings for a mode
 type ModeEntryConfig struct {
-	LimitOrderGapPercent float64 `json:"limit_order_gap_percent"` // Gap from current price for limit orders (default: 0.1 = 0.1%)
-	UseMarketEntry       bool    `json:"use_market_entry"`        // Use MARKET orders instead of LIMIT for immediate fill
-	MaxLimitGapPercent   float64 `json:"max_limit_gap_percent"`   // Max gap allowed - use market if gap exceeds this (default: 0.5%)
+	LimitOrderGapPercent float64 `json:"limit_order_gap_percent"`  // Gap from current price for limit orders (default: 0.1 = 0.1%)
+	UseMarketEntry       bool    `json:"use_market_entry"`         // Use MARKET orders instead of LIMIT for immediate fill
+	MaxLimitGapPercent   float64 `json:"max_limit_gap_percent"`    // Max gap allowed - use market if gap exceeds this (default: 0.5%)
+	PreferMakerEntry     bool    `json:"prefer_maker_entry"`       // Market entries first rest a post-only limit at the best bid/ask
+	MakerTimeoutSeconds  int     `json:"maker_timeout_seconds"`    // Seconds to wait for the maker fill before the rest goes market (default: 10)
+	MaxSpreadBps         float64 `json:"max_spread_bps"`           // Reject entries when the bid/ask spread exceeds this many basis points (0 = no limit)
+	MinQuoteVolume24hUSD float64 `json:"min_quote_volume_24h_usd"` // Reject entries when 24h quote volume is below this (0 = no floor)
+	MinVolumeRatio       float64 `json:"min_volume_ratio"`         // Reject entries when the last candle's volume is below this fraction of its 20-candle average (0 = off)
+	EntryOrderType       string  `json:"entry_order_type"`         // "MARKET" or "LIMIT" (falls back to MARKET on failure); "" = LIMIT at prev candle extreme without fallback
 }
 
 // ModeConfidenceConfig holds confidence thresholds for a mode
@@ -178,6 +189,9 @@ type ModeSizeConfig struct {
 	// Auto AI/LLM sizing - let AI determine optimal position size
 	AutoSizeEnabled     bool    `json:"auto_size_enabled"`      // Use AI/LLM to determine position size based on volatility, confidence, market conditions
 	AutoSizeMinCoverFee float64 `json:"auto_size_min_cover_fee"` // Minimum size to ensure fees are covered (default: $15 to cover 0.08% round-trip fees)
+
+	// Per-cycle selectivity, independent of free slots
+	MaxEntriesPerCycle int `json:"max_entries_per_cycle"` // Only execute the top N ranked signals per scan cycle (0 = no cap)
 }
 
 // ModeCircuitBreakerConfig holds circuit breaker settings for a mode
@@ -207,6 +221,7 @@ type ModeSLTPConfig struct {
 	TPGainLevels            []float64 `json:"tp_gain_levels"`             // Multi-level TP price gains (e.g., [0.3, 0.6, 1.0, 1.5])
 	TPAllocation            []float64 `json:"tp_allocation"`              // Multi-level TP qty allocation (e.g., [50, 50, 0, 0] = 50% at TP1, 50% at TP2)
 	TrailingActivationMode  string    `json:"trailing_activation_mode"`   // "immediate", "after_tp1", "after_breakeven", "after_tp1_and_breakeven"
+	TPLevelTrailingPercents []float64 `json:"tp_level_trailing_percents"` // Trail % once TP1, TP2, ... is hit (e.g., [2.0, 1.5, 1.0]); 0/missing = keep previous
 	// ROI-based SL/TP settings
 	UseROIBasedSLTP      bool    `json:"use_roi_based_sltp"`       // true = use ROI-based SL/TP instead of price %
 	ROIStopLossPercent   float64 `json:"roi_stop_loss_percent"`    // SL based on ROI % (e.g., -5 = close at -5% ROI)
@@ -229,6 +244,19 @@ type ModeSLTPConfig struct {
 	AutoTrailingEnabled   bool    `json:"auto_trailing_enabled"`    // Use AI/LLM to manage trailing stop activation and distance
 	MinProfitToTrailPct   float64 `json:"min_profit_to_trail_pct"`  // Minimum profit % before trailing activates (covers fees, default: 0.5%)
 	MinSLDistanceFromZero float64 `json:"min_sl_distance_from_zero"` // Minimum SL distance from entry to avoid near-zero closes (default: 0.1%)
+	NativeTrailing        bool    `json:"native_trailing"`           // Trail with Binance TRAILING_STOP_MARKET instead of client-side SL replacement
+
+	// Break-even stop placement
+	FeeAwareBreakeven      bool    `json:"fee_aware_breakeven"`      // Offset breakeven SL by the round-trip fee so a stop-out nets >= 0
+	BreakevenBufferPercent float64 `json:"breakeven_buffer_percent"` // Extra buffer % beyond breakeven (0 = global breakeven_buffer)
+
+	// Runner: keep a slice of the position open past the final TP on a wide trailing stop
+	RunnerPercent         float64 `json:"runner_percent"`          // % of the position the TP ladder leaves open (0 = close it all, max 50)
+	RunnerTrailingPercent float64 `json:"runner_trailing_percent"` // Runner trail distance % (0 = 2x trailing_stop_percent)
+
+	// Execution style for TP partial closes and full closes
+	ExitOrderType          string  `json:"exit_order_type"`           // "MARKET" or "LIMIT" (falls back to MARKET on failure); "" = LIMIT without fallback
+	ExitLimitBufferPercent float64 `json:"exit_limit_buffer_percent"` // LIMIT exit price % through the current price (0 = 0.1%)
 }
 
 // HedgeModeConfig holds hedge mode settings for a mode (LONG + SHORT simultaneously)
@@ -308,6 +336,9 @@ type ModeRiskConfig struct {
 	MaxDrawdownPercent         float64 `json:"max_drawdown_percent"`         // Max allowed drawdown
 	MaxDailyLossPercent        float64 `json:"max_daily_loss_percent"`       // Max daily loss limit
 	MinADX                     float64 `json:"min_adx"`                      // Minimum ADX for trend strength (database-first approach)
+	MinRiskReward              float64 `json:"min_risk_reward"`              // Reject signals with a lower reward:risk (0 = no minimum)
+	LossAlertUSD               float64 `json:"loss_alert_usd"`               // Alert when a position's unrealized loss reaches this (0 = use global)
+	LossAlertPercent           float64 `json:"loss_alert_percent"`           // Alert when price moves this % against entry (0 = use global)
 }
 
 // ModeTrendDivergenceConfig holds trend divergence detection settings
@@ -316,6 +347,12 @@ type ModeTrendDivergenceConfig struct {
 	MinDivergencePercent float64 `json:"min_divergence_percent"` // Min divergence to detect
 	BlockOnDivergence    bool    `json:"block_on_divergence"`    // Block trades on strong divergence
 	DivergenceWeight     float64 `json:"divergence_weight"`      // Weight in signal scoring
+
+	// Multi-timeframe confluence: a LONG/SHORT is only allowed when the trend on
+	// at least MinTimeframesAgreeing of ConfluenceTimeframes points the same way
+	RequireConfluence     bool     `json:"require_confluence"`      // Enable the confluence filter
+	ConfluenceTimeframes  []string `json:"confluence_timeframes"`   // Timeframes whose trend is checked, e.g. ["15m","1h","4h"]
+	MinTimeframesAgreeing int      `json:"min_timeframes_agreeing"` // Timeframes that must agree with the trade direction (0 = all)
 }
 
 // ====== MULTI-TIMEFRAME (MTF) CONFIGURATION ======
@@ -461,8 +498,23 @@ type ModeFullConfig struct {
 
 	// ====== NEW: Position Optimization (Story 9.9) ======
 	PositionOptimization *PositionOptimizationConfig `json:"position_optimization,omitempty"` // Progressive TP + Rebuy + Hedging
+
+	// Trading hours/days window; signals outside it are rejected as outside_trading_hours (nil = always on)
+	Schedule *database.TradingSchedule `json:"schedule,omitempty"`
+
+	// Early profit booking (full close at an ROI threshold before TP1); nil follows the global switch
+	EarlyProfitBookingEnabled *bool `json:"early_profit_booking_enabled,omitempty"`
 }
 
+// RejectionOutsideTradingHours is the skip reason for signals outside a mode or strategy schedule
+const RejectionOutsideTradingHours = "outside_trading_hours"
+
+// RejectionLowRiskReward is the skip reason for signals below a mode's min_risk_reward
+const RejectionLowRiskReward = "low_risk_reward"
+
+// RejectionNotTopRanked is the skip reason for signals outside a mode's max_entries_per_cycle
+const RejectionNotTopRanked = "not_top_ranked"
+
 // ====== LLM AND ADAPTIVE AI CONFIGURATION (Story 2.8) ======
 
 // LLMConfig holds global LLM provider settings
@@ -632,6 +684,7 @@ func DefaultModeConfigs() map[string]*ModeFullConfig {
 				AutoTrailingEnabled:     false, // Manual trailing by default
 				MinProfitToTrailPct:     0.3,   // 0.3% profit before trailing (ultra-fast needs lower)
 				MinSLDistanceFromZero:   0.1,   // 0.1% min SL distance from entry
+				FeeAwareBreakeven:       true,  // Breakeven SL covers round-trip fees
 			},
 			Hedge: &HedgeModeConfig{
 				AllowHedge:                true,
@@ -758,6 +811,7 @@ func DefaultModeConfigs() map[string]*ModeFullConfig {
 				AutoTrailingEnabled:     false,
 				MinProfitToTrailPct:     0.5,   // 0.5% profit before trailing
 				MinSLDistanceFromZero:   0.1,
+				FeeAwareBreakeven:       true,  // Breakeven SL covers round-trip fees
 			},
 			Hedge: &HedgeModeConfig{
 				AllowHedge:                true,
@@ -895,6 +949,7 @@ func DefaultModeConfigs() map[string]*ModeFullConfig {
 				AutoTrailingEnabled:     false,
 				MinProfitToTrailPct:     1.0,
 				MinSLDistanceFromZero:   0.2,
+				FeeAwareBreakeven:       true,  // Breakeven SL covers round-trip fees
 			},
 			Hedge: &HedgeModeConfig{
 				AllowHedge:                false, // No hedging in re-entry mode
@@ -1026,6 +1081,7 @@ func DefaultModeConfigs() map[string]*ModeFullConfig {
 				AutoTrailingEnabled:     false,
 				MinProfitToTrailPct:     1.0,   // 1% profit before trailing for swing
 				MinSLDistanceFromZero:   0.15,
+				FeeAwareBreakeven:       true,  // Breakeven SL covers round-trip fees
 			},
 			Hedge: &HedgeModeConfig{
 				AllowHedge:                true,
@@ -1152,6 +1208,7 @@ func DefaultModeConfigs() map[string]*ModeFullConfig {
 				AutoTrailingEnabled:     false,
 				MinProfitToTrailPct:     2.0,   // 2% profit before trailing for position trades
 				MinSLDistanceFromZero:   0.2,
+				FeeAwareBreakeven:       true,  // Breakeven SL covers round-trip fees
 			},
 			Hedge: &HedgeModeConfig{
 				AllowHedge:                true, // Cautious
@@ -1231,6 +1288,9 @@ type SymbolSettings struct {
 	Notes               string                    `json:"notes"`                // User notes about this symbol
 	BlockedUntil        string                    `json:"blocked_until"`        // ISO timestamp until when symbol is blocked (empty = not blocked)
 	BlockReason         string                    `json:"block_reason"`         // Reason for blocking (e.g., "worst_performer", "manual")
+	CategoryOverride    bool                      `json:"category_override"`    // Category pinned by the user - the auto-classifier leaves it alone
+	AutoCategory        SymbolPerformanceCategory `json:"auto_category,omitempty"` // Tier last computed by the auto-classifier
+	MaxSizeCapUSD       float64                   `json:"max_size_cap_usd,omitempty"` // Hard ceiling on position size, applied over category and mode caps (0 = none)
 
 	// Performance metrics (updated periodically)
 	TotalTrades         int                       `json:"total_trades"`
@@ -1238,6 +1298,7 @@ type SymbolSettings struct {
 	TotalPnL            float64                   `json:"total_pnl"`
 	WinRate             float64                   `json:"win_rate"`
 	AvgPnL              float64                   `json:"avg_pnl"`
+	Expectancy          float64                   `json:"expectancy"`           // Expected R per trade: (win% * avg win - loss% * avg loss) / avg loss
 	LastUpdated         string                    `json:"last_updated"`
 }
 
@@ -1469,6 +1530,13 @@ type AutopilotSettings struct {
 	MorningAutoBlockHourUTC  int  `json:"morning_auto_block_hour_utc"`  // Hour in UTC to run auto-block (0-23, default 0)
 	MorningAutoBlockMinUTC   int  `json:"morning_auto_block_min_utc"`   // Minute in UTC to run auto-block (0-59, default 5)
 
+	// ====== SYMBOL AUTO-CLASSIFIER ======
+	// Periodically re-tier each traded symbol's category from its realized win rate and expectancy
+	SymbolAutoClassifyEnabled       bool `json:"symbol_auto_classify_enabled"`        // Enable automatic category updates
+	SymbolAutoClassifyIntervalHours int  `json:"symbol_auto_classify_interval_hours"` // Hours between runs (0 = 6)
+	SymbolAutoClassifyMinTrades     int  `json:"symbol_auto_classify_min_trades"`     // Closed trades needed before a symbol leaves neutral (0 = 10)
+	SymbolAutoClassifyLookbackDays  int  `json:"symbol_auto_classify_lookback_days"`  // Trade window in days (0 = 30)
+
 	// ====== COMPREHENSIVE MODE CONFIGURATIONS (Story 2.7) ======
 	// Full configuration for each trading mode with all settings
 	// User can customize any setting - defaults provided from Story 2.7
@@ -3169,6 +3237,35 @@ func (sm *SettingsManager) UpdateSymbolROITarget(symbol string, roiPercent float
 	return sm.SaveSettings(settings)
 }
 
+// SetSymbolMaxSizeCap sets a hard USD ceiling on position size for a symbol
+// If capUSD is 0, removes the cap (category and mode caps apply alone)
+func (sm *SettingsManager) SetSymbolMaxSizeCap(symbol string, capUSD float64) error {
+	if capUSD < 0 {
+		return fmt.Errorf("max size cap must be >= 0, got %.2f", capUSD)
+	}
+
+	settings := sm.GetDefaultSettings()
+
+	if settings.SymbolSettings == nil {
+		settings.SymbolSettings = make(map[string]*SymbolSettings)
+	}
+
+	// Get or create symbol settings
+	if settings.SymbolSettings[symbol] == nil {
+		settings.SymbolSettings[symbol] = &SymbolSettings{
+			Symbol:         symbol,
+			Enabled:        true,
+			Category:       PerformanceNeutral,
+			SizeMultiplier: 1.0,
+		}
+	}
+
+	settings.SymbolSettings[symbol].MaxSizeCapUSD = capUSD
+	settings.SymbolSettings[symbol].LastUpdated = time.Now().Format("2006-01-02 15:04:05")
+
+	return sm.SaveSettings(settings)
+}
+
 // GetEffectiveConfidence returns the effective min confidence for a symbol
 // taking into account global settings and per-symbol overrides
 func (sm *SettingsManager) GetEffectiveConfidence(symbol string, globalMinConfidence float64) float64 {
@@ -3406,6 +3503,13 @@ func (sm *SettingsManager) UpdateSymbolSettings(symbol string, update *SymbolSet
 
 	update.Symbol = symbol
 	update.LastUpdated = time.Now().Format("2006-01-02 15:04:05")
+	if existing := settings.SymbolSettings[symbol]; existing != nil {
+		// Keep auto-classifier bookkeeping the settings form doesn't send
+		update.CategoryOverride = update.CategoryOverride || existing.CategoryOverride
+		update.AutoCategory = existing.AutoCategory
+		update.Expectancy = existing.Expectancy
+		update.MaxSizeCapUSD = existing.MaxSizeCapUSD
+	}
 	settings.SymbolSettings[symbol] = update
 
 	return sm.SaveSettings(settings)
@@ -3867,6 +3971,10 @@ func (sm *SettingsManager) GetModeAllocation() *ModeAllocationConfig {
 // GetUserModeAllocation loads mode allocation from database for a specific user
 // Returns database allocation if exists, otherwise returns defaults
 func (sm *SettingsManager) GetUserModeAllocation(ctx context.Context, repo *database.Repository, userID string) (*ModeAllocationConfig, error) {
+	if repo == nil {
+		return sm.dbAllocationToConfig(database.DefaultUserCapitalAllocation()), nil // No database configured
+	}
+
 	// Load from database
 	dbAllocation, err := repo.GetUserCapitalAllocation(ctx, userID)
 	if err != nil {
@@ -4078,6 +4186,11 @@ func ValidateModeConfig(config *ModeFullConfig) error {
 		return fmt.Errorf("invalid mode name '%s': must be ultra_fast, scalp, swing, position, or scalp_reentry", config.ModeName)
 	}
 
+	// Validate trading schedule if present
+	if err := config.Schedule.Validate(); err != nil {
+		return fmt.Errorf("schedule: %w", err)
+	}
+
 	// Validate timeframe config if present
 	if config.Timeframe != nil {
 		if config.Timeframe.TrendTimeframe != "" {
@@ -4121,6 +4234,9 @@ func ValidateModeConfig(config *ModeFullConfig) error {
 		if config.Size.MaxPositions < 0 {
 			return fmt.Errorf("size.max_positions must be non-negative")
 		}
+		if config.Size.MaxEntriesPerCycle < 0 {
+			return fmt.Errorf("size.max_entries_per_cycle must be non-negative")
+		}
 		if config.Size.Leverage < 1 || config.Size.Leverage > 125 {
 			return fmt.Errorf("size.leverage must be between 1 and 125")
 		}
@@ -4159,6 +4275,76 @@ func ValidateModeConfig(config *ModeFullConfig) error {
 		if config.SLTP.TrailingStopActivation < 0 || config.SLTP.TrailingStopActivation > 100 {
 			return fmt.Errorf("sltp.trailing_stop_activation must be between 0 and 100")
 		}
+		for i, pct := range config.SLTP.TPLevelTrailingPercents {
+			if pct < 0 || pct > 100 {
+				return fmt.Errorf("sltp.tp_level_trailing_percents[%d] must be between 0 and 100", i)
+			}
+		}
+		if config.SLTP.BreakevenBufferPercent < 0 || config.SLTP.BreakevenBufferPercent > 10 {
+			return fmt.Errorf("sltp.breakeven_buffer_percent must be between 0 and 10")
+		}
+		if config.SLTP.RunnerPercent < 0 || config.SLTP.RunnerPercent > maxRunnerPercent {
+			return fmt.Errorf("sltp.runner_percent must be between 0 and %.0f", maxRunnerPercent)
+		}
+		if config.SLTP.RunnerTrailingPercent < 0 || config.SLTP.RunnerTrailingPercent > 100 {
+			return fmt.Errorf("sltp.runner_trailing_percent must be between 0 and 100")
+		}
+		if !validMarginTypeSetting(config.SLTP.MarginType) {
+			return fmt.Errorf("sltp.margin_type must be CROSS or ISOLATED")
+		}
+		if !validExecOrderType(config.SLTP.ExitOrderType) {
+			return fmt.Errorf("sltp.exit_order_type must be MARKET or LIMIT")
+		}
+		if config.SLTP.ExitLimitBufferPercent < 0 || config.SLTP.ExitLimitBufferPercent > maxExitLimitBufferPercent {
+			return fmt.Errorf("sltp.exit_limit_buffer_percent must be between 0 and %.0f", maxExitLimitBufferPercent)
+		}
+	}
+
+	// Validate entry config if present
+	if config.Entry != nil {
+		if config.Entry.MakerTimeoutSeconds < 0 || config.Entry.MakerTimeoutSeconds > 300 {
+			return fmt.Errorf("entry.maker_timeout_seconds must be between 0 and 300")
+		}
+		if config.Entry.MaxSpreadBps < 0 || config.Entry.MaxSpreadBps > 500 {
+			return fmt.Errorf("entry.max_spread_bps must be between 0 and 500")
+		}
+		if config.Entry.MinQuoteVolume24hUSD < 0 {
+			return fmt.Errorf("entry.min_quote_volume_24h_usd cannot be negative")
+		}
+		if config.Entry.MinVolumeRatio < 0 || config.Entry.MinVolumeRatio > 1 {
+			return fmt.Errorf("entry.min_volume_ratio must be between 0 and 1")
+		}
+		if !validExecOrderType(config.Entry.EntryOrderType) {
+			return fmt.Errorf("entry.entry_order_type must be MARKET or LIMIT")
+		}
+	}
+
+	// Validate trend confluence if present
+	if config.TrendDivergence != nil {
+		for _, tf := range config.TrendDivergence.ConfluenceTimeframes {
+			if err := ValidateTimeframe(tf); err != nil {
+				return fmt.Errorf("trend_divergence.confluence_timeframes: %w", err)
+			}
+		}
+		if config.TrendDivergence.RequireConfluence && len(config.TrendDivergence.ConfluenceTimeframes) < 2 {
+			return fmt.Errorf("trend_divergence.confluence_timeframes needs at least 2 timeframes when require_confluence is on")
+		}
+		if config.TrendDivergence.MinTimeframesAgreeing < 0 || config.TrendDivergence.MinTimeframesAgreeing > len(config.TrendDivergence.ConfluenceTimeframes) {
+			return fmt.Errorf("trend_divergence.min_timeframes_agreeing must be between 0 and the number of confluence timeframes")
+		}
+	}
+
+	// Validate risk config if present
+	if config.Risk != nil {
+		if config.Risk.MinRiskReward < 0 || config.Risk.MinRiskReward > 20 {
+			return fmt.Errorf("risk.min_risk_reward must be between 0 and 20")
+		}
+		if config.Risk.LossAlertUSD < 0 {
+			return fmt.Errorf("risk.loss_alert_usd cannot be negative")
+		}
+		if config.Risk.LossAlertPercent < 0 || config.Risk.LossAlertPercent > 100 {
+			return fmt.Errorf("risk.loss_alert_percent must be between 0 and 100")
+		}
 	}
 
 	return nil
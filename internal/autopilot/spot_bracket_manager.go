@@ -0,0 +1,405 @@
+package autopilot
+
+import (
+	"binance-trading-bot/internal/binance"
+	"binance-trading-bot/internal/database"
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// SpotBracketConfig holds spot OCO bracket configuration
+type SpotBracketConfig struct {
+	TakeProfitPercent      float64 `json:"take_profit_percent"`       // Default 5%
+	StopLossPercent        float64 `json:"stop_loss_percent"`         // Default 2%
+	StopLimitOffsetPercent float64 `json:"stop_limit_offset_percent"` // Stop-limit price this % past the trigger
+	BreakevenEnabled       bool    `json:"breakeven_enabled"`         // Move SL to entry once in profit
+	BreakevenActivation    float64 `json:"breakeven_activation"`      // Move to breakeven after this % profit
+	BreakevenBufferPercent float64 `json:"breakeven_buffer_percent"`  // SL this % above entry to cover fees
+	TrailingStopEnabled    bool    `json:"trailing_stop_enabled"`     // Enable trailing stop
+	TrailingStopPercent    float64 `json:"trailing_stop_percent"`     // Trail by this %
+	TrailingActivation     float64 `json:"trailing_activation"`       // Activate trailing after this % profit
+	MinStopMovePercent     float64 `json:"min_stop_move_percent"`     // Skip OCO replacement for smaller SL moves
+	UpdateIntervalSecs     int     `json:"update_interval_secs"`      // How often to check brackets
+}
+
+// DefaultSpotBracketConfig returns default configuration
+func DefaultSpotBracketConfig() *SpotBracketConfig {
+	return &SpotBracketConfig{
+		TakeProfitPercent:      5.0,
+		StopLossPercent:        2.0,
+		StopLimitOffsetPercent: 0.1,
+		BreakevenEnabled:       true,
+		BreakevenActivation:    1.0,
+		BreakevenBufferPercent: 0.2, // Covers 0.1% taker fee each way
+		TrailingStopEnabled:    true,
+		TrailingStopPercent:    1.0,
+		TrailingActivation:     2.0,
+		MinStopMovePercent:     0.1,
+		UpdateIntervalSecs:     5,
+	}
+}
+
+// SpotBracket tracks the OCO exit bracket protecting a spot holding
+type SpotBracket struct {
+	TradeID         int64
+	Symbol          string
+	EntryPrice      float64
+	Quantity        float64
+	TakeProfitPrice float64
+	StopLossPrice   float64
+	HighestPrice    float64
+	LowestPrice     float64
+	BreakevenMoved  bool
+	TrailingActive  bool
+	OrderListID     int64
+	AIDecisionID    *int64
+	OpenedAt        time.Time
+}
+
+// SpotBracketManager protects spot autopilot buys with exchange-side OCO
+// brackets (take profit + stop loss that cancel each other), moving the stop to
+// breakeven and trailing it by replacing the OCO. Unlike separate TP and SL
+// orders, an OCO only locks the holding once, so both legs can rest on the book.
+type SpotBracketManager struct {
+	config     *SpotBracketConfig
+	client     binance.BinanceClient
+	repository *database.Repository
+
+	brackets map[string]*SpotBracket
+	mu       sync.RWMutex
+
+	onExit func(b *SpotBracket, reason string, exitPrice float64)
+
+	stopChan chan struct{}
+	running  bool
+}
+
+// NewSpotBracketManager creates a new spot bracket manager
+func NewSpotBracketManager(config *SpotBracketConfig, client binance.BinanceClient, repo *database.Repository) *SpotBracketManager {
+	if config == nil {
+		config = DefaultSpotBracketConfig()
+	}
+
+	return &SpotBracketManager{
+		config:     config,
+		client:     client,
+		repository: repo,
+		brackets:   make(map[string]*SpotBracket),
+		stopChan:   make(chan struct{}),
+	}
+}
+
+// OnExit sets the callback fired when a bracket leg fills and the holding is closed
+func (sm *SpotBracketManager) OnExit(fn func(b *SpotBracket, reason string, exitPrice float64)) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.onExit = fn
+}
+
+// Start begins the bracket management loop
+func (sm *SpotBracketManager) Start() {
+	sm.mu.Lock()
+	if sm.running {
+		sm.mu.Unlock()
+		return
+	}
+	sm.running = true
+	sm.mu.Unlock()
+
+	log.Printf("[SpotBracket] Starting with TP: %.1f%%, SL: %.1f%%, Breakeven: %v (%.1f%%), Trailing: %v (%.1f%%)",
+		sm.config.TakeProfitPercent, sm.config.StopLossPercent,
+		sm.config.BreakevenEnabled, sm.config.BreakevenActivation,
+		sm.config.TrailingStopEnabled, sm.config.TrailingStopPercent)
+
+	go sm.runLoop()
+}
+
+// Stop stops the bracket manager. Resting OCO orders are left on the exchange.
+func (sm *SpotBracketManager) Stop() {
+	sm.mu.Lock()
+	if !sm.running {
+		sm.mu.Unlock()
+		return
+	}
+	sm.running = false
+	sm.mu.Unlock()
+
+	close(sm.stopChan)
+	log.Printf("[SpotBracket] Stopped")
+}
+
+func (sm *SpotBracketManager) runLoop() {
+	interval := time.Duration(sm.config.UpdateIntervalSecs) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			sm.updateAllBrackets()
+		case <-sm.stopChan:
+			return
+		}
+	}
+}
+
+// RegisterPosition places an OCO bracket for a spot buy. Only BUY entries can be
+// bracketed on spot - a SELL has no holding left to protect.
+func (sm *SpotBracketManager) RegisterPosition(tradeID int64, symbol, side string, entryPrice, quantity float64, aiDecisionID *int64) error {
+	if side != "BUY" {
+		return fmt.Errorf("spot brackets only protect BUY entries, got %s", side)
+	}
+	if entryPrice <= 0 || quantity <= 0 {
+		return fmt.Errorf("invalid entry for %s: price %.8f, quantity %.8f", symbol, entryPrice, quantity)
+	}
+
+	bracket := &SpotBracket{
+		TradeID:         tradeID,
+		Symbol:          symbol,
+		EntryPrice:      entryPrice,
+		Quantity:        quantity,
+		TakeProfitPrice: entryPrice * (1 + sm.config.TakeProfitPercent/100),
+		StopLossPrice:   entryPrice * (1 - sm.config.StopLossPercent/100),
+		HighestPrice:    entryPrice,
+		LowestPrice:     entryPrice,
+		AIDecisionID:    aiDecisionID,
+		OpenedAt:        time.Now(),
+	}
+
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	if existing, ok := sm.brackets[symbol]; ok && existing.OrderListID > 0 {
+		if err := sm.client.CancelOCOOrder(symbol, existing.OrderListID); err != nil {
+			log.Printf("[SpotBracket] Failed to cancel previous OCO for %s: %v", symbol, err)
+		}
+	}
+
+	listID, err := sm.placeBracket(bracket)
+	if err != nil {
+		return fmt.Errorf("failed to place OCO bracket for %s: %w", symbol, err)
+	}
+	bracket.OrderListID = listID
+	sm.brackets[symbol] = bracket
+
+	log.Printf("[SpotBracket] Registered %s: Entry=%.8f, TP=%.8f (%.1f%%), SL=%.8f (%.1f%%), OCO list %d",
+		symbol, entryPrice, bracket.TakeProfitPrice, sm.config.TakeProfitPercent,
+		bracket.StopLossPrice, sm.config.StopLossPercent, listID)
+
+	return nil
+}
+
+func (sm *SpotBracketManager) updateAllBrackets() {
+	sm.mu.RLock()
+	symbols := make([]string, 0, len(sm.brackets))
+	for symbol := range sm.brackets {
+		symbols = append(symbols, symbol)
+	}
+	sm.mu.RUnlock()
+
+	for _, symbol := range symbols {
+		sm.updateBracket(symbol)
+	}
+}
+
+// updateBracket detects a filled bracket, then applies breakeven and trailing
+func (sm *SpotBracketManager) updateBracket(symbol string) {
+	sm.mu.Lock()
+	bracket, exists := sm.brackets[symbol]
+	if !exists {
+		sm.mu.Unlock()
+		return
+	}
+	listID := bracket.OrderListID
+	sm.mu.Unlock()
+
+	if listID > 0 {
+		list, err := sm.client.GetOCOOrder(listID)
+		if err != nil {
+			log.Printf("[SpotBracket] Failed to get OCO status for %s: %v", symbol, err)
+			return
+		}
+		if list.Done() {
+			sm.handleBracketDone(symbol, list)
+			return
+		}
+	}
+
+	currentPrice, err := sm.client.GetCurrentPrice(symbol)
+	if err != nil {
+		log.Printf("[SpotBracket] Failed to get price for %s: %v", symbol, err)
+		return
+	}
+
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	bracket, exists = sm.brackets[symbol]
+	if !exists || bracket.OrderListID != listID {
+		return // Replaced or unregistered while we were fetching
+	}
+
+	// A failed OCO replacement left the holding unprotected - keep retrying
+	if bracket.OrderListID == 0 {
+		if id, err := sm.placeBracket(bracket); err == nil {
+			bracket.OrderListID = id
+			log.Printf("[SpotBracket] %s: Protection restored, OCO list %d", symbol, id)
+		}
+		return
+	}
+
+	if currentPrice > bracket.HighestPrice {
+		bracket.HighestPrice = currentPrice
+	}
+	if currentPrice < bracket.LowestPrice {
+		bracket.LowestPrice = currentPrice
+	}
+	profitPercent := (currentPrice - bracket.EntryPrice) / bracket.EntryPrice * 100
+
+	newSL := bracket.StopLossPrice
+	reason := ""
+
+	if sm.config.BreakevenEnabled && !bracket.BreakevenMoved && profitPercent >= sm.config.BreakevenActivation {
+		breakeven := bracket.EntryPrice * (1 + sm.config.BreakevenBufferPercent/100)
+		bracket.BreakevenMoved = true
+		if breakeven > newSL && breakeven < currentPrice {
+			newSL = breakeven
+			reason = "breakeven"
+		}
+	}
+
+	if sm.config.TrailingStopEnabled {
+		if !bracket.TrailingActive && profitPercent >= sm.config.TrailingActivation {
+			bracket.TrailingActive = true
+			log.Printf("[SpotBracket] %s: Trailing stop activated at %.2f%% profit", symbol, profitPercent)
+		}
+		if bracket.TrailingActive {
+			if trailed := bracket.HighestPrice * (1 - sm.config.TrailingStopPercent/100); trailed > newSL {
+				newSL = trailed
+				reason = "trailing"
+			}
+		}
+	}
+
+	// Only replace the OCO for meaningful moves - every replacement is a
+	// cancel + place round trip during which the holding is unprotected
+	minMove := bracket.StopLossPrice * sm.config.MinStopMovePercent / 100
+	if reason != "" && newSL-bracket.StopLossPrice > minMove {
+		sm.moveStopLoss(bracket, newSL, reason)
+	}
+
+	if sm.repository != nil {
+		sm.repository.UpdateTradeTrailingInfo(context.Background(), bracket.TradeID, bracket.HighestPrice, bracket.LowestPrice, bracket.StopLossPrice)
+	}
+}
+
+// moveStopLoss replaces the OCO with one at the new stop. Caller must hold sm.mu.
+func (sm *SpotBracketManager) moveStopLoss(bracket *SpotBracket, newSL float64, reason string) {
+	if err := sm.client.CancelOCOOrder(bracket.Symbol, bracket.OrderListID); err != nil {
+		// Most likely a leg just filled - the next update will see the list as done
+		log.Printf("[SpotBracket] %s: Failed to cancel OCO %d for %s stop move: %v",
+			bracket.Symbol, bracket.OrderListID, reason, err)
+		return
+	}
+
+	oldSL := bracket.StopLossPrice
+	bracket.StopLossPrice = newSL
+
+	listID, err := sm.placeBracket(bracket)
+	if err != nil {
+		// Put the old bracket back rather than leave the holding unprotected
+		log.Printf("[SpotBracket] %s: Failed to place OCO at new SL %.8f, restoring %.8f: %v",
+			bracket.Symbol, newSL, oldSL, err)
+		bracket.StopLossPrice = oldSL
+		if listID, err = sm.placeBracket(bracket); err != nil {
+			log.Printf("[SpotBracket] %s: CRITICAL - failed to restore OCO, holding is unprotected: %v", bracket.Symbol, err)
+			bracket.OrderListID = 0
+			return
+		}
+	}
+	bracket.OrderListID = listID
+
+	log.Printf("[SpotBracket] %s: SL moved (%s): %.8f -> %.8f, OCO list %d",
+		bracket.Symbol, reason, oldSL, bracket.StopLossPrice, listID)
+}
+
+// handleBracketDone works out which leg filled and stops managing the bracket
+func (sm *SpotBracketManager) handleBracketDone(symbol string, list *binance.OCOOrderList) {
+	reason, exitPrice := "cancelled", 0.0
+	for _, ref := range list.Orders {
+		order, err := sm.client.GetOrder(symbol, ref.OrderId)
+		if err != nil || order.Status != "FILLED" {
+			continue
+		}
+		reason = "stop_loss"
+		if order.Type == "LIMIT_MAKER" {
+			reason = "take_profit"
+		}
+		exitPrice = order.Price
+		if order.ExecutedQty > 0 && order.CummulativeQuoteQty > 0 {
+			exitPrice = order.CummulativeQuoteQty / order.ExecutedQty
+		}
+		break
+	}
+
+	sm.mu.Lock()
+	bracket, exists := sm.brackets[symbol]
+	if !exists || bracket.OrderListID != list.OrderListId {
+		sm.mu.Unlock()
+		return
+	}
+	delete(sm.brackets, symbol)
+	onExit := sm.onExit
+	sm.mu.Unlock()
+
+	if reason == "cancelled" {
+		log.Printf("[SpotBracket] %s: OCO list %d ended without a fill (cancelled externally?) - no longer managed",
+			symbol, list.OrderListId)
+	} else {
+		log.Printf("[SpotBracket] %s: Closed by %s @ %.8f (entry %.8f, %.2f%%)",
+			symbol, reason, exitPrice, bracket.EntryPrice, (exitPrice-bracket.EntryPrice)/bracket.EntryPrice*100)
+	}
+
+	if onExit != nil {
+		onExit(bracket, reason, exitPrice)
+	}
+}
+
+// placeBracket places the OCO for a bracket's current TP/SL and returns its list ID
+func (sm *SpotBracketManager) placeBracket(bracket *SpotBracket) (int64, error) {
+	params := binance.OCOOrderParams{
+		Symbol:          bracket.Symbol,
+		Side:            "SELL",
+		Quantity:        bracket.Quantity,
+		TakeProfitPrice: bracket.TakeProfitPrice,
+		StopPrice:       bracket.StopLossPrice,
+	}
+	if sm.config.StopLimitOffsetPercent > 0 {
+		params.StopLimitPrice = bracket.StopLossPrice * (1 - sm.config.StopLimitOffsetPercent/100)
+	}
+
+	list, err := sm.client.PlaceOCOOrder(params)
+	if err != nil {
+		return 0, err
+	}
+	return list.OrderListId, nil
+}
+
+// GetAllBrackets returns copies of all managed brackets
+func (sm *SpotBracketManager) GetAllBrackets() map[string]*SpotBracket {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	result := make(map[string]*SpotBracket)
+	for k, v := range sm.brackets {
+		copy := *v
+		result[k] = &copy
+	}
+	return result
+}
@@ -0,0 +1,269 @@
+package autopilot
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"testing"
+
+	"binance-trading-bot/internal/binance"
+)
+
+// ocoStubClient is a spot client with a settable price that records OCO
+// placements and cancellations
+type ocoStubClient struct {
+	binance.BinanceClient
+
+	mu        sync.Mutex
+	price     float64
+	nextID    int64
+	placed    []binance.OCOOrderParams
+	lists     map[int64]*binance.OCOOrderList
+	orders    map[int64]*binance.OrderResponse
+	cancelled []int64
+	failPlace int // Fail this many upcoming placements
+}
+
+func newOCOStubClient(price float64) *ocoStubClient {
+	return &ocoStubClient{
+		price:  price,
+		lists:  make(map[int64]*binance.OCOOrderList),
+		orders: make(map[int64]*binance.OrderResponse),
+	}
+}
+
+func (c *ocoStubClient) setPrice(p float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.price = p
+}
+
+func (c *ocoStubClient) GetCurrentPrice(symbol string) (float64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.price, nil
+}
+
+func (c *ocoStubClient) PlaceOCOOrder(p binance.OCOOrderParams) (*binance.OCOOrderList, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.failPlace > 0 {
+		c.failPlace--
+		return nil, fmt.Errorf("API error: placement rejected")
+	}
+	c.nextID++
+	list := &binance.OCOOrderList{OrderListId: c.nextID, ListOrderStatus: binance.OCOListStatusExecuting, Symbol: p.Symbol}
+	for i, orderType := range []string{"LIMIT_MAKER", "STOP_LOSS_LIMIT"} {
+		id := c.nextID*10 + int64(i)
+		c.orders[id] = &binance.OrderResponse{Symbol: p.Symbol, OrderId: id, Type: orderType, Status: "NEW"}
+		list.Orders = append(list.Orders, binance.OCOOrderRef{Symbol: p.Symbol, OrderId: id})
+	}
+	c.lists[list.OrderListId] = list
+	c.placed = append(c.placed, p)
+	result := *list
+	return &result, nil
+}
+
+func (c *ocoStubClient) CancelOCOOrder(symbol string, orderListID int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	list, ok := c.lists[orderListID]
+	if !ok || list.Done() {
+		return fmt.Errorf("API error: unknown or finished order list %d", orderListID)
+	}
+	list.ListOrderStatus = binance.OCOListStatusAllDone
+	c.cancelled = append(c.cancelled, orderListID)
+	return nil
+}
+
+func (c *ocoStubClient) GetOCOOrder(orderListID int64) (*binance.OCOOrderList, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	list, ok := c.lists[orderListID]
+	if !ok {
+		return nil, fmt.Errorf("API error: unknown order list %d", orderListID)
+	}
+	result := *list
+	return &result, nil
+}
+
+func (c *ocoStubClient) GetOrder(symbol string, orderId int64) (*binance.OrderResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	order, ok := c.orders[orderId]
+	if !ok {
+		return nil, fmt.Errorf("API error: unknown order %d", orderId)
+	}
+	result := *order
+	return &result, nil
+}
+
+// fill completes one leg of an OCO list (0 = take profit, 1 = stop loss)
+func (c *ocoStubClient) fill(listID int64, leg int, qty, quote float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	list := c.lists[listID]
+	list.ListOrderStatus = binance.OCOListStatusAllDone
+	order := c.orders[list.Orders[leg].OrderId]
+	order.Status = "FILLED"
+	order.ExecutedQty = qty
+	order.CummulativeQuoteQty = quote
+}
+
+func (c *ocoStubClient) lastPlaced() binance.OCOOrderParams {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.placed[len(c.placed)-1]
+}
+
+func approxEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func newTestSpotBracketManager(client *ocoStubClient) *SpotBracketManager {
+	config := DefaultSpotBracketConfig()
+	config.TakeProfitPercent = 5
+	config.StopLossPercent = 2
+	config.StopLimitOffsetPercent = 0.1
+	config.BreakevenActivation = 1
+	config.BreakevenBufferPercent = 0.2
+	config.TrailingActivation = 2
+	config.TrailingStopPercent = 1
+	config.MinStopMovePercent = 0.1
+	return NewSpotBracketManager(config, client, nil)
+}
+
+func TestSpotBracketRegisterPosition(t *testing.T) {
+	client := newOCOStubClient(100)
+	sm := newTestSpotBracketManager(client)
+
+	if err := sm.RegisterPosition(1, "BTCUSDT", "SELL", 100, 1, nil); err == nil {
+		t.Error("SELL entry accepted")
+	}
+	// A MARKET order's reported price is 0; the caller must pass the fill price
+	if err := sm.RegisterPosition(1, "BTCUSDT", "BUY", 0, 1, nil); err == nil {
+		t.Error("zero entry price accepted")
+	}
+
+	if err := sm.RegisterPosition(1, "BTCUSDT", "BUY", 100, 0.5, nil); err != nil {
+		t.Fatalf("RegisterPosition: %v", err)
+	}
+	p := client.lastPlaced()
+	if p.Side != "SELL" || p.Quantity != 0.5 || !approxEqual(p.TakeProfitPrice, 105) ||
+		!approxEqual(p.StopPrice, 98) || !approxEqual(p.StopLimitPrice, 98*0.999) {
+		t.Errorf("placed %+v, want SELL 0.5 TP 105 stop 98 limit 97.902", p)
+	}
+
+	// Registering the symbol again replaces the resting bracket
+	if err := sm.RegisterPosition(2, "BTCUSDT", "BUY", 110, 0.5, nil); err != nil {
+		t.Fatalf("RegisterPosition again: %v", err)
+	}
+	if len(client.cancelled) != 1 || client.cancelled[0] != 1 {
+		t.Errorf("cancelled %v, want the first list", client.cancelled)
+	}
+	if brackets := sm.GetAllBrackets(); len(brackets) != 1 || brackets["BTCUSDT"].TradeID != 2 {
+		t.Errorf("brackets %+v, want only trade 2", brackets)
+	}
+}
+
+func TestSpotBracketBreakevenAndTrailing(t *testing.T) {
+	client := newOCOStubClient(100)
+	sm := newTestSpotBracketManager(client)
+	if err := sm.RegisterPosition(1, "ETHUSDT", "BUY", 100, 1, nil); err != nil {
+		t.Fatalf("RegisterPosition: %v", err)
+	}
+
+	// Below breakeven activation nothing moves
+	client.setPrice(100.5)
+	sm.updateBracket("ETHUSDT")
+	if len(client.placed) != 1 {
+		t.Fatalf("bracket replaced at +0.5%%: %d placements", len(client.placed))
+	}
+
+	client.setPrice(101.2)
+	sm.updateBracket("ETHUSDT")
+	b := sm.GetAllBrackets()["ETHUSDT"]
+	if !b.BreakevenMoved || !approxEqual(b.StopLossPrice, 100.2) || b.OrderListID != 2 {
+		t.Fatalf("after +1.2%%: %+v, want SL 100.2 on list 2", b)
+	}
+	if client.lastPlaced().StopPrice != b.StopLossPrice {
+		t.Errorf("exchange stop %.4f, tracked stop %.4f", client.lastPlaced().StopPrice, b.StopLossPrice)
+	}
+
+	client.setPrice(103)
+	sm.updateBracket("ETHUSDT")
+	b = sm.GetAllBrackets()["ETHUSDT"]
+	if !b.TrailingActive || !approxEqual(b.StopLossPrice, 103*0.99) || b.OrderListID != 3 {
+		t.Fatalf("after +3%%: %+v, want trailing SL 101.97 on list 3", b)
+	}
+
+	// A pullback never lowers the stop, and a tick higher is below the minimum move
+	client.setPrice(102)
+	sm.updateBracket("ETHUSDT")
+	client.setPrice(103.05)
+	sm.updateBracket("ETHUSDT")
+	if b = sm.GetAllBrackets()["ETHUSDT"]; b.OrderListID != 3 || b.HighestPrice != 103.05 {
+		t.Errorf("after pullback and small high: %+v, want list 3 kept with high 103.05", b)
+	}
+}
+
+func TestSpotBracketFailedReplacementRestoresStop(t *testing.T) {
+	client := newOCOStubClient(100)
+	sm := newTestSpotBracketManager(client)
+	if err := sm.RegisterPosition(1, "SOLUSDT", "BUY", 100, 1, nil); err != nil {
+		t.Fatalf("RegisterPosition: %v", err)
+	}
+
+	client.failPlace = 1
+	client.setPrice(101.2)
+	sm.updateBracket("SOLUSDT")
+	b := sm.GetAllBrackets()["SOLUSDT"]
+	if !approxEqual(b.StopLossPrice, 98) || b.OrderListID == 0 {
+		t.Fatalf("after failed move: %+v, want the original SL 98 on a live list", b)
+	}
+
+	// Both placements fail: the holding is unprotected until the next update
+	client.failPlace = 2
+	client.setPrice(103)
+	sm.updateBracket("SOLUSDT")
+	if b = sm.GetAllBrackets()["SOLUSDT"]; b.OrderListID != 0 {
+		t.Fatalf("after failed restore: list %d, want 0", b.OrderListID)
+	}
+	sm.updateBracket("SOLUSDT")
+	if b = sm.GetAllBrackets()["SOLUSDT"]; b.OrderListID == 0 {
+		t.Error("protection not restored on the next update")
+	}
+}
+
+func TestSpotBracketExitFiresOnExit(t *testing.T) {
+	client := newOCOStubClient(100)
+	sm := newTestSpotBracketManager(client)
+
+	var gotReason string
+	var gotPrice float64
+	sm.OnExit(func(b *SpotBracket, reason string, exitPrice float64) {
+		gotReason, gotPrice = reason, exitPrice
+	})
+
+	if err := sm.RegisterPosition(1, "BNBUSDT", "BUY", 100, 2, nil); err != nil {
+		t.Fatalf("RegisterPosition: %v", err)
+	}
+	client.fill(1, 0, 2, 210.2)
+	sm.updateBracket("BNBUSDT")
+
+	if gotReason != "take_profit" || !approxEqual(gotPrice, 105.1) {
+		t.Errorf("onExit(%q, %.4f), want take_profit at the 105.1 average fill", gotReason, gotPrice)
+	}
+	if len(sm.GetAllBrackets()) != 0 {
+		t.Error("bracket still managed after its take profit filled")
+	}
+
+	if err := sm.RegisterPosition(2, "BNBUSDT", "BUY", 100, 2, nil); err != nil {
+		t.Fatalf("RegisterPosition: %v", err)
+	}
+	client.fill(2, 1, 2, 195.8)
+	sm.updateBracket("BNBUSDT")
+	if gotReason != "stop_loss" || !approxEqual(gotPrice, 97.9) {
+		t.Errorf("onExit(%q, %.4f), want stop_loss at 97.9", gotReason, gotPrice)
+	}
+}
@@ -132,7 +132,7 @@ func NewSpotController(client binance.BinanceClient, logger *logging.Logger) *Sp
 	settings := sm.GetDefaultSettings()
 
 	config.Enabled = settings.SpotAutopilotEnabled
-	config.DryRun = settings.SpotDryRunMode
+	config.DryRun = settings.SpotDryRunMode || IsDryRunForced()
 	config.RiskLevel = settings.SpotRiskLevel
 	if settings.SpotMaxPositions > 0 {
 		config.MaxPositions = settings.SpotMaxPositions
@@ -181,7 +181,12 @@ func (sc *SpotController) GetConfig() *SpotControllerConfig {
 func (sc *SpotController) SetDryRun(enabled bool) {
 	sc.mu.Lock()
 	defer sc.mu.Unlock()
-	sc.config.DryRun = enabled
+	sc.config.DryRun = enabled || IsDryRunForced()
+}
+
+// isDryRun reports paper mode, honoring a kill switch engaged after startup
+func (sc *SpotController) isDryRun() bool {
+	return sc.config.DryRun || IsDryRunForced()
 }
 
 // SetRiskLevel sets the risk level
@@ -519,9 +524,9 @@ func (sc *SpotController) executeBuy(symbol string, decision *SpotDecision) {
 		"price", price,
 		"quantity", quantity,
 		"confidence", decision.Confidence,
-		"dry_run", sc.config.DryRun)
+		"dry_run", sc.isDryRun())
 
-	if !sc.config.DryRun {
+	if !sc.isDryRun() {
 		// Place market buy order
 		params := map[string]string{
 			"symbol": symbol,
@@ -618,7 +623,7 @@ func (sc *SpotController) closePosition(symbol string, pos *SpotPosition, curren
 		"pnl", pnl,
 		"pnl_percent", pnlPercent)
 
-	if !sc.config.DryRun {
+	if !sc.isDryRun() {
 		// Place market sell order
 		params := map[string]string{
 			"symbol":   symbol,
@@ -684,7 +689,7 @@ func (sc *SpotController) GetStatus() map[string]interface{} {
 	return map[string]interface{}{
 		"enabled":              sc.config.Enabled,
 		"running":              sc.running,
-		"dry_run":              sc.config.DryRun,
+		"dry_run":              sc.isDryRun(),
 		"risk_level":           sc.config.RiskLevel,
 		"max_positions":        sc.config.MaxPositions,
 		"max_usd_per_position": sc.config.MaxUSDPerPosition,
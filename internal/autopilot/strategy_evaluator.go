@@ -3,6 +3,7 @@ package autopilot
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -36,6 +37,7 @@ type LoadedStrategy struct {
 	PositionSizePct  float64
 	StopLossPercent  float64
 	TakeProfitPercent float64
+	Schedule         *database.TradingSchedule // nil = always on
 }
 
 // StrategyEvaluator handles loading and evaluating saved strategies
@@ -136,11 +138,13 @@ func (se *StrategyEvaluator) loadStrategyFromConfig(config *database.StrategyCon
 	if strat == nil {
 		switch config.IndicatorType {
 		case "breakout":
+			requireConfirmation, _ := config.ConfigParams["require_confirmation_candle"].(bool)
 			strat = strategy.NewBreakoutStrategy(&strategy.BreakoutConfig{
-				Symbol:     config.Symbol,
-				Interval:   config.Timeframe,
-				StopLoss:   config.StopLossPercent / 100,
-				TakeProfit: config.TakeProfitPercent / 100,
+				Symbol:                    config.Symbol,
+				Interval:                  config.Timeframe,
+				StopLoss:                  config.StopLossPercent / 100,
+				TakeProfit:                config.TakeProfitPercent / 100,
+				RequireConfirmationCandle: requireConfirmation,
 			})
 		case "rsi":
 			strat = strategy.NewRSIStrategy(&strategy.RSIStrategyConfig{
@@ -175,6 +179,7 @@ func (se *StrategyEvaluator) loadStrategyFromConfig(config *database.StrategyCon
 		PositionSizePct:   config.PositionSize,
 		StopLossPercent:   config.StopLossPercent,
 		TakeProfitPercent: config.TakeProfitPercent,
+		Schedule:          config.Schedule,
 	}, nil
 }
 
@@ -213,6 +218,11 @@ func (se *StrategyEvaluator) EvaluateStrategy(loaded LoadedStrategy) (*StrategyS
 		return nil, fmt.Errorf("strategy evaluation failed for %s: %w", loaded.Name, err)
 	}
 
+	if signal != nil && signal.Status == strategy.SignalStatusProvisional {
+		se.logger.Info("Strategy %s provisional signal for %s: %s", loaded.Name, loaded.Symbol, signal.Reason)
+		return nil, nil // Not tradable until the confirmation candle closes
+	}
+
 	// Check if signal is triggered
 	if signal == nil || signal.Type == strategy.SignalNone {
 		return nil, nil // No signal
@@ -276,7 +286,13 @@ func (se *StrategyEvaluator) EvaluateAllStrategies() ([]StrategySignal, error) {
 	// Evaluate strategies in parallel (max 5 concurrent)
 	semaphore := make(chan struct{}, 5)
 
+	now := time.Now()
 	for _, strat := range strategies {
+		if !strat.Schedule.IsOpen(now) {
+			se.logger.Debug("Strategy %s skipped: %s", strat.Name, RejectionOutsideTradingHours)
+			continue
+		}
+
 		wg.Add(1)
 		go func(s LoadedStrategy) {
 			defer wg.Done()
@@ -317,3 +333,59 @@ func (se *StrategyEvaluator) InvalidateCache() {
 	defer se.cacheMu.Unlock()
 	se.lastLoad = time.Time{} // Reset to zero time
 }
+
+// StrategyEvaluation is the dry-run result of a strategy config on one symbol
+type StrategyEvaluation struct {
+	Symbol    string          `json:"symbol"`
+	Triggered bool            `json:"triggered"`
+	Signal    *StrategySignal `json:"signal,omitempty"`
+	Error     string          `json:"error,omitempty"`
+}
+
+// EvaluateConfig runs an unsaved strategy config against fresh klines for each
+// symbol (config.Symbol when none are given) and reports what it would signal.
+// Nothing is cached or traded.
+func (se *StrategyEvaluator) EvaluateConfig(config *database.StrategyConfig, symbols []string) []StrategyEvaluation {
+	if len(symbols) == 0 {
+		symbols = []string{config.Symbol}
+	}
+
+	results := make([]StrategyEvaluation, len(symbols))
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, 5)
+
+	for i, symbol := range symbols {
+		symbol = strings.ToUpper(strings.TrimSpace(symbol))
+		if !strings.HasSuffix(symbol, "USDT") {
+			symbol += "USDT"
+		}
+		results[i].Symbol = symbol
+
+		wg.Add(1)
+		go func(i int, symbol string) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			// Each symbol gets its own strategy instance since some bind the symbol at construction
+			symbolConfig := *config
+			symbolConfig.Symbol = symbol
+			loaded, err := se.loadStrategyFromConfig(&symbolConfig)
+			if err != nil {
+				results[i].Error = err.Error()
+				return
+			}
+
+			signal, err := se.EvaluateStrategy(*loaded)
+			if err != nil {
+				results[i].Error = err.Error()
+				return
+			}
+			results[i].Triggered = signal != nil
+			results[i].Signal = signal
+		}(i, symbol)
+	}
+
+	wg.Wait()
+	return results
+}
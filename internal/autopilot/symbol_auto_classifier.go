@@ -0,0 +1,311 @@
+package autopilot
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"binance-trading-bot/internal/database"
+	"binance-trading-bot/internal/logging"
+)
+
+// Auto-classifier defaults, used when the settings leave them at 0
+const (
+	defaultSymbolClassifyIntervalHours = 6
+	defaultSymbolClassifyMinTrades     = 10
+	defaultSymbolClassifyLookbackDays  = 30
+)
+
+// symbolClassifierCheckInterval is how often the classifier wakes to see
+// whether it is enabled and a run is due
+const symbolClassifierCheckInterval = 15 * time.Minute
+
+// SymbolClassification is the auto-classifier's verdict for one symbol
+type SymbolClassification struct {
+	Symbol           string                    `json:"symbol"`
+	TotalTrades      int                       `json:"total_trades"`
+	WinRate          float64                   `json:"win_rate"`
+	Expectancy       float64                   `json:"expectancy"` // Expected R per trade
+	TotalPnL         float64                   `json:"total_pnl"`
+	AutoCategory     SymbolPerformanceCategory `json:"auto_category"` // Tier the classifier computed
+	Category         SymbolPerformanceCategory `json:"category"`      // Category in effect after the run
+	Overridden       bool                      `json:"overridden"`    // User-pinned (or blacklisted) - not changed
+	PreviousCategory SymbolPerformanceCategory `json:"previous_category"`
+	CategoryMoved    bool                      `json:"category_moved"`
+}
+
+// SymbolClassificationRun summarises one auto-classifier run
+type SymbolClassificationRun struct {
+	RanAt        time.Time              `json:"ran_at"`
+	LookbackDays int                    `json:"lookback_days"`
+	MinTrades    int                    `json:"min_trades"`
+	Classified   int                    `json:"classified"`
+	Changed      int                    `json:"changed"`
+	Symbols      []SymbolClassification `json:"symbols"`
+}
+
+// classifySymbolTier buckets a symbol by realized expectancy (in R, the average
+// loss) and win rate. Blacklisting is never automatic.
+func classifySymbolTier(winRate, expectancy float64, totalTrades, minTrades int) SymbolPerformanceCategory {
+	if totalTrades < minTrades {
+		return PerformanceNeutral
+	}
+	switch {
+	case expectancy >= 0.5 && winRate >= 50:
+		return PerformanceBest
+	case expectancy >= 0.15:
+		return PerformanceGood
+	case expectancy > -0.15:
+		return PerformanceNeutral
+	case expectancy > -0.5:
+		return PerformancePoor
+	default:
+		return PerformanceWorst
+	}
+}
+
+// symbolExpectancy returns the expected R per trade from aggregated trade stats:
+// (win% * avg win - loss% * avg loss) / avg loss. A symbol with no losses is
+// capped at 1R rather than infinity.
+func symbolExpectancy(stats *database.SymbolPerformanceStats) float64 {
+	if stats.TotalTrades == 0 {
+		return 0
+	}
+	winRate := float64(stats.WinningTrades) / float64(stats.TotalTrades)
+	expectancyUSD := winRate*stats.AvgWin - (1-winRate)*stats.AvgLoss
+	if stats.AvgLoss <= 0 {
+		if expectancyUSD > 0 {
+			return 1
+		}
+		return 0
+	}
+	return expectancyUSD / stats.AvgLoss
+}
+
+// ApplySymbolClassification refreshes per-symbol performance metrics from the
+// given trade stats and moves each symbol to its computed tier, except symbols
+// whose category is user-pinned (CategoryOverride) or blacklisted
+func (sm *SettingsManager) ApplySymbolClassification(stats map[string]*database.SymbolPerformanceStats, minTrades int) (*SymbolClassificationRun, error) {
+	settings := sm.GetDefaultSettings()
+	if settings.SymbolSettings == nil {
+		settings.SymbolSettings = make(map[string]*SymbolSettings)
+	}
+
+	run := &SymbolClassificationRun{RanAt: time.Now(), MinTrades: minTrades}
+	now := run.RanAt.Format("2006-01-02 15:04:05")
+
+	for symbol, st := range stats {
+		ss, exists := settings.SymbolSettings[symbol]
+		if !exists {
+			ss = &SymbolSettings{
+				Symbol:         symbol,
+				Category:       PerformanceNeutral,
+				SizeMultiplier: 1.0,
+				Enabled:        true,
+			}
+			settings.SymbolSettings[symbol] = ss
+		}
+
+		ss.TotalTrades = st.TotalTrades
+		ss.WinningTrades = st.WinningTrades
+		ss.TotalPnL = st.TotalPnL
+		ss.AvgPnL = st.AvgPnL
+		if st.TotalTrades > 0 {
+			ss.WinRate = float64(st.WinningTrades) / float64(st.TotalTrades) * 100
+		}
+		ss.Expectancy = symbolExpectancy(st)
+		ss.AutoCategory = classifySymbolTier(ss.WinRate, ss.Expectancy, ss.TotalTrades, minTrades)
+		ss.LastUpdated = now
+
+		result := SymbolClassification{
+			Symbol:           symbol,
+			TotalTrades:      ss.TotalTrades,
+			WinRate:          ss.WinRate,
+			Expectancy:       ss.Expectancy,
+			TotalPnL:         ss.TotalPnL,
+			AutoCategory:     ss.AutoCategory,
+			PreviousCategory: ss.Category,
+			Overridden:       ss.CategoryOverride || ss.Category == PerformanceBlacklist,
+		}
+		if !result.Overridden && ss.Category != ss.AutoCategory {
+			ss.Category = ss.AutoCategory
+			result.CategoryMoved = true
+			run.Changed++
+		}
+		result.Category = ss.Category
+
+		run.Symbols = append(run.Symbols, result)
+		run.Classified++
+	}
+
+	sort.Slice(run.Symbols, func(i, j int) bool { return run.Symbols[i].Expectancy > run.Symbols[j].Expectancy })
+
+	if err := sm.SaveSettings(settings); err != nil {
+		return run, err
+	}
+	return run, nil
+}
+
+// SetSymbolCategoryOverride pins a symbol's category so the auto-classifier
+// stops changing it
+func (sm *SettingsManager) SetSymbolCategoryOverride(symbol string, category SymbolPerformanceCategory) error {
+	settings := sm.GetDefaultSettings()
+	if settings.SymbolSettings == nil {
+		settings.SymbolSettings = make(map[string]*SymbolSettings)
+	}
+
+	ss, exists := settings.SymbolSettings[symbol]
+	if !exists {
+		ss = &SymbolSettings{Symbol: symbol, SizeMultiplier: 1.0}
+		settings.SymbolSettings[symbol] = ss
+	}
+	ss.Category = category
+	ss.Enabled = category != PerformanceBlacklist
+	ss.CategoryOverride = true
+	ss.LastUpdated = time.Now().Format("2006-01-02 15:04:05")
+
+	return sm.SaveSettings(settings)
+}
+
+// ClearSymbolCategoryOverride hands a symbol back to the auto-classifier,
+// restoring its last computed tier right away
+func (sm *SettingsManager) ClearSymbolCategoryOverride(symbol string) error {
+	settings := sm.GetDefaultSettings()
+	if settings.SymbolSettings == nil || settings.SymbolSettings[symbol] == nil {
+		return nil
+	}
+
+	ss := settings.SymbolSettings[symbol]
+	ss.CategoryOverride = false
+	if ss.AutoCategory != "" {
+		ss.Category = ss.AutoCategory
+		ss.Enabled = true
+	}
+	ss.LastUpdated = time.Now().Format("2006-01-02 15:04:05")
+
+	return sm.SaveSettings(settings)
+}
+
+// SymbolAutoClassifier periodically re-tiers every traded symbol's performance
+// category from closed trades across all users, so the category confidence
+// boosts and size multipliers track actual results. It is a no-op unless
+// symbol_auto_classify_enabled is set.
+type SymbolAutoClassifier struct {
+	repo   *database.Repository
+	logger *logging.Logger
+
+	lastRun *SymbolClassificationRun
+	mu      sync.RWMutex
+
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+}
+
+// NewSymbolAutoClassifier creates the classifier
+func NewSymbolAutoClassifier(repo *database.Repository, logger *logging.Logger) *SymbolAutoClassifier {
+	return &SymbolAutoClassifier{
+		repo:     repo,
+		logger:   logger,
+		stopChan: make(chan struct{}),
+	}
+}
+
+// Start begins the periodic classification loop
+func (c *SymbolAutoClassifier) Start() {
+	c.wg.Add(1)
+	go c.run()
+}
+
+// Stop ends the classification loop
+func (c *SymbolAutoClassifier) Stop() {
+	close(c.stopChan)
+	c.wg.Wait()
+}
+
+// LastRun returns the most recent run (nil before the first one)
+func (c *SymbolAutoClassifier) LastRun() *SymbolClassificationRun {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.lastRun
+}
+
+func (c *SymbolAutoClassifier) run() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(symbolClassifierCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if c.due() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			if _, err := c.RunOnce(ctx); err != nil {
+				c.logger.Warn("Symbol auto-classification failed", "error", err)
+			}
+			cancel()
+		}
+
+		select {
+		case <-c.stopChan:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// due reports whether the classifier is enabled and its interval has passed
+func (c *SymbolAutoClassifier) due() bool {
+	settings := GetSettingsManager().GetDefaultSettings()
+	if settings == nil || !settings.SymbolAutoClassifyEnabled {
+		return false
+	}
+	interval := settings.SymbolAutoClassifyIntervalHours
+	if interval <= 0 {
+		interval = defaultSymbolClassifyIntervalHours
+	}
+
+	last := c.LastRun()
+	return last == nil || time.Since(last.RanAt) >= time.Duration(interval)*time.Hour
+}
+
+// RunOnce classifies every symbol traded in the lookback window now,
+// regardless of whether the periodic run is enabled
+func (c *SymbolAutoClassifier) RunOnce(ctx context.Context) (*SymbolClassificationRun, error) {
+	if c.repo == nil {
+		return nil, fmt.Errorf("database not available")
+	}
+
+	sm := GetSettingsManager()
+	settings := sm.GetDefaultSettings()
+	minTrades := settings.SymbolAutoClassifyMinTrades
+	if minTrades <= 0 {
+		minTrades = defaultSymbolClassifyMinTrades
+	}
+	lookbackDays := settings.SymbolAutoClassifyLookbackDays
+	if lookbackDays <= 0 {
+		lookbackDays = defaultSymbolClassifyLookbackDays
+	}
+
+	since := time.Now().AddDate(0, 0, -lookbackDays)
+	stats, err := c.repo.ReadOnly().GetDB().GetSymbolPerformanceStatsSince(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+
+	run, err := sm.ApplySymbolClassification(stats, minTrades)
+	if run != nil {
+		run.LookbackDays = lookbackDays
+		c.mu.Lock()
+		c.lastRun = run
+		c.mu.Unlock()
+	}
+	if err != nil {
+		return run, fmt.Errorf("failed to save symbol categories: %w", err)
+	}
+
+	log.Printf("[SYMBOL-CLASSIFIER] Classified %d symbols over %d days, %d categories changed",
+		run.Classified, lookbackDays, run.Changed)
+	return run, nil
+}
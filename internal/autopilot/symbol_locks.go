@@ -0,0 +1,97 @@
+package autopilot
+
+import (
+	"sort"
+	"sync"
+)
+
+// symbolLocks is a keyed mutex that serializes operations on the same symbol
+// (opening, monitoring, closing, editing SL/TP) from start to finish, while
+// operations on different symbols run in parallel. Entries are created on
+// first use and removed once no goroutine holds or waits for them.
+//
+// Lock order: take the symbol lock before ga.mu, never while holding ga.mu.
+// The lock is not reentrant, so helpers called from a locked entry point
+// (closePosition, executePartialClose, ...) must not take it again.
+type symbolLocks struct {
+	mu    sync.Mutex
+	locks map[string]*symbolLock
+}
+
+type symbolLock struct {
+	mu   sync.Mutex
+	refs int // holders plus waiters
+}
+
+func newSymbolLocks() *symbolLocks {
+	return &symbolLocks{locks: make(map[string]*symbolLock)}
+}
+
+// Lock blocks until the symbol's lock is held and returns its unlock func
+func (s *symbolLocks) Lock(symbol string) (unlock func()) {
+	l := s.acquire(symbol)
+	l.mu.Lock()
+	return func() { s.release(symbol, l) }
+}
+
+// TryLock takes the symbol's lock only if it is free
+func (s *symbolLocks) TryLock(symbol string) (unlock func(), ok bool) {
+	l := s.acquire(symbol)
+	if !l.mu.TryLock() {
+		s.drop(symbol, l)
+		return nil, false
+	}
+	return func() { s.release(symbol, l) }, true
+}
+
+// LockAll takes the locks for several symbols in sorted order, so two callers
+// locking overlapping sets cannot deadlock, and returns a func releasing them
+func (s *symbolLocks) LockAll(symbols []string) (unlock func()) {
+	sorted := append([]string(nil), symbols...)
+	sort.Strings(sorted)
+	unlocks := make([]func(), 0, len(sorted))
+	for i, symbol := range sorted {
+		if i > 0 && symbol == sorted[i-1] {
+			continue
+		}
+		unlocks = append(unlocks, s.Lock(symbol))
+	}
+	return func() {
+		for i := len(unlocks) - 1; i >= 0; i-- {
+			unlocks[i]()
+		}
+	}
+}
+
+// Len returns the number of symbols currently locked or waited on
+func (s *symbolLocks) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.locks)
+}
+
+func (s *symbolLocks) acquire(symbol string) *symbolLock {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l, ok := s.locks[symbol]
+	if !ok {
+		l = &symbolLock{}
+		s.locks[symbol] = l
+	}
+	l.refs++
+	return l
+}
+
+func (s *symbolLocks) release(symbol string, l *symbolLock) {
+	l.mu.Unlock()
+	s.drop(symbol, l)
+}
+
+func (s *symbolLocks) drop(symbol string, l *symbolLock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l.refs--
+	if l.refs == 0 {
+		delete(s.locks, symbol)
+	}
+}
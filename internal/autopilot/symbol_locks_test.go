@@ -0,0 +1,198 @@
+package autopilot
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"binance-trading-bot/internal/binance"
+)
+
+func TestSymbolLocksSerializeSameSymbol(t *testing.T) {
+	locks := newSymbolLocks()
+	var wg sync.WaitGroup
+	counter := 0 // Deliberately unsynchronized; -race flags any overlap
+	inside := 0  // Goroutines currently holding the lock
+	maxInside := 0
+	for i := 0; i < 200; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				unlock := locks.Lock("BTCUSDT")
+				inside++
+				if inside > maxInside {
+					maxInside = inside
+				}
+				counter++
+				inside--
+				unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if counter != 200*50 {
+		t.Errorf("counter = %d, want %d (lost updates)", counter, 200*50)
+	}
+	if maxInside != 1 {
+		t.Errorf("%d goroutines held the lock at once, want 1", maxInside)
+	}
+	if n := locks.Len(); n != 0 {
+		t.Errorf("%d lock entries left after all released, want 0", n)
+	}
+}
+
+func TestSymbolLocksDifferentSymbolsDoNotBlock(t *testing.T) {
+	locks := newSymbolLocks()
+	unlockBTC := locks.Lock("BTCUSDT")
+	defer unlockBTC()
+
+	done := make(chan struct{})
+	go func() {
+		unlock := locks.Lock("ETHUSDT")
+		unlock()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("ETHUSDT blocked on the BTCUSDT lock")
+	}
+
+	if _, ok := locks.TryLock("BTCUSDT"); ok {
+		t.Error("TryLock succeeded on a held symbol")
+	}
+	if n := locks.Len(); n != 1 {
+		t.Errorf("Len = %d after failed TryLock, want 1", n)
+	}
+}
+
+func TestSymbolLocksLockAllOverlappingSets(t *testing.T) {
+	locks := newSymbolLocks()
+	var wg sync.WaitGroup
+	shared := 0
+	sets := [][]string{
+		{"BTCUSDT", "ETHUSDT", "SOLUSDT"},
+		{"SOLUSDT", "BTCUSDT"},
+		{"ETHUSDT", "SOLUSDT", "ETHUSDT"}, // Duplicates must not self-deadlock
+	}
+	for i := 0; i < 60; i++ {
+		wg.Add(1)
+		go func(symbols []string) {
+			defer wg.Done()
+			unlock := locks.LockAll(symbols)
+			shared++ // Every set includes SOLUSDT
+			unlock()
+		}(sets[i%len(sets)])
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("LockAll deadlocked on overlapping symbol sets")
+	}
+	if shared != 60 {
+		t.Errorf("shared = %d, want 60", shared)
+	}
+	if n := locks.Len(); n != 0 {
+		t.Errorf("%d lock entries left, want 0", n)
+	}
+}
+
+// TestPositionOperationsSerializePerSymbol drives entry, monitoring, manual
+// level edits and panic close on one symbol at once. Run with -race: every
+// opened position must be closed exactly once and no lock entry may leak.
+func TestPositionOperationsSerializePerSymbol(t *testing.T) {
+	// Breakeven at +0.3% releases ga.mu mid-pass, 99.9 then stops out; TP1 (+0.5%) is never reached
+	prices := []float64{100, 100.3, 99.9}
+	var tick atomic.Int64
+	priceAt := func() float64 { return prices[tick.Add(1)%int64(len(prices))] }
+	client := binance.NewFuturesMockClient(100000, func(string) (float64, error) { return priceAt(), nil })
+
+	modes := DefaultModeConfigs()
+	modes["scalp"].Size.BaseSizeUSD = 100
+	ga := newMonitorTestAutopilot(t, client, modes)
+	ga.config.MaxPositionsPerMinute = 0
+	ga.config.ProactiveBreakevenPercent = 0.2
+
+	decision := &GinieDecisionReport{
+		Symbol:          "BTCUSDT",
+		SelectedMode:    GinieModeScalp,
+		ConfidenceScore: 90,
+		TradeExecution: GinieTradeExecution{
+			Action:      "LONG",
+			Leverage:    5,
+			EntryLow:    99.5,
+			EntryHigh:   100.5,
+			StopLoss:    97,
+			TakeProfits: []GinieTakeProfitLevel{{Level: 1, Price: 110, Percent: 100}},
+		},
+	}
+
+	const rounds = 200
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	run := func(op func()) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			for i := 0; i < rounds; i++ {
+				op()
+				runtime.Gosched() // Let the other operations interleave
+			}
+		}()
+	}
+	run(func() { ga.executeTradeWithResult(decision) })
+	run(func() { ga.monitorPosition("BTCUSDT", priceAt()) })
+	run(func() {
+		sl := 95.0
+		ga.UpdatePositionLevels("BTCUSDT", PositionLevelUpdate{StopLoss: &sl}) // Fails while flat
+	})
+	run(func() { ga.CloseAllPositions() })
+	close(start)
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(30 * time.Second):
+		t.Fatal("position operations deadlocked on the symbol lock")
+	}
+	ga.CloseAllPositions()
+
+	ga.mu.RLock()
+	defer ga.mu.RUnlock()
+	opens, closes := 0, 0
+	for _, trade := range ga.tradeHistory {
+		switch trade.Action {
+		case "open":
+			opens++
+		case "full_close", "panic_close", "close", "close_market":
+			closes++
+		}
+	}
+	if opens == 0 {
+		t.Fatal("no position was opened")
+	}
+	if closes != opens {
+		t.Errorf("%d opens but %d closes, want every position closed exactly once", opens, closes)
+	}
+	if len(ga.positions) != 0 {
+		t.Errorf("%d positions still tracked after the final close", len(ga.positions))
+	}
+	if n := ga.symbolLocks.Len(); n != 0 {
+		t.Errorf("%d lock entries left, want 0", n)
+	}
+}
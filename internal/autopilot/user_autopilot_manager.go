@@ -2,6 +2,7 @@ package autopilot
 
 import (
 	"binance-trading-bot/internal/ai/llm"
+	"binance-trading-bot/internal/ai/sentiment"
 	"binance-trading-bot/internal/apikeys"
 	"binance-trading-bot/internal/binance"
 	"binance-trading-bot/internal/database"
@@ -72,6 +73,10 @@ type UserAutopilotManager struct {
 	// LLM config for creating per-user analyzers
 	llmConfig *llm.AnalyzerConfig
 
+	// Operator alerts and shared sentiment passed on to every instance (may be nil)
+	alertNotifier     AlertNotifier
+	sentimentAnalyzer *sentiment.Analyzer
+
 	// Cleanup settings
 	cleanupInterval    time.Duration // How often to clean up idle sessions
 	sessionIdleTimeout time.Duration // Close sessions idle for this long
@@ -265,6 +270,17 @@ func (m *UserAutopilotManager) createInstance(ctx context.Context, userID string
 		autopilot.SetLLMAnalyzer(llmAnalyzer)
 	}
 
+	m.mu.RLock()
+	alertNotifier := m.alertNotifier
+	sentimentAnalyzer := m.sentimentAnalyzer
+	m.mu.RUnlock()
+	if alertNotifier != nil {
+		autopilot.SetAlertNotifier(alertNotifier)
+	}
+	if sentimentAnalyzer != nil {
+		autopilot.SetSentimentAnalyzer(sentimentAnalyzer)
+	}
+
 	// Apply global settings (RiskLevel, etc.) from SettingsManager
 	settingsManager := GetSettingsManager()
 	if settingsManager != nil {
@@ -500,12 +516,77 @@ func (m *UserAutopilotManager) Shutdown() {
 	m.logger.Info("UserAutopilotManager shutdown complete")
 }
 
+// ReloadAllConfigs asks every loaded Ginie instance to re-read its settings
+// (mode enables, confidence thresholds, SL/TP) on its next cycle.
+// Returns the number of instances signalled.
+func (m *UserAutopilotManager) ReloadAllConfigs() int {
+	count := 0
+	m.instances.Range(func(key, value any) bool {
+		instance := value.(*UserAutopilotInstance)
+		instance.Autopilot.TriggerConfigReload()
+		count++
+		return true
+	})
+
+	m.logger.Info("Config reload triggered for all user autopilots", "instances", count)
+	return count
+}
+
+// SetAlertNotifier sets the operator alert channel for current and future instances
+func (m *UserAutopilotManager) SetAlertNotifier(notifier AlertNotifier) {
+	m.mu.Lock()
+	m.alertNotifier = notifier
+	m.mu.Unlock()
+
+	m.instances.Range(func(key, value any) bool {
+		value.(*UserAutopilotInstance).Autopilot.SetAlertNotifier(notifier)
+		return true
+	})
+}
+
+// SetSentimentAnalyzer sets the shared sentiment analyzer for current and future instances
+func (m *UserAutopilotManager) SetSentimentAnalyzer(analyzer *sentiment.Analyzer) {
+	m.mu.Lock()
+	m.sentimentAnalyzer = analyzer
+	m.mu.Unlock()
+
+	m.instances.Range(func(key, value any) bool {
+		value.(*UserAutopilotInstance).Autopilot.SetSentimentAnalyzer(analyzer)
+		return true
+	})
+}
+
+// ApplyShutdownPolicy runs the shutdown policy for every loaded user instance,
+// stopping early once ctx expires. Returns the results keyed by user ID.
+func (m *UserAutopilotManager) ApplyShutdownPolicy(ctx context.Context, policy string) map[string][]ShutdownSymbolResult {
+	results := make(map[string][]ShutdownSymbolResult)
+	m.instances.Range(func(key, value any) bool {
+		if ctx.Err() != nil {
+			return false
+		}
+		userID := key.(string)
+		instance := value.(*UserAutopilotInstance)
+		if symbolResults := instance.Autopilot.ApplyShutdownPolicy(ctx, policy); len(symbolResults) > 0 {
+			results[userID] = symbolResults
+		}
+		return true
+	})
+	return results
+}
+
 // UpdateUserDryRun updates the dry run mode for a specific user
 func (m *UserAutopilotManager) UpdateUserDryRun(userID string, dryRun bool) error {
+	if !dryRun && IsDryRunForced() {
+		return ErrLiveTradingDisabled
+	}
+
 	instance := m.GetInstance(userID)
 	if instance == nil {
 		return nil // Nothing to update
 	}
+	if !dryRun && instance.Autopilot.DrawdownGuardTripped() {
+		return ErrDrawdownGuardTripped
+	}
 
 	// Update the autopilot's config
 	config := instance.Autopilot.GetConfig()
@@ -0,0 +1,38 @@
+package binance
+
+import (
+	"errors"
+	"sync"
+)
+
+// DefaultMaxAlgoOrdersPerSymbol matches Binance's MAX_NUM_ALGO_ORDERS filter for
+// most USDT-M symbols. A fully protected Ginie position needs at most an SL, four
+// TPs and a trailing stop, so hitting this means orders are leaking.
+const DefaultMaxAlgoOrdersPerSymbol = 10
+
+// ErrAlgoOrderLimit is returned by PlaceAlgoOrder when the symbol already has the
+// configured maximum number of open algo orders
+var ErrAlgoOrderLimit = errors.New("algo order limit reached")
+
+var (
+	algoOrderLimitMu       sync.RWMutex
+	maxAlgoOrdersPerSymbol = DefaultMaxAlgoOrdersPerSymbol
+)
+
+// SetMaxAlgoOrdersPerSymbol sets the per-symbol open algo order cap enforced
+// before placement (0 disables the check, negative restores the default)
+func SetMaxAlgoOrdersPerSymbol(max int) {
+	if max < 0 {
+		max = DefaultMaxAlgoOrdersPerSymbol
+	}
+	algoOrderLimitMu.Lock()
+	maxAlgoOrdersPerSymbol = max
+	algoOrderLimitMu.Unlock()
+}
+
+// GetMaxAlgoOrdersPerSymbol returns the per-symbol open algo order cap (0 = disabled)
+func GetMaxAlgoOrdersPerSymbol() int {
+	algoOrderLimitMu.RLock()
+	defer algoOrderLimitMu.RUnlock()
+	return maxAlgoOrdersPerSymbol
+}
@@ -10,6 +10,8 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -18,6 +20,10 @@ type Client struct {
 	secretKey  string
 	baseURL    string
 	httpClient *http.Client
+
+	// Spot price/quantity filters by symbol, loaded on first OCO placement
+	filtersMu sync.Mutex
+	filters   map[string]spotFilters
 }
 
 func NewClient(apiKey, secretKey, baseURL string) *Client {
@@ -62,17 +68,48 @@ type Ticker24hr struct {
 
 // OrderResponse represents a response from placing an order
 type OrderResponse struct {
-	Symbol              string  `json:"symbol"`
-	OrderId             int64   `json:"orderId"`
-	ClientOrderId       string  `json:"clientOrderId"`
-	TransactTime        int64   `json:"transactTime"`
-	Price               float64 `json:"price,string"`
-	OrigQty             float64 `json:"origQty,string"`
-	ExecutedQty         float64 `json:"executedQty,string"`
-	CummulativeQuoteQty float64 `json:"cummulativeQuoteQty,string"`
-	Status              string  `json:"status"`
-	Type                string  `json:"type"`
-	Side                string  `json:"side"`
+	Symbol              string      `json:"symbol"`
+	OrderId             int64       `json:"orderId"`
+	ClientOrderId       string      `json:"clientOrderId"`
+	TransactTime        int64       `json:"transactTime"`
+	Price               float64     `json:"price,string"`
+	OrigQty             float64     `json:"origQty,string"`
+	ExecutedQty         float64     `json:"executedQty,string"`
+	CummulativeQuoteQty float64     `json:"cummulativeQuoteQty,string"`
+	Status              string      `json:"status"`
+	Type                string      `json:"type"`
+	Side                string      `json:"side"`
+	Fills               []OrderFill `json:"fills,omitempty"` // Only on FULL placement responses
+}
+
+// OrderFill is one trade that filled part of an order
+type OrderFill struct {
+	Price           float64 `json:"price,string"`
+	Qty             float64 `json:"qty,string"`
+	Commission      float64 `json:"commission,string"`
+	CommissionAsset string  `json:"commissionAsset"`
+}
+
+// AvgPrice returns the average fill price. MARKET orders report a price of 0,
+// so this falls back to Price only when nothing has executed.
+func (o *OrderResponse) AvgPrice() float64 {
+	if o.ExecutedQty > 0 && o.CummulativeQuoteQty > 0 {
+		return o.CummulativeQuoteQty / o.ExecutedQty
+	}
+	return o.Price
+}
+
+// NetExecutedQty returns the executed quantity less any commission charged in
+// the base asset, i.e. what a BUY actually added to the holding. The base
+// asset is recognised as the symbol prefix (BTC in BTCUSDT).
+func (o *OrderResponse) NetExecutedQty() float64 {
+	qty := o.ExecutedQty
+	for _, f := range o.Fills {
+		if f.CommissionAsset != "" && strings.HasPrefix(o.Symbol, f.CommissionAsset) {
+			qty -= f.Commission
+		}
+	}
+	return qty
 }
 
 // GetKlines fetches candlestick data
@@ -84,20 +121,10 @@ func (c *Client) GetKlines(symbol, interval string, limit int) ([]Kline, error)
 
 	endpoint := fmt.Sprintf("%s/api/v3/klines?%s", c.baseURL, params.Encode())
 
-	resp, err := c.httpClient.Get(endpoint)
+	body, err := c.publicGet(endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching klines: %w", err)
 	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("error reading response: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API error: %s", string(body))
-	}
 
 	var rawKlines [][]interface{}
 	if err := json.Unmarshal(body, &rawKlines); err != nil {
@@ -128,20 +155,10 @@ func (c *Client) GetKlines(symbol, interval string, limit int) ([]Kline, error)
 func (c *Client) Get24hrTickers() ([]Ticker24hr, error) {
 	endpoint := fmt.Sprintf("%s/api/v3/ticker/24hr", c.baseURL)
 
-	resp, err := c.httpClient.Get(endpoint)
+	body, err := c.publicGet(endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching tickers: %w", err)
 	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("error reading response: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API error: %s", string(body))
-	}
 
 	var tickers []Ticker24hr
 	if err := json.Unmarshal(body, &tickers); err != nil {
@@ -224,20 +241,10 @@ func (c *Client) CancelOrder(symbol string, orderId int64) error {
 func (c *Client) GetCurrentPrice(symbol string) (float64, error) {
 	endpoint := fmt.Sprintf("%s/api/v3/ticker/price?symbol=%s", c.baseURL, symbol)
 
-	resp, err := c.httpClient.Get(endpoint)
+	body, err := c.publicGet(endpoint)
 	if err != nil {
 		return 0, fmt.Errorf("error fetching price: %w", err)
 	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return 0, fmt.Errorf("error reading response: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return 0, fmt.Errorf("API error: %s", string(body))
-	}
 
 	var priceResp struct {
 		Symbol string  `json:"symbol"`
@@ -253,11 +260,19 @@ func (c *Client) GetCurrentPrice(symbol string) (float64, error) {
 
 // SymbolInfo represents basic symbol information
 type SymbolInfo struct {
-	Symbol               string `json:"symbol"`
-	Status               string `json:"status"`
-	BaseAsset            string `json:"baseAsset"`
-	QuoteAsset           string `json:"quoteAsset"`
-	IsSpotTradingAllowed bool   `json:"isSpotTradingAllowed"`
+	Symbol               string         `json:"symbol"`
+	Status               string         `json:"status"`
+	BaseAsset            string         `json:"baseAsset"`
+	QuoteAsset           string         `json:"quoteAsset"`
+	IsSpotTradingAllowed bool           `json:"isSpotTradingAllowed"`
+	Filters              []SymbolFilter `json:"filters,omitempty"`
+}
+
+// SymbolFilter is one entry of a spot symbol's filters array
+type SymbolFilter struct {
+	FilterType string `json:"filterType"`
+	TickSize   string `json:"tickSize,omitempty"` // PRICE_FILTER
+	StepSize   string `json:"stepSize,omitempty"` // LOT_SIZE
 }
 
 // ExchangeInfo represents exchange information response
@@ -269,20 +284,10 @@ type ExchangeInfo struct {
 func (c *Client) GetExchangeInfo() (*ExchangeInfo, error) {
 	endpoint := fmt.Sprintf("%s/api/v3/exchangeInfo", c.baseURL)
 
-	resp, err := c.httpClient.Get(endpoint)
+	body, err := c.publicGet(endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching exchange info: %w", err)
 	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("error reading response: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API error: %s", string(body))
-	}
 
 	var exchangeInfo ExchangeInfo
 	if err := json.Unmarshal(body, &exchangeInfo); err != nil {
@@ -369,6 +374,29 @@ func (c *Client) GetUSDTBalance() (float64, error) {
 	return 0, nil
 }
 
+// publicGet fetches an unauthenticated endpoint under the global retry policy
+func (c *Client) publicGet(endpoint string) ([]byte, error) {
+	var body []byte
+	err := Retry("Spot GET "+endpoint, func() error {
+		resp, err := c.httpClient.Get(endpoint)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		respBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return newAPIError(resp.StatusCode, respBody)
+		}
+		body = respBody
+		return nil
+	})
+	return body, err
+}
+
 // buildQueryString creates a query string from params (excluding signature)
 func (c *Client) buildQueryString(params map[string]string) string {
 	query := ""
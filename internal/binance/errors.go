@@ -0,0 +1,60 @@
+package binance
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Binance error codes callers react to when placing orders
+const (
+	ErrCodeServiceShuttingDown  = -1016 // This service is no longer available (maintenance)
+	ErrCodePricePrecision       = -1111 // Precision is over the maximum defined for this asset
+	ErrCodeInsufficientMargin   = -2019 // Margin is insufficient
+	ErrCodeWouldTrigger         = -2021 // Order would immediately trigger
+	ErrCodeTickSize             = -4014 // Price not increased by tick size
+	ErrCodePositionSideMismatch = -4061 // Order's position side does not match user's setting
+	ErrCodeAlgoOrderRequired    = -4120 // Conditional order type must go through the algo order API
+	ErrCodePercentPrice         = -4131 // Counterparty best price does not meet the PERCENT_PRICE filter
+)
+
+// Sentinel errors for Binance rejections. An *APIError matches them with
+// errors.Is by its Binance code, so callers can test wrapped order errors
+// without parsing the body, and errors.As still gives the code and message.
+var (
+	ErrInsufficientMargin   = errors.New("insufficient margin")
+	ErrPositionSideMismatch = errors.New("position side does not match account position mode")
+	ErrPricePrecision       = errors.New("price or quantity precision rejected")
+	ErrPercentPrice         = errors.New("price outside PERCENT_PRICE filter")
+	ErrWouldTrigger         = errors.New("order would immediately trigger")
+	ErrExchangeMaintenance  = errors.New("exchange unavailable for maintenance")
+)
+
+// Is matches an APIError against the sentinel for its Binance code
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrInsufficientMargin:
+		return e.Code == ErrCodeInsufficientMargin
+	case ErrPositionSideMismatch:
+		return e.Code == ErrCodePositionSideMismatch
+	case ErrPricePrecision:
+		return e.Code == ErrCodePricePrecision || e.Code == ErrCodeTickSize
+	case ErrPercentPrice:
+		return e.Code == ErrCodePercentPrice
+	case ErrWouldTrigger:
+		return e.Code == ErrCodeWouldTrigger
+	case ErrExchangeMaintenance:
+		// Binance answers 503 or SERVICE_SHUTTING_DOWN while down for maintenance
+		return e.Code == ErrCodeServiceShuttingDown || e.StatusCode == http.StatusServiceUnavailable
+	}
+	return false
+}
+
+// APIErrorCode returns the Binance error code carried by err, or 0 if err
+// does not wrap an *APIError
+func APIErrorCode(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Code
+	}
+	return 0
+}
@@ -0,0 +1,64 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestAPIErrorSentinels(t *testing.T) {
+	cases := []struct {
+		name string
+		body string
+		want error
+	}{
+		{"insufficient margin", `{"code":-2019,"msg":"Margin is insufficient."}`, ErrInsufficientMargin},
+		{"position side mismatch", `{"code":-4061,"msg":"Order's position side does not match user's setting."}`, ErrPositionSideMismatch},
+		{"precision", `{"code":-1111,"msg":"Precision is over the maximum defined for this asset."}`, ErrPricePrecision},
+		{"tick size", `{"code":-4014,"msg":"Price not increased by tick size."}`, ErrPricePrecision},
+		{"percent price", `{"code":-4131,"msg":"The counterparty's best price does not meet the PERCENT_PRICE filter limit."}`, ErrPercentPrice},
+		{"would trigger", `{"code":-2021,"msg":"Order would immediately trigger."}`, ErrWouldTrigger},
+	}
+	sentinels := []error{ErrInsufficientMargin, ErrPositionSideMismatch, ErrPricePrecision, ErrPercentPrice, ErrWouldTrigger, ErrExchangeMaintenance}
+
+	for _, tc := range cases {
+		err := fmt.Errorf("error placing order: %w", newAPIError(400, []byte(tc.body)))
+		for _, sentinel := range sentinels {
+			if got := errors.Is(err, sentinel); got != (sentinel == tc.want) {
+				t.Errorf("%s: errors.Is(%v) = %v", tc.name, sentinel, got)
+			}
+		}
+	}
+}
+
+func TestAPIErrorCode(t *testing.T) {
+	err := fmt.Errorf("error placing order: %w", newAPIError(400, []byte(`{"code":-2019,"msg":"Margin is insufficient."}`)))
+	if code := APIErrorCode(err); code != ErrCodeInsufficientMargin {
+		t.Errorf("APIErrorCode = %d, want %d", code, ErrCodeInsufficientMargin)
+	}
+	if code := APIErrorCode(errors.New("connection reset")); code != 0 {
+		t.Errorf("APIErrorCode of a non-API error = %d, want 0", code)
+	}
+	if errors.Is(newAPIError(503, []byte("bad gateway")), ErrInsufficientMargin) {
+		t.Error("non-JSON API error matched a sentinel")
+	}
+}
+
+func TestAPIErrorMaintenance(t *testing.T) {
+	cases := []struct {
+		status int
+		body   string
+		want   bool
+	}{
+		{503, "Service Unavailable", true},
+		{400, `{"code":-1016,"msg":"This service is no longer available."}`, true},
+		{500, `{"code":-1001,"msg":"Internal error; unable to process your request."}`, false},
+		{400, `{"code":-2019,"msg":"Margin is insufficient."}`, false},
+	}
+	for _, tc := range cases {
+		err := fmt.Errorf("error pinging futures API: %w", newAPIError(tc.status, []byte(tc.body)))
+		if got := errors.Is(err, ErrExchangeMaintenance); got != tc.want {
+			t.Errorf("%d %s: errors.Is(ErrExchangeMaintenance) = %v, want %v", tc.status, tc.body, got, tc.want)
+		}
+	}
+}
@@ -8,21 +8,14 @@ import (
 	"fmt"
 	"io"
 	"log"
-	"math/rand"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 )
 
-// Retry configuration for API calls
-const (
-	maxRetries     = 3
-	baseRetryDelay = 500 * time.Millisecond
-	maxRetryDelay  = 5 * time.Second
-)
-
 const (
 	// FuturesBaseURL is the production Binance Futures API URL
 	FuturesBaseURL = "https://fapi.binance.com"
@@ -36,6 +29,10 @@ type FuturesClientImpl struct {
 	secretKey  string
 	baseURL    string
 	httpClient *http.Client
+
+	// Set once Binance rejects conditional legs in /fapi/v1/batchOrders, so
+	// protected orders go straight to the sequential path afterwards
+	batchBracketUnsupported atomic.Bool
 }
 
 // NewFuturesClient creates a new FuturesClient instance
@@ -182,12 +179,8 @@ func (c *FuturesClientImpl) SetMarginType(symbol string, marginType MarginType)
 	// Signature is added by signParams() in signed* methods
 
 	_, err := c.signedPost("/fapi/v1/marginType", params)
-	if err != nil {
-		// Binance returns error if margin type is already set - ignore this
-		return nil
-	}
-
-	return nil
+	// Binance returns an error if the margin type is already set - that one is ignored
+	return classifyMarginTypeError(err)
 }
 
 // SetPositionMode sets the position mode (Hedge or One-way)
@@ -334,6 +327,22 @@ func (c *FuturesClientImpl) CancelAllFuturesOrders(symbol string) error {
 	return nil
 }
 
+// SetCountdownCancelAll arms (or with 0, disarms) the auto-cancel countdown for a symbol
+func (c *FuturesClientImpl) SetCountdownCancelAll(symbol string, countdownTimeMs int64) error {
+	params := map[string]string{
+		"symbol":        symbol,
+		"countdownTime": strconv.FormatInt(countdownTimeMs, 10),
+	}
+	// Signature is added by signParams() in signed* methods
+
+	_, err := c.signedPost("/fapi/v1/countdownCancelAll", params)
+	if err != nil {
+		return fmt.Errorf("error setting countdown cancel all: %w", err)
+	}
+
+	return nil
+}
+
 // GetOpenOrders retrieves all open orders for a symbol
 func (c *FuturesClientImpl) GetOpenOrders(symbol string) ([]FuturesOrder, error) {
 	params := map[string]string{
@@ -387,6 +396,23 @@ func (c *FuturesClientImpl) GetOrder(symbol string, orderId int64) (*FuturesOrde
 // This is required for STOP_MARKET, TAKE_PROFIT_MARKET, STOP, TAKE_PROFIT, TRAILING_STOP_MARKET
 // as of Binance API change on 2025-12-09
 func (c *FuturesClientImpl) PlaceAlgoOrder(params AlgoOrderParams) (*AlgoOrderResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid algo order: %w", err)
+	}
+
+	// Refuse to pile more orders onto a symbol that is already at the cap -
+	// that only happens when earlier SL/TP orders leaked
+	if max := GetMaxAlgoOrdersPerSymbol(); max > 0 {
+		count, err := c.GetAlgoOrderCount(params.Symbol)
+		if err != nil {
+			log.Printf("[ALGO-LIMIT] Could not count open algo orders for %s, placing anyway: %v", params.Symbol, err)
+		} else if count >= max {
+			log.Printf("[ALGO-LIMIT] Refusing %s %s algo order for %s: %d open algo orders (max %d)",
+				params.Side, params.Type, params.Symbol, count, max)
+			return nil, fmt.Errorf("%w: %s has %d open algo orders (max %d)", ErrAlgoOrderLimit, params.Symbol, count, max)
+		}
+	}
+
 	reqParams := map[string]string{
 		"algoType":  string(AlgoTypeConditional),
 		"symbol":    params.Symbol,
@@ -395,8 +421,8 @@ func (c *FuturesClientImpl) PlaceAlgoOrder(params AlgoOrderParams) (*AlgoOrderRe
 		"timestamp": strconv.FormatInt(time.Now().UnixMilli(), 10),
 	}
 
-	// Add trigger price (required for conditional orders)
-	if params.TriggerPrice > 0 {
+	// Add trigger price (required for conditional orders; trailing stops use activatePrice)
+	if params.TriggerPrice > 0 && params.Type != FuturesOrderTypeTrailingStop {
 		reqParams["triggerPrice"] = strconv.FormatFloat(params.TriggerPrice, 'f', -1, 64)
 	}
 
@@ -466,6 +492,15 @@ func (c *FuturesClientImpl) PlaceAlgoOrder(params AlgoOrderParams) (*AlgoOrderRe
 	return &algoResp, nil
 }
 
+// GetAlgoOrderCount returns the number of open algo orders for a symbol
+func (c *FuturesClientImpl) GetAlgoOrderCount(symbol string) (int, error) {
+	orders, err := c.GetOpenAlgoOrders(symbol)
+	if err != nil {
+		return 0, err
+	}
+	return len(orders), nil
+}
+
 // GetOpenAlgoOrders retrieves all open algo orders
 func (c *FuturesClientImpl) GetOpenAlgoOrders(symbol string) ([]AlgoOrder, error) {
 	params := map[string]string{
@@ -644,7 +679,27 @@ func (c *FuturesClientImpl) GetFuturesKlines(symbol, interval string, limit int)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching klines: %w", err)
 	}
+	return parseKlines(resp)
+}
+
+// GetFuturesKlinesRange retrieves up to limit (max 1500) candles opening in
+// [startTime, endTime], in milliseconds, for recording historical data
+func (c *FuturesClientImpl) GetFuturesKlinesRange(symbol, interval string, startTime, endTime int64, limit int) ([]Kline, error) {
+	resp, err := c.publicGet("/fapi/v1/klines", map[string]string{
+		"symbol":    symbol,
+		"interval":  interval,
+		"startTime": strconv.FormatInt(startTime, 10),
+		"endTime":   strconv.FormatInt(endTime, 10),
+		"limit":     strconv.Itoa(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching klines: %w", err)
+	}
+	return parseKlines(resp)
+}
 
+// parseKlines decodes the array-of-arrays kline response
+func parseKlines(resp []byte) ([]Kline, error) {
 	var rawKlines [][]interface{}
 	if err := json.Unmarshal(resp, &rawKlines); err != nil {
 		return nil, fmt.Errorf("error parsing klines: %w", err)
@@ -740,6 +795,14 @@ func (c *FuturesClientImpl) GetFuturesExchangeInfo() (*FuturesExchangeInfo, erro
 	return &exchangeInfo, nil
 }
 
+// Ping tests connectivity to the futures API
+func (c *FuturesClientImpl) Ping() error {
+	if _, err := c.publicGet("/fapi/v1/ping", nil); err != nil {
+		return fmt.Errorf("error pinging futures API: %w", err)
+	}
+	return nil
+}
+
 // GetFuturesSymbols retrieves all available futures trading pairs
 func (c *FuturesClientImpl) GetFuturesSymbols() ([]string, error) {
 	exchangeInfo, err := c.GetFuturesExchangeInfo()
@@ -911,10 +974,61 @@ func (c *FuturesClientImpl) GetAllOrdersByDateRange(symbol string, startTime, en
 // startTime/endTime: Unix milliseconds. Pass 0 to ignore.
 // limit: Max 1000 records
 func (c *FuturesClientImpl) GetIncomeHistory(incomeType string, startTime, endTime int64, limit int) ([]IncomeRecord, error) {
+	return c.fetchIncomeHistory("", incomeType, startTime, endTime, limit)
+}
+
+// incomePageSize is the /fapi/v1/income maximum page size; incomeMaxPages bounds
+// GetAllIncomeHistory at 50k records
+const (
+	incomePageSize = 1000
+	incomeMaxPages = 50
+)
+
+// GetAllIncomeHistory retrieves every income record for symbol and incomeType (empty for all)
+// between startTime and endTime (Unix milliseconds, 0 to ignore), paging through
+// /fapi/v1/income incomePageSize records at a time. Stops after incomeMaxPages pages.
+func (c *FuturesClientImpl) GetAllIncomeHistory(symbol, incomeType string, startTime, endTime int64) ([]IncomeRecord, error) {
+	var all []IncomeRecord
+	seen := make(map[string]bool)
+
+	for page := 0; page < incomeMaxPages; page++ {
+		records, err := c.fetchIncomeHistory(symbol, incomeType, startTime, endTime, incomePageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		added := 0
+		for _, r := range records {
+			// Pages overlap on the boundary millisecond; tranId alone is shared by
+			// the PnL and commission legs of one fill
+			key := fmt.Sprintf("%d/%s/%s", r.TranId, r.IncomeType, r.Symbol)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			all = append(all, r)
+			added++
+		}
+
+		if len(records) < incomePageSize || added == 0 {
+			return all, nil
+		}
+		// Records come back oldest first: resume from the last timestamp
+		startTime = records[len(records)-1].Time
+	}
+
+	return all, fmt.Errorf("income history truncated after %d records - narrow the time range", len(all))
+}
+
+// fetchIncomeHistory fetches one page of /fapi/v1/income
+func (c *FuturesClientImpl) fetchIncomeHistory(symbol, incomeType string, startTime, endTime int64, limit int) ([]IncomeRecord, error) {
 	params := map[string]string{
 		"timestamp": strconv.FormatInt(time.Now().UnixMilli(), 10),
 	}
 
+	if symbol != "" {
+		params["symbol"] = symbol
+	}
 	if incomeType != "" {
 		params["incomeType"] = incomeType
 	}
@@ -1049,15 +1163,7 @@ func (c *FuturesClientImpl) signParams(params map[string]string) string {
 
 // publicGet performs an unauthenticated GET request with rate limiting and retry
 func (c *FuturesClientImpl) publicGet(endpoint string, params map[string]string) ([]byte, error) {
-	rateLimiter := GetRateLimiter()
-	var lastErr error
-
-	for attempt := 0; attempt <= maxRetries; attempt++ {
-		// Check rate limiter before making request
-		if !rateLimiter.WaitForSlot(endpoint, 30*time.Second) {
-			return nil, fmt.Errorf("rate limit: circuit breaker open, request blocked")
-		}
-
+	return c.doWithRetry("Public GET", endpoint, func() (*http.Request, error) {
 		values := url.Values{}
 		for k, v := range params {
 			values.Set(k, v)
@@ -1067,246 +1173,113 @@ func (c *FuturesClientImpl) publicGet(endpoint string, params map[string]string)
 		if len(values) > 0 {
 			reqURL = fmt.Sprintf("%s?%s", reqURL, values.Encode())
 		}
+		return http.NewRequest(http.MethodGet, reqURL, nil)
+	})
+}
 
-		resp, err := c.httpClient.Get(reqURL)
-		if err != nil {
-			lastErr = err
-			if attempt < maxRetries {
-				delay := calculateRetryDelay(attempt)
-				log.Printf("[BINANCE] Public GET %s failed (attempt %d/%d): %v, retrying in %v",
-					endpoint, attempt+1, maxRetries+1, err, delay)
-				time.Sleep(delay)
-				continue
-			}
-			return nil, err
-		}
-
-		body, err := io.ReadAll(resp.Body)
-		resp.Body.Close()
-		if err != nil {
-			return nil, err
-		}
-
-		// Update rate limiter from headers
-		if usedWeight := resp.Header.Get("X-MBX-USED-WEIGHT-1M"); usedWeight != "" {
-			if weight, err := strconv.Atoi(usedWeight); err == nil {
-				rateLimiter.UpdateFromHeaders(0, weight)
-			}
-		}
-
-		if resp.StatusCode != http.StatusOK {
-			lastErr = fmt.Errorf("API error: %s", string(body))
-
-			// Check for rate limit error and trigger circuit breaker
-			if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == 418 ||
-				strings.Contains(string(body), "-1003") {
-				banUntil := ParseBanUntilFromError(string(body))
-				rateLimiter.RecordRateLimitError(banUntil)
-			}
-
-			if isRetryableError(resp.StatusCode, string(body)) && attempt < maxRetries {
-				delay := calculateRetryDelay(attempt)
-				log.Printf("[BINANCE] Public GET %s returned %d (attempt %d/%d): %s, retrying in %v",
-					endpoint, resp.StatusCode, attempt+1, maxRetries+1, string(body), delay)
-				time.Sleep(delay)
-				continue
-			}
-			return nil, lastErr
-		}
-
-		// Record successful request
-		rateLimiter.RecordRequest(endpoint)
-		return body, nil
-	}
-
-	return nil, lastErr
+// signedGet performs an authenticated GET request with rate limiting and retry logic
+func (c *FuturesClientImpl) signedGet(endpoint string, params map[string]string) ([]byte, error) {
+	return c.signedRequest(http.MethodGet, endpoint, params)
 }
 
-// isRetryableError checks if an error is transient and should be retried
-func isRetryableError(statusCode int, body string) bool {
-	// Retry on rate limits (429) and server errors (5xx)
-	if statusCode == http.StatusTooManyRequests || statusCode >= 500 {
-		return true
-	}
-	// Retry on specific Binance errors that are transient
-	if strings.Contains(body, "-1001") || // DISCONNECTED
-		strings.Contains(body, "-1003") || // TOO_MANY_REQUESTS
-		strings.Contains(body, "-1015") || // TOO_MANY_ORDERS
-		strings.Contains(body, "-1016") { // SERVICE_SHUTTING_DOWN
-		return true
-	}
-	return false
+// signedPost performs an authenticated POST request with rate limiting and retry logic
+func (c *FuturesClientImpl) signedPost(endpoint string, params map[string]string) ([]byte, error) {
+	return c.signedRequest(http.MethodPost, endpoint, params)
 }
 
-// calculateRetryDelay returns delay with exponential backoff and jitter
-func calculateRetryDelay(attempt int) time.Duration {
-	delay := baseRetryDelay * time.Duration(1<<uint(attempt)) // 2^attempt
-	if delay > maxRetryDelay {
-		delay = maxRetryDelay
-	}
-	// Add jitter (±25%)
-	jitter := time.Duration(rand.Int63n(int64(delay) / 2))
-	return delay + jitter - (delay / 4)
+// signedPut performs an authenticated PUT request with rate limiting and retry logic
+func (c *FuturesClientImpl) signedPut(endpoint string, params map[string]string) ([]byte, error) {
+	return c.signedRequest(http.MethodPut, endpoint, params)
 }
 
-// signedGet performs an authenticated GET request with rate limiting and retry logic
-func (c *FuturesClientImpl) signedGet(endpoint string, params map[string]string) ([]byte, error) {
-	rateLimiter := GetRateLimiter()
-	var lastErr error
+// signedDelete performs an authenticated DELETE request with rate limiting and retry logic
+func (c *FuturesClientImpl) signedDelete(endpoint string, params map[string]string) ([]byte, error) {
+	return c.signedRequest(http.MethodDelete, endpoint, params)
+}
 
-	for attempt := 0; attempt <= maxRetries; attempt++ {
-		// Check rate limiter before making request
-		if !rateLimiter.WaitForSlot(endpoint, 30*time.Second) {
-			return nil, fmt.Errorf("rate limit: circuit breaker open, request blocked")
-		}
+// signedRequest builds a signed request, refreshing the timestamp and
+// signature on every attempt so retries are not rejected as stale
+func (c *FuturesClientImpl) signedRequest(method, endpoint string, params map[string]string) ([]byte, error) {
+	if params == nil {
+		params = make(map[string]string)
+	}
 
-		// Refresh timestamp for each attempt and set recvWindow for clock skew tolerance
+	return c.doWithRetry(method, endpoint, func() (*http.Request, error) {
+		// Set recvWindow for clock skew tolerance
 		params["timestamp"] = strconv.FormatInt(time.Now().UnixMilli(), 10)
 		params["recvWindow"] = "10000" // 10 seconds tolerance for clock skew
-		query := c.signParams(params)
-		reqURL := fmt.Sprintf("%s%s?%s", c.baseURL, endpoint, query)
 
-		req, err := http.NewRequest("GET", reqURL, nil)
+		req, err := http.NewRequest(method, fmt.Sprintf("%s%s", c.baseURL, endpoint), nil)
 		if err != nil {
 			return nil, err
 		}
-
+		req.URL.RawQuery = c.signParams(params)
 		req.Header.Set("X-MBX-APIKEY", c.apiKey)
-
-		resp, err := c.httpClient.Do(req)
-		if err != nil {
-			lastErr = err
-			if attempt < maxRetries {
-				delay := calculateRetryDelay(attempt)
-				log.Printf("[BINANCE] GET %s failed (attempt %d/%d): %v, retrying in %v",
-					endpoint, attempt+1, maxRetries+1, err, delay)
-				time.Sleep(delay)
-				continue
-			}
-			return nil, err
-		}
-
-		body, err := io.ReadAll(resp.Body)
-		resp.Body.Close()
-		if err != nil {
-			return nil, err
-		}
-
-		// Update rate limiter from headers
-		if usedWeight := resp.Header.Get("X-MBX-USED-WEIGHT-1M"); usedWeight != "" {
-			if weight, err := strconv.Atoi(usedWeight); err == nil {
-				rateLimiter.UpdateFromHeaders(0, weight)
-			}
-		}
-
-		if resp.StatusCode != http.StatusOK {
-			lastErr = fmt.Errorf("API error: %s", string(body))
-
-			// Check for rate limit error and trigger circuit breaker
-			if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == 418 ||
-				strings.Contains(string(body), "-1003") {
-				banUntil := ParseBanUntilFromError(string(body))
-				rateLimiter.RecordRateLimitError(banUntil)
-			}
-
-			if isRetryableError(resp.StatusCode, string(body)) && attempt < maxRetries {
-				delay := calculateRetryDelay(attempt)
-				log.Printf("[BINANCE] GET %s returned %d (attempt %d/%d): %s, retrying in %v",
-					endpoint, resp.StatusCode, attempt+1, maxRetries+1, string(body), delay)
-				time.Sleep(delay)
-				continue
-			}
-			return nil, lastErr
-		}
-
-		// Record successful request
-		rateLimiter.RecordRequest(endpoint)
-		return body, nil
-	}
-
-	return nil, lastErr
+		return req, nil
+	})
 }
 
-// signedPost performs an authenticated POST request with rate limiting and retry logic
-func (c *FuturesClientImpl) signedPost(endpoint string, params map[string]string) ([]byte, error) {
+// doWithRetry sends the request produced by newRequest under the global retry
+// policy. Every attempt goes through the rate limiter; network errors and
+// transient API errors are retried, everything else is returned immediately.
+func (c *FuturesClientImpl) doWithRetry(label, endpoint string, newRequest func() (*http.Request, error)) ([]byte, error) {
 	rateLimiter := GetRateLimiter()
-	var lastErr error
+	var body []byte
 
-	for attempt := 0; attempt <= maxRetries; attempt++ {
+	err := Retry(label+" "+endpoint, func() error {
 		// Check rate limiter before making request
 		if !rateLimiter.WaitForSlot(endpoint, 30*time.Second) {
-			return nil, fmt.Errorf("rate limit: circuit breaker open, request blocked")
+			return fmt.Errorf("rate limit: circuit breaker open, request blocked")
 		}
 
-		// Refresh timestamp for each attempt and set recvWindow for clock skew tolerance
-		if params == nil {
-			params = make(map[string]string)
-		}
-		params["timestamp"] = strconv.FormatInt(time.Now().UnixMilli(), 10)
-		params["recvWindow"] = "10000" // 10 seconds tolerance for clock skew
-		query := c.signParams(params)
-		reqURL := fmt.Sprintf("%s%s", c.baseURL, endpoint)
-
-		req, err := http.NewRequest("POST", reqURL, nil)
+		req, err := newRequest()
 		if err != nil {
-			return nil, err
+			return fmt.Errorf("error building request: %v", err)
 		}
 
-		req.URL.RawQuery = query
-		req.Header.Set("X-MBX-APIKEY", c.apiKey)
-
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
-			lastErr = err
-			if attempt < maxRetries {
-				delay := calculateRetryDelay(attempt)
-				log.Printf("[BINANCE] POST %s failed (attempt %d/%d): %v, retrying in %v",
-					endpoint, attempt+1, maxRetries+1, err, delay)
-				time.Sleep(delay)
-				continue
-			}
-			return nil, err
+			return err
 		}
 
-		body, err := io.ReadAll(resp.Body)
+		respBody, err := io.ReadAll(resp.Body)
 		resp.Body.Close()
 		if err != nil {
-			return nil, err
+			// Not retried: the exchange may already have acted on the request
+			return fmt.Errorf("error reading response: %v", err)
 		}
 
 		// Update rate limiter from headers
-		if usedWeight := resp.Header.Get("X-MBX-USED-WEIGHT-1M"); usedWeight != "" {
-			if weight, err := strconv.Atoi(usedWeight); err == nil {
-				rateLimiter.UpdateFromHeaders(0, weight)
-			}
-		}
+		updateWeightFromHeaders(rateLimiter, resp)
 
 		if resp.StatusCode != http.StatusOK {
-			lastErr = fmt.Errorf("API error: %s", string(body))
-
 			// Check for rate limit error and trigger circuit breaker
 			if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == 418 ||
-				strings.Contains(string(body), "-1003") {
-				banUntil := ParseBanUntilFromError(string(body))
+				strings.Contains(string(respBody), "-1003") {
+				banUntil := ParseBanUntilFromError(string(respBody))
 				rateLimiter.RecordRateLimitError(banUntil)
 			}
-
-			if isRetryableError(resp.StatusCode, string(body)) && attempt < maxRetries {
-				delay := calculateRetryDelay(attempt)
-				log.Printf("[BINANCE] POST %s returned %d (attempt %d/%d): %s, retrying in %v",
-					endpoint, resp.StatusCode, attempt+1, maxRetries+1, string(body), delay)
-				time.Sleep(delay)
-				continue
-			}
-			return nil, lastErr
+			return newAPIError(resp.StatusCode, respBody)
 		}
 
 		// Record successful request
 		rateLimiter.RecordRequest(endpoint)
-		return body, nil
+		body = respBody
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	return body, nil
+}
 
-	return nil, lastErr
+// updateWeightFromHeaders feeds the used-weight header back into the rate limiter
+func updateWeightFromHeaders(rateLimiter *RateLimiter, resp *http.Response) {
+	if usedWeight := resp.Header.Get("X-MBX-USED-WEIGHT-1M"); usedWeight != "" {
+		if weight, err := strconv.Atoi(usedWeight); err == nil {
+			rateLimiter.UpdateFromHeaders(0, weight)
+		}
+	}
 }
 
 // criticalPut performs an authenticated PUT request that BYPASSES the circuit breaker
@@ -1314,9 +1287,10 @@ func (c *FuturesClientImpl) signedPost(endpoint string, params map[string]string
 // even when the circuit breaker is open due to rate limiting
 func (c *FuturesClientImpl) criticalPut(endpoint string, params map[string]string) ([]byte, error) {
 	rateLimiter := GetRateLimiter()
+	policy := GetRetryPolicy()
 	var lastErr error
 
-	for attempt := 0; attempt <= maxRetries; attempt++ {
+	for attempt := 0; attempt <= policy.MaxRetries; attempt++ {
 		// BYPASS circuit breaker check - only do weight-based limiting
 		// This is intentional for critical operations that must succeed
 		result := rateLimiter.TryAcquire(endpoint, PriorityCritical)
@@ -1327,7 +1301,7 @@ func (c *FuturesClientImpl) criticalPut(endpoint string, params map[string]strin
 			// Don't block, proceed anyway for critical operations
 		} else if !result.Acquired {
 			// For other reasons (weight limit), wait briefly and retry
-			if result.WaitTime > 0 && attempt < maxRetries {
+			if result.WaitTime > 0 && attempt < policy.MaxRetries {
 				waitTime := result.WaitTime
 				if waitTime > 5*time.Second {
 					waitTime = 5 * time.Second
@@ -1358,10 +1332,10 @@ func (c *FuturesClientImpl) criticalPut(endpoint string, params map[string]strin
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
 			lastErr = err
-			if attempt < maxRetries {
-				delay := calculateRetryDelay(attempt)
+			if attempt < policy.MaxRetries {
+				delay := policy.Delay(attempt)
 				log.Printf("[BINANCE] CRITICAL PUT %s failed (attempt %d/%d): %v, retrying in %v",
-					endpoint, attempt+1, maxRetries+1, err, delay)
+					endpoint, attempt+1, policy.MaxRetries+1, err, delay)
 				time.Sleep(delay)
 				continue
 			}
@@ -1375,14 +1349,10 @@ func (c *FuturesClientImpl) criticalPut(endpoint string, params map[string]strin
 		}
 
 		// Update rate limiter from headers
-		if usedWeight := resp.Header.Get("X-MBX-USED-WEIGHT-1M"); usedWeight != "" {
-			if weight, err := strconv.Atoi(usedWeight); err == nil {
-				rateLimiter.UpdateFromHeaders(0, weight)
-			}
-		}
+		updateWeightFromHeaders(rateLimiter, resp)
 
 		if resp.StatusCode != http.StatusOK {
-			lastErr = fmt.Errorf("API error: %s", string(body))
+			lastErr = newAPIError(resp.StatusCode, body)
 
 			// For critical requests, still record rate limit errors but don't block future critical requests
 			if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == 418 ||
@@ -1392,10 +1362,10 @@ func (c *FuturesClientImpl) criticalPut(endpoint string, params map[string]strin
 				log.Printf("[BINANCE] CRITICAL PUT %s got rate limited - will retry after backoff", endpoint)
 			}
 
-			if isRetryableError(resp.StatusCode, string(body)) && attempt < maxRetries {
-				delay := calculateRetryDelay(attempt)
+			if isRetryableError(resp.StatusCode, string(body)) && attempt < policy.MaxRetries {
+				delay := policy.Delay(attempt)
 				log.Printf("[BINANCE] CRITICAL PUT %s returned %d (attempt %d/%d): %s, retrying in %v",
-					endpoint, resp.StatusCode, attempt+1, maxRetries+1, string(body), delay)
+					endpoint, resp.StatusCode, attempt+1, policy.MaxRetries+1, string(body), delay)
 				time.Sleep(delay)
 				continue
 			}
@@ -1411,163 +1381,5 @@ func (c *FuturesClientImpl) criticalPut(endpoint string, params map[string]strin
 	return nil, lastErr
 }
 
-// signedPut performs an authenticated PUT request with rate limiting and retry logic
-func (c *FuturesClientImpl) signedPut(endpoint string, params map[string]string) ([]byte, error) {
-	rateLimiter := GetRateLimiter()
-	var lastErr error
-
-	for attempt := 0; attempt <= maxRetries; attempt++ {
-		// Check rate limiter before making request
-		if !rateLimiter.WaitForSlot(endpoint, 30*time.Second) {
-			return nil, fmt.Errorf("rate limit: circuit breaker open, request blocked")
-		}
-
-		// Refresh timestamp for each attempt and set recvWindow for clock skew tolerance
-		params["timestamp"] = strconv.FormatInt(time.Now().UnixMilli(), 10)
-		params["recvWindow"] = "10000" // 10 seconds tolerance for clock skew
-		query := c.signParams(params)
-		reqURL := fmt.Sprintf("%s%s", c.baseURL, endpoint)
-
-		req, err := http.NewRequest("PUT", reqURL, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		req.URL.RawQuery = query
-		req.Header.Set("X-MBX-APIKEY", c.apiKey)
-
-		resp, err := c.httpClient.Do(req)
-		if err != nil {
-			lastErr = err
-			if attempt < maxRetries {
-				delay := calculateRetryDelay(attempt)
-				log.Printf("[BINANCE] PUT %s failed (attempt %d/%d): %v, retrying in %v",
-					endpoint, attempt+1, maxRetries+1, err, delay)
-				time.Sleep(delay)
-				continue
-			}
-			return nil, err
-		}
-
-		body, err := io.ReadAll(resp.Body)
-		resp.Body.Close()
-		if err != nil {
-			return nil, err
-		}
-
-		// Update rate limiter from headers
-		if usedWeight := resp.Header.Get("X-MBX-USED-WEIGHT-1M"); usedWeight != "" {
-			if weight, err := strconv.Atoi(usedWeight); err == nil {
-				rateLimiter.UpdateFromHeaders(0, weight)
-			}
-		}
-
-		if resp.StatusCode != http.StatusOK {
-			lastErr = fmt.Errorf("API error: %s", string(body))
-
-			// Check for rate limit error and trigger circuit breaker
-			if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == 418 ||
-				strings.Contains(string(body), "-1003") {
-				banUntil := ParseBanUntilFromError(string(body))
-				rateLimiter.RecordRateLimitError(banUntil)
-			}
-
-			if isRetryableError(resp.StatusCode, string(body)) && attempt < maxRetries {
-				delay := calculateRetryDelay(attempt)
-				log.Printf("[BINANCE] PUT %s returned %d (attempt %d/%d): %s, retrying in %v",
-					endpoint, resp.StatusCode, attempt+1, maxRetries+1, string(body), delay)
-				time.Sleep(delay)
-				continue
-			}
-			return nil, lastErr
-		}
-
-		// Record successful request
-		rateLimiter.RecordRequest(endpoint)
-		return body, nil
-	}
-
-	return nil, lastErr
-}
-
-// signedDelete performs an authenticated DELETE request with rate limiting and retry logic
-func (c *FuturesClientImpl) signedDelete(endpoint string, params map[string]string) ([]byte, error) {
-	rateLimiter := GetRateLimiter()
-	var lastErr error
-
-	for attempt := 0; attempt <= maxRetries; attempt++ {
-		// Check rate limiter before making request
-		if !rateLimiter.WaitForSlot(endpoint, 30*time.Second) {
-			return nil, fmt.Errorf("rate limit: circuit breaker open, request blocked")
-		}
-
-		// Refresh timestamp for each attempt and set recvWindow for clock skew tolerance
-		params["timestamp"] = strconv.FormatInt(time.Now().UnixMilli(), 10)
-		params["recvWindow"] = "10000" // 10 seconds tolerance for clock skew
-		query := c.signParams(params)
-		reqURL := fmt.Sprintf("%s%s", c.baseURL, endpoint)
-
-		req, err := http.NewRequest("DELETE", reqURL, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		req.URL.RawQuery = query
-		req.Header.Set("X-MBX-APIKEY", c.apiKey)
-
-		resp, err := c.httpClient.Do(req)
-		if err != nil {
-			lastErr = err
-			if attempt < maxRetries {
-				delay := calculateRetryDelay(attempt)
-				log.Printf("[BINANCE] DELETE %s failed (attempt %d/%d): %v, retrying in %v",
-					endpoint, attempt+1, maxRetries+1, err, delay)
-				time.Sleep(delay)
-				continue
-			}
-			return nil, err
-		}
-
-		body, err := io.ReadAll(resp.Body)
-		resp.Body.Close()
-		if err != nil {
-			return nil, err
-		}
-
-		// Update rate limiter from headers
-		if usedWeight := resp.Header.Get("X-MBX-USED-WEIGHT-1M"); usedWeight != "" {
-			if weight, err := strconv.Atoi(usedWeight); err == nil {
-				rateLimiter.UpdateFromHeaders(0, weight)
-			}
-		}
-
-		if resp.StatusCode != http.StatusOK {
-			lastErr = fmt.Errorf("API error: %s", string(body))
-
-			// Check for rate limit error and trigger circuit breaker
-			if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == 418 ||
-				strings.Contains(string(body), "-1003") {
-				banUntil := ParseBanUntilFromError(string(body))
-				rateLimiter.RecordRateLimitError(banUntil)
-			}
-
-			if isRetryableError(resp.StatusCode, string(body)) && attempt < maxRetries {
-				delay := calculateRetryDelay(attempt)
-				log.Printf("[BINANCE] DELETE %s returned %d (attempt %d/%d): %s, retrying in %v",
-					endpoint, resp.StatusCode, attempt+1, maxRetries+1, string(body), delay)
-				time.Sleep(delay)
-				continue
-			}
-			return nil, lastErr
-		}
-
-		// Record successful request
-		rateLimiter.RecordRequest(endpoint)
-		return body, nil
-	}
-
-	return nil, lastErr
-}
-
 // Ensure FuturesClientImpl implements FuturesClient
 var _ FuturesClient = (*FuturesClientImpl)(nil)
@@ -3,6 +3,7 @@ package binance
 import (
 	"log"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -56,9 +57,10 @@ type inFlightRequest struct {
 type KlineCacheStats struct {
 	Hits             int64   `json:"hits"`
 	Misses           int64   `json:"misses"`
-	Deduplicated     int64   `json:"deduplicated"`      // Requests that waited for in-flight
-	PrefetchHits     int64   `json:"prefetch_hits"`     // Hits from prefetched data
-	PrefetchRequests int64   `json:"prefetch_requests"` // Total prefetch calls
+	Deduplicated     int64   `json:"deduplicated"`          // Requests that waited for in-flight
+	PrefetchHits     int64   `json:"prefetch_hits"`         // Hits from prefetched data
+	PrefetchRequests int64   `json:"prefetch_requests"`     // Total prefetch calls
+	StalePriceFalls  int64   `json:"stale_price_fallbacks"` // Price reads sent to REST because the cache was stale
 	HitRate          float64 `json:"hit_rate"`
 	DedupeRate       float64 `json:"dedupe_rate"`
 }
@@ -77,6 +79,10 @@ type CachedFuturesClient struct {
 	deduplicatedReqs int64
 	prefetchHits     int64
 	prefetchReqs     int64
+
+	// Stale-price fallbacks: symbol -> time the staleness was last logged
+	staleLogged     sync.Map
+	stalePriceFalls atomic.Int64
 }
 
 // NewCachedFuturesClient creates a new cache-aware futures client wrapper
@@ -89,6 +95,11 @@ func NewCachedFuturesClient(client FuturesClient, cache *MarketDataCache) *Cache
 	}
 }
 
+// Unwrap returns the underlying client without the cache layer
+func (c *CachedFuturesClient) Unwrap() FuturesClient {
+	return c.client
+}
+
 // SetCache updates the cache reference
 func (c *CachedFuturesClient) SetCache(cache *MarketDataCache) {
 	c.mu.Lock()
@@ -251,6 +262,14 @@ func (c *CachedFuturesClient) PlaceFuturesOrder(params FuturesOrderParams) (*Fut
 	return result, err
 }
 
+func (c *CachedFuturesClient) PlaceProtectedMarketOrder(params FuturesOrderParams, slPrice float64, tpPrices []float64) (*ProtectedOrderResult, error) {
+	result, err := c.client.PlaceProtectedMarketOrder(params, slPrice, tpPrices)
+	if err == nil {
+		c.InvalidateUserDataCache() // Entry and legs placed - invalidate cache
+	}
+	return result, err
+}
+
 func (c *CachedFuturesClient) CancelFuturesOrder(symbol string, orderId int64) error {
 	err := c.client.CancelFuturesOrder(symbol, orderId)
 	if err == nil {
@@ -355,6 +374,14 @@ func (c *CachedFuturesClient) GetOpenAlgoOrders(symbol string) ([]AlgoOrder, err
 	return result, nil
 }
 
+func (c *CachedFuturesClient) GetAlgoOrderCount(symbol string) (int, error) {
+	orders, err := c.GetOpenAlgoOrders(symbol)
+	if err != nil {
+		return 0, err
+	}
+	return len(orders), nil
+}
+
 func (c *CachedFuturesClient) CancelAlgoOrder(symbol string, algoId int64) error {
 	err := c.client.CancelAlgoOrder(symbol, algoId)
 	if err == nil {
@@ -363,6 +390,10 @@ func (c *CachedFuturesClient) CancelAlgoOrder(symbol string, algoId int64) error
 	return err
 }
 
+func (c *CachedFuturesClient) SetCountdownCancelAll(symbol string, countdownTimeMs int64) error {
+	return c.client.SetCountdownCancelAll(symbol, countdownTimeMs)
+}
+
 func (c *CachedFuturesClient) CancelAllAlgoOrders(symbol string) error {
 	err := c.client.CancelAllAlgoOrders(symbol)
 	if err == nil {
@@ -387,9 +418,10 @@ func (c *CachedFuturesClient) GetMarkPrice(symbol string) (*MarkPrice, error) {
 		if cached := cache.GetMarkPrice(symbol); cached != nil {
 			return cached, nil
 		}
+		c.noteStalePrice(cache, symbol)
 	}
 
-	// Cache miss - fall back to REST API
+	// Cache miss or stale - fall back to REST API
 	result, err := c.client.GetMarkPrice(symbol)
 	if err != nil {
 		return nil, err
@@ -418,12 +450,39 @@ func (c *CachedFuturesClient) GetFuturesCurrentPrice(symbol string) (float64, er
 		if price, ok := cache.GetCurrentPrice(symbol); ok {
 			return price, nil
 		}
+		c.noteStalePrice(cache, symbol)
 	}
 
-	// Cache miss - fall back to REST API
+	// Cache miss or stale - fall back to REST API
 	return c.client.GetFuturesCurrentPrice(symbol)
 }
 
+// noteStalePrice counts and logs a REST fallback caused by a cached price older
+// than the cache's max age (e.g. a stalled WebSocket). Logging is throttled to
+// once per max-age window per symbol so an outage doesn't flood the log.
+func (c *CachedFuturesClient) noteStalePrice(cache *MarketDataCache, symbol string) {
+	_, age, ok := cache.GetPriceWithAge(symbol)
+	maxAge := cache.MaxPriceAge()
+	if !ok || age < maxAge {
+		return // Plain miss, not staleness
+	}
+
+	c.stalePriceFalls.Add(1)
+	now := time.Now()
+	if last, loaded := c.staleLogged.Load(symbol); loaded && now.Sub(last.(time.Time)) < maxAge {
+		return
+	}
+	c.staleLogged.Store(symbol, now)
+	log.Printf("[MARKET-CACHE] Stale price for %s (age %v > max %v) - WebSocket may be stalled, falling back to REST",
+		symbol, age.Round(time.Second), maxAge)
+}
+
+// StalePriceFallbacks returns how many price reads fell back to REST because
+// the cached price was stale
+func (c *CachedFuturesClient) StalePriceFallbacks() int64 {
+	return c.stalePriceFalls.Load()
+}
+
 // GetFuturesKlines returns cached klines if available, otherwise falls back to REST API
 // Uses in-flight request deduplication to avoid redundant API calls for the same symbol:interval
 func (c *CachedFuturesClient) GetFuturesKlines(symbol, interval string, limit int) ([]Kline, error) {
@@ -555,6 +614,10 @@ func (c *CachedFuturesClient) GetFuturesExchangeInfo() (*FuturesExchangeInfo, er
 	return c.client.GetFuturesExchangeInfo()
 }
 
+func (c *CachedFuturesClient) Ping() error {
+	return c.client.Ping()
+}
+
 func (c *CachedFuturesClient) GetFuturesSymbols() ([]string, error) {
 	return c.client.GetFuturesSymbols()
 }
@@ -585,6 +648,10 @@ func (c *CachedFuturesClient) GetIncomeHistory(incomeType string, startTime, end
 	return c.client.GetIncomeHistory(incomeType, startTime, endTime, limit)
 }
 
+func (c *CachedFuturesClient) GetAllIncomeHistory(symbol, incomeType string, startTime, endTime int64) ([]IncomeRecord, error) {
+	return c.client.GetAllIncomeHistory(symbol, incomeType, startTime, endTime)
+}
+
 // ==================== WEBSOCKET (no caching) ====================
 
 func (c *CachedFuturesClient) GetListenKey() (string, error) {
@@ -717,6 +784,7 @@ func (c *CachedFuturesClient) GetKlineCacheStats() KlineCacheStats {
 		Deduplicated:     deduplicated,
 		PrefetchHits:     prefetchHits,
 		PrefetchRequests: prefetchReqs,
+		StalePriceFalls:  c.stalePriceFalls.Load(),
 		HitRate:          hitRate,
 		DedupeRate:       dedupeRate,
 	}
@@ -737,6 +805,7 @@ func (c *CachedFuturesClient) ResetKlineCacheStats() {
 	c.prefetchHits = 0
 	c.prefetchReqs = 0
 	c.inFlightMu.Unlock()
+	c.stalePriceFalls.Store(0)
 }
 
 // Compile-time check that CachedFuturesClient implements FuturesClient
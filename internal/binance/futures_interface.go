@@ -35,12 +35,22 @@ type FuturesClient interface {
 	// PlaceFuturesOrder places a new futures order
 	PlaceFuturesOrder(params FuturesOrderParams) (*FuturesOrderResponse, error)
 
+	// PlaceProtectedMarketOrder places a MARKET entry with a closePosition SL and
+	// reduce-only TPs (quantity split evenly), using the most atomic placement the
+	// exchange accepts and falling back to entry-then-SL-then-TPs. The error is only
+	// set when the entry was not placed; check ProtectedOrderResult.Protected().
+	PlaceProtectedMarketOrder(params FuturesOrderParams, slPrice float64, tpPrices []float64) (*ProtectedOrderResult, error)
+
 	// CancelFuturesOrder cancels an existing futures order
 	CancelFuturesOrder(symbol string, orderId int64) error
 
 	// CancelAllFuturesOrders cancels all open orders for a symbol
 	CancelAllFuturesOrders(symbol string) error
 
+	// SetCountdownCancelAll arms Binance's auto-cancel for a symbol: all open orders are
+	// cancelled if the countdown is not refreshed within countdownTimeMs (0 disarms it)
+	SetCountdownCancelAll(symbol string, countdownTimeMs int64) error
+
 	// GetOpenOrders retrieves all open orders for a symbol (empty string for all symbols)
 	GetOpenOrders(symbol string) ([]FuturesOrder, error)
 
@@ -56,6 +66,9 @@ type FuturesClient interface {
 	// GetOpenAlgoOrders retrieves all open algo orders
 	GetOpenAlgoOrders(symbol string) ([]AlgoOrder, error)
 
+	// GetAlgoOrderCount returns the number of open algo orders for a symbol
+	GetAlgoOrderCount(symbol string) (int, error)
+
 	// CancelAlgoOrder cancels an algo order
 	CancelAlgoOrder(symbol string, algoId int64) error
 
@@ -102,6 +115,9 @@ type FuturesClient interface {
 	// GetFuturesSymbols retrieves all available futures trading pairs
 	GetFuturesSymbols() ([]string, error)
 
+	// Ping tests connectivity to the futures API; it fails while the exchange is down for maintenance
+	Ping() error
+
 	// ==================== HISTORY ====================
 
 	// GetTradeHistory retrieves trade history for a symbol
@@ -127,6 +143,11 @@ type FuturesClient interface {
 	// startTime/endTime in milliseconds, 0 to ignore
 	GetIncomeHistory(incomeType string, startTime, endTime int64, limit int) ([]IncomeRecord, error)
 
+	// GetAllIncomeHistory retrieves every income record in the range, paging past the
+	// 1000-record limit. symbol and incomeType may be empty for all.
+	// startTime/endTime in milliseconds, 0 to ignore
+	GetAllIncomeHistory(symbol, incomeType string, startTime, endTime int64) ([]IncomeRecord, error)
+
 	// ==================== WEBSOCKET ====================
 
 	// GetListenKey creates a new user data stream listen key
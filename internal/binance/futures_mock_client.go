@@ -145,6 +145,9 @@ func (c *FuturesMockClient) SetMarginType(symbol string, marginType MarginType)
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	if pos, exists := c.positions[symbol]; exists && pos.PositionAmt != 0 && c.getMarginTypeLocked(symbol) != marginType {
+		return ErrMarginTypeLocked
+	}
 	c.marginType[symbol] = marginType
 	return nil
 }
@@ -305,6 +308,11 @@ func (c *FuturesMockClient) PlaceFuturesOrder(params FuturesOrderParams) (*Futur
 	}, nil
 }
 
+// PlaceProtectedMarketOrder fills the entry and places the SL/TP legs as algo orders
+func (c *FuturesMockClient) PlaceProtectedMarketOrder(params FuturesOrderParams, slPrice float64, tpPrices []float64) (*ProtectedOrderResult, error) {
+	return PlaceProtectedSequentially(c, params, slPrice, tpPrices)
+}
+
 func (c *FuturesMockClient) CancelFuturesOrder(symbol string, orderId int64) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -400,11 +408,21 @@ func (c *FuturesMockClient) GetOpenAlgoOrders(symbol string) ([]AlgoOrder, error
 	return []AlgoOrder{}, nil
 }
 
+func (c *FuturesMockClient) GetAlgoOrderCount(symbol string) (int, error) {
+	// Mock doesn't track algo orders
+	return 0, nil
+}
+
 func (c *FuturesMockClient) CancelAlgoOrder(symbol string, algoId int64) error {
 	// Mock - always succeeds
 	return nil
 }
 
+func (c *FuturesMockClient) SetCountdownCancelAll(symbol string, countdownTimeMs int64) error {
+	// Mock - nothing to protect, paper orders never outlive the process
+	return nil
+}
+
 func (c *FuturesMockClient) CancelAllAlgoOrders(symbol string) error {
 	// Mock - always succeeds
 	return nil
@@ -651,6 +669,11 @@ func (c *FuturesMockClient) GetFuturesExchangeInfo() (*FuturesExchangeInfo, erro
 	}, nil
 }
 
+// Ping always succeeds: the mock exchange never goes down for maintenance
+func (c *FuturesMockClient) Ping() error {
+	return nil
+}
+
 func (c *FuturesMockClient) GetFuturesSymbols() ([]string, error) {
 	return []string{
 		// Major
@@ -791,6 +814,11 @@ func (c *FuturesMockClient) GetIncomeHistory(incomeType string, startTime, endTi
 	return []IncomeRecord{}, nil
 }
 
+// GetAllIncomeHistory returns empty records for mock client
+func (c *FuturesMockClient) GetAllIncomeHistory(symbol, incomeType string, startTime, endTime int64) ([]IncomeRecord, error) {
+	return []IncomeRecord{}, nil
+}
+
 // ==================== WEBSOCKET ====================
 
 func (c *FuturesMockClient) GetListenKey() (string, error) {
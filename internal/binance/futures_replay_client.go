@@ -0,0 +1,224 @@
+package binance
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ReplayFuturesClient is a ScriptedFuturesClient that serves recorded klines
+// instead of live market data, on its own clock. Only candles that had closed
+// by the replay time are visible, so analysis at a given time sees exactly
+// what was known then. Advancing the clock moves each symbol to its last
+// close, which triggers algo orders like SetPrice does. 24h tickers are
+// derived from the finest recorded interval.
+type ReplayFuturesClient struct {
+	*ScriptedFuturesClient
+
+	replayMu sync.RWMutex
+	now      time.Time
+	klines   map[string]map[string][]Kline // symbol -> interval -> candles by open time
+}
+
+// NewReplayFuturesClient creates a replay client with the given USDT balance and clock
+func NewReplayFuturesClient(balance float64, start time.Time) *ReplayFuturesClient {
+	return &ReplayFuturesClient{
+		ScriptedFuturesClient: NewScriptedFuturesClient(balance),
+		now:                   start,
+		klines:                make(map[string]map[string][]Kline),
+	}
+}
+
+// LoadKlines adds recorded candles for symbol and interval, replacing any
+// already loaded with the same open time
+func (r *ReplayFuturesClient) LoadKlines(symbol, interval string, klines []Kline) {
+	r.replayMu.Lock()
+	defer r.replayMu.Unlock()
+
+	if r.klines[symbol] == nil {
+		r.klines[symbol] = make(map[string][]Kline)
+	}
+	byOpen := make(map[int64]Kline, len(r.klines[symbol][interval])+len(klines))
+	for _, k := range r.klines[symbol][interval] {
+		byOpen[k.OpenTime] = k
+	}
+	for _, k := range klines {
+		byOpen[k.OpenTime] = k
+	}
+	merged := make([]Kline, 0, len(byOpen))
+	for _, k := range byOpen {
+		merged = append(merged, k)
+	}
+	sort.Slice(merged, func(i, j int) bool { return merged[i].OpenTime < merged[j].OpenTime })
+	r.klines[symbol][interval] = merged
+}
+
+// Now returns the replay clock
+func (r *ReplayFuturesClient) Now() time.Time {
+	r.replayMu.RLock()
+	defer r.replayMu.RUnlock()
+	return r.now
+}
+
+// AdvanceTo moves the replay clock to t and every symbol to its last close
+func (r *ReplayFuturesClient) AdvanceTo(t time.Time) {
+	r.replayMu.Lock()
+	r.now = t
+	prices := make(map[string]float64, len(r.klines))
+	for symbol := range r.klines {
+		if price, ok := r.lastCloseLocked(symbol); ok {
+			prices[symbol] = price
+		}
+	}
+	r.replayMu.Unlock()
+
+	symbols := make([]string, 0, len(prices))
+	for symbol := range prices {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols) // Deterministic trigger order
+	for _, symbol := range symbols {
+		r.SetPrice(symbol, prices[symbol])
+	}
+}
+
+// Advance moves the replay clock forward by d
+func (r *ReplayFuturesClient) Advance(d time.Duration) {
+	r.AdvanceTo(r.Now().Add(d))
+}
+
+// closedLocked returns the candles of a series closed by the replay time. Caller must hold replayMu.
+func (r *ReplayFuturesClient) closedLocked(series []Kline) []Kline {
+	nowMs := r.now.UnixMilli()
+	n := sort.Search(len(series), func(i int) bool { return series[i].CloseTime > nowMs })
+	return series[:n]
+}
+
+// lastCloseLocked returns the close of the most recently closed candle in any
+// interval, preferring the finest when several close together. Caller must hold replayMu.
+func (r *ReplayFuturesClient) lastCloseLocked(symbol string) (float64, bool) {
+	var latest Kline
+	found := false
+	for _, series := range r.klines[symbol] {
+		closed := r.closedLocked(series)
+		if len(closed) == 0 {
+			continue
+		}
+		last := closed[len(closed)-1]
+		if !found || last.CloseTime > latest.CloseTime ||
+			(last.CloseTime == latest.CloseTime && last.OpenTime > latest.OpenTime) {
+			latest, found = last, true
+		}
+	}
+	return latest.Close, found
+}
+
+// finestSeriesLocked returns the recorded interval with the shortest candles. Caller must hold replayMu.
+func (r *ReplayFuturesClient) finestSeriesLocked(symbol string) []Kline {
+	var finest []Kline
+	var finestSpan int64
+	for _, series := range r.klines[symbol] {
+		if len(series) == 0 {
+			continue
+		}
+		if span := series[0].CloseTime - series[0].OpenTime; finest == nil || span < finestSpan {
+			finest, finestSpan = series, span
+		}
+	}
+	return finest
+}
+
+// ==================== MARKET DATA ====================
+
+// GetFuturesKlines returns the last limit recorded candles closed by the replay time
+func (r *ReplayFuturesClient) GetFuturesKlines(symbol, interval string, limit int) ([]Kline, error) {
+	r.replayMu.RLock()
+	defer r.replayMu.RUnlock()
+
+	closed := r.closedLocked(r.klines[symbol][interval])
+	if len(closed) == 0 {
+		return nil, fmt.Errorf("no recorded %s %s klines before %s", symbol, interval, r.now.UTC().Format(time.RFC3339))
+	}
+	if limit > 0 && len(closed) > limit {
+		closed = closed[len(closed)-limit:]
+	}
+	return append([]Kline(nil), closed...), nil
+}
+
+// Get24hrTicker derives 24h statistics from the finest recorded interval
+func (r *ReplayFuturesClient) Get24hrTicker(symbol string) (*Futures24hrTicker, error) {
+	r.replayMu.RLock()
+	defer r.replayMu.RUnlock()
+	return r.ticker24hLocked(symbol)
+}
+
+// GetAll24hrTickers returns a derived 24h ticker for every recorded symbol with data by the replay time
+func (r *ReplayFuturesClient) GetAll24hrTickers() ([]Futures24hrTicker, error) {
+	r.replayMu.RLock()
+	defer r.replayMu.RUnlock()
+
+	tickers := make([]Futures24hrTicker, 0, len(r.klines))
+	for _, symbol := range r.symbolsLocked() {
+		if ticker, err := r.ticker24hLocked(symbol); err == nil {
+			tickers = append(tickers, *ticker)
+		}
+	}
+	return tickers, nil
+}
+
+// GetFuturesSymbols returns the recorded symbols
+func (r *ReplayFuturesClient) GetFuturesSymbols() ([]string, error) {
+	r.replayMu.RLock()
+	defer r.replayMu.RUnlock()
+	return r.symbolsLocked(), nil
+}
+
+func (r *ReplayFuturesClient) symbolsLocked() []string {
+	symbols := make([]string, 0, len(r.klines))
+	for symbol := range r.klines {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	return symbols
+}
+
+// ticker24hLocked builds a 24h ticker from the candles closed in the last 24h. Caller must hold replayMu.
+func (r *ReplayFuturesClient) ticker24hLocked(symbol string) (*Futures24hrTicker, error) {
+	closed := r.closedLocked(r.finestSeriesLocked(symbol))
+	if len(closed) == 0 {
+		return nil, fmt.Errorf("no recorded %s klines before %s", symbol, r.now.UTC().Format(time.RFC3339))
+	}
+	windowStart := r.now.Add(-24 * time.Hour).UnixMilli()
+	first := sort.Search(len(closed), func(i int) bool { return closed[i].OpenTime >= windowStart })
+	if first == len(closed) {
+		first = len(closed) - 1 // Keep the last candle when the recording has a gap
+	}
+	window := closed[first:]
+
+	last := window[len(window)-1]
+	ticker := &Futures24hrTicker{
+		Symbol:    symbol,
+		OpenPrice: window[0].Open,
+		LastPrice: last.Close,
+		HighPrice: window[0].High,
+		LowPrice:  window[0].Low,
+		OpenTime:  window[0].OpenTime,
+		CloseTime: last.CloseTime,
+	}
+	for _, k := range window {
+		ticker.HighPrice = max(ticker.HighPrice, k.High)
+		ticker.LowPrice = min(ticker.LowPrice, k.Low)
+		ticker.Volume += k.Volume
+		ticker.QuoteVolume += k.QuoteAssetVolume
+		ticker.Count += int64(k.NumberOfTrades)
+	}
+	ticker.PriceChange = ticker.LastPrice - ticker.OpenPrice
+	if ticker.OpenPrice > 0 {
+		ticker.PriceChangePercent = ticker.PriceChange / ticker.OpenPrice * 100
+	}
+	if ticker.Volume > 0 {
+		ticker.WeightedAvgPrice = ticker.QuoteVolume / ticker.Volume
+	}
+	return ticker, nil
+}
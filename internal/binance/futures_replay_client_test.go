@@ -0,0 +1,89 @@
+package binance
+
+import (
+	"testing"
+	"time"
+)
+
+// replayCandles builds n consecutive candles of width step starting at start,
+// closing at closes[i]
+func replayCandles(start time.Time, step time.Duration, closes ...float64) []Kline {
+	klines := make([]Kline, len(closes))
+	for i, c := range closes {
+		open := start.Add(time.Duration(i) * step)
+		klines[i] = Kline{
+			OpenTime:         open.UnixMilli(),
+			Open:             c,
+			High:             c + 1,
+			Low:              c - 1,
+			Close:            c,
+			Volume:           10,
+			QuoteAssetVolume: 10 * c,
+			CloseTime:        open.Add(step).UnixMilli() - 1,
+		}
+	}
+	return klines
+}
+
+// TestReplayClientHidesFutureCandles checks that only candles closed by the
+// replay clock are served
+func TestReplayClientHidesFutureCandles(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	client := NewReplayFuturesClient(10000, start)
+	client.LoadKlines("BTCUSDT", "1m", replayCandles(start, time.Minute, 100, 101, 102, 103))
+
+	if _, err := client.GetFuturesKlines("BTCUSDT", "1m", 10); err == nil {
+		t.Fatal("klines served before any candle closed")
+	}
+
+	client.AdvanceTo(start.Add(2 * time.Minute))
+	klines, err := client.GetFuturesKlines("BTCUSDT", "1m", 10)
+	if err != nil || len(klines) != 2 || klines[1].Close != 101 {
+		t.Fatalf("klines at +2m = %+v, %v; want 2 candles ending at 101", klines, err)
+	}
+	if price, _ := client.GetFuturesCurrentPrice("BTCUSDT"); price != 101 {
+		t.Errorf("price at +2m = %v, want 101", price)
+	}
+
+	client.Advance(2 * time.Minute)
+	klines, _ = client.GetFuturesKlines("BTCUSDT", "1m", 3)
+	if len(klines) != 3 || klines[0].Close != 101 || klines[2].Close != 103 {
+		t.Errorf("last 3 klines at +4m = %+v, want 101..103", klines)
+	}
+
+	ticker, err := client.Get24hrTicker("BTCUSDT")
+	if err != nil || ticker.OpenPrice != 100 || ticker.LastPrice != 103 || ticker.HighPrice != 104 || ticker.Volume != 40 {
+		t.Errorf("24h ticker = %+v, %v", ticker, err)
+	}
+	if symbols, _ := client.GetFuturesSymbols(); len(symbols) != 1 || symbols[0] != "BTCUSDT" {
+		t.Errorf("symbols = %v, want [BTCUSDT]", symbols)
+	}
+}
+
+// TestReplayClientTriggersStops checks that advancing the clock fills algo
+// orders at the recorded prices
+func TestReplayClientTriggersStops(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	client := NewReplayFuturesClient(10000, start)
+	client.LoadKlines("BTCUSDT", "1m", replayCandles(start, time.Minute, 100, 98, 94))
+	client.AdvanceTo(start.Add(time.Minute))
+
+	if _, err := client.PlaceFuturesOrder(FuturesOrderParams{
+		Symbol:   "BTCUSDT",
+		Side:     "BUY",
+		Type:     FuturesOrderTypeMarket,
+		Quantity: 1,
+	}); err != nil {
+		t.Fatalf("entry: %v", err)
+	}
+	placeStop(t, client.ScriptedFuturesClient, 95)
+
+	client.Advance(time.Minute)
+	if amt := client.PositionAmt("BTCUSDT"); amt != 1 {
+		t.Fatalf("position at 98 = %v, want 1", amt)
+	}
+	client.Advance(time.Minute)
+	if amt := client.PositionAmt("BTCUSDT"); amt != 0 {
+		t.Errorf("position after close at 94 = %v, want stopped out", amt)
+	}
+}
@@ -0,0 +1,567 @@
+package binance
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ScriptedFill is the outcome a ScriptedFuturesClient gives the next order
+type ScriptedFill struct {
+	Fraction float64 // Share of the quantity that fills (0-1)
+	Err      error   // Non-nil rejects the order with this error
+}
+
+// FillFull fills the whole order at the current price (the default)
+var FillFull = ScriptedFill{Fraction: 1}
+
+// FillPartial fills fraction of the order. A partial MARKET order ends EXPIRED
+// (IOC remainder cancelled, as on Binance), a partial LIMIT order stays
+// PARTIALLY_FILLED. A fraction of 0 leaves a LIMIT order resting unfilled.
+func FillPartial(fraction float64) ScriptedFill {
+	return ScriptedFill{Fraction: fraction}
+}
+
+// FillReject rejects the order with err
+func FillReject(err error) ScriptedFill {
+	return ScriptedFill{Err: err}
+}
+
+// ScriptedOrder is one PlaceFuturesOrder call and its outcome. Orders the
+// client fills itself when an algo order triggers have Triggered set.
+type ScriptedOrder struct {
+	Params    FuturesOrderParams
+	Order     FuturesOrder // State after placement (zero if rejected)
+	Err       error
+	Triggered bool
+}
+
+// ScriptedFuturesClient is a deterministic FuturesClient for tests. Prices
+// only move when the test sets or steps them, each order consumes the next
+// scripted fill (full fill when none is left), and funding rates and the
+// balance are set directly. Conditional algo orders (STOP_MARKET,
+// TAKE_PROFIT_MARKET) trigger when a price move crosses them and close
+// against the position like the exchange would; trailing stops are recorded
+// but never trigger. Everything placed is recorded for assertions. Position
+// and balance bookkeeping comes from the embedded FuturesMockClient.
+type ScriptedFuturesClient struct {
+	*FuturesMockClient
+
+	mu           sync.Mutex
+	prices       map[string]float64
+	priceScripts map[string][]float64
+	fills        map[string][]ScriptedFill
+	funding      map[string]FundingRate
+	orders       []ScriptedOrder
+	algoOrders   []*AlgoOrder
+}
+
+// NewScriptedFuturesClient creates a scripted client with the given USDT balance
+func NewScriptedFuturesClient(balance float64) *ScriptedFuturesClient {
+	s := &ScriptedFuturesClient{
+		prices:       make(map[string]float64),
+		priceScripts: make(map[string][]float64),
+		fills:        make(map[string][]ScriptedFill),
+		funding:      make(map[string]FundingRate),
+	}
+	s.FuturesMockClient = NewFuturesMockClient(balance, s.price)
+	return s
+}
+
+// ==================== SCRIPTING ====================
+
+// SetPrice moves symbol to price and triggers any algo orders it crosses
+func (s *ScriptedFuturesClient) SetPrice(symbol string, price float64) {
+	s.mu.Lock()
+	s.prices[symbol] = price
+	s.mu.Unlock()
+
+	s.triggerAlgoOrders(symbol, price)
+}
+
+// ScriptPrices queues prices for symbol, consumed one per Step
+func (s *ScriptedFuturesClient) ScriptPrices(symbol string, prices ...float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.priceScripts[symbol] = append(s.priceScripts[symbol], prices...)
+}
+
+// Step moves every symbol with scripted prices to its next price. Returns
+// false once all scripts are exhausted.
+func (s *ScriptedFuturesClient) Step() bool {
+	s.mu.Lock()
+	next := make(map[string]float64)
+	for symbol, script := range s.priceScripts {
+		if len(script) == 0 {
+			continue
+		}
+		next[symbol] = script[0]
+		s.priceScripts[symbol] = script[1:]
+	}
+	s.mu.Unlock()
+
+	for symbol, price := range next {
+		s.SetPrice(symbol, price)
+	}
+	return len(next) > 0
+}
+
+// ScriptFills queues fill outcomes for the next orders on symbol
+func (s *ScriptedFuturesClient) ScriptFills(symbol string, fills ...ScriptedFill) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fills[symbol] = append(s.fills[symbol], fills...)
+}
+
+// SetFundingRate sets symbol's current funding rate and next funding time
+func (s *ScriptedFuturesClient) SetFundingRate(symbol string, rate float64, nextFunding time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.funding[symbol] = FundingRate{
+		Symbol:          symbol,
+		FundingRate:     rate,
+		FundingTime:     time.Now().UnixMilli(),
+		NextFundingTime: nextFunding.UnixMilli(),
+	}
+}
+
+// SetBalance sets the account's USDT wallet balance
+func (s *ScriptedFuturesClient) SetBalance(balance float64) {
+	s.FuturesMockClient.mu.Lock()
+	defer s.FuturesMockClient.mu.Unlock()
+	s.FuturesMockClient.balance = balance
+}
+
+// ==================== ASSERTIONS ====================
+
+// Orders returns every order placed so far, in order
+func (s *ScriptedFuturesClient) Orders() []ScriptedOrder {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]ScriptedOrder(nil), s.orders...)
+}
+
+// AlgoOrders returns every algo order placed so far, with its current status
+func (s *ScriptedFuturesClient) AlgoOrders() []AlgoOrder {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	orders := make([]AlgoOrder, 0, len(s.algoOrders))
+	for _, o := range s.algoOrders {
+		orders = append(orders, *o)
+	}
+	return orders
+}
+
+// PositionAmt returns symbol's signed position size (one-way mode)
+func (s *ScriptedFuturesClient) PositionAmt(symbol string) float64 {
+	s.FuturesMockClient.mu.RLock()
+	defer s.FuturesMockClient.mu.RUnlock()
+	if pos, ok := s.FuturesMockClient.positions[symbol]; ok {
+		return pos.PositionAmt
+	}
+	return 0
+}
+
+// ==================== FuturesClient OVERRIDES ====================
+
+// price is the embedded mock's price provider
+func (s *ScriptedFuturesClient) price(symbol string) (float64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	price, ok := s.prices[symbol]
+	if !ok {
+		return 0, fmt.Errorf("no scripted price for %s", symbol)
+	}
+	return price, nil
+}
+
+// GetFuturesCurrentPrice returns the scripted price
+func (s *ScriptedFuturesClient) GetFuturesCurrentPrice(symbol string) (float64, error) {
+	return s.price(symbol)
+}
+
+// GetFundingRate returns the scripted funding rate, or the mock default
+func (s *ScriptedFuturesClient) GetFundingRate(symbol string) (*FundingRate, error) {
+	s.mu.Lock()
+	rate, ok := s.funding[symbol]
+	s.mu.Unlock()
+	if !ok {
+		return s.FuturesMockClient.GetFundingRate(symbol)
+	}
+	if price, err := s.price(symbol); err == nil {
+		rate.MarkPrice = price
+	}
+	return &rate, nil
+}
+
+// PlaceProtectedMarketOrder runs the sequential path through the scripted fills
+// and algo orders (the embedded mock's version would bypass them)
+func (s *ScriptedFuturesClient) PlaceProtectedMarketOrder(params FuturesOrderParams, slPrice float64, tpPrices []float64) (*ProtectedOrderResult, error) {
+	return PlaceProtectedSequentially(s, params, slPrice, tpPrices)
+}
+
+// PlaceFuturesOrder applies the next scripted fill to the order
+func (s *ScriptedFuturesClient) PlaceFuturesOrder(params FuturesOrderParams) (*FuturesOrderResponse, error) {
+	s.mu.Lock()
+	fill := FillFull
+	if queue := s.fills[params.Symbol]; len(queue) > 0 {
+		fill = queue[0]
+		s.fills[params.Symbol] = queue[1:]
+	}
+	s.mu.Unlock()
+
+	if fill.Err != nil {
+		s.record(ScriptedOrder{Params: params, Err: fill.Err})
+		return nil, fill.Err
+	}
+	return s.fillOrder(params, fill.Fraction, false)
+}
+
+// fillOrder executes fraction of params against the mock's positions and records it
+func (s *ScriptedFuturesClient) fillOrder(params FuturesOrderParams, fraction float64, triggered bool) (*FuturesOrderResponse, error) {
+	if fraction < 0 {
+		fraction = 0
+	}
+	if fraction > 1 {
+		fraction = 1
+	}
+
+	if _, err := s.price(params.Symbol); err != nil {
+		s.record(ScriptedOrder{Params: params, Err: err, Triggered: triggered})
+		return nil, err
+	}
+
+	order := FuturesOrder{
+		OrderId:       s.nextID(),
+		ClientOrderId: params.NewClientOrderId,
+		Symbol:        params.Symbol,
+		Status:        string(FuturesOrderStatusNew),
+		Price:         params.Price,
+		OrigQty:       params.Quantity,
+		TimeInForce:   string(params.TimeInForce),
+		Type:          string(params.Type),
+		ReduceOnly:    params.ReduceOnly,
+		ClosePosition: params.ClosePosition,
+		Side:          params.Side,
+		PositionSide:  string(params.PositionSide),
+		StopPrice:     params.StopPrice,
+		WorkingType:   string(params.WorkingType),
+		Time:          time.Now().UnixMilli(),
+		UpdateTime:    time.Now().UnixMilli(),
+	}
+
+	if filledQty := params.Quantity * fraction; filledQty > 0 {
+		fillParams := params
+		fillParams.Quantity = filledQty
+		resp, err := s.FuturesMockClient.PlaceFuturesOrder(fillParams)
+		if err != nil {
+			s.record(ScriptedOrder{Params: params, Err: err, Triggered: triggered})
+			return nil, err
+		}
+		order.AvgPrice = resp.AvgPrice
+		order.ExecutedQty = filledQty
+		order.CumQuote = resp.AvgPrice * filledQty
+	}
+
+	switch {
+	case fraction >= 1:
+		order.Status = string(FuturesOrderStatusFilled)
+	case order.Type == string(FuturesOrderTypeMarket):
+		order.Status = string(FuturesOrderStatusExpired)
+	case fraction > 0:
+		order.Status = string(FuturesOrderStatusPartiallyFilled)
+	}
+
+	s.record(ScriptedOrder{Params: params, Order: order, Triggered: triggered})
+	return &FuturesOrderResponse{
+		OrderId:       order.OrderId,
+		ClientOrderId: order.ClientOrderId,
+		Symbol:        order.Symbol,
+		Status:        order.Status,
+		Price:         order.Price,
+		AvgPrice:      order.AvgPrice,
+		OrigQty:       order.OrigQty,
+		ExecutedQty:   order.ExecutedQty,
+		CumQuote:      order.CumQuote,
+		TimeInForce:   order.TimeInForce,
+		Type:          order.Type,
+		ReduceOnly:    order.ReduceOnly,
+		Side:          order.Side,
+		PositionSide:  order.PositionSide,
+		UpdateTime:    order.UpdateTime,
+	}, nil
+}
+
+// nextID hands out order and algo IDs from the mock's sequence
+func (s *ScriptedFuturesClient) nextID() int64 {
+	s.FuturesMockClient.mu.Lock()
+	defer s.FuturesMockClient.mu.Unlock()
+	id := s.FuturesMockClient.nextOrderId
+	s.FuturesMockClient.nextOrderId++
+	return id
+}
+
+func (s *ScriptedFuturesClient) record(order ScriptedOrder) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.orders = append(s.orders, order)
+}
+
+// GetOrder returns a recorded order's latest state
+func (s *ScriptedFuturesClient) GetOrder(symbol string, orderId int64) (*FuturesOrder, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.orders {
+		if o := s.orders[i].Order; o.OrderId == orderId && o.Symbol == symbol {
+			return &o, nil
+		}
+	}
+	return nil, fmt.Errorf("order not found: %d", orderId)
+}
+
+// GetOpenOrders returns recorded orders still resting on the book
+func (s *ScriptedFuturesClient) GetOpenOrders(symbol string) ([]FuturesOrder, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	open := make([]FuturesOrder, 0)
+	for _, rec := range s.orders {
+		o := rec.Order
+		if o.OrderId == 0 || (symbol != "" && o.Symbol != symbol) {
+			continue
+		}
+		if o.Status == string(FuturesOrderStatusNew) || o.Status == string(FuturesOrderStatusPartiallyFilled) {
+			open = append(open, o)
+		}
+	}
+	return open, nil
+}
+
+// GetAllOrders returns the most recent recorded orders for symbol
+func (s *ScriptedFuturesClient) GetAllOrders(symbol string, limit int) ([]FuturesOrder, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	all := make([]FuturesOrder, 0)
+	for _, rec := range s.orders {
+		if rec.Order.OrderId != 0 && rec.Order.Symbol == symbol {
+			all = append(all, rec.Order)
+		}
+	}
+	if limit > 0 && len(all) > limit {
+		all = all[len(all)-limit:]
+	}
+	return all, nil
+}
+
+// CancelFuturesOrder cancels a resting recorded order
+func (s *ScriptedFuturesClient) CancelFuturesOrder(symbol string, orderId int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.orders {
+		o := &s.orders[i].Order
+		if o.OrderId != orderId || o.Symbol != symbol {
+			continue
+		}
+		if o.Status != string(FuturesOrderStatusNew) && o.Status != string(FuturesOrderStatusPartiallyFilled) {
+			return fmt.Errorf("order cannot be canceled")
+		}
+		o.Status = string(FuturesOrderStatusCanceled)
+		return nil
+	}
+	return fmt.Errorf("order not found: %d", orderId)
+}
+
+// CancelAllFuturesOrders cancels every resting recorded order for symbol
+func (s *ScriptedFuturesClient) CancelAllFuturesOrders(symbol string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.orders {
+		o := &s.orders[i].Order
+		if o.Symbol == symbol && (o.Status == string(FuturesOrderStatusNew) || o.Status == string(FuturesOrderStatusPartiallyFilled)) {
+			o.Status = string(FuturesOrderStatusCanceled)
+		}
+	}
+	return nil
+}
+
+// ==================== ALGO ORDERS ====================
+
+// PlaceAlgoOrder records a conditional order; it triggers on a later price move
+func (s *ScriptedFuturesClient) PlaceAlgoOrder(params AlgoOrderParams) (*AlgoOrderResponse, error) {
+	now := time.Now().UnixMilli()
+	order := &AlgoOrder{
+		AlgoId:        s.nextID(),
+		ClientAlgoId:  params.ClientAlgoId,
+		AlgoType:      string(AlgoTypeConditional),
+		OrderType:     string(params.Type),
+		Symbol:        params.Symbol,
+		Side:          params.Side,
+		PositionSide:  string(params.PositionSide),
+		AlgoStatus:    string(AlgoOrderStatusNew),
+		TriggerPrice:  params.TriggerPrice,
+		Price:         params.Price,
+		Quantity:      params.Quantity,
+		WorkingType:   string(params.WorkingType),
+		ClosePosition: params.ClosePosition,
+		ReduceOnly:    params.ReduceOnly,
+		PriceProtect:  params.PriceProtect,
+		ActivatePrice: params.ActivatePrice,
+		CallbackRate:  params.CallbackRate,
+		CreateTime:    now,
+		UpdateTime:    now,
+	}
+
+	s.mu.Lock()
+	s.algoOrders = append(s.algoOrders, order)
+	s.mu.Unlock()
+
+	return &AlgoOrderResponse{
+		AlgoId:        order.AlgoId,
+		ClientAlgoId:  order.ClientAlgoId,
+		AlgoType:      order.AlgoType,
+		OrderType:     order.OrderType,
+		Symbol:        order.Symbol,
+		Side:          order.Side,
+		PositionSide:  order.PositionSide,
+		AlgoStatus:    order.AlgoStatus,
+		TriggerPrice:  order.TriggerPrice,
+		Price:         order.Price,
+		Quantity:      order.Quantity,
+		WorkingType:   order.WorkingType,
+		ClosePosition: order.ClosePosition,
+		ReduceOnly:    order.ReduceOnly,
+		CreateTime:    order.CreateTime,
+		UpdateTime:    order.UpdateTime,
+	}, nil
+}
+
+// GetOpenAlgoOrders returns untriggered algo orders ("" for all symbols)
+func (s *ScriptedFuturesClient) GetOpenAlgoOrders(symbol string) ([]AlgoOrder, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	open := make([]AlgoOrder, 0)
+	for _, o := range s.algoOrders {
+		if o.AlgoStatus == string(AlgoOrderStatusNew) && (symbol == "" || o.Symbol == symbol) {
+			open = append(open, *o)
+		}
+	}
+	return open, nil
+}
+
+// GetAlgoOrderCount returns the number of untriggered algo orders for symbol
+func (s *ScriptedFuturesClient) GetAlgoOrderCount(symbol string) (int, error) {
+	open, err := s.GetOpenAlgoOrders(symbol)
+	return len(open), err
+}
+
+// GetAllAlgoOrders returns the most recent algo orders for symbol in any status
+func (s *ScriptedFuturesClient) GetAllAlgoOrders(symbol string, limit int) ([]AlgoOrder, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	all := make([]AlgoOrder, 0)
+	for _, o := range s.algoOrders {
+		if o.Symbol == symbol {
+			all = append(all, *o)
+		}
+	}
+	if limit > 0 && len(all) > limit {
+		all = all[len(all)-limit:]
+	}
+	return all, nil
+}
+
+// CancelAlgoOrder cancels an untriggered algo order
+func (s *ScriptedFuturesClient) CancelAlgoOrder(symbol string, algoId int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, o := range s.algoOrders {
+		if o.AlgoId != algoId || o.Symbol != symbol {
+			continue
+		}
+		if o.AlgoStatus != string(AlgoOrderStatusNew) {
+			return fmt.Errorf("algo order %d is %s", algoId, o.AlgoStatus)
+		}
+		o.AlgoStatus = string(AlgoOrderStatusCancelled)
+		o.UpdateTime = time.Now().UnixMilli()
+		return nil
+	}
+	return fmt.Errorf("algo order not found: %d", algoId)
+}
+
+// CancelAllAlgoOrders cancels every untriggered algo order for symbol
+func (s *ScriptedFuturesClient) CancelAllAlgoOrders(symbol string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, o := range s.algoOrders {
+		if o.Symbol == symbol && o.AlgoStatus == string(AlgoOrderStatusNew) {
+			o.AlgoStatus = string(AlgoOrderStatusCancelled)
+			o.UpdateTime = time.Now().UnixMilli()
+		}
+	}
+	return nil
+}
+
+// algoOrderCrossed reports whether price has reached a conditional order's trigger
+func algoOrderCrossed(o *AlgoOrder, price float64) bool {
+	switch FuturesOrderType(o.OrderType) {
+	case FuturesOrderTypeStopMarket, FuturesOrderTypeStop:
+		if o.Side == "SELL" {
+			return price <= o.TriggerPrice
+		}
+		return price >= o.TriggerPrice
+	case FuturesOrderTypeTakeProfitMarket, FuturesOrderTypeTakeProfit:
+		if o.Side == "SELL" {
+			return price >= o.TriggerPrice
+		}
+		return price <= o.TriggerPrice
+	}
+	return false
+}
+
+// triggerAlgoOrders fills every open conditional order on symbol that price
+// crossed, as a market order against the position. One with nothing left to
+// close (the position already went) expires instead.
+func (s *ScriptedFuturesClient) triggerAlgoOrders(symbol string, price float64) {
+	s.mu.Lock()
+	var triggered []*AlgoOrder
+	for _, o := range s.algoOrders {
+		if o.Symbol == symbol && o.AlgoStatus == string(AlgoOrderStatusNew) && algoOrderCrossed(o, price) {
+			triggered = append(triggered, o)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, o := range triggered {
+		amt := s.PositionAmt(symbol)
+		qty := o.Quantity
+		if o.ClosePosition || qty > abs(amt) {
+			qty = abs(amt)
+		}
+		closes := (o.Side == "SELL" && amt > 0) || (o.Side == "BUY" && amt < 0)
+
+		status := AlgoOrderStatusExpired
+		if qty > 0 && closes {
+			_, err := s.fillOrder(FuturesOrderParams{
+				Symbol:       symbol,
+				Side:         o.Side,
+				PositionSide: PositionSide(o.PositionSide),
+				Type:         FuturesOrderTypeMarket,
+				Quantity:     qty,
+				ReduceOnly:   true,
+			}, 1, true)
+			if err == nil {
+				status = AlgoOrderStatusTriggered
+			}
+		}
+
+		s.mu.Lock()
+		o.AlgoStatus = string(status)
+		if status == AlgoOrderStatusTriggered {
+			o.ExecutedQty = qty
+			o.TriggerTime = time.Now().UnixMilli()
+		}
+		o.UpdateTime = time.Now().UnixMilli()
+		s.mu.Unlock()
+	}
+}
+
+var _ FuturesClient = (*ScriptedFuturesClient)(nil)
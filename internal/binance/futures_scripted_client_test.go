@@ -0,0 +1,177 @@
+package binance
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// placeStop places a closing STOP_MARKET for a long and returns its algo ID
+func placeStop(t *testing.T, client *ScriptedFuturesClient, trigger float64) int64 {
+	t.Helper()
+	resp, err := client.PlaceAlgoOrder(AlgoOrderParams{
+		Symbol:        "BTCUSDT",
+		Side:          "SELL",
+		Type:          FuturesOrderTypeStopMarket,
+		TriggerPrice:  trigger,
+		ClosePosition: true,
+	})
+	if err != nil {
+		t.Fatalf("place stop at %.0f: %v", trigger, err)
+	}
+	return resp.AlgoId
+}
+
+// TestScriptedClientTP1BreakevenTrailingClose walks a long through TP1, a
+// breakeven stop, a trailed stop and the final stop-out
+func TestScriptedClientTP1BreakevenTrailingClose(t *testing.T) {
+	client := NewScriptedFuturesClient(10000)
+	client.SetPrice("BTCUSDT", 100)
+
+	entry, err := client.PlaceFuturesOrder(FuturesOrderParams{
+		Symbol:   "BTCUSDT",
+		Side:     "BUY",
+		Type:     FuturesOrderTypeMarket,
+		Quantity: 1,
+	})
+	if err != nil || entry.Status != string(FuturesOrderStatusFilled) || entry.AvgPrice != 100 {
+		t.Fatalf("entry = %+v, %v; want FILLED @ 100", entry, err)
+	}
+
+	stopID := placeStop(t, client, 95)
+	if _, err := client.PlaceAlgoOrder(AlgoOrderParams{
+		Symbol:       "BTCUSDT",
+		Side:         "SELL",
+		Type:         FuturesOrderTypeTakeProfitMarket,
+		TriggerPrice: 105,
+		Quantity:     0.5,
+		ReduceOnly:   true,
+	}); err != nil {
+		t.Fatalf("place TP1: %v", err)
+	}
+
+	// Price runs through TP1: half the position closes, the stop is untouched
+	client.ScriptPrices("BTCUSDT", 102, 106)
+	for client.Step() {
+	}
+	if amt := client.PositionAmt("BTCUSDT"); amt != 0.5 {
+		t.Fatalf("position after TP1 = %v, want 0.5", amt)
+	}
+	if n, _ := client.GetAlgoOrderCount("BTCUSDT"); n != 1 {
+		t.Fatalf("open algo orders after TP1 = %d, want 1 (the stop)", n)
+	}
+
+	// Move the stop to breakeven, then trail it up as price rises
+	if err := client.CancelAlgoOrder("BTCUSDT", stopID); err != nil {
+		t.Fatalf("cancel initial stop: %v", err)
+	}
+	stopID = placeStop(t, client, entry.AvgPrice)
+
+	client.ScriptPrices("BTCUSDT", 110, 115)
+	for client.Step() {
+		price, _ := client.GetFuturesCurrentPrice("BTCUSDT")
+		if err := client.CancelAlgoOrder("BTCUSDT", stopID); err != nil {
+			t.Fatalf("cancel stop at %.0f: %v", price, err)
+		}
+		stopID = placeStop(t, client, price*0.97)
+	}
+	if amt := client.PositionAmt("BTCUSDT"); amt != 0.5 {
+		t.Fatalf("position while trailing = %v, want 0.5", amt)
+	}
+
+	// Pullback hits the trailed stop (111.55) and closes the rest
+	client.SetPrice("BTCUSDT", 111)
+	if amt := client.PositionAmt("BTCUSDT"); amt != 0 {
+		t.Fatalf("position after stop-out = %v, want flat", amt)
+	}
+	if n, _ := client.GetAlgoOrderCount("BTCUSDT"); n != 0 {
+		t.Errorf("open algo orders after stop-out = %d, want 0", n)
+	}
+
+	var fills []ScriptedOrder
+	for _, o := range client.Orders() {
+		if o.Triggered {
+			fills = append(fills, o)
+		}
+	}
+	if len(fills) != 2 {
+		t.Fatalf("triggered fills = %d, want 2 (TP1, stop)", len(fills))
+	}
+	if fills[0].Order.AvgPrice != 106 || fills[0].Order.ExecutedQty != 0.5 {
+		t.Errorf("TP1 fill = %.2f x %.2f, want 106 x 0.5", fills[0].Order.AvgPrice, fills[0].Order.ExecutedQty)
+	}
+	if fills[1].Order.AvgPrice != 111 || fills[1].Order.ExecutedQty != 0.5 || !fills[1].Params.ReduceOnly {
+		t.Errorf("stop fill = %+v, want reduce-only 111 x 0.5", fills[1].Order)
+	}
+
+	statuses := make(map[string]int)
+	for _, o := range client.AlgoOrders() {
+		statuses[o.AlgoStatus]++
+	}
+	if statuses[string(AlgoOrderStatusTriggered)] != 2 || statuses[string(AlgoOrderStatusCancelled)] != 3 {
+		t.Errorf("algo statuses = %v, want 2 triggered and 3 cancelled", statuses)
+	}
+}
+
+func TestScriptedClientFillScripts(t *testing.T) {
+	client := NewScriptedFuturesClient(1000)
+	client.SetPrice("ETHUSDT", 2000)
+	rejected := errors.New("Margin is insufficient")
+	client.ScriptFills("ETHUSDT", FillReject(rejected), FillPartial(0.5), FillPartial(0.25))
+
+	if _, err := client.PlaceFuturesOrder(FuturesOrderParams{
+		Symbol: "ETHUSDT", Side: "BUY", Type: FuturesOrderTypeMarket, Quantity: 1,
+	}); !errors.Is(err, rejected) {
+		t.Fatalf("first order error = %v, want scripted rejection", err)
+	}
+
+	market, err := client.PlaceFuturesOrder(FuturesOrderParams{
+		Symbol: "ETHUSDT", Side: "BUY", Type: FuturesOrderTypeMarket, Quantity: 1,
+	})
+	if err != nil {
+		t.Fatalf("partial market: %v", err)
+	}
+	if market.Status != string(FuturesOrderStatusExpired) || market.ExecutedQty != 0.5 {
+		t.Errorf("partial market = %s x %v, want EXPIRED x 0.5", market.Status, market.ExecutedQty)
+	}
+
+	limit, err := client.PlaceFuturesOrder(FuturesOrderParams{
+		Symbol: "ETHUSDT", Side: "BUY", Type: FuturesOrderTypeLimit, Quantity: 1, Price: 1990,
+	})
+	if err != nil {
+		t.Fatalf("partial limit: %v", err)
+	}
+	open, _ := client.GetOpenOrders("ETHUSDT")
+	if len(open) != 1 || open[0].OrderId != limit.OrderId || open[0].Status != string(FuturesOrderStatusPartiallyFilled) {
+		t.Errorf("open orders = %+v, want the partially filled limit", open)
+	}
+	if err := client.CancelFuturesOrder("ETHUSDT", limit.OrderId); err != nil {
+		t.Fatalf("cancel limit: %v", err)
+	}
+	if o, _ := client.GetOrder("ETHUSDT", limit.OrderId); o == nil || o.Status != string(FuturesOrderStatusCanceled) {
+		t.Errorf("limit after cancel = %+v, want CANCELED", o)
+	}
+
+	if amt := client.PositionAmt("ETHUSDT"); amt != 0.75 {
+		t.Errorf("position = %v, want 0.75 from the two partial fills", amt)
+	}
+	if got := len(client.Orders()); got != 3 {
+		t.Errorf("recorded orders = %d, want 3", got)
+	}
+}
+
+func TestScriptedClientFundingAndBalance(t *testing.T) {
+	client := NewScriptedFuturesClient(1000)
+	client.SetPrice("BTCUSDT", 50000)
+	client.SetFundingRate("BTCUSDT", -0.0025, time.Now().Add(time.Hour))
+	client.SetBalance(250)
+
+	rate, err := client.GetFundingRate("BTCUSDT")
+	if err != nil || rate.FundingRate != -0.0025 || rate.MarkPrice != 50000 {
+		t.Errorf("funding = %+v, %v; want -0.0025 at mark 50000", rate, err)
+	}
+	account, _ := client.GetFuturesAccountInfo()
+	if account.AvailableBalance != 250 {
+		t.Errorf("available balance = %v, want 250", account.AvailableBalance)
+	}
+}
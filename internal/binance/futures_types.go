@@ -1,6 +1,9 @@
 package binance
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 // ==================== ENUMS ====================
 
@@ -87,6 +90,16 @@ type FuturesAccountInfo struct {
 	Positions                   []FuturesAccountPosition `json:"positions"`
 }
 
+// MarginRatio returns the account margin ratio as Binance shows it: maintenance
+// margin over margin balance, in percent. The account is liquidated at 100%.
+// Returns 0 when there is no margin balance.
+func (a *FuturesAccountInfo) MarginRatio() float64 {
+	if a == nil || a.TotalMarginBalance <= 0 {
+		return 0
+	}
+	return a.TotalMaintMargin / a.TotalMarginBalance * 100
+}
+
 // FuturesAsset represents an asset in futures account
 type FuturesAsset struct {
 	Asset                  string  `json:"asset"`
@@ -456,6 +469,32 @@ type AlgoOrderParams struct {
 	CallbackRate  float64 `json:"callbackRate,omitempty"`  // 0.1-10% for trailing stop
 }
 
+// Binance bounds for TRAILING_STOP_MARKET callbackRate (percent)
+const (
+	MinTrailingCallbackRate = 0.1
+	MaxTrailingCallbackRate = 10.0
+)
+
+// Validate checks order-type specific constraints before the request is sent.
+// TRAILING_STOP_MARKET needs a callbackRate within Binance's bounds and a
+// quantity (closePosition is not supported for trailing stops).
+func (p AlgoOrderParams) Validate() error {
+	if p.Type != FuturesOrderTypeTrailingStop {
+		return nil
+	}
+	if p.CallbackRate < MinTrailingCallbackRate || p.CallbackRate > MaxTrailingCallbackRate {
+		return fmt.Errorf("trailing stop callbackRate %.2f outside %.1f-%.0f%%",
+			p.CallbackRate, MinTrailingCallbackRate, MaxTrailingCallbackRate)
+	}
+	if p.ClosePosition {
+		return fmt.Errorf("trailing stop does not support closePosition, set a quantity")
+	}
+	if p.Quantity <= 0 {
+		return fmt.Errorf("trailing stop requires a quantity")
+	}
+	return nil
+}
+
 // AlgoOrderResponse represents response from placing an algo order
 type AlgoOrderResponse struct {
 	AlgoId        int64   `json:"algoId"`
@@ -0,0 +1,35 @@
+package binance
+
+import "testing"
+
+func TestAlgoOrderParamsValidateTrailingStop(t *testing.T) {
+	valid := AlgoOrderParams{
+		Symbol:       "BTCUSDT",
+		Side:         "SELL",
+		Type:         FuturesOrderTypeTrailingStop,
+		Quantity:     0.01,
+		CallbackRate: 1.5,
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("expected valid trailing stop, got %v", err)
+	}
+
+	cases := map[string]func(p *AlgoOrderParams){
+		"callback too small": func(p *AlgoOrderParams) { p.CallbackRate = 0.05 },
+		"callback too large": func(p *AlgoOrderParams) { p.CallbackRate = 12 },
+		"close position":     func(p *AlgoOrderParams) { p.ClosePosition = true },
+		"missing quantity":   func(p *AlgoOrderParams) { p.Quantity = 0 },
+	}
+	for name, mutate := range cases {
+		p := valid
+		mutate(&p)
+		if err := p.Validate(); err == nil {
+			t.Errorf("%s: expected validation error", name)
+		}
+	}
+
+	stop := AlgoOrderParams{Type: FuturesOrderTypeStopMarket, ClosePosition: true, TriggerPrice: 100}
+	if err := stop.Validate(); err != nil {
+		t.Errorf("non-trailing orders should not be constrained, got %v", err)
+	}
+}
@@ -0,0 +1,88 @@
+package binance
+
+import "sort"
+
+// Income types from /fapi/v1/income used for PnL reconciliation
+const (
+	IncomeTypeRealizedPnL = "REALIZED_PNL"
+	IncomeTypeFundingFee  = "FUNDING_FEE"
+	IncomeTypeCommission  = "COMMISSION"
+)
+
+// IncomeSummary totals income records the way Binance's own statements do.
+// Commission is negative; funding is negative when paid and positive when received.
+type IncomeSummary struct {
+	RealizedPnL     float64            `json:"realized_pnl"`
+	FundingPaid     float64            `json:"funding_paid"` // Negative
+	FundingReceived float64            `json:"funding_received"`
+	FundingNet      float64            `json:"funding_net"`
+	Commission      float64            `json:"commission"` // Negative
+	NetPnL          float64            `json:"net_pnl"`    // Realized + funding + commission
+	Other           map[string]float64 `json:"other,omitempty"`
+	RecordCount     int                `json:"record_count"`
+}
+
+// SymbolIncomeSummary is an IncomeSummary for one symbol
+type SymbolIncomeSummary struct {
+	Symbol string `json:"symbol"`
+	IncomeSummary
+}
+
+// add folds one record into the summary
+func (s *IncomeSummary) add(r IncomeRecord) {
+	s.RecordCount++
+	switch r.IncomeType {
+	case IncomeTypeRealizedPnL:
+		s.RealizedPnL += r.Income
+	case IncomeTypeFundingFee:
+		if r.Income < 0 {
+			s.FundingPaid += r.Income
+		} else {
+			s.FundingReceived += r.Income
+		}
+		s.FundingNet += r.Income
+	case IncomeTypeCommission:
+		s.Commission += r.Income
+	default:
+		// Transfers, rebates, insurance clear etc. are not trading PnL
+		if s.Other == nil {
+			s.Other = make(map[string]float64)
+		}
+		s.Other[r.IncomeType] += r.Income
+		return
+	}
+	s.NetPnL += r.Income
+}
+
+// SummarizeIncome totals income records overall and per symbol. Symbols are
+// sorted by net PnL ascending, so the biggest losers come first.
+func SummarizeIncome(records []IncomeRecord) (IncomeSummary, []SymbolIncomeSummary) {
+	var total IncomeSummary
+	bySymbol := make(map[string]*SymbolIncomeSummary)
+
+	for _, r := range records {
+		total.add(r)
+		if r.Symbol == "" {
+			continue // Account-level entries such as transfers
+		}
+		sym, ok := bySymbol[r.Symbol]
+		if !ok {
+			sym = &SymbolIncomeSummary{Symbol: r.Symbol}
+			bySymbol[r.Symbol] = sym
+		}
+		sym.add(r)
+	}
+
+	symbols := make([]SymbolIncomeSummary, 0, len(bySymbol))
+	for _, sym := range bySymbol {
+		symbols = append(symbols, *sym)
+	}
+	sort.Slice(symbols, func(i, j int) bool {
+		if symbols[i].NetPnL != symbols[j].NetPnL {
+			return symbols[i].NetPnL < symbols[j].NetPnL
+		}
+		return symbols[i].Symbol < symbols[j].Symbol
+	})
+
+	return total, symbols
+}
@@ -0,0 +1,42 @@
+package binance
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSummarizeIncome(t *testing.T) {
+	records := []IncomeRecord{
+		{Symbol: "BTCUSDT", IncomeType: IncomeTypeRealizedPnL, Income: 50},
+		{Symbol: "BTCUSDT", IncomeType: IncomeTypeCommission, Income: -2},
+		{Symbol: "BTCUSDT", IncomeType: IncomeTypeFundingFee, Income: -1.5},
+		{Symbol: "ETHUSDT", IncomeType: IncomeTypeRealizedPnL, Income: -30},
+		{Symbol: "ETHUSDT", IncomeType: IncomeTypeCommission, Income: -1},
+		{Symbol: "ETHUSDT", IncomeType: IncomeTypeFundingFee, Income: 0.5},
+		{IncomeType: "TRANSFER", Income: 1000},
+	}
+
+	total, symbols := SummarizeIncome(records)
+
+	approx := func(got, want float64) bool { return math.Abs(got-want) < 1e-9 }
+	if !approx(total.RealizedPnL, 20) || !approx(total.Commission, -3) ||
+		!approx(total.FundingPaid, -1.5) || !approx(total.FundingReceived, 0.5) || !approx(total.FundingNet, -1) {
+		t.Errorf("total = %+v", total)
+	}
+	if !approx(total.NetPnL, 16) {
+		t.Errorf("net PnL = %.2f, want 16 (transfers excluded)", total.NetPnL)
+	}
+	if total.Other["TRANSFER"] != 1000 || total.RecordCount != 7 {
+		t.Errorf("other = %v, records = %d", total.Other, total.RecordCount)
+	}
+
+	if len(symbols) != 2 {
+		t.Fatalf("got %d symbols, want 2", len(symbols))
+	}
+	if symbols[0].Symbol != "ETHUSDT" || !approx(symbols[0].NetPnL, -30.5) {
+		t.Errorf("first symbol = %+v, want ETHUSDT at -30.5", symbols[0])
+	}
+	if symbols[1].Symbol != "BTCUSDT" || !approx(symbols[1].NetPnL, 46.5) {
+		t.Errorf("second symbol = %+v, want BTCUSDT at 46.5", symbols[1])
+	}
+}
@@ -11,6 +11,12 @@ type BinanceClient interface {
 	CancelOrder(symbol string, orderId int64) error
 	GetAccountInfo() (*AccountInfo, error)
 	GetUSDTBalance() (float64, error)
+
+	// Spot exit brackets
+	PlaceOCOOrder(params OCOOrderParams) (*OCOOrderList, error)
+	CancelOCOOrder(symbol string, orderListID int64) error
+	GetOCOOrder(orderListID int64) (*OCOOrderList, error)
+	GetOrder(symbol string, orderId int64) (*OrderResponse, error)
 }
 
 // AccountInfo represents spot account information
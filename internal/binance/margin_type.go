@@ -0,0 +1,37 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Binance error codes returned by POST /fapi/v1/marginType
+const (
+	errCodeMarginTypeUnchanged    = -4046 // No need to change margin type
+	errCodeMarginTypeOpenOrders   = -4047 // Margin type cannot be changed if there exists open orders
+	errCodeMarginTypeOpenPosition = -4048 // Margin type cannot be changed if there exists position
+)
+
+// ErrMarginTypeLocked is returned by SetMarginType when Binance refuses the
+// change because the symbol has an open position or open orders
+var ErrMarginTypeLocked = errors.New("margin type cannot be changed while the symbol has an open position or open orders")
+
+// classifyMarginTypeError maps a SetMarginType failure: "already set" is
+// success, a change blocked by a position or orders is ErrMarginTypeLocked,
+// anything else is returned as is
+func classifyMarginTypeError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+	switch apiErr.Code {
+	case errCodeMarginTypeUnchanged:
+		return nil
+	case errCodeMarginTypeOpenOrders, errCodeMarginTypeOpenPosition:
+		return fmt.Errorf("%w: %s", ErrMarginTypeLocked, apiErr.Message)
+	}
+	return err
+}
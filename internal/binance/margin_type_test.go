@@ -0,0 +1,26 @@
+package binance
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestClassifyMarginTypeError(t *testing.T) {
+	if err := classifyMarginTypeError(newAPIError(400, []byte(`{"code":-4046,"msg":"No need to change margin type."}`))); err != nil {
+		t.Errorf("Expected unchanged margin type to be ignored, got %v", err)
+	}
+
+	for _, body := range []string{
+		`{"code":-4047,"msg":"Margin type cannot be changed if there exists open orders."}`,
+		`{"code":-4048,"msg":"Margin type cannot be changed if there exists position."}`,
+	} {
+		if err := classifyMarginTypeError(newAPIError(400, []byte(body))); !errors.Is(err, ErrMarginTypeLocked) {
+			t.Errorf("Expected ErrMarginTypeLocked for %s, got %v", body, err)
+		}
+	}
+
+	other := newAPIError(400, []byte(`{"code":-1121,"msg":"Invalid symbol."}`))
+	if err := classifyMarginTypeError(other); err != other {
+		t.Errorf("Expected other errors to pass through, got %v", err)
+	}
+}
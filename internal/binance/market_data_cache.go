@@ -3,9 +3,14 @@ package binance
 import (
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
+// DefaultMaxPriceAge is how old a cached mark price may be before it is treated
+// as stale and callers fall back to REST
+const DefaultMaxPriceAge = 30 * time.Second
+
 // CachedMarkPrice holds mark price data with timestamp
 type CachedMarkPrice struct {
 	Data      *MarkPrice
@@ -31,6 +36,9 @@ type MarketDataCache struct {
 	klines     sync.Map // "symbol:interval" -> *CachedKlines
 	orderBooks sync.Map // symbol -> *CachedOrderBook
 
+	// Max age of a cached mark price before it counts as stale (nanoseconds)
+	maxPriceAge atomic.Int64
+
 	// Statistics
 	hitCount  int64
 	missCount int64
@@ -39,7 +47,23 @@ type MarketDataCache struct {
 
 // NewMarketDataCache creates a new market data cache
 func NewMarketDataCache() *MarketDataCache {
-	return &MarketDataCache{}
+	c := &MarketDataCache{}
+	c.maxPriceAge.Store(int64(DefaultMaxPriceAge))
+	return c
+}
+
+// SetMaxPriceAge sets how old a cached price may be before it is considered
+// stale. Non-positive values restore DefaultMaxPriceAge.
+func (c *MarketDataCache) SetMaxPriceAge(maxAge time.Duration) {
+	if maxAge <= 0 {
+		maxAge = DefaultMaxPriceAge
+	}
+	c.maxPriceAge.Store(int64(maxAge))
+}
+
+// MaxPriceAge returns the configured stale-price threshold
+func (c *MarketDataCache) MaxPriceAge() time.Duration {
+	return time.Duration(c.maxPriceAge.Load())
 }
 
 // ==================== MARK PRICE ====================
@@ -48,8 +72,8 @@ func NewMarketDataCache() *MarketDataCache {
 func (c *MarketDataCache) GetMarkPrice(symbol string) *MarkPrice {
 	if val, ok := c.markPrices.Load(symbol); ok {
 		cached := val.(*CachedMarkPrice)
-		// Check if data is stale (older than 30 seconds for REST, WebSocket updates more frequently)
-		if time.Since(cached.UpdatedAt) < 30*time.Second {
+		// Check if data is stale (WebSocket updates every few seconds when healthy)
+		if time.Since(cached.UpdatedAt) < c.MaxPriceAge() {
 			c.recordHit()
 			return cached.Data
 		}
@@ -91,6 +115,17 @@ func (c *MarketDataCache) GetCurrentPrice(symbol string) (float64, bool) {
 	return 0, false
 }
 
+// GetPriceWithAge returns the cached mark price for a symbol and how long ago it
+// was updated, regardless of staleness. ok is false when nothing is cached.
+func (c *MarketDataCache) GetPriceWithAge(symbol string) (price float64, age time.Duration, ok bool) {
+	val, found := c.markPrices.Load(symbol)
+	if !found {
+		return 0, 0, false
+	}
+	cached := val.(*CachedMarkPrice)
+	return cached.Data.MarkPrice, time.Since(cached.UpdatedAt), true
+}
+
 // ==================== KLINES ====================
 
 // GetKlines returns cached klines for a symbol and interval
@@ -0,0 +1,31 @@
+package binance
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMarketDataCacheStalePrice(t *testing.T) {
+	cache := NewMarketDataCache()
+	cache.SetMaxPriceAge(time.Minute)
+	cache.UpdateMarkPrice("BTCUSDT", 50000, 50001, 0.0001, 0)
+
+	if price, ok := cache.GetCurrentPrice("BTCUSDT"); !ok || price != 50000 {
+		t.Fatalf("expected fresh price 50000, got %v (ok=%v)", price, ok)
+	}
+
+	// Age the entry past the threshold as a stalled WebSocket would
+	val, _ := cache.markPrices.Load("BTCUSDT")
+	val.(*CachedMarkPrice).UpdatedAt = time.Now().Add(-2 * time.Minute)
+
+	if _, ok := cache.GetCurrentPrice("BTCUSDT"); ok {
+		t.Error("expected stale price to be treated as a miss")
+	}
+	price, age, ok := cache.GetPriceWithAge("BTCUSDT")
+	if !ok || price != 50000 || age < 2*time.Minute {
+		t.Errorf("GetPriceWithAge = %v, %v, %v; want 50000, >=2m, true", price, age, ok)
+	}
+	if _, _, ok := cache.GetPriceWithAge("ETHUSDT"); ok {
+		t.Error("expected no cached price for ETHUSDT")
+	}
+}
@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"fmt"
 	"math"
 	"math/rand"
 	"sync"
@@ -13,6 +14,11 @@ type MockClient struct {
 	prices     map[string]float64
 	lastUpdate time.Time
 	mu         sync.RWMutex // Protects prices map and lastUpdate
+
+	// Simulated OCO brackets
+	ocoMu     sync.Mutex
+	ocoLists  map[int64]*mockOCO
+	ocoOrders map[int64]*OrderResponse
 }
 
 // NewMockClient creates a new mock client
@@ -274,3 +280,117 @@ func (mc *MockClient) GetAccountInfo() (*AccountInfo, error) {
 func (mc *MockClient) GetUSDTBalance() (float64, error) {
 	return 10500.0, nil // 10000 free + 500 locked
 }
+
+// mockOCO is a simulated OCO bracket, resolved against the mock price on query
+type mockOCO struct {
+	list   OCOOrderList
+	params OCOOrderParams
+}
+
+// PlaceOCOOrder simulates placing an OCO exit bracket
+func (mc *MockClient) PlaceOCOOrder(p OCOOrderParams) (*OCOOrderList, error) {
+	mc.ocoMu.Lock()
+	defer mc.ocoMu.Unlock()
+	if mc.ocoLists == nil {
+		mc.ocoLists = make(map[int64]*mockOCO)
+		mc.ocoOrders = make(map[int64]*OrderResponse)
+	}
+
+	listID := rand.Int63n(1000000)
+	list := OCOOrderList{
+		OrderListId:     listID,
+		ContingencyType: "OCO",
+		ListStatusType:  "EXEC_STARTED",
+		ListOrderStatus: OCOListStatusExecuting,
+		TransactionTime: time.Now().UnixMilli(),
+		Symbol:          p.Symbol,
+	}
+	for _, orderType := range []string{"LIMIT_MAKER", "STOP_LOSS_LIMIT"} {
+		order := &OrderResponse{
+			Symbol:  p.Symbol,
+			OrderId: rand.Int63n(1000000),
+			OrigQty: p.Quantity,
+			Status:  "NEW",
+			Type:    orderType,
+			Side:    p.Side,
+		}
+		mc.ocoOrders[order.OrderId] = order
+		list.Orders = append(list.Orders, OCOOrderRef{Symbol: p.Symbol, OrderId: order.OrderId})
+	}
+	mc.ocoLists[listID] = &mockOCO{list: list, params: p}
+
+	result := list
+	return &result, nil
+}
+
+// CancelOCOOrder simulates cancelling an OCO bracket
+func (mc *MockClient) CancelOCOOrder(symbol string, orderListID int64) error {
+	mc.ocoMu.Lock()
+	defer mc.ocoMu.Unlock()
+	oco, ok := mc.ocoLists[orderListID]
+	if !ok || oco.list.ListOrderStatus == OCOListStatusAllDone {
+		return fmt.Errorf("API error: unknown or finished order list %d", orderListID)
+	}
+	oco.list.ListStatusType = OCOListStatusAllDone
+	oco.list.ListOrderStatus = OCOListStatusAllDone
+	for _, ref := range oco.list.Orders {
+		mc.ocoOrders[ref.OrderId].Status = "CANCELED"
+	}
+	return nil
+}
+
+// GetOCOOrder returns a simulated OCO bracket, filling the take profit or stop
+// leg if the mock price has crossed it
+func (mc *MockClient) GetOCOOrder(orderListID int64) (*OCOOrderList, error) {
+	mc.ocoMu.Lock()
+	oco, ok := mc.ocoLists[orderListID]
+	if !ok {
+		mc.ocoMu.Unlock()
+		return nil, fmt.Errorf("API error: unknown order list %d", orderListID)
+	}
+	symbol := oco.params.Symbol
+	mc.ocoMu.Unlock()
+
+	price, _ := mc.GetCurrentPrice(symbol)
+
+	mc.ocoMu.Lock()
+	defer mc.ocoMu.Unlock()
+	if oco.list.ListOrderStatus == OCOListStatusExecuting {
+		p := oco.params
+		tpHit := (p.Side == "SELL" && price >= p.TakeProfitPrice) || (p.Side == "BUY" && price <= p.TakeProfitPrice)
+		slHit := (p.Side == "SELL" && price <= p.StopPrice) || (p.Side == "BUY" && price >= p.StopPrice)
+		if tpHit || slHit {
+			filled, fillPrice := 1, p.StopPrice
+			if tpHit {
+				filled, fillPrice = 0, p.TakeProfitPrice
+			}
+			for i, ref := range oco.list.Orders {
+				order := mc.ocoOrders[ref.OrderId]
+				if i == filled {
+					order.Status = "FILLED"
+					order.Price = fillPrice
+					order.ExecutedQty = p.Quantity
+					order.CummulativeQuoteQty = fillPrice * p.Quantity
+				} else {
+					order.Status = "EXPIRED"
+				}
+			}
+			oco.list.ListStatusType = OCOListStatusAllDone
+			oco.list.ListOrderStatus = OCOListStatusAllDone
+		}
+	}
+
+	result := oco.list
+	return &result, nil
+}
+
+// GetOrder returns a simulated order (OCO legs only)
+func (mc *MockClient) GetOrder(symbol string, orderId int64) (*OrderResponse, error) {
+	mc.ocoMu.Lock()
+	defer mc.ocoMu.Unlock()
+	if order, ok := mc.ocoOrders[orderId]; ok {
+		result := *order
+		return &result, nil
+	}
+	return nil, fmt.Errorf("API error: unknown order %d", orderId)
+}
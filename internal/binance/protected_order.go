@@ -0,0 +1,312 @@
+package binance
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Protected order placement methods (ProtectedOrderResult.Method)
+const (
+	// ProtectedOrderMethodBatch sends the entry and its SL/TP legs in one
+	// /fapi/v1/batchOrders request, so they reach the matching engine together
+	ProtectedOrderMethodBatch = "batch"
+	// ProtectedOrderMethodSequential places the entry, then the SL, then each TP
+	// as separate requests
+	ProtectedOrderMethodSequential = "sequential"
+)
+
+// maxBatchOrders is Binance's limit on orders per /fapi/v1/batchOrders request
+const maxBatchOrders = 5
+
+// errBatchRejected marks a batch request the exchange refused as a whole, so
+// none of its orders were placed
+var errBatchRejected = errors.New("batch orders request rejected")
+
+// ProtectiveLeg is one SL or TP order attached to a protected entry. A leg
+// placed in the batch has an OrderId; one placed through the algo order API
+// has an AlgoId. Error is set when neither path could place it.
+type ProtectiveLeg struct {
+	Type         FuturesOrderType `json:"type"`
+	TriggerPrice float64          `json:"trigger_price"`
+	Quantity     float64          `json:"quantity,omitempty"` // 0 = closePosition
+	OrderId      int64            `json:"order_id,omitempty"`
+	AlgoId       int64            `json:"algo_id,omitempty"`
+	Error        string           `json:"error,omitempty"`
+}
+
+// Placed reports whether the leg is live on the exchange
+func (l *ProtectiveLeg) Placed() bool {
+	return l.OrderId > 0 || l.AlgoId > 0
+}
+
+// ProtectedOrderResult is the outcome of PlaceProtectedMarketOrder: the filled
+// entry plus the state of each protective leg
+type ProtectedOrderResult struct {
+	Entry       *FuturesOrderResponse `json:"entry"`
+	StopLoss    ProtectiveLeg         `json:"stop_loss"`
+	TakeProfits []ProtectiveLeg       `json:"take_profits"`
+	Method      string                `json:"method"`
+}
+
+// Protected reports whether the stop loss is live; callers must handle an
+// unprotected entry (retry the SL or flatten) themselves
+func (r *ProtectedOrderResult) Protected() bool {
+	return r.StopLoss.Placed()
+}
+
+// protectiveLegs builds the SL (closePosition, so it covers whatever quantity
+// remains) and the TPs (quantity split evenly, remainder on the last TP) that
+// close the position opened by entry
+func protectiveLegs(entry FuturesOrderParams, slPrice float64, tpPrices []float64) ([]AlgoOrderParams, error) {
+	if entry.Type != FuturesOrderTypeMarket {
+		return nil, fmt.Errorf("protected entry must be a MARKET order, got %s", entry.Type)
+	}
+	if entry.Quantity <= 0 {
+		return nil, fmt.Errorf("protected entry needs a quantity")
+	}
+	if slPrice <= 0 {
+		return nil, fmt.Errorf("protected entry needs a stop loss price")
+	}
+
+	closeSide := "SELL"
+	if entry.Side == "SELL" {
+		closeSide = "BUY"
+	}
+	// Hedge mode rejects reduceOnly; the position side already limits the order
+	reduceOnly := entry.PositionSide == "" || entry.PositionSide == PositionSideBoth
+
+	legs := []AlgoOrderParams{{
+		Symbol:        entry.Symbol,
+		Side:          closeSide,
+		PositionSide:  entry.PositionSide,
+		Type:          FuturesOrderTypeStopMarket,
+		ClosePosition: true,
+		TriggerPrice:  slPrice,
+		WorkingType:   WorkingTypeMarkPrice,
+	}}
+
+	quantities := splitQuantity(entry.Quantity, len(tpPrices))
+	for i, tp := range tpPrices {
+		if tp <= 0 {
+			return nil, fmt.Errorf("take profit %d has no price", i+1)
+		}
+		if quantities[i] <= 0 {
+			return nil, fmt.Errorf("quantity %v is too small to split across %d take profits", entry.Quantity, len(tpPrices))
+		}
+		legs = append(legs, AlgoOrderParams{
+			Symbol:       entry.Symbol,
+			Side:         closeSide,
+			PositionSide: entry.PositionSide,
+			Type:         FuturesOrderTypeTakeProfitMarket,
+			Quantity:     quantities[i],
+			TriggerPrice: tp,
+			WorkingType:  WorkingTypeMarkPrice,
+			ReduceOnly:   reduceOnly,
+		})
+	}
+	return legs, nil
+}
+
+// splitQuantity splits qty into n parts at qty's own decimal precision, which
+// already satisfies the symbol's step size; the last part takes the remainder
+func splitQuantity(qty float64, n int) []float64 {
+	if n <= 0 {
+		return nil
+	}
+	decimals := 0
+	if s := strconv.FormatFloat(qty, 'f', -1, 64); strings.Contains(s, ".") {
+		decimals = len(s) - strings.Index(s, ".") - 1
+	}
+	scale := math.Pow(10, float64(decimals))
+	part := math.Floor(qty/float64(n)*scale) / scale
+
+	parts := make([]float64, n)
+	for i := 0; i < n-1; i++ {
+		parts[i] = part
+	}
+	parts[n-1] = math.Round((qty-part*float64(n-1))*scale) / scale
+	return parts
+}
+
+// PlaceProtectedSequentially places the entry, then the SL, then each TP
+// through client's PlaceFuturesOrder and PlaceAlgoOrder. The error is only set
+// when the entry itself was not placed; leg failures are reported in the result.
+func PlaceProtectedSequentially(client FuturesClient, params FuturesOrderParams, slPrice float64, tpPrices []float64) (*ProtectedOrderResult, error) {
+	legs, err := protectiveLegs(params, slPrice, tpPrices)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := client.PlaceFuturesOrder(params)
+	if err != nil {
+		return nil, fmt.Errorf("error placing entry order: %w", err)
+	}
+
+	result := newProtectedOrderResult(entry, legs, ProtectedOrderMethodSequential)
+	for i := range legs {
+		placeLegViaAlgo(client, legs[i], result.leg(i))
+	}
+	return result, nil
+}
+
+func newProtectedOrderResult(entry *FuturesOrderResponse, legs []AlgoOrderParams, method string) *ProtectedOrderResult {
+	result := &ProtectedOrderResult{
+		Entry:       entry,
+		TakeProfits: make([]ProtectiveLeg, len(legs)-1),
+		Method:      method,
+	}
+	for i, leg := range legs {
+		*result.leg(i) = ProtectiveLeg{Type: leg.Type, TriggerPrice: leg.TriggerPrice, Quantity: leg.Quantity}
+	}
+	return result
+}
+
+// leg returns the result slot for legs[i]: 0 is the SL, then the TPs in order
+func (r *ProtectedOrderResult) leg(i int) *ProtectiveLeg {
+	if i == 0 {
+		return &r.StopLoss
+	}
+	return &r.TakeProfits[i-1]
+}
+
+func placeLegViaAlgo(client FuturesClient, params AlgoOrderParams, leg *ProtectiveLeg) {
+	resp, err := client.PlaceAlgoOrder(params)
+	if err == nil && (resp == nil || resp.AlgoId == 0) {
+		err = fmt.Errorf("exchange returned no algo order ID")
+	}
+	if err != nil {
+		leg.Error = err.Error()
+		log.Printf("[PROTECTED-ORDER] %s %s leg @ %.8f not placed: %v", params.Symbol, params.Type, params.TriggerPrice, err)
+		return
+	}
+	leg.AlgoId = resp.AlgoId
+	leg.Error = ""
+}
+
+// PlaceProtectedMarketOrder places a MARKET entry with its SL and TPs attached.
+// Entry and legs go out in one batchOrders request when they fit; legs Binance
+// rejects there (conditional orders now require the algo order API) are placed
+// through PlaceAlgoOrder straight after, and once that rejection is seen later
+// calls skip the batch. The error is only set when the entry was not placed.
+func (c *FuturesClientImpl) PlaceProtectedMarketOrder(params FuturesOrderParams, slPrice float64, tpPrices []float64) (*ProtectedOrderResult, error) {
+	legs, err := protectiveLegs(params, slPrice, tpPrices)
+	if err != nil {
+		return nil, err
+	}
+	if len(legs)+1 > maxBatchOrders || c.batchBracketUnsupported.Load() {
+		return PlaceProtectedSequentially(c, params, slPrice, tpPrices)
+	}
+
+	result, err := c.placeProtectedBatch(params, legs)
+	if errors.Is(err, errBatchRejected) {
+		log.Printf("[PROTECTED-ORDER] %s: batch rejected (%v), placing sequentially", params.Symbol, err)
+		return PlaceProtectedSequentially(c, params, slPrice, tpPrices)
+	}
+	// A rejected entry, or a failure that leaves its state unknown, is not retried:
+	// that could open a second position
+	return result, err
+}
+
+// batchOrderResult is one element of a batchOrders response: an order, or a code/msg error
+type batchOrderResult struct {
+	FuturesOrderResponse
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+func (c *FuturesClientImpl) placeProtectedBatch(params FuturesOrderParams, legs []AlgoOrderParams) (*ProtectedOrderResult, error) {
+	orders := []map[string]string{batchEntryOrder(params)}
+	for _, leg := range legs {
+		orders = append(orders, batchLegOrder(leg))
+	}
+	batch, err := json.Marshal(orders)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding batch orders: %w", err)
+	}
+
+	resp, err := c.signedPost("/fapi/v1/batchOrders", map[string]string{"batchOrders": string(batch)})
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode < 500 {
+		return nil, fmt.Errorf("%w: %w", errBatchRejected, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error placing protected batch: %w", err)
+	}
+	var results []batchOrderResult
+	if err := json.Unmarshal(resp, &results); err != nil {
+		return nil, fmt.Errorf("error parsing batch orders response: %w", err)
+	}
+	if len(results) != len(orders) {
+		return nil, fmt.Errorf("batch orders response has %d results for %d orders", len(results), len(orders))
+	}
+
+	if entry := results[0]; entry.Code != 0 {
+		// Entry rejected: pull any legs that were accepted without it
+		for _, leg := range results[1:] {
+			if leg.Code == 0 && leg.OrderId > 0 {
+				if err := c.CancelFuturesOrder(params.Symbol, leg.OrderId); err != nil {
+					log.Printf("[PROTECTED-ORDER] %s: failed to cancel leg %d after entry rejection: %v", params.Symbol, leg.OrderId, err)
+				}
+			}
+		}
+		return nil, fmt.Errorf("error placing entry order: %w", &APIError{Code: entry.Code, Message: entry.Msg, Body: entry.Msg})
+	}
+
+	entry := results[0].FuturesOrderResponse
+	result := newProtectedOrderResult(&entry, legs, ProtectedOrderMethodBatch)
+	for i, r := range results[1:] {
+		if r.Code == 0 && r.OrderId > 0 {
+			result.leg(i).OrderId = r.OrderId
+			continue
+		}
+		if r.Code == ErrCodeAlgoOrderRequired && !c.batchBracketUnsupported.Swap(true) {
+			log.Printf("[PROTECTED-ORDER] Binance requires the algo order API for SL/TP legs; protected orders will be placed sequentially")
+		}
+		result.leg(i).Error = fmt.Sprintf("batch: %d %s", r.Code, r.Msg)
+		placeLegViaAlgo(c, legs[i], result.leg(i))
+	}
+	return result, nil
+}
+
+func batchEntryOrder(p FuturesOrderParams) map[string]string {
+	order := map[string]string{
+		"symbol":   p.Symbol,
+		"side":     p.Side,
+		"type":     string(p.Type),
+		"quantity": strconv.FormatFloat(p.Quantity, 'f', -1, 64),
+	}
+	if p.PositionSide != "" {
+		order["positionSide"] = string(p.PositionSide)
+	}
+	if p.NewClientOrderId != "" {
+		order["newClientOrderId"] = p.NewClientOrderId
+	}
+	return order
+}
+
+func batchLegOrder(p AlgoOrderParams) map[string]string {
+	order := map[string]string{
+		"symbol":      p.Symbol,
+		"side":        p.Side,
+		"type":        string(p.Type),
+		"stopPrice":   strconv.FormatFloat(p.TriggerPrice, 'f', -1, 64),
+		"workingType": string(p.WorkingType),
+	}
+	if p.PositionSide != "" {
+		order["positionSide"] = string(p.PositionSide)
+	}
+	if p.ClosePosition {
+		order["closePosition"] = "true"
+	} else {
+		order["quantity"] = strconv.FormatFloat(p.Quantity, 'f', -1, 64)
+		if p.ReduceOnly {
+			order["reduceOnly"] = "true"
+		}
+	}
+	return order
+}
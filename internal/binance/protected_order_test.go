@@ -0,0 +1,105 @@
+package binance
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestSplitQuantity(t *testing.T) {
+	tests := []struct {
+		qty  float64
+		n    int
+		want []float64
+	}{
+		{0.003, 3, []float64{0.001, 0.001, 0.001}},
+		{10, 3, []float64{3, 3, 4}},
+		{1.25, 2, []float64{0.62, 0.63}},
+		{5, 1, []float64{5}},
+	}
+	for _, tt := range tests {
+		got := splitQuantity(tt.qty, tt.n)
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("splitQuantity(%v, %d) = %v, want %v", tt.qty, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestPlaceProtectedSequentially(t *testing.T) {
+	mc := NewFuturesMockClient(10000, nil)
+	result, err := mc.PlaceProtectedMarketOrder(FuturesOrderParams{
+		Symbol:   "BTCUSDT",
+		Side:     "BUY",
+		Type:     FuturesOrderTypeMarket,
+		Quantity: 0.02,
+	}, 49000, []float64{51000, 52000})
+	if err != nil {
+		t.Fatalf("PlaceProtectedMarketOrder: %v", err)
+	}
+	if result.Method != ProtectedOrderMethodSequential || !result.Protected() {
+		t.Fatalf("method=%s protected=%v, want sequential and protected", result.Method, result.Protected())
+	}
+	if len(result.TakeProfits) != 2 || result.TakeProfits[0].Quantity != 0.01 || !result.TakeProfits[1].Placed() {
+		t.Errorf("take profits = %+v", result.TakeProfits)
+	}
+
+	if _, err := mc.PlaceProtectedMarketOrder(FuturesOrderParams{Symbol: "BTCUSDT", Side: "BUY", Type: FuturesOrderTypeLimit, Quantity: 1}, 49000, nil); err == nil {
+		t.Error("expected a LIMIT entry to be refused")
+	}
+	if _, err := mc.PlaceProtectedMarketOrder(FuturesOrderParams{Symbol: "BTCUSDT", Side: "BUY", Type: FuturesOrderTypeMarket, Quantity: 0.01}, 49000, []float64{51000, 52000}); err == nil {
+		t.Error("expected a quantity too small to split to be refused")
+	}
+}
+
+func TestPlaceProtectedMarketOrderFallsBackFromBatch(t *testing.T) {
+	var batchCalls, entryCalls, algoCalls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/fapi/v1/batchOrders":
+			batchCalls.Add(1)
+			// Entry accepted, conditional legs pushed to the algo order API
+			fmt.Fprint(w, `[{"orderId":11,"symbol":"ETHUSDT","status":"NEW"},`+
+				`{"code":-4120,"msg":"Order type not supported for this endpoint."},`+
+				`{"code":-4120,"msg":"Order type not supported for this endpoint."}]`)
+		case "/fapi/v1/order":
+			entryCalls.Add(1)
+			fmt.Fprint(w, `{"orderId":12,"symbol":"ETHUSDT","status":"NEW"}`)
+		case "/fapi/v1/openAlgoOrders":
+			fmt.Fprint(w, `[]`)
+		case "/fapi/v1/algoOrder":
+			fmt.Fprintf(w, `{"algoId":%d}`, 100+algoCalls.Add(1))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	c := &FuturesClientImpl{baseURL: srv.URL, httpClient: srv.Client()}
+	params := FuturesOrderParams{Symbol: "ETHUSDT", Side: "SELL", Type: FuturesOrderTypeMarket, Quantity: 1}
+
+	result, err := c.PlaceProtectedMarketOrder(params, 3100, []float64{2900})
+	if err != nil {
+		t.Fatalf("PlaceProtectedMarketOrder: %v", err)
+	}
+	if result.Method != ProtectedOrderMethodBatch || result.Entry.OrderId != 11 {
+		t.Fatalf("method=%s entry=%d, want batch entry 11", result.Method, result.Entry.OrderId)
+	}
+	if result.StopLoss.AlgoId == 0 || result.TakeProfits[0].AlgoId == 0 {
+		t.Fatalf("rejected legs were not placed as algo orders: %+v", result)
+	}
+	if entryCalls.Load() != 0 {
+		t.Fatal("entry placed twice")
+	}
+
+	// The -4120 is remembered, so the next order skips the batch
+	result, err = c.PlaceProtectedMarketOrder(params, 3100, []float64{2900})
+	if err != nil {
+		t.Fatalf("second PlaceProtectedMarketOrder: %v", err)
+	}
+	if result.Method != ProtectedOrderMethodSequential || batchCalls.Load() != 1 || entryCalls.Load() != 1 {
+		t.Errorf("method=%s batch calls=%d entry calls=%d, want sequential after one batch",
+			result.Method, batchCalls.Load(), entryCalls.Load())
+	}
+}
@@ -91,8 +91,10 @@ var endpointWeights = map[string]int{
 
 	// Order endpoints
 	"/fapi/v1/order":         1,
+	"/fapi/v1/batchOrders":   5,
 	"/fapi/v1/openOrders":    1, // 1 with symbol, 40 without
 	"/fapi/v1/allOpenOrders": 40,
+	"/fapi/v1/countdownCancelAll": 10,
 	"/fapi/v1/allOrders":     5,
 	"/fapi/v1/userTrades":    5,
 
@@ -0,0 +1,174 @@
+package binance
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"math/rand"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RetryPolicy controls how Binance API calls are retried
+type RetryPolicy struct {
+	MaxRetries int           // Retries after the first attempt (0 = no retries)
+	BaseDelay  time.Duration // Delay before the first retry, doubled on each further retry
+	MaxDelay   time.Duration // Cap on the backoff delay (before jitter)
+}
+
+// DefaultRetryPolicy returns the retry policy used when none is configured
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		BaseDelay:  500 * time.Millisecond,
+		MaxDelay:   5 * time.Second,
+	}
+}
+
+var (
+	retryPolicyMu sync.RWMutex
+	retryPolicy   = DefaultRetryPolicy()
+)
+
+// SetRetryPolicy replaces the global retry policy. Invalid values fall back to defaults.
+func SetRetryPolicy(p RetryPolicy) {
+	def := DefaultRetryPolicy()
+	if p.MaxRetries < 0 {
+		p.MaxRetries = def.MaxRetries
+	}
+	if p.BaseDelay <= 0 {
+		p.BaseDelay = def.BaseDelay
+	}
+	if p.MaxDelay < p.BaseDelay {
+		p.MaxDelay = p.BaseDelay
+	}
+
+	retryPolicyMu.Lock()
+	retryPolicy = p
+	retryPolicyMu.Unlock()
+}
+
+// GetRetryPolicy returns the current global retry policy
+func GetRetryPolicy() RetryPolicy {
+	retryPolicyMu.RLock()
+	defer retryPolicyMu.RUnlock()
+	return retryPolicy
+}
+
+// Delay returns the backoff before retry number attempt (0-based):
+// exponential from BaseDelay, capped at MaxDelay, with ±25% jitter
+func (p RetryPolicy) Delay(attempt int) time.Duration {
+	if attempt > 30 {
+		attempt = 30
+	}
+	delay := p.BaseDelay * time.Duration(1<<uint(attempt))
+	if delay > p.MaxDelay || delay <= 0 {
+		delay = p.MaxDelay
+	}
+	if delay < 4 {
+		return delay
+	}
+	jitter := time.Duration(rand.Int63n(int64(delay) / 2))
+	return delay + jitter - (delay / 4)
+}
+
+// APIError is a non-200 response from the Binance API.
+// Error() keeps the "API error: <body>" format callers already match on.
+type APIError struct {
+	StatusCode int
+	Code       int    // Binance error code, e.g. -2019 (0 if the body was not JSON)
+	Message    string // Binance error message
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return "API error: " + e.Body
+}
+
+// newAPIError builds an APIError from a response status and body
+func newAPIError(statusCode int, body []byte) *APIError {
+	apiErr := &APIError{StatusCode: statusCode, Body: string(body)}
+	var payload struct {
+		Code int    `json:"code"`
+		Msg  string `json:"msg"`
+	}
+	if json.Unmarshal(body, &payload) == nil {
+		apiErr.Code = payload.Code
+		apiErr.Message = payload.Msg
+	}
+	return apiErr
+}
+
+// Retryable reports whether the response is transient (rate limits, server
+// errors, Binance disconnect/overload codes). Other 4xx responses such as
+// insufficient balance or an invalid symbol are terminal.
+func (e *APIError) Retryable() bool {
+	return isRetryableError(e.StatusCode, e.Body)
+}
+
+// isRetryableError checks if an error is transient and should be retried
+func isRetryableError(statusCode int, body string) bool {
+	// Retry on rate limits (429) and server errors (5xx)
+	if statusCode == http.StatusTooManyRequests || statusCode >= 500 {
+		return true
+	}
+	// Retry on specific Binance errors that are transient
+	if strings.Contains(body, "-1001") || // DISCONNECTED
+		strings.Contains(body, "-1003") || // TOO_MANY_REQUESTS
+		strings.Contains(body, "-1015") || // TOO_MANY_ORDERS
+		strings.Contains(body, "-1016") { // SERVICE_SHUTTING_DOWN
+		return true
+	}
+	return false
+}
+
+// IsRetryableError classifies an error returned by a Binance call:
+// network failures and transient API responses are retryable, everything
+// else (validation, balance, symbol errors, blocked by rate limiter) is terminal
+func IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Retryable()
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	return false
+}
+
+// Retry runs fn under the global retry policy. Retryable errors are retried
+// with jittered exponential backoff; terminal errors short-circuit and are
+// returned immediately.
+func Retry(operation string, fn func() error) error {
+	policy := GetRetryPolicy()
+
+	var err error
+	for attempt := 0; attempt <= policy.MaxRetries; attempt++ {
+		err = fn()
+		if err == nil {
+			return nil
+		}
+		if !IsRetryableError(err) || attempt == policy.MaxRetries {
+			return err
+		}
+
+		delay := policy.Delay(attempt)
+		log.Printf("[BINANCE] %s failed (attempt %d/%d): %v, retrying in %v",
+			operation, attempt+1, policy.MaxRetries+1, err, delay)
+		time.Sleep(delay)
+	}
+	return err
+}
@@ -0,0 +1,108 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+)
+
+func useFastRetryPolicy(t *testing.T, maxRetries int) {
+	t.Helper()
+	previous := GetRetryPolicy()
+	SetRetryPolicy(RetryPolicy{MaxRetries: maxRetries, BaseDelay: time.Millisecond, MaxDelay: 2 * time.Millisecond})
+	t.Cleanup(func() { SetRetryPolicy(previous) })
+}
+
+func TestIsRetryableError(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"server error", newAPIError(503, []byte(`{"code":-1001,"msg":"Internal error"}`)), true},
+		{"rate limited", newAPIError(429, []byte(`{"code":-1003,"msg":"Too many requests"}`)), true},
+		{"insufficient balance", newAPIError(400, []byte(`{"code":-2019,"msg":"Margin is insufficient."}`)), false},
+		{"invalid symbol", newAPIError(400, []byte(`{"code":-1121,"msg":"Invalid symbol."}`)), false},
+		{"wrapped api error", fmt.Errorf("error placing order: %w", newAPIError(502, []byte("bad gateway"))), true},
+		{"network error", &net.OpError{Op: "dial", Err: errors.New("connection refused")}, true},
+		{"other error", errors.New("rate limit: circuit breaker open, request blocked"), false},
+	}
+
+	for _, tc := range cases {
+		if got := IsRetryableError(tc.err); got != tc.want {
+			t.Errorf("%s: IsRetryableError = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestNewAPIErrorParsesBody(t *testing.T) {
+	err := newAPIError(400, []byte(`{"code":-2019,"msg":"Margin is insufficient."}`))
+	if err.Code != -2019 || err.Message != "Margin is insufficient." {
+		t.Errorf("Unexpected code/message: %d %q", err.Code, err.Message)
+	}
+	if err.Error() != `API error: {"code":-2019,"msg":"Margin is insufficient."}` {
+		t.Errorf("Unexpected error string: %s", err.Error())
+	}
+}
+
+func TestRetryShortCircuitsTerminalErrors(t *testing.T) {
+	useFastRetryPolicy(t, 3)
+
+	calls := 0
+	err := Retry("test", func() error {
+		calls++
+		return newAPIError(400, []byte(`{"code":-2019,"msg":"Margin is insufficient."}`))
+	})
+	if err == nil {
+		t.Fatal("Expected terminal error to be returned")
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 call for terminal error, got %d", calls)
+	}
+}
+
+func TestRetryRetriesTransientErrors(t *testing.T) {
+	useFastRetryPolicy(t, 3)
+
+	calls := 0
+	err := Retry("test", func() error {
+		calls++
+		if calls < 3 {
+			return newAPIError(503, []byte("service unavailable"))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Expected success after retries, got %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 calls, got %d", calls)
+	}
+}
+
+func TestRetryHonoursMaxRetries(t *testing.T) {
+	useFastRetryPolicy(t, 2)
+
+	calls := 0
+	_ = Retry("test", func() error {
+		calls++
+		return newAPIError(500, []byte("internal error"))
+	})
+	if calls != 3 {
+		t.Errorf("Expected 1 attempt + 2 retries, got %d calls", calls)
+	}
+}
+
+func TestRetryPolicyDelayIsCapped(t *testing.T) {
+	p := RetryPolicy{MaxRetries: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: 400 * time.Millisecond}
+	for attempt := 0; attempt < 10; attempt++ {
+		d := p.Delay(attempt)
+		// ±25% jitter around a delay capped at MaxDelay
+		if d < 75*time.Millisecond || d > 500*time.Millisecond {
+			t.Errorf("Attempt %d: delay %v outside expected bounds", attempt, d)
+		}
+	}
+}
@@ -0,0 +1,261 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OCO order list statuses (listOrderStatus)
+const (
+	OCOListStatusExecuting = "EXECUTING"
+	OCOListStatusAllDone   = "ALL_DONE"
+	OCOListStatusReject    = "REJECT"
+)
+
+// OCOOrderParams describes a spot one-cancels-the-other exit bracket: a
+// LIMIT_MAKER take profit above the market and a STOP_LOSS_LIMIT below it (for
+// a SELL; mirrored for a BUY)
+type OCOOrderParams struct {
+	Symbol          string
+	Side            string // Exit side: SELL closes a long spot holding
+	Quantity        float64
+	TakeProfitPrice float64
+	StopPrice       float64 // Stop trigger
+	StopLimitPrice  float64 // Limit price once triggered (0 = STOP_LOSS market)
+}
+
+// OCOOrderRef identifies one leg of an OCO order list
+type OCOOrderRef struct {
+	Symbol        string `json:"symbol"`
+	OrderId       int64  `json:"orderId"`
+	ClientOrderId string `json:"clientOrderId"`
+}
+
+// OCOOrderList is an OCO order list as returned by placement and query
+type OCOOrderList struct {
+	OrderListId       int64         `json:"orderListId"`
+	ContingencyType   string        `json:"contingencyType"`
+	ListStatusType    string        `json:"listStatusType"`
+	ListOrderStatus   string        `json:"listOrderStatus"`
+	ListClientOrderId string        `json:"listClientOrderId"`
+	TransactionTime   int64         `json:"transactionTime"`
+	Symbol            string        `json:"symbol"`
+	Orders            []OCOOrderRef `json:"orders"`
+}
+
+// Done reports whether the list is finished (one leg executed, or cancelled/rejected)
+func (l *OCOOrderList) Done() bool {
+	return l.ListOrderStatus == OCOListStatusAllDone || l.ListOrderStatus == OCOListStatusReject
+}
+
+// spotFilters holds a symbol's PRICE_FILTER tick size and LOT_SIZE step size
+// as the exchange sends them, e.g. "0.01000000"
+type spotFilters struct {
+	tickSize string
+	stepSize string
+}
+
+// price rounds a price to the nearest tick
+func (f spotFilters) price(v float64) string {
+	return roundToIncrement(v, f.tickSize, math.Round)
+}
+
+// quantity rounds a quantity down to the step so it never exceeds the holding
+func (f spotFilters) quantity(v float64) string {
+	return roundToIncrement(v, f.stepSize, math.Floor)
+}
+
+// roundToIncrement rounds v to a multiple of increment and formats it with the
+// increment's decimals. An unknown increment leaves 8 decimals.
+func roundToIncrement(v float64, increment string, round func(float64) float64) string {
+	inc, err := strconv.ParseFloat(increment, 64)
+	if err != nil || inc <= 0 {
+		return strconv.FormatFloat(v, 'f', 8, 64)
+	}
+	decimals := 0
+	if i := strings.IndexByte(increment, '.'); i >= 0 {
+		decimals = len(strings.TrimRight(increment[i+1:], "0"))
+	}
+	// The epsilon keeps values already on the grid from flooring a step down
+	return strconv.FormatFloat(round(v/inc+1e-9)*inc, 'f', decimals, 64)
+}
+
+// symbolFilters returns a symbol's spot filters, fetching and caching them on first use
+func (c *Client) symbolFilters(symbol string) (spotFilters, error) {
+	c.filtersMu.Lock()
+	defer c.filtersMu.Unlock()
+	if f, ok := c.filters[symbol]; ok {
+		return f, nil
+	}
+
+	body, err := c.publicGet(fmt.Sprintf("%s/api/v3/exchangeInfo?symbol=%s", c.baseURL, symbol))
+	if err != nil {
+		return spotFilters{}, fmt.Errorf("error fetching filters for %s: %w", symbol, err)
+	}
+	var info ExchangeInfo
+	if err := json.Unmarshal(body, &info); err != nil {
+		return spotFilters{}, fmt.Errorf("error parsing filters for %s: %w", symbol, err)
+	}
+
+	var f spotFilters
+	for _, s := range info.Symbols {
+		if s.Symbol != symbol {
+			continue
+		}
+		for _, filter := range s.Filters {
+			switch filter.FilterType {
+			case "PRICE_FILTER":
+				f.tickSize = filter.TickSize
+			case "LOT_SIZE":
+				f.stepSize = filter.StepSize
+			}
+		}
+	}
+	if f.tickSize == "" || f.stepSize == "" {
+		return spotFilters{}, fmt.Errorf("no price or lot size filter for %s", symbol)
+	}
+
+	if c.filters == nil {
+		c.filters = make(map[string]spotFilters)
+	}
+	c.filters[symbol] = f
+	return f, nil
+}
+
+// PlaceOCOOrder places a spot OCO exit bracket, rounding prices to the
+// symbol's tick size and the quantity down to its step size
+func (c *Client) PlaceOCOOrder(p OCOOrderParams) (*OCOOrderList, error) {
+	f, err := c.symbolFilters(p.Symbol)
+	if err != nil {
+		return nil, fmt.Errorf("error placing OCO order: %w", err)
+	}
+	params, err := ocoOrderParams(p, f)
+	if err != nil {
+		return nil, fmt.Errorf("error placing OCO order: %w", err)
+	}
+
+	body, err := c.signedRequest("POST", "/api/v3/orderList/oco", params)
+	if err != nil {
+		return nil, fmt.Errorf("error placing OCO order: %w", err)
+	}
+
+	var list OCOOrderList
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, fmt.Errorf("error parsing OCO order response: %w", err)
+	}
+	return &list, nil
+}
+
+// ocoOrderParams builds the request parameters for an OCO exit bracket
+func ocoOrderParams(p OCOOrderParams, f spotFilters) (map[string]string, error) {
+	quantity := f.quantity(p.Quantity)
+	if q, _ := strconv.ParseFloat(quantity, 64); q <= 0 {
+		return nil, fmt.Errorf("quantity %.8f for %s is below the lot step %s", p.Quantity, p.Symbol, f.stepSize)
+	}
+
+	params := map[string]string{
+		"symbol":         p.Symbol,
+		"side":           p.Side,
+		"quantity":       quantity,
+		"belowStopPrice": f.price(p.StopPrice),
+	}
+
+	// For a SELL the take profit sits above the market and the stop below;
+	// a BUY exit is mirrored
+	tp, stop := "above", "below"
+	if p.Side == "BUY" {
+		tp, stop = "below", "above"
+		delete(params, "belowStopPrice")
+		params["aboveStopPrice"] = f.price(p.StopPrice)
+	}
+	params[tp+"Type"] = "LIMIT_MAKER"
+	params[tp+"Price"] = f.price(p.TakeProfitPrice)
+	if p.StopLimitPrice > 0 {
+		params[stop+"Type"] = "STOP_LOSS_LIMIT"
+		params[stop+"Price"] = f.price(p.StopLimitPrice)
+		params[stop+"TimeInForce"] = "GTC"
+	} else {
+		params[stop+"Type"] = "STOP_LOSS"
+	}
+	return params, nil
+}
+
+// CancelOCOOrder cancels an entire OCO order list
+func (c *Client) CancelOCOOrder(symbol string, orderListID int64) error {
+	params := map[string]string{
+		"symbol":      symbol,
+		"orderListId": strconv.FormatInt(orderListID, 10),
+	}
+	if _, err := c.signedRequest("DELETE", "/api/v3/orderList", params); err != nil {
+		return fmt.Errorf("error canceling OCO order: %w", err)
+	}
+	return nil
+}
+
+// GetOCOOrder retrieves an OCO order list's status
+func (c *Client) GetOCOOrder(orderListID int64) (*OCOOrderList, error) {
+	params := map[string]string{
+		"orderListId": strconv.FormatInt(orderListID, 10),
+	}
+	body, err := c.signedRequest("GET", "/api/v3/orderList", params)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching OCO order: %w", err)
+	}
+
+	var list OCOOrderList
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, fmt.Errorf("error parsing OCO order: %w", err)
+	}
+	return &list, nil
+}
+
+// GetOrder retrieves a spot order's status
+func (c *Client) GetOrder(symbol string, orderId int64) (*OrderResponse, error) {
+	params := map[string]string{
+		"symbol":  symbol,
+		"orderId": strconv.FormatInt(orderId, 10),
+	}
+	body, err := c.signedRequest("GET", "/api/v3/order", params)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching order: %w", err)
+	}
+
+	var order OrderResponse
+	if err := json.Unmarshal(body, &order); err != nil {
+		return nil, fmt.Errorf("error parsing order: %w", err)
+	}
+	return &order, nil
+}
+
+// signedRequest sends a signed spot API request and returns the response body
+func (c *Client) signedRequest(method, path string, params map[string]string) ([]byte, error) {
+	params["timestamp"] = strconv.FormatInt(time.Now().UnixMilli(), 10)
+	query := c.signParams(params)
+
+	req, err := http.NewRequest(method, fmt.Sprintf("%s%s?%s", c.baseURL, path, query), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-MBX-APIKEY", c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API error: %s", string(body))
+	}
+	return body, nil
+}
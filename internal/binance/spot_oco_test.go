@@ -0,0 +1,108 @@
+package binance
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMockClientOCOFillsTakeProfit(t *testing.T) {
+	mc := NewMockClient()
+	price, _ := mc.GetCurrentPrice("BTCUSDT")
+
+	// Take profit already below the market, so the first status check fills it
+	list, err := mc.PlaceOCOOrder(OCOOrderParams{
+		Symbol:          "BTCUSDT",
+		Side:            "SELL",
+		Quantity:        0.01,
+		TakeProfitPrice: price * 0.5,
+		StopPrice:       price * 0.4,
+		StopLimitPrice:  price * 0.39,
+	})
+	if err != nil {
+		t.Fatalf("PlaceOCOOrder: %v", err)
+	}
+	if list.Done() || len(list.Orders) != 2 {
+		t.Fatalf("expected a live two-leg list, got status %s with %d legs", list.ListOrderStatus, len(list.Orders))
+	}
+
+	list, err = mc.GetOCOOrder(list.OrderListId)
+	if err != nil {
+		t.Fatalf("GetOCOOrder: %v", err)
+	}
+	if !list.Done() {
+		t.Fatalf("expected list to be done, got %s", list.ListOrderStatus)
+	}
+
+	tp, err := mc.GetOrder("BTCUSDT", list.Orders[0].OrderId)
+	if err != nil {
+		t.Fatalf("GetOrder: %v", err)
+	}
+	if tp.Status != "FILLED" || tp.Type != "LIMIT_MAKER" {
+		t.Errorf("expected filled LIMIT_MAKER take profit, got %s %s", tp.Status, tp.Type)
+	}
+	if err := mc.CancelOCOOrder("BTCUSDT", list.OrderListId); err == nil {
+		t.Error("expected cancelling a finished list to fail")
+	}
+}
+
+func TestOCOOrderParamsRoundsToFilters(t *testing.T) {
+	f := spotFilters{tickSize: "0.01000000", stepSize: "0.00001000"}
+
+	params, err := ocoOrderParams(OCOOrderParams{
+		Symbol:          "BTCUSDT",
+		Side:            "SELL",
+		Quantity:        0.012349999,
+		TakeProfitPrice: 104512.3456,
+		StopPrice:       101234.5678,
+		StopLimitPrice:  101133.3321,
+	}, f)
+	if err != nil {
+		t.Fatalf("ocoOrderParams: %v", err)
+	}
+	want := map[string]string{
+		"quantity":       "0.01234",
+		"abovePrice":     "104512.35",
+		"belowStopPrice": "101234.57",
+		"belowPrice":     "101133.33",
+	}
+	for k, v := range want {
+		if params[k] != v {
+			t.Errorf("%s = %q, want %q", k, params[k], v)
+		}
+	}
+
+	// A quantity already on the step must not be floored a step down
+	params, _ = ocoOrderParams(OCOOrderParams{Symbol: "BTCUSDT", Side: "SELL", Quantity: 0.00003, TakeProfitPrice: 2, StopPrice: 1}, f)
+	if params["quantity"] != "0.00003" {
+		t.Errorf("quantity on the step = %q, want 0.00003", params["quantity"])
+	}
+
+	if _, err := ocoOrderParams(OCOOrderParams{Symbol: "BTCUSDT", Side: "SELL", Quantity: 0.000009, TakeProfitPrice: 2, StopPrice: 1}, f); err == nil {
+		t.Error("expected a quantity below one step to be rejected")
+	}
+}
+
+func TestOrderResponseMarketFill(t *testing.T) {
+	// A MARKET buy reports price 0; the fill comes from the quote total
+	order := &OrderResponse{
+		Symbol:              "BNBUSDT",
+		ExecutedQty:         2,
+		CummulativeQuoteQty: 1420,
+		Fills: []OrderFill{
+			{Price: 709, Qty: 1, Commission: 0.001, CommissionAsset: "BNB"},
+			{Price: 711, Qty: 1, Commission: 0.001, CommissionAsset: "BNB"},
+		},
+	}
+	if got := order.AvgPrice(); got != 710 {
+		t.Errorf("AvgPrice() = %v, want 710", got)
+	}
+	if got := order.NetExecutedQty(); math.Abs(got-1.998) > 1e-12 {
+		t.Errorf("NetExecutedQty() = %v, want 1.998", got)
+	}
+
+	// Commission paid in the quote asset leaves the holding untouched
+	order.Fills[0].CommissionAsset, order.Fills[1].CommissionAsset = "USDT", "USDT"
+	if got := order.NetExecutedQty(); got != 2 {
+		t.Errorf("NetExecutedQty() with quote commission = %v, want 2", got)
+	}
+}
@@ -192,27 +192,20 @@ func (b *TradingBot) evaluateStrategy(name string, strat strategy.Strategy) erro
 		return fmt.Errorf("error evaluating strategy: %w", err)
 	}
 
+	// A provisional signal is logged but not traded until its confirmation candle closes
+	if signal.Status == strategy.SignalStatusProvisional {
+		log.Printf("Provisional signal: %s - %s - %s", name, signal.Symbol, signal.Reason)
+		b.publishSignal(name, signal)
+		return nil
+	}
+
 	// Check if there's a signal
 	if signal.Type == strategy.SignalNone {
 		return nil
 	}
 
-	log.Printf("Signal detected: %s - %s - %s", name, signal.Symbol, signal.Reason)
-
-	// Publish signal event
-	if b.eventBus != nil {
-		b.eventBus.Publish(events.Event{
-			Type: events.EventSignalGenerated,
-			Data: map[string]interface{}{
-				"strategy":    name,
-				"symbol":      signal.Symbol,
-				"signal_type": signal.Side,
-				"price":       signal.EntryPrice,
-				"reason":      signal.Reason,
-			},
-			Timestamp: time.Now(),
-		})
-	}
+	log.Printf("Signal detected (%s): %s - %s - %s", signal.ConfirmationStatus(), name, signal.Symbol, signal.Reason)
+	b.publishSignal(name, signal)
 
 	// Place order
 	if err := b.executeSignal(signal); err != nil {
@@ -222,6 +215,23 @@ func (b *TradingBot) evaluateStrategy(name string, strat strategy.Strategy) erro
 	return nil
 }
 
+// publishSignal publishes a signal event for the signal log
+func (b *TradingBot) publishSignal(name string, signal *strategy.Signal) {
+	if b.eventBus == nil {
+		return
+	}
+	b.eventBus.PublishTyped(events.SignalGenerated{
+		Strategy:   name,
+		Symbol:     signal.Symbol,
+		SignalType: signal.Side,
+		Reason:     signal.Reason,
+		Price:      signal.EntryPrice,
+		StopLoss:   signal.StopLoss,
+		TakeProfit: signal.TakeProfit,
+		Status:     signal.ConfirmationStatus(),
+	})
+}
+
 // executeSignal executes a trading signal
 func (b *TradingBot) executeSignal(signal *strategy.Signal) error {
 	if b.config.TradingConfig.DryRun {
@@ -235,6 +245,10 @@ func (b *TradingBot) executeSignal(signal *strategy.Signal) error {
 	// Calculate quantity based on risk management
 	// This is a simplified version - in production, you'd want more sophisticated position sizing
 	quantity := b.calculatePositionSize(signal)
+	// A requested size (e.g. from a webhook alert) can shrink the position but never exceed the risk-based size
+	if signal.Quantity > 0 && signal.Quantity < quantity {
+		quantity = b.roundQuantity(signal.Symbol, signal.Quantity)
+	}
 
 	// Place market order
 	params := map[string]string{
@@ -539,17 +553,13 @@ func (b *TradingBot) createVirtualTrade(signal *strategy.Signal) error {
 
 	// Publish trade opened event
 	if b.eventBus != nil {
-		b.eventBus.Publish(events.Event{
-			Type: events.EventTradeOpened,
-			Data: map[string]interface{}{
-				"symbol":      signal.Symbol,
-				"side":        signal.Side,
-				"entry_price": signal.EntryPrice,
-				"quantity":    quantity,
-				"stop_loss":   signal.StopLoss,
-				"take_profit": signal.TakeProfit,
-			},
-			Timestamp: time.Now(),
+		b.eventBus.PublishTyped(events.TradeOpened{
+			Symbol:     signal.Symbol,
+			Side:       signal.Side,
+			EntryPrice: signal.EntryPrice,
+			Quantity:   quantity,
+			StopLoss:   signal.StopLoss,
+			TakeProfit: signal.TakeProfit,
 		})
 	}
 
@@ -707,16 +717,14 @@ func (b *TradingBot) checkVirtualPositions() {
 
 			// Publish trade closed event
 			if b.eventBus != nil {
-				b.eventBus.Publish(events.Event{
-					Type: events.EventTradeClosed,
-					Data: map[string]interface{}{
-						"symbol":      trade.Symbol,
-						"exit_price":  currentPrice,
-						"pnl":         pnl,
-						"pnl_percent": pnlPercent,
-						"reason":      closeReason,
-					},
-					Timestamp: time.Now(),
+				b.eventBus.PublishTyped(events.TradeClosed{
+					Symbol:     trade.Symbol,
+					EntryPrice: trade.EntryPrice,
+					ExitPrice:  currentPrice,
+					Quantity:   trade.Quantity,
+					PnL:        pnl,
+					PnLPercent: pnlPercent,
+					Reason:     closeReason,
 				})
 			}
 		}
@@ -815,6 +823,9 @@ func (b *TradingBot) ExecutePendingSignal(pendingSignal *database.PendingSignal)
 	if pendingSignal.Reason != nil {
 		signal.Reason = *pendingSignal.Reason
 	}
+	if pendingSignal.Quantity != nil {
+		signal.Quantity = *pendingSignal.Quantity
+	}
 
 	// Execute the signal
 	log.Printf("Executing manually confirmed signal: %s %s at %.4f", signal.Side, signal.Symbol, signal.EntryPrice)
@@ -27,6 +27,10 @@ type CircuitBreakerConfig struct {
 	MaxTradesPerMinute   int     `json:"max_trades_per_minute"`   // Rate limit
 	MaxDailyLoss         float64 `json:"max_daily_loss"`          // Max daily loss %
 	MaxDailyTrades       int     `json:"max_daily_trades"`        // Max trades per day
+
+	// Escalating alerts (see OnAlert)
+	ReminderIntervalMinutes int `json:"reminder_interval_minutes"` // Remind while tripped (0 = no reminders)
+	AlertDebounceMinutes    int `json:"alert_debounce_minutes"`    // Reset must hold this long before the resolved alert
 }
 
 // DefaultCircuitBreakerConfig returns safe defaults
@@ -39,6 +43,9 @@ func DefaultCircuitBreakerConfig() *CircuitBreakerConfig {
 		MaxTradesPerMinute:   10,   // 10 trades per minute max
 		MaxDailyLoss:         5.0,  // 5% max daily loss
 		MaxDailyTrades:       100,  // 100 trades per day max
+
+		ReminderIntervalMinutes: 15,
+		AlertDebounceMinutes:    2,
 	}
 }
 
@@ -57,9 +64,11 @@ type CircuitBreaker struct {
 	dailyResetTime    time.Time
 	minuteResetTime   time.Time
 	tripReason        string
+	resumedAt         time.Time // When trading last resumed after a trip (cooldown elapsed or forced reset)
 	mu                sync.RWMutex
 	onTrip            func(reason string)
 	onReset           func()
+	escalation        escalationState
 	userID            string // UserID for WebSocket broadcasts
 }
 
@@ -117,6 +126,7 @@ func (cb *CircuitBreaker) CanTrade() (bool, string) {
 
 		// Cooldown passed, try half-open
 		cb.state = StateHalfOpen
+		cb.resumedAt = time.Now()
 	}
 
 	// Check hourly loss limit
@@ -190,6 +200,7 @@ func (cb *CircuitBreaker) RecordTrade(pnlPercent float64) {
 			if cb.onReset != nil {
 				go cb.onReset()
 			}
+			cb.escalateResetLocked()
 		}
 	}
 
@@ -237,6 +248,7 @@ func (cb *CircuitBreaker) trip(reason string) {
 	if cb.onTrip != nil {
 		go cb.onTrip(reason)
 	}
+	cb.escalateTripLocked(reason)
 
 	// Broadcast circuit breaker trip to WebSocket clients
 	if cb.userID != "" {
@@ -279,9 +291,13 @@ func (cb *CircuitBreaker) resetCountersIfNeeded() {
 // ForceReset manually resets the circuit breaker
 func (cb *CircuitBreaker) ForceReset() {
 	cb.mu.Lock()
+	if cb.state != StateClosed {
+		cb.resumedAt = time.Now()
+	}
 	cb.state = StateClosed
 	cb.consecutiveLosses = 0
 	cb.tripReason = ""
+	cb.escalateResetLocked()
 	userID := cb.userID
 	cb.mu.Unlock()
 
@@ -307,6 +323,13 @@ func (cb *CircuitBreaker) GetState() BreakerState {
 	return cb.state
 }
 
+// ResumedAt returns when trading last resumed after a trip (zero if it never tripped)
+func (cb *CircuitBreaker) ResumedAt() time.Time {
+	cb.mu.RLock()
+	defer cb.mu.RUnlock()
+	return cb.resumedAt
+}
+
 // GetStats returns current statistics
 func (cb *CircuitBreaker) GetStats() map[string]interface{} {
 	cb.mu.RLock()
@@ -359,6 +382,12 @@ func (cb *CircuitBreaker) UpdateConfig(updates *CircuitBreakerConfig) {
 	if updates.MaxDailyTrades > 0 {
 		cb.config.MaxDailyTrades = updates.MaxDailyTrades
 	}
+	if updates.ReminderIntervalMinutes > 0 {
+		cb.config.ReminderIntervalMinutes = updates.ReminderIntervalMinutes
+	}
+	if updates.AlertDebounceMinutes > 0 {
+		cb.config.AlertDebounceMinutes = updates.AlertDebounceMinutes
+	}
 }
 
 // SetEnabled enables or disables the circuit breaker
@@ -0,0 +1,145 @@
+package circuit
+
+import (
+	"time"
+)
+
+// AlertKind is the stage of a circuit breaker incident an alert reports
+type AlertKind string
+
+const (
+	AlertTripped  AlertKind = "tripped"  // Breaker just tripped
+	AlertReminder AlertKind = "reminder" // Still tripped after another reminder interval
+	AlertResolved AlertKind = "resolved" // Breaker reset and stayed reset for the debounce
+)
+
+// Alert is an escalating notification about one breaker incident. An incident
+// opens on the first trip and closes with a single resolved alert, however many
+// times the breaker flaps in between.
+type Alert struct {
+	Kind      AlertKind     `json:"kind"`
+	Reason    string        `json:"reason"`
+	TrippedAt time.Time     `json:"tripped_at"`
+	Duration  time.Duration `json:"duration"`  // How long the incident has been open
+	Reminders int           `json:"reminders"` // Reminders sent so far
+}
+
+// escalationState tracks the open incident. Guarded by CircuitBreaker.mu.
+type escalationState struct {
+	handler   func(Alert)
+	active    bool // Tripped and not yet resolved
+	resolving bool // Reset seen, resolved alert waiting out the debounce
+	reason    string
+	trippedAt time.Time
+	reminders int
+	seq       uint64 // Bumped to invalidate scheduled reminders/resolutions
+
+	// schedule runs f after d (time.AfterFunc; replaced in tests)
+	schedule func(d time.Duration, f func())
+}
+
+// OnAlert sets the handler for escalating trip/reminder/resolved alerts. Unlike
+// OnTrip, repeated trips during an open incident do not alert again.
+func (cb *CircuitBreaker) OnAlert(handler func(Alert)) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.escalation.handler = handler
+}
+
+func (cb *CircuitBreaker) scheduleLocked(d time.Duration, f func()) {
+	if cb.escalation.schedule != nil {
+		cb.escalation.schedule(d, f)
+		return
+	}
+	time.AfterFunc(d, f)
+}
+
+// escalateTripLocked opens an incident, or continues the open one if the
+// breaker re-trips (including within the debounce after a reset). Caller must hold cb.mu.
+func (cb *CircuitBreaker) escalateTripLocked(reason string) {
+	es := &cb.escalation
+	if es.handler == nil {
+		return
+	}
+	es.reason = reason
+
+	if es.active {
+		if es.resolving {
+			// Flapped back before the resolved alert went out
+			es.resolving = false
+			es.seq++
+			cb.scheduleReminderLocked()
+		}
+		return
+	}
+
+	es.active = true
+	es.trippedAt = time.Now()
+	es.reminders = 0
+	es.seq++
+	go es.handler(cb.alertLocked(AlertTripped))
+	cb.scheduleReminderLocked()
+}
+
+// escalateResetLocked starts the debounce before the resolved alert. Caller must hold cb.mu.
+func (cb *CircuitBreaker) escalateResetLocked() {
+	es := &cb.escalation
+	if es.handler == nil || !es.active || es.resolving {
+		return
+	}
+	es.seq++ // Stop reminders
+
+	debounce := time.Duration(cb.config.AlertDebounceMinutes) * time.Minute
+	if debounce <= 0 {
+		cb.resolveLocked()
+		return
+	}
+	es.resolving = true
+	seq := es.seq
+	cb.scheduleLocked(debounce, func() {
+		cb.mu.Lock()
+		defer cb.mu.Unlock()
+		if cb.escalation.seq == seq && cb.escalation.resolving {
+			cb.resolveLocked()
+		}
+	})
+}
+
+func (cb *CircuitBreaker) scheduleReminderLocked() {
+	interval := time.Duration(cb.config.ReminderIntervalMinutes) * time.Minute
+	if interval <= 0 {
+		return
+	}
+	seq := cb.escalation.seq
+	cb.scheduleLocked(interval, func() {
+		cb.mu.Lock()
+		defer cb.mu.Unlock()
+		es := &cb.escalation
+		if es.seq != seq || !es.active || es.resolving {
+			return
+		}
+		es.reminders++
+		go es.handler(cb.alertLocked(AlertReminder))
+		cb.scheduleReminderLocked()
+	})
+}
+
+func (cb *CircuitBreaker) resolveLocked() {
+	es := &cb.escalation
+	alert := cb.alertLocked(AlertResolved)
+	es.active = false
+	es.resolving = false
+	es.seq++
+	go es.handler(alert)
+}
+
+func (cb *CircuitBreaker) alertLocked(kind AlertKind) Alert {
+	es := &cb.escalation
+	return Alert{
+		Kind:      kind,
+		Reason:    es.reason,
+		TrippedAt: es.trippedAt,
+		Duration:  time.Since(es.trippedAt),
+		Reminders: es.reminders,
+	}
+}
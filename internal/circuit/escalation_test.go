@@ -0,0 +1,115 @@
+package circuit
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// alertRecorder collects alerts and runs scheduled timers on demand
+type alertRecorder struct {
+	mu      sync.Mutex
+	alerts  []Alert
+	pending []func()
+}
+
+func (r *alertRecorder) handle(a Alert) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.alerts = append(r.alerts, a)
+}
+
+func (r *alertRecorder) kinds(t *testing.T, want int) []AlertKind {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for {
+		r.mu.Lock()
+		n := len(r.alerts)
+		r.mu.Unlock()
+		if n >= want || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(10 * time.Millisecond) // Let any unexpected extra alert arrive
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	kinds := make([]AlertKind, len(r.alerts))
+	for i, a := range r.alerts {
+		kinds[i] = a.Kind
+	}
+	return kinds
+}
+
+// fire runs every timer scheduled so far
+func (r *alertRecorder) fire() {
+	r.mu.Lock()
+	pending := r.pending
+	r.pending = nil
+	r.mu.Unlock()
+	for _, f := range pending {
+		f()
+	}
+}
+
+func newTestBreaker(r *alertRecorder) *CircuitBreaker {
+	config := DefaultCircuitBreakerConfig()
+	config.MaxConsecutiveLosses = 2
+	cb := NewCircuitBreaker(config)
+	cb.OnAlert(r.handle)
+	cb.escalation.schedule = func(d time.Duration, f func()) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.pending = append(r.pending, f)
+	}
+	return cb
+}
+
+func TestEscalationTripReminderResolve(t *testing.T) {
+	r := &alertRecorder{}
+	cb := newTestBreaker(r)
+
+	cb.RecordTrade(-1)
+	cb.RecordTrade(-1)
+	cb.RecordTrade(-1) // Re-trip while open must not alert again
+	if got := r.kinds(t, 1); len(got) != 1 || got[0] != AlertTripped {
+		t.Fatalf("alerts after trip = %v, want [tripped]", got)
+	}
+
+	r.fire() // Reminder interval elapses
+	r.fire()
+	if got := r.kinds(t, 3); len(got) != 3 || got[1] != AlertReminder || got[2] != AlertReminder {
+		t.Fatalf("alerts after reminders = %v", got)
+	}
+
+	cb.ForceReset()
+	r.fire() // Debounce elapses
+	got := r.kinds(t, 4)
+	if len(got) != 4 || got[3] != AlertResolved {
+		t.Fatalf("alerts after reset = %v, want resolved last", got)
+	}
+	if r.alerts[3].Reminders != 2 {
+		t.Errorf("resolved alert reminders = %d, want 2", r.alerts[3].Reminders)
+	}
+}
+
+func TestEscalationDebouncesFlapping(t *testing.T) {
+	r := &alertRecorder{}
+	cb := newTestBreaker(r)
+
+	cb.RecordTrade(-1)
+	cb.RecordTrade(-1)
+	r.kinds(t, 1)
+
+	// Reset then re-trip before the debounce: same incident, no new alerts
+	cb.ForceReset()
+	cb.RecordTrade(-1)
+	cb.RecordTrade(-1)
+
+	// Only the reminder scheduled by the re-trip fires; the stale reminder and
+	// resolution from before the flap are ignored
+	r.fire()
+	if got := r.kinds(t, 2); len(got) != 2 || got[0] != AlertTripped || got[1] != AlertReminder {
+		t.Fatalf("alerts after flap = %v, want [tripped reminder]", got)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -12,6 +14,10 @@ import (
 // DB wraps the PostgreSQL connection pool
 type DB struct {
 	Pool *pgxpool.Pool
+
+	// Read replicas for analytics queries, picked round-robin by ReadReplica
+	replicas    []*DB
+	replicaNext atomic.Uint32
 }
 
 // Config holds database configuration
@@ -22,6 +28,44 @@ type Config struct {
 	Password string
 	Database string
 	SSLMode  string
+
+	// Connection pool tuning (0 = package defaults)
+	MaxConns        int32
+	MinConns        int32
+	MaxConnLifetime time.Duration
+	MaxConnIdleTime time.Duration
+
+	// Server-side statement_timeout for every pooled connection, a backstop for
+	// callers without a deadline (0 = DefaultStatementTimeout, negative disables)
+	StatementTimeout time.Duration
+
+	// Optional read replicas (postgres:// URLs or DSNs) for read-only analytics.
+	// They share the pool tuning above; an unreachable replica is skipped.
+	ReplicaURLs []string
+}
+
+// Pool defaults. MaxConns leaves headroom for Ginie's async writers (event
+// persistence, lifecycle logging, per-user PnL queries) running concurrently.
+const (
+	DefaultMaxConns         int32 = 40
+	DefaultMinConns         int32 = 5
+	DefaultMaxConnLifetime        = time.Hour
+	DefaultMaxConnIdleTime        = 15 * time.Minute
+	DefaultStatementTimeout       = 60 * time.Second
+)
+
+// PoolStats is a snapshot of connection pool usage
+type PoolStats struct {
+	MaxConns             int32   `json:"max_conns"`
+	TotalConns           int32   `json:"total_conns"`
+	AcquiredConns        int32   `json:"acquired_conns"`
+	IdleConns            int32   `json:"idle_conns"`
+	ConstructingConns    int32   `json:"constructing_conns"`
+	AcquireCount         int64   `json:"acquire_count"`
+	EmptyAcquireCount    int64   `json:"empty_acquire_count"` // Acquires that had to wait for a connection
+	CanceledAcquireCount int64   `json:"canceled_acquire_count"`
+	AvgAcquireWaitMs     float64 `json:"avg_acquire_wait_ms"`
+	Utilization          float64 `json:"utilization"` // AcquiredConns / MaxConns
 }
 
 // NewDB creates a new database connection
@@ -38,14 +82,73 @@ func NewDB(cfg Config) (*DB, error) {
 		return nil, fmt.Errorf("unable to parse database config: %w", err)
 	}
 
-	// Configure connection pool
-	poolConfig.MaxConns = 25
-	poolConfig.MinConns = 5
-	poolConfig.MaxConnLifetime = time.Hour
-	poolConfig.MaxConnIdleTime = 30 * time.Minute
+	statementTimeout := applyPoolConfig(poolConfig, cfg)
+
+	pool, err := connectPool(poolConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Successfully connected to PostgreSQL database: %s (pool max=%d min=%d lifetime=%v idle=%v statement_timeout=%v)",
+		cfg.Database, poolConfig.MaxConns, poolConfig.MinConns, poolConfig.MaxConnLifetime, poolConfig.MaxConnIdleTime, statementTimeout)
+
+	db := &DB{Pool: pool}
+	for i, url := range cfg.ReplicaURLs {
+		replicaConfig, err := pgxpool.ParseConfig(url)
+		if err != nil {
+			log.Printf("Warning: skipping read replica %d: unable to parse config: %v", i+1, err)
+			continue
+		}
+		applyPoolConfig(replicaConfig, cfg)
+
+		replicaPool, err := connectPool(replicaConfig)
+		if err != nil {
+			log.Printf("Warning: skipping read replica %d (%s): %v", i+1, replicaConfig.ConnConfig.Host, err)
+			continue
+		}
+		log.Printf("Connected to PostgreSQL read replica %d: %s/%s", i+1, replicaConfig.ConnConfig.Host, replicaConfig.ConnConfig.Database)
+		db.replicas = append(db.replicas, &DB{Pool: replicaPool})
+	}
+
+	return db, nil
+}
+
+// applyPoolConfig applies the pool tuning and statement timeout from cfg,
+// returning the effective statement timeout
+func applyPoolConfig(poolConfig *pgxpool.Config, cfg Config) time.Duration {
+	poolConfig.MaxConns = DefaultMaxConns
+	if cfg.MaxConns > 0 {
+		poolConfig.MaxConns = cfg.MaxConns
+	}
+	poolConfig.MinConns = DefaultMinConns
+	if cfg.MinConns > 0 {
+		poolConfig.MinConns = cfg.MinConns
+	}
+	if poolConfig.MinConns > poolConfig.MaxConns {
+		poolConfig.MinConns = poolConfig.MaxConns
+	}
+	poolConfig.MaxConnLifetime = DefaultMaxConnLifetime
+	if cfg.MaxConnLifetime > 0 {
+		poolConfig.MaxConnLifetime = cfg.MaxConnLifetime
+	}
+	poolConfig.MaxConnIdleTime = DefaultMaxConnIdleTime
+	if cfg.MaxConnIdleTime > 0 {
+		poolConfig.MaxConnIdleTime = cfg.MaxConnIdleTime
+	}
 	poolConfig.HealthCheckPeriod = time.Minute
 
-	// Create connection pool
+	statementTimeout := cfg.StatementTimeout
+	if statementTimeout == 0 {
+		statementTimeout = DefaultStatementTimeout
+	}
+	if statementTimeout > 0 {
+		poolConfig.ConnConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(statementTimeout.Milliseconds(), 10)
+	}
+	return statementTimeout
+}
+
+// connectPool creates a pool and verifies it with a ping
+func connectPool(poolConfig *pgxpool.Config) (*pgxpool.Pool, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -54,24 +157,65 @@ func NewDB(cfg Config) (*DB, error) {
 		return nil, fmt.Errorf("unable to create connection pool: %w", err)
 	}
 
-	// Test connection
 	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
 		return nil, fmt.Errorf("unable to ping database: %w", err)
 	}
+	return pool, nil
+}
 
-	log.Printf("Successfully connected to PostgreSQL database: %s", cfg.Database)
+// ReadReplica returns a replica-backed DB for read-only queries, or the primary
+// when no replica is configured. Replicas may lag the primary slightly, so
+// read-your-own-write paths must keep using the primary.
+func (db *DB) ReadReplica() *DB {
+	if len(db.replicas) == 0 {
+		return db
+	}
+	n := db.replicaNext.Add(1)
+	return db.replicas[int(n-1)%len(db.replicas)]
+}
 
-	return &DB{Pool: pool}, nil
+// ReplicaCount returns the number of connected read replicas
+func (db *DB) ReplicaCount() int {
+	return len(db.replicas)
 }
 
 // Close closes the database connection
 func (db *DB) Close() {
+	for _, replica := range db.replicas {
+		replica.Pool.Close()
+	}
 	if db.Pool != nil {
 		db.Pool.Close()
 		log.Println("Database connection closed")
 	}
 }
 
+// PoolStats returns current connection pool usage
+func (db *DB) PoolStats() PoolStats {
+	if db.Pool == nil {
+		return PoolStats{}
+	}
+	stat := db.Pool.Stat()
+	stats := PoolStats{
+		MaxConns:             stat.MaxConns(),
+		TotalConns:           stat.TotalConns(),
+		AcquiredConns:        stat.AcquiredConns(),
+		IdleConns:            stat.IdleConns(),
+		ConstructingConns:    stat.ConstructingConns(),
+		AcquireCount:         stat.AcquireCount(),
+		EmptyAcquireCount:    stat.EmptyAcquireCount(),
+		CanceledAcquireCount: stat.CanceledAcquireCount(),
+	}
+	if stats.AcquireCount > 0 {
+		stats.AvgAcquireWaitMs = float64(stat.AcquireDuration().Microseconds()) / 1000 / float64(stats.AcquireCount)
+	}
+	if stats.MaxConns > 0 {
+		stats.Utilization = float64(stats.AcquiredConns) / float64(stats.MaxConns)
+	}
+	return stats
+}
+
 // RunMigrations executes database migrations
 func (db *DB) RunMigrations(ctx context.Context) error {
 	log.Println("Running database migrations...")
@@ -161,6 +305,9 @@ func (db *DB) RunMigrations(ctx context.Context) error {
 		`CREATE INDEX IF NOT EXISTS idx_strategy_configs_symbol ON strategy_configs(symbol)`,
 		`CREATE INDEX IF NOT EXISTS idx_strategy_configs_enabled ON strategy_configs(enabled)`,
 
+		// Add schedule column to strategy_configs (trading hours/days, NULL = always on)
+		`ALTER TABLE strategy_configs ADD COLUMN IF NOT EXISTS schedule JSONB`,
+
 		// Create pending_signals table for manual confirmation
 		`CREATE TABLE IF NOT EXISTS pending_signals (
 			id SERIAL PRIMARY KEY,
@@ -0,0 +1,56 @@
+package database
+
+import (
+	"context"
+	"log"
+)
+
+// RunAuditLogMigration creates the append-only audit_log table for live trading actions
+func (db *DB) RunAuditLogMigration(ctx context.Context) error {
+	log.Println("Running audit log database migrations...")
+
+	migrations := []string{
+		`CREATE TABLE IF NOT EXISTS audit_log (
+			id BIGSERIAL PRIMARY KEY,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+			-- What happened and who did it
+			action VARCHAR(50) NOT NULL,
+			actor VARCHAR(100) NOT NULL,
+			symbol VARCHAR(20),
+
+			-- Request parameters and exchange response
+			details JSONB,
+
+			-- Outcome: "success" or "error"
+			result VARCHAR(20) NOT NULL,
+			error TEXT
+		)`,
+
+		`CREATE INDEX IF NOT EXISTS idx_audit_log_created_at ON audit_log(created_at DESC)`,
+		`CREATE INDEX IF NOT EXISTS idx_audit_log_actor_time ON audit_log(actor, created_at DESC)`,
+		`CREATE INDEX IF NOT EXISTS idx_audit_log_action ON audit_log(action)`,
+		`CREATE INDEX IF NOT EXISTS idx_audit_log_symbol ON audit_log(symbol)`,
+
+		// Rows are immutable: reject any UPDATE or DELETE at the database level
+		`CREATE OR REPLACE FUNCTION audit_log_immutable() RETURNS trigger AS $$
+		BEGIN
+			RAISE EXCEPTION 'audit_log is append-only';
+		END;
+		$$ LANGUAGE plpgsql`,
+		`DROP TRIGGER IF EXISTS trg_audit_log_immutable ON audit_log`,
+		`CREATE TRIGGER trg_audit_log_immutable
+			BEFORE UPDATE OR DELETE ON audit_log
+			FOR EACH ROW EXECUTE FUNCTION audit_log_immutable()`,
+	}
+
+	for i, migration := range migrations {
+		if _, err := db.Pool.Exec(ctx, migration); err != nil {
+			log.Printf("Audit log migration %d failed: %v", i+1, err)
+			continue
+		}
+	}
+
+	log.Println("Audit log database migrations completed")
+	return nil
+}
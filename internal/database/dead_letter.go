@@ -0,0 +1,352 @@
+package database
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Dead-letter record kinds
+const (
+	DeadLetterSystemEvent = "system_event"
+	DeadLetterSignal      = "signal"
+	DeadLetterOrder       = "order"
+)
+
+const (
+	// DefaultDeadLetterFile is under logs/, which production mounts as a volume
+	DefaultDeadLetterFile = "logs/failed_events.jsonl"
+	// DefaultDeadLetterMaxEntries bounds the queue; the oldest records are dropped beyond it
+	DefaultDeadLetterMaxEntries = 10000
+
+	deadLetterBaseBackoff = 5 * time.Second
+	deadLetterMaxBackoff  = 10 * time.Minute
+	deadLetterPollPeriod  = 5 * time.Second
+)
+
+// errUnreplayable marks a dead letter that can never be written (unknown kind
+// or undecodable record); it is dropped instead of blocking the queue
+var errUnreplayable = errors.New("unreplayable dead letter")
+
+// DeadLetter is a record whose insert failed, waiting to be retried
+type DeadLetter struct {
+	ID          int64           `json:"id"`
+	Kind        string          `json:"kind"`
+	Record      json.RawMessage `json:"record"`
+	Attempts    int             `json:"attempts"`
+	LastError   string          `json:"last_error"`
+	FailedAt    time.Time       `json:"failed_at"`
+	NextAttempt time.Time       `json:"next_attempt"`
+}
+
+// DeadLetterStats reports the queue for the metrics endpoint
+type DeadLetterStats struct {
+	Depth     int            `json:"depth"`
+	ByKind    map[string]int `json:"by_kind"`
+	Replayed  int64          `json:"replayed"`
+	Dropped   int64          `json:"dropped"`
+	OldestAt  *time.Time     `json:"oldest_at,omitempty"`
+	LastError string         `json:"last_error,omitempty"`
+}
+
+// DeadLetterQueue keeps system events, signals and orders that failed to
+// persist in a local file (the database is usually what is down) and retries
+// them with exponential backoff until they are written.
+type DeadLetterQueue struct {
+	mu         sync.Mutex
+	path       string
+	maxEntries int
+	entries    []*DeadLetter
+	nextID     int64
+	replayed   int64
+	dropped    int64
+
+	// insert writes one record (the repository's Create* methods; replaced in tests)
+	insert func(ctx context.Context, kind string, record json.RawMessage) error
+}
+
+// NewDeadLetterQueue opens the queue file at path, loading any records left
+// from a previous run
+func NewDeadLetterQueue(repo *Repository, path string, maxEntries int) (*DeadLetterQueue, error) {
+	if path == "" {
+		path = DefaultDeadLetterFile
+	}
+	if maxEntries <= 0 {
+		maxEntries = DefaultDeadLetterMaxEntries
+	}
+	q := &DeadLetterQueue{
+		path:       path,
+		maxEntries: maxEntries,
+		insert: func(ctx context.Context, kind string, record json.RawMessage) error {
+			return repo.insertDeadLetter(ctx, kind, record)
+		},
+	}
+	if err := q.load(); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// Add queues a record whose insert failed with cause
+func (q *DeadLetterQueue) Add(kind string, record interface{}, cause error) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal dead letter: %w", err)
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := time.Now()
+	q.nextID++
+	entry := &DeadLetter{
+		ID:          q.nextID,
+		Kind:        kind,
+		Record:      data,
+		FailedAt:    now,
+		NextAttempt: now.Add(deadLetterBaseBackoff),
+	}
+	if cause != nil {
+		entry.LastError = cause.Error()
+	}
+	q.entries = append(q.entries, entry)
+	if over := len(q.entries) - q.maxEntries; over > 0 {
+		q.entries = q.entries[over:]
+		q.dropped += int64(over)
+		log.Printf("[DLQ] Queue full, dropped %d oldest record(s)", over)
+	}
+	return q.saveLocked()
+}
+
+// Depth returns the number of records waiting to be retried
+func (q *DeadLetterQueue) Depth() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.entries)
+}
+
+// Stats returns the queue depth and counters
+func (q *DeadLetterQueue) Stats() DeadLetterStats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	stats := DeadLetterStats{
+		Depth:    len(q.entries),
+		ByKind:   make(map[string]int),
+		Replayed: q.replayed,
+		Dropped:  q.dropped,
+	}
+	for _, e := range q.entries {
+		stats.ByKind[e.Kind]++
+	}
+	if len(q.entries) > 0 {
+		oldest := q.entries[0].FailedAt
+		stats.OldestAt = &oldest
+		stats.LastError = q.entries[len(q.entries)-1].LastError
+	}
+	return stats
+}
+
+// Start retries due records until ctx is cancelled
+func (q *DeadLetterQueue) Start(ctx context.Context) {
+	ticker := time.NewTicker(deadLetterPollPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			q.RetryDue(ctx, time.Now())
+		}
+	}
+}
+
+// RetryDue retries every record whose backoff has elapsed and returns how
+// many were written. Retrying stops at the first failure, since the database
+// is most likely still unavailable.
+func (q *DeadLetterQueue) RetryDue(ctx context.Context, now time.Time) int {
+	q.mu.Lock()
+	due := make([]*DeadLetter, 0)
+	for _, e := range q.entries {
+		if !e.NextAttempt.After(now) {
+			due = append(due, e)
+		}
+	}
+	q.mu.Unlock()
+
+	written := make(map[int64]bool)
+	discarded := make(map[int64]bool)
+	var failed *DeadLetter
+	var failErr error
+	for _, e := range due {
+		insertCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		err := q.insert(insertCtx, e.Kind, e.Record)
+		cancel()
+		if errors.Is(err, errUnreplayable) {
+			log.Printf("[DLQ] Discarding %s record %d: %v", e.Kind, e.ID, err)
+			discarded[e.ID] = true
+			continue
+		}
+		if err != nil && !isUniqueViolation(err) { // A duplicate means an earlier attempt did commit
+			failed, failErr = e, err
+			break
+		}
+		written[e.ID] = true
+	}
+	if len(written) == 0 && len(discarded) == 0 && failed == nil {
+		return 0
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	kept := q.entries[:0]
+	for _, e := range q.entries {
+		if !written[e.ID] && !discarded[e.ID] {
+			kept = append(kept, e)
+		}
+	}
+	q.entries = kept
+	q.replayed += int64(len(written))
+	q.dropped += int64(len(discarded))
+
+	if failed != nil {
+		// Back off every due record that was not written, not just the one tried
+		for _, e := range due {
+			if written[e.ID] || discarded[e.ID] {
+				continue
+			}
+			e.Attempts++
+			e.NextAttempt = now.Add(deadLetterBackoff(e.Attempts))
+		}
+		failed.LastError = failErr.Error()
+		log.Printf("[DLQ] Retry of %s record failed (attempt %d, %d queued): %v", failed.Kind, failed.Attempts, len(q.entries), failErr)
+	}
+	if len(written) > 0 {
+		log.Printf("[DLQ] Replayed %d record(s), %d still queued", len(written), len(q.entries))
+	}
+	if err := q.saveLocked(); err != nil {
+		log.Printf("[DLQ] Failed to save queue: %v", err)
+	}
+	return len(written)
+}
+
+// deadLetterBackoff doubles from the base per attempt, capped at the max
+func deadLetterBackoff(attempts int) time.Duration {
+	backoff := deadLetterBaseBackoff
+	for i := 0; i < attempts && backoff < deadLetterMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > deadLetterMaxBackoff {
+		backoff = deadLetterMaxBackoff
+	}
+	return backoff
+}
+
+// load reads the queue file, skipping lines that cannot be parsed
+func (q *DeadLetterQueue) load() error {
+	file, err := os.Open(q.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open dead letter file: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		var entry DeadLetter
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			log.Printf("[DLQ] Skipping unreadable dead letter: %v", err)
+			continue
+		}
+		q.entries = append(q.entries, &entry)
+		if entry.ID > q.nextID {
+			q.nextID = entry.ID
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read dead letter file: %w", err)
+	}
+	if len(q.entries) > 0 {
+		log.Printf("[DLQ] Loaded %d unpersisted record(s) from %s", len(q.entries), q.path)
+	}
+	return nil
+}
+
+// saveLocked rewrites the queue file atomically. Caller must hold q.mu.
+func (q *DeadLetterQueue) saveLocked() error {
+	if err := os.MkdirAll(filepath.Dir(q.path), 0755); err != nil {
+		return fmt.Errorf("failed to create dead letter directory: %w", err)
+	}
+
+	tmpFile := q.path + ".tmp"
+	file, err := os.Create(tmpFile)
+	if err != nil {
+		return fmt.Errorf("failed to write dead letter file: %w", err)
+	}
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	for _, e := range q.entries {
+		if err := encoder.Encode(e); err != nil {
+			file.Close()
+			return fmt.Errorf("failed to encode dead letter: %w", err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write dead letter file: %w", err)
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to sync dead letter file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write dead letter file: %w", err)
+	}
+	return os.Rename(tmpFile, q.path)
+}
+
+// isUniqueViolation reports whether err is a Postgres unique constraint violation
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+// insertDeadLetter replays one dead letter through the matching Create* method
+func (r *Repository) insertDeadLetter(ctx context.Context, kind string, record json.RawMessage) error {
+	switch kind {
+	case DeadLetterSystemEvent:
+		var event SystemEvent
+		if err := json.Unmarshal(record, &event); err != nil {
+			return fmt.Errorf("%w: failed to decode system event: %v", errUnreplayable, err)
+		}
+		return r.CreateSystemEvent(ctx, &event)
+	case DeadLetterSignal:
+		var signal Signal
+		if err := json.Unmarshal(record, &signal); err != nil {
+			return fmt.Errorf("%w: failed to decode signal: %v", errUnreplayable, err)
+		}
+		return r.CreateSignal(ctx, &signal)
+	case DeadLetterOrder:
+		var order Order
+		if err := json.Unmarshal(record, &order); err != nil {
+			return fmt.Errorf("%w: failed to decode order: %v", errUnreplayable, err)
+		}
+		return r.CreateOrder(ctx, &order)
+	default:
+		return fmt.Errorf("%w: unknown kind %q", errUnreplayable, kind)
+	}
+}
@@ -0,0 +1,139 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// fakeInserter records inserts and fails while down is set
+type fakeInserter struct {
+	down     bool
+	inserted []string
+}
+
+func (f *fakeInserter) insert(_ context.Context, kind string, record json.RawMessage) error {
+	if f.down {
+		return errors.New("connection refused")
+	}
+	f.inserted = append(f.inserted, kind)
+	return nil
+}
+
+func newTestDeadLetterQueue(t *testing.T, path string, max int) (*DeadLetterQueue, *fakeInserter) {
+	t.Helper()
+	q, err := NewDeadLetterQueue(nil, path, max)
+	if err != nil {
+		t.Fatalf("NewDeadLetterQueue: %v", err)
+	}
+	f := &fakeInserter{}
+	q.insert = f.insert
+	return q, f
+}
+
+func TestDeadLetterQueueSurvivesRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dlq", "failed.jsonl")
+	q, _ := newTestDeadLetterQueue(t, path, 0)
+
+	price := 101.5
+	if err := q.Add(DeadLetterOrder, &Order{ID: 7, Symbol: "BTCUSDT", Price: &price}, errors.New("db down")); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := q.Add(DeadLetterSignal, &Signal{Symbol: "ETHUSDT"}, errors.New("db down")); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	reopened, f := newTestDeadLetterQueue(t, path, 0)
+	if got := reopened.Depth(); got != 2 {
+		t.Fatalf("depth after reopen = %d, want 2", got)
+	}
+
+	if n := reopened.RetryDue(context.Background(), time.Now().Add(time.Minute)); n != 2 {
+		t.Fatalf("replayed %d, want 2", n)
+	}
+	if len(f.inserted) != 2 || f.inserted[0] != DeadLetterOrder || f.inserted[1] != DeadLetterSignal {
+		t.Errorf("inserted = %v, want order then signal", f.inserted)
+	}
+
+	again, _ := newTestDeadLetterQueue(t, path, 0)
+	if got := again.Depth(); got != 0 {
+		t.Errorf("depth after replay and reopen = %d, want 0", got)
+	}
+}
+
+func TestDeadLetterQueueBacksOffWhileDown(t *testing.T) {
+	q, f := newTestDeadLetterQueue(t, filepath.Join(t.TempDir(), "failed.jsonl"), 0)
+	q.Add(DeadLetterSystemEvent, &SystemEvent{EventType: "TRADE_CLOSED"}, errors.New("db down"))
+
+	now := time.Now()
+	if n := q.RetryDue(context.Background(), now); n != 0 {
+		t.Fatalf("retried before first backoff elapsed")
+	}
+
+	f.down = true
+	now = now.Add(deadLetterBaseBackoff)
+	q.RetryDue(context.Background(), now)
+	entry := q.entries[0]
+	if entry.Attempts != 1 || entry.LastError != "connection refused" {
+		t.Fatalf("after failed retry: attempts=%d last_error=%q", entry.Attempts, entry.LastError)
+	}
+	if want := now.Add(2 * deadLetterBaseBackoff); !entry.NextAttempt.Equal(want) {
+		t.Errorf("next attempt = %v, want %v", entry.NextAttempt, want)
+	}
+
+	f.down = false
+	if n := q.RetryDue(context.Background(), now.Add(deadLetterBaseBackoff)); n != 0 {
+		t.Error("retried before the doubled backoff elapsed")
+	}
+	if n := q.RetryDue(context.Background(), now.Add(2*deadLetterBaseBackoff)); n != 1 {
+		t.Errorf("replayed %d after recovery, want 1", n)
+	}
+	if stats := q.Stats(); stats.Depth != 0 || stats.Replayed != 1 {
+		t.Errorf("stats = %+v", stats)
+	}
+}
+
+func TestDeadLetterQueueDropsOldestWhenFull(t *testing.T) {
+	q, _ := newTestDeadLetterQueue(t, filepath.Join(t.TempDir(), "failed.jsonl"), 2)
+	for i := 1; i <= 3; i++ {
+		q.Add(DeadLetterOrder, &Order{ID: int64(i)}, nil)
+	}
+
+	stats := q.Stats()
+	if stats.Depth != 2 || stats.Dropped != 1 {
+		t.Fatalf("stats = %+v, want depth 2 dropped 1", stats)
+	}
+	var first Order
+	json.Unmarshal(q.entries[0].Record, &first)
+	if first.ID != 2 {
+		t.Errorf("oldest remaining order = %d, want 2", first.ID)
+	}
+}
+
+func TestDeadLetterQueueSkipsUnreplayableAndDuplicates(t *testing.T) {
+	q, _ := newTestDeadLetterQueue(t, filepath.Join(t.TempDir(), "failed.jsonl"), 0)
+	q.insert = func(_ context.Context, kind string, _ json.RawMessage) error {
+		switch kind {
+		case "bogus":
+			return fmt.Errorf("%w: unknown kind", errUnreplayable)
+		case DeadLetterOrder:
+			return &pgconn.PgError{Code: "23505"}
+		}
+		return nil
+	}
+	q.Add("bogus", map[string]string{}, nil)
+	q.Add(DeadLetterOrder, &Order{ID: 1}, nil)
+
+	if n := q.RetryDue(context.Background(), time.Now().Add(time.Minute)); n != 1 {
+		t.Errorf("replayed %d, want 1 (duplicate order counts as written)", n)
+	}
+	if stats := q.Stats(); stats.Depth != 0 || stats.Dropped != 1 {
+		t.Errorf("stats = %+v, want empty queue with 1 dropped", stats)
+	}
+}
@@ -0,0 +1,172 @@
+package database
+
+import "context"
+
+// Migrations is the ordered schema history applied by MigrateUp.
+//
+// Versions 1-16 are the original bootstrap steps, kept as repeatable wrappers
+// around the idempotent Run*/Migrate* functions in their historical order.
+// New schema changes are appended as SQL migrations with a DownSQL and must
+// never be edited once released - add another version instead.
+var Migrations = []Migration{
+	legacyMigration(1, "core_schema", MigrationGroupCore, (*DB).RunMigrations),
+	legacyMigration(2, "ai_decisions", MigrationGroupCore, (*DB).RunAIMigrations),
+	legacyMigration(3, "trade_ai_link", MigrationGroupCore, (*DB).RunTradeAILinkMigration),
+	legacyMigration(4, "futures", MigrationGroupFutures, (*DB).RunFuturesMigrations),
+	legacyMigration(5, "trade_lifecycle", MigrationGroupFutures, (*DB).RunTradeLifecycleMigrations),
+	legacyMigration(6, "symbol_requirements", MigrationGroupFutures, (*DB).RunSymbolRequirementsMigration),
+	legacyMigration(7, "multi_tenant", MigrationGroupMultiTenant, (*DB).RunMultiTenantMigrations),
+	legacyMigration(8, "scan_source", MigrationGroupMultiTenant, (*DB).RunScanSourceMigrations),
+	legacyMigration(9, "global_circuit_breaker", MigrationGroupMultiTenant, (*DB).RunGlobalCircuitBreakerMigration),
+	legacyMigration(10, "user_mode_configs", MigrationGroupMultiTenant, (*DB).MigrateUserModeConfigs),
+	legacyMigration(11, "remove_scalp_reentry_mode", MigrationGroupMultiTenant, (*DB).MigrateRemoveScalpReentryMode),
+	legacyMigration(12, "user_settings", MigrationGroupMultiTenant, (*DB).RunUserSettingsMigrations),
+	legacyMigration(13, "early_warning_extended_fields", MigrationGroupMultiTenant, (*DB).MigrateEarlyWarningExtendedFields),
+	legacyMigration(14, "mode_config_early_warning", MigrationGroupMultiTenant, (*DB).MigrateModeConfigEarlyWarning),
+	legacyMigration(15, "user_safety_settings", MigrationGroupMultiTenant, (*DB).RunUserSafetySettingsMigration),
+	legacyMigration(16, "audit_log", MigrationGroupCore, (*DB).RunAuditLogMigration),
+	{
+		Version: 17,
+		Name:    "futures_trade_excursions",
+		Group:   MigrationGroupFutures,
+		UpSQL: `ALTER TABLE futures_trades ADD COLUMN IF NOT EXISTS max_adverse_excursion DECIMAL(10, 4);
+ALTER TABLE futures_trades ADD COLUMN IF NOT EXISTS max_favorable_excursion DECIMAL(10, 4);`,
+		DownSQL: `ALTER TABLE futures_trades DROP COLUMN IF EXISTS max_favorable_excursion;
+ALTER TABLE futures_trades DROP COLUMN IF EXISTS max_adverse_excursion;`,
+	},
+	{
+		Version: 18,
+		Name:    "user_drawdown_guard",
+		Group:   MigrationGroupMultiTenant,
+		UpSQL: `CREATE TABLE IF NOT EXISTS user_drawdown_guard (
+	user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+	high_water_mark DECIMAL(20,8) NOT NULL DEFAULT 0,
+	tripped BOOLEAN NOT NULL DEFAULT FALSE,
+	tripped_at TIMESTAMPTZ,
+	trip_reason TEXT NOT NULL DEFAULT '',
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);`,
+		DownSQL: `DROP TABLE IF EXISTS user_drawdown_guard;`,
+	},
+	{
+		Version: 19,
+		Name:    "trade_journal",
+		Group:   MigrationGroupCore,
+		UpSQL: `ALTER TABLE trades ADD COLUMN IF NOT EXISTS notes TEXT;
+ALTER TABLE trades ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE futures_trades ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+CREATE INDEX IF NOT EXISTS idx_trades_tags ON trades USING GIN (tags);
+CREATE INDEX IF NOT EXISTS idx_futures_trades_tags ON futures_trades USING GIN (tags);`,
+		DownSQL: `DROP INDEX IF EXISTS idx_futures_trades_tags;
+DROP INDEX IF EXISTS idx_trades_tags;
+ALTER TABLE futures_trades DROP COLUMN IF EXISTS tags;
+ALTER TABLE trades DROP COLUMN IF EXISTS tags;
+ALTER TABLE trades DROP COLUMN IF EXISTS notes;`,
+	},
+	{
+		Version: 20,
+		Name:    "signal_confirmation_status",
+		Group:   MigrationGroupCore,
+		UpSQL:   `ALTER TABLE signals ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'confirmed';`,
+		DownSQL: `ALTER TABLE signals DROP COLUMN IF EXISTS status;`,
+	},
+	{
+		Version: 21,
+		Name:    "user_licenses",
+		Group:   MigrationGroupMultiTenant,
+		UpSQL: `CREATE TABLE IF NOT EXISTS user_licenses (
+	user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+	license_key VARCHAR(20) UNIQUE NOT NULL,
+	license_type VARCHAR(20) NOT NULL,
+	max_symbols INTEGER NOT NULL DEFAULT 0,
+	features JSONB NOT NULL DEFAULT '[]',
+	valid_until TIMESTAMPTZ,
+	activated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);`,
+		DownSQL: `DROP TABLE IF EXISTS user_licenses;`,
+	},
+	{
+		Version: 22,
+		Name:    "license_fingerprints",
+		Group:   MigrationGroupCore,
+		UpSQL: `CREATE TABLE IF NOT EXISTS license_fingerprints (
+	license_key VARCHAR(20) NOT NULL,
+	fingerprint VARCHAR(64) NOT NULL,
+	first_seen TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	last_seen TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (license_key, fingerprint)
+);`,
+		DownSQL: `DROP TABLE IF EXISTS license_fingerprints;`,
+	},
+	{
+		Version: 23,
+		Name:    "settings_snapshots",
+		Group:   MigrationGroupMultiTenant,
+		UpSQL: `CREATE TABLE IF NOT EXISTS settings_snapshots (
+	id BIGSERIAL PRIMARY KEY,
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	label VARCHAR(200) NOT NULL DEFAULT '',
+	source VARCHAR(20) NOT NULL DEFAULT 'manual',
+	data JSONB NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_settings_snapshots_user_created ON settings_snapshots(user_id, created_at DESC);`,
+		DownSQL: `DROP TABLE IF EXISTS settings_snapshots;`,
+	},
+	{
+		Version: 24,
+		Name:    "trade_explanations",
+		Group:   MigrationGroupCore,
+		UpSQL: `ALTER TABLE trades ADD COLUMN IF NOT EXISTS llm_explanation JSONB;
+ALTER TABLE trades ADD COLUMN IF NOT EXISTS llm_explained_at TIMESTAMPTZ;
+ALTER TABLE futures_trades ADD COLUMN IF NOT EXISTS llm_explanation JSONB;
+ALTER TABLE futures_trades ADD COLUMN IF NOT EXISTS llm_explained_at TIMESTAMPTZ;`,
+		DownSQL: `ALTER TABLE futures_trades DROP COLUMN IF EXISTS llm_explained_at;
+ALTER TABLE futures_trades DROP COLUMN IF EXISTS llm_explanation;
+ALTER TABLE trades DROP COLUMN IF EXISTS llm_explained_at;
+ALTER TABLE trades DROP COLUMN IF EXISTS llm_explanation;`,
+	},
+	{
+		Version: 25,
+		Name:    "market_kline_snapshots",
+		Group:   MigrationGroupCore,
+		UpSQL: `CREATE TABLE IF NOT EXISTS market_kline_snapshots (
+	symbol VARCHAR(20) NOT NULL,
+	timeframe VARCHAR(10) NOT NULL,
+	open_time BIGINT NOT NULL,
+	open DECIMAL(20, 8) NOT NULL,
+	high DECIMAL(20, 8) NOT NULL,
+	low DECIMAL(20, 8) NOT NULL,
+	close DECIMAL(20, 8) NOT NULL,
+	volume DECIMAL(30, 8) NOT NULL DEFAULT 0,
+	close_time BIGINT NOT NULL,
+	quote_volume DECIMAL(30, 8) NOT NULL DEFAULT 0,
+	trades INTEGER NOT NULL DEFAULT 0,
+	taker_buy_volume DECIMAL(30, 8) NOT NULL DEFAULT 0,
+	taker_buy_quote_volume DECIMAL(30, 8) NOT NULL DEFAULT 0,
+	recorded_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (symbol, timeframe, open_time)
+);`,
+		DownSQL: `DROP TABLE IF EXISTS market_kline_snapshots;`,
+	},
+	{
+		Version: 26,
+		Name:    "user_drawdown_guard_transfers",
+		Group:   MigrationGroupMultiTenant,
+		UpSQL:   `ALTER TABLE user_drawdown_guard ADD COLUMN IF NOT EXISTS transfers_checked_at TIMESTAMPTZ;`,
+		DownSQL: `ALTER TABLE user_drawdown_guard DROP COLUMN IF EXISTS transfers_checked_at;`,
+	},
+}
+
+func legacyMigration(version int, name, group string, run func(*DB, context.Context) error) Migration {
+	return Migration{
+		Version:    version,
+		Name:       name,
+		Group:      group,
+		Repeatable: true,
+		Up: func(ctx context.Context, db *DB) error {
+			return run(db, ctx)
+		},
+	}
+}
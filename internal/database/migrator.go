@@ -0,0 +1,286 @@
+package database
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+)
+
+// Migration groups gate steps on the features a deployment runs with
+const (
+	MigrationGroupCore        = "core"
+	MigrationGroupFutures     = "futures"
+	MigrationGroupMultiTenant = "multi_tenant"
+)
+
+// migrationLockID is the pg_advisory_lock key serialising migrators across
+// instances (dev and prod can share a database)
+const migrationLockID = 727274001
+
+// Migration is one ordered schema step.
+//
+// SQL migrations (UpSQL/DownSQL) run once inside a transaction and are
+// checksummed so an edit after they were applied is reported as drift.
+// Legacy bootstrap steps (Up) wrap the original idempotent Run* functions and
+// are Repeatable: they run on every MigrateUp as they did before versioning.
+type Migration struct {
+	Version    int
+	Name       string
+	Group      string
+	Repeatable bool
+	Up         func(ctx context.Context, db *DB) error
+	UpSQL      string
+	DownSQL    string
+}
+
+// Checksum identifies the migration body; SQL changes after apply are drift
+func (m Migration) Checksum() string {
+	body := m.UpSQL
+	if body == "" {
+		body = "func:" + m.Name
+	}
+	sum := sha256.Sum256([]byte(body))
+	return hex.EncodeToString(sum[:])
+}
+
+// MigrationState is the applied state of a registered migration
+type MigrationState struct {
+	Version    int        `json:"version"`
+	Name       string     `json:"name"`
+	Group      string     `json:"group"`
+	Repeatable bool       `json:"repeatable"`
+	Applied    bool       `json:"applied"`
+	AppliedAt  *time.Time `json:"applied_at,omitempty"`
+	Drifted    bool       `json:"drifted"`
+	Reversible bool       `json:"reversible"`
+}
+
+type appliedMigration struct {
+	name      string
+	checksum  string
+	appliedAt time.Time
+}
+
+// ensureMigrationsTable creates the schema_migrations tracking table
+func (db *DB) ensureMigrationsTable(ctx context.Context) error {
+	_, err := db.Pool.Exec(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		name VARCHAR(200) NOT NULL,
+		checksum VARCHAR(64) NOT NULL,
+		applied_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		execution_ms INTEGER NOT NULL DEFAULT 0
+	)`)
+	if err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	return nil
+}
+
+func (db *DB) appliedMigrations(ctx context.Context) (map[int]appliedMigration, error) {
+	rows, err := db.Pool.Query(ctx, `SELECT version, name, checksum, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int]appliedMigration)
+	for rows.Next() {
+		var version int
+		var a appliedMigration
+		if err := rows.Scan(&version, &a.name, &a.checksum, &a.appliedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan schema_migrations: %w", err)
+		}
+		applied[version] = a
+	}
+	return applied, rows.Err()
+}
+
+// withMigrationLock runs fn while holding the cross-instance migration lock
+func (db *DB) withMigrationLock(ctx context.Context, fn func() error) error {
+	conn, err := db.Pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection for migration lock: %w", err)
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_lock($1)`, migrationLockID); err != nil {
+		return fmt.Errorf("failed to take migration lock: %w", err)
+	}
+	defer func() {
+		if _, err := conn.Exec(context.Background(), `SELECT pg_advisory_unlock($1)`, migrationLockID); err != nil {
+			log.Printf("[MIGRATE] Failed to release migration lock: %v", err)
+		}
+	}()
+
+	if err := db.ensureMigrationsTable(ctx); err != nil {
+		return err
+	}
+	return fn()
+}
+
+func groupSet(groups []string) map[string]bool {
+	set := map[string]bool{MigrationGroupCore: true}
+	for _, g := range groups {
+		set[g] = true
+	}
+	return set
+}
+
+// MigrateUp applies pending migrations in version order for the core group plus
+// the given groups. It stops at the first failure, and refuses to run when an
+// applied SQL migration's checksum no longer matches (schema drift).
+func (db *DB) MigrateUp(ctx context.Context, groups ...string) (int, error) {
+	enabled := groupSet(groups)
+	ran := 0
+
+	err := db.withMigrationLock(ctx, func() error {
+		applied, err := db.appliedMigrations(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range Migrations {
+			if a, ok := applied[m.Version]; ok && !m.Repeatable && a.checksum != m.Checksum() {
+				return fmt.Errorf("migration %d (%s) has changed since it was applied (checksum drift); add a new migration instead of editing it",
+					m.Version, m.Name)
+			}
+		}
+
+		for _, m := range Migrations {
+			if !enabled[m.Group] {
+				continue
+			}
+			if _, ok := applied[m.Version]; ok && !m.Repeatable {
+				continue
+			}
+
+			start := time.Now()
+			if err := db.applyMigration(ctx, m); err != nil {
+				return fmt.Errorf("migration %d (%s) failed: %w", m.Version, m.Name, err)
+			}
+			elapsed := time.Since(start)
+
+			if _, err := db.Pool.Exec(ctx, `
+				INSERT INTO schema_migrations (version, name, checksum, applied_at, execution_ms)
+				VALUES ($1, $2, $3, CURRENT_TIMESTAMP, $4)
+				ON CONFLICT (version) DO UPDATE SET
+					name = EXCLUDED.name, checksum = EXCLUDED.checksum,
+					applied_at = EXCLUDED.applied_at, execution_ms = EXCLUDED.execution_ms`,
+				m.Version, m.Name, m.Checksum(), elapsed.Milliseconds()); err != nil {
+				return fmt.Errorf("failed to record migration %d: %w", m.Version, err)
+			}
+			ran++
+			if !m.Repeatable {
+				log.Printf("[MIGRATE] Applied %03d_%s in %v", m.Version, m.Name, elapsed.Round(time.Millisecond))
+			}
+		}
+		return nil
+	})
+	return ran, err
+}
+
+func (db *DB) applyMigration(ctx context.Context, m Migration) error {
+	if m.Up != nil {
+		return m.Up(ctx, db)
+	}
+
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, m.UpSQL); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// MigrateDown rolls back the n most recently applied versioned migrations.
+// Legacy bootstrap steps have no down migration and stop the rollback.
+func (db *DB) MigrateDown(ctx context.Context, n int) ([]int, error) {
+	byVersion := make(map[int]Migration, len(Migrations))
+	for _, m := range Migrations {
+		byVersion[m.Version] = m
+	}
+
+	var rolledBack []int
+	err := db.withMigrationLock(ctx, func() error {
+		applied, err := db.appliedMigrations(ctx)
+		if err != nil {
+			return err
+		}
+		versions := make([]int, 0, len(applied))
+		for v := range applied {
+			versions = append(versions, v)
+		}
+		sort.Sort(sort.Reverse(sort.IntSlice(versions)))
+
+		for _, v := range versions {
+			if len(rolledBack) >= n {
+				break
+			}
+			m, ok := byVersion[v]
+			if !ok {
+				return fmt.Errorf("migration %d (%s) is applied but not registered", v, applied[v].name)
+			}
+			if m.DownSQL == "" {
+				return fmt.Errorf("migration %d (%s) is not reversible", v, m.Name)
+			}
+
+			tx, err := db.Pool.Begin(ctx)
+			if err != nil {
+				return err
+			}
+			if _, err := tx.Exec(ctx, m.DownSQL); err != nil {
+				tx.Rollback(ctx)
+				return fmt.Errorf("rollback of migration %d (%s) failed: %w", v, m.Name, err)
+			}
+			if _, err := tx.Exec(ctx, `DELETE FROM schema_migrations WHERE version = $1`, v); err != nil {
+				tx.Rollback(ctx)
+				return fmt.Errorf("failed to unrecord migration %d: %w", v, err)
+			}
+			if err := tx.Commit(ctx); err != nil {
+				return err
+			}
+			log.Printf("[MIGRATE] Rolled back %03d_%s", m.Version, m.Name)
+			rolledBack = append(rolledBack, v)
+		}
+		return nil
+	})
+	return rolledBack, err
+}
+
+// MigrationStatus reports every registered migration and whether it is applied
+func (db *DB) MigrationStatus(ctx context.Context) ([]MigrationState, error) {
+	if err := db.ensureMigrationsTable(ctx); err != nil {
+		return nil, err
+	}
+	applied, err := db.appliedMigrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]MigrationState, 0, len(Migrations))
+	for _, m := range Migrations {
+		state := MigrationState{
+			Version:    m.Version,
+			Name:       m.Name,
+			Group:      m.Group,
+			Repeatable: m.Repeatable,
+			Reversible: m.DownSQL != "",
+		}
+		if a, ok := applied[m.Version]; ok {
+			appliedAt := a.appliedAt
+			state.Applied = true
+			state.AppliedAt = &appliedAt
+			state.Drifted = !m.Repeatable && a.checksum != m.Checksum()
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
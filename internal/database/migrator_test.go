@@ -0,0 +1,43 @@
+package database
+
+import "testing"
+
+// TestMigrationsRegistryOrdered guards the registry invariants MigrateUp relies on
+func TestMigrationsRegistryOrdered(t *testing.T) {
+	validGroups := map[string]bool{
+		MigrationGroupCore:        true,
+		MigrationGroupFutures:     true,
+		MigrationGroupMultiTenant: true,
+	}
+
+	prev := 0
+	for _, m := range Migrations {
+		if m.Version <= prev {
+			t.Errorf("migration %d (%s) is not in strictly increasing version order", m.Version, m.Name)
+		}
+		prev = m.Version
+
+		if !validGroups[m.Group] {
+			t.Errorf("migration %d (%s) has unknown group %q", m.Version, m.Name, m.Group)
+		}
+		if (m.Up == nil) == (m.UpSQL == "") {
+			t.Errorf("migration %d (%s) must set exactly one of Up or UpSQL", m.Version, m.Name)
+		}
+		if m.UpSQL != "" && m.Repeatable {
+			t.Errorf("SQL migration %d (%s) must not be repeatable", m.Version, m.Name)
+		}
+	}
+}
+
+func TestMigrationChecksumDetectsEdits(t *testing.T) {
+	a := Migration{Version: 100, Name: "x", UpSQL: "ALTER TABLE t ADD COLUMN a INT"}
+	b := a
+	b.UpSQL = "ALTER TABLE t ADD COLUMN a BIGINT"
+
+	if a.Checksum() == b.Checksum() {
+		t.Error("expected checksum to change when UpSQL changes")
+	}
+	if c := a; c.Checksum() != a.Checksum() {
+		t.Error("expected checksum to be stable")
+	}
+}
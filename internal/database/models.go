@@ -42,6 +42,9 @@ type Trade struct {
 	// Order IDs for TP/SL
 	TakeProfitOrderID   *int64   `json:"take_profit_order_id,omitempty"`
 	StopLossOrderID     *int64   `json:"stop_loss_order_id,omitempty"`
+	// Trade journal
+	Notes *string  `json:"notes,omitempty"`
+	Tags  []string `json:"tags,omitempty"`
 }
 
 // Order represents an order in the database
@@ -74,6 +77,7 @@ type Signal struct {
 	Reason       *string   `json:"reason,omitempty"`
 	Timestamp    time.Time `json:"timestamp"`
 	Executed     bool      `json:"executed"`
+	Status       string    `json:"status"` // provisional or confirmed
 	CreatedAt    time.Time `json:"created_at"`
 }
 
@@ -149,6 +153,7 @@ type StrategyConfig struct {
 	StopLossPercent   float64                `json:"stop_loss_percent"`
 	TakeProfitPercent float64                `json:"take_profit_percent"`
 	ConfigParams      map[string]interface{} `json:"config_params,omitempty"`
+	Schedule          *TradingSchedule       `json:"schedule,omitempty"` // Trading hours/days, nil = always on
 	CreatedAt         time.Time              `json:"created_at"`
 	UpdatedAt         time.Time              `json:"updated_at"`
 }
@@ -216,3 +221,26 @@ type AIDecision struct {
 	Executed            bool                   `json:"executed"`
 	CreatedAt           time.Time              `json:"created_at"`
 }
+
+// AuditLogEntry is an immutable record of a live trading action
+type AuditLogEntry struct {
+	ID        int64                  `json:"id"`
+	CreatedAt time.Time              `json:"created_at"`
+	Action    string                 `json:"action"`
+	Actor     string                 `json:"actor"`
+	Symbol    string                 `json:"symbol,omitempty"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+	Result    string                 `json:"result"`
+	Error     string                 `json:"error,omitempty"`
+}
+
+// AuditLogFilter narrows an audit log query; zero values mean "any"
+type AuditLogFilter struct {
+	Actor  string
+	Action string
+	Symbol string
+	Since  time.Time
+	Until  time.Time
+	Limit  int
+	Offset int
+}
@@ -36,6 +36,9 @@ type FuturesTrade struct {
 	StrategyName        *string    `json:"strategy_name,omitempty"`
 	TradingMode         *string    `json:"trading_mode,omitempty"` // ultra_fast, scalp, swing, position
 	HedgeModeActive     bool       `json:"hedge_mode_active,omitempty"`
+	MaxAdverseExcursion   *float64 `json:"max_adverse_excursion,omitempty"`   // MAE % from entry, set on close
+	MaxFavorableExcursion *float64 `json:"max_favorable_excursion,omitempty"` // MFE % from entry, set on close
+	Tags                  []string `json:"tags,omitempty"`                    // Trade journal tags (source, mode, strategy + user tags)
 	CreatedAt           time.Time  `json:"created_at"`
 	UpdatedAt           time.Time  `json:"updated_at"`
 }
@@ -120,6 +123,13 @@ type FuturesTradingMetrics struct {
 	OpenPositions     int        `json:"open_positions"`
 	OpenOrders        int        `json:"open_orders"`
 	LastTradeTime     *time.Time `json:"last_trade_time,omitempty"`
+
+	// Excursion averages over closed trades with MAE/MFE recorded (% from entry).
+	// High MFE on losers suggests stops/TPs too tight; deep MAE on winners, stops too loose.
+	AvgMAEPercent        float64 `json:"avg_mae_percent"`
+	AvgMFEPercent        float64 `json:"avg_mfe_percent"`
+	AvgMAEWinnersPercent float64 `json:"avg_mae_winners_percent"`
+	AvgMFELosersPercent  float64 `json:"avg_mfe_losers_percent"`
 }
 
 // ModeSafetyHistory tracks safety control events for modes
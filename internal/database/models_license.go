@@ -21,6 +21,22 @@ type License struct {
 	Notes         string    `json:"notes" db:"notes"`
 	CreatedAt     time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
+	RevokedAt     *time.Time `json:"revoked_at,omitempty" db:"revoked_at"`
+	RevokeReason  string     `json:"revoke_reason,omitempty" db:"revoke_reason"`
+}
+
+// Status returns the lifecycle state of an issued key: revoked, expired, inactive or active
+func (l *License) Status() string {
+	switch {
+	case l.RevokedAt != nil:
+		return "revoked"
+	case l.ExpiresAt != nil && time.Now().After(*l.ExpiresAt):
+		return "expired"
+	case !l.IsActive:
+		return "inactive"
+	default:
+		return "active"
+	}
 }
 
 // LicenseUsageLog tracks license usage/validation attempts
@@ -33,3 +49,16 @@ type LicenseUsageLog struct {
 	Message   string    `json:"message" db:"message"`
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 }
+
+// UserLicense is a license key bound to a user account. A key can be bound to
+// one user at a time; deactivating releases it and a transfer rebinds it.
+type UserLicense struct {
+	UserID      string     `json:"user_id" db:"user_id"`
+	Key         string     `json:"key" db:"license_key"`
+	Type        string     `json:"type" db:"license_type"`
+	MaxSymbols  int        `json:"max_symbols" db:"max_symbols"`
+	Features    string     `json:"features" db:"features"` // JSON array
+	ValidUntil  *time.Time `json:"valid_until" db:"valid_until"`
+	ActivatedAt time.Time  `json:"activated_at" db:"activated_at"`
+	UpdatedAt   time.Time  `json:"updated_at" db:"updated_at"`
+}
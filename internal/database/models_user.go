@@ -205,6 +205,20 @@ type UserBalanceSnapshot struct {
 	CreatedAt        time.Time    `json:"created_at"`
 }
 
+// UserDrawdownGuard is the persisted state of the account drawdown kill switch:
+// the equity high-water mark and whether the switch has tripped
+type UserDrawdownGuard struct {
+	UserID        string     `json:"user_id"`
+	HighWaterMark float64    `json:"high_water_mark"`
+	Tripped       bool       `json:"tripped"`
+	TrippedAt     *time.Time `json:"tripped_at,omitempty"`
+	TripReason    string     `json:"trip_reason,omitempty"`
+	// TransfersCheckedAt is the end of the last wallet transfer lookup; the
+	// high-water mark already reflects every transfer up to this time
+	TransfersCheckedAt *time.Time `json:"transfers_checked_at,omitempty"`
+	UpdatedAt          time.Time  `json:"updated_at"`
+}
+
 // AdjustmentType for balance adjustments
 type AdjustmentType string
 
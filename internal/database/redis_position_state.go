@@ -44,6 +44,13 @@ type PersistedPositionState struct {
 	CurrentTPLevel int                    `json:"current_tp_level"`
 	ScalpReentry   *ScalpReentryStateData `json:"scalp_reentry,omitempty"` // Full scalp_reentry state
 	SavedAt        time.Time              `json:"saved_at"`
+
+	// Origin tags (source, strategy, entry confidence and reason) for the UI
+	Source       string  `json:"source,omitempty"`
+	StrategyID   *int64  `json:"strategy_id,omitempty"`
+	StrategyName *string `json:"strategy_name,omitempty"`
+	Confidence   float64 `json:"confidence,omitempty"`
+	EntryReason  string  `json:"entry_reason,omitempty"`
 }
 
 // ScalpReentryStateData is a simplified version of ScalpReentryStatus for persistence.
@@ -24,6 +24,22 @@ func (r *Repository) HealthCheck(ctx context.Context) error {
 	return r.db.Pool.Ping(ctx)
 }
 
+// PoolStats returns current connection pool usage
+func (r *Repository) PoolStats() PoolStats {
+	return r.db.PoolStats()
+}
+
+// ReadOnly returns a repository backed by a read replica for analytics and
+// reporting queries, keeping heavy aggregation off the trading primary. It is
+// the primary repository itself when no replica is configured.
+func (r *Repository) ReadOnly() *Repository {
+	replica := r.db.ReadReplica()
+	if replica == r.db {
+		return r
+	}
+	return &Repository{db: replica}
+}
+
 // GetDB returns the underlying DB instance for direct access to futures methods
 func (r *Repository) GetDB() *DB {
 	return r.db
@@ -41,15 +57,17 @@ func (r *Repository) CreateTrade(ctx context.Context, trade *Trade) error {
 	if trade.TradeSource == "" {
 		trade.TradeSource = TradeSourceManual
 	}
+	trade.Tags = mergeTradeTags(autoTradeTags(trade.TradeSource, nil, trade.StrategyName), trade.Tags)
 	query := `
-		INSERT INTO trades (symbol, side, entry_price, quantity, entry_time, stop_loss, take_profit, strategy_name, status, trade_source)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		INSERT INTO trades (symbol, side, entry_price, quantity, entry_time, stop_loss, take_profit, strategy_name, status, trade_source, notes, tags)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 		RETURNING id, created_at, updated_at
 	`
 	return r.db.Pool.QueryRow(
 		ctx, query,
 		trade.Symbol, trade.Side, trade.EntryPrice, trade.Quantity, trade.EntryTime,
 		trade.StopLoss, trade.TakeProfit, trade.StrategyName, trade.Status, trade.TradeSource,
+		trade.Notes, trade.Tags,
 	).Scan(&trade.ID, &trade.CreatedAt, &trade.UpdatedAt)
 }
 
@@ -71,7 +89,7 @@ func (r *Repository) UpdateTrade(ctx context.Context, trade *Trade) error {
 func (r *Repository) GetTradeByID(ctx context.Context, id int64) (*Trade, error) {
 	query := `
 		SELECT id, symbol, side, entry_price, exit_price, quantity, entry_time, exit_time,
-		       stop_loss, take_profit, pnl, pnl_percent, strategy_name, status, created_at, updated_at, trade_source
+		       stop_loss, take_profit, pnl, pnl_percent, strategy_name, status, created_at, updated_at, trade_source, notes, tags
 		FROM trades
 		WHERE id = $1
 	`
@@ -80,7 +98,7 @@ func (r *Repository) GetTradeByID(ctx context.Context, id int64) (*Trade, error)
 		&trade.ID, &trade.Symbol, &trade.Side, &trade.EntryPrice, &trade.ExitPrice,
 		&trade.Quantity, &trade.EntryTime, &trade.ExitTime, &trade.StopLoss, &trade.TakeProfit,
 		&trade.PnL, &trade.PnLPercent, &trade.StrategyName, &trade.Status,
-		&trade.CreatedAt, &trade.UpdatedAt, &trade.TradeSource,
+		&trade.CreatedAt, &trade.UpdatedAt, &trade.TradeSource, &trade.Notes, &trade.Tags,
 	)
 	if err != nil {
 		return nil, err
@@ -92,7 +110,7 @@ func (r *Repository) GetTradeByID(ctx context.Context, id int64) (*Trade, error)
 func (r *Repository) GetOpenTrades(ctx context.Context) ([]*Trade, error) {
 	query := `
 		SELECT id, symbol, side, entry_price, exit_price, quantity, entry_time, exit_time,
-		       stop_loss, take_profit, pnl, pnl_percent, strategy_name, status, created_at, updated_at, trade_source, ai_decision_id
+		       stop_loss, take_profit, pnl, pnl_percent, strategy_name, status, created_at, updated_at, trade_source, ai_decision_id, notes, tags
 		FROM trades
 		WHERE status = 'OPEN'
 		ORDER BY entry_time DESC
@@ -104,7 +122,7 @@ func (r *Repository) GetOpenTrades(ctx context.Context) ([]*Trade, error) {
 func (r *Repository) GetTradeHistory(ctx context.Context, limit, offset int) ([]*Trade, error) {
 	query := `
 		SELECT id, symbol, side, entry_price, exit_price, quantity, entry_time, exit_time,
-		       stop_loss, take_profit, pnl, pnl_percent, strategy_name, status, created_at, updated_at, trade_source, ai_decision_id
+		       stop_loss, take_profit, pnl, pnl_percent, strategy_name, status, created_at, updated_at, trade_source, ai_decision_id, notes, tags
 		FROM trades
 		WHERE status = 'CLOSED'
 		ORDER BY exit_time DESC
@@ -117,7 +135,7 @@ func (r *Repository) GetTradeHistory(ctx context.Context, limit, offset int) ([]
 func (r *Repository) GetTradesBySymbol(ctx context.Context, symbol string) ([]*Trade, error) {
 	query := `
 		SELECT id, symbol, side, entry_price, exit_price, quantity, entry_time, exit_time,
-		       stop_loss, take_profit, pnl, pnl_percent, strategy_name, status, created_at, updated_at, trade_source, ai_decision_id
+		       stop_loss, take_profit, pnl, pnl_percent, strategy_name, status, created_at, updated_at, trade_source, ai_decision_id, notes, tags
 		FROM trades
 		WHERE symbol = $1
 		ORDER BY entry_time DESC
@@ -139,7 +157,7 @@ func (r *Repository) queryTrades(ctx context.Context, query string, args ...inte
 			&trade.ID, &trade.Symbol, &trade.Side, &trade.EntryPrice, &trade.ExitPrice,
 			&trade.Quantity, &trade.EntryTime, &trade.ExitTime, &trade.StopLoss, &trade.TakeProfit,
 			&trade.PnL, &trade.PnLPercent, &trade.StrategyName, &trade.Status,
-			&trade.CreatedAt, &trade.UpdatedAt, &trade.TradeSource, &trade.AIDecisionID,
+			&trade.CreatedAt, &trade.UpdatedAt, &trade.TradeSource, &trade.AIDecisionID, &trade.Notes, &trade.Tags,
 		)
 		if err != nil {
 			return nil, err
@@ -253,15 +271,18 @@ func (r *Repository) queryOrders(ctx context.Context, query string, args ...inte
 // CreateSignal inserts a new signal
 func (r *Repository) CreateSignal(ctx context.Context, signal *Signal) error {
 	query := `
-		INSERT INTO signals (strategy_name, symbol, signal_type, entry_price, stop_loss, take_profit, quantity, reason, timestamp, executed)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		INSERT INTO signals (strategy_name, symbol, signal_type, entry_price, stop_loss, take_profit, quantity, reason, timestamp, executed, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 		RETURNING id, created_at
 	`
+	if signal.Status == "" {
+		signal.Status = "confirmed"
+	}
 	return r.db.Pool.QueryRow(
 		ctx, query,
 		signal.StrategyName, signal.Symbol, signal.SignalType, signal.EntryPrice,
 		signal.StopLoss, signal.TakeProfit, signal.Quantity, signal.Reason,
-		signal.Timestamp, signal.Executed,
+		signal.Timestamp, signal.Executed, signal.Status,
 	).Scan(&signal.ID, &signal.CreatedAt)
 }
 
@@ -276,7 +297,7 @@ func (r *Repository) MarkSignalExecuted(ctx context.Context, id int64) error {
 func (r *Repository) GetRecentSignals(ctx context.Context, limit int) ([]*Signal, error) {
 	query := `
 		SELECT id, strategy_name, symbol, signal_type, entry_price, stop_loss, take_profit,
-		       quantity, reason, timestamp, executed, created_at
+		       quantity, reason, timestamp, executed, status, created_at
 		FROM signals
 		ORDER BY timestamp DESC
 		LIMIT $1
@@ -293,7 +314,7 @@ func (r *Repository) GetRecentSignals(ctx context.Context, limit int) ([]*Signal
 		err := rows.Scan(
 			&signal.ID, &signal.StrategyName, &signal.Symbol, &signal.SignalType,
 			&signal.EntryPrice, &signal.StopLoss, &signal.TakeProfit, &signal.Quantity,
-			&signal.Reason, &signal.Timestamp, &signal.Executed, &signal.CreatedAt,
+			&signal.Reason, &signal.Timestamp, &signal.Executed, &signal.Status, &signal.CreatedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -510,17 +531,21 @@ func (r *Repository) CreateStrategyConfig(ctx context.Context, config *StrategyC
 	if err != nil {
 		return fmt.Errorf("failed to marshal config params: %w", err)
 	}
+	scheduleJSON, err := marshalTradingSchedule(config.Schedule)
+	if err != nil {
+		return err
+	}
 
 	query := `
 		INSERT INTO strategy_configs (name, symbol, timeframe, indicator_type, autopilot, enabled,
-			position_size, stop_loss_percent, take_profit_percent, config_params)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			position_size, stop_loss_percent, take_profit_percent, config_params, schedule)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 		RETURNING id, created_at, updated_at
 	`
 	return r.db.Pool.QueryRow(
 		ctx, query,
 		config.Name, config.Symbol, config.Timeframe, config.IndicatorType, config.Autopilot,
-		config.Enabled, config.PositionSize, config.StopLossPercent, config.TakeProfitPercent, configJSON,
+		config.Enabled, config.PositionSize, config.StopLossPercent, config.TakeProfitPercent, configJSON, scheduleJSON,
 	).Scan(&config.ID, &config.CreatedAt, &config.UpdatedAt)
 }
 
@@ -530,18 +555,22 @@ func (r *Repository) UpdateStrategyConfig(ctx context.Context, config *StrategyC
 	if err != nil {
 		return fmt.Errorf("failed to marshal config params: %w", err)
 	}
+	scheduleJSON, err := marshalTradingSchedule(config.Schedule)
+	if err != nil {
+		return err
+	}
 
 	query := `
 		UPDATE strategy_configs
 		SET symbol = $2, timeframe = $3, indicator_type = $4, autopilot = $5, enabled = $6,
 			position_size = $7, stop_loss_percent = $8, take_profit_percent = $9, config_params = $10,
-			updated_at = CURRENT_TIMESTAMP
+			schedule = $11, updated_at = CURRENT_TIMESTAMP
 		WHERE id = $1
 	`
 	_, err = r.db.Pool.Exec(
 		ctx, query,
 		config.ID, config.Symbol, config.Timeframe, config.IndicatorType, config.Autopilot,
-		config.Enabled, config.PositionSize, config.StopLossPercent, config.TakeProfitPercent, configJSON,
+		config.Enabled, config.PositionSize, config.StopLossPercent, config.TakeProfitPercent, configJSON, scheduleJSON,
 	)
 	return err
 }
@@ -550,16 +579,16 @@ func (r *Repository) UpdateStrategyConfig(ctx context.Context, config *StrategyC
 func (r *Repository) GetStrategyConfigByID(ctx context.Context, id int64) (*StrategyConfig, error) {
 	query := `
 		SELECT id, name, symbol, timeframe, indicator_type, autopilot, enabled,
-			   position_size, stop_loss_percent, take_profit_percent, config_params, created_at, updated_at
+			   position_size, stop_loss_percent, take_profit_percent, config_params, schedule, created_at, updated_at
 		FROM strategy_configs
 		WHERE id = $1
 	`
 	config := &StrategyConfig{}
-	var configJSON []byte
+	var configJSON, scheduleJSON []byte
 	err := r.db.Pool.QueryRow(ctx, query, id).Scan(
 		&config.ID, &config.Name, &config.Symbol, &config.Timeframe, &config.IndicatorType,
 		&config.Autopilot, &config.Enabled, &config.PositionSize, &config.StopLossPercent,
-		&config.TakeProfitPercent, &configJSON, &config.CreatedAt, &config.UpdatedAt,
+		&config.TakeProfitPercent, &configJSON, &scheduleJSON, &config.CreatedAt, &config.UpdatedAt,
 	)
 	if err != nil {
 		return nil, err
@@ -569,6 +598,9 @@ func (r *Repository) GetStrategyConfigByID(ctx context.Context, id int64) (*Stra
 			return nil, err
 		}
 	}
+	if config.Schedule, err = unmarshalTradingSchedule(scheduleJSON); err != nil {
+		return nil, err
+	}
 	return config, nil
 }
 
@@ -576,7 +608,7 @@ func (r *Repository) GetStrategyConfigByID(ctx context.Context, id int64) (*Stra
 func (r *Repository) GetAllStrategyConfigs(ctx context.Context) ([]*StrategyConfig, error) {
 	query := `
 		SELECT id, name, symbol, timeframe, indicator_type, autopilot, enabled,
-			   position_size, stop_loss_percent, take_profit_percent, config_params, created_at, updated_at
+			   position_size, stop_loss_percent, take_profit_percent, config_params, schedule, created_at, updated_at
 		FROM strategy_configs
 		ORDER BY created_at DESC
 	`
@@ -589,11 +621,11 @@ func (r *Repository) GetAllStrategyConfigs(ctx context.Context) ([]*StrategyConf
 	var configs []*StrategyConfig
 	for rows.Next() {
 		config := &StrategyConfig{}
-		var configJSON []byte
+		var configJSON, scheduleJSON []byte
 		err := rows.Scan(
 			&config.ID, &config.Name, &config.Symbol, &config.Timeframe, &config.IndicatorType,
 			&config.Autopilot, &config.Enabled, &config.PositionSize, &config.StopLossPercent,
-			&config.TakeProfitPercent, &configJSON, &config.CreatedAt, &config.UpdatedAt,
+			&config.TakeProfitPercent, &configJSON, &scheduleJSON, &config.CreatedAt, &config.UpdatedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -603,6 +635,9 @@ func (r *Repository) GetAllStrategyConfigs(ctx context.Context) ([]*StrategyConf
 				return nil, err
 			}
 		}
+		if config.Schedule, err = unmarshalTradingSchedule(scheduleJSON); err != nil {
+			return nil, err
+		}
 		configs = append(configs, config)
 	}
 	return configs, rows.Err()
@@ -0,0 +1,128 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// InsertAuditLogEntry appends an entry to the audit log
+func (db *DB) InsertAuditLogEntry(ctx context.Context, entry *AuditLogEntry) error {
+	if db.Pool == nil {
+		return nil // No database configured
+	}
+
+	var detailsJSON []byte
+	if entry.Details != nil {
+		var err error
+		detailsJSON, err = json.Marshal(entry.Details)
+		if err != nil {
+			return fmt.Errorf("failed to marshal audit details: %w", err)
+		}
+	}
+
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+
+	err := db.Pool.QueryRow(ctx,
+		`INSERT INTO audit_log (created_at, action, actor, symbol, details, result, error)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7)
+		 RETURNING id`,
+		entry.CreatedAt, entry.Action, entry.Actor, nilIfEmpty(entry.Symbol),
+		detailsJSON, entry.Result, nilIfEmpty(entry.Error),
+	).Scan(&entry.ID)
+	if err != nil {
+		return fmt.Errorf("failed to insert audit log entry: %w", err)
+	}
+	return nil
+}
+
+// GetAuditLogEntries returns a page of audit entries, newest first, and the
+// total number of entries matching the filter
+func (db *DB) GetAuditLogEntries(ctx context.Context, filter AuditLogFilter) ([]AuditLogEntry, int, error) {
+	if db.Pool == nil {
+		return nil, 0, nil
+	}
+
+	var conditions []string
+	var args []interface{}
+	addCondition := func(clause string, value interface{}) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(clause, len(args)))
+	}
+
+	if filter.Actor != "" {
+		addCondition("actor = $%d", filter.Actor)
+	}
+	if filter.Action != "" {
+		addCondition("action = $%d", filter.Action)
+	}
+	if filter.Symbol != "" {
+		addCondition("symbol = $%d", filter.Symbol)
+	}
+	if !filter.Since.IsZero() {
+		addCondition("created_at >= $%d", filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		addCondition("created_at < $%d", filter.Until)
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int
+	if err := db.Pool.QueryRow(ctx, "SELECT COUNT(*) FROM audit_log "+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count audit log entries: %w", err)
+	}
+
+	limit := filter.Limit
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	offset := filter.Offset
+	if offset < 0 {
+		offset = 0
+	}
+	args = append(args, limit, offset)
+
+	query := fmt.Sprintf(
+		`SELECT id, created_at, action, actor, COALESCE(symbol, ''), details, result, COALESCE(error, '')
+		 FROM audit_log %s
+		 ORDER BY created_at DESC, id DESC
+		 LIMIT $%d OFFSET $%d`, where, len(args)-1, len(args))
+
+	rows, err := db.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query audit log: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make([]AuditLogEntry, 0)
+	for rows.Next() {
+		var e AuditLogEntry
+		var detailsJSON []byte
+		if err := rows.Scan(&e.ID, &e.CreatedAt, &e.Action, &e.Actor, &e.Symbol, &detailsJSON, &e.Result, &e.Error); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan audit log entry: %w", err)
+		}
+		if len(detailsJSON) > 0 {
+			_ = json.Unmarshal(detailsJSON, &e.Details)
+		}
+		entries = append(entries, e)
+	}
+	return entries, total, rows.Err()
+}
+
+// InsertAuditLogEntry appends an entry to the audit log
+func (r *Repository) InsertAuditLogEntry(ctx context.Context, entry *AuditLogEntry) error {
+	return r.db.InsertAuditLogEntry(ctx, entry)
+}
+
+// GetAuditLogEntries returns a filtered, paginated page of the audit log
+func (r *Repository) GetAuditLogEntries(ctx context.Context, filter AuditLogFilter) ([]AuditLogEntry, int, error) {
+	return r.db.GetAuditLogEntries(ctx, filter)
+}
@@ -0,0 +1,224 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Confidence analysis settings. Confidence values are AI decision confidences (0-1).
+var (
+	// confidenceBucketEdges split trades into [edge[i], edge[i+1]) buckets
+	confidenceBucketEdges = []float64{0.00, 0.35, 0.50, 0.55, 0.65, 0.75, 1.00}
+
+	// confidenceThresholds are the candidate minimum-confidence settings compared
+	confidenceThresholds = []float64{0.35, 0.50, 0.55, 0.65, 0.75}
+)
+
+const (
+	// defaultConfidenceThreshold is recommended when no threshold avoids losses
+	defaultConfidenceThreshold = 0.55
+
+	// Losing trades listed in the analysis: the most recent ones beyond this loss
+	topLosingTradeMinLoss = 10.0
+	topLosingTradeLimit   = 10
+)
+
+// ConfidenceTrade is a closed futures trade with the confidence of the AI decision that opened it
+type ConfidenceTrade struct {
+	Symbol       string    `json:"symbol"`
+	Confidence   float64   `json:"confidence"`
+	RealizedPnL  float64   `json:"realized_pnl"`
+	PnLPercent   float64   `json:"pnl_percent"`
+	EntryTime    time.Time `json:"entry_time"`
+	PositionSide string    `json:"position_side"`
+}
+
+// ConfidenceBucket aggregates outcomes of trades within a confidence range
+type ConfidenceBucket struct {
+	MinConfidence float64 `json:"min_confidence"`
+	MaxConfidence float64 `json:"max_confidence"`
+	TotalTrades   int     `json:"total_trades"`
+	WinningTrades int     `json:"winning_trades"`
+	LosingTrades  int     `json:"losing_trades"`
+	TotalPnL      float64 `json:"total_pnl"`
+	AvgPnL        float64 `json:"avg_pnl"`
+	WinRate       float64 `json:"win_rate"` // Percent
+}
+
+// ConfidenceTradeGroup summarizes the trades on one side of a threshold
+type ConfidenceTradeGroup struct {
+	Trades  int     `json:"trades"`
+	Wins    int     `json:"wins"`
+	Losses  int     `json:"losses"`
+	PnL     float64 `json:"pnl"`
+	WinRate float64 `json:"win_rate"` // Percent
+}
+
+// ConfidenceThresholdComparison splits trades at a minimum confidence. A negative
+// Excluded.PnL is the loss the threshold would have avoided, a positive one the
+// profit it would have missed.
+type ConfidenceThresholdComparison struct {
+	Threshold float64              `json:"threshold"`
+	Included  ConfidenceTradeGroup `json:"included"` // Confidence >= threshold
+	Excluded  ConfidenceTradeGroup `json:"excluded"` // Confidence < threshold
+}
+
+// ConfidenceAnalysis is the confidence bucket and threshold analysis of closed trades
+type ConfidenceAnalysis struct {
+	TotalTrades      int                             `json:"total_trades"`
+	Buckets          []ConfidenceBucket              `json:"buckets"`
+	Thresholds       []ConfidenceThresholdComparison `json:"thresholds"`
+	OptimalThreshold float64                         `json:"optimal_threshold"`
+	AvoidedLoss      float64                         `json:"avoided_loss"` // Loss the optimal threshold would have avoided
+	HasOptimal       bool                            `json:"has_optimal"`  // False when no threshold avoids a loss
+	Recommendation   string                          `json:"recommendation"`
+	TopLosingTrades  []ConfidenceTrade               `json:"top_losing_trades"`
+}
+
+// add counts a trade outcome in the group
+func (g *ConfidenceTradeGroup) add(pnl float64) {
+	g.Trades++
+	g.PnL += pnl
+	if pnl > 0 {
+		g.Wins++
+	} else if pnl < 0 {
+		g.Losses++
+	}
+	g.WinRate = float64(g.Wins) / float64(g.Trades) * 100
+}
+
+// AnalyzeConfidence buckets trades by confidence, compares the candidate
+// thresholds and recommends the one that would have avoided the most loss.
+// Trades are expected newest first.
+func AnalyzeConfidence(trades []ConfidenceTrade) *ConfidenceAnalysis {
+	analysis := &ConfidenceAnalysis{
+		TotalTrades:      len(trades),
+		Buckets:          make([]ConfidenceBucket, len(confidenceBucketEdges)-1),
+		Thresholds:       make([]ConfidenceThresholdComparison, len(confidenceThresholds)),
+		OptimalThreshold: defaultConfidenceThreshold,
+		TopLosingTrades:  make([]ConfidenceTrade, 0),
+	}
+
+	for i := range analysis.Buckets {
+		analysis.Buckets[i].MinConfidence = confidenceBucketEdges[i]
+		analysis.Buckets[i].MaxConfidence = confidenceBucketEdges[i+1]
+	}
+	for i, threshold := range confidenceThresholds {
+		analysis.Thresholds[i].Threshold = threshold
+	}
+
+	for _, t := range trades {
+		for i := range analysis.Buckets {
+			b := &analysis.Buckets[i]
+			if t.Confidence >= b.MinConfidence && t.Confidence < b.MaxConfidence {
+				b.TotalTrades++
+				b.TotalPnL += t.RealizedPnL
+				if t.RealizedPnL > 0 {
+					b.WinningTrades++
+				} else if t.RealizedPnL < 0 {
+					b.LosingTrades++
+				}
+				break
+			}
+		}
+
+		for i := range analysis.Thresholds {
+			cmp := &analysis.Thresholds[i]
+			if t.Confidence >= cmp.Threshold {
+				cmp.Included.add(t.RealizedPnL)
+			} else {
+				cmp.Excluded.add(t.RealizedPnL)
+			}
+		}
+
+		if t.RealizedPnL < -topLosingTradeMinLoss && len(analysis.TopLosingTrades) < topLosingTradeLimit {
+			analysis.TopLosingTrades = append(analysis.TopLosingTrades, t)
+		}
+	}
+
+	for i := range analysis.Buckets {
+		b := &analysis.Buckets[i]
+		if b.TotalTrades > 0 {
+			b.AvgPnL = b.TotalPnL / float64(b.TotalTrades)
+			b.WinRate = float64(b.WinningTrades) / float64(b.TotalTrades) * 100
+		}
+	}
+
+	// Best threshold: the one whose excluded trades lost the most
+	bestExcludedPnL := 0.0
+	for _, cmp := range analysis.Thresholds {
+		if cmp.Excluded.PnL < bestExcludedPnL {
+			bestExcludedPnL = cmp.Excluded.PnL
+			analysis.OptimalThreshold = cmp.Threshold
+		}
+	}
+	if bestExcludedPnL < 0 {
+		analysis.HasOptimal = true
+		analysis.AvoidedLoss = -bestExcludedPnL
+		analysis.Recommendation = fmt.Sprintf("Optimal threshold: %.0f%% (would have avoided $%.2f in losses)",
+			analysis.OptimalThreshold*100, analysis.AvoidedLoss)
+	} else {
+		analysis.Recommendation = "No clear optimal threshold - confidence doesn't correlate with outcomes"
+	}
+
+	return analysis
+}
+
+// GetConfidenceTrades retrieves closed futures trades with the confidence of the
+// AI decision that opened them (0 when unlinked), newest first. Zero from/to
+// leave the range open, an empty mode matches all trading modes and an empty
+// userID matches trades regardless of owner.
+func (db *DB) GetConfidenceTrades(ctx context.Context, userID string, from, to time.Time, mode string) ([]ConfidenceTrade, error) {
+	query := `
+		SELECT
+			ft.symbol,
+			COALESCE(ad.confidence, 0) AS confidence,
+			COALESCE(ft.realized_pnl, 0) AS realized_pnl,
+			COALESCE(ft.realized_pnl_percent, 0) AS pnl_percent,
+			ft.entry_time,
+			ft.position_side
+		FROM futures_trades ft
+		LEFT JOIN ai_decisions ad ON ft.ai_decision_id = ad.id
+		WHERE ft.status = 'CLOSED'
+		  AND ft.realized_pnl IS NOT NULL
+		  AND ($1 = '' OR ft.user_id::text = $1)
+		  AND ($2::timestamptz IS NULL OR ft.entry_time >= $2)
+		  AND ($3::timestamptz IS NULL OR ft.entry_time < $3)
+		  AND ($4 = '' OR ft.trading_mode = $4)
+		ORDER BY ft.entry_time DESC`
+
+	rows, err := db.Pool.Query(ctx, query, userID, nullableTime(from), nullableTime(to), mode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get confidence trades: %w", err)
+	}
+	defer rows.Close()
+
+	trades := make([]ConfidenceTrade, 0)
+	for rows.Next() {
+		var t ConfidenceTrade
+		if err := rows.Scan(&t.Symbol, &t.Confidence, &t.RealizedPnL, &t.PnLPercent, &t.EntryTime, &t.PositionSide); err != nil {
+			return nil, fmt.Errorf("failed to scan confidence trade: %w", err)
+		}
+		trades = append(trades, t)
+	}
+
+	return trades, rows.Err()
+}
+
+// GetConfidenceAnalysis runs AnalyzeConfidence over GetConfidenceTrades
+func (db *DB) GetConfidenceAnalysis(ctx context.Context, userID string, from, to time.Time, mode string) (*ConfidenceAnalysis, error) {
+	trades, err := db.GetConfidenceTrades(ctx, userID, from, to, mode)
+	if err != nil {
+		return nil, err
+	}
+	return AnalyzeConfidence(trades), nil
+}
+
+// nullableTime maps the zero time to NULL
+func nullableTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
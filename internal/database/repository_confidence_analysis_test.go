@@ -0,0 +1,57 @@
+package database
+
+import (
+	"testing"
+)
+
+func TestAnalyzeConfidenceBucketsAndOptimalThreshold(t *testing.T) {
+	trades := []ConfidenceTrade{
+		{Symbol: "BTCUSDT", Confidence: 0.80, RealizedPnL: 40},
+		{Symbol: "ETHUSDT", Confidence: 0.70, RealizedPnL: 15},
+		{Symbol: "SOLUSDT", Confidence: 0.60, RealizedPnL: -5},
+		{Symbol: "XRPUSDT", Confidence: 0.52, RealizedPnL: -30},
+		{Symbol: "DOGEUSDT", Confidence: 0.40, RealizedPnL: -20},
+		{Symbol: "ADAUSDT", Confidence: 0.20, RealizedPnL: 0},
+	}
+
+	a := AnalyzeConfidence(trades)
+
+	if a.TotalTrades != 6 || len(a.Buckets) != 6 {
+		t.Fatalf("total=%d buckets=%d, want 6 and 6", a.TotalTrades, len(a.Buckets))
+	}
+	high := a.Buckets[5]
+	if high.TotalTrades != 1 || high.WinningTrades != 1 || high.WinRate != 100 || high.AvgPnL != 40 {
+		t.Errorf("75%%+ bucket = %+v", high)
+	}
+	if flat := a.Buckets[0]; flat.TotalTrades != 1 || flat.WinningTrades != 0 || flat.LosingTrades != 0 {
+		t.Errorf("breakeven trade should count in neither wins nor losses: %+v", flat)
+	}
+
+	// Excluded PnL: 35% -> 0, 50% -> -20, 55% -> -50, 65% -> -55, 75% -> -40
+	if !a.HasOptimal || a.OptimalThreshold != 0.65 || a.AvoidedLoss != 55 {
+		t.Errorf("optimal = %v/%.2f avoided %.2f, want 0.65 avoiding 55", a.HasOptimal, a.OptimalThreshold, a.AvoidedLoss)
+	}
+	cmp := a.Thresholds[3]
+	if cmp.Included.Trades != 2 || cmp.Included.PnL != 55 || cmp.Excluded.Trades != 4 || cmp.Excluded.Losses != 3 {
+		t.Errorf("65%% comparison = %+v", cmp)
+	}
+
+	if len(a.TopLosingTrades) != 2 || a.TopLosingTrades[0].Symbol != "XRPUSDT" {
+		t.Errorf("top losing trades = %+v, want XRPUSDT and DOGEUSDT", a.TopLosingTrades)
+	}
+}
+
+func TestAnalyzeConfidenceNoOptimalWhenLowConfidenceWins(t *testing.T) {
+	a := AnalyzeConfidence([]ConfidenceTrade{
+		{Confidence: 0.30, RealizedPnL: 25},
+		{Confidence: 0.90, RealizedPnL: -25},
+	})
+	if a.HasOptimal || a.OptimalThreshold != defaultConfidenceThreshold || a.AvoidedLoss != 0 {
+		t.Errorf("analysis = %+v, want no optimal threshold", a)
+	}
+
+	empty := AnalyzeConfidence(nil)
+	if empty.TotalTrades != 0 || empty.HasOptimal || empty.TopLosingTrades == nil {
+		t.Errorf("empty analysis = %+v", empty)
+	}
+}
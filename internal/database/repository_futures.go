@@ -15,11 +15,12 @@ func (db *DB) CreateFuturesTrade(ctx context.Context, trade *FuturesTrade) error
 			user_id, symbol, position_side, side, entry_price, quantity, leverage,
 			margin_type, isolated_margin, liquidation_price, stop_loss, take_profit,
 			status, entry_time, trade_source, notes, ai_decision_id,
-			strategy_id, strategy_name, trading_mode, created_at, updated_at
+			strategy_id, strategy_name, trading_mode, created_at, updated_at, tags
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23
 		) RETURNING id`
 
+	trade.Tags = mergeTradeTags(autoTradeTags(trade.TradeSource, trade.TradingMode, trade.StrategyName), trade.Tags)
 	now := time.Now()
 	// Handle empty UserID - pass nil for NULL in database
 	var userID interface{} = trade.UserID
@@ -49,6 +50,7 @@ func (db *DB) CreateFuturesTrade(ctx context.Context, trade *FuturesTrade) error
 		trade.TradingMode,
 		now,
 		now,
+		trade.Tags,
 	).Scan(&trade.ID)
 
 	if err != nil {
@@ -109,7 +111,7 @@ func (db *DB) GetFuturesTradeByID(ctx context.Context, id int64) (*FuturesTrade,
 		SELECT id, symbol, position_side, side, entry_price, exit_price, mark_price,
 			quantity, leverage, margin_type, isolated_margin, realized_pnl, unrealized_pnl,
 			realized_pnl_percent, liquidation_price, stop_loss, take_profit, trailing_stop,
-			status, entry_time, exit_time, trade_source, notes, ai_decision_id,
+			status, entry_time, exit_time, trade_source, notes, tags, ai_decision_id,
 			strategy_id, strategy_name, created_at, updated_at
 		FROM futures_trades WHERE id = $1`
 
@@ -120,7 +122,7 @@ func (db *DB) GetFuturesTradeByID(ctx context.Context, id int64) (*FuturesTrade,
 		&trade.MarginType, &trade.IsolatedMargin, &trade.RealizedPnL, &trade.UnrealizedPnL,
 		&trade.RealizedPnLPercent, &trade.LiquidationPrice, &trade.StopLoss, &trade.TakeProfit,
 		&trade.TrailingStop, &trade.Status, &trade.EntryTime, &trade.ExitTime,
-		&trade.TradeSource, &trade.Notes, &trade.AIDecisionID,
+		&trade.TradeSource, &trade.Notes, &trade.Tags, &trade.AIDecisionID,
 		&trade.StrategyID, &trade.StrategyName, &trade.CreatedAt, &trade.UpdatedAt,
 	)
 
@@ -137,7 +139,7 @@ func (db *DB) GetOpenFuturesTrades(ctx context.Context) ([]FuturesTrade, error)
 		SELECT id, symbol, position_side, side, entry_price, exit_price, mark_price,
 			quantity, leverage, margin_type, isolated_margin, realized_pnl, unrealized_pnl,
 			realized_pnl_percent, liquidation_price, stop_loss, take_profit, trailing_stop,
-			status, entry_time, exit_time, trade_source, notes, ai_decision_id,
+			status, entry_time, exit_time, trade_source, notes, tags, ai_decision_id,
 			strategy_id, strategy_name, created_at, updated_at
 		FROM futures_trades WHERE status = 'OPEN'
 		ORDER BY entry_time DESC`
@@ -157,7 +159,7 @@ func (db *DB) GetOpenFuturesTrades(ctx context.Context) ([]FuturesTrade, error)
 			&trade.MarginType, &trade.IsolatedMargin, &trade.RealizedPnL, &trade.UnrealizedPnL,
 			&trade.RealizedPnLPercent, &trade.LiquidationPrice, &trade.StopLoss, &trade.TakeProfit,
 			&trade.TrailingStop, &trade.Status, &trade.EntryTime, &trade.ExitTime,
-			&trade.TradeSource, &trade.Notes, &trade.AIDecisionID,
+			&trade.TradeSource, &trade.Notes, &trade.Tags, &trade.AIDecisionID,
 			&trade.StrategyID, &trade.StrategyName, &trade.CreatedAt, &trade.UpdatedAt,
 		)
 		if err != nil {
@@ -175,7 +177,7 @@ func (db *DB) GetOpenFuturesTradeBySymbol(ctx context.Context, symbol string) (*
 		SELECT id, symbol, position_side, side, entry_price, exit_price, mark_price,
 			quantity, leverage, margin_type, isolated_margin, realized_pnl, unrealized_pnl,
 			realized_pnl_percent, liquidation_price, stop_loss, take_profit, trailing_stop,
-			status, entry_time, exit_time, trade_source, notes, ai_decision_id,
+			status, entry_time, exit_time, trade_source, notes, tags, ai_decision_id,
 			strategy_id, strategy_name, created_at, updated_at
 		FROM futures_trades WHERE symbol = $1 AND status = 'OPEN'
 		ORDER BY entry_time DESC
@@ -188,7 +190,7 @@ func (db *DB) GetOpenFuturesTradeBySymbol(ctx context.Context, symbol string) (*
 		&trade.MarginType, &trade.IsolatedMargin, &trade.RealizedPnL, &trade.UnrealizedPnL,
 		&trade.RealizedPnLPercent, &trade.LiquidationPrice, &trade.StopLoss, &trade.TakeProfit,
 		&trade.TrailingStop, &trade.Status, &trade.EntryTime, &trade.ExitTime,
-		&trade.TradeSource, &trade.Notes, &trade.AIDecisionID,
+		&trade.TradeSource, &trade.Notes, &trade.Tags, &trade.AIDecisionID,
 		&trade.StrategyID, &trade.StrategyName, &trade.CreatedAt, &trade.UpdatedAt,
 	)
 
@@ -208,7 +210,7 @@ func (db *DB) GetFuturesTradeHistory(ctx context.Context, limit, offset int) ([]
 		SELECT id, symbol, position_side, side, entry_price, exit_price, mark_price,
 			quantity, leverage, margin_type, isolated_margin, realized_pnl, unrealized_pnl,
 			realized_pnl_percent, liquidation_price, stop_loss, take_profit, trailing_stop,
-			status, entry_time, exit_time, trade_source, notes, ai_decision_id,
+			status, entry_time, exit_time, trade_source, notes, tags, ai_decision_id,
 			strategy_id, strategy_name, created_at, updated_at
 		FROM futures_trades WHERE status != 'OPEN'
 		ORDER BY exit_time DESC NULLS LAST
@@ -229,7 +231,7 @@ func (db *DB) GetFuturesTradeHistory(ctx context.Context, limit, offset int) ([]
 			&trade.MarginType, &trade.IsolatedMargin, &trade.RealizedPnL, &trade.UnrealizedPnL,
 			&trade.RealizedPnLPercent, &trade.LiquidationPrice, &trade.StopLoss, &trade.TakeProfit,
 			&trade.TrailingStop, &trade.Status, &trade.EntryTime, &trade.ExitTime,
-			&trade.TradeSource, &trade.Notes, &trade.AIDecisionID,
+			&trade.TradeSource, &trade.Notes, &trade.Tags, &trade.AIDecisionID,
 			&trade.StrategyID, &trade.StrategyName, &trade.CreatedAt, &trade.UpdatedAt,
 		)
 		if err != nil {
@@ -630,7 +632,7 @@ func (db *DB) GetFuturesTradeHistoryWithAI(ctx context.Context, limit, offset in
 		SELECT id, symbol, position_side, side, entry_price, exit_price, mark_price,
 			quantity, leverage, margin_type, isolated_margin, realized_pnl, unrealized_pnl,
 			realized_pnl_percent, liquidation_price, stop_loss, take_profit, trailing_stop,
-			status, entry_time, exit_time, trade_source, notes, COALESCE(ai_decision_id, 0), created_at, updated_at
+			status, entry_time, exit_time, trade_source, notes, tags, COALESCE(ai_decision_id, 0), created_at, updated_at
 		FROM futures_trades WHERE %s
 		ORDER BY COALESCE(exit_time, entry_time) DESC
 		LIMIT $1 OFFSET $2`, statusFilter)
@@ -651,7 +653,7 @@ func (db *DB) GetFuturesTradeHistoryWithAI(ctx context.Context, limit, offset in
 			&trade.MarginType, &trade.IsolatedMargin, &trade.RealizedPnL, &trade.UnrealizedPnL,
 			&trade.RealizedPnLPercent, &trade.LiquidationPrice, &trade.StopLoss, &trade.TakeProfit,
 			&trade.TrailingStop, &trade.Status, &trade.EntryTime, &trade.ExitTime,
-			&trade.TradeSource, &trade.Notes, &aiDecisionID, &trade.CreatedAt, &trade.UpdatedAt,
+			&trade.TradeSource, &trade.Notes, &trade.Tags, &aiDecisionID, &trade.CreatedAt, &trade.UpdatedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan futures trade: %w", err)
@@ -740,6 +742,16 @@ func (db *DB) GetFuturesTradingMetrics(ctx context.Context) (*FuturesTradingMetr
 	// Get last trade time
 	db.Pool.QueryRow(ctx, `SELECT MAX(exit_time) FROM futures_trades WHERE status != 'OPEN'`).Scan(&metrics.LastTradeTime)
 
+	// Get MAE/MFE averages for SL/TP tuning
+	db.Pool.QueryRow(ctx, `
+		SELECT COALESCE(AVG(max_adverse_excursion), 0),
+			COALESCE(AVG(max_favorable_excursion), 0),
+			COALESCE(AVG(max_adverse_excursion) FILTER (WHERE realized_pnl > 0), 0),
+			COALESCE(AVG(max_favorable_excursion) FILTER (WHERE realized_pnl < 0), 0)
+		FROM futures_trades
+		WHERE status = 'CLOSED' AND max_adverse_excursion IS NOT NULL`).Scan(
+		&metrics.AvgMAEPercent, &metrics.AvgMFEPercent, &metrics.AvgMAEWinnersPercent, &metrics.AvgMFELosersPercent)
+
 	return metrics, nil
 }
 
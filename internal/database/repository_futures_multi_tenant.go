@@ -14,11 +14,12 @@ func (db *DB) CreateFuturesTradeForUser(ctx context.Context, userID string, trad
 		INSERT INTO futures_trades (
 			user_id, symbol, position_side, side, entry_price, quantity, leverage,
 			margin_type, isolated_margin, liquidation_price, stop_loss, take_profit,
-			status, entry_time, trade_source, notes, created_at, updated_at
+			status, entry_time, trade_source, notes, created_at, updated_at, tags
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19
 		) RETURNING id`
 
+	trade.Tags = mergeTradeTags(autoTradeTags(trade.TradeSource, trade.TradingMode, trade.StrategyName), trade.Tags)
 	now := time.Now()
 	err := db.Pool.QueryRow(ctx, query,
 		userID,
@@ -39,6 +40,7 @@ func (db *DB) CreateFuturesTradeForUser(ctx context.Context, userID string, trad
 		trade.Notes,
 		now,
 		now,
+		trade.Tags,
 	).Scan(&trade.ID)
 
 	if err != nil {
@@ -67,7 +69,9 @@ func (db *DB) UpdateFuturesTradeForUser(ctx context.Context, userID string, trad
 			notes = $12,
 			trading_mode = $13,
 			hedge_mode_active = $14,
-			updated_at = $15
+			updated_at = $15,
+			max_adverse_excursion = COALESCE($17, max_adverse_excursion),
+			max_favorable_excursion = COALESCE($18, max_favorable_excursion)
 		WHERE id = $1 AND user_id = $16`
 
 	now := time.Now()
@@ -88,6 +92,8 @@ func (db *DB) UpdateFuturesTradeForUser(ctx context.Context, userID string, trad
 		trade.HedgeModeActive,
 		now,
 		userID,
+		trade.MaxAdverseExcursion,
+		trade.MaxFavorableExcursion,
 	)
 
 	if err != nil {
@@ -104,7 +110,7 @@ func (db *DB) GetFuturesTradeByIDForUser(ctx context.Context, userID string, id
 		SELECT id, symbol, position_side, side, entry_price, exit_price, mark_price,
 			quantity, leverage, margin_type, isolated_margin, realized_pnl, unrealized_pnl,
 			realized_pnl_percent, liquidation_price, stop_loss, take_profit, trailing_stop,
-			status, entry_time, exit_time, trade_source, notes, created_at, updated_at
+			status, entry_time, exit_time, trade_source, notes, tags, created_at, updated_at
 		FROM futures_trades WHERE id = $1 AND user_id = $2`
 
 	trade := &FuturesTrade{}
@@ -114,7 +120,7 @@ func (db *DB) GetFuturesTradeByIDForUser(ctx context.Context, userID string, id
 		&trade.MarginType, &trade.IsolatedMargin, &trade.RealizedPnL, &trade.UnrealizedPnL,
 		&trade.RealizedPnLPercent, &trade.LiquidationPrice, &trade.StopLoss, &trade.TakeProfit,
 		&trade.TrailingStop, &trade.Status, &trade.EntryTime, &trade.ExitTime,
-		&trade.TradeSource, &trade.Notes, &trade.CreatedAt, &trade.UpdatedAt,
+		&trade.TradeSource, &trade.Notes, &trade.Tags, &trade.CreatedAt, &trade.UpdatedAt,
 	)
 
 	if err != nil {
@@ -130,7 +136,7 @@ func (db *DB) GetOpenFuturesTradesForUser(ctx context.Context, userID string) ([
 		SELECT id, symbol, position_side, side, entry_price, exit_price, mark_price,
 			quantity, leverage, margin_type, isolated_margin, realized_pnl, unrealized_pnl,
 			realized_pnl_percent, liquidation_price, stop_loss, take_profit, trailing_stop,
-			status, entry_time, exit_time, trade_source, notes, created_at, updated_at
+			status, entry_time, exit_time, trade_source, notes, tags, created_at, updated_at
 		FROM futures_trades WHERE status = 'OPEN' AND user_id = $1
 		ORDER BY entry_time DESC`
 
@@ -149,7 +155,7 @@ func (db *DB) GetOpenFuturesTradesForUser(ctx context.Context, userID string) ([
 			&trade.MarginType, &trade.IsolatedMargin, &trade.RealizedPnL, &trade.UnrealizedPnL,
 			&trade.RealizedPnLPercent, &trade.LiquidationPrice, &trade.StopLoss, &trade.TakeProfit,
 			&trade.TrailingStop, &trade.Status, &trade.EntryTime, &trade.ExitTime,
-			&trade.TradeSource, &trade.Notes, &trade.CreatedAt, &trade.UpdatedAt,
+			&trade.TradeSource, &trade.Notes, &trade.Tags, &trade.CreatedAt, &trade.UpdatedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan futures trade: %w", err)
@@ -166,7 +172,7 @@ func (db *DB) GetFuturesTradeHistoryForUser(ctx context.Context, userID string,
 		SELECT id, symbol, position_side, side, entry_price, exit_price, mark_price,
 			quantity, leverage, margin_type, isolated_margin, realized_pnl, unrealized_pnl,
 			realized_pnl_percent, liquidation_price, stop_loss, take_profit, trailing_stop,
-			status, entry_time, exit_time, trade_source, notes, created_at, updated_at
+			status, entry_time, exit_time, trade_source, notes, tags, created_at, updated_at
 		FROM futures_trades WHERE status != 'OPEN' AND user_id = $1
 		ORDER BY exit_time DESC NULLS LAST
 		LIMIT $2 OFFSET $3`
@@ -186,7 +192,7 @@ func (db *DB) GetFuturesTradeHistoryForUser(ctx context.Context, userID string,
 			&trade.MarginType, &trade.IsolatedMargin, &trade.RealizedPnL, &trade.UnrealizedPnL,
 			&trade.RealizedPnLPercent, &trade.LiquidationPrice, &trade.StopLoss, &trade.TakeProfit,
 			&trade.TrailingStop, &trade.Status, &trade.EntryTime, &trade.ExitTime,
-			&trade.TradeSource, &trade.Notes, &trade.CreatedAt, &trade.UpdatedAt,
+			&trade.TradeSource, &trade.Notes, &trade.Tags, &trade.CreatedAt, &trade.UpdatedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan futures trade: %w", err)
@@ -212,7 +218,7 @@ func (db *DB) GetOpenFuturesTradeBySymbolForUser(ctx context.Context, userID, sy
 		SELECT id, symbol, position_side, side, entry_price, exit_price, mark_price,
 			quantity, leverage, margin_type, isolated_margin, realized_pnl, unrealized_pnl,
 			realized_pnl_percent, liquidation_price, stop_loss, take_profit, trailing_stop,
-			status, entry_time, exit_time, trade_source, notes, created_at, updated_at
+			status, entry_time, exit_time, trade_source, notes, tags, created_at, updated_at
 		FROM futures_trades
 		WHERE status = 'OPEN' AND user_id = $1 AND symbol = $2
 		ORDER BY entry_time DESC
@@ -225,7 +231,7 @@ func (db *DB) GetOpenFuturesTradeBySymbolForUser(ctx context.Context, userID, sy
 		&trade.MarginType, &trade.IsolatedMargin, &trade.RealizedPnL, &trade.UnrealizedPnL,
 		&trade.RealizedPnLPercent, &trade.LiquidationPrice, &trade.StopLoss, &trade.TakeProfit,
 		&trade.TrailingStop, &trade.Status, &trade.EntryTime, &trade.ExitTime,
-		&trade.TradeSource, &trade.Notes, &trade.CreatedAt, &trade.UpdatedAt,
+		&trade.TradeSource, &trade.Notes, &trade.Tags, &trade.CreatedAt, &trade.UpdatedAt,
 	)
 	if err != nil {
 		if err.Error() == "no rows in result set" {
@@ -293,6 +299,52 @@ func (db *DB) GetSymbolPerformanceStatsForUser(ctx context.Context, userID strin
 	return stats, nil
 }
 
+// GetSymbolPerformanceStatsSince aggregates performance metrics by symbol from
+// every user's trades closed since the given time
+func (db *DB) GetSymbolPerformanceStatsSince(ctx context.Context, since time.Time) (map[string]*SymbolPerformanceStats, error) {
+	query := `
+		SELECT
+			symbol,
+			COUNT(*) as total_trades,
+			COUNT(CASE WHEN realized_pnl > 0 THEN 1 END) as winning_trades,
+			COUNT(CASE WHEN realized_pnl <= 0 THEN 1 END) as losing_trades,
+			COALESCE(SUM(realized_pnl), 0) as total_pnl,
+			COALESCE(AVG(realized_pnl), 0) as avg_pnl,
+			COALESCE(AVG(CASE WHEN realized_pnl > 0 THEN realized_pnl END), 0) as avg_win,
+			COALESCE(ABS(AVG(CASE WHEN realized_pnl <= 0 THEN realized_pnl END)), 0) as avg_loss
+		FROM futures_trades
+		WHERE status IN ('CLOSED', 'closed', 'LIQUIDATED', 'liquidated') AND exit_time >= $1
+		GROUP BY symbol
+		ORDER BY total_pnl DESC`
+
+	rows, err := db.Pool.Query(ctx, query, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get symbol performance stats: %w", err)
+	}
+	defer rows.Close()
+
+	stats := make(map[string]*SymbolPerformanceStats)
+	for rows.Next() {
+		s := &SymbolPerformanceStats{}
+		err := rows.Scan(
+			&s.Symbol,
+			&s.TotalTrades,
+			&s.WinningTrades,
+			&s.LosingTrades,
+			&s.TotalPnL,
+			&s.AvgPnL,
+			&s.AvgWin,
+			&s.AvgLoss,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan symbol performance stats: %w", err)
+		}
+		stats[s.Symbol] = s
+	}
+
+	return stats, rows.Err()
+}
+
 // ==================== USER-SCOPED FUTURES ORDERS ====================
 
 // CreateFuturesOrderForUser creates a new futures order for a specific user
@@ -735,19 +787,29 @@ func (db *DB) GetFuturesTradingMetricsForUser(ctx context.Context, userID string
 	// Get last trade time
 	db.Pool.QueryRow(ctx, `SELECT MAX(exit_time) FROM futures_trades WHERE status != 'OPEN' AND user_id = $1`, userID).Scan(&metrics.LastTradeTime)
 
+	// Get MAE/MFE averages for SL/TP tuning
+	db.Pool.QueryRow(ctx, `
+		SELECT COALESCE(AVG(max_adverse_excursion), 0),
+			COALESCE(AVG(max_favorable_excursion), 0),
+			COALESCE(AVG(max_adverse_excursion) FILTER (WHERE realized_pnl > 0), 0),
+			COALESCE(AVG(max_favorable_excursion) FILTER (WHERE realized_pnl < 0), 0)
+		FROM futures_trades
+		WHERE status = 'CLOSED' AND max_adverse_excursion IS NOT NULL AND user_id = $1`, userID).Scan(
+		&metrics.AvgMAEPercent, &metrics.AvgMFEPercent, &metrics.AvgMAEWinnersPercent, &metrics.AvgMFELosersPercent)
+
 	return metrics, nil
 }
 
 // ==================== DAILY STATS FOR USER LIMITS ====================
 
-// GetDailyFuturesTradeCountForUser gets the number of futures trades placed today for a user
-func (db *DB) GetDailyFuturesTradeCountForUser(ctx context.Context, userID string) (int, error) {
+// GetDailyFuturesTradeCountForUser gets the number of futures trades placed since dayStart for a user
+func (db *DB) GetDailyFuturesTradeCountForUser(ctx context.Context, userID string, dayStart time.Time) (int, error) {
 	query := `
 		SELECT COUNT(*) FROM futures_trades
-		WHERE user_id = $1 AND entry_time >= CURRENT_DATE
+		WHERE user_id = $1 AND entry_time >= $2
 	`
 	var count int
-	err := db.Pool.QueryRow(ctx, query, userID).Scan(&count)
+	err := db.Pool.QueryRow(ctx, query, userID, dayStart).Scan(&count)
 	return count, err
 }
 
@@ -762,13 +824,13 @@ func (db *DB) GetDailyFuturesLossForUser(ctx context.Context, userID string) (fl
 	return loss, err
 }
 
-// GetDailyFuturesPnLForUser gets the total PnL for today for a user in futures
-func (db *DB) GetDailyFuturesPnLForUser(ctx context.Context, userID string) (float64, error) {
+// GetDailyFuturesPnLForUser gets the total PnL since dayStart for a user in futures
+func (db *DB) GetDailyFuturesPnLForUser(ctx context.Context, userID string, dayStart time.Time) (float64, error) {
 	query := `
 		SELECT COALESCE(SUM(realized_pnl), 0) FROM futures_trades
-		WHERE user_id = $1 AND exit_time >= CURRENT_DATE
+		WHERE user_id = $1 AND exit_time >= $2
 	`
 	var pnl float64
-	err := db.Pool.QueryRow(ctx, query, userID).Scan(&pnl)
+	err := db.Pool.QueryRow(ctx, query, userID, dayStart).Scan(&pnl)
 	return pnl, err
 }
@@ -36,6 +36,9 @@ func (r *Repository) CreateLicenseTable(ctx context.Context) error {
 	CREATE INDEX IF NOT EXISTS idx_licenses_type ON licenses(type);
 	CREATE INDEX IF NOT EXISTS idx_licenses_active ON licenses(is_active);
 
+	ALTER TABLE licenses ADD COLUMN IF NOT EXISTS revoked_at TIMESTAMP;
+	ALTER TABLE licenses ADD COLUMN IF NOT EXISTS revoke_reason TEXT;
+
 	CREATE TABLE IF NOT EXISTS license_usage_logs (
 		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
 		license_id UUID REFERENCES licenses(id) ON DELETE CASCADE,
@@ -90,7 +93,8 @@ func (r *Repository) GetLicenseByKey(ctx context.Context, key string) (*License,
 	query := `
 	SELECT id, key, type, COALESCE(customer_email, ''), COALESCE(customer_name, ''), max_symbols,
 	       COALESCE(features::text, '[]'), is_active, activated_at, expires_at, last_used_at,
-	       COALESCE(last_used_ip, ''), COALESCE(notes, ''), created_at, updated_at
+	       COALESCE(last_used_ip, ''), COALESCE(notes, ''), created_at, updated_at,
+	       revoked_at, COALESCE(revoke_reason, '')
 	FROM licenses
 	WHERE key = $1
 	`
@@ -113,6 +117,8 @@ func (r *Repository) GetLicenseByKey(ctx context.Context, key string) (*License,
 		&license.Notes,
 		&license.CreatedAt,
 		&license.UpdatedAt,
+		&license.RevokedAt,
+		&license.RevokeReason,
 	)
 
 	if err == pgx.ErrNoRows {
@@ -130,7 +136,8 @@ func (r *Repository) GetLicenseByID(ctx context.Context, id string) (*License, e
 	query := `
 	SELECT id, key, type, COALESCE(customer_email, ''), COALESCE(customer_name, ''), max_symbols,
 	       COALESCE(features::text, '[]'), is_active, activated_at, expires_at, last_used_at,
-	       COALESCE(last_used_ip, ''), COALESCE(notes, ''), created_at, updated_at
+	       COALESCE(last_used_ip, ''), COALESCE(notes, ''), created_at, updated_at,
+	       revoked_at, COALESCE(revoke_reason, '')
 	FROM licenses
 	WHERE id = $1
 	`
@@ -153,6 +160,8 @@ func (r *Repository) GetLicenseByID(ctx context.Context, id string) (*License, e
 		&license.Notes,
 		&license.CreatedAt,
 		&license.UpdatedAt,
+		&license.RevokedAt,
+		&license.RevokeReason,
 	)
 
 	if err == pgx.ErrNoRows {
@@ -194,7 +203,8 @@ func (r *Repository) ListLicenses(ctx context.Context, licenseType string, activ
 	query := fmt.Sprintf(`
 	SELECT id, key, type, COALESCE(customer_email, ''), COALESCE(customer_name, ''), max_symbols,
 	       COALESCE(features::text, '[]'), is_active, activated_at, expires_at, last_used_at,
-	       COALESCE(last_used_ip, ''), COALESCE(notes, ''), created_at, updated_at
+	       COALESCE(last_used_ip, ''), COALESCE(notes, ''), created_at, updated_at,
+	       revoked_at, COALESCE(revoke_reason, '')
 	FROM licenses
 	%s
 	ORDER BY created_at DESC
@@ -229,6 +239,8 @@ func (r *Repository) ListLicenses(ctx context.Context, licenseType string, activ
 			&license.Notes,
 			&license.CreatedAt,
 			&license.UpdatedAt,
+			&license.RevokedAt,
+			&license.RevokeReason,
 		)
 		if err != nil {
 			return nil, 0, fmt.Errorf("failed to scan license: %w", err)
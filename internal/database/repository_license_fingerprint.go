@@ -0,0 +1,77 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"binance-trading-bot/internal/license"
+)
+
+// GetLicenseFingerprints retrieves the installations a license key is bound to
+func (r *Repository) GetLicenseFingerprints(ctx context.Context, key string) ([]license.FingerprintBinding, error) {
+	query := `
+		SELECT fingerprint, first_seen, last_seen
+		FROM license_fingerprints
+		WHERE license_key = $1
+		ORDER BY first_seen
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get license fingerprints: %w", err)
+	}
+	defer rows.Close()
+
+	var bindings []license.FingerprintBinding
+	for rows.Next() {
+		var b license.FingerprintBinding
+		if err := rows.Scan(&b.Fingerprint, &b.FirstSeen, &b.LastSeen); err != nil {
+			return nil, fmt.Errorf("failed to scan license fingerprint: %w", err)
+		}
+		bindings = append(bindings, b)
+	}
+	return bindings, rows.Err()
+}
+
+// SaveLicenseFingerprints replaces the installations a license key is bound to
+func (r *Repository) SaveLicenseFingerprints(ctx context.Context, key string, bindings []license.FingerprintBinding) error {
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin license fingerprint update: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM license_fingerprints WHERE license_key = $1`, key); err != nil {
+		return fmt.Errorf("failed to clear license fingerprints: %w", err)
+	}
+	for _, b := range bindings {
+		_, err := tx.Exec(ctx,
+			`INSERT INTO license_fingerprints (license_key, fingerprint, first_seen, last_seen) VALUES ($1, $2, $3, $4)`,
+			key, b.Fingerprint, b.FirstSeen, b.LastSeen)
+		if err != nil {
+			return fmt.Errorf("failed to save license fingerprint: %w", err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit license fingerprints: %w", err)
+	}
+	return nil
+}
+
+// licenseFingerprintStore adapts the repository to license.FingerprintStore
+type licenseFingerprintStore struct {
+	repo *Repository
+}
+
+// LicenseFingerprintStore returns a license.FingerprintStore backed by this repository
+func (r *Repository) LicenseFingerprintStore() license.FingerprintStore {
+	return &licenseFingerprintStore{repo: r}
+}
+
+func (s *licenseFingerprintStore) LoadFingerprints(key string) ([]license.FingerprintBinding, error) {
+	return s.repo.GetLicenseFingerprints(context.Background(), key)
+}
+
+func (s *licenseFingerprintStore) SaveFingerprints(key string, bindings []license.FingerprintBinding) error {
+	return s.repo.SaveLicenseFingerprints(context.Background(), key, bindings)
+}
@@ -0,0 +1,115 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"binance-trading-bot/internal/license"
+)
+
+// SearchLicenses finds issued licenses whose key, customer email or customer
+// name contains term (case-insensitive), newest first
+func (r *Repository) SearchLicenses(ctx context.Context, term string, limit int) ([]License, error) {
+	query := `
+	SELECT id, key, type, COALESCE(customer_email, ''), COALESCE(customer_name, ''), max_symbols,
+	       COALESCE(features::text, '[]'), is_active, activated_at, expires_at, last_used_at,
+	       COALESCE(last_used_ip, ''), COALESCE(notes, ''), created_at, updated_at,
+	       revoked_at, COALESCE(revoke_reason, '')
+	FROM licenses
+	WHERE key ILIKE $1 OR customer_email ILIKE $1 OR customer_name ILIKE $1
+	ORDER BY created_at DESC
+	LIMIT $2
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query, "%"+strings.TrimSpace(term)+"%", limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search licenses: %w", err)
+	}
+	defer rows.Close()
+
+	var licenses []License
+	for rows.Next() {
+		var license License
+		err := rows.Scan(
+			&license.ID,
+			&license.Key,
+			&license.Type,
+			&license.CustomerEmail,
+			&license.CustomerName,
+			&license.MaxSymbols,
+			&license.Features,
+			&license.IsActive,
+			&license.ActivatedAt,
+			&license.ExpiresAt,
+			&license.LastUsedAt,
+			&license.LastUsedIP,
+			&license.Notes,
+			&license.CreatedAt,
+			&license.UpdatedAt,
+			&license.RevokedAt,
+			&license.RevokeReason,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan license: %w", err)
+		}
+		licenses = append(licenses, license)
+	}
+	return licenses, rows.Err()
+}
+
+// RevokeLicenseByKey permanently revokes an issued key. Unlike deactivation a
+// revoked key is also published on the revocation list. Returns false if the
+// key was never issued or is already revoked.
+func (r *Repository) RevokeLicenseByKey(ctx context.Context, key, reason string) (bool, error) {
+	query := `
+	UPDATE licenses
+	SET is_active = false, revoked_at = NOW(), revoke_reason = $2, updated_at = NOW()
+	WHERE key = $1 AND revoked_at IS NULL
+	`
+	tag, err := r.db.Pool.Exec(ctx, query, key, reason)
+	if err != nil {
+		return false, fmt.Errorf("failed to revoke license: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// GetRevokedLicenseKeys returns every revoked key, for exporting a revocation list
+func (r *Repository) GetRevokedLicenseKeys(ctx context.Context) ([]string, error) {
+	rows, err := r.db.Pool.Query(ctx, `SELECT key FROM licenses WHERE revoked_at IS NOT NULL ORDER BY revoked_at`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get revoked licenses: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []string
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, fmt.Errorf("failed to scan revoked license: %w", err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+// licenseRevocationList adapts the licenses table to license.RevocationList
+type licenseRevocationList struct {
+	repo *Repository
+}
+
+// LicenseRevocationList returns a license.RevocationList backed by the licenses table
+func (r *Repository) LicenseRevocationList() license.RevocationList {
+	return &licenseRevocationList{repo: r}
+}
+
+func (l *licenseRevocationList) IsRevoked(key string) (bool, error) {
+	var revoked bool
+	err := l.repo.db.Pool.QueryRow(context.Background(),
+		`SELECT EXISTS(SELECT 1 FROM licenses WHERE key = $1 AND revoked_at IS NOT NULL)`,
+		strings.ToUpper(strings.TrimSpace(key))).Scan(&revoked)
+	if err != nil {
+		return false, fmt.Errorf("failed to check license revocation: %w", err)
+	}
+	return revoked, nil
+}
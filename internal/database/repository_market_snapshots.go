@@ -0,0 +1,162 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// MarketKline is a recorded futures candle, kept so past market conditions
+// can be replayed through the live analysis code
+type MarketKline struct {
+	Symbol              string  `json:"symbol"`
+	Timeframe           string  `json:"timeframe"` // Binance interval, e.g. "1m", "1h"
+	OpenTime            int64   `json:"open_time"` // Unix milliseconds
+	Open                float64 `json:"open"`
+	High                float64 `json:"high"`
+	Low                 float64 `json:"low"`
+	Close               float64 `json:"close"`
+	Volume              float64 `json:"volume"`
+	CloseTime           int64   `json:"close_time"` // Unix milliseconds
+	QuoteVolume         float64 `json:"quote_volume"`
+	Trades              int     `json:"trades"`
+	TakerBuyVolume      float64 `json:"taker_buy_volume"`
+	TakerBuyQuoteVolume float64 `json:"taker_buy_quote_volume"`
+}
+
+// SaveMarketKlines records candles, overwriting any already recorded with the
+// same symbol, timeframe and open time
+func (db *DB) SaveMarketKlines(ctx context.Context, klines []MarketKline) error {
+	if len(klines) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO market_kline_snapshots (
+			symbol, timeframe, open_time, open, high, low, close, volume,
+			close_time, quote_volume, trades, taker_buy_volume, taker_buy_quote_volume
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (symbol, timeframe, open_time)
+		DO UPDATE SET
+			open = EXCLUDED.open,
+			high = EXCLUDED.high,
+			low = EXCLUDED.low,
+			close = EXCLUDED.close,
+			volume = EXCLUDED.volume,
+			close_time = EXCLUDED.close_time,
+			quote_volume = EXCLUDED.quote_volume,
+			trades = EXCLUDED.trades,
+			taker_buy_volume = EXCLUDED.taker_buy_volume,
+			taker_buy_quote_volume = EXCLUDED.taker_buy_quote_volume,
+			recorded_at = NOW()`
+
+	batch := &pgx.Batch{}
+	for _, k := range klines {
+		batch.Queue(query,
+			k.Symbol, k.Timeframe, k.OpenTime, k.Open, k.High, k.Low, k.Close, k.Volume,
+			k.CloseTime, k.QuoteVolume, k.Trades, k.TakerBuyVolume, k.TakerBuyQuoteVolume,
+		)
+	}
+	results := db.Pool.SendBatch(ctx, batch)
+	defer results.Close()
+	for _, k := range klines {
+		if _, err := results.Exec(); err != nil {
+			return fmt.Errorf("failed to save %s %s kline %d: %w", k.Symbol, k.Timeframe, k.OpenTime, err)
+		}
+	}
+	return nil
+}
+
+// GetMarketKlines returns the recorded candles of a symbol and timeframe that
+// open in [start, end), oldest first
+func (db *DB) GetMarketKlines(ctx context.Context, symbol, timeframe string, start, end time.Time) ([]MarketKline, error) {
+	query := `
+		SELECT symbol, timeframe, open_time, open, high, low, close, volume,
+			close_time, quote_volume, trades, taker_buy_volume, taker_buy_quote_volume
+		FROM market_kline_snapshots
+		WHERE symbol = $1 AND timeframe = $2 AND open_time >= $3 AND open_time < $4
+		ORDER BY open_time`
+
+	rows, err := db.Pool.Query(ctx, query, symbol, timeframe, start.UnixMilli(), end.UnixMilli())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get market klines: %w", err)
+	}
+	defer rows.Close()
+
+	var klines []MarketKline
+	for rows.Next() {
+		var k MarketKline
+		if err := rows.Scan(
+			&k.Symbol, &k.Timeframe, &k.OpenTime, &k.Open, &k.High, &k.Low, &k.Close, &k.Volume,
+			&k.CloseTime, &k.QuoteVolume, &k.Trades, &k.TakerBuyVolume, &k.TakerBuyQuoteVolume,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan market kline: %w", err)
+		}
+		klines = append(klines, k)
+	}
+	return klines, rows.Err()
+}
+
+// GetMarketKlineTimeframes returns the timeframes recorded for each symbol with
+// candles opening in [start, end)
+func (db *DB) GetMarketKlineTimeframes(ctx context.Context, start, end time.Time) (map[string][]string, error) {
+	query := `
+		SELECT DISTINCT symbol, timeframe
+		FROM market_kline_snapshots
+		WHERE open_time >= $1 AND open_time < $2
+		ORDER BY symbol, timeframe`
+
+	rows, err := db.Pool.Query(ctx, query, start.UnixMilli(), end.UnixMilli())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recorded timeframes: %w", err)
+	}
+	defer rows.Close()
+
+	timeframes := make(map[string][]string)
+	for rows.Next() {
+		var symbol, timeframe string
+		if err := rows.Scan(&symbol, &timeframe); err != nil {
+			return nil, fmt.Errorf("failed to scan recorded timeframe: %w", err)
+		}
+		timeframes[symbol] = append(timeframes[symbol], timeframe)
+	}
+	return timeframes, rows.Err()
+}
+
+// GetAIDecisionsBetween returns the AI decisions recorded in [start, end),
+// oldest first, optionally for one symbol. Replays compare against these.
+func (db *DB) GetAIDecisionsBetween(ctx context.Context, symbol string, start, end time.Time) ([]AIDecision, error) {
+	query := `
+		SELECT id, symbol, current_price, action, confidence, reasoning, signals,
+			confluence_count, risk_level, executed, created_at
+		FROM ai_decisions
+		WHERE created_at >= $1 AND created_at < $2
+		AND ($3 = '' OR symbol = $3)
+		ORDER BY created_at`
+
+	rows, err := db.Pool.Query(ctx, query, start, end, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AI decisions: %w", err)
+	}
+	defer rows.Close()
+
+	var decisions []AIDecision
+	for rows.Next() {
+		var d AIDecision
+		var signalsJSON []byte
+		if err := rows.Scan(
+			&d.ID, &d.Symbol, &d.CurrentPrice, &d.Action, &d.Confidence, &d.Reasoning, &signalsJSON,
+			&d.ConfluenceCount, &d.RiskLevel, &d.Executed, &d.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan AI decision: %w", err)
+		}
+		if len(signalsJSON) > 0 {
+			json.Unmarshal(signalsJSON, &d.Signals)
+		}
+		decisions = append(decisions, d)
+	}
+	return decisions, rows.Err()
+}
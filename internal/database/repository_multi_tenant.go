@@ -18,15 +18,17 @@ func (r *Repository) CreateTradeForUser(ctx context.Context, userID string, trad
 	if trade.TradeSource == "" {
 		trade.TradeSource = TradeSourceManual
 	}
+	trade.Tags = mergeTradeTags(autoTradeTags(trade.TradeSource, nil, trade.StrategyName), trade.Tags)
 	query := `
-		INSERT INTO trades (user_id, symbol, side, entry_price, quantity, entry_time, stop_loss, take_profit, strategy_name, status, trade_source)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		INSERT INTO trades (user_id, symbol, side, entry_price, quantity, entry_time, stop_loss, take_profit, strategy_name, status, trade_source, notes, tags)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 		RETURNING id, created_at, updated_at
 	`
 	return r.db.Pool.QueryRow(
 		ctx, query,
 		userID, trade.Symbol, trade.Side, trade.EntryPrice, trade.Quantity, trade.EntryTime,
 		trade.StopLoss, trade.TakeProfit, trade.StrategyName, trade.Status, trade.TradeSource,
+		trade.Notes, trade.Tags,
 	).Scan(&trade.ID, &trade.CreatedAt, &trade.UpdatedAt)
 }
 
@@ -34,7 +36,7 @@ func (r *Repository) CreateTradeForUser(ctx context.Context, userID string, trad
 func (r *Repository) GetTradeByIDForUser(ctx context.Context, userID string, id int64) (*Trade, error) {
 	query := `
 		SELECT id, symbol, side, entry_price, exit_price, quantity, entry_time, exit_time,
-		       stop_loss, take_profit, pnl, pnl_percent, strategy_name, status, created_at, updated_at, trade_source, ai_decision_id
+		       stop_loss, take_profit, pnl, pnl_percent, strategy_name, status, created_at, updated_at, trade_source, ai_decision_id, notes, tags
 		FROM trades
 		WHERE id = $1 AND user_id = $2
 	`
@@ -43,7 +45,7 @@ func (r *Repository) GetTradeByIDForUser(ctx context.Context, userID string, id
 		&trade.ID, &trade.Symbol, &trade.Side, &trade.EntryPrice, &trade.ExitPrice,
 		&trade.Quantity, &trade.EntryTime, &trade.ExitTime, &trade.StopLoss, &trade.TakeProfit,
 		&trade.PnL, &trade.PnLPercent, &trade.StrategyName, &trade.Status,
-		&trade.CreatedAt, &trade.UpdatedAt, &trade.TradeSource, &trade.AIDecisionID,
+		&trade.CreatedAt, &trade.UpdatedAt, &trade.TradeSource, &trade.AIDecisionID, &trade.Notes, &trade.Tags,
 	)
 	if err != nil {
 		return nil, err
@@ -55,7 +57,7 @@ func (r *Repository) GetTradeByIDForUser(ctx context.Context, userID string, id
 func (r *Repository) GetOpenTradesForUser(ctx context.Context, userID string) ([]*Trade, error) {
 	query := `
 		SELECT id, symbol, side, entry_price, exit_price, quantity, entry_time, exit_time,
-		       stop_loss, take_profit, pnl, pnl_percent, strategy_name, status, created_at, updated_at, trade_source, ai_decision_id
+		       stop_loss, take_profit, pnl, pnl_percent, strategy_name, status, created_at, updated_at, trade_source, ai_decision_id, notes, tags
 		FROM trades
 		WHERE status = 'OPEN' AND user_id = $1
 		ORDER BY entry_time DESC
@@ -67,7 +69,7 @@ func (r *Repository) GetOpenTradesForUser(ctx context.Context, userID string) ([
 func (r *Repository) GetTradeHistoryForUser(ctx context.Context, userID string, limit, offset int) ([]*Trade, error) {
 	query := `
 		SELECT id, symbol, side, entry_price, exit_price, quantity, entry_time, exit_time,
-		       stop_loss, take_profit, pnl, pnl_percent, strategy_name, status, created_at, updated_at, trade_source, NULL as ai_decision_id
+		       stop_loss, take_profit, pnl, pnl_percent, strategy_name, status, created_at, updated_at, trade_source, NULL as ai_decision_id, notes, tags
 		FROM trades
 		WHERE status = 'CLOSED' AND user_id = $1
 		ORDER BY exit_time DESC
@@ -80,7 +82,7 @@ func (r *Repository) GetTradeHistoryForUser(ctx context.Context, userID string,
 func (r *Repository) GetTradesBySymbolForUser(ctx context.Context, userID, symbol string) ([]*Trade, error) {
 	query := `
 		SELECT id, symbol, side, entry_price, exit_price, quantity, entry_time, exit_time,
-		       stop_loss, take_profit, pnl, pnl_percent, strategy_name, status, created_at, updated_at, trade_source, NULL as ai_decision_id
+		       stop_loss, take_profit, pnl, pnl_percent, strategy_name, status, created_at, updated_at, trade_source, NULL as ai_decision_id, notes, tags
 		FROM trades
 		WHERE symbol = $1 AND user_id = $2
 		ORDER BY entry_time DESC
@@ -192,15 +194,18 @@ func (r *Repository) UpdateOrderStatusForUser(ctx context.Context, userID string
 // CreateSignalForUser inserts a new signal for a specific user
 func (r *Repository) CreateSignalForUser(ctx context.Context, userID string, signal *Signal) error {
 	query := `
-		INSERT INTO signals (user_id, strategy_name, symbol, signal_type, entry_price, stop_loss, take_profit, quantity, reason, timestamp, executed)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		INSERT INTO signals (user_id, strategy_name, symbol, signal_type, entry_price, stop_loss, take_profit, quantity, reason, timestamp, executed, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 		RETURNING id, created_at
 	`
+	if signal.Status == "" {
+		signal.Status = "confirmed"
+	}
 	return r.db.Pool.QueryRow(
 		ctx, query,
 		userID, signal.StrategyName, signal.Symbol, signal.SignalType, signal.EntryPrice,
 		signal.StopLoss, signal.TakeProfit, signal.Quantity, signal.Reason,
-		signal.Timestamp, signal.Executed,
+		signal.Timestamp, signal.Executed, signal.Status,
 	).Scan(&signal.ID, &signal.CreatedAt)
 }
 
@@ -208,7 +213,7 @@ func (r *Repository) CreateSignalForUser(ctx context.Context, userID string, sig
 func (r *Repository) GetRecentSignalsForUser(ctx context.Context, userID string, limit int) ([]*Signal, error) {
 	query := `
 		SELECT id, strategy_name, symbol, signal_type, entry_price, stop_loss, take_profit,
-		       quantity, reason, timestamp, executed, created_at
+		       quantity, reason, timestamp, executed, status, created_at
 		FROM signals
 		WHERE user_id = $1
 		ORDER BY timestamp DESC
@@ -226,7 +231,7 @@ func (r *Repository) GetRecentSignalsForUser(ctx context.Context, userID string,
 		err := rows.Scan(
 			&signal.ID, &signal.StrategyName, &signal.Symbol, &signal.SignalType,
 			&signal.EntryPrice, &signal.StopLoss, &signal.TakeProfit, &signal.Quantity,
-			&signal.Reason, &signal.Timestamp, &signal.Executed, &signal.CreatedAt,
+			&signal.Reason, &signal.Timestamp, &signal.Executed, &signal.Status, &signal.CreatedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -375,17 +380,21 @@ func (r *Repository) CreateStrategyConfigForUser(ctx context.Context, userID str
 	if err != nil {
 		return fmt.Errorf("failed to marshal config params: %w", err)
 	}
+	scheduleJSON, err := marshalTradingSchedule(config.Schedule)
+	if err != nil {
+		return err
+	}
 
 	query := `
 		INSERT INTO strategy_configs (user_id, name, symbol, timeframe, indicator_type, autopilot, enabled,
-			position_size, stop_loss_percent, take_profit_percent, config_params)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+			position_size, stop_loss_percent, take_profit_percent, config_params, schedule)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 		RETURNING id, created_at, updated_at
 	`
 	return r.db.Pool.QueryRow(
 		ctx, query,
 		userID, config.Name, config.Symbol, config.Timeframe, config.IndicatorType, config.Autopilot,
-		config.Enabled, config.PositionSize, config.StopLossPercent, config.TakeProfitPercent, configJSON,
+		config.Enabled, config.PositionSize, config.StopLossPercent, config.TakeProfitPercent, configJSON, scheduleJSON,
 	).Scan(&config.ID, &config.CreatedAt, &config.UpdatedAt)
 }
 
@@ -393,7 +402,7 @@ func (r *Repository) CreateStrategyConfigForUser(ctx context.Context, userID str
 func (r *Repository) GetAllStrategyConfigsForUser(ctx context.Context, userID string) ([]*StrategyConfig, error) {
 	query := `
 		SELECT id, name, symbol, timeframe, indicator_type, autopilot, enabled,
-			   position_size, stop_loss_percent, take_profit_percent, config_params, created_at, updated_at
+			   position_size, stop_loss_percent, take_profit_percent, config_params, schedule, created_at, updated_at
 		FROM strategy_configs
 		WHERE user_id = $1
 		ORDER BY created_at DESC
@@ -407,11 +416,11 @@ func (r *Repository) GetAllStrategyConfigsForUser(ctx context.Context, userID st
 	var configs []*StrategyConfig
 	for rows.Next() {
 		config := &StrategyConfig{}
-		var configJSON []byte
+		var configJSON, scheduleJSON []byte
 		err := rows.Scan(
 			&config.ID, &config.Name, &config.Symbol, &config.Timeframe, &config.IndicatorType,
 			&config.Autopilot, &config.Enabled, &config.PositionSize, &config.StopLossPercent,
-			&config.TakeProfitPercent, &configJSON, &config.CreatedAt, &config.UpdatedAt,
+			&config.TakeProfitPercent, &configJSON, &scheduleJSON, &config.CreatedAt, &config.UpdatedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -421,6 +430,9 @@ func (r *Repository) GetAllStrategyConfigsForUser(ctx context.Context, userID st
 				return nil, err
 			}
 		}
+		if config.Schedule, err = unmarshalTradingSchedule(scheduleJSON); err != nil {
+			return nil, err
+		}
 		configs = append(configs, config)
 	}
 	return configs, rows.Err()
@@ -432,17 +444,22 @@ func (r *Repository) UpdateStrategyConfigForUser(ctx context.Context, userID str
 	if err != nil {
 		return fmt.Errorf("failed to marshal config params: %w", err)
 	}
+	scheduleJSON, err := marshalTradingSchedule(config.Schedule)
+	if err != nil {
+		return err
+	}
 
 	query := `
 		UPDATE strategy_configs
 		SET symbol = $2, timeframe = $3, indicator_type = $4, autopilot = $5, enabled = $6,
-			position_size = $7, stop_loss_percent = $8, take_profit_percent = $9, config_params = $10
-		WHERE id = $1 AND user_id = $11
+			position_size = $7, stop_loss_percent = $8, take_profit_percent = $9, config_params = $10,
+			schedule = $11
+		WHERE id = $1 AND user_id = $12
 	`
 	_, err = r.db.Pool.Exec(
 		ctx, query,
 		config.ID, config.Symbol, config.Timeframe, config.IndicatorType, config.Autopilot,
-		config.Enabled, config.PositionSize, config.StopLossPercent, config.TakeProfitPercent, configJSON, userID,
+		config.Enabled, config.PositionSize, config.StopLossPercent, config.TakeProfitPercent, configJSON, scheduleJSON, userID,
 	)
 	return err
 }
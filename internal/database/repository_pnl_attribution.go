@@ -0,0 +1,132 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// PnLAttribution is the realized PnL of closed futures trades sharing a mode,
+// strategy or source
+type PnLAttribution struct {
+	Key           string  `json:"key"`
+	TotalTrades   int     `json:"total_trades"`
+	WinningTrades int     `json:"winning_trades"`
+	LosingTrades  int     `json:"losing_trades"`
+	TotalPnL      float64 `json:"total_pnl"`
+	GrossProfit   float64 `json:"gross_profit"`
+	GrossLoss     float64 `json:"gross_loss"` // Negative
+	AvgPnL        float64 `json:"avg_pnl"`
+	WinRate       float64 `json:"win_rate"`      // Percent
+	ProfitFactor  float64 `json:"profit_factor"` // 0 when there are no losses
+}
+
+// PnLAttributionReport breaks realized PnL down by trading mode, strategy and
+// trade source, each group sorted by total PnL descending
+type PnLAttributionReport struct {
+	ByMode     []PnLAttribution `json:"by_mode"`
+	ByStrategy []PnLAttribution `json:"by_strategy"`
+	BySource   []PnLAttribution `json:"by_source"`
+}
+
+// pnlAttributionGroups maps each attribution group to its key expression.
+// Trades with no mode or strategy are grouped under "unknown" and "none".
+var pnlAttributionGroups = map[string]string{
+	"mode":     "COALESCE(NULLIF(trading_mode, ''), 'unknown')",
+	"strategy": "COALESCE(NULLIF(strategy_name, ''), 'none')",
+	"source":   "COALESCE(NULLIF(trade_source, ''), 'unknown')",
+}
+
+// finalize derives the averages and ratios from the summed counts
+func (a *PnLAttribution) finalize() {
+	if a.TotalTrades > 0 {
+		a.AvgPnL = a.TotalPnL / float64(a.TotalTrades)
+		a.WinRate = float64(a.WinningTrades) / float64(a.TotalTrades) * 100
+	}
+	if a.GrossLoss < 0 {
+		a.ProfitFactor = a.GrossProfit / -a.GrossLoss
+	}
+}
+
+// GetPnLAttribution aggregates closed futures trades by mode, strategy and
+// source. Trades are matched on exit time; zero from/to leave the range open
+// and an empty userID matches trades regardless of owner.
+func (db *DB) GetPnLAttribution(ctx context.Context, userID string, from, to time.Time) (*PnLAttributionReport, error) {
+	report := &PnLAttributionReport{}
+	var err error
+	if report.ByMode, err = db.getPnLAttributionGroup(ctx, "mode", userID, from, to); err != nil {
+		return nil, err
+	}
+	if report.ByStrategy, err = db.getPnLAttributionGroup(ctx, "strategy", userID, from, to); err != nil {
+		return nil, err
+	}
+	if report.BySource, err = db.getPnLAttributionGroup(ctx, "source", userID, from, to); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// getPnLAttributionGroup aggregates closed futures trades by one attribution group
+func (db *DB) getPnLAttributionGroup(ctx context.Context, group, userID string, from, to time.Time) ([]PnLAttribution, error) {
+	keyExpr, ok := pnlAttributionGroups[group]
+	if !ok {
+		return nil, fmt.Errorf("unknown attribution group %q", group)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			%s AS key,
+			COUNT(*),
+			COUNT(*) FILTER (WHERE realized_pnl > 0),
+			COUNT(*) FILTER (WHERE realized_pnl < 0),
+			COALESCE(SUM(realized_pnl), 0),
+			COALESCE(SUM(realized_pnl) FILTER (WHERE realized_pnl > 0), 0),
+			COALESCE(SUM(realized_pnl) FILTER (WHERE realized_pnl < 0), 0)
+		FROM futures_trades
+		WHERE status = 'CLOSED'
+		  AND realized_pnl IS NOT NULL
+		  AND ($1 = '' OR user_id::text = $1)
+		  AND ($2::timestamptz IS NULL OR exit_time >= $2)
+		  AND ($3::timestamptz IS NULL OR exit_time < $3)
+		GROUP BY 1
+		ORDER BY 5 DESC`, keyExpr)
+
+	rows, err := db.Pool.Query(ctx, query, userID, nullableTime(from), nullableTime(to))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PnL attribution by %s: %w", group, err)
+	}
+	defer rows.Close()
+
+	attributions := make([]PnLAttribution, 0)
+	for rows.Next() {
+		var a PnLAttribution
+		if err := rows.Scan(&a.Key, &a.TotalTrades, &a.WinningTrades, &a.LosingTrades,
+			&a.TotalPnL, &a.GrossProfit, &a.GrossLoss); err != nil {
+			return nil, fmt.Errorf("failed to scan PnL attribution: %w", err)
+		}
+		a.finalize()
+		attributions = append(attributions, a)
+	}
+
+	return attributions, rows.Err()
+}
+
+// GetRealizedPnLTotal sums realized PnL of closed futures trades exited in the
+// range, for one symbol or all (empty), scoped like GetPnLAttribution. Used to
+// reconcile the bot's figures against the exchange income history.
+func (db *DB) GetRealizedPnLTotal(ctx context.Context, userID, symbol string, from, to time.Time) (total float64, trades int, err error) {
+	query := `
+		SELECT COALESCE(SUM(realized_pnl), 0), COUNT(*)
+		FROM futures_trades
+		WHERE status = 'CLOSED'
+		  AND realized_pnl IS NOT NULL
+		  AND ($1 = '' OR user_id::text = $1)
+		  AND ($2 = '' OR symbol = $2)
+		  AND ($3::timestamptz IS NULL OR exit_time >= $3)
+		  AND ($4::timestamptz IS NULL OR exit_time < $4)`
+
+	if err := db.Pool.QueryRow(ctx, query, userID, symbol, nullableTime(from), nullableTime(to)).Scan(&total, &trades); err != nil {
+		return 0, 0, fmt.Errorf("failed to get realized PnL total: %w", err)
+	}
+	return total, trades, nil
+}
@@ -0,0 +1,20 @@
+package database
+
+import (
+	"testing"
+)
+
+func TestPnLAttributionFinalize(t *testing.T) {
+	a := PnLAttribution{Key: "scalp", TotalTrades: 4, WinningTrades: 3, LosingTrades: 1,
+		TotalPnL: 20, GrossProfit: 30, GrossLoss: -10}
+	a.finalize()
+	if a.AvgPnL != 5 || a.WinRate != 75 || a.ProfitFactor != 3 {
+		t.Errorf("finalized = %+v, want avg 5, win rate 75, profit factor 3", a)
+	}
+
+	winsOnly := PnLAttribution{Key: "swing", TotalTrades: 2, WinningTrades: 2, TotalPnL: 8, GrossProfit: 8}
+	winsOnly.finalize()
+	if winsOnly.ProfitFactor != 0 || winsOnly.WinRate != 100 {
+		t.Errorf("no-loss group = %+v, want profit factor 0 and win rate 100", winsOnly)
+	}
+}
@@ -0,0 +1,313 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// =====================================================
+// SETTINGS SNAPSHOTS (versioned config history)
+// =====================================================
+
+// Settings snapshot sources
+const (
+	SnapshotSourceManual = "manual"
+	SnapshotSourceAuto   = "auto"
+)
+
+// maxAutoSettingsSnapshots is how many automatic snapshots are kept per user;
+// older ones are pruned when a new one is taken. Manual snapshots are never pruned.
+const maxAutoSettingsSnapshots = 50
+
+// SettingsSnapshot is a labelled copy of a user's settings at a point in time
+type SettingsSnapshot struct {
+	ID        int64                 `json:"id"`
+	UserID    string                `json:"user_id"`
+	Label     string                `json:"label"`
+	Source    string                `json:"source"` // manual, auto
+	Data      *SettingsSnapshotData `json:"data,omitempty"`
+	CreatedAt time.Time             `json:"created_at"`
+}
+
+// SettingsSnapshotData is what a snapshot holds: mode configs, per-mode safety
+// settings and per-symbol settings
+type SettingsSnapshotData struct {
+	Modes   map[string]*SnapshotModeConfig     `json:"modes"`
+	Safety  map[string]*UserSafetySettings     `json:"safety"`
+	Symbols map[string]*SnapshotSymbolSettings `json:"symbols"`
+}
+
+// SnapshotModeConfig is a mode's enabled flag and its full config JSON
+type SnapshotModeConfig struct {
+	Enabled bool            `json:"enabled"`
+	Config  json.RawMessage `json:"config"`
+}
+
+// SnapshotSymbolSettings is the configurable part of UserSymbolSettings;
+// trade statistics aren't settings and are left alone on restore
+type SnapshotSymbolSettings struct {
+	Category         string  `json:"category"`
+	MinConfidence    float64 `json:"min_confidence"`
+	MaxPositionUSD   float64 `json:"max_position_usd"`
+	SizeMultiplier   float64 `json:"size_multiplier"`
+	LeverageOverride int     `json:"leverage_override"`
+	Enabled          bool    `json:"enabled"`
+	CustomROIPercent float64 `json:"custom_roi_percent"`
+	Notes            string  `json:"notes"`
+}
+
+// SettingsSnapshotChange is one setting that differs between two snapshots.
+// Path is dot separated, e.g. "modes.scalp.config.sltp.stop_loss_percent".
+type SettingsSnapshotChange struct {
+	Path string      `json:"path"`
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+// CollectUserSettings reads the user's current settings in snapshot form
+func (r *Repository) CollectUserSettings(ctx context.Context, userID string) (*SettingsSnapshotData, error) {
+	modes, err := r.GetAllUserModeConfigsWithEnabled(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	safety, err := r.GetAllUserSafetySettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	symbols, err := r.GetAllUserSymbolSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &SettingsSnapshotData{
+		Modes:   make(map[string]*SnapshotModeConfig, len(modes)),
+		Safety:  make(map[string]*UserSafetySettings, len(safety)),
+		Symbols: make(map[string]*SnapshotSymbolSettings, len(symbols)),
+	}
+	for name, m := range modes {
+		data.Modes[name] = &SnapshotModeConfig{Enabled: m.Enabled, Config: m.ConfigJSON}
+	}
+	for mode, s := range safety {
+		// Row identity and timestamps would show up as noise in every diff
+		s.ID, s.UserID = "", ""
+		s.CreatedAt, s.UpdatedAt = time.Time{}, time.Time{}
+		data.Safety[mode] = s
+	}
+	for symbol, s := range symbols {
+		data.Symbols[symbol] = &SnapshotSymbolSettings{
+			Category:         s.Category,
+			MinConfidence:    s.MinConfidence,
+			MaxPositionUSD:   s.MaxPositionUSD,
+			SizeMultiplier:   s.SizeMultiplier,
+			LeverageOverride: s.LeverageOverride,
+			Enabled:          s.Enabled,
+			CustomROIPercent: s.CustomROIPercent,
+			Notes:            s.Notes,
+		}
+	}
+	return data, nil
+}
+
+// CaptureUserSettingsSnapshot saves a snapshot of the user's current settings.
+// Taking an automatic snapshot prunes the user's oldest automatic ones.
+func (r *Repository) CaptureUserSettingsSnapshot(ctx context.Context, userID, label, source string) (*SettingsSnapshot, error) {
+	data, err := r.CollectUserSettings(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read settings for snapshot: %w", err)
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal settings snapshot: %w", err)
+	}
+
+	snapshot := &SettingsSnapshot{UserID: userID, Label: label, Source: source, Data: data}
+	err = r.db.Pool.QueryRow(ctx, `
+		INSERT INTO settings_snapshots (user_id, label, source, data)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
+	`, userID, label, source, dataJSON).Scan(&snapshot.ID, &snapshot.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save settings snapshot: %w", err)
+	}
+
+	if source == SnapshotSourceAuto {
+		_, err := r.db.Pool.Exec(ctx, `
+			DELETE FROM settings_snapshots
+			WHERE user_id = $1 AND source = $2 AND id NOT IN (
+				SELECT id FROM settings_snapshots
+				WHERE user_id = $1 AND source = $2
+				ORDER BY created_at DESC, id DESC
+				LIMIT $3
+			)
+		`, userID, SnapshotSourceAuto, maxAutoSettingsSnapshots)
+		if err != nil {
+			return nil, fmt.Errorf("failed to prune settings snapshots: %w", err)
+		}
+	}
+	return snapshot, nil
+}
+
+// ListUserSettingsSnapshots returns the user's snapshots, newest first, without their data
+func (r *Repository) ListUserSettingsSnapshots(ctx context.Context, userID string, limit int) ([]*SettingsSnapshot, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+	rows, err := r.db.Pool.Query(ctx, `
+		SELECT id, user_id, label, source, created_at
+		FROM settings_snapshots
+		WHERE user_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query settings snapshots: %w", err)
+	}
+	defer rows.Close()
+
+	var snapshots []*SettingsSnapshot
+	for rows.Next() {
+		s := &SettingsSnapshot{}
+		if err := rows.Scan(&s.ID, &s.UserID, &s.Label, &s.Source, &s.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan settings snapshot: %w", err)
+		}
+		snapshots = append(snapshots, s)
+	}
+	return snapshots, rows.Err()
+}
+
+// GetUserSettingsSnapshot returns one of the user's snapshots with its data,
+// or nil if it doesn't exist
+func (r *Repository) GetUserSettingsSnapshot(ctx context.Context, userID string, id int64) (*SettingsSnapshot, error) {
+	s := &SettingsSnapshot{}
+	var dataJSON []byte
+	err := r.db.Pool.QueryRow(ctx, `
+		SELECT id, user_id, label, source, data, created_at
+		FROM settings_snapshots
+		WHERE user_id = $1 AND id = $2
+	`, userID, id).Scan(&s.ID, &s.UserID, &s.Label, &s.Source, &dataJSON, &s.CreatedAt)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get settings snapshot: %w", err)
+	}
+	if err := json.Unmarshal(dataJSON, &s.Data); err != nil {
+		return nil, fmt.Errorf("failed to parse settings snapshot %d: %w", id, err)
+	}
+	return s, nil
+}
+
+// RestoreUserSettingsSnapshot writes a snapshot's settings back. Modes, safety
+// modes and symbols that aren't in the snapshot are left as they are, and
+// symbol trade statistics are kept.
+func (r *Repository) RestoreUserSettingsSnapshot(ctx context.Context, snapshot *SettingsSnapshot) error {
+	if snapshot == nil || snapshot.Data == nil {
+		return fmt.Errorf("settings snapshot has no data")
+	}
+	userID := snapshot.UserID
+
+	for name, m := range snapshot.Data.Modes {
+		if err := r.SaveUserModeConfig(ctx, userID, name, m.Enabled, m.Config); err != nil {
+			return err
+		}
+	}
+
+	for mode, s := range snapshot.Data.Safety {
+		restored := *s
+		restored.UserID, restored.Mode = userID, mode
+		if err := r.SaveUserSafetySettings(ctx, &restored); err != nil {
+			return err
+		}
+	}
+
+	current, err := r.GetAllUserSymbolSettings(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for symbol, s := range snapshot.Data.Symbols {
+		restored := current[symbol]
+		if restored == nil {
+			restored = &UserSymbolSettings{UserID: userID, Symbol: symbol}
+		}
+		restored.Category = s.Category
+		restored.MinConfidence = s.MinConfidence
+		restored.MaxPositionUSD = s.MaxPositionUSD
+		restored.SizeMultiplier = s.SizeMultiplier
+		restored.LeverageOverride = s.LeverageOverride
+		restored.Enabled = s.Enabled
+		restored.CustomROIPercent = s.CustomROIPercent
+		restored.Notes = s.Notes
+		if err := r.UpsertUserSymbolSettings(ctx, restored); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DiffSettingsSnapshots lists every setting that differs between two snapshots,
+// sorted by path. Settings present in only one side have a nil From or To.
+func DiffSettingsSnapshots(from, to *SettingsSnapshotData) ([]SettingsSnapshotChange, error) {
+	fromFlat, err := flattenSnapshotData(from)
+	if err != nil {
+		return nil, err
+	}
+	toFlat, err := flattenSnapshotData(to)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []SettingsSnapshotChange{}
+	for path, fromValue := range fromFlat {
+		toValue, ok := toFlat[path]
+		if !ok || !reflect.DeepEqual(fromValue, toValue) {
+			changes = append(changes, SettingsSnapshotChange{Path: path, From: fromValue, To: toValue})
+		}
+	}
+	for path, toValue := range toFlat {
+		if _, ok := fromFlat[path]; !ok {
+			changes = append(changes, SettingsSnapshotChange{Path: path, To: toValue})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Path < changes[j].Path })
+	return changes, nil
+}
+
+// flattenSnapshotData maps every leaf setting in the snapshot to its dotted path.
+// Arrays are compared as a whole.
+func flattenSnapshotData(data *SettingsSnapshotData) (map[string]interface{}, error) {
+	flat := make(map[string]interface{})
+	if data == nil {
+		return flat, nil
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal settings snapshot: %w", err)
+	}
+	var tree interface{}
+	if err := json.Unmarshal(raw, &tree); err != nil {
+		return nil, fmt.Errorf("failed to parse settings snapshot: %w", err)
+	}
+	flattenSettingsValue("", tree, flat)
+	return flat, nil
+}
+
+func flattenSettingsValue(path string, value interface{}, flat map[string]interface{}) {
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		flat[path] = value
+		return
+	}
+	for key, child := range obj {
+		childPath := key
+		if path != "" {
+			childPath = path + "." + key
+		}
+		flattenSettingsValue(childPath, child, flat)
+	}
+}
@@ -0,0 +1,78 @@
+package database
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+)
+
+func TestDiffSettingsSnapshots(t *testing.T) {
+	from := &SettingsSnapshotData{
+		Modes: map[string]*SnapshotModeConfig{
+			"scalp": {Enabled: true, Config: json.RawMessage(`{"sltp":{"stop_loss_percent":1.5,"take_profit_percent":3},"tags":["a"]}`)},
+		},
+		Safety: map[string]*UserSafetySettings{
+			"scalp": {Mode: "scalp", MaxTradesPerHour: 10},
+		},
+		Symbols: map[string]*SnapshotSymbolSettings{
+			"BTCUSDT": {Category: "best", SizeMultiplier: 1, Enabled: true},
+		},
+	}
+	to := &SettingsSnapshotData{
+		Modes: map[string]*SnapshotModeConfig{
+			"scalp": {Enabled: false, Config: json.RawMessage(`{"sltp":{"stop_loss_percent":2,"take_profit_percent":3},"tags":["a","b"]}`)},
+		},
+		Safety: map[string]*UserSafetySettings{
+			"scalp": {Mode: "scalp", MaxTradesPerHour: 10},
+		},
+		Symbols: map[string]*SnapshotSymbolSettings{
+			"ETHUSDT": {Category: "good", SizeMultiplier: 1, Enabled: true},
+		},
+	}
+
+	changes, err := DiffSettingsSnapshots(from, to)
+	if err != nil {
+		t.Fatalf("DiffSettingsSnapshots: %v", err)
+	}
+	byPath := make(map[string]SettingsSnapshotChange)
+	var paths []string
+	for _, c := range changes {
+		byPath[c.Path] = c
+		paths = append(paths, c.Path)
+	}
+
+	for _, path := range []string{
+		"modes.scalp.enabled",
+		"modes.scalp.config.sltp.stop_loss_percent",
+		"modes.scalp.config.tags",
+		"symbols.BTCUSDT.category",
+		"symbols.ETHUSDT.category",
+	} {
+		if _, ok := byPath[path]; !ok {
+			t.Errorf("missing change %s in %v", path, paths)
+		}
+	}
+	for _, path := range []string{"modes.scalp.config.sltp.take_profit_percent", "safety.scalp.max_trades_per_hour"} {
+		if _, ok := byPath[path]; ok {
+			t.Errorf("unchanged setting %s reported as changed", path)
+		}
+	}
+
+	if c := byPath["modes.scalp.config.sltp.stop_loss_percent"]; c.From != 1.5 || c.To != 2.0 {
+		t.Errorf("stop_loss_percent change = %v -> %v, want 1.5 -> 2", c.From, c.To)
+	}
+	if c := byPath["symbols.BTCUSDT.category"]; c.From != "best" || c.To != nil {
+		t.Errorf("removed symbol change = %v -> %v, want best -> nil", c.From, c.To)
+	}
+	if c := byPath["symbols.ETHUSDT.category"]; c.From != nil || c.To != "good" {
+		t.Errorf("added symbol change = %v -> %v, want nil -> good", c.From, c.To)
+	}
+
+	same, err := DiffSettingsSnapshots(from, from)
+	if err != nil || len(same) != 0 {
+		t.Errorf("diff of a snapshot with itself = %v, %v; want no changes", same, err)
+	}
+	if !sort.StringsAreSorted(paths) {
+		t.Errorf("changes not sorted by path: %v", paths)
+	}
+}
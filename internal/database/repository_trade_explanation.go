@@ -0,0 +1,131 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// TradeExplanationCache is an LLM retrospective cached on a trade
+type TradeExplanationCache struct {
+	Explanation json.RawMessage `json:"explanation"`
+	ExplainedAt time.Time       `json:"explained_at"`
+}
+
+// GetFuturesTradeForReview loads a futures trade with the fields a retrospective
+// needs (mode, excursions) and its cached explanation, nil when there is none.
+// An empty userID matches trades regardless of owner.
+func (r *Repository) GetFuturesTradeForReview(ctx context.Context, userID string, id int64) (*FuturesTrade, *TradeExplanationCache, error) {
+	query := `
+		SELECT id, symbol, position_side, side, entry_price, exit_price, quantity, leverage,
+			realized_pnl, realized_pnl_percent, stop_loss, take_profit, status, entry_time, exit_time,
+			trade_source, notes, tags, ai_decision_id, strategy_name, trading_mode,
+			max_adverse_excursion, max_favorable_excursion, llm_explanation, llm_explained_at
+		FROM futures_trades
+		WHERE id = $1 AND ($2 = '' OR user_id::text = $2)`
+
+	trade := &FuturesTrade{}
+	var explanation []byte
+	var explainedAt *time.Time
+	err := r.db.Pool.QueryRow(ctx, query, id, userID).Scan(
+		&trade.ID, &trade.Symbol, &trade.PositionSide, &trade.Side, &trade.EntryPrice,
+		&trade.ExitPrice, &trade.Quantity, &trade.Leverage, &trade.RealizedPnL,
+		&trade.RealizedPnLPercent, &trade.StopLoss, &trade.TakeProfit, &trade.Status,
+		&trade.EntryTime, &trade.ExitTime, &trade.TradeSource, &trade.Notes, &trade.Tags,
+		&trade.AIDecisionID, &trade.StrategyName, &trade.TradingMode,
+		&trade.MaxAdverseExcursion, &trade.MaxFavorableExcursion, &explanation, &explainedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil, fmt.Errorf("futures trade %d: %w", id, ErrTradeNotFound)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get futures trade for review: %w", err)
+	}
+	return trade, explanationCache(explanation, explainedAt), nil
+}
+
+// SaveFuturesTradeExplanation caches an LLM retrospective on a futures trade.
+// An empty userID matches trades regardless of owner.
+func (r *Repository) SaveFuturesTradeExplanation(ctx context.Context, userID string, id int64, explanation interface{}) error {
+	data, err := json.Marshal(explanation)
+	if err != nil {
+		return fmt.Errorf("failed to marshal trade explanation: %w", err)
+	}
+	query := `
+		UPDATE futures_trades SET llm_explanation = $2, llm_explained_at = NOW()
+		WHERE id = $1 AND ($3 = '' OR user_id::text = $3)`
+
+	result, err := r.db.Pool.Exec(ctx, query, id, data, userID)
+	if err != nil {
+		return fmt.Errorf("failed to save futures trade explanation: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("futures trade %d: %w", id, ErrTradeNotFound)
+	}
+	return nil
+}
+
+// GetTradeLifecycleEvents returns a futures trade's lifecycle events, oldest first
+func (r *Repository) GetTradeLifecycleEvents(ctx context.Context, futuresTradeID int64) ([]TradeLifecycleEvent, error) {
+	return r.db.GetTradeLifecycleEvents(ctx, futuresTradeID)
+}
+
+// GetTradeForReview loads a spot trade and its cached explanation, nil when
+// there is none. An empty userID matches trades regardless of owner.
+func (r *Repository) GetTradeForReview(ctx context.Context, userID string, id int64) (*Trade, *TradeExplanationCache, error) {
+	query := `
+		SELECT id, symbol, side, entry_price, exit_price, quantity, entry_time, exit_time,
+		       stop_loss, take_profit, pnl, pnl_percent, strategy_name, status, trade_source,
+		       ai_decision_id, notes, tags, llm_explanation, llm_explained_at
+		FROM trades
+		WHERE id = $1 AND ($2 = '' OR user_id::text = $2)`
+
+	trade := &Trade{}
+	var explanation []byte
+	var explainedAt *time.Time
+	err := r.db.Pool.QueryRow(ctx, query, id, userID).Scan(
+		&trade.ID, &trade.Symbol, &trade.Side, &trade.EntryPrice, &trade.ExitPrice,
+		&trade.Quantity, &trade.EntryTime, &trade.ExitTime, &trade.StopLoss, &trade.TakeProfit,
+		&trade.PnL, &trade.PnLPercent, &trade.StrategyName, &trade.Status, &trade.TradeSource,
+		&trade.AIDecisionID, &trade.Notes, &trade.Tags, &explanation, &explainedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil, fmt.Errorf("trade %d: %w", id, ErrTradeNotFound)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get trade for review: %w", err)
+	}
+	return trade, explanationCache(explanation, explainedAt), nil
+}
+
+// SaveTradeExplanation caches an LLM retrospective on a spot trade. An empty
+// userID matches trades regardless of owner.
+func (r *Repository) SaveTradeExplanation(ctx context.Context, userID string, id int64, explanation interface{}) error {
+	data, err := json.Marshal(explanation)
+	if err != nil {
+		return fmt.Errorf("failed to marshal trade explanation: %w", err)
+	}
+	query := `
+		UPDATE trades SET llm_explanation = $2, llm_explained_at = NOW()
+		WHERE id = $1 AND ($3 = '' OR user_id::text = $3)`
+
+	result, err := r.db.Pool.Exec(ctx, query, id, data, userID)
+	if err != nil {
+		return fmt.Errorf("failed to save trade explanation: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("trade %d: %w", id, ErrTradeNotFound)
+	}
+	return nil
+}
+
+func explanationCache(explanation []byte, explainedAt *time.Time) *TradeExplanationCache {
+	if len(explanation) == 0 || explainedAt == nil {
+		return nil
+	}
+	return &TradeExplanationCache{Explanation: explanation, ExplainedAt: *explainedAt}
+}
@@ -0,0 +1,172 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrTradeNotFound is returned when a journal update matches no trade
+var ErrTradeNotFound = errors.New("trade not found")
+
+// maxTradeTagLength caps a single journal tag
+const maxTradeTagLength = 40
+
+// TradeJournalUpdate edits a trade's journal. Nil fields are left unchanged;
+// an empty Notes clears the notes and an empty (non-nil) Tags clears the tags.
+type TradeJournalUpdate struct {
+	Notes *string  `json:"notes,omitempty"`
+	Tags  []string `json:"tags,omitempty"`
+}
+
+// NormalizeTradeTags lowercases and trims tags, joins inner whitespace with
+// dashes, truncates long tags and drops empties and duplicates, keeping order
+func NormalizeTradeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.Join(strings.Fields(strings.ToLower(tag)), "-")
+		if len(tag) > maxTradeTagLength {
+			tag = tag[:maxTradeTagLength]
+		}
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized
+}
+
+// autoTradeTags derives the journal tags every trade gets from its trigger
+// source: trade source (manual, ginie, ai, sync...), trading mode and strategy
+func autoTradeTags(source string, mode, strategy *string) []string {
+	tags := []string{source}
+	if mode != nil {
+		tags = append(tags, *mode)
+	}
+	if strategy != nil {
+		tags = append(tags, "strategy:"+*strategy)
+	}
+	return tags
+}
+
+// mergeTradeTags combines tag lists into one normalized list
+func mergeTradeTags(lists ...[]string) []string {
+	var all []string
+	for _, list := range lists {
+		all = append(all, list...)
+	}
+	return NormalizeTradeTags(all)
+}
+
+// journalArgs converts an update into query args: set-notes flag, notes, tags (NULL = keep)
+func (u TradeJournalUpdate) journalArgs() (bool, string, []string) {
+	notes := ""
+	if u.Notes != nil {
+		notes = strings.TrimSpace(*u.Notes)
+	}
+	var tags []string
+	if u.Tags != nil {
+		tags = NormalizeTradeTags(u.Tags)
+	}
+	return u.Notes != nil, notes, tags
+}
+
+// UpdateFuturesTradeJournal sets notes and/or tags on a futures trade. An empty
+// userID matches trades regardless of owner (single-user deployments).
+func (db *DB) UpdateFuturesTradeJournal(ctx context.Context, userID string, id int64, update TradeJournalUpdate) error {
+	setNotes, notes, tags := update.journalArgs()
+	query := `
+		UPDATE futures_trades SET
+			notes = CASE WHEN $2 THEN NULLIF($3, '') ELSE notes END,
+			tags = COALESCE($4::text[], tags),
+			updated_at = NOW()
+		WHERE id = $1 AND ($5 = '' OR user_id::text = $5)`
+
+	result, err := db.Pool.Exec(ctx, query, id, setNotes, notes, tags, userID)
+	if err != nil {
+		return fmt.Errorf("failed to update futures trade journal: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("futures trade %d: %w", id, ErrTradeNotFound)
+	}
+	return nil
+}
+
+// GetFuturesTradesByTag retrieves open and closed futures trades carrying tag,
+// newest first. An empty userID matches trades regardless of owner.
+func (db *DB) GetFuturesTradesByTag(ctx context.Context, userID, tag string, limit, offset int) ([]FuturesTrade, error) {
+	query := `
+		SELECT id, symbol, position_side, side, entry_price, exit_price, mark_price,
+			quantity, leverage, margin_type, isolated_margin, realized_pnl, unrealized_pnl,
+			realized_pnl_percent, liquidation_price, stop_loss, take_profit, trailing_stop,
+			status, entry_time, exit_time, trade_source, notes, tags, ai_decision_id,
+			strategy_id, strategy_name, created_at, updated_at
+		FROM futures_trades
+		WHERE $1 = ANY(tags) AND ($2 = '' OR user_id::text = $2)
+		ORDER BY COALESCE(exit_time, entry_time) DESC
+		LIMIT $3 OFFSET $4`
+
+	rows, err := db.Pool.Query(ctx, query, tag, userID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get futures trades by tag: %w", err)
+	}
+	defer rows.Close()
+
+	var trades []FuturesTrade
+	for rows.Next() {
+		var trade FuturesTrade
+		err := rows.Scan(
+			&trade.ID, &trade.Symbol, &trade.PositionSide, &trade.Side, &trade.EntryPrice,
+			&trade.ExitPrice, &trade.MarkPrice, &trade.Quantity, &trade.Leverage,
+			&trade.MarginType, &trade.IsolatedMargin, &trade.RealizedPnL, &trade.UnrealizedPnL,
+			&trade.RealizedPnLPercent, &trade.LiquidationPrice, &trade.StopLoss, &trade.TakeProfit,
+			&trade.TrailingStop, &trade.Status, &trade.EntryTime, &trade.ExitTime,
+			&trade.TradeSource, &trade.Notes, &trade.Tags, &trade.AIDecisionID,
+			&trade.StrategyID, &trade.StrategyName, &trade.CreatedAt, &trade.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan futures trade: %w", err)
+		}
+		trades = append(trades, trade)
+	}
+
+	return trades, rows.Err()
+}
+
+// UpdateTradeJournal sets notes and/or tags on a spot trade. An empty userID
+// matches trades regardless of owner.
+func (r *Repository) UpdateTradeJournal(ctx context.Context, userID string, id int64, update TradeJournalUpdate) error {
+	setNotes, notes, tags := update.journalArgs()
+	query := `
+		UPDATE trades SET
+			notes = CASE WHEN $2 THEN NULLIF($3, '') ELSE notes END,
+			tags = COALESCE($4::text[], tags),
+			updated_at = NOW()
+		WHERE id = $1 AND ($5 = '' OR user_id::text = $5)`
+
+	result, err := r.db.Pool.Exec(ctx, query, id, setNotes, notes, tags, userID)
+	if err != nil {
+		return fmt.Errorf("failed to update trade journal: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("trade %d: %w", id, ErrTradeNotFound)
+	}
+	return nil
+}
+
+// GetTradesByTag retrieves spot trades carrying tag, newest first. An empty
+// userID matches trades regardless of owner.
+func (r *Repository) GetTradesByTag(ctx context.Context, userID, tag string, limit, offset int) ([]*Trade, error) {
+	query := `
+		SELECT id, symbol, side, entry_price, exit_price, quantity, entry_time, exit_time,
+		       stop_loss, take_profit, pnl, pnl_percent, strategy_name, status, created_at, updated_at, trade_source, ai_decision_id, notes, tags
+		FROM trades
+		WHERE $1 = ANY(tags) AND ($2 = '' OR user_id::text = $2)
+		ORDER BY COALESCE(exit_time, entry_time) DESC
+		LIMIT $3 OFFSET $4
+	`
+	return r.queryTrades(ctx, query, tag, userID, limit, offset)
+}
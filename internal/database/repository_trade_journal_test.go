@@ -0,0 +1,28 @@
+package database
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeTradeTags(t *testing.T) {
+	got := NormalizeTradeTags([]string{" Scalp ", "scalp", "", "  ", "Breakout  Retest", strings.Repeat("x", 50)})
+	want := []string{"scalp", "breakout-retest", strings.Repeat("x", maxTradeTagLength)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NormalizeTradeTags = %q, want %q", got, want)
+	}
+	if got := NormalizeTradeTags(nil); got == nil || len(got) != 0 {
+		t.Errorf("NormalizeTradeTags(nil) = %#v, want empty non-nil slice", got)
+	}
+}
+
+func TestAutoTradeTagsMergeWithUserTags(t *testing.T) {
+	mode := "swing"
+	strategy := "RSI Reversal"
+	got := mergeTradeTags(autoTradeTags("ginie", &mode, &strategy), []string{"Swing", "fomo"})
+	want := []string{"ginie", "swing", "strategy:rsi-reversal", "fomo"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("merged tags = %q, want %q", got, want)
+	}
+}
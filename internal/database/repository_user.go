@@ -475,6 +475,41 @@ func (r *Repository) GetActiveAPIKey(ctx context.Context, userID, exchange strin
 	return key, nil
 }
 
+// GetActiveAPIKeyByLabel retrieves an active API key by its label, for users
+// running several accounts on the same exchange
+func (r *Repository) GetActiveAPIKeyByLabel(ctx context.Context, userID, exchange, label string, testnet bool) (*UserAPIKey, error) {
+	query := `
+		SELECT id, user_id, exchange, COALESCE(vault_secret_path, ''),
+			COALESCE(encrypted_api_key, ''), COALESCE(encrypted_secret_key, ''),
+			COALESCE(api_key_last_four, ''), COALESCE(label, ''),
+			is_testnet, is_active, COALESCE(permissions, '{}')::jsonb,
+			last_validated_at, validation_status, COALESCE(validation_error, ''),
+			created_at, updated_at
+		FROM user_api_keys
+		WHERE user_id = $1 AND exchange = $2 AND label = $3 AND is_testnet = $4 AND is_active = true
+		ORDER BY created_at DESC
+		LIMIT 1
+	`
+
+	key := &UserAPIKey{}
+	err := r.db.Pool.QueryRow(ctx, query, userID, exchange, label, testnet).Scan(
+		&key.ID, &key.UserID, &key.Exchange, &key.VaultSecretPath,
+		&key.EncryptedAPIKey, &key.EncryptedSecretKey,
+		&key.APIKeyLastFour, &key.Label, &key.IsTestnet, &key.IsActive, &key.Permissions,
+		&key.LastValidatedAt, &key.ValidationStatus, &key.ValidationError,
+		&key.CreatedAt, &key.UpdatedAt,
+	)
+
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API key: %w", err)
+	}
+
+	return key, nil
+}
+
 // UpdateAPIKeyValidation updates the validation status of an API key
 func (r *Repository) UpdateAPIKeyValidation(ctx context.Context, keyID string, status ValidationStatus, errorMsg string) error {
 	query := `
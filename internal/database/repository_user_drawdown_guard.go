@@ -0,0 +1,68 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// GetUserDrawdownGuard retrieves the drawdown kill switch state for a user.
+// Returns nil if the user has no state yet.
+func (r *Repository) GetUserDrawdownGuard(ctx context.Context, userID string) (*UserDrawdownGuard, error) {
+	query := `
+		SELECT user_id, high_water_mark, tripped, tripped_at, trip_reason, transfers_checked_at, updated_at
+		FROM user_drawdown_guard
+		WHERE user_id = $1
+	`
+
+	guard := &UserDrawdownGuard{}
+	err := r.db.Pool.QueryRow(ctx, query, userID).Scan(
+		&guard.UserID,
+		&guard.HighWaterMark,
+		&guard.Tripped,
+		&guard.TrippedAt,
+		&guard.TripReason,
+		&guard.TransfersCheckedAt,
+		&guard.UpdatedAt,
+	)
+
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get drawdown guard for user %s: %w", userID, err)
+	}
+
+	return guard, nil
+}
+
+// SaveUserDrawdownGuard creates or updates the drawdown kill switch state for a user
+func (r *Repository) SaveUserDrawdownGuard(ctx context.Context, guard *UserDrawdownGuard) error {
+	query := `
+		INSERT INTO user_drawdown_guard (user_id, high_water_mark, tripped, tripped_at, trip_reason, transfers_checked_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW())
+		ON CONFLICT (user_id) DO UPDATE SET
+			high_water_mark = EXCLUDED.high_water_mark,
+			tripped = EXCLUDED.tripped,
+			tripped_at = EXCLUDED.tripped_at,
+			trip_reason = EXCLUDED.trip_reason,
+			transfers_checked_at = EXCLUDED.transfers_checked_at,
+			updated_at = NOW()
+		RETURNING updated_at
+	`
+
+	err := r.db.Pool.QueryRow(ctx, query,
+		guard.UserID,
+		guard.HighWaterMark,
+		guard.Tripped,
+		guard.TrippedAt,
+		guard.TripReason,
+		guard.TransfersCheckedAt,
+	).Scan(&guard.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save drawdown guard for user %s: %w", guard.UserID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const userLicenseColumns = `user_id, license_key, license_type, max_symbols,
+		       COALESCE(features::text, '[]'), valid_until, activated_at, updated_at`
+
+func scanUserLicense(row pgx.Row) (*UserLicense, error) {
+	ul := &UserLicense{}
+	err := row.Scan(
+		&ul.UserID,
+		&ul.Key,
+		&ul.Type,
+		&ul.MaxSymbols,
+		&ul.Features,
+		&ul.ValidUntil,
+		&ul.ActivatedAt,
+		&ul.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return ul, nil
+}
+
+// GetUserLicense retrieves the license bound to a user.
+// Returns nil if the user has not activated one.
+func (r *Repository) GetUserLicense(ctx context.Context, userID string) (*UserLicense, error) {
+	query := `SELECT ` + userLicenseColumns + ` FROM user_licenses WHERE user_id = $1`
+
+	ul, err := scanUserLicense(r.db.Pool.QueryRow(ctx, query, userID))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get license for user %s: %w", userID, err)
+	}
+	return ul, nil
+}
+
+// GetUserLicenseByKey retrieves the binding for a license key.
+// Returns nil if the key is not bound to any user.
+func (r *Repository) GetUserLicenseByKey(ctx context.Context, key string) (*UserLicense, error) {
+	query := `SELECT ` + userLicenseColumns + ` FROM user_licenses WHERE license_key = $1`
+
+	ul, err := scanUserLicense(r.db.Pool.QueryRow(ctx, query, key))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user license by key: %w", err)
+	}
+	return ul, nil
+}
+
+// SaveUserLicense binds a license to a user, replacing any license the user
+// had. Fails if the key is bound to another user (unique license_key).
+func (r *Repository) SaveUserLicense(ctx context.Context, ul *UserLicense) error {
+	query := `
+		INSERT INTO user_licenses (user_id, license_key, license_type, max_symbols, features, valid_until, activated_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+		ON CONFLICT (user_id) DO UPDATE SET
+			license_key = EXCLUDED.license_key,
+			license_type = EXCLUDED.license_type,
+			max_symbols = EXCLUDED.max_symbols,
+			features = EXCLUDED.features,
+			valid_until = EXCLUDED.valid_until,
+			activated_at = NOW(),
+			updated_at = NOW()
+		RETURNING activated_at, updated_at
+	`
+
+	err := r.db.Pool.QueryRow(ctx, query,
+		ul.UserID,
+		ul.Key,
+		ul.Type,
+		ul.MaxSymbols,
+		ul.Features,
+		ul.ValidUntil,
+	).Scan(&ul.ActivatedAt, &ul.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save license for user %s: %w", ul.UserID, err)
+	}
+	return nil
+}
+
+// DeleteUserLicense releases the license bound to a user
+func (r *Repository) DeleteUserLicense(ctx context.Context, userID string) error {
+	_, err := r.db.Pool.Exec(ctx, `DELETE FROM user_licenses WHERE user_id = $1`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete license for user %s: %w", userID, err)
+	}
+	return nil
+}
+
+// TransferUserLicense moves a user's license to another user, replacing any
+// license the target had. Returns false if the source user has no license.
+func (r *Repository) TransferUserLicense(ctx context.Context, fromUserID, toUserID string) (bool, error) {
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin license transfer: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM user_licenses WHERE user_id = $1`, toUserID); err != nil {
+		return false, fmt.Errorf("failed to release target user's license: %w", err)
+	}
+	tag, err := tx.Exec(ctx,
+		`UPDATE user_licenses SET user_id = $2, activated_at = NOW(), updated_at = NOW() WHERE user_id = $1`,
+		fromUserID, toUserID)
+	if err != nil {
+		return false, fmt.Errorf("failed to transfer license: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit license transfer: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,153 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TradingHoursRange is a daily window in "HH:MM" 24h format. End is exclusive;
+// a range whose End is before its Start wraps past midnight (e.g. 22:00-02:00).
+type TradingHoursRange struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// TradingSchedule restricts when a strategy or Ginie mode may open trades.
+// An empty schedule (no hours and no days) means always on.
+type TradingSchedule struct {
+	Timezone     string              `json:"timezone,omitempty"`      // IANA name, default UTC
+	TradingHours []TradingHoursRange `json:"trading_hours,omitempty"` // Empty = all day
+	TradingDays  []string            `json:"trading_days,omitempty"`  // "mon".."sun", empty = every day
+}
+
+var scheduleWeekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// Validate checks the timezone, hour ranges and day names
+func (s *TradingSchedule) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if _, err := s.location(); err != nil {
+		return err
+	}
+	for i, r := range s.TradingHours {
+		start, err := parseScheduleClock(r.Start)
+		if err != nil {
+			return fmt.Errorf("trading_hours[%d].start: %w", i, err)
+		}
+		end, err := parseScheduleClock(r.End)
+		if err != nil {
+			return fmt.Errorf("trading_hours[%d].end: %w", i, err)
+		}
+		if start == end {
+			return fmt.Errorf("trading_hours[%d]: start and end must differ", i)
+		}
+	}
+	for _, d := range s.TradingDays {
+		if _, ok := scheduleWeekdays[strings.ToLower(d)]; !ok {
+			return fmt.Errorf("trading_days: invalid day %q (use mon, tue, wed, thu, fri, sat, sun)", d)
+		}
+	}
+	return nil
+}
+
+// IsOpen reports whether t falls inside the schedule. A window that wraps past
+// midnight belongs to the day it started on, so Friday 22:00-02:00 still allows
+// trading at 01:00 on Saturday.
+func (s *TradingSchedule) IsOpen(t time.Time) bool {
+	if s == nil || (len(s.TradingHours) == 0 && len(s.TradingDays) == 0) {
+		return true
+	}
+	loc, err := s.location()
+	if err != nil {
+		loc = time.UTC
+	}
+	local := t.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+
+	if len(s.TradingHours) == 0 {
+		return s.dayAllowed(local.Weekday())
+	}
+	for _, r := range s.TradingHours {
+		start, err1 := parseScheduleClock(r.Start)
+		end, err2 := parseScheduleClock(r.End)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		if start < end {
+			if minute >= start && minute < end && s.dayAllowed(local.Weekday()) {
+				return true
+			}
+			continue
+		}
+		// Wraps past midnight
+		if minute >= start && s.dayAllowed(local.Weekday()) {
+			return true
+		}
+		if minute < end && s.dayAllowed(local.AddDate(0, 0, -1).Weekday()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *TradingSchedule) dayAllowed(day time.Weekday) bool {
+	if len(s.TradingDays) == 0 {
+		return true
+	}
+	for _, d := range s.TradingDays {
+		if wd, ok := scheduleWeekdays[strings.ToLower(d)]; ok && wd == day {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *TradingSchedule) location() (*time.Location, error) {
+	if s.Timezone == "" || strings.EqualFold(s.Timezone, "UTC") {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %w", s.Timezone, err)
+	}
+	return loc, nil
+}
+
+// parseScheduleClock converts "HH:MM" to minutes since midnight
+func parseScheduleClock(v string) (int, error) {
+	t, err := time.Parse("15:04", v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q (expected HH:MM)", v)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// marshalTradingSchedule encodes a schedule for a JSONB column (nil stays NULL)
+func marshalTradingSchedule(s *TradingSchedule) ([]byte, error) {
+	if s == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal schedule: %w", err)
+	}
+	return data, nil
+}
+
+// unmarshalTradingSchedule decodes a JSONB schedule column (NULL = no schedule)
+func unmarshalTradingSchedule(data []byte) (*TradingSchedule, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var s TradingSchedule
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal schedule: %w", err)
+	}
+	return &s, nil
+}
@@ -0,0 +1,77 @@
+package database
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTradingScheduleIsOpen(t *testing.T) {
+	usOpen := &TradingSchedule{
+		TradingHours: []TradingHoursRange{{Start: "13:30", End: "20:00"}},
+		TradingDays:  []string{"mon", "tue", "wed", "thu", "fri"},
+	}
+	overnight := &TradingSchedule{
+		TradingHours: []TradingHoursRange{{Start: "22:00", End: "02:00"}},
+		TradingDays:  []string{"fri"},
+	}
+
+	cases := []struct {
+		name     string
+		schedule *TradingSchedule
+		at       time.Time
+		want     bool
+	}{
+		{"nil schedule", nil, time.Date(2026, 1, 3, 3, 0, 0, 0, time.UTC), true},
+		{"empty schedule", &TradingSchedule{}, time.Date(2026, 1, 3, 3, 0, 0, 0, time.UTC), true},
+		{"inside window", usOpen, time.Date(2026, 1, 5, 14, 0, 0, 0, time.UTC), true},
+		{"end is exclusive", usOpen, time.Date(2026, 1, 5, 20, 0, 0, 0, time.UTC), false},
+		{"before window", usOpen, time.Date(2026, 1, 5, 13, 29, 0, 0, time.UTC), false},
+		{"weekend", usOpen, time.Date(2026, 1, 3, 14, 0, 0, 0, time.UTC), false},
+		{"overnight start day", overnight, time.Date(2026, 1, 2, 23, 0, 0, 0, time.UTC), true},
+		{"overnight after midnight", overnight, time.Date(2026, 1, 3, 1, 0, 0, 0, time.UTC), true},
+		{"overnight wrong day", overnight, time.Date(2026, 1, 3, 23, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, tc := range cases {
+		if got := tc.schedule.IsOpen(tc.at); got != tc.want {
+			t.Errorf("%s: IsOpen = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestTradingScheduleTimezone(t *testing.T) {
+	s := &TradingSchedule{
+		Timezone:     "America/New_York",
+		TradingHours: []TradingHoursRange{{Start: "09:30", End: "16:00"}},
+	}
+	// 14:30 UTC in January is 09:30 in New York
+	if !s.IsOpen(time.Date(2026, 1, 5, 14, 30, 0, 0, time.UTC)) {
+		t.Error("Expected schedule to be open at 09:30 New York time")
+	}
+	if s.IsOpen(time.Date(2026, 1, 5, 13, 30, 0, 0, time.UTC)) {
+		t.Error("Expected schedule to be closed at 08:30 New York time")
+	}
+}
+
+func TestTradingScheduleValidate(t *testing.T) {
+	invalid := []*TradingSchedule{
+		{Timezone: "Mars/Olympus"},
+		{TradingHours: []TradingHoursRange{{Start: "25:00", End: "02:00"}}},
+		{TradingHours: []TradingHoursRange{{Start: "10:00", End: "10:00"}}},
+		{TradingDays: []string{"funday"}},
+	}
+	for i, s := range invalid {
+		if err := s.Validate(); err == nil {
+			t.Errorf("Case %d: expected validation error", i)
+		}
+	}
+
+	valid := &TradingSchedule{
+		Timezone:     "UTC",
+		TradingHours: []TradingHoursRange{{Start: "00:00", End: "08:00"}, {Start: "22:00", End: "00:00"}},
+		TradingDays:  []string{"Mon", "fri"},
+	}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Expected valid schedule, got %v", err)
+	}
+}
@@ -1,7 +1,11 @@
 package events
 
 import (
+	"errors"
+	"fmt"
+	"log"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -38,6 +42,13 @@ const (
 	EventModeStatusUpdate    EventType = "MODE_STATUS_UPDATE"
 	EventSystemStatusUpdate  EventType = "SYSTEM_STATUS_UPDATE"
 	EventSignalUpdate        EventType = "SIGNAL_UPDATE"
+
+	// Granular Ginie position lifecycle events (payload carries the full position snapshot)
+	EventGiniePositionOpened            EventType = "GINIE_POSITION_OPENED"
+	EventGiniePositionTPHit             EventType = "GINIE_POSITION_TP_HIT"
+	EventGiniePositionSLMoved           EventType = "GINIE_POSITION_SL_MOVED"
+	EventGiniePositionTrailingActivated EventType = "GINIE_POSITION_TRAILING_ACTIVATED"
+	EventGiniePositionClosed            EventType = "GINIE_POSITION_CLOSED"
 )
 
 // Event represents a system event
@@ -45,171 +56,266 @@ type Event struct {
 	Type      EventType              `json:"type"`
 	Timestamp time.Time              `json:"timestamp"`
 	Data      map[string]interface{} `json:"data"`
+	Payload   TypedEvent             `json:"-"` // Set by PublishTyped; Data mirrors it
 }
 
+// DefaultBufferSize is how many undelivered events each async subscriber may
+// queue before the oldest are dropped
+const DefaultBufferSize = 256
+
 // Subscriber is a function that handles events
 type Subscriber func(Event)
 
+// ErrorSubscriber is a subscriber that reports failures. Errors from async
+// delivery are logged and counted; PublishSync returns them to the publisher.
+type ErrorSubscriber func(Event) error
+
+// subscription is one registered handler with its async delivery queue
+type subscription struct {
+	handler ErrorSubscriber
+	queue   chan Event
+}
+
+// Stats counts delivery problems since the bus was created
+type Stats struct {
+	Dropped uint64 `json:"dropped"` // Oldest queued events discarded for slow subscribers
+	Failed  uint64 `json:"failed"`  // Handler calls that returned an error or panicked
+	Panics  uint64 `json:"panics"`  // Handler calls that panicked (also counted in Failed)
+}
+
 // EventBus manages event publishing and subscriptions
 type EventBus struct {
 	mu          sync.RWMutex
-	subscribers map[EventType][]Subscriber
-	allSubs     []Subscriber // Subscribers to all events
+	subscribers map[EventType][]*subscription
+	allSubs     []*subscription // Subscribers to all events
+	bufferSize  int
+
+	dropped atomic.Uint64
+	failed  atomic.Uint64
+	panics  atomic.Uint64
 }
 
 // NewEventBus creates a new event bus
 func NewEventBus() *EventBus {
+	return NewEventBusWithBuffer(DefaultBufferSize)
+}
+
+// NewEventBusWithBuffer creates an event bus whose async subscribers each
+// queue at most bufferSize events
+func NewEventBusWithBuffer(bufferSize int) *EventBus {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
 	return &EventBus{
-		subscribers: make(map[EventType][]Subscriber),
-		allSubs:     make([]Subscriber, 0),
+		subscribers: make(map[EventType][]*subscription),
+		allSubs:     make([]*subscription, 0),
+		bufferSize:  bufferSize,
 	}
 }
 
 // Subscribe registers a subscriber for a specific event type
 func (eb *EventBus) Subscribe(eventType EventType, subscriber Subscriber) {
+	eb.SubscribeErr(eventType, func(event Event) error {
+		subscriber(event)
+		return nil
+	})
+}
+
+// SubscribeErr registers a subscriber that can fail for a specific event type
+func (eb *EventBus) SubscribeErr(eventType EventType, subscriber ErrorSubscriber) {
+	sub := eb.newSubscription(subscriber)
+
 	eb.mu.Lock()
 	defer eb.mu.Unlock()
 
-	eb.subscribers[eventType] = append(eb.subscribers[eventType], subscriber)
+	eb.subscribers[eventType] = append(eb.subscribers[eventType], sub)
 }
 
 // SubscribeAll registers a subscriber for all events
 func (eb *EventBus) SubscribeAll(subscriber Subscriber) {
+	sub := eb.newSubscription(func(event Event) error {
+		subscriber(event)
+		return nil
+	})
+
 	eb.mu.Lock()
 	defer eb.mu.Unlock()
 
-	eb.allSubs = append(eb.allSubs, subscriber)
+	eb.allSubs = append(eb.allSubs, sub)
 }
 
-// Publish sends an event to all subscribers
-func (eb *EventBus) Publish(event Event) {
+// newSubscription creates a subscription and starts its delivery worker
+func (eb *EventBus) newSubscription(handler ErrorSubscriber) *subscription {
+	sub := &subscription{
+		handler: handler,
+		queue:   make(chan Event, eb.bufferSize),
+	}
+	go eb.run(sub)
+	return sub
+}
+
+// run delivers a subscriber's queued events in order
+func (eb *EventBus) run(sub *subscription) {
+	for event := range sub.queue {
+		if err := eb.deliver(sub, event); err != nil {
+			log.Printf("[EVENTS] %s subscriber failed: %v", event.Type, err)
+		}
+	}
+}
+
+// deliver calls the handler, turning a panic into an error so one bad handler
+// cannot take down the publisher or the delivery worker
+func (eb *EventBus) deliver(sub *subscription, event Event) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			eb.panics.Add(1)
+			err = fmt.Errorf("subscriber panicked: %v", r)
+		}
+		if err != nil {
+			eb.failed.Add(1)
+		}
+	}()
+	return sub.handler(event)
+}
+
+// enqueue adds an event to a subscriber's queue, dropping the oldest queued
+// event while the queue is full
+func (eb *EventBus) enqueue(sub *subscription, event Event) {
+	for {
+		select {
+		case sub.queue <- event:
+			return
+		default:
+		}
+		select {
+		case old := <-sub.queue:
+			if eb.dropped.Add(1)%100 == 1 {
+				log.Printf("[EVENTS] Subscriber queue full, dropped oldest %s event (%d dropped so far)", old.Type, eb.dropped.Load())
+			}
+		default:
+		}
+	}
+}
+
+// subscriptionsFor returns the subscribers that receive an event type
+func (eb *EventBus) subscriptionsFor(eventType EventType) []*subscription {
 	eb.mu.RLock()
 	defer eb.mu.RUnlock()
 
+	subs := make([]*subscription, 0, len(eb.subscribers[eventType])+len(eb.allSubs))
+	subs = append(subs, eb.subscribers[eventType]...)
+	return append(subs, eb.allSubs...)
+}
+
+// Publish queues an event for every subscriber and returns immediately.
+// Each subscriber receives events in publish order; if it falls more than
+// the buffer size behind, its oldest undelivered events are dropped.
+func (eb *EventBus) Publish(event Event) {
 	// Set timestamp if not provided
 	if event.Timestamp.IsZero() {
 		event.Timestamp = time.Now()
 	}
 
-	// Notify specific subscribers
-	if subs, ok := eb.subscribers[event.Type]; ok {
-		for _, sub := range subs {
-			go sub(event) // Run in goroutine to avoid blocking
+	for _, sub := range eb.subscriptionsFor(event.Type) {
+		eb.enqueue(sub, event)
+	}
+}
+
+// PublishSync delivers an event to every subscriber on the caller's goroutine
+// and returns their errors joined. Events still queued by Publish are not
+// waited for, so ordering relative to async events is not guaranteed.
+func (eb *EventBus) PublishSync(event Event) error {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	var errs []error
+	for _, sub := range eb.subscriptionsFor(event.Type) {
+		if err := eb.deliver(sub, event); err != nil {
+			errs = append(errs, err)
 		}
 	}
+	return errors.Join(errs...)
+}
 
-	// Notify all-event subscribers
-	for _, sub := range eb.allSubs {
-		go sub(event)
+// Stats returns the bus's delivery counters
+func (eb *EventBus) Stats() Stats {
+	return Stats{
+		Dropped: eb.dropped.Load(),
+		Failed:  eb.failed.Load(),
+		Panics:  eb.panics.Load(),
 	}
 }
 
 // PublishTradeOpened publishes a trade opened event
 func (eb *EventBus) PublishTradeOpened(symbol string, side string, entryPrice, quantity float64) {
-	eb.Publish(Event{
-		Type: EventTradeOpened,
-		Data: map[string]interface{}{
-			"symbol":      symbol,
-			"side":        side,
-			"entry_price": entryPrice,
-			"quantity":    quantity,
-		},
-	})
+	eb.PublishTyped(TradeOpened{Symbol: symbol, Side: side, EntryPrice: entryPrice, Quantity: quantity})
 }
 
 // PublishTradeClosed publishes a trade closed event
 func (eb *EventBus) PublishTradeClosed(symbol string, entryPrice, exitPrice, quantity, pnl, pnlPercent float64) {
-	eb.Publish(Event{
-		Type: EventTradeClosed,
-		Data: map[string]interface{}{
-			"symbol":      symbol,
-			"entry_price": entryPrice,
-			"exit_price":  exitPrice,
-			"quantity":    quantity,
-			"pnl":         pnl,
-			"pnl_percent": pnlPercent,
-		},
+	eb.PublishTyped(TradeClosed{
+		Symbol:     symbol,
+		EntryPrice: entryPrice,
+		ExitPrice:  exitPrice,
+		Quantity:   quantity,
+		PnL:        pnl,
+		PnLPercent: pnlPercent,
 	})
 }
 
 // PublishSignal publishes a signal generated event
 func (eb *EventBus) PublishSignal(strategyName, symbol, signalType, reason string, price float64) {
-	eb.Publish(Event{
-		Type: EventSignalGenerated,
-		Data: map[string]interface{}{
-			"strategy":    strategyName,
-			"symbol":      symbol,
-			"signal_type": signalType,
-			"reason":      reason,
-			"price":       price,
-		},
+	eb.PublishTyped(SignalGenerated{
+		Strategy:   strategyName,
+		Symbol:     symbol,
+		SignalType: signalType,
+		Reason:     reason,
+		Price:      price,
 	})
 }
 
 // PublishOrderPlaced publishes an order placed event
 func (eb *EventBus) PublishOrderPlaced(orderID int64, symbol, orderType, side string, price, quantity float64) {
-	eb.Publish(Event{
-		Type: EventOrderPlaced,
-		Data: map[string]interface{}{
-			"order_id":   orderID,
-			"symbol":     symbol,
-			"order_type": orderType,
-			"side":       side,
-			"price":      price,
-			"quantity":   quantity,
-		},
+	eb.PublishTyped(OrderPlaced{
+		OrderID:   orderID,
+		Symbol:    symbol,
+		OrderType: orderType,
+		Side:      side,
+		Price:     price,
+		Quantity:  quantity,
 	})
 }
 
 // PublishPriceUpdate publishes a price update event
 func (eb *EventBus) PublishPriceUpdate(symbol string, price float64) {
-	eb.Publish(Event{
-		Type: EventPriceUpdate,
-		Data: map[string]interface{}{
-			"symbol": symbol,
-			"price":  price,
-		},
-	})
+	eb.PublishTyped(PriceUpdate{Symbol: symbol, Price: price})
 }
 
 // PublishPositionUpdate publishes a position update event
 func (eb *EventBus) PublishPositionUpdate(symbol string, entryPrice, currentPrice, quantity, pnl, pnlPercent float64) {
-	eb.Publish(Event{
-		Type: EventPositionUpdate,
-		Data: map[string]interface{}{
-			"symbol":        symbol,
-			"entry_price":   entryPrice,
-			"current_price": currentPrice,
-			"quantity":      quantity,
-			"pnl":           pnl,
-			"pnl_percent":   pnlPercent,
-		},
+	eb.PublishTyped(PositionUpdate{
+		Symbol:       symbol,
+		EntryPrice:   entryPrice,
+		CurrentPrice: currentPrice,
+		Quantity:     quantity,
+		PnL:          pnl,
+		PnLPercent:   pnlPercent,
 	})
 }
 
 // PublishError publishes an error event
 func (eb *EventBus) PublishError(source, message string, err error) {
-	data := map[string]interface{}{
-		"source":  source,
-		"message": message,
-	}
+	payload := ErrorOccurred{Source: source, Message: message}
 	if err != nil {
-		data["error"] = err.Error()
+		payload.Error = err.Error()
 	}
-	eb.Publish(Event{
-		Type: EventError,
-		Data: data,
-	})
+	eb.PublishTyped(payload)
 }
 
 // PublishUserLogout publishes a user logout event
 func (eb *EventBus) PublishUserLogout(userID string) {
-	eb.Publish(Event{
-		Type: EventUserLogout,
-		Data: map[string]interface{}{
-			"user_id": userID,
-		},
-	})
+	eb.PublishTyped(UserLoggedOut{UserID: userID})
 }
 
 // ============================================================================
@@ -221,6 +327,9 @@ func (eb *EventBus) PublishUserLogout(userID string) {
 // BroadcastFunc is a callback function for broadcasting events to specific users
 type BroadcastFunc func(userID string, data interface{})
 
+// BroadcastTypedFunc is a callback for broadcasting events whose type varies per call
+type BroadcastTypedFunc func(userID string, eventType EventType, data interface{})
+
 // Global broadcast callbacks - wired up by api package at startup
 var (
 	broadcastLifecycleEvent  BroadcastFunc
@@ -232,6 +341,8 @@ var (
 	broadcastSystemStatus    BroadcastFunc
 	broadcastSignalUpdate    BroadcastFunc
 	broadcastPositionUpdate  BroadcastFunc
+
+	broadcastGiniePositionEvent BroadcastTypedFunc
 )
 
 // SetBroadcastLifecycleEvent sets the callback for lifecycle event broadcasts
@@ -279,6 +390,11 @@ func SetBroadcastPositionUpdate(fn BroadcastFunc) {
 	broadcastPositionUpdate = fn
 }
 
+// SetBroadcastGiniePositionEvent sets the callback for Ginie position lifecycle broadcasts
+func SetBroadcastGiniePositionEvent(fn BroadcastTypedFunc) {
+	broadcastGiniePositionEvent = fn
+}
+
 // BroadcastLifecycleEvent broadcasts a lifecycle event to a user
 func BroadcastLifecycleEvent(userID string, data interface{}) {
 	if broadcastLifecycleEvent != nil && userID != "" {
@@ -341,3 +457,10 @@ func BroadcastPositionUpdate(userID string, data interface{}) {
 		go broadcastPositionUpdate(userID, data)
 	}
 }
+
+// BroadcastGiniePositionEvent broadcasts a Ginie position lifecycle event to a user
+func BroadcastGiniePositionEvent(userID string, eventType EventType, data interface{}) {
+	if broadcastGiniePositionEvent != nil && userID != "" {
+		go broadcastGiniePositionEvent(userID, eventType, data)
+	}
+}
@@ -0,0 +1,108 @@
+package events
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPublishSyncAggregatesErrors(t *testing.T) {
+	bus := NewEventBus()
+	errA := errors.New("db down")
+	errB := errors.New("webhook failed")
+	bus.SubscribeErr(EventTradeClosed, func(Event) error { return errA })
+	bus.SubscribeErr(EventTradeClosed, func(Event) error { return nil })
+	bus.SubscribeErr(EventTradeClosed, func(Event) error { return errB })
+
+	err := bus.PublishSync(Event{Type: EventTradeClosed})
+	if !errors.Is(err, errA) || !errors.Is(err, errB) {
+		t.Fatalf("PublishSync error = %v, want both subscriber errors", err)
+	}
+	if got := bus.Stats().Failed; got != 2 {
+		t.Errorf("Failed = %d, want 2", got)
+	}
+}
+
+func TestPanickingSubscriberIsRecovered(t *testing.T) {
+	bus := NewEventBus()
+	delivered := make(chan struct{}, 2)
+	bus.Subscribe(EventError, func(Event) { panic("boom") })
+	bus.Subscribe(EventError, func(Event) { delivered <- struct{}{} })
+
+	if err := bus.PublishSync(Event{Type: EventError}); err == nil {
+		t.Error("PublishSync should report the panic as an error")
+	}
+	bus.Publish(Event{Type: EventError})
+
+	for i := 0; i < 2; i++ {
+		if _, ok := receive(t, delivered); !ok {
+			t.Fatalf("healthy subscriber missed delivery %d", i+1)
+		}
+	}
+	// The async panic may land after the healthy subscriber's delivery
+	deadline := time.Now().Add(time.Second)
+	for bus.Stats().Panics < 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if got := bus.Stats().Panics; got != 2 {
+		t.Errorf("Panics = %d, want 2", got)
+	}
+}
+
+func TestSlowSubscriberDropsOldest(t *testing.T) {
+	bus := NewEventBusWithBuffer(2)
+	release := make(chan struct{})
+	started := make(chan struct{}, 1)
+	received := make(chan float64, 10)
+	SubscribeTyped(bus, func(e PriceUpdate) {
+		select {
+		case started <- struct{}{}:
+			<-release // Block on the first event only
+		default:
+		}
+		received <- e.Price
+	})
+
+	bus.PublishPriceUpdate("BTCUSDT", 1)
+	if _, ok := receive(t, started); !ok {
+		t.Fatal("subscriber never started")
+	}
+	for price := 2.0; price <= 5; price++ {
+		bus.PublishPriceUpdate("BTCUSDT", price)
+	}
+	close(release)
+
+	var got []float64
+	for {
+		price, ok := receive(t, received)
+		if !ok {
+			break
+		}
+		got = append(got, price)
+	}
+	// 1 was in flight; 2 and 3 were dropped to make room for 4 and 5
+	if len(got) != 3 || got[0] != 1 || got[1] != 4 || got[2] != 5 {
+		t.Errorf("received %v, want [1 4 5]", got)
+	}
+	if dropped := bus.Stats().Dropped; dropped != 2 {
+		t.Errorf("Dropped = %d, want 2", dropped)
+	}
+}
+
+func TestPublishReturnsWithoutWaiting(t *testing.T) {
+	bus := NewEventBus()
+	block := make(chan struct{})
+	defer close(block)
+	bus.Subscribe(EventBotStopped, func(Event) { <-block })
+
+	done := make(chan struct{})
+	go func() {
+		bus.Publish(Event{Type: EventBotStopped})
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Publish blocked on a slow subscriber")
+	}
+}
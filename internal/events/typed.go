@@ -0,0 +1,202 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// TypedEvent is a structured event payload. Publishing one with PublishTyped
+// still fills Event.Data (keyed by the json tags) for map-based subscribers
+// and WebSocket clients, and SubscribeTyped hands it back without assertions.
+type TypedEvent interface {
+	EventType() EventType
+}
+
+// TradeOpened is the payload of EventTradeOpened
+type TradeOpened struct {
+	Symbol     string  `json:"symbol"`
+	Side       string  `json:"side"`
+	EntryPrice float64 `json:"entry_price"`
+	Quantity   float64 `json:"quantity"`
+	StopLoss   float64 `json:"stop_loss,omitempty"`
+	TakeProfit float64 `json:"take_profit,omitempty"`
+}
+
+// TradeClosed is the payload of EventTradeClosed
+type TradeClosed struct {
+	Symbol     string  `json:"symbol"`
+	EntryPrice float64 `json:"entry_price"`
+	ExitPrice  float64 `json:"exit_price"`
+	Quantity   float64 `json:"quantity"`
+	PnL        float64 `json:"pnl"`
+	PnLPercent float64 `json:"pnl_percent"`
+	Reason     string  `json:"reason,omitempty"`
+}
+
+// SignalGenerated is the payload of EventSignalGenerated
+type SignalGenerated struct {
+	Strategy   string  `json:"strategy"`
+	Symbol     string  `json:"symbol"`
+	SignalType string  `json:"signal_type"`
+	Reason     string  `json:"reason"`
+	Price      float64 `json:"price"`
+	StopLoss   float64 `json:"stop_loss,omitempty"`
+	TakeProfit float64 `json:"take_profit,omitempty"`
+	Status     string  `json:"status,omitempty"` // confirmed (default) or provisional
+}
+
+// OrderPlaced is the payload of EventOrderPlaced
+type OrderPlaced struct {
+	OrderID   int64   `json:"order_id"`
+	Symbol    string  `json:"symbol"`
+	OrderType string  `json:"order_type"`
+	Side      string  `json:"side"`
+	Price     float64 `json:"price"`
+	Quantity  float64 `json:"quantity"`
+}
+
+// PriceUpdate is the payload of EventPriceUpdate
+type PriceUpdate struct {
+	Symbol string  `json:"symbol"`
+	Price  float64 `json:"price"`
+}
+
+// PositionUpdate is the payload of EventPositionUpdate
+type PositionUpdate struct {
+	Symbol       string  `json:"symbol"`
+	EntryPrice   float64 `json:"entry_price"`
+	CurrentPrice float64 `json:"current_price"`
+	Quantity     float64 `json:"quantity"`
+	PnL          float64 `json:"pnl"`
+	PnLPercent   float64 `json:"pnl_percent"`
+}
+
+// ErrorOccurred is the payload of EventError
+type ErrorOccurred struct {
+	Source  string `json:"source"`
+	Message string `json:"message"`
+	Error   string `json:"error,omitempty"`
+}
+
+// UserLoggedOut is the payload of EventUserLogout
+type UserLoggedOut struct {
+	UserID string `json:"user_id"`
+}
+
+// BotStarted is the payload of EventBotStarted
+type BotStarted struct {
+	DryRun  bool `json:"dry_run"`
+	TestNet bool `json:"testnet"`
+}
+
+// BotStopped is the payload of EventBotStopped
+type BotStopped struct{}
+
+func (TradeOpened) EventType() EventType     { return EventTradeOpened }
+func (TradeClosed) EventType() EventType     { return EventTradeClosed }
+func (SignalGenerated) EventType() EventType { return EventSignalGenerated }
+func (OrderPlaced) EventType() EventType     { return EventOrderPlaced }
+func (PriceUpdate) EventType() EventType     { return EventPriceUpdate }
+func (PositionUpdate) EventType() EventType  { return EventPositionUpdate }
+func (ErrorOccurred) EventType() EventType   { return EventError }
+func (UserLoggedOut) EventType() EventType   { return EventUserLogout }
+func (BotStarted) EventType() EventType      { return EventBotStarted }
+func (BotStopped) EventType() EventType      { return EventBotStopped }
+
+// PublishTyped publishes a structured event
+func (eb *EventBus) PublishTyped(payload TypedEvent) {
+	eb.Publish(typedEvent(payload))
+}
+
+// PublishTypedSync publishes a structured event synchronously (see PublishSync)
+func (eb *EventBus) PublishTypedSync(payload TypedEvent) error {
+	return eb.PublishSync(typedEvent(payload))
+}
+
+func typedEvent(payload TypedEvent) Event {
+	return Event{
+		Type:    payload.EventType(),
+		Data:    PayloadData(payload),
+		Payload: payload,
+	}
+}
+
+// SubscribeTyped registers handler for T's event type. Events published with
+// PublishTyped are passed through as is; events published as a plain map are
+// decoded into T, and a field of the wrong type is logged and the event
+// dropped instead of silently reading as zero. Optional filters must all
+// return true for the handler to run, e.g. only one symbol's trades.
+func SubscribeTyped[T TypedEvent](eb *EventBus, handler func(T), filters ...func(T) bool) {
+	SubscribeTypedErr(eb, func(payload T) error {
+		handler(payload)
+		return nil
+	}, filters...)
+}
+
+// SubscribeTypedErr is SubscribeTyped for a handler that can fail; a decode
+// error is reported the same way as a handler error
+func SubscribeTypedErr[T TypedEvent](eb *EventBus, handler func(T) error, filters ...func(T) bool) {
+	var zero T
+	eb.SubscribeErr(zero.EventType(), func(event Event) error {
+		payload, ok := event.Payload.(T)
+		if !ok {
+			var err error
+			payload, err = DecodeData[T](event.Data)
+			if err != nil {
+				return fmt.Errorf("dropping malformed event: %w", err)
+			}
+		}
+		for _, filter := range filters {
+			if !filter(payload) {
+				return nil
+			}
+		}
+		return handler(payload)
+	})
+}
+
+// DecodeData decodes map-based event data into a typed payload
+func DecodeData[T TypedEvent](data map[string]interface{}) (T, error) {
+	var payload T
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return payload, fmt.Errorf("failed to encode event data: %w", err)
+	}
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return payload, fmt.Errorf("failed to decode event data: %w", err)
+	}
+	return payload, nil
+}
+
+// PayloadData converts a typed payload to Event.Data, keyed by json tag and
+// keeping each field's Go type (so an int64 order ID stays an int64)
+func PayloadData(payload TypedEvent) map[string]interface{} {
+	v := reflect.ValueOf(payload)
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	t := v.Type()
+
+	data := make(map[string]interface{}, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		value := v.Field(i)
+		if strings.Contains(opts, "omitempty") && value.IsZero() {
+			continue
+		}
+		data[name] = value.Interface()
+	}
+	return data
+}
@@ -0,0 +1,99 @@
+package events
+
+import (
+	"testing"
+	"time"
+)
+
+func receive[T any](t *testing.T, ch <-chan T) (T, bool) {
+	t.Helper()
+	select {
+	case v := <-ch:
+		return v, true
+	case <-time.After(200 * time.Millisecond):
+		var zero T
+		return zero, false
+	}
+}
+
+func TestPublishTypedDeliversPayloadAndData(t *testing.T) {
+	bus := NewEventBus()
+	typed := make(chan TradeClosed, 1)
+	raw := make(chan Event, 1)
+	SubscribeTyped(bus, func(e TradeClosed) { typed <- e })
+	bus.SubscribeAll(func(e Event) { raw <- e })
+
+	bus.PublishTyped(TradeClosed{Symbol: "BTCUSDT", EntryPrice: 100, ExitPrice: 110, PnL: 10, PnLPercent: 10, Reason: "TAKE_PROFIT"})
+
+	got, ok := receive(t, typed)
+	if !ok {
+		t.Fatal("typed subscriber not called")
+	}
+	if got.PnL != 10 || got.EntryPrice != 100 || got.Reason != "TAKE_PROFIT" {
+		t.Errorf("typed payload = %+v", got)
+	}
+
+	event, ok := receive(t, raw)
+	if !ok {
+		t.Fatal("map subscriber not called")
+	}
+	if pnl, ok := event.Data["pnl"].(float64); !ok || pnl != 10 {
+		t.Errorf("Data[pnl] = %#v, want float64 10", event.Data["pnl"])
+	}
+}
+
+func TestPayloadDataKeepsTypesAndOmitsEmpty(t *testing.T) {
+	data := PayloadData(OrderPlaced{OrderID: 42, Symbol: "ETHUSDT"})
+	if id, ok := data["order_id"].(int64); !ok || id != 42 {
+		t.Errorf("order_id = %#v, want int64 42", data["order_id"])
+	}
+
+	data = PayloadData(SignalGenerated{Symbol: "ETHUSDT"})
+	if _, ok := data["stop_loss"]; ok {
+		t.Error("omitempty stop_loss should be left out when zero")
+	}
+	if _, ok := data["price"]; !ok {
+		t.Error("price should always be present")
+	}
+}
+
+func TestSubscribeTypedDecodesMapEvents(t *testing.T) {
+	bus := NewEventBus()
+	typed := make(chan UserLoggedOut, 1)
+	SubscribeTyped(bus, func(e UserLoggedOut) { typed <- e })
+
+	bus.Publish(Event{Type: EventUserLogout, Data: map[string]interface{}{"user_id": "u1"}})
+	if got, ok := receive(t, typed); !ok || got.UserID != "u1" {
+		t.Errorf("decoded payload = %+v, %v", got, ok)
+	}
+}
+
+func TestSubscribeTypedDropsMalformedData(t *testing.T) {
+	bus := NewEventBus()
+	typed := make(chan TradeClosed, 1)
+	SubscribeTyped(bus, func(e TradeClosed) { typed <- e })
+
+	// A string PnL used to read as a silent zero
+	bus.Publish(Event{Type: EventTradeClosed, Data: map[string]interface{}{"symbol": "BTCUSDT", "pnl": "12.5"}})
+	if got, ok := receive(t, typed); ok {
+		t.Errorf("malformed event delivered as %+v", got)
+	}
+}
+
+func TestSubscribeTypedFilters(t *testing.T) {
+	bus := NewEventBus()
+	typed := make(chan PriceUpdate, 2)
+	SubscribeTyped(bus, func(e PriceUpdate) { typed <- e }, func(e PriceUpdate) bool {
+		return e.Symbol == "BTCUSDT"
+	})
+
+	bus.PublishPriceUpdate("ETHUSDT", 3000)
+	bus.PublishPriceUpdate("BTCUSDT", 60000)
+	got, ok := receive(t, typed)
+	if !ok || got.Symbol != "BTCUSDT" {
+		t.Fatalf("filtered payload = %+v, %v", got, ok)
+	}
+	if extra, ok := receive(t, typed); ok {
+		t.Errorf("filtered-out event delivered: %+v", extra)
+	}
+}
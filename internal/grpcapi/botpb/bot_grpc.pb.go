@@ -0,0 +1,473 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.3
+// source: bot.proto
+
+// Bot control API served alongside REST for low-latency programmatic clients.
+// Every call must carry "authorization: Bearer <grpc auth token>" metadata.
+
+package botpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	BotControl_GetStatus_FullMethodName       = "/botcontrol.v1.BotControl/GetStatus"
+	BotControl_ListPositions_FullMethodName   = "/botcontrol.v1.BotControl/ListPositions"
+	BotControl_StreamPositions_FullMethodName = "/botcontrol.v1.BotControl/StreamPositions"
+	BotControl_StreamEvents_FullMethodName    = "/botcontrol.v1.BotControl/StreamEvents"
+	BotControl_PlaceOrder_FullMethodName      = "/botcontrol.v1.BotControl/PlaceOrder"
+	BotControl_CancelOrder_FullMethodName     = "/botcontrol.v1.BotControl/CancelOrder"
+	BotControl_ClosePosition_FullMethodName   = "/botcontrol.v1.BotControl/ClosePosition"
+	BotControl_PauseTrading_FullMethodName    = "/botcontrol.v1.BotControl/PauseTrading"
+	BotControl_ResumeTrading_FullMethodName   = "/botcontrol.v1.BotControl/ResumeTrading"
+)
+
+// BotControlClient is the client API for BotControl service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BotControlClient interface {
+	// Bot status, the same document GET /api/status returns
+	GetStatus(ctx context.Context, in *GetStatusRequest, opts ...grpc.CallOption) (*GetStatusResponse, error)
+	// Open positions, once
+	ListPositions(ctx context.Context, in *ListPositionsRequest, opts ...grpc.CallOption) (*ListPositionsResponse, error)
+	// Open positions, re-sent every interval until the client cancels
+	StreamPositions(ctx context.Context, in *StreamPositionsRequest, opts ...grpc.CallOption) (BotControl_StreamPositionsClient, error)
+	// Bot events (trades, orders, signals, errors) as they are published
+	StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (BotControl_StreamEventsClient, error)
+	PlaceOrder(ctx context.Context, in *PlaceOrderRequest, opts ...grpc.CallOption) (*PlaceOrderResponse, error)
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
+	ClosePosition(ctx context.Context, in *ClosePositionRequest, opts ...grpc.CallOption) (*ClosePositionResponse, error)
+	// Disables every enabled strategy; ResumeTrading re-enables the same ones
+	PauseTrading(ctx context.Context, in *PauseTradingRequest, opts ...grpc.CallOption) (*PauseTradingResponse, error)
+	ResumeTrading(ctx context.Context, in *ResumeTradingRequest, opts ...grpc.CallOption) (*ResumeTradingResponse, error)
+}
+
+type botControlClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBotControlClient(cc grpc.ClientConnInterface) BotControlClient {
+	return &botControlClient{cc}
+}
+
+func (c *botControlClient) GetStatus(ctx context.Context, in *GetStatusRequest, opts ...grpc.CallOption) (*GetStatusResponse, error) {
+	out := new(GetStatusResponse)
+	err := c.cc.Invoke(ctx, BotControl_GetStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *botControlClient) ListPositions(ctx context.Context, in *ListPositionsRequest, opts ...grpc.CallOption) (*ListPositionsResponse, error) {
+	out := new(ListPositionsResponse)
+	err := c.cc.Invoke(ctx, BotControl_ListPositions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *botControlClient) StreamPositions(ctx context.Context, in *StreamPositionsRequest, opts ...grpc.CallOption) (BotControl_StreamPositionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BotControl_ServiceDesc.Streams[0], BotControl_StreamPositions_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &botControlStreamPositionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BotControl_StreamPositionsClient interface {
+	Recv() (*PositionsSnapshot, error)
+	grpc.ClientStream
+}
+
+type botControlStreamPositionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *botControlStreamPositionsClient) Recv() (*PositionsSnapshot, error) {
+	m := new(PositionsSnapshot)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *botControlClient) StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (BotControl_StreamEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BotControl_ServiceDesc.Streams[1], BotControl_StreamEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &botControlStreamEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BotControl_StreamEventsClient interface {
+	Recv() (*BotEvent, error)
+	grpc.ClientStream
+}
+
+type botControlStreamEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *botControlStreamEventsClient) Recv() (*BotEvent, error) {
+	m := new(BotEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *botControlClient) PlaceOrder(ctx context.Context, in *PlaceOrderRequest, opts ...grpc.CallOption) (*PlaceOrderResponse, error) {
+	out := new(PlaceOrderResponse)
+	err := c.cc.Invoke(ctx, BotControl_PlaceOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *botControlClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error) {
+	out := new(CancelOrderResponse)
+	err := c.cc.Invoke(ctx, BotControl_CancelOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *botControlClient) ClosePosition(ctx context.Context, in *ClosePositionRequest, opts ...grpc.CallOption) (*ClosePositionResponse, error) {
+	out := new(ClosePositionResponse)
+	err := c.cc.Invoke(ctx, BotControl_ClosePosition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *botControlClient) PauseTrading(ctx context.Context, in *PauseTradingRequest, opts ...grpc.CallOption) (*PauseTradingResponse, error) {
+	out := new(PauseTradingResponse)
+	err := c.cc.Invoke(ctx, BotControl_PauseTrading_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *botControlClient) ResumeTrading(ctx context.Context, in *ResumeTradingRequest, opts ...grpc.CallOption) (*ResumeTradingResponse, error) {
+	out := new(ResumeTradingResponse)
+	err := c.cc.Invoke(ctx, BotControl_ResumeTrading_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BotControlServer is the server API for BotControl service.
+// All implementations must embed UnimplementedBotControlServer
+// for forward compatibility
+type BotControlServer interface {
+	// Bot status, the same document GET /api/status returns
+	GetStatus(context.Context, *GetStatusRequest) (*GetStatusResponse, error)
+	// Open positions, once
+	ListPositions(context.Context, *ListPositionsRequest) (*ListPositionsResponse, error)
+	// Open positions, re-sent every interval until the client cancels
+	StreamPositions(*StreamPositionsRequest, BotControl_StreamPositionsServer) error
+	// Bot events (trades, orders, signals, errors) as they are published
+	StreamEvents(*StreamEventsRequest, BotControl_StreamEventsServer) error
+	PlaceOrder(context.Context, *PlaceOrderRequest) (*PlaceOrderResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
+	ClosePosition(context.Context, *ClosePositionRequest) (*ClosePositionResponse, error)
+	// Disables every enabled strategy; ResumeTrading re-enables the same ones
+	PauseTrading(context.Context, *PauseTradingRequest) (*PauseTradingResponse, error)
+	ResumeTrading(context.Context, *ResumeTradingRequest) (*ResumeTradingResponse, error)
+	mustEmbedUnimplementedBotControlServer()
+}
+
+// UnimplementedBotControlServer must be embedded to have forward compatible implementations.
+type UnimplementedBotControlServer struct {
+}
+
+func (UnimplementedBotControlServer) GetStatus(context.Context, *GetStatusRequest) (*GetStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+}
+func (UnimplementedBotControlServer) ListPositions(context.Context, *ListPositionsRequest) (*ListPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPositions not implemented")
+}
+func (UnimplementedBotControlServer) StreamPositions(*StreamPositionsRequest, BotControl_StreamPositionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPositions not implemented")
+}
+func (UnimplementedBotControlServer) StreamEvents(*StreamEventsRequest, BotControl_StreamEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEvents not implemented")
+}
+func (UnimplementedBotControlServer) PlaceOrder(context.Context, *PlaceOrderRequest) (*PlaceOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceOrder not implemented")
+}
+func (UnimplementedBotControlServer) CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedBotControlServer) ClosePosition(context.Context, *ClosePositionRequest) (*ClosePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePosition not implemented")
+}
+func (UnimplementedBotControlServer) PauseTrading(context.Context, *PauseTradingRequest) (*PauseTradingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseTrading not implemented")
+}
+func (UnimplementedBotControlServer) ResumeTrading(context.Context, *ResumeTradingRequest) (*ResumeTradingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeTrading not implemented")
+}
+func (UnimplementedBotControlServer) mustEmbedUnimplementedBotControlServer() {}
+
+// UnsafeBotControlServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BotControlServer will
+// result in compilation errors.
+type UnsafeBotControlServer interface {
+	mustEmbedUnimplementedBotControlServer()
+}
+
+func RegisterBotControlServer(s grpc.ServiceRegistrar, srv BotControlServer) {
+	s.RegisterService(&BotControl_ServiceDesc, srv)
+}
+
+func _BotControl_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BotControlServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BotControl_GetStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BotControlServer).GetStatus(ctx, req.(*GetStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BotControl_ListPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BotControlServer).ListPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BotControl_ListPositions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BotControlServer).ListPositions(ctx, req.(*ListPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BotControl_StreamPositions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPositionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BotControlServer).StreamPositions(m, &botControlStreamPositionsServer{stream})
+}
+
+type BotControl_StreamPositionsServer interface {
+	Send(*PositionsSnapshot) error
+	grpc.ServerStream
+}
+
+type botControlStreamPositionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *botControlStreamPositionsServer) Send(m *PositionsSnapshot) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BotControl_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BotControlServer).StreamEvents(m, &botControlStreamEventsServer{stream})
+}
+
+type BotControl_StreamEventsServer interface {
+	Send(*BotEvent) error
+	grpc.ServerStream
+}
+
+type botControlStreamEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *botControlStreamEventsServer) Send(m *BotEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BotControl_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BotControlServer).PlaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BotControl_PlaceOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BotControlServer).PlaceOrder(ctx, req.(*PlaceOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BotControl_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BotControlServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BotControl_CancelOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BotControlServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BotControl_ClosePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClosePositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BotControlServer).ClosePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BotControl_ClosePosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BotControlServer).ClosePosition(ctx, req.(*ClosePositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BotControl_PauseTrading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseTradingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BotControlServer).PauseTrading(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BotControl_PauseTrading_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BotControlServer).PauseTrading(ctx, req.(*PauseTradingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BotControl_ResumeTrading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeTradingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BotControlServer).ResumeTrading(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BotControl_ResumeTrading_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BotControlServer).ResumeTrading(ctx, req.(*ResumeTradingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BotControl_ServiceDesc is the grpc.ServiceDesc for BotControl service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BotControl_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "botcontrol.v1.BotControl",
+	HandlerType: (*BotControlServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStatus",
+			Handler:    _BotControl_GetStatus_Handler,
+		},
+		{
+			MethodName: "ListPositions",
+			Handler:    _BotControl_ListPositions_Handler,
+		},
+		{
+			MethodName: "PlaceOrder",
+			Handler:    _BotControl_PlaceOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _BotControl_CancelOrder_Handler,
+		},
+		{
+			MethodName: "ClosePosition",
+			Handler:    _BotControl_ClosePosition_Handler,
+		},
+		{
+			MethodName: "PauseTrading",
+			Handler:    _BotControl_PauseTrading_Handler,
+		},
+		{
+			MethodName: "ResumeTrading",
+			Handler:    _BotControl_ResumeTrading_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPositions",
+			Handler:       _BotControl_StreamPositions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _BotControl_StreamEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "bot.proto",
+}
@@ -0,0 +1,5 @@
+// Package botpb holds the BotControl gRPC service definition and its
+// generated Go code
+package botpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative bot.proto
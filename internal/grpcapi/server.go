@@ -0,0 +1,455 @@
+// Package grpcapi serves the BotControl gRPC API: a typed, low-latency
+// alternative to the REST API for programmatic clients, backed by the same
+// BotAPI implementation and listening on its own port.
+package grpcapi
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"binance-trading-bot/internal/events"
+	"binance-trading-bot/internal/grpcapi/botpb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// eventBufferSize is how many undelivered events a StreamEvents client may
+// queue before newer ones are dropped
+const eventBufferSize = 256
+
+// BotAPI is the part of api.BotAPI the gRPC API uses, so the REST server's
+// implementation can be passed as is
+type BotAPI interface {
+	GetStatus() map[string]interface{}
+	GetOpenPositions() []map[string]interface{}
+	GetStrategies() []map[string]interface{}
+	PlaceOrder(symbol, side, orderType string, quantity, price float64) (int64, error)
+	CancelOrder(orderID int64) error
+	ClosePosition(symbol string) error
+	ToggleStrategy(name string, enabled bool) error
+}
+
+// Config holds gRPC server configuration
+type Config struct {
+	Host      string
+	Port      int
+	AuthToken string // Required; calls without "authorization: Bearer <token>" are rejected
+	// Default and minimum interval of StreamPositions snapshots
+	StreamInterval    time.Duration
+	MinStreamInterval time.Duration
+}
+
+// Server implements botpb.BotControlServer over a BotAPI
+type Server struct {
+	botpb.UnimplementedBotControlServer
+
+	botAPI     BotAPI
+	config     Config
+	grpcServer *grpc.Server
+
+	mu       sync.Mutex
+	paused   []string // Strategies disabled by PauseTrading, re-enabled by ResumeTrading
+	eventSub map[chan events.Event]struct{}
+}
+
+// NewServer creates a gRPC server over botAPI. Events published on eventBus
+// are fanned out to StreamEvents clients; eventBus may be nil.
+func NewServer(config Config, botAPI BotAPI, eventBus *events.EventBus) *Server {
+	if config.StreamInterval <= 0 {
+		config.StreamInterval = time.Second
+	}
+	if config.MinStreamInterval <= 0 {
+		config.MinStreamInterval = 100 * time.Millisecond
+	}
+
+	s := &Server{
+		botAPI:   botAPI,
+		config:   config,
+		eventSub: make(map[chan events.Event]struct{}),
+	}
+	s.grpcServer = grpc.NewServer(
+		grpc.UnaryInterceptor(s.authUnary),
+		grpc.StreamInterceptor(s.authStream),
+	)
+	botpb.RegisterBotControlServer(s.grpcServer, s)
+
+	if eventBus != nil {
+		eventBus.SubscribeAll(s.fanOutEvent)
+	}
+	return s
+}
+
+// Start listens on the configured address and serves until Shutdown
+func (s *Server) Start() error {
+	if s.config.AuthToken == "" {
+		return fmt.Errorf("grpc auth token is not configured")
+	}
+	addr := fmt.Sprintf("%s:%d", s.config.Host, s.config.Port)
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+	log.Printf("[GRPC] BotControl API listening on %s", addr)
+	return s.grpcServer.Serve(lis)
+}
+
+// Shutdown stops accepting calls and waits for in-flight ones, cutting open
+// streams off when ctx expires
+func (s *Server) Shutdown(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		s.grpcServer.Stop()
+		return ctx.Err()
+	}
+}
+
+// ==================== AUTH ====================
+
+// authorize checks the bearer token in the call's metadata
+func (s *Server) authorize(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		token, ok := strings.CutPrefix(value, "Bearer ")
+		if ok && s.config.AuthToken != "" &&
+			subtle.ConstantTimeCompare([]byte(token), []byte(s.config.AuthToken)) == 1 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "missing or invalid bearer token")
+}
+
+func (s *Server) authUnary(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (s *Server) authStream(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := s.authorize(ss.Context()); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// ==================== STATUS & POSITIONS ====================
+
+// GetStatus returns the bot status
+func (s *Server) GetStatus(ctx context.Context, _ *botpb.GetStatusRequest) (*botpb.GetStatusResponse, error) {
+	raw := s.botAPI.GetStatus()
+	details, err := toStruct(raw)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode status: %v", err)
+	}
+	running, _ := raw["running"].(bool)
+	dryRun, _ := raw["dry_run"].(bool)
+
+	s.mu.Lock()
+	paused := len(s.paused) > 0
+	s.mu.Unlock()
+
+	return &botpb.GetStatusResponse{
+		Running: running,
+		DryRun:  dryRun,
+		Paused:  paused,
+		Details: details,
+	}, nil
+}
+
+// ListPositions returns the open positions
+func (s *Server) ListPositions(ctx context.Context, _ *botpb.ListPositionsRequest) (*botpb.ListPositionsResponse, error) {
+	positions, err := s.positions()
+	if err != nil {
+		return nil, err
+	}
+	return &botpb.ListPositionsResponse{Positions: positions}, nil
+}
+
+// StreamPositions sends a positions snapshot immediately and then every
+// interval until the client cancels
+func (s *Server) StreamPositions(req *botpb.StreamPositionsRequest, stream botpb.BotControl_StreamPositionsServer) error {
+	interval := s.config.StreamInterval
+	if req.GetIntervalMs() > 0 {
+		interval = time.Duration(req.GetIntervalMs()) * time.Millisecond
+	}
+	interval = max(interval, s.config.MinStreamInterval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		positions, err := s.positions()
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(&botpb.PositionsSnapshot{Time: timestamppb.Now(), Positions: positions}); err != nil {
+			return err
+		}
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// positions converts the bot's open positions to messages
+func (s *Server) positions() ([]*botpb.Position, error) {
+	raw := s.botAPI.GetOpenPositions()
+	positions := make([]*botpb.Position, 0, len(raw))
+	for _, p := range raw {
+		position, err := toPosition(p)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to encode position: %v", err)
+		}
+		positions = append(positions, position)
+	}
+	return positions, nil
+}
+
+// ==================== EVENTS ====================
+
+// StreamEvents forwards bot events to the client until it cancels. Events a
+// slow client can't keep up with are dropped rather than stalling the bus.
+func (s *Server) StreamEvents(req *botpb.StreamEventsRequest, stream botpb.BotControl_StreamEventsServer) error {
+	var types map[events.EventType]bool
+	if len(req.GetTypes()) > 0 {
+		types = make(map[events.EventType]bool, len(req.GetTypes()))
+		for _, t := range req.GetTypes() {
+			types[events.EventType(strings.ToUpper(t))] = true
+		}
+	}
+
+	ch := make(chan events.Event, eventBufferSize)
+	s.mu.Lock()
+	s.eventSub[ch] = struct{}{}
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.eventSub, ch)
+		s.mu.Unlock()
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event := <-ch:
+			if types != nil && !types[event.Type] {
+				continue
+			}
+			data, err := toStruct(event.Data)
+			if err != nil {
+				log.Printf("[GRPC] Skipping %s event that can't be encoded: %v", event.Type, err)
+				continue
+			}
+			if err := stream.Send(&botpb.BotEvent{
+				Type: string(event.Type),
+				Time: timestamppb.New(event.Timestamp),
+				Data: data,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// fanOutEvent queues a bus event for every StreamEvents client
+func (s *Server) fanOutEvent(event events.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for ch := range s.eventSub {
+		select {
+		case ch <- event:
+		default: // Client is behind; drop rather than block the bus
+		}
+	}
+}
+
+// ==================== ORDERS & POSITIONS CONTROL ====================
+
+// PlaceOrder places a manual order, with the same rules as POST /api/orders
+func (s *Server) PlaceOrder(ctx context.Context, req *botpb.PlaceOrderRequest) (*botpb.PlaceOrderResponse, error) {
+	symbol := strings.ToUpper(strings.TrimSpace(req.GetSymbol()))
+	side := strings.ToUpper(req.GetSide())
+	orderType := strings.ToUpper(req.GetOrderType())
+
+	if symbol == "" {
+		return nil, status.Error(codes.InvalidArgument, "symbol is required")
+	}
+	if side != "BUY" && side != "SELL" {
+		return nil, status.Error(codes.InvalidArgument, "side must be BUY or SELL")
+	}
+	if orderType != "MARKET" && orderType != "LIMIT" {
+		return nil, status.Error(codes.InvalidArgument, "order_type must be MARKET or LIMIT")
+	}
+	if req.GetQuantity() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "quantity must be greater than 0")
+	}
+	if orderType == "LIMIT" && req.GetPrice() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "price is required for LIMIT orders")
+	}
+
+	orderID, err := s.botAPI.PlaceOrder(symbol, side, orderType, req.GetQuantity(), req.GetPrice())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to place order: %v", err)
+	}
+	return &botpb.PlaceOrderResponse{OrderId: orderID}, nil
+}
+
+// CancelOrder cancels an order by ID
+func (s *Server) CancelOrder(ctx context.Context, req *botpb.CancelOrderRequest) (*botpb.CancelOrderResponse, error) {
+	if req.GetOrderId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "order_id is required")
+	}
+	if err := s.botAPI.CancelOrder(req.GetOrderId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to cancel order: %v", err)
+	}
+	return &botpb.CancelOrderResponse{}, nil
+}
+
+// ClosePosition closes the open position on a symbol
+func (s *Server) ClosePosition(ctx context.Context, req *botpb.ClosePositionRequest) (*botpb.ClosePositionResponse, error) {
+	symbol := strings.ToUpper(strings.TrimSpace(req.GetSymbol()))
+	if symbol == "" {
+		return nil, status.Error(codes.InvalidArgument, "symbol is required")
+	}
+	if err := s.botAPI.ClosePosition(symbol); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to close position: %v", err)
+	}
+	return &botpb.ClosePositionResponse{}, nil
+}
+
+// ==================== PAUSE / RESUME ====================
+
+// PauseTrading disables every enabled strategy and remembers which, so
+// ResumeTrading re-enables exactly those. Pausing again adds any strategies
+// enabled since.
+func (s *Server) PauseTrading(ctx context.Context, _ *botpb.PauseTradingRequest) (*botpb.PauseTradingResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var disabled []string
+	for _, strategy := range s.botAPI.GetStrategies() {
+		name, _ := strategy["name"].(string)
+		if enabled, _ := strategy["enabled"].(bool); name == "" || !enabled {
+			continue
+		}
+		if err := s.botAPI.ToggleStrategy(name, false); err != nil {
+			s.paused = append(s.paused, disabled...)
+			return nil, status.Errorf(codes.Internal, "failed to disable strategy %s: %v", name, err)
+		}
+		disabled = append(disabled, name)
+	}
+	s.paused = append(s.paused, disabled...)
+	log.Printf("[GRPC] Trading paused: disabled %d strategies", len(disabled))
+	return &botpb.PauseTradingResponse{DisabledStrategies: disabled}, nil
+}
+
+// ResumeTrading re-enables the strategies PauseTrading disabled
+func (s *Server) ResumeTrading(ctx context.Context, _ *botpb.ResumeTradingRequest) (*botpb.ResumeTradingResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	enabled := make([]string, 0, len(s.paused))
+	for i, name := range s.paused {
+		if err := s.botAPI.ToggleStrategy(name, true); err != nil {
+			s.paused = s.paused[i:]
+			return nil, status.Errorf(codes.Internal, "failed to enable strategy %s: %v", name, err)
+		}
+		enabled = append(enabled, name)
+	}
+	s.paused = nil
+	log.Printf("[GRPC] Trading resumed: enabled %d strategies", len(enabled))
+	return &botpb.ResumeTradingResponse{EnabledStrategies: enabled}, nil
+}
+
+// ==================== CONVERSION ====================
+
+// toStruct converts a JSON-style map to a protobuf Struct. Values go through
+// encoding/json first so nested structs and times encode as they do over REST.
+func toStruct(m map[string]interface{}) (*structpb.Struct, error) {
+	if m == nil {
+		return &structpb.Struct{}, nil
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	var plain map[string]interface{}
+	if err := json.Unmarshal(b, &plain); err != nil {
+		return nil, err
+	}
+	return structpb.NewStruct(plain)
+}
+
+// toPosition converts one of BotAPI.GetOpenPositions' maps to a Position
+func toPosition(m map[string]interface{}) (*botpb.Position, error) {
+	details, err := toStruct(m)
+	if err != nil {
+		return nil, err
+	}
+	position := &botpb.Position{
+		Symbol:       stringField(m, "symbol"),
+		Side:         stringField(m, "side"),
+		EntryPrice:   floatField(m, "entry_price"),
+		CurrentPrice: floatField(m, "current_price"),
+		Quantity:     floatField(m, "quantity"),
+		Pnl:          floatField(m, "pnl"),
+		PnlPercent:   floatField(m, "pnl_percent"),
+		StopLoss:     floatField(m, "stop_loss"),
+		TakeProfit:   floatField(m, "take_profit"),
+		Details:      details,
+	}
+	switch t := m["entry_time"].(type) {
+	case time.Time:
+		position.EntryTime = timestamppb.New(t)
+	case *time.Time:
+		if t != nil {
+			position.EntryTime = timestamppb.New(*t)
+		}
+	}
+	return position, nil
+}
+
+func stringField(m map[string]interface{}, key string) string {
+	s, _ := m[key].(string)
+	return s
+}
+
+// floatField reads a numeric field, dereferencing optional (pointer) values
+func floatField(m map[string]interface{}, key string) float64 {
+	switch v := m[key].(type) {
+	case float64:
+		return v
+	case *float64:
+		if v != nil {
+			return *v
+		}
+	case float32:
+		return float64(v)
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	}
+	return 0
+}
@@ -0,0 +1,223 @@
+package grpcapi
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"binance-trading-bot/internal/events"
+	"binance-trading-bot/internal/grpcapi/botpb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const testToken = "test-token-0123456789"
+
+type fakeBotAPI struct {
+	mu         sync.Mutex
+	strategies map[string]bool
+	orders     []string
+	closed     []string
+}
+
+func (f *fakeBotAPI) GetStatus() map[string]interface{} {
+	return map[string]interface{}{"running": true, "dry_run": true, "open_positions": 1}
+}
+
+func (f *fakeBotAPI) GetOpenPositions() []map[string]interface{} {
+	stopLoss := 58000.0
+	return []map[string]interface{}{{
+		"symbol":      "BTCUSDT",
+		"side":        "BUY",
+		"entry_price": 60000.0,
+		"quantity":    0.01,
+		"stop_loss":   &stopLoss,
+		"entry_time":  time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}}
+}
+
+func (f *fakeBotAPI) GetStrategies() []map[string]interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var result []map[string]interface{}
+	for name, enabled := range f.strategies {
+		result = append(result, map[string]interface{}{"name": name, "enabled": enabled})
+	}
+	return result
+}
+
+func (f *fakeBotAPI) PlaceOrder(symbol, side, orderType string, quantity, price float64) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.orders = append(f.orders, symbol+" "+side+" "+orderType)
+	return 42, nil
+}
+
+func (f *fakeBotAPI) CancelOrder(orderID int64) error { return nil }
+
+func (f *fakeBotAPI) ClosePosition(symbol string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.closed = append(f.closed, symbol)
+	return nil
+}
+
+func (f *fakeBotAPI) ToggleStrategy(name string, enabled bool) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.strategies[name] = enabled
+	return nil
+}
+
+// newTestClient serves s over an in-memory listener
+func newTestClient(t *testing.T, s *Server) botpb.BotControlClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	go s.grpcServer.Serve(lis)
+	t.Cleanup(s.grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return botpb.NewBotControlClient(conn)
+}
+
+func authed(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+testToken)
+}
+
+func TestServerRequiresToken(t *testing.T) {
+	client := newTestClient(t, NewServer(Config{AuthToken: testToken}, &fakeBotAPI{}, nil))
+
+	_, err := client.GetStatus(context.Background(), &botpb.GetStatusRequest{})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("call without token: got %v, want Unauthenticated", err)
+	}
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer wrong")
+	if _, err := client.GetStatus(ctx, &botpb.GetStatusRequest{}); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("call with wrong token: got %v, want Unauthenticated", err)
+	}
+
+	resp, err := client.GetStatus(authed(context.Background()), &botpb.GetStatusRequest{})
+	if err != nil {
+		t.Fatalf("GetStatus: %v", err)
+	}
+	if !resp.Running || !resp.DryRun || resp.Details.Fields["open_positions"].GetNumberValue() != 1 {
+		t.Errorf("status = %+v", resp)
+	}
+}
+
+func TestServerOrdersAndPositions(t *testing.T) {
+	bot := &fakeBotAPI{}
+	client := newTestClient(t, NewServer(Config{AuthToken: testToken}, bot, nil))
+	ctx := authed(context.Background())
+
+	_, err := client.PlaceOrder(ctx, &botpb.PlaceOrderRequest{Symbol: "btcusdt", Side: "BUY", OrderType: "LIMIT", Quantity: 1})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("LIMIT without price: got %v, want InvalidArgument", err)
+	}
+	resp, err := client.PlaceOrder(ctx, &botpb.PlaceOrderRequest{Symbol: "btcusdt", Side: "buy", OrderType: "market", Quantity: 1})
+	if err != nil || resp.OrderId != 42 {
+		t.Fatalf("PlaceOrder = %v, %v", resp, err)
+	}
+	if len(bot.orders) != 1 || bot.orders[0] != "BTCUSDT BUY MARKET" {
+		t.Errorf("orders = %v", bot.orders)
+	}
+
+	if _, err := client.ClosePosition(ctx, &botpb.ClosePositionRequest{Symbol: "ethusdt"}); err != nil {
+		t.Fatalf("ClosePosition: %v", err)
+	}
+	if len(bot.closed) != 1 || bot.closed[0] != "ETHUSDT" {
+		t.Errorf("closed = %v", bot.closed)
+	}
+
+	stream, err := client.StreamPositions(ctx, &botpb.StreamPositionsRequest{IntervalMs: 1})
+	if err != nil {
+		t.Fatalf("StreamPositions: %v", err)
+	}
+	snapshot, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv: %v", err)
+	}
+	if len(snapshot.Positions) != 1 {
+		t.Fatalf("got %d positions, want 1", len(snapshot.Positions))
+	}
+	p := snapshot.Positions[0]
+	if p.Symbol != "BTCUSDT" || p.EntryPrice != 60000 || p.StopLoss != 58000 || p.EntryTime.AsTime().Year() != 2026 {
+		t.Errorf("position = %+v", p)
+	}
+}
+
+func TestServerPauseResume(t *testing.T) {
+	bot := &fakeBotAPI{strategies: map[string]bool{"rsi": true, "macd": true, "off": false}}
+	client := newTestClient(t, NewServer(Config{AuthToken: testToken}, bot, nil))
+	ctx := authed(context.Background())
+
+	paused, err := client.PauseTrading(ctx, &botpb.PauseTradingRequest{})
+	if err != nil || len(paused.DisabledStrategies) != 2 {
+		t.Fatalf("PauseTrading = %v, %v", paused, err)
+	}
+	if bot.strategies["rsi"] || bot.strategies["macd"] {
+		t.Errorf("strategies still enabled after pause: %v", bot.strategies)
+	}
+	if status, _ := client.GetStatus(ctx, &botpb.GetStatusRequest{}); !status.GetPaused() {
+		t.Error("status not paused")
+	}
+
+	resumed, err := client.ResumeTrading(ctx, &botpb.ResumeTradingRequest{})
+	if err != nil || len(resumed.EnabledStrategies) != 2 {
+		t.Fatalf("ResumeTrading = %v, %v", resumed, err)
+	}
+	if !bot.strategies["rsi"] || !bot.strategies["macd"] || bot.strategies["off"] {
+		t.Errorf("strategies after resume = %v, want the pre-pause set", bot.strategies)
+	}
+}
+
+func TestServerStreamEvents(t *testing.T) {
+	bus := events.NewEventBus()
+	s := NewServer(Config{AuthToken: testToken}, &fakeBotAPI{}, bus)
+	client := newTestClient(t, s)
+	ctx, cancel := context.WithCancel(authed(context.Background()))
+	defer cancel()
+
+	stream, err := client.StreamEvents(ctx, &botpb.StreamEventsRequest{Types: []string{"TRADE_OPENED"}})
+	if err != nil {
+		t.Fatalf("StreamEvents: %v", err)
+	}
+	// The stream registers once its handler runs; publish until it's there
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		s.mu.Lock()
+		n := len(s.eventSub)
+		s.mu.Unlock()
+		if n > 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("stream never subscribed")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	bus.PublishSignal("rsi", "BTCUSDT", "BUY", "oversold", 60000) // Filtered out
+	bus.PublishTradeOpened("BTCUSDT", "BUY", 60000, 0.01)
+
+	event, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv: %v", err)
+	}
+	if event.Type != "TRADE_OPENED" || event.Data.Fields["symbol"].GetStringValue() != "BTCUSDT" {
+		t.Errorf("event = %+v", event)
+	}
+}
@@ -0,0 +1,138 @@
+package license
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Fingerprint binding defaults, overridable with LICENSE_MAX_FINGERPRINTS and
+// LICENSE_FINGERPRINT_GRACE_DAYS
+const (
+	DefaultMaxFingerprints  = 2
+	DefaultFingerprintGrace = 30 * 24 * time.Hour
+)
+
+// ErrFingerprintLimit is returned when a key is already bound to the maximum
+// number of installations and none of them has been retired
+var ErrFingerprintLimit = errors.New("license is bound to the maximum number of installations")
+
+// FingerprintBinding is one installation a license key has been validated on
+type FingerprintBinding struct {
+	Fingerprint string    `json:"fingerprint"`
+	FirstSeen   time.Time `json:"first_seen"`
+	LastSeen    time.Time `json:"last_seen"`
+}
+
+// FingerprintPolicy limits how many installations a key may run on. A binding
+// not seen for Grace is treated as retired hardware and frees its slot, so a
+// replaced machine can take over without the vendor resetting the key.
+type FingerprintPolicy struct {
+	MaxFingerprints int           // 0 = unlimited
+	Grace           time.Duration // 0 = bindings never expire
+}
+
+// FingerprintStore persists the installations each license key is bound to
+type FingerprintStore interface {
+	LoadFingerprints(key string) ([]FingerprintBinding, error)
+	SaveFingerprints(key string, bindings []FingerprintBinding) error
+}
+
+// FingerprintPolicyFromEnv reads the binding policy from the environment
+func FingerprintPolicyFromEnv() FingerprintPolicy {
+	policy := FingerprintPolicy{
+		MaxFingerprints: DefaultMaxFingerprints,
+		Grace:           DefaultFingerprintGrace,
+	}
+	if v, err := strconv.Atoi(os.Getenv("LICENSE_MAX_FINGERPRINTS")); err == nil && v >= 0 {
+		policy.MaxFingerprints = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("LICENSE_FINGERPRINT_GRACE_DAYS")); err == nil && v >= 0 {
+		policy.Grace = time.Duration(v) * 24 * time.Hour
+	}
+	return policy
+}
+
+// Fingerprint returns a stable identifier for this installation. LICENSE_INSTALL_ID
+// takes precedence (containers and white-label deployments that move between
+// hosts); otherwise it is a hash of the hostname, machine ID and MAC addresses.
+// Returns the fingerprint and the source it was derived from.
+func Fingerprint() (string, string) {
+	if id := strings.TrimSpace(os.Getenv("LICENSE_INSTALL_ID")); id != "" {
+		return hashFingerprint("install:" + id), "install_id"
+	}
+
+	var parts []string
+	if host, err := os.Hostname(); err == nil {
+		parts = append(parts, "host:"+host)
+	}
+	for _, path := range []string{"/etc/machine-id", "/var/lib/dbus/machine-id"} {
+		if data, err := os.ReadFile(path); err == nil {
+			if id := strings.TrimSpace(string(data)); id != "" {
+				parts = append(parts, "machine:"+id)
+				break
+			}
+		}
+	}
+	parts = append(parts, macAddresses()...)
+	return hashFingerprint(strings.Join(parts, "|")), "host"
+}
+
+// macAddresses returns the sorted hardware addresses of non-loopback interfaces
+func macAddresses() []string {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return nil
+	}
+	var macs []string
+	for _, iface := range ifaces {
+		if iface.Flags&net.FlagLoopback != 0 || len(iface.HardwareAddr) == 0 {
+			continue
+		}
+		macs = append(macs, "mac:"+iface.HardwareAddr.String())
+	}
+	sort.Strings(macs)
+	return macs
+}
+
+func hashFingerprint(source string) string {
+	hash := sha256.Sum256([]byte(source))
+	return strings.ToUpper(hex.EncodeToString(hash[:])[:32])
+}
+
+// CheckFingerprint applies the policy to a key's bindings for fingerprint and
+// returns the updated bindings. A known fingerprint is refreshed; a new one is
+// bound if there is a free slot once retired bindings are dropped; otherwise
+// ErrFingerprintLimit is returned with the bindings unchanged.
+func CheckFingerprint(bindings []FingerprintBinding, fingerprint string, policy FingerprintPolicy, now time.Time) ([]FingerprintBinding, error) {
+	active := make([]FingerprintBinding, 0, len(bindings)+1)
+	found := false
+	for _, b := range bindings {
+		if b.Fingerprint == fingerprint {
+			b.LastSeen = now
+			found = true
+		} else if policy.Grace > 0 && now.Sub(b.LastSeen) > policy.Grace {
+			continue // Retired hardware
+		}
+		active = append(active, b)
+	}
+	if found {
+		return active, nil
+	}
+
+	if policy.MaxFingerprints > 0 && len(active) >= policy.MaxFingerprints {
+		return bindings, fmt.Errorf("%w (%d of %d in use)", ErrFingerprintLimit, len(active), policy.MaxFingerprints)
+	}
+	return append(active, FingerprintBinding{
+		Fingerprint: fingerprint,
+		FirstSeen:   now,
+		LastSeen:    now,
+	}), nil
+}
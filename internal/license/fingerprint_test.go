@@ -0,0 +1,47 @@
+package license
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCheckFingerprint(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	policy := FingerprintPolicy{MaxFingerprints: 2, Grace: 30 * 24 * time.Hour}
+
+	bindings, err := CheckFingerprint(nil, "A", policy, now)
+	if err != nil || len(bindings) != 1 {
+		t.Fatalf("first binding = %v, %v", bindings, err)
+	}
+	bindings, err = CheckFingerprint(bindings, "B", policy, now.Add(time.Hour))
+	if err != nil || len(bindings) != 2 {
+		t.Fatalf("second binding = %v, %v", bindings, err)
+	}
+
+	// A third installation is rejected while both slots are in use
+	if _, err := CheckFingerprint(bindings, "C", policy, now.Add(2*time.Hour)); !errors.Is(err, ErrFingerprintLimit) {
+		t.Fatalf("third binding err = %v, want ErrFingerprintLimit", err)
+	}
+
+	// A known installation refreshes its last seen time
+	later := now.Add(20 * 24 * time.Hour)
+	bindings, err = CheckFingerprint(bindings, "A", policy, later)
+	if err != nil || !bindings[0].LastSeen.Equal(later) || !bindings[0].FirstSeen.Equal(now) {
+		t.Fatalf("refresh = %v, %v", bindings, err)
+	}
+
+	// Once B is unseen past the grace period, its slot goes to the new hardware
+	bindings, err = CheckFingerprint(bindings, "C", policy, now.Add(35*24*time.Hour))
+	if err != nil {
+		t.Fatalf("binding after grace: %v", err)
+	}
+	if len(bindings) != 2 || bindings[0].Fingerprint != "A" || bindings[1].Fingerprint != "C" {
+		t.Errorf("bindings after grace = %v, want A and C", bindings)
+	}
+
+	// Unlimited policy never rejects
+	if _, err := CheckFingerprint(bindings, "D", FingerprintPolicy{}, now); err != nil {
+		t.Errorf("unlimited policy rejected: %v", err)
+	}
+}
@@ -35,6 +35,7 @@ type LicenseInfo struct {
 	Message      string      `json:"message,omitempty"`
 	LastChecked  time.Time   `json:"last_checked"`
 	OfflineMode  bool        `json:"offline_mode"`
+	Fingerprint  string      `json:"fingerprint,omitempty"`
 }
 
 // Validator handles license validation
@@ -43,6 +44,13 @@ type Validator struct {
 	licenseInfo  *LicenseInfo
 	validatorURL string
 	offlineMode  bool
+
+	// Installation binding for white-label keys (see fingerprint.go)
+	fingerprint       string
+	fingerprintStore  FingerprintStore
+	fingerprintPolicy FingerprintPolicy
+
+	revocations []RevocationList
 }
 
 // LicenseKeyPattern matches XXX-XXXX-XXXX-XXXX format
@@ -50,12 +58,36 @@ var LicenseKeyPattern = regexp.MustCompile(`^[A-Z0-9]{3}-[A-Z0-9]{4}-[A-Z0-9]{4}
 
 // NewValidator creates a new license validator
 func NewValidator(validatorURL string) *Validator {
+	fingerprint, _ := Fingerprint()
 	return &Validator{
-		validatorURL: validatorURL,
-		offlineMode:  validatorURL == "",
+		validatorURL:      validatorURL,
+		offlineMode:       validatorURL == "",
+		fingerprint:       fingerprint,
+		fingerprintPolicy: FingerprintPolicyFromEnv(),
 	}
 }
 
+// SetFingerprintStore enables installation binding: white-label keys are
+// rejected once they are bound to more installations than the policy allows
+func (v *Validator) SetFingerprintStore(store FingerprintStore, policy FingerprintPolicy) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.fingerprintStore = store
+	v.fingerprintPolicy = policy
+}
+
+// AddRevocationList makes the validator reject keys on list
+func (v *Validator) AddRevocationList(list RevocationList) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.revocations = append(v.revocations, list)
+}
+
+// Fingerprint returns the installation fingerprint sent with validations
+func (v *Validator) Fingerprint() string {
+	return v.fingerprint
+}
+
 // ValidateLicense validates the license key
 func (v *Validator) ValidateLicense(key string) (*LicenseInfo, error) {
 	v.mu.Lock()
@@ -82,6 +114,8 @@ func (v *Validator) ValidateLicense(key string) (*LicenseInfo, error) {
 	if !v.offlineMode {
 		info, err := v.validateOnline(key)
 		if err == nil {
+			v.checkRevoked(info)
+			v.bindFingerprint(info)
 			v.licenseInfo = info
 			return info, nil
 		}
@@ -90,10 +124,54 @@ func (v *Validator) ValidateLicense(key string) (*LicenseInfo, error) {
 
 	// Offline validation using checksum
 	info := v.validateOffline(key)
+	v.checkRevoked(info)
+	v.bindFingerprint(info)
 	v.licenseInfo = info
 	return info, nil
 }
 
+// checkRevoked invalidates a license whose key is on a revocation list. An
+// unavailable list does not reject the key but is noted in the message.
+func (v *Validator) checkRevoked(info *LicenseInfo) {
+	if !info.IsValid {
+		return
+	}
+	for _, list := range v.revocations {
+		revoked, err := list.IsRevoked(info.Key)
+		if err != nil {
+			info.Message += fmt.Sprintf(" (revocation list unavailable: %v)", err)
+			continue
+		}
+		if revoked {
+			info.IsValid = false
+			info.Message = "License has been revoked"
+			return
+		}
+	}
+}
+
+// bindFingerprint records this installation against a valid white-label key
+// and invalidates the license if the key is already bound to too many others.
+// Store errors are not fatal so a database outage cannot lock out a paid key.
+func (v *Validator) bindFingerprint(info *LicenseInfo) {
+	info.Fingerprint = v.fingerprint
+	if v.fingerprintStore == nil || v.fingerprint == "" || !info.HasFeature("white_label") {
+		return
+	}
+
+	bindings, err := v.fingerprintStore.LoadFingerprints(info.Key)
+	if err != nil {
+		return
+	}
+	updated, err := CheckFingerprint(bindings, v.fingerprint, v.fingerprintPolicy, time.Now())
+	if err != nil {
+		info.IsValid = false
+		info.Message = fmt.Sprintf("License rejected on this installation: %v", err)
+		return
+	}
+	v.fingerprintStore.SaveFingerprints(info.Key, updated)
+}
+
 // validateOnline validates the license against the server
 func (v *Validator) validateOnline(key string) (*LicenseInfo, error) {
 	client := &http.Client{Timeout: 10 * time.Second}
@@ -103,6 +181,7 @@ func (v *Validator) validateOnline(key string) (*LicenseInfo, error) {
 		return nil, err
 	}
 	req.Header.Set("X-License-Key", key)
+	req.Header.Set("X-Fingerprint", v.fingerprint)
 	req.Header.Set("X-Product", "binance-trading-bot")
 
 	resp, err := client.Do(req)
@@ -209,6 +288,11 @@ func (v *Validator) getLicenseTypeFromPrefix(prefix string) LicenseType {
 
 // getFeaturesForType returns features and max symbols for license type
 func (v *Validator) getFeaturesForType(licenseType LicenseType) ([]string, int) {
+	return FeaturesForType(licenseType)
+}
+
+// FeaturesForType returns the features and max symbols a license type grants
+func FeaturesForType(licenseType LicenseType) ([]string, int) {
 	switch licenseType {
 	case LicenseTypePersonal:
 		return []string{
@@ -324,11 +408,30 @@ func generateRandomAlphanumeric(length int) string {
 	return string(result)
 }
 
+// NewValidatorFromEnv creates a validator for the LICENSE_VALIDATOR_URL server
+// (offline checksum validation when unset) that honours the revocation lists
+// configured with LICENSE_REVOCATION_FILE and LICENSE_REVOCATION_URL
+func NewValidatorFromEnv() *Validator {
+	v := NewValidator(os.Getenv("LICENSE_VALIDATOR_URL"))
+	v.revocations = RevocationListsFromEnv()
+	return v
+}
+
 // GetLicenseFromEnv reads and validates license from environment
 func GetLicenseFromEnv() (*LicenseInfo, error) {
 	key := os.Getenv("LICENSE_KEY")
-	validatorURL := os.Getenv("LICENSE_VALIDATOR_URL")
+	return NewValidatorFromEnv().ValidateLicense(key)
+}
 
-	validator := NewValidator(validatorURL)
-	return validator.ValidateLicense(key)
+// HasFeature checks if the license includes a feature
+func (info *LicenseInfo) HasFeature(feature string) bool {
+	if info == nil || !info.IsValid {
+		return false
+	}
+	for _, f := range info.Features {
+		if f == feature {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,128 @@
+package license
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// remoteRevocationTTL is how long a downloaded revocation list is trusted
+const remoteRevocationTTL = time.Hour
+
+// RevocationList reports whether a license key has been revoked
+type RevocationList interface {
+	IsRevoked(key string) (bool, error)
+}
+
+// RevocationSet is a fixed set of revoked keys, e.g. loaded from a file
+type RevocationSet map[string]bool
+
+// IsRevoked implements RevocationList
+func (s RevocationSet) IsRevoked(key string) (bool, error) {
+	return s[strings.ToUpper(strings.TrimSpace(key))], nil
+}
+
+// ParseRevocationList reads one key per line; blank lines and # comments are ignored
+func ParseRevocationList(r io.Reader) (RevocationSet, error) {
+	set := make(RevocationSet)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		if key := strings.ToUpper(strings.TrimSpace(line)); key != "" {
+			set[key] = true
+		}
+	}
+	return set, scanner.Err()
+}
+
+// LoadRevocationFile reads a revocation list written by the license-admin tool
+func LoadRevocationFile(path string) (RevocationSet, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open revocation list: %w", err)
+	}
+	defer f.Close()
+	return ParseRevocationList(f)
+}
+
+// RemoteRevocationList downloads a revocation list (same format as the file)
+// and caches it for remoteRevocationTTL. A stale copy is kept if a refresh fails.
+type RemoteRevocationList struct {
+	url string
+
+	mu        sync.Mutex
+	set       RevocationSet
+	fetchedAt time.Time
+}
+
+// NewRemoteRevocationList creates a revocation list served from url
+func NewRemoteRevocationList(url string) *RemoteRevocationList {
+	return &RemoteRevocationList{url: url}
+}
+
+// IsRevoked implements RevocationList
+func (l *RemoteRevocationList) IsRevoked(key string) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.set == nil || time.Since(l.fetchedAt) > remoteRevocationTTL {
+		set, err := l.fetch()
+		if err != nil && l.set == nil {
+			return false, err
+		}
+		if err == nil {
+			l.set = set
+			l.fetchedAt = time.Now()
+		}
+	}
+	return l.set.IsRevoked(key)
+}
+
+func (l *RemoteRevocationList) fetch() (RevocationSet, error) {
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Get(l.url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("revocation list fetch failed with status: %d", resp.StatusCode)
+	}
+	return ParseRevocationList(resp.Body)
+}
+
+// RevocationListsFromEnv returns the revocation lists configured with
+// LICENSE_REVOCATION_FILE and LICENSE_REVOCATION_URL
+func RevocationListsFromEnv() []RevocationList {
+	var lists []RevocationList
+	if path := os.Getenv("LICENSE_REVOCATION_FILE"); path != "" {
+		if set, err := LoadRevocationFile(path); err == nil {
+			lists = append(lists, set)
+		} else {
+			// Keep the error so validation reports the list as unavailable
+			lists = append(lists, failedRevocationList{err: err})
+		}
+	}
+	if url := os.Getenv("LICENSE_REVOCATION_URL"); url != "" {
+		lists = append(lists, NewRemoteRevocationList(url))
+	}
+	return lists
+}
+
+// failedRevocationList reports the error from loading a configured list
+type failedRevocationList struct {
+	err error
+}
+
+func (l failedRevocationList) IsRevoked(string) (bool, error) {
+	return false, l.err
+}
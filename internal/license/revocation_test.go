@@ -0,0 +1,39 @@
+package license
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidatorRejectsRevokedKeys(t *testing.T) {
+	revoked := GenerateLicenseKey(LicenseTypePro)
+	good := GenerateLicenseKey(LicenseTypePersonal)
+
+	list, err := ParseRevocationList(strings.NewReader("# Revoked license keys\n\n" + strings.ToLower(revoked) + "  # chargeback\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v := NewValidator("")
+	v.AddRevocationList(list)
+
+	info, err := v.ValidateLicense(revoked)
+	if err != nil || info.IsValid || info.Message != "License has been revoked" {
+		t.Errorf("revoked key = %+v, %v; want rejected", info, err)
+	}
+	info, err = v.ValidateLicense(good)
+	if err != nil || !info.IsValid {
+		t.Errorf("unrevoked key = %+v, %v; want valid", info, err)
+	}
+
+	// An unavailable list is reported but does not lock out the key
+	v.AddRevocationList(failedRevocationList{err: errTest("no such file")})
+	info, _ = v.ValidateLicense(good)
+	if !info.IsValid || !strings.Contains(info.Message, "revocation list unavailable") {
+		t.Errorf("with failed list = %+v; want valid with warning", info)
+	}
+}
+
+type errTest string
+
+func (e errTest) Error() string { return string(e) }
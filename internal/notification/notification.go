@@ -5,7 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
+
+	"binance-trading-bot/internal/circuit"
 )
 
 // NotificationType represents the type of notification
@@ -17,6 +20,7 @@ const (
 	NotifyTradeClose  NotificationType = "trade_close"
 	NotifyError       NotificationType = "error"
 	NotifyInfo        NotificationType = "info"
+	NotifyCircuitBreaker NotificationType = "circuit_breaker"
 )
 
 // Notification represents a notification message
@@ -43,6 +47,8 @@ type Notifier interface {
 type Manager struct {
 	notifiers []Notifier
 	enabled   bool
+	muted     map[string]bool // notifier name -> muted at runtime
+	mu        sync.RWMutex
 }
 
 // NewManager creates a new notification manager
@@ -50,22 +56,49 @@ func NewManager() *Manager {
 	return &Manager{
 		notifiers: make([]Notifier, 0),
 		enabled:   true,
+		muted:     make(map[string]bool),
 	}
 }
 
 // AddNotifier adds a notification provider
 func (m *Manager) AddNotifier(n Notifier) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.notifiers = append(m.notifiers, n)
 }
 
+// SetEnabled turns all notifications on or off at runtime
+func (m *Manager) SetEnabled(enabled bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.enabled = enabled
+}
+
+// SetNotifierEnabled mutes or unmutes a registered provider by name.
+// A provider that was not configured at startup cannot be enabled here.
+func (m *Manager) SetNotifierEnabled(name string, enabled bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.muted[name] = !enabled
+}
+
 // Send sends a notification to all enabled providers
 func (m *Manager) Send(notification *Notification) error {
+	m.mu.RLock()
 	if !m.enabled {
+		m.mu.RUnlock()
 		return nil
 	}
+	notifiers := make([]Notifier, 0, len(m.notifiers))
+	for _, n := range m.notifiers {
+		if !m.muted[n.Name()] {
+			notifiers = append(notifiers, n)
+		}
+	}
+	m.mu.RUnlock()
 
 	var lastErr error
-	for _, n := range m.notifiers {
+	for _, n := range notifiers {
 		if n.IsEnabled() {
 			if err := n.Send(notification); err != nil {
 				lastErr = err
@@ -139,6 +172,53 @@ func (m *Manager) SendError(title, message string) error {
 	})
 }
 
+// SendInfo sends an informational notification
+func (m *Manager) SendInfo(title, message string) error {
+	return m.Send(&Notification{
+		Type:      NotifyInfo,
+		Title:     title,
+		Message:   message,
+		Timestamp: time.Now(),
+	})
+}
+
+// SubscribeCircuitBreaker sends the breaker's escalating alerts: on trip, a
+// reminder every ReminderIntervalMinutes while tripped, and on resolution
+func (m *Manager) SubscribeCircuitBreaker(cb *circuit.CircuitBreaker) {
+	cb.OnAlert(func(alert circuit.Alert) {
+		m.SendCircuitBreakerAlert(alert)
+	})
+}
+
+// SendCircuitBreakerAlert sends a circuit breaker incident notification
+func (m *Manager) SendCircuitBreakerAlert(alert circuit.Alert) error {
+	var title, message string
+	switch alert.Kind {
+	case circuit.AlertTripped:
+		title = "🛑 Circuit Breaker Tripped"
+		message = fmt.Sprintf("Trading halted\nReason: %s", alert.Reason)
+	case circuit.AlertReminder:
+		title = fmt.Sprintf("🛑 Circuit Breaker Still Tripped (reminder %d)", alert.Reminders)
+		message = fmt.Sprintf("Trading halted for %s\nReason: %s", alert.Duration.Round(time.Minute), alert.Reason)
+	default:
+		title = "✅ Circuit Breaker Reset"
+		message = fmt.Sprintf("Trading resumed after %s\nLast reason: %s", alert.Duration.Round(time.Minute), alert.Reason)
+	}
+
+	return m.Send(&Notification{
+		Type:      NotifyCircuitBreaker,
+		Title:     title,
+		Message:   message,
+		Timestamp: time.Now(),
+		Extra: map[string]interface{}{
+			"kind":       string(alert.Kind),
+			"reason":     alert.Reason,
+			"tripped_at": alert.TrippedAt,
+			"reminders":  alert.Reminders,
+		},
+	})
+}
+
 // =============================================================================
 // TELEGRAM NOTIFIER
 // =============================================================================
@@ -253,6 +333,8 @@ func (d *DiscordNotifier) Send(notification *Notification) error {
 		color = 0xFF0000 // Red
 	} else if notification.Type == NotifyTradeClose && notification.PnL < 0 {
 		color = 0xFF0000 // Red
+	} else if notification.Type == NotifyCircuitBreaker && notification.Extra["kind"] != string(circuit.AlertResolved) {
+		color = 0xFF0000 // Red
 	}
 
 	embed := map[string]interface{}{
@@ -262,6 +344,11 @@ func (d *DiscordNotifier) Send(notification *Notification) error {
 		"timestamp":   notification.Timestamp.Format(time.RFC3339),
 	}
 
+	// Trade alerts link the title to the chart
+	if chartURL, ok := notification.Extra["chart_url"].(string); ok && chartURL != "" {
+		embed["url"] = chartURL
+	}
+
 	// Add fields if available
 	if notification.Symbol != "" {
 		fields := []map[string]interface{}{
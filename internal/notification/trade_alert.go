@@ -0,0 +1,156 @@
+package notification
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Chart link presets for TradeAlert.ChartURL templates
+const (
+	ChartTemplateTradingView = "https://www.tradingview.com/chart/?symbol=BINANCE:{symbol}.P&interval={tv_interval}"
+	ChartTemplateBinance     = "https://www.binance.com/en/futures/{symbol}"
+)
+
+// TradeAlert is a trade open/close notification carrying the setup the bot
+// acted on, so the alert can be checked against the chart straight away
+type TradeAlert struct {
+	Action     string // "open" or "close"
+	Symbol     string
+	Side       string
+	Mode       string
+	Interval   string // timeframe the entry was taken on, e.g. "15m"
+	Price      float64
+	Quantity   float64
+	EntryPrice float64 // close only, 0 when unknown
+	PnL        float64
+	PnLPercent float64
+	Reason     string
+	Rationale  string // signal summary / decision rationale
+	ChartURL   string
+	DryRun     bool
+	UserID     string
+}
+
+// ChartURL builds a chart link from template, which is a preset name
+// ("tradingview", "binance") or a URL using the placeholders {symbol},
+// {base}, {quote}, {interval} and {tv_interval}. An empty template
+// means no link.
+func ChartURL(template, symbol, interval string) string {
+	switch strings.ToLower(strings.TrimSpace(template)) {
+	case "":
+		return ""
+	case "tradingview":
+		template = ChartTemplateTradingView
+	case "binance":
+		template = ChartTemplateBinance
+	}
+
+	base, quote := symbol, ""
+	for _, q := range []string{"USDT", "USDC", "BUSD"} {
+		if strings.HasSuffix(symbol, q) && len(symbol) > len(q) {
+			base, quote = strings.TrimSuffix(symbol, q), q
+			break
+		}
+	}
+
+	return strings.NewReplacer(
+		"{symbol}", symbol,
+		"{base}", base,
+		"{quote}", quote,
+		"{interval}", interval,
+		"{tv_interval}", tradingViewInterval(interval),
+	).Replace(template)
+}
+
+// tradingViewInterval converts a Binance kline interval to TradingView's
+// format: minutes for intraday ("4h" -> "240"), otherwise D/W/M
+func tradingViewInterval(interval string) string {
+	if len(interval) < 2 {
+		return interval
+	}
+	var n int
+	if _, err := fmt.Sscanf(interval[:len(interval)-1], "%d", &n); err != nil {
+		return interval
+	}
+	switch interval[len(interval)-1] {
+	case 'm':
+		return fmt.Sprintf("%d", n)
+	case 'h':
+		return fmt.Sprintf("%d", n*60)
+	case 'd':
+		return fmt.Sprintf("%dD", n)
+	case 'w':
+		return fmt.Sprintf("%dW", n)
+	case 'M':
+		return fmt.Sprintf("%dM", n)
+	}
+	return interval
+}
+
+// SendTradeAlert sends a trade open/close notification with its rationale and chart link
+func (m *Manager) SendTradeAlert(alert TradeAlert) error {
+	n := &Notification{
+		Symbol:    alert.Symbol,
+		Price:     alert.Price,
+		Timestamp: time.Now(),
+		Extra: map[string]interface{}{
+			"side":      alert.Side,
+			"mode":      alert.Mode,
+			"interval":  alert.Interval,
+			"reason":    alert.Reason,
+			"rationale": alert.Rationale,
+			"chart_url": alert.ChartURL,
+			"dry_run":   alert.DryRun,
+		},
+	}
+
+	var b strings.Builder
+	if alert.UserID != "" {
+		fmt.Fprintf(&b, "User %s\n", alert.UserID)
+	}
+
+	if alert.Action == "open" {
+		n.Type = NotifyTradeOpen
+		n.Title = fmt.Sprintf("📈 Trade Opened: %s %s", alert.Side, alert.Symbol)
+		fmt.Fprintf(&b, "Price: %.4f\nQuantity: %.8f\n", alert.Price, alert.Quantity)
+	} else {
+		emoji := "✅"
+		if alert.PnL < 0 {
+			emoji = "❌"
+		}
+		n.Type = NotifyTradeClose
+		n.Title = fmt.Sprintf("%s Trade Closed: %s %s", emoji, alert.Side, alert.Symbol)
+		n.PnL = alert.PnL
+		n.PnLPercent = alert.PnLPercent
+		if alert.EntryPrice > 0 {
+			fmt.Fprintf(&b, "Entry: %.4f → Exit: %.4f\n", alert.EntryPrice, alert.Price)
+		} else {
+			fmt.Fprintf(&b, "Exit: %.4f\n", alert.Price)
+		}
+		fmt.Fprintf(&b, "P&L: %.4f (%.2f%%)\n", alert.PnL, alert.PnLPercent)
+	}
+	if alert.DryRun {
+		n.Title += " [dry run]"
+	}
+
+	if alert.Mode != "" {
+		fmt.Fprintf(&b, "Mode: %s", alert.Mode)
+		if alert.Interval != "" {
+			fmt.Fprintf(&b, " (%s)", alert.Interval)
+		}
+		b.WriteString("\n")
+	}
+	if alert.Reason != "" {
+		fmt.Fprintf(&b, "Reason: %s\n", alert.Reason)
+	}
+	if alert.Rationale != "" {
+		fmt.Fprintf(&b, "Rationale: %s\n", alert.Rationale)
+	}
+	if alert.ChartURL != "" {
+		fmt.Fprintf(&b, "Chart: %s\n", alert.ChartURL)
+	}
+	n.Message = strings.TrimRight(b.String(), "\n")
+
+	return m.Send(n)
+}
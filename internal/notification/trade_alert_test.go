@@ -0,0 +1,72 @@
+package notification
+
+import (
+	"strings"
+	"testing"
+)
+
+type captureNotifier struct {
+	sent []*Notification
+}
+
+func (c *captureNotifier) Send(n *Notification) error { c.sent = append(c.sent, n); return nil }
+func (c *captureNotifier) Name() string               { return "capture" }
+func (c *captureNotifier) IsEnabled() bool            { return true }
+
+func TestChartURL(t *testing.T) {
+	tests := []struct {
+		template, symbol, interval, want string
+	}{
+		{"tradingview", "BTCUSDT", "15m", "https://www.tradingview.com/chart/?symbol=BINANCE:BTCUSDT.P&interval=15"},
+		{"TradingView", "ETHUSDT", "4h", "https://www.tradingview.com/chart/?symbol=BINANCE:ETHUSDT.P&interval=240"},
+		{"tradingview", "SOLUSDT", "1d", "https://www.tradingview.com/chart/?symbol=BINANCE:SOLUSDT.P&interval=1D"},
+		{"binance", "BTCUSDT", "5m", "https://www.binance.com/en/futures/BTCUSDT"},
+		{"https://example.com/{base}-{quote}?tf={interval}", "DOGEUSDC", "1h", "https://example.com/DOGE-USDC?tf=1h"},
+		{"", "BTCUSDT", "5m", ""},
+	}
+	for _, tt := range tests {
+		if got := ChartURL(tt.template, tt.symbol, tt.interval); got != tt.want {
+			t.Errorf("ChartURL(%q, %q, %q) = %q, want %q", tt.template, tt.symbol, tt.interval, got, tt.want)
+		}
+	}
+}
+
+func TestSendTradeAlert(t *testing.T) {
+	capture := &captureNotifier{}
+	m := NewManager()
+	m.AddNotifier(capture)
+
+	err := m.SendTradeAlert(TradeAlert{
+		Action:     "close",
+		Symbol:     "BTCUSDT",
+		Side:       "LONG",
+		Mode:       "swing",
+		Interval:   "15m",
+		Price:      49000,
+		EntryPrice: 50000,
+		PnL:        -10,
+		PnLPercent: -2,
+		Reason:     "stop_loss",
+		Rationale:  "strong LONG signal",
+		ChartURL:   "https://example.com/chart",
+		DryRun:     true,
+	})
+	if err != nil {
+		t.Fatalf("SendTradeAlert: %v", err)
+	}
+	if len(capture.sent) != 1 {
+		t.Fatalf("sent %d notifications, want 1", len(capture.sent))
+	}
+	n := capture.sent[0]
+	if n.Type != NotifyTradeClose || !strings.HasPrefix(n.Title, "❌") || !strings.HasSuffix(n.Title, "[dry run]") {
+		t.Errorf("type=%s title=%q", n.Type, n.Title)
+	}
+	for _, want := range []string{"Entry: 50000.0000", "Mode: swing (15m)", "Rationale: strong LONG signal", "Chart: https://example.com/chart"} {
+		if !strings.Contains(n.Message, want) {
+			t.Errorf("message missing %q:\n%s", want, n.Message)
+		}
+	}
+	if n.Extra["chart_url"] != "https://example.com/chart" {
+		t.Errorf("chart_url extra = %v", n.Extra["chart_url"])
+	}
+}
@@ -38,6 +38,14 @@ func NewRiskManager(config *Config) *RiskManager {
 	}
 }
 
+// UpdateConfig replaces the risk limits without resetting daily PnL or
+// open position tracking
+func (rm *RiskManager) UpdateConfig(config *Config) {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+	rm.config = config
+}
+
 // UpdateAccountBalance updates the current account balance
 func (rm *RiskManager) UpdateAccountBalance(balance float64) {
 	rm.mu.Lock()
@@ -6,6 +6,7 @@ import (
 	"log"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"binance-trading-bot/internal/binance"
@@ -21,9 +22,32 @@ type Scanner struct {
 	strategies []strategy.Strategy
 	config     ScannerConfig
 	stopChan   chan struct{}
+	intervalCh chan time.Duration
 	wg         sync.WaitGroup
 	mu         sync.RWMutex
 	lastResult *ScanResult
+
+	lastMetrics *ScanMetrics
+	scanning    atomic.Bool
+}
+
+// Worker pool bounds
+const (
+	defaultWorkerCount = 10
+	maxWorkerCount     = 50
+)
+
+// scanJob is one symbol and the strategies to evaluate for it
+type scanJob struct {
+	symbol     string
+	strategies []strategy.Strategy
+}
+
+// scanStats counts work done by the workers of a single scan
+type scanStats struct {
+	symbolsScanned atomic.Int64
+	apiCalls       atomic.Int64
+	errors         atomic.Int64
 }
 
 // NewScanner creates a new scanner instance
@@ -40,6 +64,7 @@ func NewScanner(
 		strategies: strategies,
 		config:     config,
 		stopChan:   make(chan struct{}),
+		intervalCh: make(chan time.Duration, 1),
 	}
 }
 
@@ -76,6 +101,9 @@ func (sc *Scanner) runScanLoop() {
 		select {
 		case <-ticker.C:
 			sc.scan()
+		case newInterval := <-sc.intervalCh:
+			ticker.Reset(newInterval)
+			log.Printf("Strategy scanner interval changed to %v", newInterval)
 		case <-sc.stopChan:
 			log.Println("Strategy scanner stopped")
 			return
@@ -88,43 +116,86 @@ func (sc *Scanner) Scan() {
 	sc.scan()
 }
 
-// scan executes a single scan cycle
+// scan executes a single scan cycle. Symbols are fed through a small job channel
+// to a bounded worker pool sharing the scanner's client, so a large symbol list
+// applies backpressure instead of bursting every request at once. The whole scan
+// is cancelled if it overruns the scan interval or the scanner is stopped.
 func (sc *Scanner) scan() {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	// Manual refreshes and the ticker must not overlap
+	if !sc.scanning.CompareAndSwap(false, true) {
+		log.Println("[Scanner] Scan already in progress, skipping")
+		return
+	}
+	defer sc.scanning.Store(false)
+
+	sc.mu.RLock()
+	timeout := sc.config.ScanInterval
+	sc.mu.RUnlock()
+	if timeout <= 0 {
+		timeout = 5 * time.Minute
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
+	go func() {
+		select {
+		case <-sc.stopChan:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
 
 	startTime := time.Now()
 	scanID := fmt.Sprintf("scan-%d", startTime.Unix())
 
 	log.Printf("[Scanner] Starting scan %s", scanID)
 
+	stats := &scanStats{}
+
 	// Get symbols to scan
-	symbols := sc.getSymbolsToScan(ctx)
+	symbols := sc.getSymbolsToScan(ctx, stats)
+
+	// Only symbols with at least one strategy need API calls
+	strategiesBySymbol := make(map[string][]strategy.Strategy)
+	for _, strat := range sc.strategies {
+		strategiesBySymbol[strat.GetSymbol()] = append(strategiesBySymbol[strat.GetSymbol()], strat)
+	}
 
 	// Create result container
 	allResults := []ProximityResult{}
-	resultChan := make(chan ProximityResult, len(symbols)*len(sc.strategies))
+	resultChan := make(chan ProximityResult, len(sc.strategies))
 
-	// Worker pool for concurrent scanning
-	symbolChan := make(chan string, len(symbols))
+	workerCount := sc.config.WorkerCount
+	if workerCount <= 0 {
+		workerCount = defaultWorkerCount
+	}
+	if workerCount > maxWorkerCount {
+		workerCount = maxWorkerCount
+	}
+
+	// Bounded job channel: the feeder blocks until a worker is free
+	jobChan := make(chan scanJob, workerCount)
 	var wg sync.WaitGroup
 
 	// Start workers
-	for i := 0; i < sc.config.WorkerCount; i++ {
+	for i := 0; i < workerCount; i++ {
 		wg.Add(1)
-		go sc.worker(ctx, symbolChan, resultChan, &wg)
+		go sc.worker(ctx, jobChan, resultChan, stats, &wg)
 	}
 
 	// Feed symbols to workers
 	go func() {
+		defer close(jobChan)
 		for _, symbol := range symbols {
+			strats := strategiesBySymbol[symbol]
+			if len(strats) == 0 {
+				continue
+			}
 			select {
-			case symbolChan <- symbol:
+			case jobChan <- scanJob{symbol: symbol, strategies: strats}:
 			case <-ctx.Done():
-				break
+				return
 			}
 		}
-		close(symbolChan)
 	}()
 
 	// Wait for workers to finish
@@ -165,71 +236,99 @@ func (sc *Scanner) scan() {
 		Results:        allResults,
 	}
 
+	metrics := &ScanMetrics{
+		ScanID:         scanID,
+		StartTime:      startTime,
+		Duration:       scanResult.Duration,
+		SymbolsTotal:   len(symbols),
+		SymbolsScanned: int(stats.symbolsScanned.Load()),
+		Errors:         int(stats.errors.Load()),
+		APICalls:       int(stats.apiCalls.Load()),
+		WorkerCount:    workerCount,
+		Cancelled:      ctx.Err() != nil,
+	}
+
 	// Update last result
 	sc.mu.Lock()
 	sc.lastResult = scanResult
+	sc.lastMetrics = metrics
 	sc.mu.Unlock()
 
-	log.Printf("[Scanner] Scan completed in %v: %d opportunities found (top %d shown)",
-		scanResult.Duration, len(allResults), sc.config.MaxSymbols)
+	if metrics.Cancelled {
+		log.Printf("[Scanner] Scan %s cancelled after %v (timeout %v): %d/%d symbols scanned",
+			scanID, metrics.Duration, timeout, metrics.SymbolsScanned, len(strategiesBySymbol))
+	}
+	log.Printf("[Scanner] Scan completed in %v: %d opportunities found (top %d shown), %d API calls, %d errors",
+		scanResult.Duration, len(allResults), sc.config.MaxSymbols, metrics.APICalls, metrics.Errors)
 }
 
-// worker processes symbols from the channel
+// worker processes symbols from the job channel
 func (sc *Scanner) worker(
 	ctx context.Context,
-	symbolChan <-chan string,
+	jobChan <-chan scanJob,
 	resultChan chan<- ProximityResult,
+	stats *scanStats,
 	wg *sync.WaitGroup,
 ) {
 	defer wg.Done()
 
-	for symbol := range symbolChan {
+	for job := range jobChan {
 		select {
 		case <-ctx.Done():
 			return
 		default:
-			sc.scanSymbol(ctx, symbol, resultChan)
+			sc.scanSymbol(ctx, job, resultChan, stats)
 		}
 	}
 }
 
-// scanSymbol evaluates strategies that match the given symbol
+// scanSymbol evaluates the strategies registered for one symbol
 func (sc *Scanner) scanSymbol(
 	ctx context.Context,
-	symbol string,
+	job scanJob,
 	resultChan chan<- ProximityResult,
+	stats *scanStats,
 ) {
+	stats.symbolsScanned.Add(1)
+
 	// Get current price
-	currentPrice, err := sc.client.GetCurrentPrice(symbol)
+	stats.apiCalls.Add(1)
+	currentPrice, err := sc.client.GetCurrentPrice(job.symbol)
 	if err != nil {
+		stats.errors.Add(1)
 		return
 	}
 
-	// Evaluate only strategies that match this symbol
-	for _, strat := range sc.strategies {
-		// Skip strategies that don't match this symbol
-		if strat.GetSymbol() != symbol {
-			continue
+	for _, strat := range job.strategies {
+		if ctx.Err() != nil {
+			return
 		}
 
 		// Get klines for this strategy's interval
-		klines, err := sc.client.GetKlines(symbol, strat.GetInterval(), 100)
+		stats.apiCalls.Add(1)
+		klines, err := sc.client.GetKlines(job.symbol, strat.GetInterval(), 100)
 		if err != nil {
+			stats.errors.Add(1)
 			continue
 		}
 
 		// Evaluate proximity
 		result, err := sc.evaluator.EvaluateProximity(strat, klines, currentPrice)
 		if err != nil {
+			stats.errors.Add(1)
 			continue
 		}
 
-		resultChan <- *result
+		select {
+		case resultChan <- *result:
+		case <-ctx.Done():
+			return
+		}
 	}
 }
 
 // getSymbolsToScan returns symbols to evaluate (watchlist + all USDT pairs)
-func (sc *Scanner) getSymbolsToScan(ctx context.Context) []string {
+func (sc *Scanner) getSymbolsToScan(ctx context.Context, stats *scanStats) []string {
 	symbols := []string{}
 
 	// Add watchlist symbols first (prioritize these)
@@ -243,8 +342,11 @@ func (sc *Scanner) getSymbolsToScan(ctx context.Context) []string {
 	}
 
 	// Get all USDT pairs from Binance
+	stats.apiCalls.Add(1)
 	exchangeInfo, err := sc.client.GetExchangeInfo()
-	if err == nil {
+	if err != nil {
+		stats.errors.Add(1)
+	} else {
 		for _, s := range exchangeInfo.Symbols {
 			if s.Status == "TRADING" && s.QuoteAsset == "USDT" {
 				// Avoid duplicates from watchlist
@@ -265,6 +367,32 @@ func (sc *Scanner) GetLastResult() *ScanResult {
 	return sc.lastResult
 }
 
+// GetLastScanMetrics returns timing and API usage of the most recent scan
+func (sc *Scanner) GetLastScanMetrics() *ScanMetrics {
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+	return sc.lastMetrics
+}
+
+// SetScanInterval changes the scan interval of a running scanner.
+// Takes effect from the next tick; non-positive values are ignored.
+func (sc *Scanner) SetScanInterval(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	sc.mu.Lock()
+	sc.config.ScanInterval = interval
+	sc.mu.Unlock()
+
+	// Replace any pending change so the loop always picks up the latest value
+	select {
+	case <-sc.intervalCh:
+	default:
+	}
+	sc.intervalCh <- interval
+}
+
 // Stop gracefully shuts down the scanner
 func (sc *Scanner) Stop() {
 	close(sc.stopChan)
@@ -54,6 +54,19 @@ type ScanResult struct {
 	Results        []ProximityResult  `json:"results"`
 }
 
+// ScanMetrics describes the cost of a single scan cycle
+type ScanMetrics struct {
+	ScanID         string        `json:"scan_id"`
+	StartTime      time.Time     `json:"start_time"`
+	Duration       time.Duration `json:"duration"`
+	SymbolsTotal   int           `json:"symbols_total"`   // Symbols considered
+	SymbolsScanned int           `json:"symbols_scanned"` // Symbols with strategies actually evaluated
+	Errors         int           `json:"errors"`
+	APICalls       int           `json:"api_calls"`
+	WorkerCount    int           `json:"worker_count"`
+	Cancelled      bool          `json:"cancelled"` // Overran the scan interval or scanner stopped
+}
+
 // ScannerConfig holds scanner configuration
 type ScannerConfig struct {
 	Enabled          bool
@@ -61,7 +74,7 @@ type ScannerConfig struct {
 	MaxSymbols       int
 	IncludeWatchlist bool
 	CacheTTL         time.Duration
-	WorkerCount      int
+	WorkerCount      int // Bounded worker pool size (default 10, max 50)
 }
 
 // CachedProximity stores proximity results with TTL
@@ -0,0 +1,95 @@
+package screener
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// cacheMaxPriceMove is how far (as a fraction) a symbol's last price may drift
+// from the evaluated price before its cached kline analysis counts as stale
+const cacheMaxPriceMove = 0.001
+
+// cachedEvaluation is a symbol's kline analysis and the price it was made at
+type cachedEvaluation struct {
+	high, low   float64
+	signals     []string // Kline signals only; ticker signals are recomputed every cycle
+	price       float64
+	evaluatedAt time.Time
+}
+
+// ResultCache keeps per-symbol kline evaluations for a TTL. The screener fetches
+// all 24h tickers every cycle anyway, so the ticker price tells it cheaply
+// whether a symbol moved enough to need its klines re-scored.
+type ResultCache struct {
+	mu      sync.RWMutex
+	entries map[string]*cachedEvaluation
+	ttl     time.Duration
+}
+
+// NewResultCache creates a cache with the given TTL (0 disables caching)
+func NewResultCache(ttl time.Duration) *ResultCache {
+	return &ResultCache{
+		entries: make(map[string]*cachedEvaluation),
+		ttl:     ttl,
+	}
+}
+
+// Get fills result from the cached evaluation of result.Symbol if it is within
+// the TTL and the price hasn't moved since. Returns false on a miss.
+func (rc *ResultCache) Get(result *ScreenResult, now time.Time) bool {
+	if rc.ttl <= 0 {
+		return false
+	}
+
+	rc.mu.RLock()
+	entry, exists := rc.entries[result.Symbol]
+	rc.mu.RUnlock()
+	if !exists || now.Sub(entry.evaluatedAt) >= rc.ttl || entry.price <= 0 {
+		return false
+	}
+	if math.Abs(result.LastPrice-entry.price)/entry.price > cacheMaxPriceMove {
+		return false
+	}
+
+	result.HighLow24h.High = entry.high
+	result.HighLow24h.Low = entry.low
+	result.Signals = append(make([]string, 0, len(entry.signals)), entry.signals...)
+	return true
+}
+
+// Set stores the kline analysis in result (before ticker signals are added)
+func (rc *ResultCache) Set(result *ScreenResult, now time.Time) {
+	if rc.ttl <= 0 {
+		return
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.entries[result.Symbol] = &cachedEvaluation{
+		high:        result.HighLow24h.High,
+		low:         result.HighLow24h.Low,
+		signals:     append([]string(nil), result.Signals...),
+		price:       result.LastPrice,
+		evaluatedAt: now,
+	}
+}
+
+// CleanupExpired removes entries past the TTL
+func (rc *ResultCache) CleanupExpired(now time.Time) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	for symbol, entry := range rc.entries {
+		if now.Sub(entry.evaluatedAt) >= rc.ttl {
+			delete(rc.entries, symbol)
+		}
+	}
+}
+
+// Size returns the number of cached symbols
+func (rc *ResultCache) Size() int {
+	rc.mu.RLock()
+	defer rc.mu.RUnlock()
+	return len(rc.entries)
+}
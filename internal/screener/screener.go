@@ -7,8 +7,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -21,6 +23,37 @@ type Screener struct {
 	wg       sync.WaitGroup
 	mu       sync.RWMutex
 	results  []ScreenResult
+
+	stats ScreenerStats
+	cache *ResultCache
+}
+
+// Per-cycle load bounds used when the config leaves them at 0
+const (
+	defaultWorkerCount         = 5
+	maxWorkerCount             = 20
+	defaultMaxAPICallsPerCycle = 100
+	defaultMaxSymbolsPerCycle  = 100
+)
+
+// ScreenerStats describes the load and yield of the last screening cycle
+type ScreenerStats struct {
+	Cycles                int64         `json:"cycles"`
+	LastCycleStart        time.Time     `json:"last_cycle_start"`
+	LastCycleDuration     time.Duration `json:"last_cycle_duration"`
+	SymbolsTotal          int           `json:"symbols_total"`           // Tickers fetched
+	SymbolsPassingFilters int           `json:"symbols_passing_filters"` // Quote, exclusion, volume and change filters
+	SymbolsEvaluated      int           `json:"symbols_evaluated"`       // Analyzed with klines
+	SymbolsSkipped        int           `json:"symbols_skipped"`         // Passed filters but over the symbol cap or API budget
+	CacheHits             int           `json:"cache_hits"`              // Reused a cached analysis, no klines call
+	CacheMisses           int           `json:"cache_misses"`            // Expired, uncached or price moved
+	CacheHitRate          float64       `json:"cache_hit_rate"`          // Hits / (hits + misses)
+	CacheSize             int           `json:"cache_size"`
+	APICalls              int           `json:"api_calls"`
+	APICallBudget         int           `json:"api_call_budget"`
+	Errors                int           `json:"errors"`
+	WorkerCount           int           `json:"worker_count"`
+	Cancelled             bool          `json:"cancelled"` // Screener stopped mid-cycle
 }
 
 // ScreenResult represents a screening result for a symbol
@@ -45,6 +78,7 @@ func NewScreener(client binance.BinanceClient, config config.ScreenerConfig, rep
 		repo:     repo,
 		stopChan: make(chan struct{}),
 		results:  make([]ScreenResult, 0),
+		cache:    NewResultCache(time.Duration(config.CacheTTL) * time.Second),
 	}
 }
 
@@ -77,18 +111,43 @@ func (s *Screener) StartScreening() {
 	}
 }
 
-// scan performs a full market scan
+// scan performs a full market scan. Symbols passing the ticker filters are
+// analyzed most liquid first by a bounded worker pool, capped per cycle by
+// MaxSymbolsPerCycle and the API call budget so the screener can't burst
+// requests alongside the scanner. Symbols whose price hasn't moved since a
+// cached analysis within CacheTTL reuse it without a klines call.
 func (s *Screener) scan() {
 	log.Println("Starting market scan...")
 	startTime := time.Now()
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-s.stopChan:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	workerCount, budget, maxSymbols := s.cycleLimits()
+	stats := ScreenerStats{
+		LastCycleStart: startTime,
+		APICallBudget:  budget,
+		WorkerCount:    workerCount,
+	}
+
+	stats.APICalls++
 	tickers, err := s.client.Get24hrTickers()
 	if err != nil {
 		log.Printf("Error fetching tickers: %v", err)
+		stats.Errors++
+		s.recordStats(stats, startTime)
 		return
 	}
+	stats.SymbolsTotal = len(tickers)
 
-	results := make([]ScreenResult, 0)
+	candidates := make([]binance.Ticker24hr, 0)
 	filtered := 0
 
 	for _, ticker := range tickers {
@@ -114,34 +173,76 @@ func (s *Screener) scan() {
 			continue
 		}
 
-		result := ScreenResult{
-			Symbol:             ticker.Symbol,
-			LastPrice:          ticker.LastPrice,
-			PriceChangePercent: ticker.PriceChangePercent,
-			Volume:             ticker.Volume,
-			QuoteVolume:        ticker.QuoteVolume,
-			Timestamp:          time.Now(),
-			Signals:            make([]string, 0),
+		candidates = append(candidates, ticker)
+	}
+	stats.SymbolsPassingFilters = len(candidates)
+
+	// Most liquid first, so the caps drop the thinnest markets
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].QuoteVolume > candidates[j].QuoteVolume
+	})
+
+	if s.config.MaxSymbols > 0 && len(candidates) > s.config.MaxSymbols {
+		stats.SymbolsSkipped = len(candidates) - s.config.MaxSymbols
+		candidates = candidates[:s.config.MaxSymbols]
+	}
+
+	// Reuse cached analyses; only misses need klines
+	now := time.Now()
+	s.cache.CleanupExpired(now)
+	screened := make([]ScreenResult, len(candidates))
+	ready := make([]bool, len(candidates))
+	misses := make([]int, 0, len(candidates))
+	for i, ticker := range candidates {
+		screened[i] = newScreenResult(ticker, now)
+		if s.cache.Get(&screened[i], now) {
+			ready[i] = true
+			stats.CacheHits++
+		} else {
+			misses = append(misses, i)
 		}
+	}
+	stats.CacheMisses = len(misses)
 
-		// Analyze the symbol
-		s.analyzeSymbol(&result)
+	// Each analysis costs one klines call
+	limit := maxSymbols
+	if remaining := budget - stats.APICalls; remaining < limit {
+		limit = remaining
+	}
+	if limit < 0 {
+		limit = 0
+	}
+	if len(misses) > limit {
+		stats.SymbolsSkipped += len(misses) - limit
+		misses = misses[:limit]
+	}
 
-		results = append(results, result)
+	for i, ok := range s.analyzeCandidates(ctx, screened, misses, workerCount, &stats) {
+		ready[misses[i]] = ok
+	}
 
-		// Limit the number of symbols
-		if len(results) >= s.config.MaxSymbols {
-			break
+	results := make([]ScreenResult, 0, len(screened))
+	for i, ok := range ready {
+		if ok {
+			addTickerSignals(&screened[i])
+			results = append(results, screened[i])
 		}
 	}
+	if lookups := stats.CacheHits + stats.CacheMisses; lookups > 0 {
+		stats.CacheHitRate = float64(stats.CacheHits) / float64(lookups)
+	}
+	stats.CacheSize = s.cache.Size()
 
 	// Update results
 	s.mu.Lock()
 	s.results = results
 	s.mu.Unlock()
 
-	duration := time.Since(startTime)
-	log.Printf("Market scan completed in %v. Found %d opportunities (filtered %d)", duration, len(results), filtered)
+	stats.Cancelled = ctx.Err() != nil
+	s.recordStats(stats, startTime)
+
+	log.Printf("Market scan completed in %v. Found %d opportunities (filtered %d, skipped %d over cap/budget, %d cached, %d API calls, %d workers)",
+		time.Since(startTime), len(results), filtered, stats.SymbolsSkipped, stats.CacheHits, stats.APICalls, workerCount)
 
 	// Save results to database
 	if s.repo != nil {
@@ -152,16 +253,119 @@ func (s *Screener) scan() {
 	s.printTopOpportunities(5)
 }
 
-// analyzeSymbol performs technical analysis on a symbol
-func (s *Screener) analyzeSymbol(result *ScreenResult) {
+// cycleLimits returns the worker count, API call budget and symbol cap for a cycle
+func (s *Screener) cycleLimits() (workers, budget, maxSymbols int) {
+	workers = s.config.WorkerCount
+	if workers <= 0 {
+		workers = defaultWorkerCount
+	}
+	if workers > maxWorkerCount {
+		workers = maxWorkerCount
+	}
+	budget = s.config.MaxAPICallsPerCycle
+	if budget <= 0 {
+		budget = defaultMaxAPICallsPerCycle
+	}
+	maxSymbols = s.config.MaxSymbolsPerCycle
+	if maxSymbols <= 0 {
+		maxSymbols = defaultMaxSymbolsPerCycle
+	}
+	return workers, budget, maxSymbols
+}
+
+// analyzeCandidates runs analyzeSymbol on screened[i] for each index in indexes
+// with at most workerCount in flight, caching successful analyses. The returned
+// flags are aligned with indexes and report which symbols were analyzed before
+// the screener was stopped.
+func (s *Screener) analyzeCandidates(ctx context.Context, screened []ScreenResult, indexes []int, workerCount int, stats *ScreenerStats) []bool {
+	evaluated := make([]bool, len(indexes))
+	var apiCalls, errors atomic.Int64
+
+	jobs := make(chan int, workerCount)
+	var wg sync.WaitGroup
+	for w := 0; w < workerCount; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				if ctx.Err() != nil {
+					return
+				}
+				result := &screened[indexes[j]]
+				apiCalls.Add(1)
+				if s.analyzeSymbol(result) {
+					s.cache.Set(result, result.Timestamp)
+				} else {
+					errors.Add(1)
+				}
+				evaluated[j] = true
+			}
+		}()
+	}
+
+	for j := range indexes {
+		select {
+		case jobs <- j:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	for _, ok := range evaluated {
+		if ok {
+			stats.SymbolsEvaluated++
+		}
+	}
+	stats.APICalls += int(apiCalls.Load())
+	stats.Errors += int(errors.Load())
+	return evaluated
+}
+
+// newScreenResult creates a result from a symbol's 24h ticker
+func newScreenResult(ticker binance.Ticker24hr, now time.Time) ScreenResult {
+	return ScreenResult{
+		Symbol:             ticker.Symbol,
+		LastPrice:          ticker.LastPrice,
+		PriceChangePercent: ticker.PriceChangePercent,
+		Volume:             ticker.Volume,
+		QuoteVolume:        ticker.QuoteVolume,
+		Timestamp:          now,
+		Signals:            make([]string, 0),
+	}
+}
+
+// recordStats publishes a finished cycle's stats
+func (s *Screener) recordStats(stats ScreenerStats, startTime time.Time) {
+	stats.LastCycleDuration = time.Since(startTime)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats.Cycles = s.stats.Cycles + 1
+	s.stats = stats
+}
+
+// GetStats returns the load and yield of the last screening cycle
+func (s *Screener) GetStats() ScreenerStats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stats
+}
+
+// analyzeSymbol performs kline analysis on a symbol. Returns false if the
+// klines couldn't be fetched.
+func (s *Screener) analyzeSymbol(result *ScreenResult) bool {
 	// Fetch recent klines for analysis
 	klines, err := s.client.GetKlines(result.Symbol, s.config.Interval, 10)
 	if err != nil {
-		return
+		return false
 	}
 
 	if len(klines) < 2 {
-		return
+		return true
 	}
 
 	lastCandle := klines[len(klines)-2]
@@ -187,7 +391,12 @@ func (s *Screener) analyzeSymbol(result *ScreenResult) {
 	if lastCandle.Volume > avgVolume*1.5 {
 		result.Signals = append(result.Signals, "HIGH_VOLUME")
 	}
+	return true
+}
 
+// addTickerSignals adds signals derived from the current 24h ticker. These are
+// applied every cycle so cached kline analyses still reflect fresh ticker data.
+func addTickerSignals(result *ScreenResult) {
 	// Check for strong momentum
 	if result.PriceChangePercent > 5 {
 		result.Signals = append(result.Signals, fmt.Sprintf("STRONG_MOMENTUM: +%.2f%%", result.PriceChangePercent))
@@ -225,7 +434,7 @@ func (s *Screener) printTopOpportunities(count int) {
 		log.Printf("%d. %s - Price: %.4f | Change: +%.2f%% | Volume: $%.0f | Signals: %v",
 			i+1, r.Symbol, r.LastPrice, r.PriceChangePercent, r.QuoteVolume, r.Signals)
 	}
-	log.Println("========================\n")
+	log.Print("========================\n\n")
 }
 
 // isExcluded checks if a symbol is in the exclusion list
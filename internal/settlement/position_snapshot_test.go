@@ -488,8 +488,13 @@ func (m *mockFuturesClient) CancelAllFuturesOrders(string) error
 func (m *mockFuturesClient) GetOrder(string, int64) (*binance.FuturesOrder, error)       { return nil, nil }
 func (m *mockFuturesClient) PlaceAlgoOrder(binance.AlgoOrderParams) (*binance.AlgoOrderResponse, error) { return nil, nil }
 func (m *mockFuturesClient) GetOpenAlgoOrders(string) ([]binance.AlgoOrder, error)       { return nil, nil }
+func (m *mockFuturesClient) GetAlgoOrderCount(string) (int, error)                       { return 0, nil }
 func (m *mockFuturesClient) CancelAlgoOrder(string, int64) error                         { return nil }
 func (m *mockFuturesClient) CancelAllAlgoOrders(string) error                            { return nil }
+func (m *mockFuturesClient) SetCountdownCancelAll(string, int64) error                   { return nil }
+func (m *mockFuturesClient) PlaceProtectedMarketOrder(binance.FuturesOrderParams, float64, []float64) (*binance.ProtectedOrderResult, error) {
+	return nil, nil
+}
 func (m *mockFuturesClient) GetAllAlgoOrders(string, int) ([]binance.AlgoOrder, error)   { return nil, nil }
 func (m *mockFuturesClient) GetFundingRate(string) (*binance.FundingRate, error)         { return nil, nil }
 func (m *mockFuturesClient) GetFundingRateHistory(string, int) ([]binance.FundingRate, error) { return nil, nil }
@@ -502,11 +507,13 @@ func (m *mockFuturesClient) GetAll24hrTickers() ([]binance.Futures24hrTicker, er
 func (m *mockFuturesClient) GetFuturesCurrentPrice(string) (float64, error)              { return 0, nil }
 func (m *mockFuturesClient) GetFuturesExchangeInfo() (*binance.FuturesExchangeInfo, error) { return nil, nil }
 func (m *mockFuturesClient) GetFuturesSymbols() ([]string, error)                        { return nil, nil }
+func (m *mockFuturesClient) Ping() error                                                   { return nil }
 func (m *mockFuturesClient) GetTradeHistory(string, int) ([]binance.FuturesTrade, error) { return nil, nil }
 func (m *mockFuturesClient) GetTradeHistoryByDateRange(string, int64, int64, int) ([]binance.FuturesTrade, error) { return nil, nil }
 func (m *mockFuturesClient) GetFundingFeeHistory(string, int) ([]binance.FundingFeeRecord, error) { return nil, nil }
 func (m *mockFuturesClient) GetAllOrdersByDateRange(string, int64, int64, int) ([]binance.FuturesOrder, error) { return nil, nil }
 func (m *mockFuturesClient) GetIncomeHistory(string, int64, int64, int) ([]binance.IncomeRecord, error) { return nil, nil }
+func (m *mockFuturesClient) GetAllIncomeHistory(string, string, int64, int64) ([]binance.IncomeRecord, error) { return nil, nil }
 func (m *mockFuturesClient) GetListenKey() (string, error)                               { return "", nil }
 func (m *mockFuturesClient) KeepAliveListenKey(string) error                             { return nil }
 func (m *mockFuturesClient) CloseListenKey(string) error                                 { return nil }
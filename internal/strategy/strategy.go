@@ -3,6 +3,7 @@ package strategy
 import (
 	"binance-trading-bot/internal/binance"
 	"fmt"
+	"sync"
 	"time"
 )
 
@@ -33,6 +34,7 @@ type Signal struct {
 	Side       string // BUY, SELL
 	Reason     string
 	Timestamp  time.Time
+	Status     string // SignalStatusProvisional or SignalStatusConfirmed (empty = confirmed)
 }
 
 type SignalType string
@@ -43,6 +45,22 @@ const (
 	SignalNone SignalType = "NONE"
 )
 
+// Signal confirmation states. A provisional signal has Type SignalNone so it is
+// never traded; it becomes a confirmed, tradable signal once its confirmation
+// candle closes in the signal direction.
+const (
+	SignalStatusProvisional = "provisional"
+	SignalStatusConfirmed   = "confirmed"
+)
+
+// ConfirmationStatus returns the signal's confirmation state, defaulting to confirmed
+func (s *Signal) ConfirmationStatus() string {
+	if s.Status == "" {
+		return SignalStatusConfirmed
+	}
+	return s.Status
+}
+
 // BreakoutConfig configures the breakout strategy
 type BreakoutConfig struct {
 	Symbol       string
@@ -63,12 +81,25 @@ type BreakoutConfig struct {
 	EMAPeriod             int     // EMA period for trend (default 20)
 	RSIPeriod             int     // RSI period (default 14)
 	VolumePeriod          int     // Volume average period (default 20)
+	// Hold the breakout as a provisional signal until the next candle also closes
+	// above the broken high (one candle later, fewer fakeouts)
+	RequireConfirmationCandle bool
+}
+
+// pendingBreakout is a provisional breakout waiting for its confirmation candle
+type pendingBreakout struct {
+	signal         *Signal
+	level          float64 // The high that was broken
+	candleOpenTime int64   // Open time of the breakout candle
 }
 
 // BreakoutStrategy implements a strategy that triggers when price breaks above previous candle's high
 // Enhanced with trend, volume, and RSI filters for improved win rate
 type BreakoutStrategy struct {
 	config *BreakoutConfig
+
+	mu      sync.Mutex
+	pending *pendingBreakout
 }
 
 func NewBreakoutStrategy(config *BreakoutConfig) *BreakoutStrategy {
@@ -118,17 +149,94 @@ func (s *BreakoutStrategy) Evaluate(klines []binance.Kline, currentPrice float64
 		return &Signal{Type: SignalNone}, nil
 	}
 
+	if !s.config.RequireConfirmationCandle {
+		return s.detectBreakout(klines, currentPrice), nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.pending != nil {
+		return s.checkConfirmation(klines, currentPrice), nil
+	}
+
+	signal := s.detectBreakout(klines, currentPrice)
+	if signal.Type == SignalNone {
+		return signal, nil
+	}
+
+	// Hold the breakout until the next candle closes; only this first
+	// provisional signal is returned, later evaluations return SignalNone
+	s.pending = &pendingBreakout{
+		signal:         signal,
+		level:          klines[len(klines)-2].High,
+		candleOpenTime: klines[len(klines)-1].OpenTime,
+	}
+	provisional := *signal
+	provisional.Type = SignalNone
+	provisional.Status = SignalStatusProvisional
+	provisional.Reason = signal.Reason + " | Awaiting confirmation candle"
+	return &provisional, nil
+}
+
+// checkConfirmation resolves the pending breakout once its breakout candle and
+// the candle after it have closed. Both must close above the broken high; a
+// close back at or below it drops the breakout as a fakeout. Caller must hold s.mu.
+func (s *BreakoutStrategy) checkConfirmation(klines []binance.Kline, currentPrice float64) *Signal {
+	p := s.pending
+	completed := klines[:len(klines)-1]
+
+	idx := -1
+	for i := len(completed) - 1; i >= 0; i-- {
+		if completed[i].OpenTime == p.candleOpenTime {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		if klines[len(klines)-1].OpenTime != p.candleOpenTime {
+			s.pending = nil // Breakout candle is no longer in the window
+		}
+		return &Signal{Type: SignalNone} // Breakout candle still forming
+	}
+	if completed[idx].Close <= p.level {
+		s.pending = nil
+		return &Signal{Type: SignalNone}
+	}
+	if idx == len(completed)-1 {
+		return &Signal{Type: SignalNone} // Confirmation candle still forming
+	}
+
+	s.pending = nil
+	confirmCandle := completed[idx+1]
+	if confirmCandle.Close <= p.level {
+		return &Signal{Type: SignalNone}
+	}
+
+	// Confirmed: enter at the current price, one candle after the breakout
+	signal := *p.signal
+	signal.EntryPrice = currentPrice
+	signal.StopLoss = currentPrice * (1 - s.config.StopLoss)
+	signal.TakeProfit = currentPrice * (1 + s.config.TakeProfit)
+	signal.Reason = fmt.Sprintf("%s | Confirmed: next candle closed %.2f > %.2f", p.signal.Reason, confirmCandle.Close, p.level)
+	signal.Status = SignalStatusConfirmed
+	signal.Timestamp = time.Now()
+	return &signal
+}
+
+// detectBreakout checks the breakout and its filters on the current candle
+func (s *BreakoutStrategy) detectBreakout(klines []binance.Kline, currentPrice float64) *Signal {
 	// Get the last completed candle
 	lastCandle := klines[len(klines)-2]
 
 	// Check if minimum volume requirement is met
 	if s.config.MinVolume > 0 && lastCandle.Volume < s.config.MinVolume {
-		return &Signal{Type: SignalNone}, nil
+		return &Signal{Type: SignalNone}
 	}
 
 	// Check if current price breaks above the last candle's high
 	if currentPrice <= lastCandle.High {
-		return &Signal{Type: SignalNone}, nil
+		return &Signal{Type: SignalNone}
 	}
 
 	// ==================== ENHANCED FILTERS ====================
@@ -138,7 +246,7 @@ func (s *BreakoutStrategy) Evaluate(klines []binance.Kline, currentPrice float64
 		ema := CalculateEMA(klines, s.config.EMAPeriod)
 		if currentPrice < ema {
 			// Price below EMA = downtrend, skip long breakout
-			return &Signal{Type: SignalNone}, nil
+			return &Signal{Type: SignalNone}
 		}
 	}
 
@@ -146,7 +254,7 @@ func (s *BreakoutStrategy) Evaluate(klines []binance.Kline, currentPrice float64
 	if s.config.RequireVolumeSpike {
 		if !IsVolumeSpike(klines, s.config.VolumePeriod, s.config.VolumeMultiplier) {
 			// No volume confirmation, weak breakout
-			return &Signal{Type: SignalNone}, nil
+			return &Signal{Type: SignalNone}
 		}
 	}
 
@@ -155,7 +263,7 @@ func (s *BreakoutStrategy) Evaluate(klines []binance.Kline, currentPrice float64
 		rsi := CalculateRSI(klines, s.config.RSIPeriod)
 		if rsi > s.config.RSIOverbought {
 			// RSI overbought, skip entry
-			return &Signal{Type: SignalNone}, nil
+			return &Signal{Type: SignalNone}
 		}
 	}
 
@@ -181,7 +289,7 @@ func (s *BreakoutStrategy) Evaluate(klines []binance.Kline, currentPrice float64
 		Side:       s.config.OrderSide,
 		Reason:     reason,
 		Timestamp:  time.Now(),
-	}, nil
+	}
 }
 
 // SupportConfig configures the support strategy
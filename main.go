@@ -7,6 +7,8 @@ import (
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -16,6 +18,7 @@ import (
 	"binance-trading-bot/internal/ai/sentiment"
 	"binance-trading-bot/internal/api"
 	"binance-trading-bot/internal/apikeys"
+	"binance-trading-bot/internal/audit"
 	"binance-trading-bot/internal/auth"
 	"binance-trading-bot/internal/autopilot"
 	"binance-trading-bot/internal/billing"
@@ -27,6 +30,7 @@ import (
 	"binance-trading-bot/internal/database"
 	"binance-trading-bot/internal/email"
 	"binance-trading-bot/internal/events"
+	"binance-trading-bot/internal/grpcapi"
 	"binance-trading-bot/internal/license"
 	"binance-trading-bot/internal/logging"
 	"binance-trading-bot/internal/notification"
@@ -46,6 +50,37 @@ func main() {
 		log.Fatalf("Failed to load configuration: %v", err)
 	}
 
+	// Validate cross-field invariants before anything initializes so that
+	// misconfigurations surface immediately instead of at first trade
+	cfg.ModeTPAllocations = modeTPAllocations()
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("%v", err)
+	}
+
+	// Operator kill switch must be armed before any component picks a client
+	autopilot.ConfigureForceDryRun(cfg.TradingConfig.ForceDryRun, cfg.TradingConfig.ForceDryRunFile)
+	if autopilot.IsDryRunForced() {
+		cfg.TradingConfig.DryRun = true
+		log.Printf("WARNING: FORCE_DRY_RUN kill switch is active - live trading is disabled for this process")
+	}
+
+	// Opt-in dead-man's switch: Ginie keeps Binance countdownCancelAll armed while it runs
+	autopilot.ConfigureDeadManSwitch(time.Duration(cfg.FuturesConfig.DeadManSwitchSeconds) * time.Second)
+	if cfg.FuturesConfig.DeadManSwitchSeconds > 0 {
+		log.Printf("Dead-man's switch enabled: open orders are cancelled by Binance if not refreshed within %ds",
+			cfg.FuturesConfig.DeadManSwitchSeconds)
+	}
+
+	// Guard against leaked SL/TP orders piling up on a symbol
+	binance.SetMaxAlgoOrdersPerSymbol(cfg.FuturesConfig.MaxAlgoOrdersPerSymbol)
+
+	// One retry policy for every Binance REST call
+	binance.SetRetryPolicy(binance.RetryPolicy{
+		MaxRetries: cfg.BinanceConfig.RetryMaxRetries,
+		BaseDelay:  time.Duration(cfg.BinanceConfig.RetryBaseDelayMs) * time.Millisecond,
+		MaxDelay:   time.Duration(cfg.BinanceConfig.RetryMaxDelayMs) * time.Millisecond,
+	})
+
 	// Initialize structured logging
 	logger := logging.New(&logging.Config{
 		Level:       cfg.LoggingConfig.Level,
@@ -114,6 +149,19 @@ func main() {
 		Password: getEnv("DB_PASSWORD", "trading_bot_password"),
 		Database: getEnv("DB_NAME", "trading_bot"),
 		SSLMode:  getEnv("DB_SSLMODE", "disable"),
+
+		MaxConns:        int32(getEnvInt("DB_MAX_CONNS", int(database.DefaultMaxConns))),
+		MinConns:        int32(getEnvInt("DB_MIN_CONNS", int(database.DefaultMinConns))),
+		MaxConnLifetime: time.Duration(getEnvInt("DB_MAX_CONN_LIFETIME_MINUTES", 60)) * time.Minute,
+		MaxConnIdleTime: time.Duration(getEnvInt("DB_MAX_CONN_IDLE_MINUTES", 15)) * time.Minute,
+		// -1 disables the server-side statement timeout
+		StatementTimeout: time.Duration(getEnvInt("DB_STATEMENT_TIMEOUT_SECONDS", 60)) * time.Second,
+	}
+	// Optional read replicas for analytics/report queries (comma-separated postgres:// URLs)
+	for _, url := range strings.Split(getEnv("DB_REPLICA_URLS", ""), ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			dbConfig.ReplicaURLs = append(dbConfig.ReplicaURLs, url)
+		}
 	}
 
 	db, err := database.NewDB(dbConfig)
@@ -122,108 +170,33 @@ func main() {
 	}
 	defer db.Close()
 
-	// Run database migrations
+	// Apply schema migrations (tracked in schema_migrations); any failure is fatal
 	ctx := context.Background()
-	if err := db.RunMigrations(ctx); err != nil {
-		log.Fatalf("Failed to run migrations: %v", err)
-	}
-
-	// Run AI migrations
-	if err := db.RunAIMigrations(ctx); err != nil {
-		log.Printf("Warning: AI migrations failed (table may already exist): %v", err)
+	migrationGroups := []string{}
+	if cfg.FuturesConfig.Enabled {
+		migrationGroups = append(migrationGroups, database.MigrationGroupFutures)
 	}
-
-	// Run Trade-AI link migrations (adds ai_decision_id to trades table)
-	if err := db.RunTradeAILinkMigration(ctx); err != nil {
-		log.Printf("Warning: Trade-AI link migrations failed: %v", err)
+	if cfg.AuthConfig.Enabled {
+		migrationGroups = append(migrationGroups, database.MigrationGroupMultiTenant)
 	}
-
-	// Run Futures migrations if enabled
-	if cfg.FuturesConfig.Enabled {
-		if err := db.RunFuturesMigrations(ctx); err != nil {
-			log.Printf("Warning: Futures migrations failed (table may already exist): %v", err)
-		}
-		logger.Info("Futures migrations completed")
-
-		// Run Trade Lifecycle migrations for detailed trade event tracking
-		if err := db.RunTradeLifecycleMigrations(ctx); err != nil {
-			log.Printf("Warning: Trade lifecycle migrations failed: %v", err)
-		}
-		logger.Info("Trade lifecycle migrations completed")
-
-		// Run Symbol Requirements migrations for order validation
-		if err := db.RunSymbolRequirementsMigration(ctx); err != nil {
-			log.Printf("Warning: Symbol requirements migrations failed: %v", err)
-		}
-		logger.Info("Symbol requirements migrations completed")
+	applied, err := db.MigrateUp(ctx, migrationGroups...)
+	if err != nil {
+		log.Fatalf("Failed to run migrations: %v", err)
 	}
+	logger.Info("Database migrations completed", "steps", applied, "groups", migrationGroups)
 
-	// Run Multi-Tenant migrations if auth is enabled
 	if cfg.AuthConfig.Enabled {
-		if err := db.RunMultiTenantMigrations(ctx); err != nil {
-			log.Printf("Warning: Multi-tenant migrations failed: %v", err)
-		}
-		logger.Info("Multi-tenant migrations completed")
-
 		// Seed admin user with proper password
 		if err := auth.SeedAdminUser(ctx, db); err != nil {
 			log.Printf("Warning: Failed to seed admin user: %v", err)
 		} else {
 			logger.Info("Admin user seeded successfully")
 		}
-
-		// Run Scan Source migrations for per-user coin source configuration
-		if err := db.RunScanSourceMigrations(ctx); err != nil {
-			log.Printf("Warning: Scan source migrations failed: %v", err)
-		}
-		logger.Info("Scan source migrations completed")
-
-		// Run Global Circuit Breaker migrations (Story 5.3)
-		if err := db.RunGlobalCircuitBreakerMigration(ctx); err != nil {
-			log.Printf("Warning: Global Circuit Breaker migrations failed: %v", err)
-		}
-		logger.Info("Global Circuit Breaker migrations completed")
-
-		// Run User Mode Config migrations (Epic 4 - per-user trading mode configurations)
-		if err := db.MigrateUserModeConfigs(ctx); err != nil {
-			log.Printf("Warning: User Mode Config migrations failed: %v", err)
-		}
-		logger.Info("User Mode Config migrations completed")
-
-		// Run scalp_reentry mode removal migration (Story 9.4 Phase 2)
-		// scalp_reentry is an optimization, not a trading mode
-		if err := db.MigrateRemoveScalpReentryMode(ctx); err != nil {
-			log.Printf("Warning: Scalp_reentry mode removal migration failed: %v", err)
-		}
-		logger.Info("Scalp_reentry mode removal migration completed")
-
-		// Run User Settings migrations (013-019: LLM config, capital allocation, circuit breaker, etc.)
-		if err := db.RunUserSettingsMigrations(ctx); err != nil {
-			log.Printf("Warning: User Settings migrations failed: %v", err)
-		}
-		logger.Info("User Settings migrations completed")
-
-		// Run Early Warning extended fields migration (Story 9.4 Phase 4)
-		if err := db.MigrateEarlyWarningExtendedFields(ctx); err != nil {
-			log.Printf("Warning: Early Warning extended fields migration failed: %v", err)
-		}
-		logger.Info("Early Warning extended fields migration completed")
-
-		// Run Mode Config early_warning column migration (Story 9.4 Phase 4)
-		if err := db.MigrateModeConfigEarlyWarning(ctx); err != nil {
-			log.Printf("Warning: Mode Config early_warning migration failed: %v", err)
-		}
-		logger.Info("Mode Config early_warning migration completed")
-
-		// Run User Safety Settings migration (023) - Story 9.4
-		if err := db.RunUserSafetySettingsMigration(ctx); err != nil {
-			log.Printf("Warning: User Safety Settings migration failed: %v", err)
-		}
-		logger.Info("User Safety Settings migration completed")
 	}
 
 	// Create repository early for API key service
 	earlyRepo := database.NewRepository(db)
+	audit.Init(earlyRepo)
 
 	// Initialize API Key Service for user-specific keys from database
 	apiKeyService := apikeys.NewService(earlyRepo) // Used by UserAutopilotManager for per-user AI keys
@@ -273,7 +246,8 @@ func main() {
 	var marketDataCache *binance.MarketDataCache
 	if cfg.FuturesConfig.Enabled {
 		marketDataCache = binance.NewMarketDataCache()
-		logger.Info("Market data cache initialized")
+		marketDataCache.SetMaxPriceAge(time.Duration(cfg.FuturesConfig.PriceMaxAgeSeconds) * time.Second)
+		logger.Info("Market data cache initialized", "max_price_age", marketDataCache.MaxPriceAge())
 	}
 
 	// Initialize Spot clients - NO GLOBAL API KEYS
@@ -306,15 +280,20 @@ func main() {
 		MaxTradesPerMinute:   cfg.CircuitBreakerConfig.MaxTradesPerMinute,
 		MaxDailyLoss:         cfg.CircuitBreakerConfig.MaxDailyLoss,
 		MaxDailyTrades:       cfg.CircuitBreakerConfig.MaxDailyTrades,
+
+		ReminderIntervalMinutes: cfg.CircuitBreakerConfig.ReminderIntervalMinutes,
+		AlertDebounceMinutes:    cfg.CircuitBreakerConfig.AlertDebounceMinutes,
 	}
 	circuitBreaker := circuit.NewCircuitBreaker(circuitBreakerConfig)
 	circuitBreaker.OnTrip(func(reason string) {
 		logger.Warn("Circuit breaker tripped", "reason", reason)
-		// Notification can be added here if needed
 	})
 	circuitBreaker.OnReset(func() {
 		logger.Info("Circuit breaker reset, trading resumed")
 	})
+	if notifyManager != nil {
+		notifyManager.SubscribeCircuitBreaker(circuitBreaker)
+	}
 	logger.Info("Circuit breaker initialized", "enabled", circuitBreakerConfig.Enabled)
 
 	// Initialize ML Predictor
@@ -349,6 +328,14 @@ func main() {
 			UpdateInterval:   15 * time.Minute,
 			SentimentWeight:  0.2,
 		}
+		if raw := os.Getenv("SENTIMENT_SOURCE_WEIGHTS"); raw != "" {
+			weights, err := sentiment.ParseSourceWeights(raw)
+			if err != nil {
+				logger.Warn("Ignoring SENTIMENT_SOURCE_WEIGHTS", "error", err)
+			} else {
+				sentimentConfig.SourceWeights = weights
+			}
+		}
 		sentimentAnalyzer = sentiment.NewAnalyzer(sentimentConfig)
 		sentimentAnalyzer.Start()
 		logger.Info("Sentiment Analyzer initialized", "news_enabled", cryptoNewsKey != "")
@@ -372,15 +359,12 @@ func main() {
 				"direction", event.Direction,
 				"size_multiplier", event.SizeMultiplier,
 				"confidence", event.Confidence)
-			eventBus.Publish(events.Event{
-				Type: events.EventSignalGenerated,
-				Data: map[string]interface{}{
-					"strategy":    "big_candle",
-					"symbol":      event.Symbol,
-					"signal_type": event.Direction,
-					"price":       event.ClosePrice,
-					"reason":      fmt.Sprintf("Big %s candle: %.1fx average size", event.Direction, event.SizeMultiplier),
-				},
+			eventBus.PublishTyped(events.SignalGenerated{
+				Strategy:   "big_candle",
+				Symbol:     event.Symbol,
+				SignalType: event.Direction,
+				Price:      event.ClosePrice,
+				Reason:     fmt.Sprintf("Big %s candle: %.1fx average size", event.Direction, event.SizeMultiplier),
 			})
 		})
 		logger.Info("Big Candle Detector initialized", "multiplier", cfg.BigCandleConfig.SizeMultiplier)
@@ -474,6 +458,27 @@ func main() {
 			"stop_loss", orderManagerConfig.StopLossPercent,
 			"trailing_enabled", orderManagerConfig.TrailingStopEnabled)
 
+		// OCO brackets (TP + SL + breakeven + trailing) for live spot buys. Opt-in
+		// until proven on live fills; the order manager protects buys otherwise.
+		if getEnvBool("SPOT_OCO_BRACKETS_ENABLED", false) {
+			bracketConfig := autopilot.DefaultSpotBracketConfig()
+			bracketConfig.TakeProfitPercent = orderManagerConfig.TakeProfitPercent
+			bracketConfig.StopLossPercent = orderManagerConfig.StopLossPercent
+			bracketConfig.TrailingStopEnabled = orderManagerConfig.TrailingStopEnabled
+			bracketConfig.TrailingStopPercent = orderManagerConfig.TrailingStopPercent
+			bracketConfig.BreakevenEnabled = getEnvBool("SPOT_BREAKEVEN_ENABLED", true)
+			if beActivation := getEnvFloat("SPOT_BREAKEVEN_ACTIVATION_PERCENT", 1.0); beActivation > 0 {
+				bracketConfig.BreakevenActivation = beActivation
+			}
+
+			spotBrackets := autopilot.NewSpotBracketManager(bracketConfig, tradingBot.GetBinanceClient(), repo)
+			autopilotController.SetSpotBracketManager(spotBrackets)
+			spotBrackets.Start()
+			logger.Info("Spot OCO bracket manager started",
+				"breakeven_enabled", bracketConfig.BreakevenEnabled,
+				"breakeven_activation", bracketConfig.BreakevenActivation)
+		}
+
 		// Set up callbacks
 		autopilotController.OnDecision(func(decision *autopilot.TradingDecision) {
 			logger.Info("Autopilot decision",
@@ -687,8 +692,16 @@ func main() {
 	log.Printf("Strategy scanner initialized (enabled: %v, interval: %v)",
 		scannerConfig.Enabled, scannerConfig.ScanInterval)
 
+	// Records that fail to persist are kept in a local dead-letter file and retried
+	deadLetters, err := database.NewDeadLetterQueue(repo, getEnv("EVENT_DLQ_FILE", database.DefaultDeadLetterFile), getEnvInt("EVENT_DLQ_MAX_ENTRIES", database.DefaultDeadLetterMaxEntries))
+	if err != nil {
+		logger.WithError(err).Warn("Dead-letter queue unavailable, failed event persistence will not be retried")
+	} else {
+		go deadLetters.Start(context.Background())
+	}
+
 	// Subscribe to events and persist to database
-	setupEventPersistence(eventBus, repo, notifyManager, logger)
+	setupEventPersistence(eventBus, repo, deadLetters, notifyManager, logger)
 
 	// Initialize WebSocket hub
 	wsHub := api.InitWebSocket(eventBus)
@@ -778,6 +791,11 @@ func main() {
 		Host:            getEnv("WEB_HOST", "0.0.0.0"),
 		ProductionMode:  true,
 		StaticFilesPath: "./web/dist", // Path to built React app
+		TradingViewWebhook: api.TradingViewWebhookConfig{
+			Enabled:        cfg.WebhookConfig.TradingViewEnabled,
+			Secret:         cfg.WebhookConfig.TradingViewSecret,
+			AllowedSymbols: cfg.WebhookConfig.TradingViewAllowedSymbols,
+		},
 	}
 
 	// Create a bot API wrapper for the web interface
@@ -817,9 +835,6 @@ func main() {
 	// Initialize auth service if enabled
 	var authService *auth.Service
 	if cfg.AuthConfig.Enabled {
-		if cfg.AuthConfig.JWTSecret == "" {
-			log.Fatalf("AUTH_ENABLED is true but AUTH_JWT_SECRET is not set")
-		}
 		authConfig := auth.Config{
 			JWTSecret:                cfg.AuthConfig.JWTSecret,
 			AccessTokenDuration:      cfg.AuthConfig.AccessTokenDuration,
@@ -868,6 +883,7 @@ func main() {
 	// This enables each user to have their own independent autopilot instance
 	// Note: clientFactory is optional - manager can use apiKeyService directly from database
 	var userAutopilotManager *autopilot.UserAutopilotManager
+	var accountManager *autopilot.AccountManager
 	// Story 6.5: Declare settingsCache outside the block so it's accessible for API server wiring
 	var settingsCache *cache.SettingsCacheService
 	// Story 6.4: Declare adminDefaultsCache outside the block so it's accessible for API server wiring
@@ -877,6 +893,9 @@ func main() {
 		llmConfig := llm.DefaultAnalyzerConfig()
 		llmConfig.Provider = llm.Provider(cfg.AIConfig.LLMProvider)
 		llmConfig.Model = cfg.AIConfig.LLMModel
+		llmConfig.MaxConcurrent = cfg.AIConfig.LLMMaxConcurrent
+		llmConfig.MaxQueued = cfg.AIConfig.LLMMaxQueued
+		llmConfig.QueueTimeout = time.Duration(cfg.AIConfig.LLMQueueTimeoutMs) * time.Millisecond
 
 		// Create the multi-user autopilot manager
 		userAutopilotLogger := logging.New(&logging.Config{
@@ -926,8 +945,34 @@ func main() {
 
 		// Set manager on FuturesController for access in handlers
 		futuresAutopilotController.SetUserAutopilotManager(userAutopilotManager)
+		botAPI.userAutopilotManager = userAutopilotManager
+
+		if sentimentAnalyzer != nil {
+			userAutopilotManager.SetSentimentAnalyzer(sentimentAnalyzer)
+		}
+
+		// Orphan algo-order spikes are reported through the notification channels
+		if notifyManager != nil {
+			userAutopilotManager.SetAlertNotifier(notifyManager)
+			if ginie := futuresAutopilotController.GetGinieAutopilot(); ginie != nil {
+				ginie.SetAlertNotifier(notifyManager)
+			}
+		}
 
 		logger.Info("UserAutopilotManager initialized for multi-user trading")
+
+		// Extra futures accounts (e.g. a hedge account) run alongside the admin's
+		// primary account, each by its own autopilot on the shared analyzer
+		if len(cfg.FuturesConfig.Accounts) > 0 && apiKeyService != nil {
+			accountManager = setupFuturesAccounts(cfg, repo, apiKeyService,
+				futuresAutopilotController.GetGinieAnalyzer(), userAutopilotLogger, positionStateRepo, settingsCache, logger)
+			if accountManager != nil {
+				botAPI.accountManager = accountManager
+				if notifyManager != nil {
+					accountManager.SetAlertNotifier(notifyManager)
+				}
+			}
+		}
 	}
 
 	// Story 6.4: Wire DefaultsCopierAdapter to auth service for new user registration
@@ -939,9 +984,9 @@ func main() {
 	}
 
 	// Subscribe to user logout events for cleanup (after all components are initialized)
-	eventBus.Subscribe(events.EventUserLogout, func(event events.Event) {
-		userID, ok := event.Data["user_id"].(string)
-		if !ok || userID == "" {
+	events.SubscribeTyped(eventBus, func(logout events.UserLoggedOut) {
+		userID := logout.UserID
+		if userID == "" {
 			logger.Warn("User logout event missing user_id")
 			return
 		}
@@ -982,7 +1027,12 @@ func main() {
 	}
 
 	// Initialize License validation
-	licenseInfo, err := license.GetLicenseFromEnv()
+	licenseValidator := license.NewValidatorFromEnv()
+	if repo != nil {
+		licenseValidator.SetFingerprintStore(repo.LicenseFingerprintStore(), license.FingerprintPolicyFromEnv())
+		licenseValidator.AddRevocationList(repo.LicenseRevocationList())
+	}
+	licenseInfo, err := licenseValidator.ValidateLicense(os.Getenv("LICENSE_KEY"))
 	if err != nil {
 		logger.Warn("License validation failed, running in trial mode", "error", err)
 	} else if licenseInfo != nil && licenseInfo.IsValid {
@@ -1000,6 +1050,17 @@ func main() {
 		server.SetUserAutopilotManager(userAutopilotManager)
 		logger.Info("UserAutopilotManager set on API server")
 	}
+	if accountManager != nil {
+		server.SetAccountManager(accountManager)
+	}
+
+	// Symbol auto-classifier: re-tiers per-symbol performance categories from closed trades
+	var symbolClassifier *autopilot.SymbolAutoClassifier
+	if repo != nil {
+		symbolClassifier = autopilot.NewSymbolAutoClassifier(repo, logger)
+		symbolClassifier.Start()
+		server.SetSymbolClassifier(symbolClassifier)
+	}
 
 	// Story 6.5: Set the SettingsCacheService on the server for cache-first API pattern
 	if settingsCache != nil {
@@ -1013,6 +1074,10 @@ func main() {
 		logger.Info("AdminDefaultsCacheService set on API server for settings comparison")
 	}
 
+	if deadLetters != nil {
+		server.SetDeadLetterQueue(deadLetters)
+	}
+
 	// Epic 8: Set the SettlementService on the server for daily P&L analytics
 	if settlementService != nil {
 		server.SetSettlementService(settlementService)
@@ -1038,6 +1103,23 @@ func main() {
 		}
 	}()
 
+	// Start the opt-in gRPC control API on its own port
+	var grpcServer *grpcapi.Server
+	if cfg.GRPCConfig.Enabled {
+		grpcServer = grpcapi.NewServer(grpcapi.Config{
+			Host:              cfg.GRPCConfig.Host,
+			Port:              cfg.GRPCConfig.Port,
+			AuthToken:         cfg.GRPCConfig.AuthToken,
+			StreamInterval:    time.Duration(cfg.GRPCConfig.StreamIntervalMs) * time.Millisecond,
+			MinStreamInterval: time.Duration(cfg.GRPCConfig.MinStreamIntervalMs) * time.Millisecond,
+		}, botAPI, eventBus)
+		go func() {
+			if err := grpcServer.Start(); err != nil {
+				log.Fatalf("Failed to start gRPC server: %v", err)
+			}
+		}()
+	}
+
 	// Start the bot
 	log.Println("Starting Binance Trading Bot...")
 	log.Printf("Dry run mode: %v", cfg.TradingConfig.DryRun)
@@ -1048,12 +1130,9 @@ func main() {
 	}
 
 	// Publish bot started event
-	eventBus.Publish(events.Event{
-		Type: events.EventBotStarted,
-		Data: map[string]interface{}{
-			"dry_run": cfg.TradingConfig.DryRun,
-			"testnet": cfg.BinanceConfig.TestNet,
-		},
+	eventBus.PublishTyped(events.BotStarted{
+		DryRun:  cfg.TradingConfig.DryRun,
+		TestNet: cfg.BinanceConfig.TestNet,
 	})
 
 	// Start screener
@@ -1090,21 +1169,32 @@ func main() {
 			if err := userAutopilotManager.AutoStartFromSettings(ctx); err != nil {
 				logger.Warn("Failed to auto-start Ginie from settings", "error", err)
 			}
+			if accountManager != nil {
+				if err := accountManager.AutoStartFromSettings(ctx); err != nil {
+					logger.Warn("Failed to auto-start futures accounts", "error", err)
+				}
+			}
 		}()
 	}
 
-	// Wait for interrupt signal
+	// Wait for interrupt signal; SIGHUP reloads configuration in place
 	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
-	<-sigChan
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	for sig := range sigChan {
+		if sig != syscall.SIGHUP {
+			break
+		}
+		if result, err := botAPI.ReloadConfig(); err != nil {
+			logger.Error("Config reload failed, keeping current configuration", "error", err)
+		} else {
+			logger.Info("Config reload via SIGHUP complete", "applied", result["applied"])
+		}
+	}
 
 	log.Println("Shutting down...")
 
 	// Publish bot stopped event
-	eventBus.Publish(events.Event{
-		Type: events.EventBotStopped,
-		Data: map[string]interface{}{},
-	})
+	eventBus.PublishTyped(events.BotStopped{})
 
 	// Graceful shutdown
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -1114,6 +1204,11 @@ func main() {
 	if err := server.Shutdown(shutdownCtx); err != nil {
 		log.Printf("Error shutting down web server: %v", err)
 	}
+	if grpcServer != nil {
+		if err := grpcServer.Shutdown(shutdownCtx); err != nil {
+			log.Printf("Error shutting down gRPC server: %v", err)
+		}
+	}
 
 	// Stop AI components
 	if autopilotController != nil {
@@ -1133,6 +1228,43 @@ func main() {
 		userAutopilotManager.Shutdown()
 		logger.Info("UserAutopilotManager stopped")
 	}
+	if accountManager != nil {
+		accountManager.Shutdown()
+		logger.Info("Futures accounts stopped")
+	}
+	if symbolClassifier != nil {
+		symbolClassifier.Stop()
+	}
+
+	// Apply the futures shutdown policy now that no autopilot can place new orders.
+	// Bounded by shutdownCtx so a slow exchange cannot hold up the exit.
+	if policy := cfg.FuturesConfig.ShutdownPolicy; policy != "" && policy != autopilot.ShutdownPolicyLeave {
+		policyCtx, policyCancel := context.WithTimeout(shutdownCtx, 20*time.Second)
+		logger.Info("Applying futures shutdown policy", "policy", policy)
+		handled := 0
+		if futuresAutopilotController != nil {
+			if ginie := futuresAutopilotController.GetGinieAutopilot(); ginie != nil {
+				handled += len(ginie.ApplyShutdownPolicy(policyCtx, policy))
+			}
+		}
+		if userAutopilotManager != nil {
+			for _, symbolResults := range userAutopilotManager.ApplyShutdownPolicy(policyCtx, policy) {
+				handled += len(symbolResults)
+			}
+		}
+		if accountManager != nil {
+			for _, symbolResults := range accountManager.ApplyShutdownPolicy(policyCtx, policy) {
+				handled += len(symbolResults)
+			}
+		}
+		if policyCtx.Err() != nil {
+			logger.Warn("Futures shutdown policy hit its deadline", "policy", policy, "symbols_handled", handled)
+		} else {
+			logger.Info("Futures shutdown policy applied", "policy", policy, "symbols_handled", handled)
+		}
+		policyCancel()
+	}
+
 	if sentimentAnalyzer != nil {
 		sentimentAnalyzer.Stop()
 		logger.Info("Sentiment analyzer stopped")
@@ -1164,6 +1296,13 @@ func main() {
 		}
 	}
 
+	// Write out queued audit entries before the database pool closes
+	auditCtx, auditCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	if err := audit.Flush(auditCtx); err != nil {
+		logger.Warn("Audit log not fully written before exit", "error", err)
+	}
+	auditCancel()
+
 	log.Println("Shutdown complete")
 }
 
@@ -1263,108 +1402,108 @@ func registerStrategies(bot *bot.TradingBot, cfg *config.Config) {
 	fmt.Println("Enhanced strategies registered (EMA + RSI + Volume filters enabled)")
 }
 
-func setupEventPersistence(eventBus *events.EventBus, repo *database.Repository, notifyManager *notification.Manager, logger *logging.Logger) {
+func setupEventPersistence(eventBus *events.EventBus, repo *database.Repository, deadLetters *database.DeadLetterQueue, notifyManager *notification.Manager, logger *logging.Logger) {
+	// deadLetter queues a record whose insert failed so it is retried instead of lost
+	deadLetter := func(kind string, record interface{}, persistErr error) error {
+		if deadLetters == nil {
+			return persistErr
+		}
+		if err := deadLetters.Add(kind, record, persistErr); err != nil {
+			return fmt.Errorf("%w (dead-letter queue failed: %v)", persistErr, err)
+		}
+		return fmt.Errorf("%w (queued for retry)", persistErr)
+	}
+
 	// Subscribe to trade events
-	eventBus.Subscribe(events.EventTradeClosed, func(event events.Event) {
+	events.SubscribeTypedErr(eventBus, func(trade events.TradeClosed) error {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 
 		// Create system event record
 		sysEvent := &database.SystemEvent{
-			EventType: string(event.Type),
+			EventType: string(trade.EventType()),
 			Source:    strPtr("bot"),
 			Message:   strPtr("Trade closed"),
-			Data:      event.Data,
-			Timestamp: event.Timestamp,
-		}
-		if err := repo.CreateSystemEvent(ctx, sysEvent); err != nil {
-			logger.WithError(err).Error("Failed to persist trade closed event")
+			Data:      events.PayloadData(trade),
+			Timestamp: time.Now(),
 		}
+		persistErr := repo.CreateSystemEvent(ctx, sysEvent)
 
 		// Send notification for closed trades
 		if notifyManager != nil {
-			symbol, _ := event.Data["symbol"].(string)
-			pnl, _ := event.Data["pnl"].(float64)
-			pnlPercent, _ := event.Data["pnl_percent"].(float64)
-			entryPrice, _ := event.Data["entry_price"].(float64)
-			exitPrice, _ := event.Data["exit_price"].(float64)
-
-			if err := notifyManager.SendTradeClose(symbol, entryPrice, exitPrice, pnl, pnlPercent, "closed"); err != nil {
+			reason := trade.Reason
+			if reason == "" {
+				reason = "closed"
+			}
+			if err := notifyManager.SendTradeClose(trade.Symbol, trade.EntryPrice, trade.ExitPrice, trade.PnL, trade.PnLPercent, reason); err != nil {
 				logger.WithError(err).Warn("Failed to send trade notification")
 			}
 		}
+		if persistErr != nil {
+			return fmt.Errorf("failed to persist trade closed event: %w", deadLetter(database.DeadLetterSystemEvent, sysEvent, persistErr))
+		}
+		return nil
 	})
 
 	// Subscribe to signal events
-	eventBus.Subscribe(events.EventSignalGenerated, func(event events.Event) {
+	events.SubscribeTypedErr(eventBus, func(sig events.SignalGenerated) error {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 
 		// Create signal record
-		strategyName, _ := event.Data["strategy"].(string)
-		symbol, _ := event.Data["symbol"].(string)
-		signalType, _ := event.Data["signal_type"].(string)
-		price, _ := event.Data["price"].(float64)
-		reason, _ := event.Data["reason"].(string)
-
 		signal := &database.Signal{
-			StrategyName: strategyName,
-			Symbol:       symbol,
-			SignalType:   signalType,
-			EntryPrice:   price,
-			Reason:       strPtr(reason),
-			Timestamp:    event.Timestamp,
+			StrategyName: sig.Strategy,
+			Symbol:       sig.Symbol,
+			SignalType:   sig.SignalType,
+			EntryPrice:   sig.Price,
+			Reason:       strPtr(sig.Reason),
+			Timestamp:    time.Now(),
 			Executed:     false,
+			Status:       sig.Status,
 		}
-		if err := repo.CreateSignal(ctx, signal); err != nil {
-			logger.WithError(err).Error("Failed to persist signal")
-		}
+		persistErr := repo.CreateSignal(ctx, signal)
 
-		// Send notification for new signals
-		if notifyManager != nil {
-			stopLoss, _ := event.Data["stop_loss"].(float64)
-			takeProfit, _ := event.Data["take_profit"].(float64)
-			if err := notifyManager.SendSignal(symbol, signalType, reason, price, stopLoss, takeProfit); err != nil {
+		// Send notification for new signals (provisional ones only go to the signal log)
+		if notifyManager != nil && sig.Status != "provisional" {
+			if err := notifyManager.SendSignal(sig.Symbol, sig.SignalType, sig.Reason, sig.Price, sig.StopLoss, sig.TakeProfit); err != nil {
 				logger.WithError(err).Warn("Failed to send signal notification")
 			}
 		}
+		if persistErr != nil {
+			return fmt.Errorf("failed to persist signal: %w", deadLetter(database.DeadLetterSignal, signal, persistErr))
+		}
+		return nil
 	})
 
 	// Subscribe to order events
-	eventBus.Subscribe(events.EventOrderPlaced, func(event events.Event) {
+	events.SubscribeTypedErr(eventBus, func(placed events.OrderPlaced) error {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 
 		// Create order record
-		orderID, _ := event.Data["order_id"].(int64)
-		symbol, _ := event.Data["symbol"].(string)
-		orderType, _ := event.Data["order_type"].(string)
-		side, _ := event.Data["side"].(string)
-		quantity, _ := event.Data["quantity"].(float64)
-
+		price := placed.Price
 		order := &database.Order{
-			ID:        orderID,
-			Symbol:    symbol,
-			OrderType: orderType,
-			Side:      side,
-			Quantity:  quantity,
+			ID:        placed.OrderID,
+			Symbol:    placed.Symbol,
+			OrderType: placed.OrderType,
+			Side:      placed.Side,
+			Price:     &price,
+			Quantity:  placed.Quantity,
 			Status:    "NEW",
-			CreatedAt: event.Timestamp,
-		}
-		if price, ok := event.Data["price"].(float64); ok {
-			order.Price = &price
-		}
-		if err := repo.CreateOrder(ctx, order); err != nil {
-			logger.WithError(err).Error("Failed to persist order")
+			CreatedAt: time.Now(),
 		}
+		persistErr := repo.CreateOrder(ctx, order)
 
 		// Send notification for new orders
 		if notifyManager != nil {
-			price, _ := event.Data["price"].(float64)
-			if err := notifyManager.SendTradeOpen(symbol, side, price, quantity); err != nil {
+			if err := notifyManager.SendTradeOpen(placed.Symbol, placed.Side, placed.Price, placed.Quantity); err != nil {
 				logger.WithError(err).Warn("Failed to send order notification")
 			}
 		}
+		if persistErr != nil {
+			return fmt.Errorf("failed to persist order: %w", deadLetter(database.DeadLetterOrder, order, persistErr))
+		}
+		return nil
 	})
 
 	logger.Info("Event persistence and notifications configured")
@@ -1398,12 +1537,17 @@ type BotAPIWrapper struct {
 	// API key service for creating per-user clients during mode switch
 	apiKeyService *apikeys.Service
 	repo          *database.Repository
+	// Multi-user Ginie manager, used to propagate config reloads
+	userAutopilotManager *autopilot.UserAutopilotManager
+	// Extra futures accounts (FUTURES_ACCOUNTS), also reloaded with config
+	accountManager *autopilot.AccountManager
+	reloadMu             sync.Mutex
 }
 
 func (w *BotAPIWrapper) GetStatus() map[string]interface{} {
 	status := map[string]interface{}{
 		"running":          true,
-		"dry_run":          w.cfg.TradingConfig.DryRun,
+		"dry_run":          w.isDryRun(),
 		"testnet":          w.cfg.BinanceConfig.TestNet,
 		"strategies_count": 2,
 		"open_positions":   0,
@@ -1464,13 +1608,24 @@ func (w *BotAPIWrapper) PlaceOrder(symbol, side, orderType string, quantity, pri
 	}
 
 	// In dry run mode, simulate the order
-	if w.cfg.TradingConfig.DryRun {
+	if w.isDryRun() {
 		log.Printf("DRY RUN - Manual order: %s %s %.8f %s @ %.8f", side, symbol, quantity, orderType, price)
 		// Return a fake order ID for dry run
 		return time.Now().UnixNano(), nil
 	}
 
 	orderResp, err := client.PlaceOrder(params)
+	details := map[string]interface{}{
+		"symbol":   symbol,
+		"side":     side,
+		"type":     orderType,
+		"quantity": quantity,
+		"price":    price,
+	}
+	if orderResp != nil {
+		details["order_id"] = orderResp.OrderId
+	}
+	audit.RecordResult(context.Background(), audit.ActionOrderPlaced, "manual", details, err)
 	if err != nil {
 		return 0, fmt.Errorf("failed to place order: %w", err)
 	}
@@ -1486,7 +1641,7 @@ func (w *BotAPIWrapper) CancelOrder(orderID int64) error {
 	}
 
 	// In dry run mode, just log and return success
-	if w.cfg.TradingConfig.DryRun {
+	if w.isDryRun() {
 		log.Printf("DRY RUN - Cancel order: %d", orderID)
 		return nil
 	}
@@ -1501,7 +1656,12 @@ func (w *BotAPIWrapper) CancelOrder(orderID int64) error {
 		return fmt.Errorf("failed to find order %d: %w", orderID, err)
 	}
 
-	if err := client.CancelOrder(order.Symbol, orderID); err != nil {
+	err = client.CancelOrder(order.Symbol, orderID)
+	audit.RecordResult(ctx, audit.ActionOrderCancelled, "manual", map[string]interface{}{
+		"symbol":   order.Symbol,
+		"order_id": orderID,
+	}, err)
+	if err != nil {
 		return fmt.Errorf("failed to cancel order: %w", err)
 	}
 
@@ -1544,7 +1704,7 @@ func (w *BotAPIWrapper) ClosePosition(symbol string) error {
 	}
 
 	// In dry run mode, update the database directly
-	if w.cfg.TradingConfig.DryRun {
+	if w.isDryRun() {
 		log.Printf("DRY RUN - Closing position: %s at %.8f", symbol, currentPrice)
 
 		// Calculate P&L
@@ -1588,6 +1748,17 @@ func (w *BotAPIWrapper) ClosePosition(symbol string) error {
 	}
 
 	orderResp, err := client.PlaceOrder(params)
+	details := map[string]interface{}{
+		"symbol":   symbol,
+		"side":     closeSide,
+		"type":     "MARKET",
+		"quantity": targetTrade.Quantity,
+		"trade_id": targetTrade.ID,
+	}
+	if orderResp != nil {
+		details["order_id"] = orderResp.OrderId
+	}
+	audit.RecordResult(ctx, audit.ActionPositionClosed, "manual", details, err)
 	if err != nil {
 		return fmt.Errorf("failed to close position: %w", err)
 	}
@@ -1621,6 +1792,10 @@ func (w *BotAPIWrapper) GetScanner() interface{} {
 	return w.scanner
 }
 
+func (w *BotAPIWrapper) GetScreener() interface{} {
+	return w.screener
+}
+
 func (w *BotAPIWrapper) GetRiskManager() *risk.RiskManager {
 	return w.riskManager
 }
@@ -1634,6 +1809,14 @@ func (w *BotAPIWrapper) GetNotificationManager() *notification.Manager {
 }
 
 func (w *BotAPIWrapper) GetFuturesClient() binance.FuturesClient {
+	// Kill switch: skip the controller's client, which may still be a live one
+	if autopilot.IsDryRunForced() {
+		if w.marketDataCache != nil {
+			return binance.NewCachedFuturesClient(w.futuresMockClient, w.marketDataCache)
+		}
+		return w.futuresMockClient
+	}
+
 	// Use FuturesController's actual client if available
 	// The client is already wrapped with cache when passed to FuturesController
 	if w.futuresAutopilotController != nil {
@@ -1646,7 +1829,7 @@ func (w *BotAPIWrapper) GetFuturesClient() binance.FuturesClient {
 	// Get base client based on dry_run mode
 	// In live mode, real client must be created per-request from user API keys
 	var baseClient binance.FuturesClient
-	if w.cfg.TradingConfig.DryRun {
+	if w.isDryRun() {
 		baseClient = w.futuresMockClient
 	} else {
 		// Live mode but no user-specific client was found
@@ -1664,7 +1847,7 @@ func (w *BotAPIWrapper) GetFuturesClient() binance.FuturesClient {
 // GetSpotClient returns the appropriate spot client based on trading mode
 func (w *BotAPIWrapper) GetSpotClient() binance.BinanceClient {
 	// Return appropriate client based on dry_run mode
-	if w.cfg.TradingConfig.DryRun {
+	if w.isDryRun() {
 		return w.spotMockClient
 	}
 	// Live mode - real client must be created per-request from user API keys
@@ -1689,11 +1872,29 @@ func (w *BotAPIWrapper) GetCircuitBreaker() *circuit.CircuitBreaker {
 	return w.circuitBreaker
 }
 
+// isDryRun reports paper mode, honoring the FORCE_DRY_RUN kill switch
+func (w *BotAPIWrapper) isDryRun() bool {
+	return w.cfg.TradingConfig.DryRun || autopilot.IsDryRunForced()
+}
+
 func (w *BotAPIWrapper) GetDryRunMode() bool {
-	return w.cfg.TradingConfig.DryRun
+	return w.isDryRun()
 }
 
-func (w *BotAPIWrapper) SetDryRunMode(enabled bool) error {
+func (w *BotAPIWrapper) SetDryRunMode(enabled bool) (err error) {
+	fromDryRun := w.cfg.TradingConfig.DryRun
+	defer func() {
+		audit.RecordResult(context.Background(), audit.ActionDryRunToggled, "settings", map[string]interface{}{
+			"from_dry_run": fromDryRun,
+			"to_dry_run":   enabled,
+		}, err)
+	}()
+
+	if !enabled && autopilot.IsDryRunForced() {
+		w.logger.Warn("Live mode switch refused: FORCE_DRY_RUN kill switch is active")
+		return autopilot.ErrLiveTradingDisabled
+	}
+
 	oldMode := w.cfg.TradingConfig.DryRun
 	modeStr := "PAPER"
 	if !enabled {
@@ -1851,6 +2052,153 @@ func (w *BotAPIWrapper) SetDryRunMode(enabled bool) error {
 	return nil
 }
 
+// restartRequiredConfig lists config sections that ReloadConfig never applies
+// to a running process. Changing any of these needs a full restart:
+//   - server (port, TLS, timeouts), database, Redis, Vault, billing, auth/JWT
+//   - binance (testnet, base URLs), futures (enabled, leverage caps, margin type, position mode)
+//   - AI providers and API keys, logging, screener
+//   - scanner enabled/worker count/max symbols, trailing stop settings
+//   - enabling notifications when they were disabled at startup, or changing credentials
+//   - trading dry_run (use POST /api/settings/trading-mode instead) and the FORCE_DRY_RUN kill switch
+//
+// Environment variables are read once per process, so a reload only picks up
+// changes made to config.json.
+var restartRequiredConfig = []string{
+	"server", "database", "redis", "vault", "billing", "auth",
+	"binance", "futures", "ai", "logging", "screener",
+	"scanner.enabled", "scanner.worker_count", "scanner.max_symbols",
+	"risk.use_trailing_stop", "risk.trailing_stop_percent", "risk.trailing_stop_activation",
+	"notifications.credentials", "trading.dry_run", "trading.force_dry_run",
+}
+
+// modeTPAllocations returns the TP quantity allocation of each default mode
+// config, for Config.Validate
+func modeTPAllocations() map[string][]float64 {
+	allocations := make(map[string][]float64)
+	for mode, modeConfig := range autopilot.GetSettingsManager().GetDefaultModeConfigs() {
+		if modeConfig != nil && modeConfig.SLTP != nil {
+			allocations[mode] = modeConfig.SLTP.TPAllocation
+		}
+	}
+	return allocations
+}
+
+// ReloadConfig re-reads config.json and autopilot settings and applies the
+// safely reloadable subset to the running components: risk limits, circuit
+// breaker limits, futures autopilot confidence/confluence, scan interval,
+// notification toggles and per-user Ginie mode settings.
+// Invoked by SIGHUP and POST /api/admin/config/reload.
+func (w *BotAPIWrapper) ReloadConfig() (map[string]interface{}, error) {
+	w.reloadMu.Lock()
+	defer w.reloadMu.Unlock()
+
+	newCfg, err := config.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	newCfg.ModeTPAllocations = modeTPAllocations()
+	if err := newCfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	applied := []string{}
+
+	// Risk limits
+	if w.riskManager != nil {
+		w.riskManager.UpdateConfig(&risk.Config{
+			MaxRiskPerTrade:    newCfg.RiskConfig.MaxRiskPerTrade,
+			MaxDailyDrawdown:   newCfg.RiskConfig.MaxDailyDrawdown,
+			MaxOpenPositions:   newCfg.RiskConfig.MaxOpenPositions,
+			PositionSizeMethod: newCfg.RiskConfig.PositionSizeMethod,
+			FixedPositionSize:  newCfg.RiskConfig.FixedPositionSize,
+		})
+		w.cfg.RiskConfig.MaxRiskPerTrade = newCfg.RiskConfig.MaxRiskPerTrade
+		w.cfg.RiskConfig.MaxDailyDrawdown = newCfg.RiskConfig.MaxDailyDrawdown
+		w.cfg.RiskConfig.MaxOpenPositions = newCfg.RiskConfig.MaxOpenPositions
+		w.cfg.RiskConfig.PositionSizeMethod = newCfg.RiskConfig.PositionSizeMethod
+		w.cfg.RiskConfig.FixedPositionSize = newCfg.RiskConfig.FixedPositionSize
+		applied = append(applied, "risk")
+	}
+
+	// Circuit breaker limits. Same precedence as startup: config.json first,
+	// then values saved from the UI in autopilot settings win.
+	if w.circuitBreaker != nil {
+		w.circuitBreaker.SetEnabled(newCfg.CircuitBreakerConfig.Enabled)
+		w.circuitBreaker.UpdateConfig(&circuit.CircuitBreakerConfig{
+			MaxLossPerHour:       newCfg.CircuitBreakerConfig.MaxLossPerHour,
+			MaxConsecutiveLosses: newCfg.CircuitBreakerConfig.MaxConsecutiveLosses,
+			CooldownMinutes:      newCfg.CircuitBreakerConfig.CooldownMinutes,
+			MaxTradesPerMinute:   newCfg.CircuitBreakerConfig.MaxTradesPerMinute,
+			MaxDailyLoss:         newCfg.CircuitBreakerConfig.MaxDailyLoss,
+			MaxDailyTrades:       newCfg.CircuitBreakerConfig.MaxDailyTrades,
+		})
+		w.cfg.CircuitBreakerConfig = newCfg.CircuitBreakerConfig
+		applied = append(applied, "circuit_breaker")
+	}
+
+	// Futures autopilot thresholds
+	if w.futuresAutopilotController != nil {
+		if err := w.futuresAutopilotController.SetMinConfidence(newCfg.FuturesAutopilotConfig.MinConfidence); err != nil {
+			w.logger.Warn("Config reload: min confidence not applied", "error", err)
+		}
+		if err := w.futuresAutopilotController.SetConfluence(newCfg.FuturesAutopilotConfig.RequireConfluence); err != nil {
+			w.logger.Warn("Config reload: confluence not applied", "error", err)
+		}
+
+		if settings, err := autopilot.GetSettingsManager().LoadSettings(); err != nil {
+			w.logger.Warn("Config reload: failed to read autopilot settings", "error", err)
+		} else {
+			if err := w.futuresAutopilotController.SetCircuitBreakerEnabled(settings.CircuitBreakerEnabled); err == nil {
+				w.futuresAutopilotController.UpdateCircuitBreakerConfig(&circuit.CircuitBreakerConfig{
+					MaxLossPerHour:       settings.MaxLossPerHour,
+					MaxDailyLoss:         settings.MaxDailyLoss,
+					MaxConsecutiveLosses: settings.MaxConsecutiveLosses,
+					CooldownMinutes:      settings.CooldownMinutes,
+					MaxTradesPerMinute:   settings.MaxTradesPerMinute,
+					MaxDailyTrades:       settings.MaxDailyTrades,
+				})
+			}
+		}
+		applied = append(applied, "futures_autopilot.min_confidence", "futures_autopilot.require_confluence")
+	}
+
+	// Scan interval
+	if w.scanner != nil && newCfg.ScannerConfig.ScanInterval > 0 {
+		w.scanner.SetScanInterval(time.Duration(newCfg.ScannerConfig.ScanInterval) * time.Second)
+		w.cfg.ScannerConfig.ScanInterval = newCfg.ScannerConfig.ScanInterval
+		applied = append(applied, "scanner.scan_interval")
+	}
+
+	// Notification toggles (only for providers registered at startup)
+	if w.notifyManager != nil {
+		w.notifyManager.SetEnabled(newCfg.NotificationConfig.Enabled)
+		w.notifyManager.SetNotifierEnabled("telegram", newCfg.NotificationConfig.Telegram.Enabled)
+		w.notifyManager.SetNotifierEnabled("discord", newCfg.NotificationConfig.Discord.Enabled)
+		w.cfg.NotificationConfig.Enabled = newCfg.NotificationConfig.Enabled
+		w.cfg.NotificationConfig.Telegram.Enabled = newCfg.NotificationConfig.Telegram.Enabled
+		w.cfg.NotificationConfig.Discord.Enabled = newCfg.NotificationConfig.Discord.Enabled
+		applied = append(applied, "notifications")
+	}
+
+	// Per-user Ginie instances re-read mode enables and thresholds from their settings
+	ginieInstances := 0
+	if w.userAutopilotManager != nil {
+		ginieInstances = w.userAutopilotManager.ReloadAllConfigs()
+		applied = append(applied, "ginie")
+	}
+	if w.accountManager != nil {
+		ginieInstances += w.accountManager.ReloadAllConfigs()
+	}
+
+	w.logger.Info("Configuration reloaded", "applied", applied, "ginie_instances", ginieInstances)
+
+	return map[string]interface{}{
+		"applied":          applied,
+		"restart_required": restartRequiredConfig,
+		"ginie_instances":  ginieInstances,
+	}, nil
+}
+
 // Helper functions
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
@@ -1889,3 +2237,53 @@ func getEnvBool(key string, defaultValue bool) bool {
 func strPtr(s string) *string {
 	return &s
 }
+
+// setupFuturesAccounts builds the extra futures accounts listed in
+// FUTURES_ACCOUNTS. Accounts belong to the admin user; each uses the admin's
+// stored Binance key with the account's name as its label. Accounts whose key
+// can't be loaded are skipped with a warning.
+func setupFuturesAccounts(
+	cfg *config.Config,
+	repo *database.Repository,
+	apiKeyService *apikeys.Service,
+	ginieAnalyzer *autopilot.GinieAnalyzer,
+	accountLogger *logging.Logger,
+	positionStateRepo *database.RedisPositionStateRepository,
+	settingsCache *cache.SettingsCacheService,
+	logger *logging.Logger,
+) *autopilot.AccountManager {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	ownerID, err := apiKeyService.GetAdminUserID(ctx)
+	if err != nil {
+		logger.Warn("Futures accounts disabled - no admin user to own them", "error", err)
+		return nil
+	}
+
+	mgr := autopilot.NewAccountManager(ownerID, repo, ginieAnalyzer, accountLogger, positionStateRepo, settingsCache)
+
+	for _, acct := range cfg.FuturesConfig.Accounts {
+		route, err := autopilot.ParseAccountRoute(acct.Route)
+		if err != nil {
+			logger.Warn("Skipping futures account", "account", acct.Name, "error", err)
+			continue
+		}
+		key, err := apiKeyService.GetBinanceKeyByLabel(ctx, ownerID, acct.Name, cfg.FuturesConfig.TestNet)
+		if err != nil {
+			logger.Warn("Skipping futures account - API key unavailable", "account", acct.Name, "error", err)
+			continue
+		}
+		client := binance.NewFuturesClient(key.APIKey, key.SecretKey, key.IsTestnet)
+		if _, err := mgr.AddAccount(acct.Name, client, route); err != nil {
+			logger.Warn("Skipping futures account", "account", acct.Name, "error", err)
+		}
+	}
+
+	if len(mgr.Names()) == 0 {
+		logger.Warn("No futures accounts could be set up")
+		return nil
+	}
+	logger.Info("Futures accounts initialized", "accounts", mgr.Names())
+	return mgr
+}
@@ -359,6 +359,18 @@ export default function GinieDiagnosticsPanel() {
                 style={{ width: `${(diagnostics.positions.open_count / diagnostics.positions.max_allowed) * 100}%` }}
               />
             </div>
+            {diagnostics.positions.modes && (
+              <div className="mt-2 space-y-0.5">
+                {Object.entries(diagnostics.positions.modes).map(([mode, slots]) => (
+                  <div key={mode} className="flex justify-between text-xs">
+                    <span className="text-gray-400">{mode}</span>
+                    <span className={slots.saturated ? 'text-red-400' : 'text-gray-300'}>
+                      {slots.open}/{slots.max}
+                    </span>
+                  </div>
+                ))}
+              </div>
+            )}
           </div>
 
           {/* Circuit Breaker */}
@@ -5741,7 +5741,11 @@ export default function GiniePanel() {
                               {decision.rejection_tracking.trend_divergence?.blocked && (
                                 <div className="flex items-center gap-2 text-xs text-orange-400">
                                   <AlertOctagon className="w-3 h-3" />
-                                  <span>Trend Divergence: {decision.rejection_tracking.trend_divergence.scan_trend} ({decision.rejection_tracking.trend_divergence.scan_timeframe}) vs {decision.rejection_tracking.trend_divergence.decision_trend} ({decision.rejection_tracking.trend_divergence.decision_timeframe})</span>
+                                  {decision.rejection_tracking.trend_divergence.timeframes_required ? (
+                                    <span>Timeframe Confluence: {decision.rejection_tracking.trend_divergence.reason}</span>
+                                  ) : (
+                                    <span>Trend Divergence: {decision.rejection_tracking.trend_divergence.scan_trend} ({decision.rejection_tracking.trend_divergence.scan_timeframe}) vs {decision.rejection_tracking.trend_divergence.decision_trend} ({decision.rejection_tracking.trend_divergence.decision_timeframe})</span>
+                                  )}
                                 </div>
                               )}
                               {decision.rejection_tracking.signal_strength?.blocked && (
@@ -3242,6 +3242,9 @@ export interface TrendDivergenceRejection {
   decision_trend: string;
   severity: string;
   reason: string;
+  timeframe_trends?: Record<string, string>; // Set when multi-timeframe confluence blocked the trade
+  timeframes_agreeing?: number;
+  timeframes_required?: number;
 }
 
 export interface SignalStrengthRejection {
@@ -3638,6 +3641,16 @@ export interface PositionDiagnostics {
   max_allowed: number;
   slots_available: number;
   total_unrealized_pnl: number;
+  modes?: Record<string, ModePositionSlots>;
+}
+
+export interface ModePositionSlots {
+  open: number;
+  max: number;
+  available: number;
+  saturated: boolean;
+  cap_source: string;
+  last_skipped?: string;
 }
 
 export interface ScanDiagnostics {
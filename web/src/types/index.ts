@@ -292,7 +292,12 @@ export type WebSocketEventType =
   | 'PNL_UPDATE'
   | 'MODE_STATUS_UPDATE'
   | 'SYSTEM_STATUS_UPDATE'
-  | 'SIGNAL_UPDATE';
+  | 'SIGNAL_UPDATE'
+  | 'GINIE_POSITION_OPENED'
+  | 'GINIE_POSITION_TP_HIT'
+  | 'GINIE_POSITION_SL_MOVED'
+  | 'GINIE_POSITION_TRAILING_ACTIVATED'
+  | 'GINIE_POSITION_CLOSED';
 
 // Chart Data
 export interface CandleData {